@@ -0,0 +1,12 @@
+package config
+
+type AbsenceConfig struct {
+	// Page customers are sent to for booking again the appointments cancelled by an absence,
+	// e.g. https://clinic.com/appointments/reschedule. The cancelled appointment is passed as
+	// the appointment_id query parameter. When empty customers are asked to contact the clinic
+	RescheduleURL string `json:"reschedule_url"`
+}
+
+func loadAbsenceConfig(config *AbsenceConfig) {
+	config.RescheduleURL = getEnvWithDefault("ABSENCE_RESCHEDULE_URL", "")
+}
@@ -33,6 +33,26 @@ type AppSettings struct {
 	// CORS Configuration
 	CORS CORSConfig `json:"cors"`
 
+	// Background Workers Configuration
+	Workers WorkerConfig `json:"workers"`
+
+	// Appointment No-Show Policy Configuration
+	NoShow NoShowConfig `json:"no_show"`
+
+	Waitlist WaitlistConfig `json:"waitlist"`
+
+	// Appointment iCalendar Feeds Configuration
+	CalendarFeed CalendarFeedConfig `json:"calendar_feed"`
+
+	// Employee Absence Coverage Configuration
+	Absence AbsenceConfig `json:"absence"`
+
+	// Lab Analyzer Interface Configuration
+	Lab LabConfig `json:"lab"`
+
+	// Medical Attachments Storage Configuration
+	Attachment AttachmentConfig `json:"attachment"`
+
 	// Application Configuration
 	App AppConfig `json:"app"`
 }
@@ -95,6 +115,23 @@ func LoadSettings() (*AppSettings, error) {
 
 	loadRateLimitConfig(&settings.RateLimit)
 	loadCORSConfig(&settings.CORS)
+	loadWorkerConfig(&settings.Workers)
+	loadNoShowConfig(&settings.NoShow)
+	loadWaitlistConfig(&settings.Waitlist)
+	loadAbsenceConfig(&settings.Absence)
+
+	if err := loadLabConfig(&settings.Lab); err != nil {
+		return nil, fmt.Errorf("lab config error: %w", err)
+	}
+
+	if err := loadCalendarFeedConfig(&settings.CalendarFeed, settings.Auth.JWTSecret); err != nil {
+		return nil, fmt.Errorf("calendar feed config error: %w", err)
+	}
+
+	if err := loadAttachmentConfig(&settings.Attachment, settings.Auth.JWTSecret); err != nil {
+		return nil, fmt.Errorf("attachment config error: %w", err)
+	}
+
 	loadAppConfig(&settings.App)
 
 	return settings, nil
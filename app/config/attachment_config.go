@@ -0,0 +1,121 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"clinic-vet-api/app/shared/storage"
+)
+
+const (
+	AttachmentStorageLocal = "local"
+	AttachmentStorageS3    = "s3"
+)
+
+type AttachmentConfig struct {
+	// Where the files are kept, "local" for a directory of the server or "s3" for Amazon S3 or
+	// a compatible service such as MinIO
+	Storage   string `json:"storage"`
+	LocalPath string `json:"local_path"`
+
+	S3Endpoint string `json:"s3_endpoint"`
+	// Endpoint written in download URLs when browsers reach the service through another
+	// address than the API, e.g. http://localhost:9000 for the MinIO container
+	S3PublicEndpoint string `json:"s3_public_endpoint"`
+	S3Region         string `json:"s3_region"`
+	S3Bucket         string `json:"s3_bucket"`
+	S3AccessKeyID    string `json:"-"`
+	S3SecretKey      string `json:"-"`
+	S3UsePathStyle   bool   `json:"s3_use_path_style"`
+
+	// Secret signing the download URLs served by the API. It must not be shared with the JWT
+	// secret, a leaked URL secret would otherwise let anyone mint access tokens
+	SigningSecret string `json:"-"`
+	// Public URL the API serves downloads under, e.g. https://api.clinic.com/api/v2/medical-attachments/downloads.
+	// It is never taken from the request, a forged Host header would send the signed links elsewhere
+	DownloadBaseURL string        `json:"download_base_url"`
+	URLExpiration   time.Duration `json:"url_expiration"`
+
+	// Largest file accepted, in bytes
+	MaxFileSize int64 `json:"max_file_size"`
+}
+
+func loadAttachmentConfig(config *AttachmentConfig, jwtSecret string) error {
+	config.Storage = getEnvWithDefault("ATTACHMENT_STORAGE", AttachmentStorageLocal)
+	if config.Storage != AttachmentStorageLocal && config.Storage != AttachmentStorageS3 {
+		return fmt.Errorf("invalid ATTACHMENT_STORAGE %q, expected %q or %q", config.Storage, AttachmentStorageLocal, AttachmentStorageS3)
+	}
+
+	config.LocalPath = getEnvWithDefault("ATTACHMENT_LOCAL_PATH", "./storage/attachments")
+
+	config.S3Endpoint = getEnvWithDefault("ATTACHMENT_S3_ENDPOINT", "")
+	config.S3PublicEndpoint = getEnvWithDefault("ATTACHMENT_S3_PUBLIC_ENDPOINT", "")
+	config.S3Region = getEnvWithDefault("ATTACHMENT_S3_REGION", "us-east-1")
+	config.S3Bucket = getEnvWithDefault("ATTACHMENT_S3_BUCKET", "medical-attachments")
+	config.S3AccessKeyID = getEnvWithDefault("ATTACHMENT_S3_ACCESS_KEY", "")
+	config.S3SecretKey = getEnvWithDefault("ATTACHMENT_S3_SECRET_KEY", "")
+	config.S3UsePathStyle = parseBoolWithDefault("ATTACHMENT_S3_PATH_STYLE", true)
+
+	if config.Storage == AttachmentStorageS3 && (config.S3AccessKeyID == "" || config.S3SecretKey == "") {
+		return fmt.Errorf("ATTACHMENT_S3_ACCESS_KEY and ATTACHMENT_S3_SECRET_KEY are required with the s3 storage")
+	}
+
+	config.SigningSecret = getEnvWithDefault("ATTACHMENT_URL_SECRET", "")
+	if len(config.SigningSecret) < 32 {
+		return fmt.Errorf("ATTACHMENT_URL_SECRET is required and must be at least 32 characters long")
+	}
+	if config.SigningSecret == jwtSecret {
+		return fmt.Errorf("ATTACHMENT_URL_SECRET must differ from JWT_SECRET")
+	}
+
+	config.DownloadBaseURL = strings.TrimSuffix(getEnvWithDefault("ATTACHMENT_DOWNLOAD_BASE_URL", ""), "/")
+	baseURL, err := url.Parse(config.DownloadBaseURL)
+	if err != nil || (baseURL.Scheme != "http" && baseURL.Scheme != "https") || baseURL.Host == "" {
+		return fmt.Errorf("ATTACHMENT_DOWNLOAD_BASE_URL is required and must be an absolute http or https URL")
+	}
+
+	config.URLExpiration, err = parseDuration("ATTACHMENT_URL_TTL", "15m")
+	if err != nil || config.URLExpiration <= 0 {
+		return fmt.Errorf("invalid ATTACHMENT_URL_TTL")
+	}
+
+	maxSizeMB, err := parseIntWithDefault("ATTACHMENT_MAX_SIZE_MB", 25)
+	if err != nil || maxSizeMB <= 0 {
+		return fmt.Errorf("invalid ATTACHMENT_MAX_SIZE_MB")
+	}
+	config.MaxFileSize = int64(maxSizeMB) << 20
+
+	return nil
+}
+
+// NewAttachmentStorage opens the storage the files are kept in. The S3 bucket is created when
+// missing, which suits the MinIO container of development
+func NewAttachmentStorage(ctx context.Context, config AttachmentConfig) (storage.BlobStorage, error) {
+	if config.Storage != AttachmentStorageS3 {
+		return storage.NewLocalStorage(config.LocalPath)
+	}
+
+	s3Storage, err := storage.NewS3Storage(storage.S3Config{
+		Endpoint:        config.S3Endpoint,
+		PublicEndpoint:  config.S3PublicEndpoint,
+		Region:          config.S3Region,
+		Bucket:          config.S3Bucket,
+		AccessKeyID:     config.S3AccessKeyID,
+		SecretAccessKey: config.S3SecretKey,
+		UsePathStyle:    config.S3UsePathStyle,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	if err := s3Storage.EnsureBucket(ctx); err != nil {
+		return nil, err
+	}
+	return s3Storage, nil
+}
@@ -3,20 +3,31 @@ package config
 import (
 	"clinic-vet-api/app/middleware"
 	"clinic-vet-api/sqlc"
+	"context"
 	"fmt"
 	"log"
 
 	authAPI "clinic-vet-api/app/modules/account/auth"
 	userAPI "clinic-vet-api/app/modules/account/user/presentation"
 	apptApi "clinic-vet-api/app/modules/appointment/presentation"
+	calendarAPI "clinic-vet-api/app/modules/calendar/presentation"
+	"clinic-vet-api/app/modules/core/domain/entity/appointment"
 	"clinic-vet-api/app/modules/core/service"
 	customerAPI "clinic-vet-api/app/modules/customer/presentation"
 	vetAPI "clinic-vet-api/app/modules/employee/presentation"
+	inventoryAPI "clinic-vet-api/app/modules/inventory/presentation"
+	attachmentAPI "clinic-vet-api/app/modules/medical/attachment/presentation"
 	dewormApi "clinic-vet-api/app/modules/medical/deworm/presentation"
+	labAPI "clinic-vet-api/app/modules/medical/lab/presentation"
+	prescriptionAPI "clinic-vet-api/app/modules/medical/prescription/presentation"
 	medSessionAPI "clinic-vet-api/app/modules/medical/session/presentation"
 	api "clinic-vet-api/app/modules/medical/vaccination/presentation"
 	paymentAPI "clinic-vet-api/app/modules/payment/presentation"
 	petAPI "clinic-vet-api/app/modules/pet/presentation"
+	resourceAPI "clinic-vet-api/app/modules/resource/presentation"
+	"clinic-vet-api/app/shared/database"
+	"clinic-vet-api/app/shared/hl7"
+	"clinic-vet-api/app/shared/worker"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
@@ -26,10 +37,13 @@ import (
 func BootstrapAPIModules(
 	routerGroup *gin.RouterGroup,
 	queries *sqlc.Queries,
+	transactor *database.Transactor,
 	notificationService service.NotificationService,
 	validator *validator.Validate,
 	redis *redis.Client,
 	jwtSecret string,
+	workers *worker.Scheduler,
+	settings *AppSettings,
 ) error {
 	userModule := userAPI.NewUserAPIModule(userAPI.UserAPIConfig{
 		Router:        routerGroup,
@@ -44,12 +58,62 @@ func BootstrapAPIModules(
 		return fmt.Errorf("failed to bootstrap user API module: %w", err)
 	}
 
+	// Bootstrap Clinic Calendar Module
+	calendarModule := calendarAPI.NewCalendarAPIModule(&calendarAPI.CalendarAPIConfig{
+		Router:         routerGroup,
+		Queries:        queries,
+		Validator:      validator,
+		AuthMiddleware: authMiddleware,
+	})
+
+	if err := calendarModule.Bootstrap(); err != nil {
+		return fmt.Errorf("failed to bootstrap calendar module: %w", err)
+	}
+
+	calendarRepo, err := calendarModule.GetRepository()
+	if err != nil {
+		return fmt.Errorf("failed to get calendar repository: %w", err)
+	}
+
+	// Bootstrap Clinic Resources Module
+	resourceModule := resourceAPI.NewResourceAPIModule(&resourceAPI.ResourceAPIConfig{
+		Router:         routerGroup,
+		Queries:        queries,
+		Transactor:     transactor,
+		Validator:      validator,
+		AuthMiddleware: authMiddleware,
+	})
+
+	if err := resourceModule.Bootstrap(); err != nil {
+		return fmt.Errorf("failed to bootstrap clinic resources module: %w", err)
+	}
+
+	// Bootstrap Inventory Module
+	inventoryModule := inventoryAPI.NewInventoryAPIModule(&inventoryAPI.InventoryAPIConfig{
+		Router:         routerGroup,
+		Queries:        queries,
+		Transactor:     transactor,
+		Validator:      validator,
+		AuthMiddleware: authMiddleware,
+	})
+
+	if err := inventoryModule.Bootstrap(); err != nil {
+		return fmt.Errorf("failed to bootstrap inventory module: %w", err)
+	}
+
+	stockService, err := inventoryModule.GetStockService()
+	if err != nil {
+		return fmt.Errorf("failed to get stock service: %w", err)
+	}
+
 	// Bootstrap Employee Module
 	vetModule := vetAPI.NewEmployeeModule(&vetAPI.EmployeeAPIConfig{
 		Router:         routerGroup,
 		Queries:        queries,
+		Transactor:     transactor,
 		DataValidator:  validator,
 		AuthMiddleware: authMiddleware,
+		CalendarRepo:   calendarRepo,
 	})
 
 	if err := vetModule.Bootstrap(); err != nil {
@@ -96,21 +160,6 @@ func BootstrapAPIModules(
 		return fmt.Errorf("failed to get pet repository: %w", err)
 	}
 
-	// Bootstrap Medical Session Module
-	medSessionModule := medSessionAPI.NewMedicalSessionModule(&medSessionAPI.MedicalSessionModuleConfig{
-		Router:         routerGroup,
-		Queries:        queries,
-		Validator:      validator,
-		CustomerRepo:   &customerRepo,
-		EmployeeRepo:   &vetRepo,
-		PetRepo:        &petRepository,
-		AuthMiddleware: authMiddleware,
-	})
-
-	if err := medSessionModule.Bootstrap(); err != nil {
-		return fmt.Errorf("failed to bootstrap medical history module: %w", err)
-	}
-
 	customerRepo, err = customerModule.GetRepository()
 	if err != nil {
 		return fmt.Errorf("failed to get customer repository: %w", err)
@@ -155,27 +204,162 @@ func BootstrapAPIModules(
 		return fmt.Errorf("failed to get employee repository: %w", err)
 	}
 
+	exceptionRepo, err := vetModule.GetScheduleExceptionRepository()
+	if err != nil {
+		return fmt.Errorf("failed to get schedule exception repository: %w", err)
+	}
+
+	onCallService, err := vetModule.GetOnCallService()
+	if err != nil {
+		return fmt.Errorf("failed to get on-call service: %w", err)
+	}
+
 	apptModule := apptApi.NewAppointmentAPIBuilder(&apptApi.AppointmentAPIConfig{
 		Router:         routerGroup,
 		Validator:      validator,
 		Queries:        queries,
+		Transactor:     transactor,
 		AuthMiddleware: authMiddleware,
 		CustomerRepo:   customerRepo,
 		EmployeeRepo:   employeeRepo,
+		ExceptionRepo:  exceptionRepo,
+		OnCallService:  onCallService,
+		CalendarRepo:   calendarRepo,
+		UserRepo:       userModule.GetRepository(),
+		PetRepo:        petRepository,
+
+		NotificationService:  notificationService,
+		ReminderClaimTimeout: settings.Workers.ReminderClaimTimeout,
+		WaitlistOfferTTL:     settings.Waitlist.OfferTTL,
+		NoShowPolicy: appointment.NoShowPolicy{
+			GracePeriod:  settings.NoShow.GracePeriod,
+			MaxNoShows:   settings.NoShow.MaxNoShows,
+			LookbackDays: settings.NoShow.LookbackDays,
+		},
+		CalendarFeedSecret:   settings.CalendarFeed.SigningSecret,
+		CalendarFeedBaseURL:  settings.CalendarFeed.BaseURL,
+		AbsenceRescheduleURL: settings.Absence.RescheduleURL,
 	})
 
 	if err := apptModule.Build(); err != nil {
 		return fmt.Errorf("failed to bootstrap appointment API module: %w", err)
 	}
 
+	apptComponents, err := apptModule.GetComponents()
+	if err != nil {
+		return fmt.Errorf("failed to get appointment components: %w", err)
+	}
+
+	if err := vetModule.SetTimeOffCoverage(apptComponents.AbsenceCoverage); err != nil {
+		return fmt.Errorf("failed to set the time off coverage of the vet module: %w", err)
+	}
+
+	// Bootstrap Medical Session Module
+	medSessionModule := medSessionAPI.NewMedicalSessionModule(&medSessionAPI.MedicalSessionModuleConfig{
+		Router:         routerGroup,
+		Queries:        queries,
+		Transactor:     transactor,
+		Validator:      validator,
+		CustomerRepo:   &customerRepo,
+		EmployeeRepo:   &vetRepo,
+		PetRepo:        &petRepository,
+		ApptRepo:       apptComponents.Repository,
+		VisitRepo:      apptComponents.VisitRepository,
+		CalendarRepo:   calendarRepo,
+		ExceptionRepo:  exceptionRepo,
+		UserRepo:       userModule.GetRepository(),
+		AuthMiddleware: authMiddleware,
+
+		NotificationService: notificationService,
+		OnCallService:       onCallService,
+	})
+
+	if err := medSessionModule.Bootstrap(); err != nil {
+		return fmt.Errorf("failed to bootstrap medical history module: %w", err)
+	}
+
+	medSessionRepo, err := medSessionModule.GetRepository()
+	if err != nil {
+		return fmt.Errorf("failed to get medical session repository: %w", err)
+	}
+
+	prescriptionModule := prescriptionAPI.NewPrescriptionAPIModule(&prescriptionAPI.PrescriptionAPIConfig{
+		Router:             routerGroup,
+		Validator:          validator,
+		AuthMiddleware:     authMiddleware,
+		Queries:            queries,
+		Transactor:         transactor,
+		PetRepo:            petRepository,
+		MedicalSessionRepo: medSessionRepo,
+		StockService:       stockService,
+	})
+
+	if err := prescriptionModule.Bootstrap(); err != nil {
+		return fmt.Errorf("failed to bootstrap prescription API module: %w", err)
+	}
+
+	labModule := labAPI.NewLabAPIModule(&labAPI.LabAPIConfig{
+		Router:             routerGroup,
+		Validator:          validator,
+		AuthMiddleware:     authMiddleware,
+		Queries:            queries,
+		Transactor:         transactor,
+		PetRepo:            petRepository,
+		MedicalSessionRepo: medSessionRepo,
+	})
+
+	if err := labModule.Bootstrap(); err != nil {
+		return fmt.Errorf("failed to bootstrap lab API module: %w", err)
+	}
+
+	if settings.Lab.MLLPEnabled {
+		workers.RegisterService(hl7.NewMLLPServer(
+			settings.Lab.MLLPAddress,
+			labModule.Components.ORUIngestor.Handle,
+			settings.Lab.MLLPIdleTimeout,
+			settings.Lab.MLLPAllowedPeers,
+		))
+	}
+
+	attachmentStorage, err := NewAttachmentStorage(context.Background(), settings.Attachment)
+	if err != nil {
+		return fmt.Errorf("failed to open attachment storage: %w", err)
+	}
+
+	attachmentModule := attachmentAPI.NewAttachmentAPIModule(&attachmentAPI.AttachmentAPIConfig{
+		Router:             routerGroup,
+		Validator:          validator,
+		AuthMiddleware:     authMiddleware,
+		Queries:            queries,
+		PetRepo:            petRepository,
+		MedicalSessionRepo: medSessionRepo,
+		Storage:            attachmentStorage,
+		SigningSecret:      settings.Attachment.SigningSecret,
+		DownloadBaseURL:    settings.Attachment.DownloadBaseURL,
+		URLExpiration:      settings.Attachment.URLExpiration,
+		MaxFileSize:        settings.Attachment.MaxFileSize,
+	})
+
+	if err := attachmentModule.Bootstrap(); err != nil {
+		return fmt.Errorf("failed to bootstrap medical attachment API module: %w", err)
+	}
+
+	if settings.Workers.Enabled {
+		workers.Register(apptComponents.ReminderDispatcher, settings.Workers.ReminderInterval)
+		workers.Register(apptComponents.NoShowMarker, settings.Workers.NoShowInterval)
+		workers.Register(apptComponents.WaitlistOfferExpirer, settings.Workers.WaitlistInterval)
+	}
+
 	dewormModule := dewormApi.NewDewormAPIModule(&dewormApi.DewormAPIConfig{
 		RouterGroup:    routerGroup,
 		Queries:        queries,
+		Transactor:     transactor,
 		Validator:      validator,
 		AuthMiddleware: authMiddleware,
 		PetRepo:        petRepository,
 		EmployeeRepo:   vetRepo,
 		CustomerRepo:   customerRepo,
+		StockService:   stockService,
 	})
 
 	if err := dewormModule.Bootstrap(); err != nil {
@@ -185,11 +369,13 @@ func BootstrapAPIModules(
 	vaccinationModule := api.NewVaccinationAPIModule(&api.VaccinationConfig{
 		Router:         routerGroup,
 		Queries:        queries,
+		Transactor:     transactor,
 		Validator:      validator,
 		AuthMiddleware: authMiddleware,
 		PetRepo:        petRepository,
 		EmployeeRepo:   vetRepo,
 		CustomerRepo:   customerRepo,
+		StockService:   stockService,
 	})
 
 	if err := vaccinationModule.Bootstrap(); err != nil {
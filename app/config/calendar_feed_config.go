@@ -0,0 +1,25 @@
+package config
+
+import "fmt"
+
+type CalendarFeedConfig struct {
+	// Secret signing the feed URLs. It must not be shared with the JWT secret, a leaked feed
+	// secret would otherwise let anyone mint access tokens
+	SigningSecret string `json:"-"`
+	// Public URL the feeds are served under, e.g. https://api.clinic.com/api/v2/calendar-feeds.
+	// When empty the URL is built from the host of each request
+	BaseURL string `json:"base_url"`
+}
+
+func loadCalendarFeedConfig(config *CalendarFeedConfig, jwtSecret string) error {
+	config.SigningSecret = getEnvWithDefault("CALENDAR_FEED_SECRET", "")
+	if len(config.SigningSecret) < 32 {
+		return fmt.Errorf("CALENDAR_FEED_SECRET is required and must be at least 32 characters long")
+	}
+	if config.SigningSecret == jwtSecret {
+		return fmt.Errorf("CALENDAR_FEED_SECRET must differ from JWT_SECRET")
+	}
+
+	config.BaseURL = getEnvWithDefault("CALENDAR_FEED_BASE_URL", "")
+	return nil
+}
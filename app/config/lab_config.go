@@ -0,0 +1,64 @@
+package config
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+)
+
+type LabConfig struct {
+	// TCP listener receiving HL7 v2 results from in-house analyzers over MLLP. Analyzers
+	// able to post over HTTP use the /employees/lab-orders/hl7/oru endpoint instead. MLLP has
+	// no authentication, the listener binds to loopback unless an address is configured
+	MLLPEnabled bool   `json:"mllp_enabled"`
+	MLLPAddress string `json:"mllp_address"`
+
+	// Analyzers allowed to connect, as IPs or CIDR ranges. When empty only loopback peers are
+	// accepted
+	MLLPAllowedPeers []netip.Prefix `json:"mllp_allowed_peers"`
+
+	// Connections without traffic for this long are closed, analyzers reconnect when needed
+	MLLPIdleTimeout time.Duration `json:"mllp_idle_timeout"`
+}
+
+func loadLabConfig(config *LabConfig) error {
+	config.MLLPEnabled = parseBoolWithDefault("LAB_MLLP_ENABLED", false)
+	config.MLLPAddress = getEnvWithDefault("LAB_MLLP_ADDRESS", "127.0.0.1:2575")
+	config.MLLPIdleTimeout, _ = parseDuration("LAB_MLLP_IDLE_TIMEOUT", "5m")
+
+	peers, err := parsePeers(getEnvWithDefault("LAB_MLLP_ALLOWED_PEERS", ""))
+	if err != nil {
+		return fmt.Errorf("LAB_MLLP_ALLOWED_PEERS: %w", err)
+	}
+	config.MLLPAllowedPeers = peers
+	return nil
+}
+
+// parsePeers reads a comma separated list of IPs and CIDR ranges
+func parsePeers(value string) ([]netip.Prefix, error) {
+	var peers []netip.Prefix
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		if strings.Contains(entry, "/") {
+			prefix, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid CIDR range %q", entry)
+			}
+			peers = append(peers, prefix.Masked())
+			continue
+		}
+
+		addr, err := netip.ParseAddr(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IP %q", entry)
+		}
+		addr = addr.Unmap()
+		peers = append(peers, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return peers, nil
+}
@@ -0,0 +1,15 @@
+package config
+
+import "time"
+
+type NoShowConfig struct {
+	GracePeriod  time.Duration `json:"grace_period"`
+	MaxNoShows   int           `json:"max_no_shows"`
+	LookbackDays int           `json:"lookback_days"`
+}
+
+func loadNoShowConfig(config *NoShowConfig) {
+	config.GracePeriod, _ = parseDuration("NO_SHOW_GRACE_PERIOD", "30m")
+	config.MaxNoShows, _ = parseIntWithDefault("NO_SHOW_MAX_ALLOWED", 2)
+	config.LookbackDays, _ = parseIntWithDefault("NO_SHOW_LOOKBACK_DAYS", 180)
+}
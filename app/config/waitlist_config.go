@@ -0,0 +1,12 @@
+package config
+
+import "time"
+
+type WaitlistConfig struct {
+	// How long a customer has to claim an offered slot
+	OfferTTL time.Duration `json:"offer_ttl"`
+}
+
+func loadWaitlistConfig(config *WaitlistConfig) {
+	config.OfferTTL, _ = parseDuration("WAITLIST_OFFER_TTL", "2h")
+}
@@ -0,0 +1,25 @@
+package config
+
+import "time"
+
+type WorkerConfig struct {
+	Enabled bool `json:"enabled"`
+
+	// Appointment reminders
+	ReminderInterval     time.Duration `json:"reminder_interval"`
+	ReminderClaimTimeout time.Duration `json:"reminder_claim_timeout"`
+
+	// Appointment no-show marking
+	NoShowInterval time.Duration `json:"no_show_interval"`
+
+	// Appointment waitlist offer expiration
+	WaitlistInterval time.Duration `json:"waitlist_interval"`
+}
+
+func loadWorkerConfig(config *WorkerConfig) {
+	config.Enabled = parseBoolWithDefault("WORKERS_ENABLED", true)
+	config.ReminderInterval, _ = parseDuration("REMINDER_INTERVAL", "5m")
+	config.ReminderClaimTimeout, _ = parseDuration("REMINDER_CLAIM_TIMEOUT", "10m")
+	config.NoShowInterval, _ = parseDuration("NO_SHOW_INTERVAL", "15m")
+	config.WaitlistInterval, _ = parseDuration("WAITLIST_INTERVAL", "5m")
+}
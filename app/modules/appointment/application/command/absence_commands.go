@@ -0,0 +1,51 @@
+package command
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type RegisterAbsenceCommand struct {
+	employeeID valueobject.EmployeeID
+	startDate  time.Time
+	endDate    time.Time
+	reason     string
+	reportedBy valueobject.UserID
+}
+
+// NewRegisterAbsenceCommand reports a period the employee cannot attend their appointments,
+// the ones already confirmed are proposed to a colleague or cancelled
+func NewRegisterAbsenceCommand(employeeID uint, startDate, endDate time.Time, reason string, reportedBy uint) (RegisterAbsenceCommand, error) {
+	cmd := RegisterAbsenceCommand{
+		employeeID: valueobject.NewEmployeeID(employeeID),
+		startDate:  startDate,
+		endDate:    endDate,
+		reason:     reason,
+		reportedBy: valueobject.NewUserID(reportedBy),
+	}
+
+	if cmd.employeeID.IsZero() {
+		return RegisterAbsenceCommand{}, absenceCmdErr("vet_id", "Employee ID is required")
+	}
+	if startDate.IsZero() || endDate.IsZero() {
+		return RegisterAbsenceCommand{}, absenceCmdErr("start_date", "Start and end dates are required")
+	}
+	if !endDate.After(startDate) {
+		return RegisterAbsenceCommand{}, absenceCmdErr("end_date", "End date must be after the start date")
+	}
+	if reason == "" {
+		return RegisterAbsenceCommand{}, absenceCmdErr("reason", "Reason is required")
+	}
+	if cmd.reportedBy.IsZero() {
+		return RegisterAbsenceCommand{}, absenceCmdErr("reported_by", "The user reporting the absence is required")
+	}
+
+	return cmd, nil
+}
+
+func (c *RegisterAbsenceCommand) EmployeeID() valueobject.EmployeeID { return c.employeeID }
+func (c *RegisterAbsenceCommand) StartDate() time.Time               { return c.startDate }
+func (c *RegisterAbsenceCommand) EndDate() time.Time                 { return c.endDate }
+func (c *RegisterAbsenceCommand) Reason() string                     { return c.reason }
+func (c *RegisterAbsenceCommand) ReportedBy() valueobject.UserID     { return c.reportedBy }
@@ -0,0 +1,48 @@
+package command
+
+import (
+	"clinic-vet-api/app/modules/core/domain/enum"
+)
+
+type IssueCalendarFeedCommand struct {
+	ownerType enum.CalendarFeedOwner
+	ownerID   uint
+}
+
+// NewIssueCalendarFeedCommand issues a new feed URL for the owner, revoking the previous one
+func NewIssueCalendarFeedCommand(ownerType enum.CalendarFeedOwner, ownerID uint) (IssueCalendarFeedCommand, error) {
+	cmd := IssueCalendarFeedCommand{ownerType: ownerType, ownerID: ownerID}
+
+	if !cmd.ownerType.IsValid() {
+		return IssueCalendarFeedCommand{}, calendarFeedCmdErr("owner_type", "Owner type is invalid", "IssueCalendarFeedCommand")
+	}
+	if cmd.ownerID == 0 {
+		return IssueCalendarFeedCommand{}, calendarFeedCmdErr("owner_id", "Owner ID is required", "IssueCalendarFeedCommand")
+	}
+
+	return cmd, nil
+}
+
+func (c *IssueCalendarFeedCommand) OwnerType() enum.CalendarFeedOwner { return c.ownerType }
+func (c *IssueCalendarFeedCommand) OwnerID() uint                     { return c.ownerID }
+
+type RevokeCalendarFeedCommand struct {
+	ownerType enum.CalendarFeedOwner
+	ownerID   uint
+}
+
+func NewRevokeCalendarFeedCommand(ownerType enum.CalendarFeedOwner, ownerID uint) (RevokeCalendarFeedCommand, error) {
+	cmd := RevokeCalendarFeedCommand{ownerType: ownerType, ownerID: ownerID}
+
+	if !cmd.ownerType.IsValid() {
+		return RevokeCalendarFeedCommand{}, calendarFeedCmdErr("owner_type", "Owner type is invalid", "RevokeCalendarFeedCommand")
+	}
+	if cmd.ownerID == 0 {
+		return RevokeCalendarFeedCommand{}, calendarFeedCmdErr("owner_id", "Owner ID is required", "RevokeCalendarFeedCommand")
+	}
+
+	return cmd, nil
+}
+
+func (c *RevokeCalendarFeedCommand) OwnerType() enum.CalendarFeedOwner { return c.ownerType }
+func (c *RevokeCalendarFeedCommand) OwnerID() uint                     { return c.ownerID }
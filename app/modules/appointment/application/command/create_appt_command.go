@@ -48,8 +48,12 @@ func (c *CreateApptCommand) Validate() error {
 		return createApptCommand("service", "invalid value")
 	}
 
-	if !c.status.IsValid() {
-		return createApptCommand("status", "invalid value")
+	if c.status != enum.AppointmentStatusPending && c.status != enum.AppointmentStatusConfirmed {
+		return createApptCommand("status", "must be pending or confirmed")
+	}
+
+	if c.status == enum.AppointmentStatusConfirmed && c.employeeID == nil {
+		return createApptCommand("employeeID", "is required for a confirmed appointment")
 	}
 
 	if c.customerID.IsZero() {
@@ -0,0 +1,84 @@
+package command
+
+import (
+	"clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type CreateEmergencyApptCommand struct {
+	customerID     valueobject.CustomerID
+	petID          valueobject.PetID
+	employeeID     valueobject.EmployeeID
+	registeredBy   valueobject.EmployeeID
+	service        enum.ClinicService
+	triagePriority enum.TriagePriority
+	notes          *string
+}
+
+// NewCreateEmergencyApptCommand registers a patient arriving without a booking. The service
+// defaults to emergency care. Without an employee the veterinarian on call attends it, the
+// veterinarian registering it, if any, stands in when nobody is on call
+func NewCreateEmergencyApptCommand(
+	customerID, petID uint, employeeID *uint, registeredBy uint, service, triagePriority string, notes *string,
+) (CreateEmergencyApptCommand, error) {
+	cmd := CreateEmergencyApptCommand{
+		customerID:   valueobject.NewCustomerID(customerID),
+		petID:        valueobject.NewPetID(petID),
+		registeredBy: valueobject.NewEmployeeID(registeredBy),
+		service:      enum.ClinicServiceEmergencyCare,
+		notes:        notes,
+	}
+
+	if employeeID != nil {
+		cmd.employeeID = valueobject.NewEmployeeID(*employeeID)
+		if cmd.employeeID.IsZero() {
+			return CreateEmergencyApptCommand{}, emergencyCmdErr("vet_id", "Veterinarian ID is invalid")
+		}
+	}
+
+	if cmd.customerID.IsZero() {
+		return CreateEmergencyApptCommand{}, emergencyCmdErr("customer_id", "Customer ID is required")
+	}
+	if cmd.petID.IsZero() {
+		return CreateEmergencyApptCommand{}, emergencyCmdErr("pet_id", "Pet ID is required")
+	}
+	if service != "" {
+		cmd.service = enum.ClinicService(service)
+		if !cmd.service.IsValid() {
+			return CreateEmergencyApptCommand{}, emergencyCmdErr("service", "Service is invalid")
+		}
+	}
+
+	priority, err := enum.ParseTriagePriority(triagePriority)
+	if err != nil {
+		return CreateEmergencyApptCommand{}, emergencyCmdErr("triage_priority", err.Error())
+	}
+	cmd.triagePriority = priority
+
+	return cmd, nil
+}
+
+func (c *CreateEmergencyApptCommand) ToIntake() appointment.EmergencyIntake {
+	return appointment.EmergencyIntake{
+		CustomerID:     c.customerID,
+		PetID:          c.petID,
+		EmployeeID:     c.employeeID,
+		Service:        c.service,
+		TriagePriority: c.triagePriority,
+		Notes:          c.notes,
+	}
+}
+
+func (c *CreateEmergencyApptCommand) CustomerID() valueobject.CustomerID { return c.customerID }
+func (c *CreateEmergencyApptCommand) PetID() valueobject.PetID           { return c.petID }
+func (c *CreateEmergencyApptCommand) EmployeeID() valueobject.EmployeeID { return c.employeeID }
+func (c *CreateEmergencyApptCommand) Service() enum.ClinicService        { return c.service }
+func (c *CreateEmergencyApptCommand) TriagePriority() enum.TriagePriority {
+	return c.triagePriority
+}
+func (c *CreateEmergencyApptCommand) Notes() *string    { return c.notes }
+func (c *CreateEmergencyApptCommand) HasEmployee() bool { return !c.employeeID.IsZero() }
+func (c *CreateEmergencyApptCommand) RegisteredBy() valueobject.EmployeeID {
+	return c.registeredBy
+}
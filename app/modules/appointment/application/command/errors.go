@@ -24,6 +24,10 @@ func confirmApptCmdErr(field, issue string) error {
 	return apperror.CommandDataValidationError(field, issue, "ConfirmApptCommand")
 }
 
+func confirmProposalCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "ConfirmProposalCommand")
+}
+
 func notAttendApptCmdErr(field, issue string) error {
 	return apperror.CommandDataValidationError(field, issue, "NotAttendApptCommand")
 }
@@ -31,3 +35,27 @@ func notAttendApptCmdErr(field, issue string) error {
 func requestScheduleCmdErr(field, issue string) error {
 	return apperror.CommandDataValidationError(field, issue, "RequestScheduleCmd")
 }
+
+func waitlistCmdErr(field, issue, command string) error {
+	return apperror.CommandDataValidationError(field, issue, command)
+}
+
+func seriesCmdErr(field, issue, command string) error {
+	return apperror.CommandDataValidationError(field, issue, command)
+}
+
+func calendarFeedCmdErr(field, issue, command string) error {
+	return apperror.CommandDataValidationError(field, issue, command)
+}
+
+func emergencyCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "CreateEmergencyApptCommand")
+}
+
+func absenceCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "RegisterAbsenceCommand")
+}
+
+func visitCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "AdvanceVisitCommand")
+}
@@ -0,0 +1,160 @@
+package command
+
+import (
+	"clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/mapper"
+	"time"
+)
+
+type CreateApptSeriesCommand struct {
+	customerID valueobject.CustomerID
+	petID      valueobject.PetID
+	employeeID *valueobject.EmployeeID
+	service    enum.ClinicService
+	startDate  time.Time
+	recurrence appointment.Recurrence
+	notes      *string
+}
+
+func NewCreateApptSeriesCommand(
+	customerID, petID uint, employeeID *uint, service string, startDate time.Time,
+	frequency string, interval int, count *int, until *time.Time, notes *string,
+) (CreateApptSeriesCommand, error) {
+	commandName := "CreateApptSeriesCommand"
+
+	parsedFrequency, err := enum.ParseRecurrenceFrequency(frequency)
+	if err != nil {
+		return CreateApptSeriesCommand{}, seriesCmdErr("frequency", err.Error(), commandName)
+	}
+
+	parsedService, err := enum.ParseClinicService(service)
+	if err != nil {
+		return CreateApptSeriesCommand{}, seriesCmdErr("service", err.Error(), commandName)
+	}
+
+	if interval == 0 {
+		interval = 1
+	}
+
+	cmd := CreateApptSeriesCommand{
+		customerID: valueobject.NewCustomerID(customerID),
+		petID:      valueobject.NewPetID(petID),
+		employeeID: mapper.PtrToEmployeeIDPtr(employeeID),
+		service:    parsedService,
+		startDate:  startDate,
+		recurrence: appointment.Recurrence{
+			Frequency: parsedFrequency,
+			Interval:  interval,
+			Count:     count,
+			Until:     until,
+		},
+		notes: notes,
+	}
+
+	if cmd.customerID.IsZero() {
+		return CreateApptSeriesCommand{}, seriesCmdErr("customer_id", "Customer ID is required", commandName)
+	}
+	if cmd.petID.IsZero() {
+		return CreateApptSeriesCommand{}, seriesCmdErr("pet_id", "Pet ID is required", commandName)
+	}
+	if cmd.startDate.IsZero() {
+		return CreateApptSeriesCommand{}, seriesCmdErr("start_date", "Start date is required", commandName)
+	}
+
+	return cmd, nil
+}
+
+func (c *CreateApptSeriesCommand) ToEntity() *appointment.AppointmentSeries {
+	return appointment.NewAppointmentSeriesBuilder().
+		WithCustomerID(c.customerID).
+		WithPetID(c.petID).
+		WithEmployeeID(c.employeeID).
+		WithService(c.service).
+		WithStartDate(c.startDate).
+		WithRecurrence(c.recurrence).
+		WithNotes(c.notes).
+		Build()
+}
+
+type CancelApptSeriesCommand struct {
+	appointmentID valueobject.AppointmentID
+	employeeID    *valueobject.EmployeeID
+	scope         enum.SeriesScope
+	reason        string
+}
+
+func NewCancelApptSeriesCommand(appointmentID uint, employeeID *uint, scope, reason string) (CancelApptSeriesCommand, error) {
+	commandName := "CancelApptSeriesCommand"
+
+	parsedScope, err := enum.ParseSeriesScope(scope)
+	if err != nil {
+		return CancelApptSeriesCommand{}, seriesCmdErr("scope", err.Error(), commandName)
+	}
+
+	cmd := CancelApptSeriesCommand{
+		appointmentID: valueobject.NewAppointmentID(appointmentID),
+		employeeID:    mapper.PtrToEmployeeIDPtr(employeeID),
+		scope:         parsedScope,
+		reason:        reason,
+	}
+
+	if cmd.appointmentID.IsZero() {
+		return CancelApptSeriesCommand{}, seriesCmdErr("appointment_id", "Appointment ID is required", commandName)
+	}
+	if cmd.reason == "" {
+		return CancelApptSeriesCommand{}, seriesCmdErr("reason", "Reason is required", commandName)
+	}
+
+	return cmd, nil
+}
+
+func (c *CancelApptSeriesCommand) AppointmentID() valueobject.AppointmentID { return c.appointmentID }
+func (c *CancelApptSeriesCommand) EmployeeID() *valueobject.EmployeeID      { return c.employeeID }
+func (c *CancelApptSeriesCommand) Scope() enum.SeriesScope                  { return c.scope }
+func (c *CancelApptSeriesCommand) Reason() string                           { return c.reason }
+
+type RescheduleApptSeriesCommand struct {
+	appointmentID valueobject.AppointmentID
+	employeeID    *valueobject.EmployeeID
+	datetime      time.Time
+	scope         enum.SeriesScope
+}
+
+func NewRescheduleApptSeriesCommand(
+	appointmentID uint, employeeID *uint, datetime time.Time, scope string,
+) (RescheduleApptSeriesCommand, error) {
+	commandName := "RescheduleApptSeriesCommand"
+
+	parsedScope, err := enum.ParseSeriesScope(scope)
+	if err != nil {
+		return RescheduleApptSeriesCommand{}, seriesCmdErr("scope", err.Error(), commandName)
+	}
+
+	cmd := RescheduleApptSeriesCommand{
+		appointmentID: valueobject.NewAppointmentID(appointmentID),
+		employeeID:    mapper.PtrToEmployeeIDPtr(employeeID),
+		datetime:      datetime,
+		scope:         parsedScope,
+	}
+
+	if cmd.appointmentID.IsZero() {
+		return RescheduleApptSeriesCommand{}, seriesCmdErr("appointment_id", "Appointment ID is required", commandName)
+	}
+	if cmd.datetime.IsZero() {
+		return RescheduleApptSeriesCommand{}, seriesCmdErr("datetime", "Date time is required", commandName)
+	}
+	if cmd.datetime.Before(time.Now()) {
+		return RescheduleApptSeriesCommand{}, seriesCmdErr("datetime", "Date time must be in the future", commandName)
+	}
+
+	return cmd, nil
+}
+
+func (c *RescheduleApptSeriesCommand) AppointmentID() valueobject.AppointmentID {
+	return c.appointmentID
+}
+func (c *RescheduleApptSeriesCommand) EmployeeID() *valueobject.EmployeeID { return c.employeeID }
+func (c *RescheduleApptSeriesCommand) DateTime() time.Time                 { return c.datetime }
+func (c *RescheduleApptSeriesCommand) Scope() enum.SeriesScope             { return c.scope }
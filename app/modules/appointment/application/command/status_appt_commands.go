@@ -91,6 +91,29 @@ func (c *ConfirmApptCommand) validate() error {
 	return nil
 }
 
+// ConfirmProposalCommand is the owner accepting the employee the clinic proposed for a pending
+// appointment of theirs
+type ConfirmProposalCommand struct {
+	id         valueobject.AppointmentID
+	customerID valueobject.CustomerID
+}
+
+func NewConfirmProposalCommand(appointmentID, customerID uint) (ConfirmProposalCommand, error) {
+	cmd := ConfirmProposalCommand{
+		id:         valueobject.NewAppointmentID(appointmentID),
+		customerID: valueobject.NewCustomerID(customerID),
+	}
+
+	if cmd.id.IsZero() {
+		return ConfirmProposalCommand{}, confirmProposalCmdErr("appointmentID", "is required")
+	}
+	if cmd.customerID.IsZero() {
+		return ConfirmProposalCommand{}, confirmProposalCmdErr("customerID", "is required")
+	}
+
+	return cmd, nil
+}
+
 func (c *NotAttendApptCommand) AppointmentID() valueobject.AppointmentID { return c.appointmentID }
 func (c *NotAttendApptCommand) EmployeeID() *valueobject.EmployeeID      { return c.employeeID }
 
@@ -99,3 +122,6 @@ func (c *CompleteApptCommand) EmployeeID() *valueobject.EmployeeID { return c.em
 
 func (c *ConfirmApptCommand) ID() valueobject.AppointmentID      { return c.id }
 func (c *ConfirmApptCommand) EmployeeID() valueobject.EmployeeID { return c.employeeID }
+
+func (c *ConfirmProposalCommand) ID() valueobject.AppointmentID      { return c.id }
+func (c *ConfirmProposalCommand) CustomerID() valueobject.CustomerID { return c.customerID }
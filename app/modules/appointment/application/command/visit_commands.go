@@ -0,0 +1,39 @@
+package command
+
+import (
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/mapper"
+)
+
+type AdvanceVisitCommand struct {
+	appointmentID valueobject.AppointmentID
+	employeeID    *valueobject.EmployeeID
+	stage         enum.VisitStage
+}
+
+// NewAdvanceVisitCommand moves the patient of the appointment to the given visit stage. When
+// employeeID is given only the appointments of that employee can be advanced
+func NewAdvanceVisitCommand(appointmentID uint, employeeID *uint, stage enum.VisitStage) (AdvanceVisitCommand, error) {
+	cmd := AdvanceVisitCommand{
+		appointmentID: valueobject.NewAppointmentID(appointmentID),
+		employeeID:    mapper.PtrToEmployeeIDPtr(employeeID),
+		stage:         stage,
+	}
+
+	if cmd.appointmentID.IsZero() {
+		return AdvanceVisitCommand{}, visitCmdErr("appointmentID", "is required")
+	}
+	if cmd.employeeID != nil && cmd.employeeID.IsZero() {
+		return AdvanceVisitCommand{}, visitCmdErr("employeeID", "cannot be zero if provided")
+	}
+	if !cmd.stage.IsValid() {
+		return AdvanceVisitCommand{}, visitCmdErr("stage", "invalid value")
+	}
+
+	return cmd, nil
+}
+
+func (c *AdvanceVisitCommand) AppointmentID() valueobject.AppointmentID { return c.appointmentID }
+func (c *AdvanceVisitCommand) EmployeeID() *valueobject.EmployeeID      { return c.employeeID }
+func (c *AdvanceVisitCommand) Stage() enum.VisitStage                   { return c.stage }
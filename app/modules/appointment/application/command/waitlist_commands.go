@@ -0,0 +1,102 @@
+package command
+
+import (
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/mapper"
+	"time"
+)
+
+type JoinWaitlistCommand struct {
+	customerID          valueobject.CustomerID
+	petID               valueobject.PetID
+	service             enum.ClinicService
+	requestedDate       time.Time
+	preferredEmployeeID *valueobject.EmployeeID
+	notes               *string
+}
+
+func NewJoinWaitlistCommand(
+	customerID, petID uint, service string, requestedDate time.Time, preferredEmployeeID *uint, notes *string,
+) (JoinWaitlistCommand, error) {
+	cmd := JoinWaitlistCommand{
+		customerID:          valueobject.NewCustomerID(customerID),
+		petID:               valueobject.NewPetID(petID),
+		service:             enum.ClinicService(service),
+		requestedDate:       requestedDate,
+		preferredEmployeeID: mapper.PtrToEmployeeIDPtr(preferredEmployeeID),
+		notes:               notes,
+	}
+
+	if cmd.customerID.IsZero() {
+		return JoinWaitlistCommand{}, waitlistCmdErr("customer_id", "Customer ID is required", "JoinWaitlistCommand")
+	}
+	if cmd.petID.IsZero() {
+		return JoinWaitlistCommand{}, waitlistCmdErr("pet_id", "Pet ID is required", "JoinWaitlistCommand")
+	}
+	if !cmd.service.IsValid() {
+		return JoinWaitlistCommand{}, waitlistCmdErr("service", "Service is invalid", "JoinWaitlistCommand")
+	}
+	if cmd.requestedDate.IsZero() {
+		return JoinWaitlistCommand{}, waitlistCmdErr("requested_date", "Requested date is required", "JoinWaitlistCommand")
+	}
+
+	return cmd, nil
+}
+
+func (c *JoinWaitlistCommand) CustomerID() valueobject.CustomerID { return c.customerID }
+func (c *JoinWaitlistCommand) PetID() valueobject.PetID           { return c.petID }
+func (c *JoinWaitlistCommand) Service() enum.ClinicService        { return c.service }
+func (c *JoinWaitlistCommand) RequestedDate() time.Time           { return c.requestedDate }
+func (c *JoinWaitlistCommand) PreferredEmployeeID() *valueobject.EmployeeID {
+	return c.preferredEmployeeID
+}
+func (c *JoinWaitlistCommand) Notes() *string { return c.notes }
+
+type LeaveWaitlistCommand struct {
+	entryID    valueobject.WaitlistID
+	customerID valueobject.CustomerID
+}
+
+func NewLeaveWaitlistCommand(entryID, customerID uint) (LeaveWaitlistCommand, error) {
+	cmd := LeaveWaitlistCommand{
+		entryID:    valueobject.NewWaitlistID(entryID),
+		customerID: valueobject.NewCustomerID(customerID),
+	}
+
+	if cmd.entryID.IsZero() {
+		return LeaveWaitlistCommand{}, waitlistCmdErr("id", "Waitlist entry ID is required", "LeaveWaitlistCommand")
+	}
+	if cmd.customerID.IsZero() {
+		return LeaveWaitlistCommand{}, waitlistCmdErr("customer_id", "Customer ID is required", "LeaveWaitlistCommand")
+	}
+
+	return cmd, nil
+}
+
+func (c *LeaveWaitlistCommand) EntryID() valueobject.WaitlistID    { return c.entryID }
+func (c *LeaveWaitlistCommand) CustomerID() valueobject.CustomerID { return c.customerID }
+
+type ClaimWaitlistSlotCommand struct {
+	customerID valueobject.CustomerID
+	claimToken string
+}
+
+func NewClaimWaitlistSlotCommand(customerID uint, claimToken string) (ClaimWaitlistSlotCommand, error) {
+	cmd := ClaimWaitlistSlotCommand{
+		customerID: valueobject.NewCustomerID(customerID),
+		claimToken: claimToken,
+	}
+
+	if cmd.customerID.IsZero() {
+		return ClaimWaitlistSlotCommand{}, waitlistCmdErr("customer_id", "Customer ID is required", "ClaimWaitlistSlotCommand")
+	}
+	if cmd.claimToken == "" {
+		return ClaimWaitlistSlotCommand{}, waitlistCmdErr("claim_token", "Claim token is required", "ClaimWaitlistSlotCommand")
+	}
+
+	return cmd, nil
+}
+
+func (c *ClaimWaitlistSlotCommand) CustomerID() valueobject.CustomerID { return c.customerID }
+func (c *ClaimWaitlistSlotCommand) ClaimToken() string                 { return c.claimToken }
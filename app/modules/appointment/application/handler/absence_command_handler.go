@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	c "clinic-vet-api/app/modules/appointment/application/command"
+	"clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/shared/cqrs"
+)
+
+// HandleRegisterAbsence records the absence as approved time off and proposes the confirmed
+// appointments of the absent employee to free colleagues of the same specialty. Appointments
+// nobody can take are cancelled and their owners invited to book again
+func (h *ApptCommandHandler) HandleRegisterAbsence(ctx context.Context, cmd c.RegisterAbsenceCommand) cqrs.CommandResult {
+	absence, err := appointment.NewAbsence(ctx, cmd.EmployeeID(), cmd.StartDate(), cmd.EndDate(), cmd.Reason(), cmd.ReportedBy())
+	if err != nil {
+		return cqrs.FailureResult(BusinessRuleFailed, err)
+	}
+
+	coverage, err := h.absences.Cover(ctx, absence)
+	if err != nil {
+		return cqrs.FailureResult(CoverAbsenceFailed, err)
+	}
+
+	return cqrs.SuccessResult(fmt.Sprintf(SuccessAbsenceRegistered, len(coverage.Proposed), len(coverage.Cancelled)))
+}
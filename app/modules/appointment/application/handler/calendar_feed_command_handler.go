@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"context"
+
+	c "clinic-vet-api/app/modules/appointment/application/command"
+	"clinic-vet-api/app/modules/core/domain/entity/calendarfeed"
+	"clinic-vet-api/app/shared/cqrs"
+)
+
+func (h *ApptCommandHandler) HandleIssueCalendarFeed(ctx context.Context, cmd c.IssueCalendarFeedCommand) cqrs.CommandResult {
+	feed := calendarfeed.NewCalendarFeedBuilder().
+		WithOwner(cmd.OwnerType(), cmd.OwnerID()).
+		Build()
+
+	if err := h.feedRepo.Replace(ctx, feed); err != nil {
+		return cqrs.FailureResult(IssueCalendarFeedFailed, err)
+	}
+
+	return cqrs.SuccessCreateResult(feed.ID().String(), SuccessCalendarFeedIssued)
+}
+
+func (h *ApptCommandHandler) HandleRevokeCalendarFeed(ctx context.Context, cmd c.RevokeCalendarFeedCommand) cqrs.CommandResult {
+	revoked, err := h.feedRepo.RevokeByOwner(ctx, cmd.OwnerType(), cmd.OwnerID())
+	if err != nil {
+		return cqrs.FailureResult(RevokeCalendarFeedFailed, err)
+	}
+
+	if !revoked {
+		return cqrs.FailureResult(CalendarFeedNotFound, ErrCalendarFeedNotFound(cmd.OwnerType(), cmd.OwnerID()))
+	}
+
+	return cqrs.SuccessResult(SuccessCalendarFeedRevoked)
+}
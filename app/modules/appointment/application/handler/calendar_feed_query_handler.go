@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"math"
+	"time"
+
+	q "clinic-vet-api/app/modules/appointment/application/query"
+	"clinic-vet-api/app/modules/core/domain/entity/calendarfeed"
+	"clinic-vet-api/app/modules/core/domain/specification"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+const (
+	calendarFeedLookback  = 90 * 24 * time.Hour
+	calendarFeedLookahead = 365 * 24 * time.Hour
+)
+
+func (h *ApptQueryHandler) HandleCalendarFeed(ctx context.Context, query q.FindCalendarFeedQuery) (CalendarFeedResult, error) {
+	feed, err := h.feedRepository.FindActiveByOwner(ctx, query.OwnerType(), query.OwnerID())
+	if err != nil {
+		return CalendarFeedResult{}, err
+	}
+
+	return CalendarFeedResult{
+		ID:        feed.ID(),
+		OwnerType: feed.OwnerType(),
+		Token:     h.feedSigner.Token(feed),
+		CreatedAt: feed.CreatedAt(),
+	}, nil
+}
+
+// HandleCalendarFeedEvents returns the appointments published by the feed, cancelled ones
+// included so subscribed calendars drop them instead of keeping a stale copy
+func (h *ApptQueryHandler) HandleCalendarFeedEvents(ctx context.Context, query q.FindCalendarFeedEventsQuery) (CalendarFeedEventsResult, error) {
+	feed, err := h.resolveCalendarFeed(ctx, query.Token())
+	if err != nil {
+		return CalendarFeedEventsResult{}, err
+	}
+
+	now := time.Now()
+	spec := specification.ApptByDateRange(now.Add(-calendarFeedLookback), now.Add(calendarFeedLookahead))
+	if employeeID, isEmployee := feed.EmployeeID(); isEmployee {
+		spec = specification.ApptByEmployee(employeeID).And(spec)
+	} else if customerID, isCustomer := feed.CustomerID(); isCustomer {
+		spec = specification.ApptByCustomer(customerID).And(spec)
+	}
+
+	appointments, err := h.apptRepository.Find(ctx, spec.WithPagination(specification.Pagination{Limit: math.MaxInt32}))
+	if err != nil {
+		return CalendarFeedEventsResult{}, err
+	}
+
+	petNames := make(map[valueobject.PetID]string)
+	events := make([]CalendarEventResult, len(appointments.Items))
+	for i, appointment := range appointments.Items {
+		petName, cached := petNames[appointment.PetID()]
+		if !cached {
+			// a removed pet should not break the whole feed, the event is published without its name
+			if pet, err := h.petRepository.FindByID(ctx, appointment.PetID()); err == nil {
+				petName = pet.Name()
+			}
+			petNames[appointment.PetID()] = petName
+		}
+
+		events[i] = CalendarEventResult{
+			Appointment: apptToResult(appointment),
+			PetName:     petName,
+			EndDate:     appointment.EndDate(),
+			Sequence:    appointment.Sequence(),
+		}
+	}
+
+	return CalendarFeedEventsResult{OwnerType: feed.OwnerType(), Events: events}, nil
+}
+
+// resolveCalendarFeed answers unknown and forged tokens with the same error so feed ids can't be
+// probed; a genuine token of a revoked feed is told so
+func (h *ApptQueryHandler) resolveCalendarFeed(ctx context.Context, token string) (calendarfeed.CalendarFeed, error) {
+	feedID, err := h.feedSigner.ParseToken(ctx, token)
+	if err != nil {
+		return calendarfeed.CalendarFeed{}, err
+	}
+
+	feed, err := h.feedRepository.FindByID(ctx, feedID)
+	if err != nil {
+		return calendarfeed.CalendarFeed{}, calendarfeed.InvalidTokenError(ctx, "ResolveCalendarFeed")
+	}
+
+	if err := h.feedSigner.Verify(ctx, feed, token); err != nil {
+		return calendarfeed.CalendarFeed{}, err
+	}
+
+	return feed, nil
+}
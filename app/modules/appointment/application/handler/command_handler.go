@@ -2,35 +2,104 @@ package handler
 
 import (
 	"context"
+	"time"
 
 	c "clinic-vet-api/app/modules/appointment/application/command"
 	"clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/waitlist"
+	"clinic-vet-api/app/modules/core/domain/enum"
 	"clinic-vet-api/app/modules/core/domain/specification"
 	"clinic-vet-api/app/modules/core/domain/valueobject"
 	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
 	"clinic-vet-api/app/shared/cqrs"
 	apperror "clinic-vet-api/app/shared/error/application"
+	"clinic-vet-api/app/shared/log"
+
+	"go.uber.org/zap"
 )
 
 type ApptCommandHandler struct {
 	apptRepository repository.AppointmentRepository
+	calendarRepo   repository.ClinicCalendarRepository
+	waitlistRepo   repository.WaitlistRepository
+	seriesRepo     repository.AppointmentSeriesRepository
+	feedRepo       repository.CalendarFeedRepository
+	visitRepo      repository.AppointmentVisitRepository
+	reservations   repository.AppointmentReservationRepository
+	employeeRepo   repository.EmployeeRepository
+	petRepo        repository.PetRepository
+	availability   *service.AppointmentAvailabilityService
+	schedule       *service.ScheduleGuard
+	waitlistOffers *service.WaitlistOfferService
+	absences       *service.AbsenceCoverageService
+	onCall         *service.OnCallService
+	noShowPolicy   appointment.NoShowPolicy
 }
 
-func NewAppointmentCommandHandler(apptRepository repository.AppointmentRepository) *ApptCommandHandler {
-	return &ApptCommandHandler{apptRepository: apptRepository}
+func NewAppointmentCommandHandler(
+	apptRepository repository.AppointmentRepository,
+	calendarRepo repository.ClinicCalendarRepository,
+	waitlistRepo repository.WaitlistRepository,
+	seriesRepo repository.AppointmentSeriesRepository,
+	feedRepo repository.CalendarFeedRepository,
+	visitRepo repository.AppointmentVisitRepository,
+	reservations repository.AppointmentReservationRepository,
+	exceptionRepo repository.ScheduleExceptionRepository,
+	employeeRepo repository.EmployeeRepository,
+	petRepo repository.PetRepository,
+	waitlistOffers *service.WaitlistOfferService,
+	absences *service.AbsenceCoverageService,
+	onCall *service.OnCallService,
+	noShowPolicy appointment.NoShowPolicy,
+) *ApptCommandHandler {
+	return &ApptCommandHandler{
+		apptRepository: apptRepository,
+		calendarRepo:   calendarRepo,
+		waitlistRepo:   waitlistRepo,
+		seriesRepo:     seriesRepo,
+		feedRepo:       feedRepo,
+		visitRepo:      visitRepo,
+		reservations:   reservations,
+		employeeRepo:   employeeRepo,
+		petRepo:        petRepo,
+		availability:   service.NewAppointmentAvailabilityService(apptRepository, exceptionRepo),
+		schedule:       service.NewScheduleGuard(apptRepository, exceptionRepo),
+		waitlistOffers: waitlistOffers,
+		absences:       absences,
+		onCall:         onCall,
+		noShowPolicy:   noShowPolicy,
+	}
 }
 
 func (h *ApptCommandHandler) HandleRequestByCustomer(ctx context.Context, cmd c.RequestApptByCustomerCommand) cqrs.CommandResult {
+	if err := h.ensureCustomerCanSelfBook(ctx, cmd.CustomerID()); err != nil {
+		return cqrs.FailureResult(NoShowLimitExceeded, err)
+	}
+
 	appointment := appointment.CreateCustomerRequest(
 		cmd.PetID(), cmd.CustomerID(), cmd.Service(), cmd.RequestedDate(), cmd.Notes(),
 	)
 
-	if err := appointment.ValidatePersistence(ctx); err != nil {
+	clinicCalendar, err := h.calendarRepo.GetForPeriod(ctx, appointment.ScheduledDate(), appointment.ScheduledDate())
+	if err != nil {
+		return cqrs.FailureResult(LoadCalendarFailed, err)
+	}
+
+	if err := appointment.ValidatePersistence(ctx, clinicCalendar); err != nil {
 		return cqrs.FailureResult(BusinessRuleFailed, err)
 	}
 
-	if err := h.apptRepository.Save(ctx, &appointment); err != nil {
-		return cqrs.FailureResult(SaveApptFailed, err)
+	if err := h.ensureNoScheduleConflict(ctx, appointment); err != nil {
+		return cqrs.FailureResult(ScheduleConflictFailed, err)
+	}
+
+	if err := h.ensureEmployeeAvailable(ctx, appointment); err != nil {
+		return cqrs.FailureResult(EmployeeUnavailable, err)
+	}
+
+	if err := h.saveWithReservations(ctx, &appointment); err != nil {
+		return cqrs.FailureResult(ReserveResourcesFailed, err)
 	}
 
 	return cqrs.SuccessResult(SuccessApptCreated)
@@ -42,6 +111,7 @@ func (h *ApptCommandHandler) HandleCancel(ctx context.Context, cmd c.CancelApptC
 		return cqrs.FailureResult(ApptNotFound, err)
 	}
 
+	freedSlot := slotOf(appointment)
 	if err := appointment.Cancel(ctx); err != nil {
 		return cqrs.FailureResult(FailedToCancel, err)
 	}
@@ -50,6 +120,8 @@ func (h *ApptCommandHandler) HandleCancel(ctx context.Context, cmd c.CancelApptC
 		return cqrs.FailureResult(UpdateApptFailed, err)
 	}
 
+	h.offerFreedSlot(ctx, freedSlot)
+
 	return cqrs.SuccessResult(SuccessApptUpdated)
 }
 
@@ -76,14 +148,29 @@ func (h *ApptCommandHandler) HandleReschedule(ctx context.Context, cmd c.Resched
 		return cqrs.FailureResult(FailedToCheckExistence, err)
 	}
 
-	if err := appointment.Reschedule(ctx, cmd.DateTime()); err != nil {
+	clinicCalendar, err := h.calendarRepo.GetForPeriod(ctx, cmd.DateTime(), cmd.DateTime())
+	if err != nil {
+		return cqrs.FailureResult(LoadCalendarFailed, err)
+	}
+
+	freedSlot := slotOf(appointment)
+	if err := appointment.Reschedule(ctx, cmd.DateTime(), clinicCalendar); err != nil {
 		return cqrs.FailureResult(UpdateApptFailed, err)
 	}
 
-	if err := h.apptRepository.Save(ctx, &appointment); err != nil {
-		return cqrs.FailureResult(SaveApptFailed, err)
+	if err := h.ensureNoScheduleConflict(ctx, appointment); err != nil {
+		return cqrs.FailureResult(ScheduleConflictFailed, err)
+	}
+
+	if err := h.ensureEmployeeAvailable(ctx, appointment); err != nil {
+		return cqrs.FailureResult(EmployeeUnavailable, err)
+	}
+
+	if err := h.saveWithReservations(ctx, &appointment); err != nil {
+		return cqrs.FailureResult(ReserveResourcesFailed, err)
 	}
 
+	h.offerFreedSlot(ctx, freedSlot)
 	return cqrs.SuccessResult(SuccessApptUpdated)
 }
 
@@ -107,12 +194,29 @@ func (h *ApptCommandHandler) HandleUpdate(ctx context.Context, cmd c.UpdateApptC
 		return cqrs.FailureResult(ApptNotFound, err)
 	}
 
+	previousService := appointment.Service()
 	if err := appointment.Update(ctx, cmd.Notes(), cmd.Service()); err != nil {
 		return cqrs.FailureResult(UpdateApptFailed, err)
 	}
 
-	if err := h.apptRepository.Save(ctx, &appointment); err != nil {
-		return cqrs.FailureResult(SaveApptFailed, err)
+	if appointment.Service() == previousService {
+		if err := h.apptRepository.Save(ctx, &appointment); err != nil {
+			return cqrs.FailureResult(SaveApptFailed, err)
+		}
+		return cqrs.SuccessResult(SuccessApptUpdated)
+	}
+
+	// another service changes how long the slot lasts and which rooms it needs
+	if err := h.ensureNoScheduleConflict(ctx, appointment); err != nil {
+		return cqrs.FailureResult(ScheduleConflictFailed, err)
+	}
+
+	if err := h.ensureEmployeeAvailable(ctx, appointment); err != nil {
+		return cqrs.FailureResult(EmployeeUnavailable, err)
+	}
+
+	if err := h.saveWithReservations(ctx, &appointment); err != nil {
+		return cqrs.FailureResult(ReserveResourcesFailed, err)
 	}
 
 	return cqrs.SuccessResult(SuccessApptUpdated)
@@ -128,8 +232,47 @@ func (h *ApptCommandHandler) HandleConfirm(ctx context.Context, cmd c.ConfirmApp
 		return cqrs.FailureResult(ConfirmApptFailed, err)
 	}
 
-	if err := h.apptRepository.Save(ctx, &appointment); err != nil {
-		return cqrs.FailureResult(SaveApptFailed, err)
+	if err := h.ensureNoScheduleConflict(ctx, appointment); err != nil {
+		return cqrs.FailureResult(ScheduleConflictFailed, err)
+	}
+
+	if err := h.ensureEmployeeAvailable(ctx, appointment); err != nil {
+		return cqrs.FailureResult(EmployeeUnavailable, err)
+	}
+
+	if err := h.saveWithReservations(ctx, &appointment); err != nil {
+		return cqrs.FailureResult(ReserveResourcesFailed, err)
+	}
+
+	return cqrs.SuccessResult(SuccessConfirmedAppt)
+}
+
+// HandleConfirmProposal lets the owner accept the employee proposed for one of their pending
+// appointments, going through the same checks as a confirmation by the staff
+func (h *ApptCommandHandler) HandleConfirmProposal(ctx context.Context, cmd c.ConfirmProposalCommand) cqrs.CommandResult {
+	appointment, err := h.apptRepository.FindByID(ctx, cmd.ID())
+	if err != nil {
+		return cqrs.FailureResult(FailedToCheckExistence, err)
+	}
+
+	if appointment.CustomerID() != cmd.CustomerID() {
+		return cqrs.FailureResult(ApptNotFound, ErrAppointmentNotFound(cmd.ID()))
+	}
+
+	if err := appointment.ConfirmProposal(ctx); err != nil {
+		return cqrs.FailureResult(ConfirmApptFailed, err)
+	}
+
+	if err := h.ensureNoScheduleConflict(ctx, appointment); err != nil {
+		return cqrs.FailureResult(ScheduleConflictFailed, err)
+	}
+
+	if err := h.ensureEmployeeAvailable(ctx, appointment); err != nil {
+		return cqrs.FailureResult(EmployeeUnavailable, err)
+	}
+
+	if err := h.saveWithReservations(ctx, &appointment); err != nil {
+		return cqrs.FailureResult(ReserveResourcesFailed, err)
 	}
 
 	return cqrs.SuccessResult(SuccessConfirmedAppt)
@@ -138,12 +281,25 @@ func (h *ApptCommandHandler) HandleConfirm(ctx context.Context, cmd c.ConfirmApp
 func (h *ApptCommandHandler) HandleCreate(ctx context.Context, cmd c.CreateApptCommand) cqrs.CommandResult {
 	appointment := cmd.ToEntity()
 
-	if err := appointment.ValidatePersistence(ctx); err != nil {
+	clinicCalendar, err := h.calendarRepo.GetForPeriod(ctx, appointment.ScheduledDate(), appointment.ScheduledDate())
+	if err != nil {
+		return cqrs.FailureResult(LoadCalendarFailed, err)
+	}
+
+	if err := appointment.ValidatePersistence(ctx, clinicCalendar); err != nil {
 		return cqrs.FailureResult(BusinessRuleFailed, err)
 	}
 
-	if err := h.apptRepository.Save(ctx, &appointment); err != nil {
-		return cqrs.FailureResult(SaveApptFailed, err)
+	if err := h.ensureNoScheduleConflict(ctx, appointment); err != nil {
+		return cqrs.FailureResult(ScheduleConflictFailed, err)
+	}
+
+	if err := h.ensureEmployeeAvailable(ctx, appointment); err != nil {
+		return cqrs.FailureResult(EmployeeUnavailable, err)
+	}
+
+	if err := h.saveWithReservations(ctx, &appointment); err != nil {
+		return cqrs.FailureResult(ReserveResourcesFailed, err)
 	}
 
 	return cqrs.SuccessCreateResult(appointment.ID().String(), SuccessApptCreated)
@@ -159,10 +315,14 @@ func (h *ApptCommandHandler) HandleComplete(ctx context.Context, cmd c.CompleteA
 		return cqrs.FailureResult(CompleteApptFailed, err)
 	}
 
-	if err := h.apptRepository.Save(ctx, &appointment); err != nil {
-		return cqrs.FailureResult(UpdateApptFailed, err)
+	opened, err := h.saveAndOpenSession(ctx, appointment)
+	if err != nil {
+		return cqrs.FailureResult(OpenMedicalSessionFailed, err)
 	}
 
+	if opened {
+		return cqrs.SuccessResult(SuccessApptCompletedDraftOpened)
+	}
 	return cqrs.SuccessResult(SuccessApptUpdated)
 }
 
@@ -182,3 +342,55 @@ func (h *ApptCommandHandler) getAppByIDAndEmployeeID(ctx context.Context, appoin
 	}
 	return appoint.Items[0], nil
 }
+
+// ensureCustomerCanSelfBook counts the customer no-shows inside the policy lookback window
+func (h *ApptCommandHandler) ensureCustomerCanSelfBook(ctx context.Context, customerID valueobject.CustomerID) error {
+	now := time.Now()
+	spec := specification.ApptByCustomer(customerID).
+		And(specification.ApptByStatus(enum.AppointmentStatusNotPresented)).
+		And(specification.ApptByDateRange(h.noShowPolicy.LookbackStart(now), now))
+
+	noShows, err := h.apptRepository.Count(ctx, spec)
+	if err != nil {
+		return err
+	}
+
+	return h.noShowPolicy.EnsureCanSelfBook(ctx, noShows)
+}
+
+// saveWithReservations saves the appointment holding the rooms and equipment its service requires
+// in the new slot, failing when any of them is taken
+func (h *ApptCommandHandler) saveWithReservations(ctx context.Context, appt *appointment.Appointment) error {
+	appointments := []appointment.Appointment{*appt}
+	if err := h.reservations.SaveWithReservations(ctx, appointments); err != nil {
+		return err
+	}
+
+	*appt = appointments[0]
+	return nil
+}
+
+// ensureNoScheduleConflict rejects the appointment when it overlaps one of the same employee or
+// pet. Ignored appointments are left out of the check
+func (h *ApptCommandHandler) ensureNoScheduleConflict(ctx context.Context, appt appointment.Appointment, ignored ...valueobject.AppointmentID) error {
+	return h.schedule.EnsureNoConflict(ctx, appt, ignored...)
+}
+
+// ensureEmployeeAvailable rejects appointments booked with a veterinarian during their approved
+// time off
+func (h *ApptCommandHandler) ensureEmployeeAvailable(ctx context.Context, appt appointment.Appointment) error {
+	return h.schedule.EnsureEmployeeAvailable(ctx, appt)
+}
+
+// offerFreedSlot hands the slot to the waitlist. The appointment change is already saved, so
+// a failure here is logged instead of failing the command
+func (h *ApptCommandHandler) offerFreedSlot(ctx context.Context, slot waitlist.Slot) {
+	if _, err := h.waitlistOffers.OfferSlot(ctx, slot); err != nil {
+		log.Error("failed to offer freed slot to the waitlist", err,
+			zap.String("service", slot.Service.String()), zap.Time("slot", slot.Start))
+	}
+}
+
+func slotOf(appt appointment.Appointment) waitlist.Slot {
+	return waitlist.Slot{Start: appt.ScheduledDate(), Service: appt.Service(), EmployeeID: appt.EmployeeID()}
+}
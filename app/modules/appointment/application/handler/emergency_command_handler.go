@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	c "clinic-vet-api/app/modules/appointment/application/command"
+	"clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/cqrs"
+)
+
+// HandleCreateEmergency registers a walk-in or emergency for right now together with the
+// draft medical session the veterinarian fills in. No scheduling rule or conflict check applies,
+// emergencies are seen as soon as possible whatever is already booked, but the pet must belong to
+// the customer and a veterinarian picked by the caller must not be on time off
+func (h *ApptCommandHandler) HandleCreateEmergency(ctx context.Context, cmd c.CreateEmergencyApptCommand) cqrs.CommandResult {
+	if _, err := h.petRepo.FindByIDAndCustomerID(ctx, cmd.PetID(), cmd.CustomerID()); err != nil {
+		return cqrs.FailureResult(PetNotFound, err)
+	}
+
+	intake := cmd.ToIntake()
+	if !cmd.HasEmployee() {
+		employeeID, err := h.emergencyVet(ctx, cmd.RegisteredBy())
+		if err != nil {
+			return cqrs.FailureResult(FindOnCallFailed, err)
+		}
+		intake.EmployeeID = employeeID
+	}
+
+	appt, err := appointment.NewEmergencyAppointment(ctx, intake)
+	if err != nil {
+		return cqrs.FailureResult(BusinessRuleFailed, err)
+	}
+
+	if cmd.HasEmployee() {
+		if err := h.ensureEmployeeAvailable(ctx, *appt); err != nil {
+			return cqrs.FailureResult(EmployeeUnavailable, err)
+		}
+	}
+
+	session, err := medical.OpenAppointmentSession(ctx, *appt)
+	if err != nil {
+		return cqrs.FailureResult(BusinessRuleFailed, err)
+	}
+
+	if err := h.visitRepo.CreateWithSession(ctx, appt, session); err != nil {
+		return cqrs.FailureResult(CreateEmergencyFailed, err)
+	}
+
+	return cqrs.SuccessCreateResult(appt.ID().String(), SuccessEmergencyCreated)
+}
+
+// emergencyVet returns the veterinarian on call, the veterinarian registering the emergency
+// stands in when nobody is
+func (h *ApptCommandHandler) emergencyVet(ctx context.Context, registeredBy valueobject.EmployeeID) (valueobject.EmployeeID, error) {
+	employeeID, err := h.onCall.EmergencyVet(ctx, time.Now())
+	if err != nil && !registeredBy.IsZero() {
+		return registeredBy, nil
+	}
+	return employeeID, err
+}
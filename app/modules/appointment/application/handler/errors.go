@@ -1,6 +1,9 @@
 package handler
 
 import (
+	"fmt"
+
+	"clinic-vet-api/app/modules/core/domain/enum"
 	"clinic-vet-api/app/modules/core/domain/valueobject"
 	apperror "clinic-vet-api/app/shared/error/application"
 )
@@ -19,6 +22,28 @@ const (
 	FailedToCheckExistence   = "failed to check appointment existence"
 	BusinessRuleFailed       = "business rule validation failed"
 	AppointmentNotFound      = "appointment not found"
+	ScheduleConflictFailed   = "appointment overlaps with an existing appointment"
+	EmployeeUnavailable      = "the veterinarian is off at the appointment time"
+	LoadCalendarFailed       = "failed to load clinic calendar"
+	NoShowLimitExceeded      = "customer exceeded the no-show limit"
+	WaitlistEntryNotFound    = "waitlist entry not found"
+	JoinWaitlistFailed       = "failed to join the waitlist"
+	LeaveWaitlistFailed      = "failed to leave the waitlist"
+	ClaimWaitlistSlotFailed  = "failed to claim waitlist slot"
+	SaveWaitlistEntryFailed  = "failed to save waitlist entry"
+	SeriesNotFound           = "appointment series not found"
+	CreateSeriesFailed       = "failed to create appointment series"
+	SaveSeriesFailed         = "failed to save appointment series occurrences"
+	IssueCalendarFeedFailed  = "failed to issue calendar feed"
+	RevokeCalendarFeedFailed = "failed to revoke calendar feed"
+	CalendarFeedNotFound     = "calendar feed not found"
+	CreateEmergencyFailed    = "failed to register emergency appointment"
+	AdvanceVisitFailed       = "failed to update the visit stage"
+	ReserveResourcesFailed   = "failed to reserve the rooms and equipment of the appointment"
+	OpenMedicalSessionFailed = "failed to open the medical session of the visit"
+	CoverAbsenceFailed       = "failed to reassign the appointments of the absent employee"
+	FindOnCallFailed         = "no veterinarian is on call to attend the emergency"
+	PetNotFound              = "pet not found for the customer"
 
 	SuccessApptCreated          = "appointment created successfully"
 	SuccessApptUpdated          = "appointment updated successfully"
@@ -26,8 +51,28 @@ const (
 	SuccessApptCanceled         = "appointment canceled successfully"
 	SuccessMarkedAsNotPresented = "appointment marked as not presented successfully"
 	SuccessConfirmedAppt        = "appointment confirmed successfully"
+	SuccessJoinedWaitlist       = "joined the waitlist successfully"
+	SuccessLeftWaitlist         = "left the waitlist successfully"
+	SuccessWaitlistSlotClaimed  = "waitlist slot claimed successfully"
+	SuccessSeriesCreated        = "appointment series created successfully"
+	SuccessSeriesUpdated        = "appointment series updated successfully"
+	SuccessCalendarFeedIssued   = "calendar feed issued successfully"
+	SuccessCalendarFeedRevoked  = "calendar feed revoked successfully"
+	SuccessEmergencyCreated     = "emergency appointment registered successfully"
+	SuccessVisitAdvanced        = "visit stage updated successfully"
+	SuccessAbsenceRegistered    = "absence registered, %d appointments proposed to another veterinarian and %d cancelled"
+
+	SuccessApptCompletedDraftOpened = "appointment completed, a draft medical session was opened for the visit"
 )
 
 func ErrAppointmentNotFound(id valueobject.AppointmentID) error {
 	return apperror.EntityNotFoundValidationError("Appointment", "id", id.String())
 }
+
+func ErrWaitlistEntryNotFound(id valueobject.WaitlistID) error {
+	return apperror.EntityNotFoundValidationError("WaitlistEntry", "id", id.String())
+}
+
+func ErrCalendarFeedNotFound(ownerType enum.CalendarFeedOwner, ownerID uint) error {
+	return apperror.EntityNotFoundValidationError("CalendarFeed", ownerType.String()+"_id", fmt.Sprintf("%d", ownerID))
+}
@@ -2,30 +2,56 @@ package handler
 
 import (
 	"context"
+	"time"
 
 	q "clinic-vet-api/app/modules/appointment/application/query"
+	"clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/calendarfeed"
+	"clinic-vet-api/app/modules/core/domain/entity/employee"
 	"clinic-vet-api/app/modules/core/domain/specification"
 	"clinic-vet-api/app/modules/core/domain/valueobject"
 	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
 	apperror "clinic-vet-api/app/shared/error/application"
 	p "clinic-vet-api/app/shared/page"
 )
 
 type ApptQueryHandler struct {
-	apptRepository     repository.AppointmentRepository
-	customerRepository repository.CustomerRepository
-	employeeRepository repository.EmployeeRepository
+	apptRepository      repository.AppointmentRepository
+	customerRepository  repository.CustomerRepository
+	employeeRepository  repository.EmployeeRepository
+	calendarRepository  repository.ClinicCalendarRepository
+	waitlistRepository  repository.WaitlistRepository
+	seriesRepository    repository.AppointmentSeriesRepository
+	feedRepository      repository.CalendarFeedRepository
+	petRepository       repository.PetRepository
+	feedSigner          calendarfeed.Signer
+	availabilityService *service.AppointmentAvailabilityService
 }
 
 func NewAppointmentQueryHandler(
 	apptRepository repository.AppointmentRepository,
 	customerRepository repository.CustomerRepository,
 	employeeRepository repository.EmployeeRepository,
+	exceptionRepository repository.ScheduleExceptionRepository,
+	calendarRepository repository.ClinicCalendarRepository,
+	waitlistRepository repository.WaitlistRepository,
+	seriesRepository repository.AppointmentSeriesRepository,
+	feedRepository repository.CalendarFeedRepository,
+	petRepository repository.PetRepository,
+	feedSigner calendarfeed.Signer,
 ) *ApptQueryHandler {
 	return &ApptQueryHandler{
-		apptRepository:     apptRepository,
-		customerRepository: customerRepository,
-		employeeRepository: employeeRepository,
+		apptRepository:      apptRepository,
+		customerRepository:  customerRepository,
+		employeeRepository:  employeeRepository,
+		calendarRepository:  calendarRepository,
+		waitlistRepository:  waitlistRepository,
+		seriesRepository:    seriesRepository,
+		feedRepository:      feedRepository,
+		petRepository:       petRepository,
+		feedSigner:          feedSigner,
+		availabilityService: service.NewAppointmentAvailabilityService(apptRepository, exceptionRepository),
 	}
 }
 
@@ -107,6 +133,114 @@ func (h *ApptQueryHandler) HandleByPetID(ctx context.Context, query q.FindApptsB
 	return p.MapItems(appointmentsPage, apptToResult), nil
 }
 
+func (h *ApptQueryHandler) HandleAvailability(ctx context.Context, query q.FindApptAvailabilityQuery) ([]ApptAvailabilityResult, error) {
+	employees, err := h.findAvailabilityEmployees(ctx, query.EmployeeID())
+	if err != nil {
+		return nil, err
+	}
+
+	clinicCalendar, err := h.calendarRepository.GetForPeriod(ctx, query.StartDate(), query.EndDate())
+	if err != nil {
+		return nil, err
+	}
+
+	results := []ApptAvailabilityResult{}
+	for _, emp := range employees {
+		availability, err := h.availabilityService.FindAvailableSlots(ctx, emp, query.Service(), query.StartDate(), query.EndDate(), clinicCalendar)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, day := range availability {
+			results = append(results, availabilityToResult(query.Service(), day))
+		}
+	}
+
+	return results, nil
+}
+
+func (h *ApptQueryHandler) HandleStats(ctx context.Context, query q.GetAppointmentStatsQuery) (ApptStatsResult, error) {
+	if query.EmployeeID() != nil {
+		if err := h.validateEmployee(ctx, *query.EmployeeID()); err != nil {
+			return ApptStatsResult{}, err
+		}
+	}
+
+	stats, err := h.apptRepository.Stats(ctx, appointment.StatsFilter{
+		EmployeeID: query.EmployeeID(),
+		StartDate:  query.StartDate(),
+		EndDate:    query.EndDate().AddDate(0, 0, 1),
+	})
+	if err != nil {
+		return ApptStatsResult{}, err
+	}
+
+	return statsToResult(query, stats), nil
+}
+
+func (h *ApptQueryHandler) HandleWaitingRoomQueue(ctx context.Context, query q.GetWaitingRoomQueueQuery) (WaitingRoomQueueResult, error) {
+	if query.EmployeeID() != nil {
+		if err := h.validateEmployee(ctx, *query.EmployeeID()); err != nil {
+			return WaitingRoomQueueResult{}, err
+		}
+	}
+
+	visits, err := h.apptRepository.FindCheckedInBetween(ctx, query.Day(), query.Day().AddDate(0, 0, 1), query.EmployeeID())
+	if err != nil {
+		return WaitingRoomQueueResult{}, err
+	}
+
+	now := time.Now()
+	return waitingRoomToResult(query.EmployeeID(), appointment.BuildWaitingRoomQueue(visits, now), now), nil
+}
+
+func (h *ApptQueryHandler) HandleWaitlistByCustomer(ctx context.Context, query q.FindWaitlistByCustomerQuery) ([]WaitlistEntryResult, error) {
+	entries, err := h.waitlistRepository.FindByCustomer(ctx, query.CustomerID())
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]WaitlistEntryResult, len(entries))
+	for i, entry := range entries {
+		results[i] = waitlistEntryToResult(entry)
+	}
+	return results, nil
+}
+
+func (h *ApptQueryHandler) HandleSeriesByID(ctx context.Context, query q.FindApptSeriesByIDQuery) (ApptSeriesResult, error) {
+	series, err := h.seriesRepository.FindByID(ctx, query.SeriesID())
+	if err != nil {
+		return ApptSeriesResult{}, err
+	}
+
+	occurrences, err := h.seriesRepository.FindOccurrences(ctx, query.SeriesID())
+	if err != nil {
+		return ApptSeriesResult{}, err
+	}
+
+	return seriesToResult(series, occurrences), nil
+}
+
+func (h *ApptQueryHandler) findAvailabilityEmployees(ctx context.Context, employeeID *valueobject.EmployeeID) ([]employee.Employee, error) {
+	if employeeID != nil {
+		emp, err := h.employeeRepository.FindByID(ctx, *employeeID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !emp.IsActive() {
+			return []employee.Employee{}, nil
+		}
+		return []employee.Employee{emp}, nil
+	}
+
+	employeePage, err := h.employeeRepository.FindActive(ctx, p.AllItems())
+	if err != nil {
+		return nil, err
+	}
+	return employeePage.Items, nil
+}
+
 func (h *ApptQueryHandler) validateEmployee(ctx context.Context, employeeID valueobject.EmployeeID) error {
 	exists, err := h.employeeRepository.ExistsByID(ctx, employeeID)
 	if err != nil {
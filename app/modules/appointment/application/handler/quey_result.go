@@ -3,74 +3,229 @@ package handler
 import (
 	"time"
 
+	q "clinic-vet-api/app/modules/appointment/application/query"
 	"clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/waitlist"
 	"clinic-vet-api/app/modules/core/domain/enum"
 	"clinic-vet-api/app/modules/core/domain/valueobject"
+	svc "clinic-vet-api/app/modules/core/service"
 )
 
 type ApptResult struct {
-	ID            valueobject.AppointmentID
-	CustomerID    valueobject.CustomerID
-	PetID         valueobject.PetID
-	EmployeeID    *valueobject.EmployeeID
-	Service       enum.ClinicService
-	ScheduledDate time.Time
-	Status        enum.AppointmentStatus
-	Notes         *string
-	IsEmergency   bool
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	ID             valueobject.AppointmentID
+	CustomerID     valueobject.CustomerID
+	PetID          valueobject.PetID
+	EmployeeID     *valueobject.EmployeeID
+	Service        enum.ClinicService
+	ScheduledDate  time.Time
+	Status         enum.AppointmentStatus
+	Notes          *string
+	IsEmergency    bool
+	TriagePriority *enum.TriagePriority
+	Visit          appointment.VisitProgress
+	SeriesID       *valueobject.ApptSeriesID
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
 
 func apptToResult(appointment appointment.Appointment) ApptResult {
 	return ApptResult{
-		ID:            appointment.ID(),
-		CustomerID:    appointment.CustomerID(),
-		PetID:         appointment.PetID(),
-		EmployeeID:    appointment.EmployeeID(),
-		Service:       appointment.Service(),
-		ScheduledDate: appointment.ScheduledDate(),
-		Status:        appointment.Status(),
-		CreatedAt:     appointment.CreatedAt(),
-		UpdatedAt:     appointment.UpdatedAt(),
+		ID:             appointment.ID(),
+		CustomerID:     appointment.CustomerID(),
+		PetID:          appointment.PetID(),
+		EmployeeID:     appointment.EmployeeID(),
+		Service:        appointment.Service(),
+		ScheduledDate:  appointment.ScheduledDate(),
+		Status:         appointment.Status(),
+		Notes:          appointment.Notes(),
+		IsEmergency:    appointment.IsEmergency(),
+		TriagePriority: appointment.TriagePriority(),
+		Visit:          appointment.VisitProgress(),
+		SeriesID:       appointment.SeriesID(),
+		CreatedAt:      appointment.CreatedAt(),
+		UpdatedAt:      appointment.UpdatedAt(),
 	}
 }
 
 type ApptStatsResult struct {
-	TotalAppts       int                            `json:"total_appointments"`
-	PendingAppts     int                            `json:"pending_appointments"`
-	ConfirmedAppts   int                            `json:"confirmed_appointments"`
-	CompletedAppts   int                            `json:"completed_appointments"`
-	CancelledAppts   int                            `json:"cancelled_appointments"`
-	NoShowAppts      int                            `json:"no_show_appointments"`
-	StatusBreakdown  map[enum.AppointmentStatus]int `json:"status_breakdown"`
-	ServiceBreakdown map[enum.ClinicService]int     `json:"service_breakdown"`
-	EmergencyCount   int                            `json:"emergency_count"`
-	Period           *string                        `json:"period,omitempty"`
-}
-
-func NewApptStatsResult(
-	totalAppts,
-	pendingAppts,
-	confirmedAppts,
-	completedAppts,
-	cancelledAppts,
-	noShowAppts,
-	emergencyCount int,
-	statusBreakdown map[enum.AppointmentStatus]int,
-	serviceBreakdown map[enum.ClinicService]int,
-	period *string,
-) ApptStatsResult {
+	EmployeeID      *valueobject.EmployeeID
+	StartDate       time.Time
+	EndDate         time.Time
+	Total           int
+	ByStatus        map[enum.AppointmentStatus]int
+	NoShowRate      float64
+	CompletionRate  float64
+	AvgLeadTime     time.Duration
+	ByService       []appointment.ServiceStats
+	BusiestWeekdays []appointment.WeekdayCount
+	BusiestHours    []appointment.HourCount
+}
+
+func statsToResult(query q.GetAppointmentStatsQuery, stats appointment.Stats) ApptStatsResult {
 	return ApptStatsResult{
-		TotalAppts:       totalAppts,
-		PendingAppts:     pendingAppts,
-		ConfirmedAppts:   confirmedAppts,
-		CompletedAppts:   completedAppts,
-		CancelledAppts:   cancelledAppts,
-		NoShowAppts:      noShowAppts,
-		StatusBreakdown:  statusBreakdown,
-		ServiceBreakdown: serviceBreakdown,
-		EmergencyCount:   emergencyCount,
-		Period:           period,
+		EmployeeID:      query.EmployeeID(),
+		StartDate:       query.StartDate(),
+		EndDate:         query.EndDate(),
+		Total:           stats.Total,
+		ByStatus:        stats.ByStatus,
+		NoShowRate:      stats.NoShowRate(),
+		CompletionRate:  stats.CompletionRate(),
+		AvgLeadTime:     stats.AvgLeadTime,
+		ByService:       stats.ByService,
+		BusiestWeekdays: stats.ByWeekday,
+		BusiestHours:    stats.ByHour,
+	}
+}
+
+type QueueEntryResult struct {
+	Appointment ApptResult
+	Position    int
+	WaitTime    time.Duration
+	ExamTime    time.Duration
+}
+
+type WaitingRoomQueueResult struct {
+	EmployeeID  *valueobject.EmployeeID
+	GeneratedAt time.Time
+	Entries     []QueueEntryResult
+	Metrics     appointment.QueueMetrics
+}
+
+func waitingRoomToResult(employeeID *valueobject.EmployeeID, queue appointment.WaitingRoomQueue, generatedAt time.Time) WaitingRoomQueueResult {
+	entries := make([]QueueEntryResult, len(queue.Entries))
+	for i, entry := range queue.Entries {
+		entries[i] = QueueEntryResult{
+			Appointment: apptToResult(entry.Appointment),
+			Position:    entry.Position,
+			WaitTime:    entry.WaitTime,
+			ExamTime:    entry.ExamTime,
+		}
+	}
+
+	return WaitingRoomQueueResult{
+		EmployeeID:  employeeID,
+		GeneratedAt: generatedAt,
+		Entries:     entries,
+		Metrics:     queue.Metrics,
+	}
+}
+
+type ApptSlotResult struct {
+	Start time.Time
+	End   time.Time
+}
+
+type ApptAvailabilityResult struct {
+	EmployeeID valueobject.EmployeeID
+	Service    enum.ClinicService
+	Date       time.Time
+	Slots      []ApptSlotResult
+}
+
+func availabilityToResult(service enum.ClinicService, availability svc.EmployeeAvailability) ApptAvailabilityResult {
+	slots := make([]ApptSlotResult, len(availability.Slots))
+	for i, slot := range availability.Slots {
+		slots[i] = ApptSlotResult{Start: slot.Start, End: slot.End}
+	}
+
+	return ApptAvailabilityResult{
+		EmployeeID: availability.EmployeeID,
+		Service:    service,
+		Date:       availability.Date,
+		Slots:      slots,
 	}
 }
+
+type WaitlistEntryResult struct {
+	ID                  valueobject.WaitlistID
+	CustomerID          valueobject.CustomerID
+	PetID               valueobject.PetID
+	Service             enum.ClinicService
+	RequestedDate       time.Time
+	PreferredEmployeeID *valueobject.EmployeeID
+	Notes               *string
+	Status              enum.WaitlistStatus
+	OfferedSlot         *time.Time
+	OfferedEmployeeID   *valueobject.EmployeeID
+	OfferExpiresAt      *time.Time
+	AppointmentID       *valueobject.AppointmentID
+	CreatedAt           time.Time
+}
+
+func waitlistEntryToResult(entry waitlist.WaitlistEntry) WaitlistEntryResult {
+	return WaitlistEntryResult{
+		ID:                  entry.ID(),
+		CustomerID:          entry.CustomerID(),
+		PetID:               entry.PetID(),
+		Service:             entry.Service(),
+		RequestedDate:       entry.RequestedDate(),
+		PreferredEmployeeID: entry.PreferredEmployeeID(),
+		Notes:               entry.Notes(),
+		Status:              entry.Status(),
+		OfferedSlot:         entry.OfferedSlot(),
+		OfferedEmployeeID:   entry.OfferedEmployeeID(),
+		OfferExpiresAt:      entry.OfferExpiresAt(),
+		AppointmentID:       entry.AppointmentID(),
+		CreatedAt:           entry.CreatedAt(),
+	}
+}
+
+type ApptSeriesResult struct {
+	ID              valueobject.ApptSeriesID
+	CustomerID      valueobject.CustomerID
+	PetID           valueobject.PetID
+	EmployeeID      *valueobject.EmployeeID
+	Service         enum.ClinicService
+	StartDate       time.Time
+	Frequency       enum.RecurrenceFrequency
+	Interval        int
+	OccurrenceCount *int
+	UntilDate       *time.Time
+	Notes           *string
+	Occurrences     []ApptResult
+	CreatedAt       time.Time
+}
+
+func seriesToResult(series appointment.AppointmentSeries, occurrences []appointment.Appointment) ApptSeriesResult {
+	recurrence := series.Recurrence()
+
+	occurrenceResults := make([]ApptResult, len(occurrences))
+	for i, occurrence := range occurrences {
+		occurrenceResults[i] = apptToResult(occurrence)
+	}
+
+	return ApptSeriesResult{
+		ID:              series.ID(),
+		CustomerID:      series.CustomerID(),
+		PetID:           series.PetID(),
+		EmployeeID:      series.EmployeeID(),
+		Service:         series.Service(),
+		StartDate:       series.StartDate(),
+		Frequency:       recurrence.Frequency,
+		Interval:        recurrence.Interval,
+		OccurrenceCount: recurrence.Count,
+		UntilDate:       recurrence.Until,
+		Notes:           series.Notes(),
+		Occurrences:     occurrenceResults,
+		CreatedAt:       series.CreatedAt(),
+	}
+}
+
+type CalendarFeedResult struct {
+	ID        valueobject.CalendarFeedID
+	OwnerType enum.CalendarFeedOwner
+	Token     string
+	CreatedAt time.Time
+}
+
+type CalendarEventResult struct {
+	Appointment ApptResult
+	PetName     string
+	EndDate     time.Time
+	Sequence    int
+}
+
+type CalendarFeedEventsResult struct {
+	OwnerType enum.CalendarFeedOwner
+	Events    []CalendarEventResult
+}
@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"context"
+
+	c "clinic-vet-api/app/modules/appointment/application/command"
+	"clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/waitlist"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/cqrs"
+)
+
+// HandleCreateSeries generates every occurrence up front so a conflict on any of them rejects
+// the whole series before anything is persisted
+func (h *ApptCommandHandler) HandleCreateSeries(ctx context.Context, cmd c.CreateApptSeriesCommand) cqrs.CommandResult {
+	series := cmd.ToEntity()
+
+	clinicCalendar, err := h.calendarRepo.GetForPeriod(ctx, series.StartDate(), series.LastDate())
+	if err != nil {
+		return cqrs.FailureResult(LoadCalendarFailed, err)
+	}
+
+	occurrences, err := series.GenerateOccurrences(ctx, clinicCalendar)
+	if err != nil {
+		return cqrs.FailureResult(BusinessRuleFailed, err)
+	}
+
+	for _, occurrence := range occurrences {
+		if err := h.ensureNoScheduleConflict(ctx, occurrence); err != nil {
+			return cqrs.FailureResult(ScheduleConflictFailed, err)
+		}
+
+		if err := h.ensureEmployeeAvailable(ctx, occurrence); err != nil {
+			return cqrs.FailureResult(EmployeeUnavailable, err)
+		}
+	}
+
+	if err := h.seriesRepo.Create(ctx, series, occurrences); err != nil {
+		return cqrs.FailureResult(CreateSeriesFailed, err)
+	}
+
+	return cqrs.SuccessCreateResult(series.ID().String(), SuccessSeriesCreated)
+}
+
+func (h *ApptCommandHandler) HandleCancelSeries(ctx context.Context, cmd c.CancelApptSeriesCommand) cqrs.CommandResult {
+	appt, err := h.getAppByIDAndEmployeeID(ctx, cmd.AppointmentID(), cmd.EmployeeID())
+	if err != nil {
+		return cqrs.FailureResult(ApptNotFound, err)
+	}
+
+	occurrences, err := h.occurrencesInScope(ctx, appt, cmd.Scope())
+	if err != nil {
+		return cqrs.FailureResult(SeriesNotFound, err)
+	}
+
+	freedSlots := make([]waitlist.Slot, 0, len(occurrences))
+	for i := range occurrences {
+		freedSlots = append(freedSlots, slotOf(occurrences[i]))
+		if err := occurrences[i].Cancel(ctx); err != nil {
+			return cqrs.FailureResult(FailedToCancel, err)
+		}
+	}
+
+	if err := h.reservations.SaveWithReservations(ctx, occurrences); err != nil {
+		return cqrs.FailureResult(ReserveResourcesFailed, err)
+	}
+
+	for _, slot := range freedSlots {
+		h.offerFreedSlot(ctx, slot)
+	}
+
+	return cqrs.SuccessResult(SuccessSeriesUpdated)
+}
+
+// HandleRescheduleSeries moves the occurrences in scope by the same offset as the selected one,
+// keeping the rhythm of the series. Every moved occurrence must stay inside the booking window.
+// The moved occurrences are ignored by the conflict check since they are all being shifted
+// together
+func (h *ApptCommandHandler) HandleRescheduleSeries(ctx context.Context, cmd c.RescheduleApptSeriesCommand) cqrs.CommandResult {
+	appt, err := h.getAppByIDAndEmployeeID(ctx, cmd.AppointmentID(), cmd.EmployeeID())
+	if err != nil {
+		return cqrs.FailureResult(ApptNotFound, err)
+	}
+
+	occurrences, err := h.occurrencesInScope(ctx, appt, cmd.Scope())
+	if err != nil {
+		return cqrs.FailureResult(SeriesNotFound, err)
+	}
+
+	offset := cmd.DateTime().Sub(appt.ScheduledDate())
+	lastDate := cmd.DateTime()
+	if len(occurrences) > 0 {
+		lastDate = occurrences[len(occurrences)-1].ScheduledDate().Add(offset)
+	}
+
+	clinicCalendar, err := h.calendarRepo.GetForPeriod(ctx, cmd.DateTime(), lastDate)
+	if err != nil {
+		return cqrs.FailureResult(LoadCalendarFailed, err)
+	}
+
+	movedIDs := make([]valueobject.AppointmentID, len(occurrences))
+	freedSlots := make([]waitlist.Slot, 0, len(occurrences))
+	for i := range occurrences {
+		movedIDs[i] = occurrences[i].ID()
+		freedSlots = append(freedSlots, slotOf(occurrences[i]))
+
+		newDate := occurrences[i].ScheduledDate().Add(offset)
+		if err := occurrences[i].Reschedule(ctx, newDate, clinicCalendar); err != nil {
+			return cqrs.FailureResult(UpdateApptFailed, appointment.InvalidOccurrenceError(ctx, i+1, newDate, err, "RescheduleSeries"))
+		}
+	}
+
+	for _, occurrence := range occurrences {
+		if err := h.ensureNoScheduleConflict(ctx, occurrence, movedIDs...); err != nil {
+			return cqrs.FailureResult(ScheduleConflictFailed, err)
+		}
+
+		if err := h.ensureEmployeeAvailable(ctx, occurrence); err != nil {
+			return cqrs.FailureResult(EmployeeUnavailable, err)
+		}
+	}
+
+	if err := h.reservations.SaveWithReservations(ctx, occurrences); err != nil {
+		return cqrs.FailureResult(ReserveResourcesFailed, err)
+	}
+
+	for _, slot := range freedSlots {
+		h.offerFreedSlot(ctx, slot)
+	}
+
+	return cqrs.SuccessResult(SuccessSeriesUpdated)
+}
+
+// occurrencesInScope returns the selected occurrence alone or together with the ones after it
+func (h *ApptCommandHandler) occurrencesInScope(ctx context.Context, appt appointment.Appointment, scope enum.SeriesScope) ([]appointment.Appointment, error) {
+	if appt.SeriesID() == nil {
+		return nil, appointment.NotInSeriesError(ctx, appt.ID(), "FindSeriesOccurrences")
+	}
+
+	if scope == enum.SeriesScopeOccurrence {
+		return []appointment.Appointment{appt}, nil
+	}
+
+	occurrences, err := h.seriesRepo.FindOccurrences(ctx, *appt.SeriesID())
+	if err != nil {
+		return nil, err
+	}
+
+	return appointment.FollowingOccurrences(occurrences, appt), nil
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	c "clinic-vet-api/app/modules/appointment/application/command"
+	"clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/shared/cqrs"
+)
+
+func (h *ApptCommandHandler) HandleAdvanceVisit(ctx context.Context, cmd c.AdvanceVisitCommand) cqrs.CommandResult {
+	appointment, err := h.getAppByIDAndEmployeeID(ctx, cmd.AppointmentID(), cmd.EmployeeID())
+	if err != nil {
+		return cqrs.FailureResult(FailedToCheckExistence, err)
+	}
+
+	if err := appointment.AdvanceVisit(ctx, cmd.Stage(), time.Now()); err != nil {
+		return cqrs.FailureResult(AdvanceVisitFailed, err)
+	}
+
+	// The visit starts once the patient leaves the waiting room
+	if cmd.Stage() == enum.VisitStageCheckedIn {
+		if err := h.apptRepository.Save(ctx, &appointment); err != nil {
+			return cqrs.FailureResult(UpdateApptFailed, err)
+		}
+		return cqrs.SuccessResult(SuccessVisitAdvanced)
+	}
+
+	if _, err := h.saveAndOpenSession(ctx, appointment); err != nil {
+		return cqrs.FailureResult(OpenMedicalSessionFailed, err)
+	}
+
+	return cqrs.SuccessResult(SuccessVisitAdvanced)
+}
+
+// saveAndOpenSession saves the appointment together with the draft medical session of its visit,
+// the session opened earlier in the visit is kept when there is one
+func (h *ApptCommandHandler) saveAndOpenSession(ctx context.Context, appt appointment.Appointment) (bool, error) {
+	session, err := medical.OpenAppointmentSession(ctx, appt)
+	if err != nil {
+		return false, err
+	}
+
+	return h.visitRepo.SaveAndOpenSession(ctx, &appt, session)
+}
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	c "clinic-vet-api/app/modules/appointment/application/command"
+	"clinic-vet-api/app/modules/core/domain/entity/employee"
+	"clinic-vet-api/app/modules/core/domain/entity/waitlist"
+	"clinic-vet-api/app/shared/cqrs"
+	apperror "clinic-vet-api/app/shared/error/application"
+	p "clinic-vet-api/app/shared/page"
+)
+
+func (h *ApptCommandHandler) HandleJoinWaitlist(ctx context.Context, cmd c.JoinWaitlistCommand) cqrs.CommandResult {
+	entry := waitlist.NewWaitlistEntryBuilder().
+		WithCustomerID(cmd.CustomerID()).
+		WithPetID(cmd.PetID()).
+		WithService(cmd.Service()).
+		WithRequestedDate(cmd.RequestedDate()).
+		WithPreferredEmployeeID(cmd.PreferredEmployeeID()).
+		WithNotes(cmd.Notes()).
+		Build()
+
+	if err := entry.Validate(ctx, time.Now()); err != nil {
+		return cqrs.FailureResult(JoinWaitlistFailed, err)
+	}
+
+	if err := h.ensureDayIsFull(ctx, *entry); err != nil {
+		return cqrs.FailureResult(JoinWaitlistFailed, err)
+	}
+
+	if err := h.waitlistRepo.Save(ctx, entry); err != nil {
+		return cqrs.FailureResult(SaveWaitlistEntryFailed, err)
+	}
+
+	return cqrs.SuccessCreateResult(entry.ID().String(), SuccessJoinedWaitlist)
+}
+
+func (h *ApptCommandHandler) HandleLeaveWaitlist(ctx context.Context, cmd c.LeaveWaitlistCommand) cqrs.CommandResult {
+	entry, err := h.waitlistRepo.FindByID(ctx, cmd.EntryID())
+	if err != nil {
+		return cqrs.FailureResult(WaitlistEntryNotFound, err)
+	}
+
+	if entry.CustomerID() != cmd.CustomerID() {
+		return cqrs.FailureResult(WaitlistEntryNotFound, ErrWaitlistEntryNotFound(cmd.EntryID()))
+	}
+
+	if err := entry.Cancel(ctx); err != nil {
+		return cqrs.FailureResult(LeaveWaitlistFailed, err)
+	}
+
+	if err := h.waitlistRepo.Save(ctx, &entry); err != nil {
+		return cqrs.FailureResult(SaveWaitlistEntryFailed, err)
+	}
+
+	// a slot held by the cancelled offer goes to the next customer in line
+	if slot, hasSlot := entry.Slot(); hasSlot && entry.AppointmentID() == nil {
+		h.offerFreedSlot(ctx, slot)
+	}
+
+	return cqrs.SuccessResult(SuccessLeftWaitlist)
+}
+
+// HandleClaimWaitlistSlot converts a pending offer into a pending appointment for the offered slot
+func (h *ApptCommandHandler) HandleClaimWaitlistSlot(ctx context.Context, cmd c.ClaimWaitlistSlotCommand) cqrs.CommandResult {
+	entry, err := h.waitlistRepo.FindByClaimToken(ctx, cmd.ClaimToken())
+	if err != nil {
+		return cqrs.FailureResult(WaitlistEntryNotFound, err)
+	}
+
+	if entry.CustomerID() != cmd.CustomerID() {
+		return cqrs.FailureResult(WaitlistEntryNotFound, apperror.EntityNotFoundValidationError("WaitlistEntry", "claim_token", "***"))
+	}
+
+	appointment, err := entry.Claim(ctx, cmd.ClaimToken(), time.Now())
+	if err != nil {
+		return cqrs.FailureResult(ClaimWaitlistSlotFailed, err)
+	}
+
+	if err := h.ensureNoScheduleConflict(ctx, appointment); err != nil {
+		return cqrs.FailureResult(ScheduleConflictFailed, err)
+	}
+
+	if err := h.ensureEmployeeAvailable(ctx, appointment); err != nil {
+		return cqrs.FailureResult(EmployeeUnavailable, err)
+	}
+
+	claimed, err := h.waitlistRepo.SaveClaim(ctx, &entry, &appointment)
+	if err != nil {
+		return cqrs.FailureResult(SaveApptFailed, err)
+	}
+
+	if !claimed {
+		return cqrs.FailureResult(ClaimWaitlistSlotFailed, waitlist.OfferNoLongerAvailableError(ctx, "ClaimWaitlistSlot"))
+	}
+
+	return cqrs.SuccessCreateResult(appointment.ID().String(), SuccessWaitlistSlotClaimed)
+}
+
+// ensureDayIsFull only lets customers wait for a day where the service cannot be booked anymore,
+// with the preferred veterinarian when there is one
+func (h *ApptCommandHandler) ensureDayIsFull(ctx context.Context, entry waitlist.WaitlistEntry) error {
+	var employees []employee.Employee
+	if entry.PreferredEmployeeID() != nil {
+		emp, err := h.employeeRepo.FindByID(ctx, *entry.PreferredEmployeeID())
+		if err != nil {
+			return err
+		}
+		if emp.IsActive() {
+			employees = []employee.Employee{emp}
+		}
+	} else {
+		employeePage, err := h.employeeRepo.FindActive(ctx, p.AllItems())
+		if err != nil {
+			return err
+		}
+		employees = employeePage.Items
+	}
+
+	clinicCalendar, err := h.calendarRepo.GetForPeriod(ctx, entry.RequestedDate(), entry.RequestedDate())
+	if err != nil {
+		return err
+	}
+
+	available, err := h.availability.HasAvailableSlot(ctx, employees, entry.Service(), entry.RequestedDate(), clinicCalendar)
+	if err != nil {
+		return err
+	}
+
+	if available {
+		return waitlist.DayNotFullError(ctx, "JoinWaitlist")
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package query
+
+import (
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/service"
+	apperror "clinic-vet-api/app/shared/error/application"
+	"fmt"
+	"time"
+)
+
+type FindApptAvailabilityQuery struct {
+	employeeID *valueobject.EmployeeID
+	service    enum.ClinicService
+	startDate  time.Time
+	endDate    time.Time
+}
+
+func NewFindApptAvailabilityQuery(employeeID *uint, serviceName string, startDate, endDate time.Time) (FindApptAvailabilityQuery, error) {
+	clinicService, err := enum.ParseClinicService(serviceName)
+	if err != nil {
+		return FindApptAvailabilityQuery{}, apperror.FieldValidationError("service", serviceName, err.Error())
+	}
+
+	if startDate.IsZero() {
+		return FindApptAvailabilityQuery{}, apperror.FieldValidationError("startDate", "zero", "startDate can't be zero")
+	}
+
+	if endDate.IsZero() {
+		return FindApptAvailabilityQuery{}, apperror.FieldValidationError("endDate", "zero", "endDate can't be zero")
+	}
+
+	if endDate.Before(startDate) {
+		return FindApptAvailabilityQuery{}, apperror.FieldValidationError("date-range", "", "endDate can't be before startDate")
+	}
+
+	if endDate.Sub(startDate) > time.Duration(service.MAX_AVAILABILITY_RANGE_DAYS)*24*time.Hour {
+		message := fmt.Sprintf("date range can't exceed %d days", service.MAX_AVAILABILITY_RANGE_DAYS)
+		return FindApptAvailabilityQuery{}, apperror.FieldValidationError("date-range", "", message)
+	}
+
+	return FindApptAvailabilityQuery{
+		employeeID: valueobject.NewOptEmployeeID(employeeID),
+		service:    clinicService,
+		startDate:  startDate,
+		endDate:    endDate,
+	}, nil
+}
+
+func (q FindApptAvailabilityQuery) EmployeeID() *valueobject.EmployeeID { return q.employeeID }
+func (q FindApptAvailabilityQuery) Service() enum.ClinicService         { return q.service }
+func (q FindApptAvailabilityQuery) StartDate() time.Time                { return q.startDate }
+func (q FindApptAvailabilityQuery) EndDate() time.Time                  { return q.endDate }
@@ -0,0 +1,13 @@
+package query
+
+import "clinic-vet-api/app/modules/core/domain/valueobject"
+
+type FindApptSeriesByIDQuery struct {
+	seriesID valueobject.ApptSeriesID
+}
+
+func NewFindApptSeriesByIDQuery(seriesID uint) FindApptSeriesByIDQuery {
+	return FindApptSeriesByIDQuery{seriesID: valueobject.NewApptSeriesID(seriesID)}
+}
+
+func (q FindApptSeriesByIDQuery) SeriesID() valueobject.ApptSeriesID { return q.seriesID }
@@ -0,0 +1,27 @@
+package query
+
+import "clinic-vet-api/app/modules/core/domain/enum"
+
+type FindCalendarFeedQuery struct {
+	ownerType enum.CalendarFeedOwner
+	ownerID   uint
+}
+
+func NewFindCalendarFeedQuery(ownerType enum.CalendarFeedOwner, ownerID uint) FindCalendarFeedQuery {
+	return FindCalendarFeedQuery{ownerType: ownerType, ownerID: ownerID}
+}
+
+func (q FindCalendarFeedQuery) OwnerType() enum.CalendarFeedOwner { return q.ownerType }
+func (q FindCalendarFeedQuery) OwnerID() uint                     { return q.ownerID }
+
+// FindCalendarFeedEventsQuery is resolved from the signed token alone, feed subscribers are not
+// authenticated
+type FindCalendarFeedEventsQuery struct {
+	token string
+}
+
+func NewFindCalendarFeedEventsQuery(token string) FindCalendarFeedEventsQuery {
+	return FindCalendarFeedEventsQuery{token: token}
+}
+
+func (q FindCalendarFeedEventsQuery) Token() string { return q.token }
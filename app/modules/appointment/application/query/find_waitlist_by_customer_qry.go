@@ -0,0 +1,13 @@
+package query
+
+import "clinic-vet-api/app/modules/core/domain/valueobject"
+
+type FindWaitlistByCustomerQuery struct {
+	customerID valueobject.CustomerID
+}
+
+func NewFindWaitlistByCustomerQuery(customerID uint) FindWaitlistByCustomerQuery {
+	return FindWaitlistByCustomerQuery{customerID: valueobject.NewCustomerID(customerID)}
+}
+
+func (q FindWaitlistByCustomerQuery) CustomerID() valueobject.CustomerID { return q.customerID }
@@ -0,0 +1,49 @@
+package query
+
+import (
+	"fmt"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+// MaxStatsRangeDays caps the date range a single statistics request can cover
+const MaxStatsRangeDays = 366
+
+type GetAppointmentStatsQuery struct {
+	employeeID *valueobject.EmployeeID
+	startDate  time.Time
+	endDate    time.Time
+}
+
+// NewGetAppointmentStatsQuery builds the query for the appointments scheduled from startDate to
+// endDate, both days included. A nil employeeID covers the whole clinic
+func NewGetAppointmentStatsQuery(employeeID *uint, startDate, endDate time.Time) (GetAppointmentStatsQuery, error) {
+	if startDate.IsZero() {
+		return GetAppointmentStatsQuery{}, apperror.FieldValidationError("startDate", "zero", "startDate can't be zero")
+	}
+
+	if endDate.IsZero() {
+		return GetAppointmentStatsQuery{}, apperror.FieldValidationError("endDate", "zero", "endDate can't be zero")
+	}
+
+	if endDate.Before(startDate) {
+		return GetAppointmentStatsQuery{}, apperror.FieldValidationError("date-range", "", "endDate can't be before startDate")
+	}
+
+	if endDate.Sub(startDate) > time.Duration(MaxStatsRangeDays)*24*time.Hour {
+		message := fmt.Sprintf("date range can't exceed %d days", MaxStatsRangeDays)
+		return GetAppointmentStatsQuery{}, apperror.FieldValidationError("date-range", "", message)
+	}
+
+	return GetAppointmentStatsQuery{
+		employeeID: valueobject.NewOptEmployeeID(employeeID),
+		startDate:  startDate,
+		endDate:    endDate,
+	}, nil
+}
+
+func (q GetAppointmentStatsQuery) EmployeeID() *valueobject.EmployeeID { return q.employeeID }
+func (q GetAppointmentStatsQuery) StartDate() time.Time                { return q.startDate }
+func (q GetAppointmentStatsQuery) EndDate() time.Time                  { return q.endDate }
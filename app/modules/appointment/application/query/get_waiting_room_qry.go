@@ -0,0 +1,25 @@
+package query
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type GetWaitingRoomQueueQuery struct {
+	employeeID *valueobject.EmployeeID
+	day        time.Time
+}
+
+// NewGetWaitingRoomQueueQuery builds the query for today's live queue, of a single veterinarian
+// when employeeID is given, otherwise of the whole clinic for the front desk
+func NewGetWaitingRoomQueueQuery(employeeID *uint) GetWaitingRoomQueueQuery {
+	now := time.Now()
+	return GetWaitingRoomQueueQuery{
+		employeeID: valueobject.NewOptEmployeeID(employeeID),
+		day:        time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()),
+	}
+}
+
+func (q GetWaitingRoomQueueQuery) EmployeeID() *valueobject.EmployeeID { return q.employeeID }
+func (q GetWaitingRoomQueueQuery) Day() time.Time                      { return q.day }
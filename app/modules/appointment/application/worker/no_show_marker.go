@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/specification"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/log"
+
+	"go.uber.org/zap"
+)
+
+// NoShowMarker marks as not presented the confirmed appointments whose grace period is over.
+// Marking is idempotent: an appointment already moved out of confirmed by another replica or
+// by staff is skipped by the domain transition rules
+type NoShowMarker struct {
+	apptRepo repository.AppointmentRepository
+	policy   appt.NoShowPolicy
+}
+
+func NewNoShowMarker(apptRepo repository.AppointmentRepository, policy appt.NoShowPolicy) *NoShowMarker {
+	return &NoShowMarker{apptRepo: apptRepo, policy: policy}
+}
+
+func (m *NoShowMarker) Name() string { return "appointment-no-show-marker" }
+
+func (m *NoShowMarker) Run(ctx context.Context) error {
+	now := time.Now()
+	spec := specification.ApptByStatus(enum.AppointmentStatusConfirmed).
+		And(specification.ApptByDateRange(time.Time{}, m.policy.OverdueBefore(now))).
+		WithPagination(specification.Pagination{Limit: math.MaxInt32})
+
+	appointmentPage, err := m.apptRepo.Find(ctx, spec)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, appointment := range appointmentPage.Items {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if !m.policy.IsOverdue(appointment, now) {
+			continue
+		}
+
+		if err := m.markAsNotPresented(ctx, appointment); err != nil {
+			failed++
+			log.Error("failed to mark appointment as not presented", err, zap.String(log.EntityIDKey, appointment.ID().String()))
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d appointments could not be marked as not presented", failed)
+	}
+	return nil
+}
+
+func (m *NoShowMarker) markAsNotPresented(ctx context.Context, appointment appt.Appointment) error {
+	if err := appointment.MarkAsNotPresented(ctx); err != nil {
+		return err
+	}
+
+	if err := m.apptRepo.Save(ctx, &appointment); err != nil {
+		return err
+	}
+
+	log.Info("appointment marked as not presented", zap.String(log.EntityIDKey, appointment.ID().String()))
+	return nil
+}
@@ -0,0 +1,121 @@
+// Package worker contains the background jobs of the appointment module
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/notification"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/specification"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
+	"clinic-vet-api/app/shared/log"
+
+	"go.uber.org/zap"
+)
+
+// ReminderWindow is how long before the appointment the reminder goes out
+const ReminderWindow = 24 * time.Hour
+
+// ReminderDispatcher sends a reminder for every confirmed appointment entering the reminder
+// window. Each reminder is claimed in the database before sending, so the job can run on
+// every API replica without duplicates
+type ReminderDispatcher struct {
+	apptRepo            repository.AppointmentRepository
+	reminderRepo        repository.AppointmentReminderRepository
+	contactService      *service.CustomerContactService
+	notificationService service.NotificationService
+	claimTimeout        time.Duration
+}
+
+func NewReminderDispatcher(
+	apptRepo repository.AppointmentRepository,
+	reminderRepo repository.AppointmentReminderRepository,
+	contactService *service.CustomerContactService,
+	notificationService service.NotificationService,
+	claimTimeout time.Duration,
+) *ReminderDispatcher {
+	return &ReminderDispatcher{
+		apptRepo:            apptRepo,
+		reminderRepo:        reminderRepo,
+		contactService:      contactService,
+		notificationService: notificationService,
+		claimTimeout:        claimTimeout,
+	}
+}
+
+func (d *ReminderDispatcher) Name() string { return "appointment-reminder-dispatcher" }
+
+func (d *ReminderDispatcher) Run(ctx context.Context) error {
+	now := time.Now()
+	spec := specification.ApptByStatus(enum.AppointmentStatusConfirmed).
+		And(specification.ApptByDateRange(now, now.Add(ReminderWindow))).
+		WithPagination(specification.Pagination{Limit: math.MaxInt32})
+
+	appointmentPage, err := d.apptRepo.Find(ctx, spec)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, appointment := range appointmentPage.Items {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if !appointment.RequiresReminder() {
+			continue
+		}
+
+		if err := d.remind(ctx, appointment); err != nil {
+			failed++
+			log.Error("failed to send appointment reminder", err, zap.String(log.EntityIDKey, appointment.ID().String()))
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d appointment reminders could not be sent", failed)
+	}
+	return nil
+}
+
+func (d *ReminderDispatcher) remind(ctx context.Context, appointment appt.Appointment) error {
+	claimed, err := d.reminderRepo.Claim(ctx, appointment.ID(), appointment.ScheduledDate(), d.claimTimeout)
+	if err != nil || !claimed {
+		return err
+	}
+
+	channel, err := d.send(ctx, appointment)
+	if err != nil {
+		if releaseErr := d.reminderRepo.Release(ctx, appointment.ID(), appointment.ScheduledDate()); releaseErr != nil {
+			return errors.Join(err, releaseErr)
+		}
+		return err
+	}
+
+	return d.reminderRepo.MarkSent(ctx, appointment.ID(), appointment.ScheduledDate(), channel)
+}
+
+// send notifies the customer over the preferred channel resolved by the contact service
+func (d *ReminderDispatcher) send(ctx context.Context, appointment appt.Appointment) (enum.NotificationChannel, error) {
+	contact, err := d.contactService.Find(ctx, appointment.CustomerID())
+	if err != nil {
+		return "", err
+	}
+
+	reminder := notification.NewAppointmentReminder(
+		contact.UserID, contact.Channel, contact.Email, contact.Phone,
+		contact.Name, appointment.Service(), appointment.ScheduledDate(),
+	)
+
+	if err := d.notificationService.Send(ctx, reminder); err != nil {
+		return "", err
+	}
+
+	return contact.Channel, nil
+}
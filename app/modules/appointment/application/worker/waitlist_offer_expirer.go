@@ -0,0 +1,23 @@
+package worker
+
+import (
+	"context"
+
+	"clinic-vet-api/app/modules/core/service"
+)
+
+// WaitlistOfferExpirer expires the waitlist offers that were not claimed in time and offers
+// their slots to the next customer in line
+type WaitlistOfferExpirer struct {
+	offerService *service.WaitlistOfferService
+}
+
+func NewWaitlistOfferExpirer(offerService *service.WaitlistOfferService) *WaitlistOfferExpirer {
+	return &WaitlistOfferExpirer{offerService: offerService}
+}
+
+func (e *WaitlistOfferExpirer) Name() string { return "appointment-waitlist-offer-expirer" }
+
+func (e *WaitlistOfferExpirer) Run(ctx context.Context) error {
+	return e.offerService.ExpireOffers(ctx)
+}
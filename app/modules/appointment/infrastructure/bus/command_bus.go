@@ -39,6 +39,10 @@ func (b *ApptCmdBus) ConfirmAppointment(ctx context.Context, cmd cmd.ConfirmAppt
 	return b.apptHandler.HandleConfirm(ctx, cmd)
 }
 
+func (b *ApptCmdBus) ConfirmProposal(ctx context.Context, cmd cmd.ConfirmProposalCommand) icqrs.CommandResult {
+	return b.apptHandler.HandleConfirmProposal(ctx, cmd)
+}
+
 func (b *ApptCmdBus) MarkAppointmentAsNotAttend(ctx context.Context, cmd cmd.NotAttendApptCommand) icqrs.CommandResult {
 	return b.apptHandler.HandleMarkAsNotAttend(ctx, cmd)
 }
@@ -50,3 +54,47 @@ func (b *ApptCmdBus) CompleteAppointment(ctx context.Context, cmd cmd.CompleteAp
 func (b *ApptCmdBus) UpdateAppointment(ctx context.Context, cmd cmd.UpdateApptCommand) icqrs.CommandResult {
 	return b.apptHandler.HandleUpdate(ctx, cmd)
 }
+
+func (b *ApptCmdBus) JoinWaitlist(ctx context.Context, cmd cmd.JoinWaitlistCommand) icqrs.CommandResult {
+	return b.apptHandler.HandleJoinWaitlist(ctx, cmd)
+}
+
+func (b *ApptCmdBus) LeaveWaitlist(ctx context.Context, cmd cmd.LeaveWaitlistCommand) icqrs.CommandResult {
+	return b.apptHandler.HandleLeaveWaitlist(ctx, cmd)
+}
+
+func (b *ApptCmdBus) ClaimWaitlistSlot(ctx context.Context, cmd cmd.ClaimWaitlistSlotCommand) icqrs.CommandResult {
+	return b.apptHandler.HandleClaimWaitlistSlot(ctx, cmd)
+}
+
+func (b *ApptCmdBus) CreateSeries(ctx context.Context, cmd cmd.CreateApptSeriesCommand) icqrs.CommandResult {
+	return b.apptHandler.HandleCreateSeries(ctx, cmd)
+}
+
+func (b *ApptCmdBus) CancelSeries(ctx context.Context, cmd cmd.CancelApptSeriesCommand) icqrs.CommandResult {
+	return b.apptHandler.HandleCancelSeries(ctx, cmd)
+}
+
+func (b *ApptCmdBus) RescheduleSeries(ctx context.Context, cmd cmd.RescheduleApptSeriesCommand) icqrs.CommandResult {
+	return b.apptHandler.HandleRescheduleSeries(ctx, cmd)
+}
+
+func (b *ApptCmdBus) IssueCalendarFeed(ctx context.Context, cmd cmd.IssueCalendarFeedCommand) icqrs.CommandResult {
+	return b.apptHandler.HandleIssueCalendarFeed(ctx, cmd)
+}
+
+func (b *ApptCmdBus) RevokeCalendarFeed(ctx context.Context, cmd cmd.RevokeCalendarFeedCommand) icqrs.CommandResult {
+	return b.apptHandler.HandleRevokeCalendarFeed(ctx, cmd)
+}
+
+func (b *ApptCmdBus) CreateEmergency(ctx context.Context, cmd cmd.CreateEmergencyApptCommand) icqrs.CommandResult {
+	return b.apptHandler.HandleCreateEmergency(ctx, cmd)
+}
+
+func (b *ApptCmdBus) RegisterAbsence(ctx context.Context, cmd cmd.RegisterAbsenceCommand) icqrs.CommandResult {
+	return b.apptHandler.HandleRegisterAbsence(ctx, cmd)
+}
+
+func (b *ApptCmdBus) AdvanceVisit(ctx context.Context, cmd cmd.AdvanceVisitCommand) icqrs.CommandResult {
+	return b.apptHandler.HandleAdvanceVisit(ctx, cmd)
+}
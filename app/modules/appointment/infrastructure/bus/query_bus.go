@@ -40,3 +40,31 @@ func (b *ApptQueryBus) FindByEmployeeID(ctx context.Context, qry q.FindApptsByEm
 func (b *ApptQueryBus) FindByPetID(ctx context.Context, qry q.FindApptsByPetQuery) (p.Page[h.ApptResult], error) {
 	return b.queryHandler.HandleByPetID(ctx, qry)
 }
+
+func (b *ApptQueryBus) FindAvailability(ctx context.Context, qry q.FindApptAvailabilityQuery) ([]h.ApptAvailabilityResult, error) {
+	return b.queryHandler.HandleAvailability(ctx, qry)
+}
+
+func (b *ApptQueryBus) FindWaitlistByCustomer(ctx context.Context, qry q.FindWaitlistByCustomerQuery) ([]h.WaitlistEntryResult, error) {
+	return b.queryHandler.HandleWaitlistByCustomer(ctx, qry)
+}
+
+func (b *ApptQueryBus) FindSeriesByID(ctx context.Context, qry q.FindApptSeriesByIDQuery) (h.ApptSeriesResult, error) {
+	return b.queryHandler.HandleSeriesByID(ctx, qry)
+}
+
+func (b *ApptQueryBus) FindCalendarFeed(ctx context.Context, qry q.FindCalendarFeedQuery) (h.CalendarFeedResult, error) {
+	return b.queryHandler.HandleCalendarFeed(ctx, qry)
+}
+
+func (b *ApptQueryBus) FindCalendarFeedEvents(ctx context.Context, qry q.FindCalendarFeedEventsQuery) (h.CalendarFeedEventsResult, error) {
+	return b.queryHandler.HandleCalendarFeedEvents(ctx, qry)
+}
+
+func (b *ApptQueryBus) GetStats(ctx context.Context, qry q.GetAppointmentStatsQuery) (h.ApptStatsResult, error) {
+	return b.queryHandler.HandleStats(ctx, qry)
+}
+
+func (b *ApptQueryBus) GetWaitingRoomQueue(ctx context.Context, qry q.GetWaitingRoomQueueQuery) (h.WaitingRoomQueueResult, error) {
+	return b.queryHandler.HandleWaitingRoomQueue(ctx, qry)
+}
@@ -14,8 +14,21 @@ const (
 	OpCount  = "count"
 	OpSearch = "search"
 
-	TableAppts = "appointments"
-	DriverSQL  = "sql"
+	TableAppts     = "appointments"
+	TableReminders = "appointment_reminders"
+	TableWaitlist  = "appointment_waitlist"
+	TableSeries    = "appointment_series"
+	TableFeeds     = "calendar_feeds"
+	TableSessions  = "medical_sessions"
+	TableFollowUps = "medical_session_follow_ups"
+
+	TableResources    = "clinic_resources"
+	TableRequirements = "service_resource_requirements"
+	TableReservations = "appointment_resource_reservations"
+	TableExceptions   = "employee_schedule_exceptions"
+	TableEmployees    = "employees"
+	TablePets         = "pets"
+	DriverSQL         = "sql"
 )
 
 const (
@@ -28,6 +41,44 @@ const (
 	ErrMsgDeleteAppt      = "failed to delete appointment"
 	ErrMsgConvertToDomain = "failed to convert to domain entity"
 	ErrMsgNotFound        = "appointment not found"
+	ErrMsgApptStats       = "failed to compute appointment statistics"
+	ErrMsgListCheckedIn   = "failed to list checked-in appointments"
+
+	ErrMsgClaimReminder    = "failed to claim appointment reminder"
+	ErrMsgMarkReminderSent = "failed to mark appointment reminder as sent"
+	ErrMsgReleaseReminder  = "failed to release appointment reminder"
+
+	ErrMsgGetWaitlistEntry    = "failed to get waitlist entry"
+	ErrMsgListWaitlist        = "failed to list waitlist entries"
+	ErrMsgCreateWaitlistEntry = "failed to create waitlist entry"
+	ErrMsgUpdateWaitlistEntry = "failed to update waitlist entry"
+	ErrMsgExpireWaitlistOffer = "failed to expire waitlist offer"
+
+	ErrMsgGetSeries       = "failed to get appointment series"
+	ErrMsgCreateSeries    = "failed to create appointment series"
+	ErrMsgListOccurrences = "failed to list series occurrences"
+	ErrMsgSaveOccurrences = "failed to save series occurrences"
+
+	ErrMsgGetCalendarFeed    = "failed to get calendar feed"
+	ErrMsgCreateCalendarFeed = "failed to create calendar feed"
+	ErrMsgRevokeCalendarFeed = "failed to revoke calendar feed"
+
+	ErrMsgOpenMedicalSession  = "failed to open medical session"
+	ErrMsgFindMedicalSession  = "failed to find medical session"
+	ErrMsgCloseMedicalSession = "failed to close medical session"
+	ErrMsgUpdateFollowUpDate  = "failed to update the follow-up date of the medical session"
+	ErrMsgFindFollowUp        = "failed to find the follow-up appointment of the medical session"
+	ErrMsgLinkFollowUp        = "failed to link the follow-up appointment to the medical session"
+
+	ErrMsgListRequirements    = "failed to list service resource requirements"
+	ErrMsgLockResources       = "failed to lock clinic resources"
+	ErrMsgListBusyResources   = "failed to list busy clinic resources"
+	ErrMsgCreateReservation   = "failed to reserve clinic resource"
+	ErrMsgReleaseReservations = "failed to release clinic resource reservations"
+	ErrMsgSaveTimeOff         = "failed to save the time off of the absent employee"
+
+	ErrMsgLockSchedule           = "failed to lock the schedule of the appointment"
+	ErrMsgListConflictCandidates = "failed to list the appointments the booking could overlap"
 )
 
 // dbError creates a standardized database operation error
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/employee"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/database"
+	"clinic-vet-api/app/shared/mapper"
+	"clinic-vet-api/sqlc"
+)
+
+type SqlcAbsenceCoverageRepository struct {
+	transactor *database.Transactor
+	pgMap      *mapper.SqlcFieldMapper
+}
+
+func NewSqlcAbsenceCoverageRepository(transactor *database.Transactor) repository.AbsenceCoverageRepository {
+	return &SqlcAbsenceCoverageRepository{
+		transactor: transactor,
+		pgMap:      mapper.NewSqlcFieldMapper(),
+	}
+}
+
+// SaveCoverage saves the time off before the appointments. New time off is inserted and then
+// approved, IDs are only assigned once the transaction is committed
+func (r *SqlcAbsenceCoverageRepository) SaveCoverage(ctx context.Context, timeOff *employee.ScheduleException, appointments []appt.Appointment) error {
+	createdIDs := make(map[int]valueobject.AppointmentID)
+	timeOffID := timeOff.ID()
+
+	err := r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+		if timeOffID.IsZero() {
+			created, err := queries.CreateScheduleException(ctx, sqlc.CreateScheduleExceptionParams{
+				EmployeeID:    timeOff.EmployeeID().Int32(),
+				ExceptionType: timeOff.Type().String(),
+				StartDate:     r.pgMap.PgTimestamptz.FromTime(timeOff.StartDate()),
+				EndDate:       r.pgMap.PgTimestamptz.FromTime(timeOff.EndDate()),
+				Reason:        timeOff.Reason(),
+				Status:        timeOff.Status().String(),
+			})
+			if err != nil {
+				return reservationDBError(TableExceptions, OpInsert, ErrMsgSaveTimeOff, err)
+			}
+			timeOffID = valueobject.NewScheduleExcID(uint(created.ID))
+		}
+
+		if err := queries.UpdateScheduleExceptionStatus(ctx, sqlc.UpdateScheduleExceptionStatusParams{
+			ID:          timeOffID.Int32(),
+			Status:      timeOff.Status().String(),
+			ReviewedBy:  r.pgMap.PgInt4.FromUserIDPtr(timeOff.ReviewedBy()),
+			ReviewNotes: r.pgMap.PgText.FromStringPtr(timeOff.ReviewNotes()),
+			ReviewedAt:  r.pgMap.PgTimestamptz.FromTimePtr(timeOff.ReviewedAt()),
+		}); err != nil {
+			return reservationDBError(TableExceptions, OpUpdate, ErrMsgSaveTimeOff, err)
+		}
+
+		return saveAppointments(ctx, queries, r.pgMap, appointments, createdIDs)
+	})
+	if err != nil {
+		return err
+	}
+
+	timeOff.SetID(timeOffID)
+	for i, id := range createdIDs {
+		appointments[i].SetID(id)
+	}
+	return nil
+}
@@ -126,11 +126,12 @@ func (r *SqlcAppointmentRepository) Delete(ctx context.Context, id valueobject.A
 func (r *SqlcAppointmentRepository) create(ctx context.Context, appointment *appt.Appointment) error {
 	params := appointmentToCreateParams(appointment)
 
-	_, err := r.queries.CreateAppointment(ctx, params)
+	row, err := r.queries.CreateAppointment(ctx, params)
 	if err != nil {
 		return r.dbError("insert", "failed to create appointment", err)
 	}
 
+	appointment.SetID(valueobject.NewAppointmentID(uint(row.ID)))
 	return nil
 }
 
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/sqlc"
+)
+
+// Stats runs one aggregate query per breakdown, every count is computed by the database
+func (r *SqlcAppointmentRepository) Stats(ctx context.Context, filter appt.StatsFilter) (appt.Stats, error) {
+	var employeeID int32
+	if filter.EmployeeID != nil {
+		employeeID = filter.EmployeeID.Int32()
+	}
+	startDate := r.pgMap.PgTimestamptz.FromTime(filter.StartDate)
+	endDate := r.pgMap.PgTimestamptz.FromTime(filter.EndDate)
+
+	statusRows, err := r.queries.GetAppointmentStatusCounts(ctx, sqlc.GetAppointmentStatusCountsParams{
+		StartDate: startDate, EndDate: endDate, EmployeeID: employeeID,
+	})
+	if err != nil {
+		return appt.Stats{}, r.dbError(OpSelect, ErrMsgApptStats, err)
+	}
+
+	serviceRows, err := r.queries.GetAppointmentServiceStats(ctx, sqlc.GetAppointmentServiceStatsParams{
+		StartDate: startDate, EndDate: endDate, EmployeeID: employeeID,
+	})
+	if err != nil {
+		return appt.Stats{}, r.dbError(OpSelect, ErrMsgApptStats, err)
+	}
+
+	weekdayRows, err := r.queries.GetAppointmentWeekdayCounts(ctx, sqlc.GetAppointmentWeekdayCountsParams{
+		StartDate: startDate, EndDate: endDate, EmployeeID: employeeID,
+	})
+	if err != nil {
+		return appt.Stats{}, r.dbError(OpSelect, ErrMsgApptStats, err)
+	}
+
+	hourRows, err := r.queries.GetAppointmentHourCounts(ctx, sqlc.GetAppointmentHourCountsParams{
+		StartDate: startDate, EndDate: endDate, EmployeeID: employeeID,
+	})
+	if err != nil {
+		return appt.Stats{}, r.dbError(OpSelect, ErrMsgApptStats, err)
+	}
+
+	avgLeadSeconds, err := r.queries.GetAppointmentAverageLeadTime(ctx, sqlc.GetAppointmentAverageLeadTimeParams{
+		StartDate: startDate, EndDate: endDate, EmployeeID: employeeID,
+	})
+	if err != nil {
+		return appt.Stats{}, r.dbError(OpSelect, ErrMsgApptStats, err)
+	}
+
+	stats := appt.Stats{
+		ByStatus:    make(map[enum.AppointmentStatus]int, len(statusRows)),
+		ByService:   make([]appt.ServiceStats, len(serviceRows)),
+		ByWeekday:   make([]appt.WeekdayCount, len(weekdayRows)),
+		ByHour:      make([]appt.HourCount, len(hourRows)),
+		AvgLeadTime: time.Duration(avgLeadSeconds * float64(time.Second)),
+	}
+
+	for _, row := range statusRows {
+		stats.ByStatus[enum.AppointmentStatus(row.Status)] = int(row.Total)
+		stats.Total += int(row.Total)
+	}
+
+	for i, row := range serviceRows {
+		stats.ByService[i] = appt.ServiceStats{
+			Service:   enum.ClinicService(row.ClinicService),
+			Total:     int(row.Total),
+			Completed: int(row.Completed),
+			NoShows:   int(row.NoShows),
+			Cancelled: int(row.Cancelled),
+		}
+	}
+
+	for i, row := range weekdayRows {
+		stats.ByWeekday[i] = appt.WeekdayCount{Weekday: time.Weekday(row.Weekday), Count: int(row.Total)}
+	}
+
+	for i, row := range hourRows {
+		stats.ByHour[i] = appt.HourCount{Hour: int(row.Hour), Count: int(row.Total)}
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"clinic-vet-api/app/modules/core/domain/entity/calendarfeed"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/database"
+	dberr "clinic-vet-api/app/shared/error/infrastructure/database"
+	"clinic-vet-api/app/shared/mapper"
+	"clinic-vet-api/sqlc"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type SqlcCalendarFeedRepository struct {
+	queries    *sqlc.Queries
+	transactor *database.Transactor
+	pgMap      *mapper.SqlcFieldMapper
+}
+
+func NewSqlcCalendarFeedRepository(queries *sqlc.Queries, transactor *database.Transactor) repository.CalendarFeedRepository {
+	return &SqlcCalendarFeedRepository{
+		queries:    queries,
+		transactor: transactor,
+		pgMap:      mapper.NewSqlcFieldMapper(),
+	}
+}
+
+func (r *SqlcCalendarFeedRepository) FindByID(ctx context.Context, id valueobject.CalendarFeedID) (calendarfeed.CalendarFeed, error) {
+	row, err := r.queries.FindCalendarFeedByID(ctx, id.Int32())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return calendarfeed.CalendarFeed{}, r.notFoundError("id", id.String())
+		}
+		return calendarfeed.CalendarFeed{}, r.dbError(OpSelect, ErrMsgGetCalendarFeed, err)
+	}
+
+	return *r.toEntity(row), nil
+}
+
+func (r *SqlcCalendarFeedRepository) FindActiveByOwner(ctx context.Context, ownerType enum.CalendarFeedOwner, ownerID uint) (calendarfeed.CalendarFeed, error) {
+	row, err := r.queries.FindActiveCalendarFeedByOwner(ctx, sqlc.FindActiveCalendarFeedByOwnerParams{
+		OwnerType: ownerType.String(),
+		OwnerID:   int32(ownerID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return calendarfeed.CalendarFeed{}, r.notFoundError(ownerType.String()+"_id", fmt.Sprintf("%d", ownerID))
+		}
+		return calendarfeed.CalendarFeed{}, r.dbError(OpSelect, ErrMsgGetCalendarFeed, err)
+	}
+
+	return *r.toEntity(row), nil
+}
+
+func (r *SqlcCalendarFeedRepository) Replace(ctx context.Context, feed *calendarfeed.CalendarFeed) error {
+	var created sqlc.CalendarFeed
+
+	err := r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+		if _, err := queries.RevokeCalendarFeedsByOwner(ctx, sqlc.RevokeCalendarFeedsByOwnerParams{
+			OwnerType: feed.OwnerType().String(),
+			OwnerID:   int32(feed.OwnerID()),
+		}); err != nil {
+			return r.dbError(OpUpdate, ErrMsgRevokeCalendarFeed, err)
+		}
+
+		row, err := queries.CreateCalendarFeed(ctx, sqlc.CreateCalendarFeedParams{
+			OwnerType: feed.OwnerType().String(),
+			OwnerID:   int32(feed.OwnerID()),
+		})
+		if err != nil {
+			return r.dbError(OpInsert, ErrMsgCreateCalendarFeed, err)
+		}
+		created = row
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	feed.SetID(valueobject.NewCalendarFeedID(uint(created.ID)))
+	feed.SetTimeStamps(created.CreatedAt.Time, created.CreatedAt.Time)
+	return nil
+}
+
+func (r *SqlcCalendarFeedRepository) RevokeByOwner(ctx context.Context, ownerType enum.CalendarFeedOwner, ownerID uint) (bool, error) {
+	rowsAffected, err := r.queries.RevokeCalendarFeedsByOwner(ctx, sqlc.RevokeCalendarFeedsByOwnerParams{
+		OwnerType: ownerType.String(),
+		OwnerID:   int32(ownerID),
+	})
+	if err != nil {
+		return false, r.dbError(OpUpdate, ErrMsgRevokeCalendarFeed, err)
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *SqlcCalendarFeedRepository) toEntity(row sqlc.CalendarFeed) *calendarfeed.CalendarFeed {
+	return calendarfeed.NewCalendarFeedBuilder().
+		WithID(valueobject.NewCalendarFeedID(uint(row.ID))).
+		WithOwner(enum.CalendarFeedOwner(row.OwnerType), uint(row.OwnerID)).
+		WithRevokedAt(r.pgMap.PgTimestamptz.ToTimePtr(row.RevokedAt)).
+		WithCreatedAt(row.CreatedAt.Time).
+		Build()
+}
+
+func (r *SqlcCalendarFeedRepository) dbError(operation, message string, err error) error {
+	return dberr.DatabaseOperationError(operation, TableFeeds, DriverSQL, fmt.Errorf("%s: %v", message, err))
+}
+
+func (r *SqlcCalendarFeedRepository) notFoundError(parameterName, parameterValue string) error {
+	return dberr.EntityNotFoundError(parameterName, parameterValue, OpSelect, TableFeeds, DriverSQL)
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
 	"clinic-vet-api/app/modules/core/domain/enum"
 	"clinic-vet-api/app/modules/core/domain/valueobject"
@@ -22,6 +24,12 @@ func sqlcToEntity(row sqlc.Appointment) *appt.Appointment {
 		notes = &row.Notes.String
 	}
 
+	var seriesID *valueobject.ApptSeriesID
+	if row.SeriesID.Valid {
+		seriesIDValue := valueobject.NewApptSeriesID(uint(row.SeriesID.Int32))
+		seriesID = &seriesIDValue
+	}
+
 	return appt.NewAppointmentBuilder().
 		WithID(valueobject.NewAppointmentID(uint(row.ID))).
 		WithPetID(valueobject.NewPetID(uint(row.PetID))).
@@ -31,6 +39,10 @@ func sqlcToEntity(row sqlc.Appointment) *appt.Appointment {
 		WithScheduledDate(row.ScheduledDate.Time).
 		WithService(enum.ClinicService(row.ClinicService)).
 		WithNotes(notes).
+		WithSeriesID(seriesID).
+		WithSequence(int(row.Sequence)).
+		WithEmergency(row.IsEmergency, toTriagePriority(row.TriagePriority)).
+		WithVisitProgress(toVisitProgress(row.VisitStage, row.CheckedInAt, row.InExamRoomAt, row.ReadyForCheckoutAt)).
 		WithTimestamps(row.CreatedAt.Time, row.UpdatedAt.Time).
 		Build()
 }
@@ -62,19 +74,49 @@ func appointmentToCreateParams(appointment *appt.Appointment) sqlc.CreateAppoint
 		params.Notes = pgtype.Text{Valid: false}
 	}
 
+	if appointment.SeriesID() != nil {
+		params.SeriesID = pgtype.Int4{Int32: appointment.SeriesID().Int32(), Valid: true}
+	}
+
+	params.IsEmergency = appointment.IsEmergency()
+	params.TriagePriority = fromTriagePriority(appointment.TriagePriority())
+
+	visit := appointment.VisitProgress()
+	params.VisitStage = fromVisitStage(visit.Stage)
+	params.CheckedInAt = optTimestamptz(visit.CheckedInAt)
+	params.InExamRoomAt = optTimestamptz(visit.InExamRoomAt)
+	params.ReadyForCheckoutAt = optTimestamptz(visit.ReadyForCheckoutAt)
+
 	return params
 }
 
 func appointmentToUpdateParams(appointment *appt.Appointment) sqlc.UpdateAppointmentParams {
-	return sqlc.UpdateAppointmentParams{
+	params := sqlc.UpdateAppointmentParams{
 		ID:            int32(appointment.ID().Value()),
+		ClinicService: models.ClinicService(appointment.Service().String()),
 		CustomerID:    int32(appointment.CustomerID().Value()),
-		EmployeeID:    pgtype.Int4{Int32: int32(appointment.EmployeeID().Value()), Valid: appointment.EmployeeID().IsZero()},
 		PetID:         int32(appointment.PetID().Value()),
 		ScheduledDate: pgtype.Timestamptz{Time: appointment.ScheduledDate(), Valid: true},
-		Notes:         pgtype.Text{String: *appointment.Notes(), Valid: appointment.Notes() != nil},
 		Status:        models.AppointmentStatus(string(appointment.Status())),
 	}
+
+	if appointment.EmployeeID() != nil {
+		params.EmployeeID = pgtype.Int4{Int32: int32(appointment.EmployeeID().Value()), Valid: true}
+	}
+
+	if appointment.Notes() != nil {
+		params.Notes = pgtype.Text{String: *appointment.Notes(), Valid: true}
+	}
+
+	params.TriagePriority = fromTriagePriority(appointment.TriagePriority())
+
+	visit := appointment.VisitProgress()
+	params.VisitStage = fromVisitStage(visit.Stage)
+	params.CheckedInAt = optTimestamptz(visit.CheckedInAt)
+	params.InExamRoomAt = optTimestamptz(visit.InExamRoomAt)
+	params.ReadyForCheckoutAt = optTimestamptz(visit.ReadyForCheckoutAt)
+
+	return params
 }
 
 func toEntity(row sqlc.FindAppointmentsBySpecRow) appt.Appointment {
@@ -89,6 +131,12 @@ func toEntity(row sqlc.FindAppointmentsBySpecRow) appt.Appointment {
 		notes = &row.Notes.String
 	}
 
+	var seriesID *valueobject.ApptSeriesID
+	if row.SeriesID.Valid {
+		seriesIDObj := valueobject.NewApptSeriesID(uint(row.SeriesID.Int32))
+		seriesID = &seriesIDObj
+	}
+
 	appt := appt.NewAppointmentBuilder().
 		WithID(valueobject.NewAppointmentID(uint(row.ID))).
 		WithPetID(valueobject.NewPetID(uint(row.PetID))).
@@ -96,7 +144,12 @@ func toEntity(row sqlc.FindAppointmentsBySpecRow) appt.Appointment {
 		WithEmployeeID(vetID).
 		WithStatus(enum.AppointmentStatus(row.Status)).
 		WithScheduledDate(row.ScheduledDate.Time).
+		WithService(enum.ClinicService(row.ClinicService)).
 		WithNotes(notes).
+		WithSeriesID(seriesID).
+		WithSequence(int(row.Sequence)).
+		WithEmergency(row.IsEmergency, toTriagePriority(row.TriagePriority)).
+		WithVisitProgress(toVisitProgress(row.VisitStage, row.CheckedInAt, row.InExamRoomAt, row.ReadyForCheckoutAt)).
 		WithTimestamps(row.CreatedAt.Time, row.UpdatedAt.Time).
 		Build()
 
@@ -114,3 +167,52 @@ func toEntities(rows []sqlc.FindAppointmentsBySpecRow) []appt.Appointment {
 	}
 	return appointments
 }
+
+func toTriagePriority(value pgtype.Text) *enum.TriagePriority {
+	if !value.Valid {
+		return nil
+	}
+	priority := enum.TriagePriority(value.String)
+	return &priority
+}
+
+func fromTriagePriority(priority *enum.TriagePriority) pgtype.Text {
+	if priority == nil {
+		return pgtype.Text{Valid: false}
+	}
+	return pgtype.Text{String: priority.String(), Valid: true}
+}
+
+func toVisitProgress(stage pgtype.Text, checkedInAt, inExamRoomAt, readyForCheckoutAt pgtype.Timestamptz) appt.VisitProgress {
+	progress := appt.VisitProgress{
+		CheckedInAt:        optTime(checkedInAt),
+		InExamRoomAt:       optTime(inExamRoomAt),
+		ReadyForCheckoutAt: optTime(readyForCheckoutAt),
+	}
+	if stage.Valid {
+		visitStage := enum.VisitStage(stage.String)
+		progress.Stage = &visitStage
+	}
+	return progress
+}
+
+func fromVisitStage(stage *enum.VisitStage) pgtype.Text {
+	if stage == nil {
+		return pgtype.Text{Valid: false}
+	}
+	return pgtype.Text{String: stage.String(), Valid: true}
+}
+
+func optTime(value pgtype.Timestamptz) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Time
+}
+
+func optTimestamptz(value *time.Time) pgtype.Timestamptz {
+	if value == nil {
+		return pgtype.Timestamptz{Valid: false}
+	}
+	return pgtype.Timestamptz{Time: *value, Valid: true}
+}
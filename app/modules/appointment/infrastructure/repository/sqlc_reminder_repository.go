@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	dberr "clinic-vet-api/app/shared/error/infrastructure/database"
+	"clinic-vet-api/app/shared/mapper"
+	"clinic-vet-api/sqlc"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type SqlcReminderRepository struct {
+	queries *sqlc.Queries
+	pgMap   *mapper.SqlcFieldMapper
+}
+
+func NewSqlcReminderRepository(queries *sqlc.Queries) repository.AppointmentReminderRepository {
+	return &SqlcReminderRepository{
+		queries: queries,
+		pgMap:   mapper.NewSqlcFieldMapper(),
+	}
+}
+
+// Claim relies on the primary key of appointment_reminders: the insert only succeeds for one
+// replica and a conflicting row is taken over only when its claim is stale and was never sent
+func (r *SqlcReminderRepository) Claim(
+	ctx context.Context,
+	id valueobject.AppointmentID,
+	scheduledDate time.Time,
+	staleAfter time.Duration,
+) (bool, error) {
+	_, err := r.queries.ClaimAppointmentReminder(ctx, sqlc.ClaimAppointmentReminderParams{
+		AppointmentID: id.Int32(),
+		ScheduledDate: r.pgMap.PgTimestamptz.FromTime(scheduledDate),
+		ClaimedBefore: r.pgMap.PgTimestamptz.FromTime(time.Now().Add(-staleAfter)),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, r.dbError(OpInsert, ErrMsgClaimReminder, err)
+	}
+
+	return true, nil
+}
+
+func (r *SqlcReminderRepository) MarkSent(
+	ctx context.Context,
+	id valueobject.AppointmentID,
+	scheduledDate time.Time,
+	channel enum.NotificationChannel,
+) error {
+	err := r.queries.MarkAppointmentReminderSent(ctx, sqlc.MarkAppointmentReminderSentParams{
+		AppointmentID: id.Int32(),
+		ScheduledDate: r.pgMap.PgTimestamptz.FromTime(scheduledDate),
+		Channel:       r.pgMap.PgText.FromString(channel.String()),
+	})
+	if err != nil {
+		return r.dbError(OpUpdate, ErrMsgMarkReminderSent, err)
+	}
+	return nil
+}
+
+func (r *SqlcReminderRepository) Release(ctx context.Context, id valueobject.AppointmentID, scheduledDate time.Time) error {
+	err := r.queries.ReleaseAppointmentReminder(ctx, sqlc.ReleaseAppointmentReminderParams{
+		AppointmentID: id.Int32(),
+		ScheduledDate: r.pgMap.PgTimestamptz.FromTime(scheduledDate),
+	})
+	if err != nil {
+		return r.dbError(OpDelete, ErrMsgReleaseReminder, err)
+	}
+	return nil
+}
+
+func (r *SqlcReminderRepository) dbError(operation, message string, err error) error {
+	return dberr.DatabaseOperationError(operation, TableReminders, DriverSQL, fmt.Errorf("%s: %v", message, err))
+}
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/resource"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/database"
+	dberr "clinic-vet-api/app/shared/error/infrastructure/database"
+	"clinic-vet-api/app/shared/mapper"
+	"clinic-vet-api/db/models"
+	"clinic-vet-api/sqlc"
+)
+
+type SqlcReservationRepository struct {
+	transactor *database.Transactor
+	pgMap      *mapper.SqlcFieldMapper
+}
+
+func NewSqlcReservationRepository(transactor *database.Transactor) repository.AppointmentReservationRepository {
+	return &SqlcReservationRepository{
+		transactor: transactor,
+		pgMap:      mapper.NewSqlcFieldMapper(),
+	}
+}
+
+// SaveWithReservations drops the previous reservations of every appointment and allocates the
+// resources again for its current slot. New appointments are inserted and get their IDs once
+// the transaction is committed. Candidate resources are locked so concurrent bookings of the
+// same room are serialized instead of both succeeding
+func (r *SqlcReservationRepository) SaveWithReservations(ctx context.Context, appointments []appt.Appointment) error {
+	createdIDs := make(map[int]valueobject.AppointmentID)
+
+	err := r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+		return saveAppointments(ctx, queries, r.pgMap, appointments, createdIDs)
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, id := range createdIDs {
+		appointments[i].SetID(id)
+	}
+	return nil
+}
+
+// saveAppointments checks the appointments against the schedule, creates or updates them and
+// reserves their resources again within the transaction of the caller, recording the IDs of the
+// inserted ones by position
+func saveAppointments(ctx context.Context, queries *sqlc.Queries, pgMap *mapper.SqlcFieldMapper, appointments []appt.Appointment, createdIDs map[int]valueobject.AppointmentID) error {
+	batchIDs := make([]valueobject.AppointmentID, 0, len(appointments))
+	for _, appointment := range appointments {
+		if !appointment.ID().IsZero() {
+			batchIDs = append(batchIDs, appointment.ID())
+		}
+	}
+
+	for i := range appointments {
+		appointment := appointments[i]
+
+		if err := ensureNoConflict(ctx, queries, pgMap, &appointment, batchIDs); err != nil {
+			return err
+		}
+
+		if appointment.ID().IsZero() {
+			created, err := queries.CreateAppointment(ctx, appointmentToCreateParams(&appointment))
+			if err != nil {
+				return reservationDBError(TableAppts, OpInsert, ErrMsgCreateAppt, err)
+			}
+			appointment.SetID(valueobject.NewAppointmentID(uint(created.ID)))
+			createdIDs[i] = appointment.ID()
+		} else {
+			if _, err := queries.UpdateAppointment(ctx, appointmentToUpdateParams(&appointment)); err != nil {
+				return reservationDBError(TableAppts, OpUpdate, fmt.Sprintf("%s with ID %d", ErrMsgUpdateAppt, appointment.ID().Value()), err)
+			}
+
+			if err := queries.DeleteAppointmentReservations(ctx, appointment.ID().Int32()); err != nil {
+				return reservationDBError(TableReservations, OpDelete, ErrMsgReleaseReservations, err)
+			}
+		}
+
+		if err := reserveResources(ctx, queries, pgMap, &appointment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReserveResourcesWithin allocates the rooms and equipment of an appointment saved by another
+// repository within the transaction the queries are bound to, so every booking path holds its
+// resources the same way
+func ReserveResourcesWithin(ctx context.Context, queries *sqlc.Queries, appointment *appt.Appointment) error {
+	return reserveResources(ctx, queries, mapper.NewSqlcFieldMapper(), appointment)
+}
+
+// reserveResources allocates the rooms and equipment the service of a saved appointment requires
+// for its slot, within the transaction of the caller. Appointments that do not hold resources
+// are left alone
+func reserveResources(ctx context.Context, queries *sqlc.Queries, pgMap *mapper.SqlcFieldMapper, appointment *appt.Appointment) error {
+	if !holdsResources(appointment) {
+		return nil
+	}
+
+	rows, err := queries.ListServiceResourceRequirements(ctx, models.ClinicService(appointment.Service().String()))
+	if err != nil {
+		return reservationDBError(TableRequirements, OpSelect, ErrMsgListRequirements, err)
+	}
+
+	start := pgMap.PgTimestamptz.FromTime(appointment.ScheduledDate())
+	end := pgMap.PgTimestamptz.FromTime(appointment.EndDate())
+
+	for _, row := range rows {
+		requirement := resource.ServiceRequirement{
+			Service:      appointment.Service(),
+			ResourceType: enum.ResourceType(row.ResourceType),
+			Quantity:     int(row.Quantity),
+		}
+
+		candidateRows, err := queries.LockActiveClinicResourcesByType(ctx, row.ResourceType)
+		if err != nil {
+			return reservationDBError(TableResources, OpSelect, ErrMsgLockResources, err)
+		}
+
+		candidates := make([]resource.ClinicResource, len(candidateRows))
+		candidateIDs := make([]int32, len(candidateRows))
+		for j, candidateRow := range candidateRows {
+			candidates[j] = *resource.NewClinicResourceBuilder().
+				WithID(valueobject.NewResourceID(uint(candidateRow.ID))).
+				WithName(candidateRow.Name).
+				WithResourceType(enum.ResourceType(candidateRow.ResourceType)).
+				WithIsActive(candidateRow.IsActive).
+				Build()
+			candidateIDs[j] = candidateRow.ID
+		}
+
+		busyIDs, err := queries.ListBusyClinicResourceIDs(ctx, sqlc.ListBusyClinicResourceIDsParams{
+			ResourceIds:   candidateIDs,
+			EndTime:       end,
+			StartTime:     start,
+			AppointmentID: appointment.ID().Int32(),
+		})
+		if err != nil {
+			return reservationDBError(TableReservations, OpSelect, ErrMsgListBusyResources, err)
+		}
+
+		busy := make(map[valueobject.ResourceID]bool, len(busyIDs))
+		for _, id := range busyIDs {
+			busy[valueobject.NewResourceID(uint(id))] = true
+		}
+
+		allocated, err := resource.Allocate(ctx, requirement, candidates, busy, appointment.ScheduledDate(), appointment.EndDate())
+		if err != nil {
+			return err
+		}
+
+		for _, allocatedResource := range allocated {
+			if err := queries.CreateResourceReservation(ctx, sqlc.CreateResourceReservationParams{
+				AppointmentID: appointment.ID().Int32(),
+				ResourceID:    allocatedResource.ID().Int32(),
+				StartTime:     start,
+				EndTime:       end,
+			}); err != nil {
+				return reservationDBError(TableReservations, OpInsert, ErrMsgCreateReservation, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// holdsResources tells whether the appointment keeps rooms and equipment booked. Emergencies
+// are seen in whatever room is free and never block scheduled visits
+func holdsResources(appointment *appt.Appointment) bool {
+	if appointment.IsEmergency() {
+		return false
+	}
+
+	switch appointment.Status() {
+	case enum.AppointmentStatusPending, enum.AppointmentStatusConfirmed, enum.AppointmentStatusRescheduled:
+		return true
+	default:
+		return false
+	}
+}
+
+func reservationDBError(table, operation, message string, err error) error {
+	return dberr.DatabaseOperationError(operation, table, DriverSQL, fmt.Errorf("%s: %v", message, err))
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"slices"
+
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/mapper"
+	"clinic-vet-api/sqlc"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// EnsureNoConflictWithin runs the schedule conflict check of an appointment saved by another
+// repository within the transaction the queries are bound to, before it is inserted
+func EnsureNoConflictWithin(ctx context.Context, queries *sqlc.Queries, appointment *appt.Appointment) error {
+	return ensureNoConflict(ctx, queries, mapper.NewSqlcFieldMapper(), appointment, nil)
+}
+
+// ensureNoConflict locks the rows of the employee and the pet of the appointment and then checks
+// it against their appointments, so two bookings of the same employee or pet saved at the same
+// time are serialized and the second one sees the first. The employee is always locked before
+// the pet. Emergencies are seen whenever they arrive and appointments that no longer take place
+// are left alone, ignored appointments are the ones saved in the same batch
+func ensureNoConflict(ctx context.Context, queries *sqlc.Queries, pgMap *mapper.SqlcFieldMapper, appointment *appt.Appointment, ignored []valueobject.AppointmentID) error {
+	if appointment.IsEmergency() || appointment.Status().IsFinalStatus() {
+		return nil
+	}
+
+	if appointment.EmployeeID() != nil {
+		if err := queries.LockEmployeeSchedule(ctx, appointment.EmployeeID().Int32()); err != nil {
+			return reservationDBError(TableEmployees, OpSelect, ErrMsgLockSchedule, err)
+		}
+	}
+
+	if err := queries.LockPetSchedule(ctx, appointment.PetID().Int32()); err != nil {
+		return reservationDBError(TablePets, OpSelect, ErrMsgLockSchedule, err)
+	}
+
+	var employeeID pgtype.Int4
+	if appointment.EmployeeID() != nil {
+		employeeID = pgtype.Int4{Int32: appointment.EmployeeID().Int32(), Valid: true}
+	}
+
+	rows, err := queries.FindScheduleConflictCandidates(ctx, sqlc.FindScheduleConflictCandidatesParams{
+		PetID:       appointment.PetID().Int32(),
+		EmployeeID:  employeeID,
+		WindowStart: pgMap.PgTimestamptz.FromTime(appointment.ScheduledDate().Add(-enum.LongestClinicServiceDuration())),
+		WindowEnd:   pgMap.PgTimestamptz.FromTime(appointment.EndDate()),
+	})
+	if err != nil {
+		return reservationDBError(TableAppts, OpSelect, ErrMsgListConflictCandidates, err)
+	}
+
+	others := make([]appt.Appointment, 0, len(rows))
+	for _, row := range rows {
+		other := sqlcToEntity(row)
+		if slices.Contains(ignored, other.ID()) {
+			continue
+		}
+		others = append(others, *other)
+	}
+
+	return appointment.EnsureNoScheduleConflict(ctx, others)
+}
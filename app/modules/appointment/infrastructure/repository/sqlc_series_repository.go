@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/database"
+	dberr "clinic-vet-api/app/shared/error/infrastructure/database"
+	"clinic-vet-api/app/shared/mapper"
+	"clinic-vet-api/db/models"
+	"clinic-vet-api/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type SqlcSeriesRepository struct {
+	queries    *sqlc.Queries
+	transactor *database.Transactor
+	pgMap      *mapper.SqlcFieldMapper
+}
+
+func NewSqlcSeriesRepository(queries *sqlc.Queries, transactor *database.Transactor) repository.AppointmentSeriesRepository {
+	return &SqlcSeriesRepository{
+		queries:    queries,
+		transactor: transactor,
+		pgMap:      mapper.NewSqlcFieldMapper(),
+	}
+}
+
+func (r *SqlcSeriesRepository) FindByID(ctx context.Context, id valueobject.ApptSeriesID) (appt.AppointmentSeries, error) {
+	row, err := r.queries.FindAppointmentSeriesByID(ctx, id.Int32())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return appt.AppointmentSeries{}, r.notFoundError("id", id.String())
+		}
+		return appt.AppointmentSeries{}, r.dbError(OpSelect, ErrMsgGetSeries, err)
+	}
+
+	return *r.toEntity(row), nil
+}
+
+func (r *SqlcSeriesRepository) FindOccurrences(ctx context.Context, id valueobject.ApptSeriesID) ([]appt.Appointment, error) {
+	rows, err := r.queries.FindAppointmentsBySeries(ctx, pgtype.Int4{Int32: id.Int32(), Valid: true})
+	if err != nil {
+		return nil, r.dbError(OpSelect, ErrMsgListOccurrences, err)
+	}
+
+	occurrences := make([]appt.Appointment, len(rows))
+	for i, row := range rows {
+		occurrences[i] = *sqlcToEntity(row)
+	}
+	return occurrences, nil
+}
+
+// Create inserts the series first so every occurrence can reference it, reserving the resources
+// of each occurrence on the way. IDs are only assigned to the entities once the transaction is
+// committed
+func (r *SqlcSeriesRepository) Create(ctx context.Context, series *appt.AppointmentSeries, occurrences []appt.Appointment) error {
+	var seriesID valueobject.ApptSeriesID
+	occurrenceIDs := make([]valueobject.AppointmentID, len(occurrences))
+
+	err := r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+		row, err := queries.CreateAppointmentSeries(ctx, r.toCreateParams(series))
+		if err != nil {
+			return r.dbError(OpInsert, ErrMsgCreateSeries, err)
+		}
+		seriesID = valueobject.NewApptSeriesID(uint(row.ID))
+
+		for i := range occurrences {
+			occurrence := occurrences[i]
+			occurrence.AttachToSeries(seriesID)
+
+			created, err := queries.CreateAppointment(ctx, appointmentToCreateParams(&occurrence))
+			if err != nil {
+				return r.dbError(OpInsert, ErrMsgCreateAppt, err)
+			}
+			occurrenceIDs[i] = valueobject.NewAppointmentID(uint(created.ID))
+			occurrence.SetID(occurrenceIDs[i])
+
+			if err := reserveResources(ctx, queries, r.pgMap, &occurrence); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	series.SetID(seriesID)
+	for i := range occurrences {
+		occurrences[i].AttachToSeries(seriesID)
+		occurrences[i].SetID(occurrenceIDs[i])
+	}
+	return nil
+}
+
+func (r *SqlcSeriesRepository) SaveOccurrences(ctx context.Context, occurrences []appt.Appointment) error {
+	return r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+		for i := range occurrences {
+			if _, err := queries.UpdateAppointment(ctx, appointmentToUpdateParams(&occurrences[i])); err != nil {
+				return r.dbError(OpUpdate, fmt.Sprintf("%s with ID %d", ErrMsgSaveOccurrences, occurrences[i].ID().Value()), err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *SqlcSeriesRepository) toCreateParams(series *appt.AppointmentSeries) sqlc.CreateAppointmentSeriesParams {
+	recurrence := series.Recurrence()
+
+	var employeeID pgtype.Int4
+	if series.EmployeeID() != nil {
+		employeeID = pgtype.Int4{Int32: series.EmployeeID().Int32(), Valid: true}
+	}
+
+	var occurrenceCount pgtype.Int4
+	if recurrence.Count != nil {
+		occurrenceCount = pgtype.Int4{Int32: int32(*recurrence.Count), Valid: true}
+	}
+
+	return sqlc.CreateAppointmentSeriesParams{
+		CustomerID:      series.CustomerID().Int32(),
+		PetID:           series.PetID().Int32(),
+		EmployeeID:      employeeID,
+		ClinicService:   models.ClinicService(series.Service().String()),
+		StartDate:       r.pgMap.PgTimestamptz.FromTime(series.StartDate()),
+		Frequency:       recurrence.Frequency.String(),
+		RepeatInterval:  int32(recurrence.Interval),
+		OccurrenceCount: occurrenceCount,
+		UntilDate:       r.pgMap.PgTimestamptz.FromTimePtr(recurrence.Until),
+		Notes:           r.pgMap.PgText.FromStringPtr(series.Notes()),
+	}
+}
+
+func (r *SqlcSeriesRepository) toEntity(row sqlc.AppointmentSeries) *appt.AppointmentSeries {
+	recurrence := appt.Recurrence{
+		Frequency: enum.RecurrenceFrequency(row.Frequency),
+		Interval:  int(row.RepeatInterval),
+		Until:     r.pgMap.PgTimestamptz.ToTimePtr(row.UntilDate),
+	}
+	if row.OccurrenceCount.Valid {
+		count := int(row.OccurrenceCount.Int32)
+		recurrence.Count = &count
+	}
+
+	return appt.NewAppointmentSeriesBuilder().
+		WithID(valueobject.NewApptSeriesID(uint(row.ID))).
+		WithCustomerID(valueobject.NewCustomerID(uint(row.CustomerID))).
+		WithPetID(valueobject.NewPetID(uint(row.PetID))).
+		WithEmployeeID(r.pgMap.PgInt4.ToEmployeeIDPtr(row.EmployeeID)).
+		WithService(enum.ClinicService(row.ClinicService)).
+		WithStartDate(row.StartDate.Time).
+		WithRecurrence(recurrence).
+		WithNotes(r.pgMap.PgText.ToStringPtr(row.Notes)).
+		WithTimestamps(row.CreatedAt.Time, row.UpdatedAt.Time).
+		Build()
+}
+
+func (r *SqlcSeriesRepository) dbError(operation, message string, err error) error {
+	return dberr.DatabaseOperationError(operation, TableSeries, DriverSQL, fmt.Errorf("%s: %v", message, err))
+}
+
+func (r *SqlcSeriesRepository) notFoundError(parameterName, parameterValue string) error {
+	return dberr.EntityNotFoundError(parameterName, parameterValue, OpSelect, TableSeries, DriverSQL)
+}
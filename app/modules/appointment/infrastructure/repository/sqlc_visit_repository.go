@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/database"
+	dberr "clinic-vet-api/app/shared/error/infrastructure/database"
+	"clinic-vet-api/app/shared/mapper"
+	"clinic-vet-api/db/models"
+	"clinic-vet-api/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type SqlcVisitRepository struct {
+	queries    *sqlc.Queries
+	transactor *database.Transactor
+	pgMap      *mapper.SqlcFieldMapper
+}
+
+func NewSqlcVisitRepository(queries *sqlc.Queries, transactor *database.Transactor) repository.AppointmentVisitRepository {
+	return &SqlcVisitRepository{
+		queries:    queries,
+		transactor: transactor,
+		pgMap:      mapper.NewSqlcFieldMapper(),
+	}
+}
+
+// CreateWithSession inserts the appointment first so the session can reference it. IDs are only
+// assigned to the entities once the transaction is committed
+func (r *SqlcVisitRepository) CreateWithSession(ctx context.Context, appointment *appt.Appointment, session *medical.MedicalSession) error {
+	var appointmentID valueobject.AppointmentID
+	var sessionID valueobject.MedSessionID
+
+	err := r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+		created, err := queries.CreateAppointment(ctx, appointmentToCreateParams(appointment))
+		if err != nil {
+			return r.dbError(TableAppts, OpInsert, ErrMsgCreateAppt, err)
+		}
+		appointmentID = valueobject.NewAppointmentID(uint(created.ID))
+
+		opened, err := queries.CreateAppointmentMedicalSession(ctx, r.toSessionParams(appointmentID, session))
+		if err != nil {
+			return r.dbError(TableSessions, OpInsert, ErrMsgOpenMedicalSession, err)
+		}
+		sessionID = valueobject.NewMedSessionID(uint(opened.ID))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	appointment.SetID(appointmentID)
+	session.SetID(sessionID)
+	session.AttachToAppointment(appointmentID)
+	return nil
+}
+
+func (r *SqlcVisitRepository) SaveAndOpenSession(ctx context.Context, appointment *appt.Appointment, session *medical.MedicalSession) (bool, error) {
+	var sessionID valueobject.MedSessionID
+
+	err := r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+		if _, err := queries.UpdateAppointment(ctx, appointmentToUpdateParams(appointment)); err != nil {
+			return r.dbError(TableAppts, OpUpdate, ErrMsgUpdateAppt, err)
+		}
+
+		exists, err := queries.ExistsMedicalSessionByAppointmentID(ctx, pgtype.Int4{Int32: appointment.ID().Int32(), Valid: true})
+		if err != nil {
+			return r.dbError(TableSessions, OpSelect, ErrMsgFindMedicalSession, err)
+		}
+
+		if exists {
+			return nil
+		}
+
+		opened, err := queries.CreateAppointmentMedicalSession(ctx, r.toSessionParams(appointment.ID(), session))
+		if err != nil {
+			return r.dbError(TableSessions, OpInsert, ErrMsgOpenMedicalSession, err)
+		}
+		sessionID = valueobject.NewMedSessionID(uint(opened.ID))
+		return nil
+	})
+	if err != nil || sessionID.IsZero() {
+		return false, err
+	}
+
+	session.SetID(sessionID)
+	session.AttachToAppointment(appointment.ID())
+	return true, nil
+}
+
+// CloseSession only closes drafts, a session closed concurrently fails the whole transaction so
+// the appointment is never completed twice. The follow-up proposal is saved in the same transaction
+// and gets its ID once it is committed
+func (r *SqlcVisitRepository) CloseSession(ctx context.Context, session *medical.MedicalSession, appointment *appt.Appointment, followUp *appt.Appointment) error {
+	var followUpID valueobject.AppointmentID
+
+	err := r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+		closed, err := queries.CloseMedicalSession(ctx, sqlc.CloseMedicalSessionParams{
+			ID:           session.ID().Int32(),
+			ClosedAt:     r.pgMap.PgTimestamptz.FromTimePtr(session.ClosedAt()),
+			Diagnosis:    r.pgMap.PgText.FromString(session.PetDetails().Diagnosis()),
+			Treatment:    r.pgMap.PgText.FromString(session.PetDetails().Treatment()),
+			Condition:    r.pgMap.PgText.FromString(session.PetDetails().Condition().String()),
+			Notes:        r.pgMap.PgText.FromStringPtr(session.Notes()),
+			FollowUpDate: r.pgMap.PgTimestamptz.FromTimePtr(session.PetDetails().FollowUpDate()),
+		})
+		if err != nil {
+			return r.dbError(TableSessions, OpUpdate, ErrMsgCloseMedicalSession, err)
+		}
+
+		if closed == 0 {
+			return r.dbError(TableSessions, OpUpdate, ErrMsgCloseMedicalSession, fmt.Errorf("session %d is no longer a draft", session.ID().Value()))
+		}
+
+		if appointment != nil {
+			if _, err := queries.UpdateAppointment(ctx, appointmentToUpdateParams(appointment)); err != nil {
+				return r.dbError(TableAppts, OpUpdate, ErrMsgUpdateAppt, err)
+			}
+		}
+
+		followUpID, err = r.saveProposal(ctx, queries, session, followUp)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if !followUpID.IsZero() {
+		followUp.SetID(followUpID)
+	}
+	return nil
+}
+
+func (r *SqlcVisitRepository) FindFollowUpID(ctx context.Context, sessionID valueobject.MedSessionID) (*valueobject.AppointmentID, error) {
+	followUp, err := r.queries.FindMedicalSessionFollowUp(ctx, sessionID.Int32())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, r.dbError(TableFollowUps, OpSelect, ErrMsgFindFollowUp, err)
+	}
+
+	appointmentID := valueobject.NewAppointmentID(uint(followUp.AppointmentID))
+	return &appointmentID, nil
+}
+
+// SaveFollowUp assigns the ID of a new proposal once the transaction is committed
+func (r *SqlcVisitRepository) SaveFollowUp(ctx context.Context, session *medical.MedicalSession, proposal *appt.Appointment) error {
+	var proposalID valueobject.AppointmentID
+
+	err := r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+		if err := queries.UpdateMedicalSessionFollowUpDate(ctx, sqlc.UpdateMedicalSessionFollowUpDateParams{
+			ID:           session.ID().Int32(),
+			FollowUpDate: r.pgMap.PgTimestamptz.FromTimePtr(session.PetDetails().FollowUpDate()),
+		}); err != nil {
+			return r.dbError(TableSessions, OpUpdate, ErrMsgUpdateFollowUpDate, err)
+		}
+
+		var err error
+		proposalID, err = r.saveProposal(ctx, queries, session, proposal)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if !proposalID.IsZero() {
+		proposal.SetID(proposalID)
+	}
+	return nil
+}
+
+// saveProposal updates the follow-up proposal of the session or inserts and links a new one,
+// reserving its resources like any other booking. Returns the ID of the inserted proposal
+func (r *SqlcVisitRepository) saveProposal(ctx context.Context, queries *sqlc.Queries, session *medical.MedicalSession, proposal *appt.Appointment) (valueobject.AppointmentID, error) {
+	if proposal == nil {
+		return valueobject.AppointmentID{}, nil
+	}
+
+	createdIDs := make(map[int]valueobject.AppointmentID)
+	if err := saveAppointments(ctx, queries, r.pgMap, []appt.Appointment{*proposal}, createdIDs); err != nil {
+		return valueobject.AppointmentID{}, err
+	}
+
+	createdID, created := createdIDs[0]
+	if !created {
+		return valueobject.AppointmentID{}, nil
+	}
+
+	if err := queries.CreateMedicalSessionFollowUp(ctx, sqlc.CreateMedicalSessionFollowUpParams{
+		MedicalSessionID: session.ID().Int32(),
+		AppointmentID:    createdID.Int32(),
+	}); err != nil {
+		return valueobject.AppointmentID{}, r.dbError(TableFollowUps, OpInsert, ErrMsgLinkFollowUp, err)
+	}
+
+	return createdID, nil
+}
+
+func (r *SqlcVisitRepository) toSessionParams(appointmentID valueobject.AppointmentID, session *medical.MedicalSession) sqlc.CreateAppointmentMedicalSessionParams {
+	return sqlc.CreateAppointmentMedicalSessionParams{
+		PetID:         session.PetDetails().PetID().Int32(),
+		CustomerID:    session.CustomerID().Int32(),
+		EmployeeID:    session.EmployeeID().Int32(),
+		AppointmentID: pgtype.Int4{Int32: appointmentID.Int32(), Valid: true},
+		ClinicService: models.ClinicService(session.Service().String()),
+		VisitDate:     r.pgMap.PgTimestamptz.FromTime(session.VisitDate()),
+		VisitType:     session.VisitType().String(),
+		IsEmergency:   pgtype.Bool{Bool: session.IsEmergency(), Valid: true},
+	}
+}
+
+func (r *SqlcVisitRepository) dbError(table, operation, message string, err error) error {
+	return dberr.DatabaseOperationError(operation, table, DriverSQL, fmt.Errorf("%s: %v", message, err))
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/sqlc"
+)
+
+func (r *SqlcAppointmentRepository) FindCheckedInBetween(
+	ctx context.Context, start, end time.Time, employeeID *valueobject.EmployeeID,
+) ([]appt.Appointment, error) {
+	params := sqlc.FindAppointmentsCheckedInBetweenParams{
+		StartDate: r.pgMap.PgTimestamptz.FromTime(start),
+		EndDate:   r.pgMap.PgTimestamptz.FromTime(end),
+	}
+	if employeeID != nil {
+		params.EmployeeID = employeeID.Int32()
+	}
+
+	rows, err := r.queries.FindAppointmentsCheckedInBetween(ctx, params)
+	if err != nil {
+		return nil, r.dbError(OpSelect, ErrMsgListCheckedIn, err)
+	}
+
+	appointments := make([]appt.Appointment, len(rows))
+	for i, row := range rows {
+		appointments[i] = *sqlcToEntity(row)
+	}
+	return appointments, nil
+}
@@ -0,0 +1,285 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/waitlist"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/database"
+	dberr "clinic-vet-api/app/shared/error/infrastructure/database"
+	"clinic-vet-api/app/shared/mapper"
+	"clinic-vet-api/db/models"
+	"clinic-vet-api/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// errOfferNotClaimable rolls back a claim that lost the race for the offer
+var errOfferNotClaimable = errors.New("waitlist offer is no longer claimable")
+
+type SqlcWaitlistRepository struct {
+	queries    *sqlc.Queries
+	transactor *database.Transactor
+	pgMap      *mapper.SqlcFieldMapper
+}
+
+func NewSqlcWaitlistRepository(queries *sqlc.Queries, transactor *database.Transactor) repository.WaitlistRepository {
+	return &SqlcWaitlistRepository{
+		queries:    queries,
+		transactor: transactor,
+		pgMap:      mapper.NewSqlcFieldMapper(),
+	}
+}
+
+func (r *SqlcWaitlistRepository) FindByID(ctx context.Context, id valueobject.WaitlistID) (waitlist.WaitlistEntry, error) {
+	row, err := r.queries.FindWaitlistEntryByID(ctx, id.Int32())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return waitlist.WaitlistEntry{}, r.notFoundError("id", id.String())
+		}
+		return waitlist.WaitlistEntry{}, r.dbError(OpSelect, ErrMsgGetWaitlistEntry, err)
+	}
+
+	return *r.toEntity(row), nil
+}
+
+// FindByClaimToken looks the entry up by the hash of the token, the plain token is never stored
+func (r *SqlcWaitlistRepository) FindByClaimToken(ctx context.Context, claimToken string) (waitlist.WaitlistEntry, error) {
+	row, err := r.queries.FindWaitlistEntryByClaimToken(ctx, r.pgMap.PgText.FromString(waitlist.HashClaimToken(claimToken)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return waitlist.WaitlistEntry{}, r.notFoundError("claim_token", "***")
+		}
+		return waitlist.WaitlistEntry{}, r.dbError(OpSelect, ErrMsgGetWaitlistEntry, err)
+	}
+
+	return *r.toEntity(row), nil
+}
+
+func (r *SqlcWaitlistRepository) FindByCustomer(ctx context.Context, customerID valueobject.CustomerID) ([]waitlist.WaitlistEntry, error) {
+	rows, err := r.queries.FindWaitlistEntriesByCustomer(ctx, customerID.Int32())
+	if err != nil {
+		return nil, r.dbError(OpSelect, ErrMsgListWaitlist, err)
+	}
+
+	return r.toEntities(rows), nil
+}
+
+func (r *SqlcWaitlistRepository) FindWaitingForSlot(ctx context.Context, slot waitlist.Slot) ([]waitlist.WaitlistEntry, error) {
+	var employeeID pgtype.Int4
+	if slot.EmployeeID != nil {
+		employeeID = pgtype.Int4{Int32: slot.EmployeeID.Int32(), Valid: true}
+	}
+
+	rows, err := r.queries.FindWaitingEntriesForSlot(ctx, sqlc.FindWaitingEntriesForSlotParams{
+		RequestedDate: r.pgMap.PgDate.FromTime(slot.Start),
+		ClinicService: models.ClinicService(slot.Service.String()),
+		EmployeeID:    employeeID,
+	})
+	if err != nil {
+		return nil, r.dbError(OpSelect, ErrMsgListWaitlist, err)
+	}
+
+	return r.toEntities(rows), nil
+}
+
+func (r *SqlcWaitlistRepository) FindExpiredOffers(ctx context.Context, now time.Time) ([]waitlist.WaitlistEntry, error) {
+	rows, err := r.queries.FindExpiredWaitlistOffers(ctx, r.pgMap.PgTimestamptz.FromTime(now))
+	if err != nil {
+		return nil, r.dbError(OpSelect, ErrMsgListWaitlist, err)
+	}
+
+	return r.toEntities(rows), nil
+}
+
+func (r *SqlcWaitlistRepository) Save(ctx context.Context, entry *waitlist.WaitlistEntry) error {
+	if entry.ID().IsZero() {
+		return r.create(ctx, entry)
+	}
+	return r.update(ctx, entry)
+}
+
+func (r *SqlcWaitlistRepository) SaveOffer(ctx context.Context, entry *waitlist.WaitlistEntry) (bool, error) {
+	var offeredEmployeeID pgtype.Int4
+	if entry.OfferedEmployeeID() != nil {
+		offeredEmployeeID = pgtype.Int4{Int32: entry.OfferedEmployeeID().Int32(), Valid: true}
+	}
+
+	rowsAffected, err := r.queries.OfferWaitlistSlot(ctx, sqlc.OfferWaitlistSlotParams{
+		ID:                entry.ID().Int32(),
+		OfferedSlot:       r.pgMap.PgTimestamptz.FromTimePtr(entry.OfferedSlot()),
+		OfferedEmployeeID: offeredEmployeeID,
+		ClaimTokenHash:    r.pgMap.PgText.FromStringPtr(entry.ClaimTokenHash()),
+		OfferExpiresAt:    r.pgMap.PgTimestamptz.FromTimePtr(entry.OfferExpiresAt()),
+	})
+	if err != nil {
+		return false, r.dbError(OpUpdate, fmt.Sprintf("%s with ID %d", ErrMsgUpdateWaitlistEntry, entry.ID().Value()), err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// SaveClaim is decided by the conditional update of the entry, which loses the race against the
+// offer expirer or a second claim by finding no offered row. The appointment reserves its resources
+// in the same transaction, the IDs are only assigned to the entities once it is committed
+func (r *SqlcWaitlistRepository) SaveClaim(ctx context.Context, entry *waitlist.WaitlistEntry, appointment *appt.Appointment) (bool, error) {
+	var appointmentID valueobject.AppointmentID
+
+	err := r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+		if err := ensureNoConflict(ctx, queries, r.pgMap, appointment, nil); err != nil {
+			return err
+		}
+
+		created, err := queries.CreateAppointment(ctx, appointmentToCreateParams(appointment))
+		if err != nil {
+			return dberr.DatabaseOperationError(OpInsert, TableAppts, DriverSQL, fmt.Errorf("%s: %v", ErrMsgCreateAppt, err))
+		}
+		appointmentID = valueobject.NewAppointmentID(uint(created.ID))
+
+		rowsAffected, err := queries.ClaimWaitlistOffer(ctx, sqlc.ClaimWaitlistOfferParams{
+			ID:            entry.ID().Int32(),
+			AppointmentID: pgtype.Int4{Int32: appointmentID.Int32(), Valid: true},
+		})
+		if err != nil {
+			return r.dbError(OpUpdate, fmt.Sprintf("%s with ID %d", ErrMsgUpdateWaitlistEntry, entry.ID().Value()), err)
+		}
+
+		if rowsAffected == 0 {
+			return errOfferNotClaimable
+		}
+
+		claimed := *appointment
+		claimed.SetID(appointmentID)
+		return reserveResources(ctx, queries, r.pgMap, &claimed)
+	})
+	if errors.Is(err, errOfferNotClaimable) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	appointment.SetID(appointmentID)
+	entry.LinkAppointment(appointmentID)
+	return true, nil
+}
+
+func (r *SqlcWaitlistRepository) SaveMissedOffer(ctx context.Context, entry *waitlist.WaitlistEntry) (bool, error) {
+	var offeredEmployeeID pgtype.Int4
+	if entry.OfferedEmployeeID() != nil {
+		offeredEmployeeID = pgtype.Int4{Int32: entry.OfferedEmployeeID().Int32(), Valid: true}
+	}
+
+	rowsAffected, err := r.queries.MissWaitlistOffer(ctx, sqlc.MissWaitlistOfferParams{
+		ID:                entry.ID().Int32(),
+		Status:            entry.Status().String(),
+		MissedOffers:      int32(entry.MissedOffers()),
+		OfferedSlot:       r.pgMap.PgTimestamptz.FromTimePtr(entry.OfferedSlot()),
+		OfferedEmployeeID: offeredEmployeeID,
+		OfferExpiresAt:    r.pgMap.PgTimestamptz.FromTimePtr(entry.OfferExpiresAt()),
+	})
+	if err != nil {
+		return false, r.dbError(OpUpdate, ErrMsgExpireWaitlistOffer, err)
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *SqlcWaitlistRepository) create(ctx context.Context, entry *waitlist.WaitlistEntry) error {
+	var preferredEmployeeID pgtype.Int4
+	if entry.PreferredEmployeeID() != nil {
+		preferredEmployeeID = pgtype.Int4{Int32: entry.PreferredEmployeeID().Int32(), Valid: true}
+	}
+
+	row, err := r.queries.CreateWaitlistEntry(ctx, sqlc.CreateWaitlistEntryParams{
+		CustomerID:          entry.CustomerID().Int32(),
+		PetID:               entry.PetID().Int32(),
+		ClinicService:       models.ClinicService(entry.Service().String()),
+		RequestedDate:       r.pgMap.PgDate.FromTime(entry.RequestedDate()),
+		PreferredEmployeeID: preferredEmployeeID,
+		Notes:               r.pgMap.PgText.FromStringPtr(entry.Notes()),
+		Status:              entry.Status().String(),
+	})
+	if err != nil {
+		return r.dbError(OpInsert, ErrMsgCreateWaitlistEntry, err)
+	}
+
+	entry.SetID(valueobject.NewWaitlistID(uint(row.ID)))
+	return nil
+}
+
+func (r *SqlcWaitlistRepository) update(ctx context.Context, entry *waitlist.WaitlistEntry) error {
+	var offeredEmployeeID pgtype.Int4
+	if entry.OfferedEmployeeID() != nil {
+		offeredEmployeeID = pgtype.Int4{Int32: entry.OfferedEmployeeID().Int32(), Valid: true}
+	}
+
+	var appointmentID pgtype.Int4
+	if entry.AppointmentID() != nil {
+		appointmentID = pgtype.Int4{Int32: entry.AppointmentID().Int32(), Valid: true}
+	}
+
+	err := r.queries.UpdateWaitlistEntry(ctx, sqlc.UpdateWaitlistEntryParams{
+		ID:                entry.ID().Int32(),
+		Status:            entry.Status().String(),
+		OfferedSlot:       r.pgMap.PgTimestamptz.FromTimePtr(entry.OfferedSlot()),
+		OfferedEmployeeID: offeredEmployeeID,
+		ClaimTokenHash:    r.pgMap.PgText.FromStringPtr(entry.ClaimTokenHash()),
+		OfferExpiresAt:    r.pgMap.PgTimestamptz.FromTimePtr(entry.OfferExpiresAt()),
+		AppointmentID:     appointmentID,
+	})
+	if err != nil {
+		return r.dbError(OpUpdate, fmt.Sprintf("%s with ID %d", ErrMsgUpdateWaitlistEntry, entry.ID().Value()), err)
+	}
+
+	return nil
+}
+
+func (r *SqlcWaitlistRepository) toEntity(row sqlc.AppointmentWaitlist) *waitlist.WaitlistEntry {
+	var appointmentID *valueobject.AppointmentID
+	if row.AppointmentID.Valid {
+		id := valueobject.NewAppointmentID(uint(row.AppointmentID.Int32))
+		appointmentID = &id
+	}
+
+	return waitlist.NewWaitlistEntryBuilder().
+		WithID(valueobject.NewWaitlistID(uint(row.ID))).
+		WithCustomerID(valueobject.NewCustomerID(uint(row.CustomerID))).
+		WithPetID(valueobject.NewPetID(uint(row.PetID))).
+		WithService(enum.ClinicService(row.ClinicService)).
+		WithRequestedDate(r.pgMap.PgDate.ToTime(row.RequestedDate)).
+		WithPreferredEmployeeID(r.pgMap.PgInt4.ToEmployeeIDPtr(row.PreferredEmployeeID)).
+		WithNotes(r.pgMap.PgText.ToStringPtr(row.Notes)).
+		WithStatus(enum.WaitlistStatus(row.Status)).
+		WithOffer(
+			r.pgMap.PgTimestamptz.ToTimePtr(row.OfferedSlot),
+			r.pgMap.PgInt4.ToEmployeeIDPtr(row.OfferedEmployeeID),
+			r.pgMap.PgText.ToStringPtr(row.ClaimTokenHash),
+			r.pgMap.PgTimestamptz.ToTimePtr(row.OfferExpiresAt),
+		).
+		WithAppointmentID(appointmentID).
+		WithMissedOffers(int(row.MissedOffers)).
+		WithTimestamps(row.CreatedAt.Time, row.UpdatedAt.Time).
+		Build()
+}
+
+func (r *SqlcWaitlistRepository) toEntities(rows []sqlc.AppointmentWaitlist) []waitlist.WaitlistEntry {
+	entries := make([]waitlist.WaitlistEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = *r.toEntity(row)
+	}
+	return entries
+}
+
+func (r *SqlcWaitlistRepository) dbError(operation, message string, err error) error {
+	return dberr.DatabaseOperationError(operation, TableWaitlist, DriverSQL, fmt.Errorf("%s: %v", message, err))
+}
+
+func (r *SqlcWaitlistRepository) notFoundError(parameterName, parameterValue string) error {
+	return dberr.EntityNotFoundError(parameterName, parameterValue, OpSelect, TableWaitlist, DriverSQL)
+}
@@ -3,12 +3,18 @@ package api
 import (
 	"clinic-vet-api/app/middleware"
 	"clinic-vet-api/app/modules/appointment/application/handler"
+	"clinic-vet-api/app/modules/appointment/application/worker"
 	"clinic-vet-api/app/modules/appointment/infrastructure/bus"
 	"clinic-vet-api/app/modules/appointment/presentation/controller"
 	"clinic-vet-api/app/modules/appointment/presentation/routes"
+	"clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/calendarfeed"
 	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
+	"clinic-vet-api/app/shared/database"
 	"clinic-vet-api/sqlc"
 	"fmt"
+	"time"
 
 	apptRepo "clinic-vet-api/app/modules/appointment/infrastructure/repository"
 
@@ -20,18 +26,41 @@ import (
 type AppointmentAPIConfig struct {
 	Router         *gin.RouterGroup
 	Queries        *sqlc.Queries
+	Transactor     *database.Transactor
 	Validator      *validator.Validate
 	CustomerRepo   repository.CustomerRepository
 	EmployeeRepo   repository.EmployeeRepository
+	ExceptionRepo  repository.ScheduleExceptionRepository
+	OnCallService  *service.OnCallService
+	CalendarRepo   repository.ClinicCalendarRepository
+	UserRepo       repository.UserRepository
+	PetRepo        repository.PetRepository
 	AuthMiddleware *middleware.AuthMiddleware
+
+	NotificationService  service.NotificationService
+	ReminderClaimTimeout time.Duration
+	WaitlistOfferTTL     time.Duration
+	NoShowPolicy         appointment.NoShowPolicy
+
+	// Secret signing the calendar feed URLs
+	CalendarFeedSecret string
+	// Public URL the calendar feeds are served under, relative to the request host when empty
+	CalendarFeedBaseURL string
+	// Page the customers of appointments cancelled by an employee absence book them again on
+	AbsenceRescheduleURL string
 }
 
 // AppointmentAPIComponents holds all created components
 type AppointmentAPIComponents struct {
-	Repository  repository.AppointmentRepository
-	Bus         *bus.AppointmentBus
-	Controllers *AppointmentControllers
-	Routes      *routes.AppointmentRoutes
+	Repository           repository.AppointmentRepository
+	VisitRepository      repository.AppointmentVisitRepository
+	Bus                  *bus.AppointmentBus
+	Controllers          *AppointmentControllers
+	Routes               *routes.AppointmentRoutes
+	ReminderDispatcher   *worker.ReminderDispatcher
+	NoShowMarker         *worker.NoShowMarker
+	WaitlistOfferExpirer *worker.WaitlistOfferExpirer
+	AbsenceCoverage      *service.AbsenceCoverageService
 }
 
 // AppointmentControllers holds all appointment controllers
@@ -39,6 +68,7 @@ type AppointmentControllers struct {
 	Customer   *controller.CustomerAppointmetController
 	Employee   *controller.EmployeeAppointmentController
 	Admin      *controller.AdminApptController
+	Feed       *controller.CalendarFeedController
 	Operations *controller.ApptControllerOperations
 }
 
@@ -67,12 +97,28 @@ func (f *AppointmentAPIBuilder) Build() error {
 		return err
 	}
 
-	// Create repository (single instance)
+	// Create repositories (single instance)
 	repository := apptRepo.NewSqlcAppointmentRepository(f.config.Queries)
+	waitlistRepo := apptRepo.NewSqlcWaitlistRepository(f.config.Queries, f.config.Transactor)
+	seriesRepo := apptRepo.NewSqlcSeriesRepository(f.config.Queries, f.config.Transactor)
+	feedRepo := apptRepo.NewSqlcCalendarFeedRepository(f.config.Queries, f.config.Transactor)
+	visitRepo := apptRepo.NewSqlcVisitRepository(f.config.Queries, f.config.Transactor)
+	reservationRepo := apptRepo.NewSqlcReservationRepository(f.config.Transactor)
+	coverageRepo := apptRepo.NewSqlcAbsenceCoverageRepository(f.config.Transactor)
+
+	// Create services
+	contactService := service.NewCustomerContactService(f.config.CustomerRepo, f.config.UserRepo)
+	waitlistOffers := service.NewWaitlistOfferService(waitlistRepo, contactService, f.config.NotificationService, f.config.WaitlistOfferTTL)
+	absenceCoverage := service.NewAbsenceCoverageService(
+		repository, f.config.EmployeeRepo, f.config.ExceptionRepo, coverageRepo, contactService, f.config.NotificationService, f.config.AbsenceRescheduleURL,
+	)
 
 	// Create handlers
-	commandHandler := handler.NewAppointmentCommandHandler(repository)
-	queryHandler := handler.NewAppointmentQueryHandler(repository, f.config.CustomerRepo, f.config.EmployeeRepo)
+	commandHandler := handler.NewAppointmentCommandHandler(repository, f.config.CalendarRepo, waitlistRepo, seriesRepo, feedRepo, visitRepo, reservationRepo, f.config.ExceptionRepo, f.config.EmployeeRepo, f.config.PetRepo, waitlistOffers, absenceCoverage, f.config.OnCallService, f.config.NoShowPolicy)
+	queryHandler := handler.NewAppointmentQueryHandler(
+		repository, f.config.CustomerRepo, f.config.EmployeeRepo, f.config.ExceptionRepo, f.config.CalendarRepo, waitlistRepo, seriesRepo,
+		feedRepo, f.config.PetRepo, calendarfeed.NewSigner(f.config.CalendarFeedSecret),
+	)
 
 	// Create buses
 	commandBus := bus.NewApptCmdBus(*commandHandler)
@@ -85,12 +131,28 @@ func (f *AppointmentAPIBuilder) Build() error {
 	// Create and register routes
 	routes := f.createRoutes(controllers)
 
+	// Create background jobs
+	reminderDispatcher := worker.NewReminderDispatcher(
+		repository,
+		apptRepo.NewSqlcReminderRepository(f.config.Queries),
+		contactService,
+		f.config.NotificationService,
+		f.config.ReminderClaimTimeout,
+	)
+	noShowMarker := worker.NewNoShowMarker(repository, f.config.NoShowPolicy)
+	waitlistOfferExpirer := worker.NewWaitlistOfferExpirer(waitlistOffers)
+
 	// Store components
 	f.components = &AppointmentAPIComponents{
-		Repository:  repository,
-		Bus:         apptBus,
-		Controllers: controllers,
-		Routes:      routes,
+		Repository:           repository,
+		VisitRepository:      visitRepo,
+		Bus:                  apptBus,
+		Controllers:          controllers,
+		Routes:               routes,
+		ReminderDispatcher:   reminderDispatcher,
+		NoShowMarker:         noShowMarker,
+		WaitlistOfferExpirer: waitlistOfferExpirer,
+		AbsenceCoverage:      absenceCoverage,
 	}
 
 	f.isBuilt = true
@@ -105,19 +167,28 @@ func (f *AppointmentAPIBuilder) createControllers(apptBus bus.AppointmentBus) *A
 		Customer:   controller.NewCustomerApptControleer(&apptBus, f.config.Validator, ctrlOperations),
 		Employee:   controller.NewEmployeeController(ctrlOperations, f.config.Validator),
 		Admin:      controller.NewAdminApptController(&apptBus, f.config.Validator, ctrlOperations),
+		Feed:       controller.NewCalendarFeedController(&apptBus, f.calendarFeedBaseURL()),
 		Operations: ctrlOperations,
 	}
 }
 
 // createRoutes creates routes and registers them
 func (f *AppointmentAPIBuilder) createRoutes(controllers *AppointmentControllers) *routes.AppointmentRoutes {
-	routes := routes.NewAppointmentRoutes(controllers.Customer, controllers.Employee, controllers.Admin)
+	routes := routes.NewAppointmentRoutes(controllers.Customer, controllers.Employee, controllers.Admin, controllers.Feed)
 	routes.RegisterAdminRoutes(f.config.Router, f.config.AuthMiddleware)
 	routes.RegisterCustomerRoutes(f.config.Router, f.config.AuthMiddleware)
 	routes.RegisterEmployeeRoutes(f.config.Router, f.config.AuthMiddleware)
+	routes.RegisterCalendarFeedRoutes(f.config.Router)
 	return routes
 }
 
+func (f *AppointmentAPIBuilder) calendarFeedBaseURL() string {
+	if f.config.CalendarFeedBaseURL != "" {
+		return f.config.CalendarFeedBaseURL
+	}
+	return f.config.Router.BasePath() + routes.CalendarFeedPath
+}
+
 // validateConfig validates the Builder configuration
 func (f *AppointmentAPIBuilder) validateConfig() error {
 	if f.config == nil {
@@ -129,6 +200,9 @@ func (f *AppointmentAPIBuilder) validateConfig() error {
 	if f.config.Queries == nil {
 		return fmt.Errorf("queries cannot be nil")
 	}
+	if f.config.Transactor == nil {
+		return fmt.Errorf("transactor cannot be nil")
+	}
 	if f.config.Validator == nil {
 		return fmt.Errorf("validator cannot be nil")
 	}
@@ -140,10 +214,38 @@ func (f *AppointmentAPIBuilder) validateConfig() error {
 		return fmt.Errorf("employee repository cannot be nil")
 	}
 
+	if f.config.ExceptionRepo == nil {
+		return fmt.Errorf("schedule exception repository cannot be nil")
+	}
+
+	if f.config.OnCallService == nil {
+		return fmt.Errorf("on-call service cannot be nil")
+	}
+
 	if f.config.CustomerRepo == nil {
 		return fmt.Errorf("customer repository cannot be nil")
 	}
 
+	if f.config.CalendarRepo == nil {
+		return fmt.Errorf("calendar repository cannot be nil")
+	}
+
+	if f.config.UserRepo == nil {
+		return fmt.Errorf("user repository cannot be nil")
+	}
+
+	if f.config.PetRepo == nil {
+		return fmt.Errorf("pet repository cannot be nil")
+	}
+
+	if f.config.CalendarFeedSecret == "" {
+		return fmt.Errorf("calendar feed secret cannot be empty")
+	}
+
+	if f.config.NotificationService == nil {
+		return fmt.Errorf("notification service cannot be nil")
+	}
+
 	if f.config.AuthMiddleware == nil {
 		return fmt.Errorf("auth middleware cannot be nil")
 	}
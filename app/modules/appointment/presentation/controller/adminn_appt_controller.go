@@ -32,6 +32,12 @@ func NewAdminApptController(
 
 func (ctrl *AdminApptController) GetBySpecfificationAppointments(c *gin.Context) {
 
+}
+func (ctrl *AdminApptController) GetAvailability(c *gin.Context) {
+	ctrl.operations.FindAvailability(c)
+}
+func (ctrl *AdminApptController) GetAppointmentStats(c *gin.Context) {
+	ctrl.operations.GetAppointmentStats(c, nil)
 }
 func (ctrl *AdminApptController) GetAppointmentByID(c *gin.Context) {
 	ctrl.operations.FindAppointmentByID(c, GetByIDExtraArgs{})
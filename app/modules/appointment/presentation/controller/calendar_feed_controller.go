@@ -0,0 +1,197 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/appointment/application/command"
+	"clinic-vet-api/app/modules/appointment/application/query"
+	"clinic-vet-api/app/modules/appointment/infrastructure/bus"
+	"clinic-vet-api/app/modules/appointment/presentation/dto"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	authError "clinic-vet-api/app/shared/error/auth"
+	"clinic-vet-api/app/shared/ical"
+	"clinic-vet-api/app/shared/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CalendarFeedController manages the iCalendar feeds employees and customers subscribe to from
+// their phone calendars. Feed URLs carry a signed token instead of a bearer token since
+// calendar clients can't authenticate
+type CalendarFeedController struct {
+	bus         *bus.AppointmentBus
+	mapper      dto.ResponseMapper
+	feedBaseURL string
+}
+
+// NewCalendarFeedController takes the public URL feeds are served under. A relative URL is
+// completed with the scheme and host of the request
+func NewCalendarFeedController(bus *bus.AppointmentBus, feedBaseURL string) *CalendarFeedController {
+	return &CalendarFeedController{
+		bus:         bus,
+		mapper:      dto.ResponseMapper{},
+		feedBaseURL: feedBaseURL,
+	}
+}
+
+// GetEmployeeFeed godoc
+// @Summary Get the employee calendar feed URL
+// @Tags vet-appointments
+// @Produce json
+// @Security BearerAuth
+// @Router /employees/appointments/calendar-feed [get]
+func (ctrl *CalendarFeedController) GetEmployeeFeed(c *gin.Context) {
+	ctrl.withOwner(c, enum.CalendarFeedOwnerEmployee, ctrl.getFeed)
+}
+
+// IssueEmployeeFeed godoc
+// @Summary Issue a new employee calendar feed URL
+// @Description Any URL issued before stops working
+// @Tags vet-appointments
+// @Produce json
+// @Security BearerAuth
+// @Router /employees/appointments/calendar-feed [post]
+func (ctrl *CalendarFeedController) IssueEmployeeFeed(c *gin.Context) {
+	ctrl.withOwner(c, enum.CalendarFeedOwnerEmployee, ctrl.issueFeed)
+}
+
+// RevokeEmployeeFeed godoc
+// @Summary Revoke the employee calendar feed
+// @Tags vet-appointments
+// @Produce json
+// @Security BearerAuth
+// @Router /employees/appointments/calendar-feed [delete]
+func (ctrl *CalendarFeedController) RevokeEmployeeFeed(c *gin.Context) {
+	ctrl.withOwner(c, enum.CalendarFeedOwnerEmployee, ctrl.revokeFeed)
+}
+
+// GetCustomerFeed godoc
+// @Summary Get the customer calendar feed URL
+// @Tags customer-appointments
+// @Produce json
+// @Security BearerAuth
+// @Router /customers/appointments/calendar-feed [get]
+func (ctrl *CalendarFeedController) GetCustomerFeed(c *gin.Context) {
+	ctrl.withOwner(c, enum.CalendarFeedOwnerCustomer, ctrl.getFeed)
+}
+
+// IssueCustomerFeed godoc
+// @Summary Issue a new customer calendar feed URL
+// @Description Any URL issued before stops working
+// @Tags customer-appointments
+// @Produce json
+// @Security BearerAuth
+// @Router /customers/appointments/calendar-feed [post]
+func (ctrl *CalendarFeedController) IssueCustomerFeed(c *gin.Context) {
+	ctrl.withOwner(c, enum.CalendarFeedOwnerCustomer, ctrl.issueFeed)
+}
+
+// RevokeCustomerFeed godoc
+// @Summary Revoke the customer calendar feed
+// @Tags customer-appointments
+// @Produce json
+// @Security BearerAuth
+// @Router /customers/appointments/calendar-feed [delete]
+func (ctrl *CalendarFeedController) RevokeCustomerFeed(c *gin.Context) {
+	ctrl.withOwner(c, enum.CalendarFeedOwnerCustomer, ctrl.revokeFeed)
+}
+
+// ServeFeed godoc
+// @Summary Download a calendar feed
+// @Description Public iCalendar (RFC 5545) feed, the signed token in the URL grants access
+// @Tags calendar-feeds
+// @Produce text/calendar
+// @Param token path string true "Feed token, optionally ending in .ics"
+// @Router /calendar-feeds/{token} [get]
+func (ctrl *CalendarFeedController) ServeFeed(c *gin.Context) {
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+
+	result, err := ctrl.bus.QueryBus.FindCalendarFeedEvents(c.Request.Context(), query.NewFindCalendarFeedEventsQuery(token))
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", `inline; filename="appointments.ics"`)
+	c.Header("Cache-Control", "private, max-age=300")
+	c.Data(http.StatusOK, ical.ContentType, ctrl.mapper.ToICalendar(result).Encode())
+}
+
+func (ctrl *CalendarFeedController) getFeed(c *gin.Context, ownerType enum.CalendarFeedOwner, ownerID uint) {
+	result, err := ctrl.bus.QueryBus.FindCalendarFeed(c.Request.Context(), query.NewFindCalendarFeedQuery(ownerType, ownerID))
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, ctrl.mapper.FromCalendarFeedResult(result, ctrl.baseURL(c)), "Calendar Feed")
+}
+
+func (ctrl *CalendarFeedController) issueFeed(c *gin.Context, ownerType enum.CalendarFeedOwner, ownerID uint) {
+	issueCommand, err := command.NewIssueCalendarFeedCommand(ownerType, ownerID)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	result := ctrl.bus.CommandBus.IssueCalendarFeed(c.Request.Context(), issueCommand)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	feed, err := ctrl.bus.QueryBus.FindCalendarFeed(c.Request.Context(), query.NewFindCalendarFeedQuery(ownerType, ownerID))
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Success(c, ctrl.mapper.FromCalendarFeedResult(feed, ctrl.baseURL(c)), "Calendar feed issued successfully")
+}
+
+func (ctrl *CalendarFeedController) revokeFeed(c *gin.Context, ownerType enum.CalendarFeedOwner, ownerID uint) {
+	revokeCommand, err := command.NewRevokeCalendarFeedCommand(ownerType, ownerID)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	result := ctrl.bus.CommandBus.RevokeCalendarFeed(c.Request.Context(), revokeCommand)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Success(c, result.ToMap(), "Calendar feed revoked successfully")
+}
+
+func (ctrl *CalendarFeedController) withOwner(
+	c *gin.Context, ownerType enum.CalendarFeedOwner, handle func(*gin.Context, enum.CalendarFeedOwner, uint),
+) {
+	userCTX, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, authError.UnauthorizedCTXError())
+		return
+	}
+
+	ownerID := userCTX.EmployeeID
+	if ownerType == enum.CalendarFeedOwnerCustomer {
+		ownerID = userCTX.CustomerID
+	}
+
+	handle(c, ownerType, ownerID)
+}
+
+func (ctrl *CalendarFeedController) baseURL(c *gin.Context) string {
+	if strings.HasPrefix(ctrl.feedBaseURL, "http://") || strings.HasPrefix(ctrl.feedBaseURL, "https://") {
+		return ctrl.feedBaseURL
+	}
+
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + c.Request.Host + ctrl.feedBaseURL
+}
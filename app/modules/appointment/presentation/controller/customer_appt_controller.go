@@ -3,6 +3,7 @@ package controller
 
 import (
 	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/appointment/application/command"
 	"clinic-vet-api/app/modules/appointment/infrastructure/bus"
 	"clinic-vet-api/app/modules/appointment/presentation/dto"
 	"clinic-vet-api/app/shared/response"
@@ -87,6 +88,46 @@ func (ctrl *CustomerAppointmetController) RequestAppointment(c *gin.Context) {
 	response.Created(c, result, "Appointment")
 }
 
+// ConfirmProposal godoc
+// @Summary Confirm a proposed appointment
+// @Description Customer accepts the veterinarian the clinic proposed for a pending appointment, such as a follow-up or a reassignment while the booked veterinarian is absent
+// @Tags customer-appointments
+// @Produce json
+// @Param id path int true "Appointment ID"
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{message=string} "Appointment confirmed successfully"
+// @Failure 400 {object} response.APIResponse "Invalid appointment ID"
+// @Failure 401 {object} response.APIResponse "Unauthorized - Customer not authenticated"
+// @Failure 404 {object} response.APIResponse "Appointment not found"
+// @Failure 422 {object} response.APIResponse "The appointment has no proposal to confirm"
+// @Router /customers/appointments/{id}/confirm [put]
+func (ctrl *CustomerAppointmetController) ConfirmProposal(c *gin.Context) {
+	userCtx, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, authError.UnauthorizedCTXError())
+		return
+	}
+
+	appointmentID, err := ginUtils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	confirmCommand, err := command.NewConfirmProposalCommand(appointmentID, userCtx.CustomerID)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	result := ctrl.bus.CommandBus.ConfirmProposal(c.Request.Context(), confirmCommand)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+	response.Success(c, nil, result.Message())
+}
+
 // GetMyAppts godoc
 // @Summary Get customer's appointments
 // @Description Retrieves a list of all appointments for the authenticated customer
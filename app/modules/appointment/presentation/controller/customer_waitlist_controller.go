@@ -0,0 +1,146 @@
+package controller
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/appointment/application/command"
+	"clinic-vet-api/app/modules/appointment/application/query"
+	"clinic-vet-api/app/modules/appointment/presentation/dto"
+	"clinic-vet-api/app/shared/response"
+
+	authError "clinic-vet-api/app/shared/error/auth"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+	ginUtils "clinic-vet-api/app/shared/gin_utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// JoinWaitlist godoc
+// @Summary Join the appointment waitlist
+// @Description Customer waits for a slot of a service on a given day, optionally with a preferred veterinarian. Freed slots are offered in order of arrival
+// @Tags customer-appointments
+// @Accept json
+// @Produce json
+// @Param waitlist body dto.JoinWaitlistRequest true "Waitlist entry details"
+// @Security BearerAuth
+// @Router /customers/appointments/waitlist [post]
+func (ctrl *CustomerAppointmetController) JoinWaitlist(c *gin.Context) {
+	userCtx, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, authError.UnauthorizedCTXError())
+		return
+	}
+
+	var requestData dto.JoinWaitlistRequest
+	if err := ginUtils.ShouldBindAndValidateBody(c, &requestData, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	joinCommand, err := requestData.ToCommand(userCtx.CustomerID)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	result := ctrl.bus.CommandBus.JoinWaitlist(c.Request.Context(), joinCommand)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+	response.Created(c, result.ID(), "Waitlist Entry")
+}
+
+// GetMyWaitlist godoc
+// @Summary Get customer's waitlist entries
+// @Description Retrieves the waitlist entries of the authenticated customer, including pending offers
+// @Tags customer-appointments
+// @Produce json
+// @Security BearerAuth
+// @Router /customers/appointments/waitlist [get]
+func (ctrl *CustomerAppointmetController) GetMyWaitlist(c *gin.Context) {
+	userCtx, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, authError.UnauthorizedCTXError())
+		return
+	}
+
+	waitlistQuery := query.NewFindWaitlistByCustomerQuery(userCtx.CustomerID)
+	results, err := ctrl.bus.QueryBus.FindWaitlistByCustomer(c.Request.Context(), waitlistQuery)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, ctrl.operations.mapper.FromWaitlistResults(results), "Waitlist Entries")
+}
+
+// LeaveWaitlist godoc
+// @Summary Leave the appointment waitlist
+// @Description Cancels a waitlist entry of the authenticated customer. A pending offer is passed to the next customer
+// @Tags customer-appointments
+// @Produce json
+// @Param id path int true "Waitlist entry ID"
+// @Security BearerAuth
+// @Router /customers/appointments/waitlist/{id} [delete]
+func (ctrl *CustomerAppointmetController) LeaveWaitlist(c *gin.Context) {
+	userCtx, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, authError.UnauthorizedCTXError())
+		return
+	}
+
+	entryID, err := ginUtils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	leaveCommand, err := command.NewLeaveWaitlistCommand(entryID, userCtx.CustomerID)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	result := ctrl.bus.CommandBus.LeaveWaitlist(c.Request.Context(), leaveCommand)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+	response.Success(c, nil, result.Message())
+}
+
+// ClaimWaitlistSlot godoc
+// @Summary Claim an offered slot
+// @Description Converts the slot offered to the authenticated customer into a pending appointment using the claim token sent with the offer
+// @Tags customer-appointments
+// @Accept json
+// @Produce json
+// @Param claim body dto.ClaimWaitlistSlotRequest true "Claim token"
+// @Security BearerAuth
+// @Router /customers/appointments/waitlist/claim [post]
+func (ctrl *CustomerAppointmetController) ClaimWaitlistSlot(c *gin.Context) {
+	userCtx, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, authError.UnauthorizedCTXError())
+		return
+	}
+
+	var requestData dto.ClaimWaitlistSlotRequest
+	if err := ginUtils.ShouldBindAndValidateBody(c, &requestData, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	claimCommand, err := command.NewClaimWaitlistSlotCommand(userCtx.CustomerID, requestData.ClaimToken)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	result := ctrl.bus.CommandBus.ClaimWaitlistSlot(c.Request.Context(), claimCommand)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+	response.Created(c, result.ID(), "Appointment")
+}
@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"fmt"
+
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/appointment/presentation/dto"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/shared/response"
+
+	authError "clinic-vet-api/app/shared/error/auth"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+	ginUtils "clinic-vet-api/app/shared/gin_utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterAbsence godoc
+// @Summary Register a veterinarian absence
+// @Description Records the absence as approved sick leave and proposes every confirmed appointment of the veterinarian within the period to an active colleague of the same specialty who is free according to their schedule. Proposed appointments go back to pending until the staff or the customer confirms them. Appointments nobody can take are cancelled and the customers are sent a link to book again. Veterinarians can only register their own absences, receptionists and admins must name the veterinarian with vet_id
+// @Tags vet-appointments
+// @Accept json
+// @Produce json
+// @Param absence body dto.RegisterAbsenceRequest true "Absence"
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{message=string} "Absence registered, with the proposed and cancelled counts"
+// @Failure 400 {object} response.APIResponse "Invalid input data or missing veterinarian"
+// @Failure 401 {object} response.APIResponse "Unauthorized - Employee not authenticated"
+// @Failure 403 {object} response.APIResponse "Veterinarian registering the absence of someone else"
+// @Failure 404 {object} response.APIResponse "Veterinarian not found"
+// @Failure 422 {object} response.APIResponse "Invalid period or reason"
+// @Router /employees/appointments/absences [post]
+func (ctrl *EmployeeAppointmentController) RegisterAbsence(c *gin.Context) {
+	userCTX, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, authError.UnauthorizedCTXError())
+		return
+	}
+
+	var requestData dto.RegisterAbsenceRequest
+	if err := ginUtils.ShouldBindAndValidateBody(c, &requestData, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	var employeeID uint
+	switch userCTX.Role {
+	case enum.UserRoleVeterinarian.String():
+		if requestData.EmployeeID != nil && *requestData.EmployeeID != userCTX.EmployeeID {
+			response.Forbidden(c, authError.PermissionDeniedError(fmt.Sprint(userCTX.UserID), "absence of another veterinarian", "register"))
+			return
+		}
+		employeeID = userCTX.EmployeeID
+	case enum.UserRoleReceptionist.String(), enum.UserRoleAdmin.String():
+		if requestData.EmployeeID == nil {
+			response.BadRequest(c, httpError.MissingRequiredFieldError("vet_id"))
+			return
+		}
+		employeeID = *requestData.EmployeeID
+	default:
+		response.Forbidden(c, authError.ForbiddenError("veterinarian, receptionist or admin", userCTX.Role))
+		return
+	}
+
+	absenceCommand, err := requestData.ToCommand(employeeID, userCTX.UserID)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	result := ctrl.operations.bus.CommandBus.RegisterAbsence(c.Request.Context(), absenceCommand)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Success(c, nil, result.Message())
+}
@@ -148,48 +148,37 @@ func (ctrl *EmployeeAppointmentController) MarkAsNoShow(c *gin.Context) {
 // @Param start_date query string false "Start date (YYYY-MM-DD)" format(date)
 // @Param end_date query string false "End date (YYYY-MM-DD)" format(date)
 // @Security BearerAuth
-// @Success 200 {object} response.APIResponse"Appointment statistics"
+// @Success 200 {object} response.APIResponse{data=dto.ApptStatsResponse} "Appointment statistics"
 // @Failure 400 {object} response.APIResponse "Invalid date parameters"
 // @Failure 401 {object} response.APIResponse "Unauthorized - Veterinarian not authenticated"
 // @Failure 500 {object} response.APIResponse "Internal server error"
 // @Router /vet/appointments/stats [get]
 func (ctrl *EmployeeAppointmentController) GetAppointmentStats(c *gin.Context) {
-	/*
-		// Get vet id from JWT context
-		vetIDInterface, exists := c.Get("vet_id")
-		if !exists {
-			response.Unauthorized(c, errors.New("vet id not found in context"))
-			return
-		}
-
-		vetID, ok := vetIDInterface.(int)
-		if !ok {
-			response.BadRequest(c, errors.New("invalid vet id format"))
-			return
-		}
-
-		// Parse date range from query parameters (optional)
-		var startDate, endDate *time.Time
-		if startDateStr := c.Query("start_date"); startDateStr != "" {
-			if parsed, err := time.Parse("2006-01-02", startDateStr); err == nil {
-				startDate = &parsed
-			}
-		}
-		if endDateStr := c.Query("end_date"); endDateStr != "" {
-			if parsed, err := time.Parse("2006-01-02", endDateStr); err == nil {
-				endDate = &parsed
-			}
-		}
-
-		query := query.NewGetAppointmentStatsQuery(&vetID, nil, startDate, endDate)
-		result, err := c.queryBus.Execute(context.Background(), query)
-		if err != nil {
-			response.ApplicationError(c, err)
-			return
-		}
-
-		response.Success(c, result)
-	*/
+	userCTX, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, authError.UnauthorizedCTXError())
+		return
+	}
+
+	ctrl.operations.GetAppointmentStats(c, &userCTX.EmployeeID)
+}
+
+// GetAvailability godoc
+// @Summary List available appointment slots
+// @Description Lists the free slots of a veterinarian, or of every active one, for the service in the date range. Only days inside the booking window of the clinic are listed
+// @Tags vet-appointments
+// @Produce json
+// @Param vet_id query int false "Veterinarian ID"
+// @Param service query string true "Clinic service"
+// @Param start_date query string true "First day (YYYY-MM-DD)" format(date)
+// @Param end_date query string true "Last day (YYYY-MM-DD)" format(date)
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=[]dto.ApptAvailabilityResponse} "Available slots"
+// @Failure 400 {object} response.APIResponse "Invalid query parameters"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /employees/appointments/availability [get]
+func (ctrl *EmployeeAppointmentController) GetAvailability(c *gin.Context) {
+	ctrl.operations.FindAvailability(c)
 }
 
 // RescheduleAppointment godoc
@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/appointment/presentation/dto"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/shared/response"
+
+	authError "clinic-vet-api/app/shared/error/auth"
+	ginUtils "clinic-vet-api/app/shared/gin_utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateEmergencyAppointment godoc
+// @Summary Register an emergency or walk-in
+// @Description Registers a patient arriving without a booking. The appointment starts right away, skips the lead-time and calendar rules and opens the medical session of the visit flagged as an emergency. Without vet_id the veterinarian on call attends it, the veterinarian registering it stands in when nobody is on call
+// @Tags vet-appointments
+// @Accept json
+// @Produce json
+// @Param emergency body dto.CreateEmergencyApptRequest true "Emergency intake"
+// @Security BearerAuth
+// @Success 201 {object} response.APIResponse "Emergency registered"
+// @Failure 400 {object} response.APIResponse "Invalid input data"
+// @Failure 401 {object} response.APIResponse "Unauthorized - Employee not authenticated"
+// @Failure 422 {object} response.APIResponse "Invalid triage priority or service, or no veterinarian on call"
+// @Router /employees/appointments/emergency [post]
+func (ctrl *EmployeeAppointmentController) CreateEmergencyAppointment(c *gin.Context) {
+	userCTX, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, authError.UnauthorizedCTXError())
+		return
+	}
+
+	var requestData dto.CreateEmergencyApptRequest
+	if err := ginUtils.ShouldBindAndValidateBody(c, &requestData, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	var registeredBy uint
+	if userCTX.Role == enum.UserRoleVeterinarian.String() {
+		registeredBy = userCTX.EmployeeID
+	}
+
+	createCommand, err := requestData.ToCommand(registeredBy)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	result := ctrl.operations.bus.CommandBus.CreateEmergency(c.Request.Context(), createCommand)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Created(c, result.ID(), "Emergency Appointment")
+}
@@ -0,0 +1,160 @@
+package controller
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/appointment/application/query"
+	"clinic-vet-api/app/modules/appointment/presentation/dto"
+	"clinic-vet-api/app/shared/response"
+
+	authError "clinic-vet-api/app/shared/error/auth"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+	ginUtils "clinic-vet-api/app/shared/gin_utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateAppointmentSeries godoc
+// @Summary Create a recurring appointment series
+// @Description Books every occurrence of a weekly or monthly recurrence at once. The series is rejected when any occurrence falls outside the clinic calendar or overlaps another appointment
+// @Tags vet-appointments
+// @Accept json
+// @Produce json
+// @Param series body dto.CreateApptSeriesRequest true "Series details"
+// @Security BearerAuth
+// @Success 201 {object} response.APIResponse "Series created"
+// @Failure 400 {object} response.APIResponse "Invalid input data"
+// @Failure 422 {object} response.APIResponse "An occurrence is invalid or overlaps another appointment"
+// @Router /employees/appointments/series [post]
+func (ctrl *EmployeeAppointmentController) CreateAppointmentSeries(c *gin.Context) {
+	var requestData dto.CreateApptSeriesRequest
+	if err := ginUtils.ShouldBindAndValidateBody(c, &requestData, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	createCommand, err := requestData.ToCommand()
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	result := ctrl.operations.bus.CommandBus.CreateSeries(c.Request.Context(), createCommand)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Created(c, result.ID(), "Appointment Series")
+}
+
+// GetAppointmentSeries godoc
+// @Summary Get an appointment series
+// @Description Retrieves a series with all of its occurrences in chronological order
+// @Tags vet-appointments
+// @Produce json
+// @Param id path int true "Series ID"
+// @Security BearerAuth
+// @Router /employees/appointments/series/{id} [get]
+func (ctrl *EmployeeAppointmentController) GetAppointmentSeries(c *gin.Context) {
+	seriesID, err := ginUtils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	result, err := ctrl.operations.bus.QueryBus.FindSeriesByID(c.Request.Context(), query.NewFindApptSeriesByIDQuery(seriesID))
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, ctrl.operations.mapper.FromSeriesResult(result), "Appointment Series")
+}
+
+// CancelSeriesOccurrence godoc
+// @Summary Cancel occurrences of a series
+// @Description Cancels the selected occurrence or, with scope "following", it and every later occurrence
+// @Tags vet-appointments
+// @Accept json
+// @Produce json
+// @Param id path int true "Appointment ID of the occurrence"
+// @Param cancel body dto.CancelSeriesOccurrenceRequest true "Scope and reason"
+// @Security BearerAuth
+// @Router /employees/appointments/series/occurrences/{id}/cancel [put]
+func (ctrl *EmployeeAppointmentController) CancelSeriesOccurrence(c *gin.Context) {
+	userCTX, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, authError.UnauthorizedCTXError())
+		return
+	}
+
+	appointmentID, err := ginUtils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	var requestData dto.CancelSeriesOccurrenceRequest
+	if err := ginUtils.ShouldBindAndValidateBody(c, &requestData, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	cancelCommand, err := requestData.ToCommand(appointmentID, &userCTX.EmployeeID)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	result := ctrl.operations.bus.CommandBus.CancelSeries(c.Request.Context(), cancelCommand)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Success(c, result.ToMap(), "Series occurrences cancelled successfully")
+}
+
+// RescheduleSeriesOccurrence godoc
+// @Summary Reschedule occurrences of a series
+// @Description Moves the selected occurrence or, with scope "following", shifts it and every later occurrence by the same offset
+// @Tags vet-appointments
+// @Accept json
+// @Produce json
+// @Param id path int true "Appointment ID of the occurrence"
+// @Param reschedule body dto.RescheduleSeriesOccurrenceRequest true "New date and scope"
+// @Security BearerAuth
+// @Router /employees/appointments/series/occurrences/{id}/reschedule [put]
+func (ctrl *EmployeeAppointmentController) RescheduleSeriesOccurrence(c *gin.Context) {
+	userCTX, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, authError.UnauthorizedCTXError())
+		return
+	}
+
+	appointmentID, err := ginUtils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	var requestData dto.RescheduleSeriesOccurrenceRequest
+	if err := ginUtils.ShouldBindAndValidateBody(c, &requestData, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	rescheduleCommand, err := requestData.ToCommand(appointmentID, &userCTX.EmployeeID)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	result := ctrl.operations.bus.CommandBus.RescheduleSeries(c.Request.Context(), rescheduleCommand)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Success(c, result.ToMap(), "Series occurrences rescheduled successfully")
+}
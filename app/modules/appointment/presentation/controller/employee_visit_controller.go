@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/appointment/application/command"
+	"clinic-vet-api/app/modules/appointment/presentation/dto"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/shared/response"
+
+	authError "clinic-vet-api/app/shared/error/auth"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+	ginUtils "clinic-vet-api/app/shared/gin_utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CheckInAppointment godoc
+// @Summary Check in a patient
+// @Description Registers the arrival of the patient of a confirmed appointment of the day, the patient joins the waiting-room queue
+// @Tags vet-appointments
+// @Produce json
+// @Param id path int true "Appointment ID"
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{message=string} "Patient checked in"
+// @Failure 400 {object} response.APIResponse "Invalid appointment ID"
+// @Failure 404 {object} response.APIResponse "Appointment not found"
+// @Failure 422 {object} response.APIResponse "Appointment not confirmed or not scheduled today"
+// @Router /employees/appointments/{id}/check-in [put]
+func (ctrl *EmployeeAppointmentController) CheckInAppointment(c *gin.Context) {
+	ctrl.advanceVisit(c, enum.VisitStageCheckedIn)
+}
+
+// MoveToExamRoom godoc
+// @Summary Call a patient into the exam room
+// @Description Moves a checked-in patient from the waiting room to the exam room
+// @Tags vet-appointments
+// @Produce json
+// @Param id path int true "Appointment ID"
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{message=string} "Patient in the exam room"
+// @Failure 404 {object} response.APIResponse "Appointment not found"
+// @Failure 422 {object} response.APIResponse "Patient not waiting"
+// @Router /employees/appointments/{id}/exam-room [put]
+func (ctrl *EmployeeAppointmentController) MoveToExamRoom(c *gin.Context) {
+	ctrl.advanceVisit(c, enum.VisitStageInExamRoom)
+}
+
+// MarkReadyForCheckout godoc
+// @Summary Send a patient to checkout
+// @Description Marks the exam as over, the patient goes back to the front desk for checkout
+// @Tags vet-appointments
+// @Produce json
+// @Param id path int true "Appointment ID"
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{message=string} "Patient ready for checkout"
+// @Failure 404 {object} response.APIResponse "Appointment not found"
+// @Failure 422 {object} response.APIResponse "Patient not in the exam room"
+// @Router /employees/appointments/{id}/ready-for-checkout [put]
+func (ctrl *EmployeeAppointmentController) MarkReadyForCheckout(c *gin.Context) {
+	ctrl.advanceVisit(c, enum.VisitStageReadyForCheckout)
+}
+
+// GetWaitingRoomQueue godoc
+// @Summary Get the live waiting-room queue
+// @Description Lists today's patients still in the clinic ordered by triage priority and scheduled time, with their wait times and the metrics of the day. Covers the whole clinic for the front desk, or a single veterinarian with vet_id
+// @Tags vet-appointments
+// @Produce json
+// @Param vet_id query int false "Veterinarian ID"
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=dto.WaitingRoomResponse} "Waiting-room queue"
+// @Failure 400 {object} response.APIResponse "Invalid query params"
+// @Failure 404 {object} response.APIResponse "Veterinarian not found"
+// @Router /employees/appointments/queue [get]
+func (ctrl *EmployeeAppointmentController) GetWaitingRoomQueue(c *gin.Context) {
+	var queueRequest dto.WaitingRoomRequest
+	if err := c.ShouldBindQuery(&queueRequest); err != nil {
+		response.BadRequest(c, httpError.RequestURLQueryError(err, c.Request.URL.RawQuery))
+		return
+	}
+
+	if err := ctrl.validator.Struct(&queueRequest); err != nil {
+		response.BadRequest(c, httpError.InvalidDataError(err))
+		return
+	}
+
+	result, err := ctrl.operations.bus.QueryBus.GetWaitingRoomQueue(c.Request.Context(), queueRequest.ToQuery())
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, ctrl.operations.mapper.FromWaitingRoomResult(result), "Waiting Room Queue")
+}
+
+// advanceVisit moves the patient to the next stage. Veterinarians only handle their own
+// patients, the front desk handles every appointment
+func (ctrl *EmployeeAppointmentController) advanceVisit(c *gin.Context, stage enum.VisitStage) {
+	userCTX, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, authError.UnauthorizedCTXError())
+		return
+	}
+
+	appointmentID, err := ginUtils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	var employeeID *uint
+	if userCTX.Role == enum.UserRoleVeterinarian.String() {
+		employeeID = &userCTX.EmployeeID
+	}
+
+	advanceCommand, err := command.NewAdvanceVisitCommand(appointmentID, employeeID, stage)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	result := ctrl.operations.bus.CommandBus.AdvanceVisit(c.Request.Context(), advanceCommand)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Success(c, nil, result.Message())
+}
@@ -158,8 +158,64 @@ func (ctrl *ApptControllerOperations) GetAppointmentsByPet(c *gin.Context, petID
 	ctrl.HandlePaginatedResult(c, appointmentPage, pageParams.ToMap())
 }
 
-func (ctrl *ApptControllerOperations) GetAppointmentStats(c *gin.Context) {
-	// TODO: Implement appointment stats logic or remove this function if not needed
+func (ctrl *ApptControllerOperations) FindAvailability(c *gin.Context) {
+	var availabilityRequest dto.ApptAvailabilityRequest
+	if err := c.ShouldBindQuery(&availabilityRequest); err != nil {
+		response.BadRequest(c, httpError.RequestURLQueryError(err, c.Request.URL.RawQuery))
+		return
+	}
+
+	if err := ctrl.validate.Struct(&availabilityRequest); err != nil {
+		response.BadRequest(c, httpError.InvalidDataError(err))
+		return
+	}
+
+	availabilityQuery, err := availabilityRequest.ToQuery()
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	results, err := ctrl.bus.QueryBus.FindAvailability(c.Request.Context(), availabilityQuery)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, ctrl.mapper.FromAvailabilityResults(results), "Appointment Availability")
+}
+
+// GetAppointmentStats scopes the stats to employeeID when given, otherwise to the vet_id query
+// param or the whole clinic
+func (ctrl *ApptControllerOperations) GetAppointmentStats(c *gin.Context, employeeID *uint) {
+	var statsRequest dto.ApptStatsRequest
+	if err := c.ShouldBindQuery(&statsRequest); err != nil {
+		response.BadRequest(c, httpError.RequestURLQueryError(err, c.Request.URL.RawQuery))
+		return
+	}
+
+	if err := ctrl.validate.Struct(&statsRequest); err != nil {
+		response.BadRequest(c, httpError.InvalidDataError(err))
+		return
+	}
+
+	if employeeID == nil {
+		employeeID = statsRequest.EmployeeID
+	}
+
+	statsQuery, err := statsRequest.ToQuery(employeeID)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	result, err := ctrl.bus.QueryBus.GetStats(c.Request.Context(), statsQuery)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, ctrl.mapper.FromStatsResult(result), "Appointment Stats")
 }
 
 func (ctrl *ApptControllerOperations) HandlePaginatedResult(c *gin.Context, pageResponse page.Page[handler.ApptResult], queryParams map[string]any) {
@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/appointment/application/command"
+)
+
+// RegisterAbsenceRequest represents a period an employee cannot attend their appointments
+// @Description Request body for registering an employee absence. Confirmed appointments in the period are proposed to another veterinarian or cancelled
+type RegisterAbsenceRequest struct {
+	// Absent veterinarian, always the veterinarian registering it when they are one.
+	// Required for receptionists and admins
+	EmployeeID *uint `json:"vet_id,omitempty" binding:"omitempty,min=1" example:"12"`
+
+	// Start of the absence
+	// Required: true
+	StartDate time.Time `json:"start_date" binding:"required" example:"2024-03-15T08:00:00Z"`
+
+	// End of the absence, at most 31 days after the start
+	// Required: true
+	EndDate time.Time `json:"end_date" binding:"required" example:"2024-03-16T20:00:00Z"`
+
+	// Why the employee is absent
+	// Required: true
+	Reason string `json:"reason" binding:"required,max=500" example:"Sick leave"`
+}
+
+func (r *RegisterAbsenceRequest) ToCommand(employeeID, reportedBy uint) (command.RegisterAbsenceCommand, error) {
+	return command.NewRegisterAbsenceCommand(employeeID, r.StartDate, r.EndDate, r.Reason, reportedBy)
+}
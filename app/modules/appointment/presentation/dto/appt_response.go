@@ -11,18 +11,23 @@ import (
 
 // AppointmentResponse represents an appointment response
 type AppointmentResponse struct {
-	ID            uint    `json:"id"`
-	PetID         uint    `json:"pet_id"`
-	CustomerID    uint    `json:"customer_id"`
-	EmployeeID    *uint   `json:"vet_id,omitempty"`
-	Service       string  `json:"service"`
-	Datetime      string  `json:"date_time"`
-	ScheduledDate string  `json:"scheduled_date"`
-	Status        string  `json:"status"`
-	Reason        *string `json:"reason"`
-	Notes         *string `json:"notes,omitempty"`
-	CreatedAt     string  `json:"created_at"`
-	UpdatedAt     string  `json:"updated_at"`
+	ID             uint    `json:"id"`
+	PetID          uint    `json:"pet_id"`
+	CustomerID     uint    `json:"customer_id"`
+	EmployeeID     *uint   `json:"vet_id,omitempty"`
+	Service        string  `json:"service"`
+	Datetime       string  `json:"date_time"`
+	ScheduledDate  string  `json:"scheduled_date"`
+	Status         string  `json:"status"`
+	Reason         *string `json:"reason"`
+	Notes          *string `json:"notes,omitempty"`
+	IsEmergency    bool    `json:"is_emergency"`
+	TriagePriority *string `json:"triage_priority,omitempty"`
+	VisitStage     *string `json:"visit_stage,omitempty"`
+	CheckedInAt    *string `json:"checked_in_at,omitempty"`
+	SeriesID       *uint   `json:"series_id,omitempty"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
 }
 
 // AppointmentDetail represents a detailed appointment with related entities
@@ -67,15 +72,6 @@ type AppointmentListResponse struct {
 	Metadata page.PageMetadata     `json:"metadata"`
 }
 
-// AppointmentStats represents appointment statistics
-type AppointmentStats struct {
-	TotalAppointments     int                            `json:"total_appointments"`
-	AppointmentsByStatus  map[enum.AppointmentStatus]int `json:"appointments_by_status"`
-	AppointmentsByService map[enum.ClinicService]int     `json:"appointments_by_service"`
-	UpcomingAppointments  int                            `json:"upcoming_appointments"`
-	OverdueAppointments   int                            `json:"overdue_appointments"`
-}
-
 type ResponseMapper struct{}
 
 func NewResponseMapper() *ResponseMapper {
@@ -83,17 +79,40 @@ func NewResponseMapper() *ResponseMapper {
 }
 
 func (m *ResponseMapper) FromResult(result handler.ApptResult) *AppointmentResponse {
+	var seriesID *uint
+	if result.SeriesID != nil {
+		id := result.SeriesID.Value()
+		seriesID = &id
+	}
+
+	var triagePriority *string
+	if result.TriagePriority != nil {
+		priority := result.TriagePriority.String()
+		triagePriority = &priority
+	}
+
+	var visitStage *string
+	if result.Visit.Stage != nil {
+		stage := result.Visit.Stage.String()
+		visitStage = &stage
+	}
+
 	return &AppointmentResponse{
-		ID:         result.ID.Value(),
-		PetID:      result.PetID.Value(),
-		CustomerID: result.CustomerID.Value(),
-		EmployeeID: valueobject.OptEmployeeIDToUint(result.EmployeeID),
-		Service:    result.Service.DisplayName(),
-		Datetime:   result.ScheduledDate.Format(time.RFC822),
-		Notes:      result.Notes,
-		Status:     result.Status.DisplayName(),
-		CreatedAt:  result.CreatedAt.Format(time.RFC822),
-		UpdatedAt:  result.UpdatedAt.Format(time.RFC822),
+		ID:             result.ID.Value(),
+		PetID:          result.PetID.Value(),
+		CustomerID:     result.CustomerID.Value(),
+		EmployeeID:     valueobject.OptEmployeeIDToUint(result.EmployeeID),
+		Service:        result.Service.DisplayName(),
+		Datetime:       result.ScheduledDate.Format(time.RFC822),
+		Notes:          result.Notes,
+		Status:         result.Status.DisplayName(),
+		IsEmergency:    result.IsEmergency,
+		TriagePriority: triagePriority,
+		VisitStage:     visitStage,
+		CheckedInAt:    formatOptTime(result.Visit.CheckedInAt),
+		SeriesID:       seriesID,
+		CreatedAt:      result.CreatedAt.Format(time.RFC822),
+		UpdatedAt:      result.UpdatedAt.Format(time.RFC822),
 	}
 }
 
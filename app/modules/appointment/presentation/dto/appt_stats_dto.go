@@ -0,0 +1,118 @@
+package dto
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/appointment/application/handler"
+	"clinic-vet-api/app/modules/appointment/application/query"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// DefaultStatsRangeDays is the range covered when no dates are requested, ending today
+const DefaultStatsRangeDays = 30
+
+// ApptStatsRequest represents the query params of the appointment statistics
+// @Description Query params for appointment statistics
+type ApptStatsRequest struct {
+	// ID of the veterinarian, only honored for admins. When omitted the stats cover the whole clinic
+	EmployeeID *uint `form:"vet_id" validate:"omitempty,min=1" example:"12"`
+
+	// First day of the range (YYYY-MM-DD), defaults to 30 days before the end date
+	StartDate CustomDate `form:"start_date" example:"2024-03-01"`
+
+	// Last day of the range (YYYY-MM-DD), defaults to today
+	EndDate CustomDate `form:"end_date" example:"2024-03-31"`
+}
+
+func (r *ApptStatsRequest) ToQuery(employeeID *uint) (query.GetAppointmentStatsQuery, error) {
+	endDate := r.EndDate.Time
+	if endDate.IsZero() {
+		now := time.Now()
+		endDate = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	}
+
+	startDate := r.StartDate.Time
+	if startDate.IsZero() {
+		startDate = endDate.AddDate(0, 0, -(DefaultStatsRangeDays - 1))
+	}
+
+	return query.NewGetAppointmentStatsQuery(employeeID, startDate, endDate)
+}
+
+// ServiceStatsResponse represents the appointments of a single service
+type ServiceStatsResponse struct {
+	Service   string `json:"service"`
+	Total     int    `json:"total"`
+	Completed int    `json:"completed"`
+	NoShows   int    `json:"no_shows"`
+	Cancelled int    `json:"cancelled"`
+}
+
+// WeekdayStatsResponse represents how many appointments fall on a weekday
+type WeekdayStatsResponse struct {
+	Weekday string `json:"weekday"`
+	Count   int    `json:"count"`
+}
+
+// HourStatsResponse represents how many appointments start at an hour of the day
+type HourStatsResponse struct {
+	Hour  int `json:"hour"`
+	Count int `json:"count"`
+}
+
+// ApptStatsResponse represents the appointment statistics of a date range
+type ApptStatsResponse struct {
+	EmployeeID      *uint                  `json:"vet_id,omitempty"`
+	StartDate       string                 `json:"start_date"`
+	EndDate         string                 `json:"end_date"`
+	Total           int                    `json:"total_appointments"`
+	ByStatus        map[string]int         `json:"appointments_by_status"`
+	NoShowRate      float64                `json:"no_show_rate"`
+	CompletionRate  float64                `json:"completion_rate"`
+	AvgLeadTimeDays float64                `json:"avg_lead_time_days"`
+	ByService       []ServiceStatsResponse `json:"appointments_by_service"`
+	BusiestWeekdays []WeekdayStatsResponse `json:"busiest_weekdays"`
+	BusiestHours    []HourStatsResponse    `json:"busiest_hours"`
+}
+
+func (m *ResponseMapper) FromStatsResult(result handler.ApptStatsResult) ApptStatsResponse {
+	byStatus := make(map[string]int, len(result.ByStatus))
+	for status, count := range result.ByStatus {
+		byStatus[status.String()] = count
+	}
+
+	byService := make([]ServiceStatsResponse, len(result.ByService))
+	for i, service := range result.ByService {
+		byService[i] = ServiceStatsResponse{
+			Service:   service.Service.String(),
+			Total:     service.Total,
+			Completed: service.Completed,
+			NoShows:   service.NoShows,
+			Cancelled: service.Cancelled,
+		}
+	}
+
+	weekdays := make([]WeekdayStatsResponse, len(result.BusiestWeekdays))
+	for i, weekday := range result.BusiestWeekdays {
+		weekdays[i] = WeekdayStatsResponse{Weekday: weekday.Weekday.String(), Count: weekday.Count}
+	}
+
+	hours := make([]HourStatsResponse, len(result.BusiestHours))
+	for i, hour := range result.BusiestHours {
+		hours[i] = HourStatsResponse{Hour: hour.Hour, Count: hour.Count}
+	}
+
+	return ApptStatsResponse{
+		EmployeeID:      valueobject.OptEmployeeIDToUint(result.EmployeeID),
+		StartDate:       result.StartDate.Format(time.DateOnly),
+		EndDate:         result.EndDate.Format(time.DateOnly),
+		Total:           result.Total,
+		ByStatus:        byStatus,
+		NoShowRate:      result.NoShowRate,
+		CompletionRate:  result.CompletionRate,
+		AvgLeadTimeDays: result.AvgLeadTime.Hours() / 24,
+		ByService:       byService,
+		BusiestWeekdays: weekdays,
+		BusiestHours:    hours,
+	}
+}
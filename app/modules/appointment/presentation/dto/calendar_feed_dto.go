@@ -0,0 +1,86 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"clinic-vet-api/app/modules/appointment/application/handler"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/shared/ical"
+)
+
+const calendarFeedProdID = "-//Clinic Vet API//Appointments//EN"
+
+// CalendarFeedResponse represents the subscription URL of a calendar feed
+type CalendarFeedResponse struct {
+	ID        uint      `json:"id"`
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (m *ResponseMapper) FromCalendarFeedResult(result handler.CalendarFeedResult, baseURL string) CalendarFeedResponse {
+	return CalendarFeedResponse{
+		ID:        result.ID.Value(),
+		URL:       fmt.Sprintf("%s/%s.ics", strings.TrimSuffix(baseURL, "/"), result.Token),
+		CreatedAt: result.CreatedAt,
+	}
+}
+
+// ToICalendar maps every appointment to a VEVENT identified by the appointment ID, so updates
+// replace the event already in the subscriber calendar
+func (m *ResponseMapper) ToICalendar(result handler.CalendarFeedEventsResult) ical.Calendar {
+	events := make([]ical.Event, len(result.Events))
+	for i, event := range result.Events {
+		appointment := event.Appointment
+
+		summary := appointment.Service.DisplayName()
+		if event.PetName != "" {
+			summary = fmt.Sprintf("%s - %s", summary, event.PetName)
+		}
+
+		events[i] = ical.Event{
+			UID:         fmt.Sprintf("appointment-%d@clinic-vet-api", appointment.ID.Value()),
+			Sequence:    event.Sequence,
+			Stamp:       appointment.UpdatedAt,
+			Start:       appointment.ScheduledDate,
+			End:         event.EndDate,
+			Summary:     summary,
+			Description: calendarEventDescription(event),
+			Status:      calendarEventStatus(appointment.Status),
+		}
+	}
+
+	name := "Vet Appointments"
+	if result.OwnerType == enum.CalendarFeedOwnerCustomer {
+		name = "My Pet Appointments"
+	}
+
+	return ical.Calendar{ProdID: calendarFeedProdID, Name: name, Events: events}
+}
+
+func calendarEventDescription(event handler.CalendarEventResult) string {
+	lines := []string{}
+	if event.PetName != "" {
+		lines = append(lines, "Pet: "+event.PetName)
+	}
+	lines = append(lines,
+		"Service: "+event.Appointment.Service.DisplayName(),
+		"Status: "+event.Appointment.Status.DisplayName(),
+	)
+	if event.Appointment.Notes != nil && *event.Appointment.Notes != "" {
+		lines = append(lines, "Notes: "+*event.Appointment.Notes)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func calendarEventStatus(status enum.AppointmentStatus) ical.EventStatus {
+	switch status {
+	case enum.AppointmentStatusCancelled:
+		return ical.EventStatusCancelled
+	case enum.AppointmentStatusPending:
+		return ical.EventStatusTentative
+	default:
+		return ical.EventStatusConfirmed
+	}
+}
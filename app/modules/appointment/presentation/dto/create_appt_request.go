@@ -33,9 +33,9 @@ type CreateApptRequest struct {
 	// Additional notes for the appointment (optional)
 	Notes *string `json:"notes" example:"Patient has allergy to penicillin"`
 
-	// Status of the appointment
+	// Status of the appointment, a confirmed appointment needs an employee
 	// Required: true
-	Status string `json:"status" binding:"required" example:"scheduled"`
+	Status string `json:"status" binding:"required,oneof=pending confirmed" example:"confirmed"`
 }
 
 // RequestApptResponse represents the response for appointment request
@@ -0,0 +1,35 @@
+package dto
+
+import "clinic-vet-api/app/modules/appointment/application/command"
+
+// CreateEmergencyApptRequest represents a walk-in or emergency registered at the front desk
+// @Description Request body for registering an emergency. The appointment starts right away and opens the medical session of the visit
+type CreateEmergencyApptRequest struct {
+	// ID of the customer owning the pet
+	// Required: true
+	CustomerID uint `json:"customer_id" binding:"required,min=1" example:"123"`
+
+	// ID of the pet brought in
+	// Required: true
+	PetID uint `json:"pet_id" binding:"required,min=1" example:"456"`
+
+	// Veterinarian attending the emergency, defaults to the veterinarian on call. The veterinarian
+	// registering it stands in when nobody is on call
+	EmployeeID *uint `json:"vet_id,omitempty" binding:"omitempty,min=1" example:"12"`
+
+	// Service provided, defaults to emergency_care
+	Service string `json:"service,omitempty" example:"emergency_care"`
+
+	// Triage priority (critical, urgent, semi_urgent, non_urgent)
+	// Required: true
+	TriagePriority string `json:"triage_priority" binding:"required" example:"urgent"`
+
+	// Presenting complaint and intake notes
+	Notes *string `json:"notes,omitempty" binding:"omitempty,max=1000" example:"Hit by a car, bleeding from the left leg"`
+}
+
+func (r *CreateEmergencyApptRequest) ToCommand(registeredBy uint) (command.CreateEmergencyApptCommand, error) {
+	return command.NewCreateEmergencyApptCommand(
+		r.CustomerID, r.PetID, r.EmployeeID, registeredBy, r.Service, r.TriagePriority, r.Notes,
+	)
+}
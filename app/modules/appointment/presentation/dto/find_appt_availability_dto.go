@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/appointment/application/handler"
+	"clinic-vet-api/app/modules/appointment/application/query"
+)
+
+// ApptAvailabilityRequest represents the query params to look up free appointment slots
+// @Description Query params for listing available appointment slots
+type ApptAvailabilityRequest struct {
+	// ID of the veterinarian, when omitted all active employees are checked
+	EmployeeID *uint `form:"vet_id" validate:"omitempty,min=1" example:"12"`
+
+	// Service to be booked, determines the slot length
+	// Required: true
+	Service string `form:"service" binding:"required" validate:"required" example:"general_consultation"`
+
+	// First day of the range (YYYY-MM-DD)
+	// Required: true
+	StartDate CustomDate `form:"start_date" binding:"required" example:"2024-03-15"`
+
+	// Last day of the range (YYYY-MM-DD)
+	// Required: true
+	EndDate CustomDate `form:"end_date" binding:"required" example:"2024-03-22"`
+}
+
+func (r *ApptAvailabilityRequest) ToQuery() (query.FindApptAvailabilityQuery, error) {
+	return query.NewFindApptAvailabilityQuery(r.EmployeeID, r.Service, r.StartDate.Time, r.EndDate.Time)
+}
+
+// ApptSlotResponse represents a free slot
+type ApptSlotResponse struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// ApptAvailabilityResponse represents the free slots of a veterinarian for a single day
+type ApptAvailabilityResponse struct {
+	EmployeeID uint               `json:"vet_id"`
+	Service    string             `json:"service"`
+	Date       string             `json:"date"`
+	Slots      []ApptSlotResponse `json:"slots"`
+}
+
+func (m *ResponseMapper) FromAvailabilityResults(results []handler.ApptAvailabilityResult) []ApptAvailabilityResponse {
+	responses := make([]ApptAvailabilityResponse, len(results))
+	for i, result := range results {
+		slots := make([]ApptSlotResponse, len(result.Slots))
+		for j, slot := range result.Slots {
+			slots[j] = ApptSlotResponse{Start: slot.Start, End: slot.End}
+		}
+
+		responses[i] = ApptAvailabilityResponse{
+			EmployeeID: result.EmployeeID.Value(),
+			Service:    result.Service.String(),
+			Date:       result.Date.Format("2006-01-02"),
+			Slots:      slots,
+		}
+	}
+	return responses
+}
@@ -43,6 +43,11 @@ func (cd *CustomDate) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// UnmarshalParam lets gin bind the date from query and form params
+func (cd *CustomDate) UnmarshalParam(param string) error {
+	return cd.UnmarshalText([]byte(param))
+}
+
 func (cd *CustomDate) UnmarshalText(text []byte) error {
 	t, err := time.Parse("2006-01-02", string(text))
 	if err != nil {
@@ -0,0 +1,131 @@
+package dto
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/appointment/application/command"
+	"clinic-vet-api/app/modules/appointment/application/handler"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// CreateApptSeriesRequest represents the request to book a recurring appointment
+// @Description Request body for creating an appointment series. Either count or until must be provided
+type CreateApptSeriesRequest struct {
+	// ID of the customer owning the pet
+	// Required: true
+	CustomerID uint `json:"customer_id" binding:"required,min=1" example:"123"`
+
+	// ID of the pet for the appointments
+	// Required: true
+	PetID uint `json:"pet_id" binding:"required,min=1" example:"456"`
+
+	// Veterinarian attending every occurrence, occurrences stay pending when omitted
+	EmployeeID *uint `json:"vet_id,omitempty" binding:"omitempty,min=1" example:"12"`
+
+	// Service requested
+	// Required: true
+	Service string `json:"service" binding:"required" example:"general_consultation"`
+
+	// Date and time of the first occurrence
+	// Required: true
+	// Format: RFC3339
+	StartDate time.Time `json:"start_date" binding:"required" example:"2024-03-15T10:30:00Z"`
+
+	// Recurrence frequency (weekly, monthly)
+	// Required: true
+	Frequency string `json:"frequency" binding:"required" example:"weekly"`
+
+	// Weeks or months between occurrences, defaults to 1
+	Interval int `json:"interval,omitempty" binding:"omitempty,min=1,max=12" example:"2"`
+
+	// Number of occurrences
+	Count *int `json:"count,omitempty" binding:"omitempty,min=1,max=52" example:"6"`
+
+	// Last day an occurrence can fall on (YYYY-MM-DD)
+	Until *CustomDate `json:"until,omitempty" example:"2024-06-30"`
+
+	// Additional notes copied to every occurrence (optional)
+	Notes *string `json:"notes,omitempty" binding:"omitempty,max=1000" example:"Insulin follow-up"`
+}
+
+func (r *CreateApptSeriesRequest) ToCommand() (command.CreateApptSeriesCommand, error) {
+	var until *time.Time
+	if r.Until != nil {
+		until = &r.Until.Time
+	}
+
+	return command.NewCreateApptSeriesCommand(
+		r.CustomerID, r.PetID, r.EmployeeID, r.Service, r.StartDate,
+		r.Frequency, r.Interval, r.Count, until, r.Notes,
+	)
+}
+
+// CancelSeriesOccurrenceRequest represents the request to cancel one or more occurrences
+// @Description Scope is "occurrence" for the selected one only or "following" for it and the ones after it
+type CancelSeriesOccurrenceRequest struct {
+	// Required: true
+	Scope string `json:"scope" binding:"required" example:"following"`
+
+	// Required: true
+	Reason string `json:"reason" binding:"required,max=500" example:"Treatment finished early"`
+}
+
+func (r *CancelSeriesOccurrenceRequest) ToCommand(appointmentID uint, employeeID *uint) (command.CancelApptSeriesCommand, error) {
+	return command.NewCancelApptSeriesCommand(appointmentID, employeeID, r.Scope, r.Reason)
+}
+
+// RescheduleSeriesOccurrenceRequest represents the request to move one or more occurrences
+// @Description With scope "following" every later occurrence is shifted by the same offset
+type RescheduleSeriesOccurrenceRequest struct {
+	// New date and time of the selected occurrence (RFC3339 format)
+	// Required: true
+	NewDateTime time.Time `json:"datetime" binding:"required" example:"2024-03-16T10:30:00Z"`
+
+	// Required: true
+	Scope string `json:"scope" binding:"required" example:"occurrence"`
+}
+
+func (r *RescheduleSeriesOccurrenceRequest) ToCommand(appointmentID uint, employeeID *uint) (command.RescheduleApptSeriesCommand, error) {
+	return command.NewRescheduleApptSeriesCommand(appointmentID, employeeID, r.NewDateTime, r.Scope)
+}
+
+// ApptSeriesResponse represents an appointment series with its occurrences
+type ApptSeriesResponse struct {
+	ID          uint                  `json:"id"`
+	CustomerID  uint                  `json:"customer_id"`
+	PetID       uint                  `json:"pet_id"`
+	EmployeeID  *uint                 `json:"vet_id,omitempty"`
+	Service     string                `json:"service"`
+	StartDate   time.Time             `json:"start_date"`
+	Frequency   string                `json:"frequency"`
+	Interval    int                   `json:"interval"`
+	Count       *int                  `json:"count,omitempty"`
+	Until       *string               `json:"until,omitempty"`
+	Notes       *string               `json:"notes,omitempty"`
+	Occurrences []AppointmentResponse `json:"occurrences"`
+	CreatedAt   time.Time             `json:"created_at"`
+}
+
+func (m *ResponseMapper) FromSeriesResult(result handler.ApptSeriesResult) ApptSeriesResponse {
+	var until *string
+	if result.UntilDate != nil {
+		formatted := result.UntilDate.Format("2006-01-02")
+		until = &formatted
+	}
+
+	return ApptSeriesResponse{
+		ID:          result.ID.Value(),
+		CustomerID:  result.CustomerID.Value(),
+		PetID:       result.PetID.Value(),
+		EmployeeID:  valueobject.OptEmployeeIDToUint(result.EmployeeID),
+		Service:     result.Service.DisplayName(),
+		StartDate:   result.StartDate,
+		Frequency:   result.Frequency.String(),
+		Interval:    result.Interval,
+		Count:       result.OccurrenceCount,
+		Until:       until,
+		Notes:       result.Notes,
+		Occurrences: m.FromResults(result.Occurrences),
+		CreatedAt:   result.CreatedAt,
+	}
+}
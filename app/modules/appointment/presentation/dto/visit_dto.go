@@ -0,0 +1,110 @@
+package dto
+
+import (
+	"math"
+	"time"
+
+	"clinic-vet-api/app/modules/appointment/application/handler"
+	"clinic-vet-api/app/modules/appointment/application/query"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// WaitingRoomRequest represents the query params of the live queue
+// @Description Query params for the waiting-room queue
+type WaitingRoomRequest struct {
+	// ID of the veterinarian whose patients are listed. When omitted the queue covers the whole clinic
+	EmployeeID *uint `form:"vet_id" validate:"omitempty,min=1" example:"12"`
+}
+
+func (r *WaitingRoomRequest) ToQuery() query.GetWaitingRoomQueueQuery {
+	return query.NewGetWaitingRoomQueueQuery(r.EmployeeID)
+}
+
+// QueueEntryResponse represents a patient in the clinic
+type QueueEntryResponse struct {
+	AppointmentID      uint    `json:"appointment_id"`
+	PetID              uint    `json:"pet_id"`
+	CustomerID         uint    `json:"customer_id"`
+	EmployeeID         *uint   `json:"vet_id,omitempty"`
+	Service            string  `json:"service"`
+	ScheduledDate      string  `json:"scheduled_date"`
+	IsEmergency        bool    `json:"is_emergency"`
+	TriagePriority     *string `json:"triage_priority,omitempty"`
+	VisitStage         string  `json:"visit_stage"`
+	Position           int     `json:"position,omitempty"`
+	CheckedInAt        *string `json:"checked_in_at,omitempty"`
+	InExamRoomAt       *string `json:"in_exam_room_at,omitempty"`
+	ReadyForCheckoutAt *string `json:"ready_for_checkout_at,omitempty"`
+	WaitMinutes        int     `json:"wait_minutes"`
+	ExamMinutes        int     `json:"exam_minutes"`
+}
+
+// WaitingRoomMetricsResponse represents the wait-time metrics of the day
+type WaitingRoomMetricsResponse struct {
+	Waiting              int `json:"waiting"`
+	InExamRoom           int `json:"in_exam_room"`
+	ReadyForCheckout     int `json:"ready_for_checkout"`
+	AvgWaitMinutes       int `json:"avg_wait_minutes"`
+	LongestWaitMinutes   int `json:"longest_wait_minutes"`
+	AvgDoorToRoomMinutes int `json:"avg_door_to_room_minutes"`
+	SeenToday            int `json:"seen_today"`
+}
+
+// WaitingRoomResponse represents the live queue of the patients in the clinic
+type WaitingRoomResponse struct {
+	EmployeeID  *uint                      `json:"vet_id,omitempty"`
+	GeneratedAt string                     `json:"generated_at"`
+	Metrics     WaitingRoomMetricsResponse `json:"metrics"`
+	Queue       []QueueEntryResponse       `json:"queue"`
+}
+
+func (m *ResponseMapper) FromWaitingRoomResult(result handler.WaitingRoomQueueResult) WaitingRoomResponse {
+	queue := make([]QueueEntryResponse, len(result.Entries))
+	for i, entry := range result.Entries {
+		appt := m.FromResult(entry.Appointment)
+		queue[i] = QueueEntryResponse{
+			AppointmentID:      appt.ID,
+			PetID:              appt.PetID,
+			CustomerID:         appt.CustomerID,
+			EmployeeID:         appt.EmployeeID,
+			Service:            appt.Service,
+			ScheduledDate:      appt.Datetime,
+			IsEmergency:        appt.IsEmergency,
+			TriagePriority:     appt.TriagePriority,
+			VisitStage:         entry.Appointment.Visit.Stage.String(),
+			Position:           entry.Position,
+			CheckedInAt:        formatOptTime(entry.Appointment.Visit.CheckedInAt),
+			InExamRoomAt:       formatOptTime(entry.Appointment.Visit.InExamRoomAt),
+			ReadyForCheckoutAt: formatOptTime(entry.Appointment.Visit.ReadyForCheckoutAt),
+			WaitMinutes:        minutes(entry.WaitTime),
+			ExamMinutes:        minutes(entry.ExamTime),
+		}
+	}
+
+	return WaitingRoomResponse{
+		EmployeeID:  valueobject.OptEmployeeIDToUint(result.EmployeeID),
+		GeneratedAt: result.GeneratedAt.Format(time.RFC822),
+		Metrics: WaitingRoomMetricsResponse{
+			Waiting:              result.Metrics.Waiting,
+			InExamRoom:           result.Metrics.InExamRoom,
+			ReadyForCheckout:     result.Metrics.ReadyForCheckout,
+			AvgWaitMinutes:       minutes(result.Metrics.AvgWait),
+			LongestWaitMinutes:   minutes(result.Metrics.LongestWait),
+			AvgDoorToRoomMinutes: minutes(result.Metrics.AvgDoorToRoom),
+			SeenToday:            result.Metrics.Seen,
+		},
+		Queue: queue,
+	}
+}
+
+func formatOptTime(value *time.Time) *string {
+	if value == nil {
+		return nil
+	}
+	formatted := value.Format(time.RFC822)
+	return &formatted
+}
+
+func minutes(duration time.Duration) int {
+	return int(math.Round(duration.Minutes()))
+}
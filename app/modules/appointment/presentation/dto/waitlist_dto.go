@@ -0,0 +1,91 @@
+package dto
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/appointment/application/command"
+	"clinic-vet-api/app/modules/appointment/application/handler"
+)
+
+// JoinWaitlistRequest represents the request to wait for a freed slot
+// @Description Request body for joining the appointment waitlist
+type JoinWaitlistRequest struct {
+	// ID of the pet for the appointment
+	// Required: true
+	PetID uint `json:"pet_id" binding:"required,min=1" example:"456"`
+
+	// Service requested
+	// Required: true
+	Service string `json:"service" binding:"required" example:"general_consultation"`
+
+	// Day the customer wants the appointment (YYYY-MM-DD)
+	// Required: true
+	RequestedDate CustomDate `json:"requested_date" binding:"required" example:"2024-03-15"`
+
+	// Veterinarian the customer prefers, any veterinarian when omitted
+	PreferredEmployeeID *uint `json:"preferred_vet_id,omitempty" binding:"omitempty,min=1" example:"12"`
+
+	// Additional notes for the appointment (optional)
+	Notes *string `json:"notes,omitempty" binding:"omitempty,max=1000" example:"Mornings preferred"`
+}
+
+func (r *JoinWaitlistRequest) ToCommand(customerID uint) (command.JoinWaitlistCommand, error) {
+	return command.NewJoinWaitlistCommand(customerID, r.PetID, r.Service, r.RequestedDate.Time, r.PreferredEmployeeID, r.Notes)
+}
+
+// ClaimWaitlistSlotRequest represents the request to claim an offered slot
+// @Description Request body with the claim token received in the offer notification
+type ClaimWaitlistSlotRequest struct {
+	// Token received with the offer
+	// Required: true
+	ClaimToken string `json:"claim_token" binding:"required" example:"5f2b9c..."`
+}
+
+// WaitlistEntryResponse represents a waitlist entry
+type WaitlistEntryResponse struct {
+	ID                  uint       `json:"id"`
+	PetID               uint       `json:"pet_id"`
+	Service             string     `json:"service"`
+	RequestedDate       string     `json:"requested_date"`
+	PreferredEmployeeID *uint      `json:"preferred_vet_id,omitempty"`
+	Notes               *string    `json:"notes,omitempty"`
+	Status              string     `json:"status"`
+	OfferedSlot         *time.Time `json:"offered_slot,omitempty"`
+	OfferedEmployeeID   *uint      `json:"offered_vet_id,omitempty"`
+	OfferExpiresAt      *time.Time `json:"offer_expires_at,omitempty"`
+	AppointmentID       *uint      `json:"appointment_id,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+}
+
+func (m *ResponseMapper) FromWaitlistResults(results []handler.WaitlistEntryResult) []WaitlistEntryResponse {
+	responses := make([]WaitlistEntryResponse, len(results))
+	for i, result := range results {
+		response := WaitlistEntryResponse{
+			ID:             result.ID.Value(),
+			PetID:          result.PetID.Value(),
+			Service:        result.Service.String(),
+			RequestedDate:  result.RequestedDate.Format("2006-01-02"),
+			Notes:          result.Notes,
+			Status:         result.Status.String(),
+			OfferedSlot:    result.OfferedSlot,
+			OfferExpiresAt: result.OfferExpiresAt,
+			CreatedAt:      result.CreatedAt,
+		}
+
+		if result.PreferredEmployeeID != nil {
+			id := result.PreferredEmployeeID.Value()
+			response.PreferredEmployeeID = &id
+		}
+		if result.OfferedEmployeeID != nil {
+			id := result.OfferedEmployeeID.Value()
+			response.OfferedEmployeeID = &id
+		}
+		if result.AppointmentID != nil {
+			id := result.AppointmentID.Value()
+			response.AppointmentID = &id
+		}
+
+		responses[i] = response
+	}
+	return responses
+}
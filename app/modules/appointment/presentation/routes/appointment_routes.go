@@ -8,21 +8,27 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// CalendarFeedPath is where the public calendar feeds are served, relative to the API router
+const CalendarFeedPath = "/calendar-feeds"
+
 type AppointmentRoutes struct {
 	customerController *controller.CustomerAppointmetController
 	employeeController *controller.EmployeeAppointmentController
 	adminController    *controller.AdminApptController
+	feedController     *controller.CalendarFeedController
 }
 
 func NewAppointmentRoutes(
 	customerController *controller.CustomerAppointmetController,
 	employeeController *controller.EmployeeAppointmentController,
 	adminController *controller.AdminApptController,
+	feedController *controller.CalendarFeedController,
 ) *AppointmentRoutes {
 	return &AppointmentRoutes{
 		customerController: customerController,
 		employeeController: employeeController,
 		adminController:    adminController,
+		feedController:     feedController,
 	}
 }
 
@@ -41,6 +47,14 @@ func (r *AppointmentRoutes) RegisterAdminRoutes(router *gin.RouterGroup, middlew
 		appointmentGroup.GET("/", r.adminController.GetBySpecfificationAppointments)
 
 	}
+
+	// Schedule and statistics queries expose every employee, so they always require an admin
+	insightsGroup := router.Group("/appointments")
+	insightsGroup.Use(middleware.Authenticate())
+	insightsGroup.Use(middleware.RequireAnyRole("admin"))
+
+	insightsGroup.GET("/availability", r.adminController.GetAvailability)
+	insightsGroup.GET("/stats", r.adminController.GetAppointmentStats)
 }
 
 func (r *AppointmentRoutes) RegisterCustomerRoutes(router *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware) {
@@ -50,9 +64,17 @@ func (r *AppointmentRoutes) RegisterCustomerRoutes(router *gin.RouterGroup, auth
 
 	customerGroup.GET("/", r.customerController.GetMyAppointments)
 	customerGroup.GET("/upcoming", r.customerController.GetMyUpcomingAppointments)
+	customerGroup.GET("/waitlist", r.customerController.GetMyWaitlist)
+	customerGroup.POST("/waitlist", r.customerController.JoinWaitlist)
+	customerGroup.POST("/waitlist/claim", r.customerController.ClaimWaitlistSlot)
+	customerGroup.DELETE("/waitlist/:id", r.customerController.LeaveWaitlist)
+	customerGroup.GET("/calendar-feed", r.feedController.GetCustomerFeed)
+	customerGroup.POST("/calendar-feed", r.feedController.IssueCustomerFeed)
+	customerGroup.DELETE("/calendar-feed", r.feedController.RevokeCustomerFeed)
 	customerGroup.GET("/:id", r.customerController.GetMyAppointmentByID)
 	customerGroup.GET("/pets/:petID/", r.customerController.GetAppointmentsByPet)
 	customerGroup.POST("/", r.customerController.RequestAppointment)
+	customerGroup.PUT("/:id/confirm", r.customerController.ConfirmProposal)
 	//customerGroup.PUT("//:id/reschedule", controller.RescheduleAppointment)
 	//customerGroup.DELETE("/:id", controller.CancelAppointment)
 
@@ -65,9 +87,30 @@ func (r *AppointmentRoutes) RegisterEmployeeRoutes(router *gin.RouterGroup, auth
 
 	employeeRoutes.GET("", r.employeeController.GetMyAppointments)
 	employeeRoutes.GET("/stats", r.employeeController.GetAppointmentStats)
+	employeeRoutes.GET("/availability", r.employeeController.GetAvailability)
+	employeeRoutes.POST("/emergency", r.employeeController.CreateEmergencyAppointment)
+	employeeRoutes.POST("/absences", r.employeeController.RegisterAbsence)
+	employeeRoutes.GET("/queue", r.employeeController.GetWaitingRoomQueue)
+	employeeRoutes.POST("/series", r.employeeController.CreateAppointmentSeries)
+	employeeRoutes.GET("/series/:id", r.employeeController.GetAppointmentSeries)
+	employeeRoutes.PUT("/series/occurrences/:id/cancel", r.employeeController.CancelSeriesOccurrence)
+	employeeRoutes.PUT("/series/occurrences/:id/reschedule", r.employeeController.RescheduleSeriesOccurrence)
+	employeeRoutes.GET("/calendar-feed", r.feedController.GetEmployeeFeed)
+	employeeRoutes.POST("/calendar-feed", r.feedController.IssueEmployeeFeed)
+	employeeRoutes.DELETE("/calendar-feed", r.feedController.RevokeEmployeeFeed)
 	employeeRoutes.PUT("/:id/confirm", r.employeeController.ConfirmAppointment)
 	employeeRoutes.PUT("/:id/complete", r.employeeController.CompleteAppointment)
 	employeeRoutes.PUT("/:id/reschedule", r.employeeController.RescheduleAppointment)
 	employeeRoutes.PUT("/:id/no-show", r.employeeController.MarkAsNoShow)
 	employeeRoutes.PUT("/:id/cancel", r.employeeController.CancelAppointment)
+	employeeRoutes.PUT("/:id/check-in", r.employeeController.CheckInAppointment)
+	employeeRoutes.PUT("/:id/exam-room", r.employeeController.MoveToExamRoom)
+	employeeRoutes.PUT("/:id/ready-for-checkout", r.employeeController.MarkReadyForCheckout)
+}
+
+// RegisterCalendarFeedRoutes registers the public feed calendar clients subscribe to, access is
+// granted by the signed token in the URL
+func (r *AppointmentRoutes) RegisterCalendarFeedRoutes(router *gin.RouterGroup) {
+	feedGroup := router.Group(CalendarFeedPath)
+	feedGroup.GET("/:token", r.feedController.ServeFeed)
 }
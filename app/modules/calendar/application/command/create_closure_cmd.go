@@ -0,0 +1,60 @@
+package command
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/calendar"
+	"clinic-vet-api/app/modules/core/domain/enum"
+)
+
+type CreateClosureCommand struct {
+	date        time.Time
+	closureType enum.ClosureType
+	reason      string
+	startHour   *int
+	endHour     *int
+}
+
+func NewCreateClosureCommand(
+	date time.Time, closureType string, reason string, startHour, endHour *int,
+) (CreateClosureCommand, error) {
+	closureTypeEnum, err := enum.ParseClosureType(closureType)
+	if err != nil {
+		return CreateClosureCommand{}, createClosureCmdErr("closureType", err.Error())
+	}
+
+	cmd := CreateClosureCommand{
+		date:        date,
+		closureType: closureTypeEnum,
+		reason:      reason,
+		startHour:   startHour,
+		endHour:     endHour,
+	}
+
+	if err := cmd.validate(); err != nil {
+		return CreateClosureCommand{}, err
+	}
+
+	return cmd, nil
+}
+
+func (c *CreateClosureCommand) validate() error {
+	if c.date.IsZero() {
+		return createClosureCmdErr("date", "is required")
+	}
+
+	if c.reason == "" {
+		return createClosureCmdErr("reason", "is required")
+	}
+
+	return nil
+}
+
+func (c *CreateClosureCommand) ToEntity() *calendar.Closure {
+	return calendar.NewClosureBuilder().
+		WithDate(c.date).
+		WithClosureType(c.closureType).
+		WithReason(c.reason).
+		WithHours(c.startHour, c.endHour).
+		Build()
+}
@@ -0,0 +1,21 @@
+package command
+
+import (
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type DeleteClosureCommand struct {
+	closureID valueobject.ClosureID
+}
+
+func NewDeleteClosureCommand(closureID uint) (DeleteClosureCommand, error) {
+	cmd := DeleteClosureCommand{closureID: valueobject.NewClosureID(closureID)}
+
+	if cmd.closureID.IsZero() {
+		return DeleteClosureCommand{}, deleteClosureCmdErr("closureID", "is required")
+	}
+
+	return cmd, nil
+}
+
+func (c *DeleteClosureCommand) ClosureID() valueobject.ClosureID { return c.closureID }
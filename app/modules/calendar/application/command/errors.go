@@ -0,0 +1,21 @@
+package command
+
+import (
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+func openingHoursCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "UpdateOpeningHoursCommand")
+}
+
+func bookingPolicyCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "UpdateBookingPolicyCommand")
+}
+
+func createClosureCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "CreateClosureCommand")
+}
+
+func deleteClosureCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "DeleteClosureCommand")
+}
@@ -0,0 +1,39 @@
+package command
+
+import (
+	"clinic-vet-api/app/modules/core/domain/entity/calendar"
+)
+
+type UpdateBookingPolicyCommand struct {
+	minLeadDays int
+	maxLeadDays int
+}
+
+func NewUpdateBookingPolicyCommand(minLeadDays, maxLeadDays int) (UpdateBookingPolicyCommand, error) {
+	cmd := UpdateBookingPolicyCommand{
+		minLeadDays: minLeadDays,
+		maxLeadDays: maxLeadDays,
+	}
+
+	if err := cmd.validate(); err != nil {
+		return UpdateBookingPolicyCommand{}, err
+	}
+
+	return cmd, nil
+}
+
+func (c *UpdateBookingPolicyCommand) validate() error {
+	if c.minLeadDays < 0 {
+		return bookingPolicyCmdErr("minLeadDays", "cannot be negative")
+	}
+
+	if c.maxLeadDays <= 0 {
+		return bookingPolicyCmdErr("maxLeadDays", "must be greater than zero")
+	}
+
+	return nil
+}
+
+func (c *UpdateBookingPolicyCommand) ToPolicy() calendar.BookingPolicy {
+	return calendar.BookingPolicy{MinLeadDays: c.minLeadDays, MaxLeadDays: c.maxLeadDays}
+}
@@ -0,0 +1,29 @@
+package command
+
+import (
+	"clinic-vet-api/app/modules/core/domain/entity/calendar"
+)
+
+type UpdateOpeningHoursCommand struct {
+	openingHours []calendar.OpeningHours
+}
+
+func NewUpdateOpeningHoursCommand(openingHours []calendar.OpeningHours) (UpdateOpeningHoursCommand, error) {
+	cmd := UpdateOpeningHoursCommand{openingHours: openingHours}
+
+	if err := cmd.validate(); err != nil {
+		return UpdateOpeningHoursCommand{}, err
+	}
+
+	return cmd, nil
+}
+
+func (c *UpdateOpeningHoursCommand) validate() error {
+	if len(c.openingHours) == 0 {
+		return openingHoursCmdErr("openingHours", "must contain at least one day")
+	}
+
+	return nil
+}
+
+func (c *UpdateOpeningHoursCommand) OpeningHours() []calendar.OpeningHours { return c.openingHours }
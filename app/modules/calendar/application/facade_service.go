@@ -0,0 +1,56 @@
+package application
+
+import (
+	"context"
+
+	c "clinic-vet-api/app/modules/calendar/application/command"
+	h "clinic-vet-api/app/modules/calendar/application/handler"
+	q "clinic-vet-api/app/modules/calendar/application/query"
+	"clinic-vet-api/app/shared/cqrs"
+)
+
+type CalendarFacadeService interface {
+	GetCalendar(ctx context.Context) (h.CalendarResult, error)
+	FindClosures(ctx context.Context, qry q.FindClosuresQuery) ([]h.ClosureResult, error)
+
+	UpdateOpeningHours(ctx context.Context, cmd c.UpdateOpeningHoursCommand) cqrs.CommandResult
+	UpdateBookingPolicy(ctx context.Context, cmd c.UpdateBookingPolicyCommand) cqrs.CommandResult
+	CreateClosure(ctx context.Context, cmd c.CreateClosureCommand) cqrs.CommandResult
+	DeleteClosure(ctx context.Context, cmd c.DeleteClosureCommand) cqrs.CommandResult
+}
+
+type calendarFacadeService struct {
+	qryHandler *h.CalendarQueryHandler
+	cmdHandler *h.CalendarCommandHandler
+}
+
+func NewCalendarFacadeService(qryHandler *h.CalendarQueryHandler, cmdHandler *h.CalendarCommandHandler) CalendarFacadeService {
+	return &calendarFacadeService{
+		qryHandler: qryHandler,
+		cmdHandler: cmdHandler,
+	}
+}
+
+func (s *calendarFacadeService) GetCalendar(ctx context.Context) (h.CalendarResult, error) {
+	return s.qryHandler.HandleGetCalendar(ctx)
+}
+
+func (s *calendarFacadeService) FindClosures(ctx context.Context, qry q.FindClosuresQuery) ([]h.ClosureResult, error) {
+	return s.qryHandler.HandleFindClosures(ctx, qry)
+}
+
+func (s *calendarFacadeService) UpdateOpeningHours(ctx context.Context, cmd c.UpdateOpeningHoursCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleUpdateOpeningHours(ctx, cmd)
+}
+
+func (s *calendarFacadeService) UpdateBookingPolicy(ctx context.Context, cmd c.UpdateBookingPolicyCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleUpdateBookingPolicy(ctx, cmd)
+}
+
+func (s *calendarFacadeService) CreateClosure(ctx context.Context, cmd c.CreateClosureCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleCreateClosure(ctx, cmd)
+}
+
+func (s *calendarFacadeService) DeleteClosure(ctx context.Context, cmd c.DeleteClosureCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleDeleteClosure(ctx, cmd)
+}
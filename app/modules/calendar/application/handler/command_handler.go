@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"clinic-vet-api/app/modules/calendar/application/command"
+	"clinic-vet-api/app/modules/core/domain/entity/calendar"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/cqrs"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+var (
+	FailFindCalendarMsg         = "failed to load clinic calendar"
+	FailValidateCalendarMsg     = "clinic calendar validation failed"
+	FailSaveOpeningHoursMsg     = "failed to save opening hours"
+	FailSaveBookingPolicyMsg    = "failed to save booking policy"
+	FailFindClosureMsg          = "failed to find closure"
+	FailSaveClosureMsg          = "failed to save closure"
+	FailDeleteClosureMsg        = "failed to delete closure"
+	FailClosureAlreadyExistsMsg = "a closure already exists for that date"
+
+	SuccessOpeningHoursUpdatedMsg  = "opening hours updated successfully"
+	SuccessBookingPolicyUpdatedMsg = "booking policy updated successfully"
+	SuccessClosureCreatedMsg       = "closure created successfully"
+	SuccessClosureDeletedMsg       = "closure deleted successfully"
+)
+
+type CalendarCommandHandler struct {
+	calendarRepo repository.ClinicCalendarRepository
+}
+
+func NewCalendarCommandHandler(calendarRepo repository.ClinicCalendarRepository) *CalendarCommandHandler {
+	return &CalendarCommandHandler{calendarRepo: calendarRepo}
+}
+
+// HandleUpdateOpeningHours replaces the hours of the given weekdays, the rest keep their current values
+func (h *CalendarCommandHandler) HandleUpdateOpeningHours(ctx context.Context, cmd command.UpdateOpeningHoursCommand) cqrs.CommandResult {
+	if err := calendar.ValidateOpeningHours(ctx, cmd.OpeningHours()); err != nil {
+		return cqrs.FailureResult(FailValidateCalendarMsg, err)
+	}
+
+	if err := h.calendarRepo.SaveOpeningHours(ctx, cmd.OpeningHours()); err != nil {
+		return cqrs.FailureResult(FailSaveOpeningHoursMsg, err)
+	}
+
+	return cqrs.SuccessResult(SuccessOpeningHoursUpdatedMsg)
+}
+
+func (h *CalendarCommandHandler) HandleUpdateBookingPolicy(ctx context.Context, cmd command.UpdateBookingPolicyCommand) cqrs.CommandResult {
+	policy := cmd.ToPolicy()
+	if err := policy.Validate(ctx); err != nil {
+		return cqrs.FailureResult(FailValidateCalendarMsg, err)
+	}
+
+	if err := h.calendarRepo.SaveBookingPolicy(ctx, policy); err != nil {
+		return cqrs.FailureResult(FailSaveBookingPolicyMsg, err)
+	}
+
+	return cqrs.SuccessResult(SuccessBookingPolicyUpdatedMsg)
+}
+
+func (h *CalendarCommandHandler) HandleCreateClosure(ctx context.Context, cmd command.CreateClosureCommand) cqrs.CommandResult {
+	closure := cmd.ToEntity()
+	if err := closure.Validate(ctx); err != nil {
+		return cqrs.FailureResult(FailValidateCalendarMsg, err)
+	}
+
+	existing, err := h.calendarRepo.FindClosures(ctx, closure.Date(), closure.Date())
+	if err != nil {
+		return cqrs.FailureResult(FailFindClosureMsg, err)
+	}
+
+	if len(existing) > 0 {
+		date := closure.Date().Format("2006-01-02")
+		return cqrs.FailureResult(FailClosureAlreadyExistsMsg, apperror.ConflictError("closure", fmt.Sprintf("a closure already exists on %s", date)))
+	}
+
+	if err := h.calendarRepo.SaveClosure(ctx, closure); err != nil {
+		return cqrs.FailureResult(FailSaveClosureMsg, err)
+	}
+
+	return cqrs.SuccessCreateResult(closure.ID().String(), SuccessClosureCreatedMsg)
+}
+
+func (h *CalendarCommandHandler) HandleDeleteClosure(ctx context.Context, cmd command.DeleteClosureCommand) cqrs.CommandResult {
+	if _, err := h.calendarRepo.FindClosureByID(ctx, cmd.ClosureID()); err != nil {
+		return cqrs.FailureResult(FailFindClosureMsg, err)
+	}
+
+	if err := h.calendarRepo.DeleteClosure(ctx, cmd.ClosureID()); err != nil {
+		return cqrs.FailureResult(FailDeleteClosureMsg, err)
+	}
+
+	return cqrs.SuccessResult(SuccessClosureDeletedMsg)
+}
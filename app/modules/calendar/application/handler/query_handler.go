@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"context"
+
+	"clinic-vet-api/app/modules/calendar/application/query"
+	"clinic-vet-api/app/modules/core/repository"
+)
+
+type CalendarQueryHandler struct {
+	calendarRepo repository.ClinicCalendarRepository
+}
+
+func NewCalendarQueryHandler(calendarRepo repository.ClinicCalendarRepository) *CalendarQueryHandler {
+	return &CalendarQueryHandler{calendarRepo: calendarRepo}
+}
+
+func (h *CalendarQueryHandler) HandleGetCalendar(ctx context.Context) (CalendarResult, error) {
+	clinicCalendar, err := h.calendarRepo.Get(ctx)
+	if err != nil {
+		return CalendarResult{}, err
+	}
+
+	return toCalendarResult(clinicCalendar), nil
+}
+
+func (h *CalendarQueryHandler) HandleFindClosures(ctx context.Context, qry query.FindClosuresQuery) ([]ClosureResult, error) {
+	closures, err := h.calendarRepo.FindClosures(ctx, qry.StartDate(), qry.EndDate())
+	if err != nil {
+		return nil, err
+	}
+
+	return toClosureResults(closures), nil
+}
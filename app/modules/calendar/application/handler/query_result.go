@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/calendar"
+)
+
+type OpeningHoursResult struct {
+	Day       time.Weekday
+	OpenHour  int
+	CloseHour int
+	IsClosed  bool
+}
+
+type ClosureResult struct {
+	ID          uint
+	Date        time.Time
+	ClosureType string
+	Reason      string
+	StartHour   *int
+	EndHour     *int
+	IsFullDay   bool
+}
+
+type CalendarResult struct {
+	OpeningHours     []OpeningHoursResult
+	MinLeadDays      int
+	MaxLeadDays      int
+	UpcomingClosures []ClosureResult
+}
+
+func toCalendarResult(clinicCalendar calendar.ClinicCalendar) CalendarResult {
+	openingHours := clinicCalendar.OpeningHours()
+	hoursResults := make([]OpeningHoursResult, len(openingHours))
+	for i, hours := range openingHours {
+		hoursResults[i] = OpeningHoursResult{
+			Day:       hours.Day,
+			OpenHour:  hours.OpenHour,
+			CloseHour: hours.CloseHour,
+			IsClosed:  hours.IsClosed,
+		}
+	}
+
+	policy := clinicCalendar.Policy()
+	return CalendarResult{
+		OpeningHours:     hoursResults,
+		MinLeadDays:      policy.MinLeadDays,
+		MaxLeadDays:      policy.MaxLeadDays,
+		UpcomingClosures: toClosureResults(clinicCalendar.Closures()),
+	}
+}
+
+func toClosureResults(closures []calendar.Closure) []ClosureResult {
+	results := make([]ClosureResult, len(closures))
+	for i, closure := range closures {
+		results[i] = ClosureResult{
+			ID:          closure.ID().Value(),
+			Date:        closure.Date(),
+			ClosureType: closure.ClosureType().String(),
+			Reason:      closure.Reason(),
+			StartHour:   closure.StartHour(),
+			EndHour:     closure.EndHour(),
+			IsFullDay:   closure.IsFullDay(),
+		}
+	}
+	return results
+}
@@ -0,0 +1,37 @@
+package query
+
+import (
+	"time"
+
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+const MaxClosuresRangeDays = 366
+
+type FindClosuresQuery struct {
+	startDate time.Time
+	endDate   time.Time
+}
+
+func NewFindClosuresQuery(startDate, endDate time.Time) (FindClosuresQuery, error) {
+	if startDate.IsZero() {
+		return FindClosuresQuery{}, apperror.FieldValidationError("start_date", "", "start date is required")
+	}
+
+	if endDate.IsZero() {
+		return FindClosuresQuery{}, apperror.FieldValidationError("end_date", "", "end date is required")
+	}
+
+	if endDate.Before(startDate) {
+		return FindClosuresQuery{}, apperror.FieldValidationError("end_date", endDate.Format(time.DateOnly), "end date cannot be before start date")
+	}
+
+	if endDate.Sub(startDate) > MaxClosuresRangeDays*24*time.Hour {
+		return FindClosuresQuery{}, apperror.FieldValidationError("end_date", endDate.Format(time.DateOnly), "date range cannot exceed one year")
+	}
+
+	return FindClosuresQuery{startDate: startDate, endDate: endDate}, nil
+}
+
+func (q FindClosuresQuery) StartDate() time.Time { return q.startDate }
+func (q FindClosuresQuery) EndDate() time.Time   { return q.endDate }
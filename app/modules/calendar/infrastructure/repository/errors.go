@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"fmt"
+
+	dberr "clinic-vet-api/app/shared/error/infrastructure/database"
+)
+
+const (
+	TableOpeningHours  = "clinic_opening_hours"
+	TableClosures      = "clinic_closures"
+	TableBookingPolicy = "clinic_booking_policy"
+	OpSelect           = "select"
+	OpInsert           = "insert"
+	OpUpdate           = "update"
+	OpDelete           = "delete"
+	DriverSQL          = "sqlc"
+)
+
+func (r *SqlcClinicCalendarRepository) dbError(operation, table, message string, err error) error {
+	return dberr.DatabaseOperationError(operation, table, DriverSQL, fmt.Errorf("%s: %v", message, err))
+}
+
+func (r *SqlcClinicCalendarRepository) notFoundError(parameterName, parameterValue string) error {
+	return dberr.EntityNotFoundError(parameterName, parameterValue, OpSelect, TableClosures, DriverSQL)
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/calendar"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/mapper"
+	"clinic-vet-api/sqlc"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type SqlcClinicCalendarRepository struct {
+	queries *sqlc.Queries
+	pgMap   *mapper.SqlcFieldMapper
+}
+
+func NewSqlcClinicCalendarRepository(queries *sqlc.Queries, pgMap *mapper.SqlcFieldMapper) repository.ClinicCalendarRepository {
+	return &SqlcClinicCalendarRepository{queries: queries, pgMap: pgMap}
+}
+
+func (r *SqlcClinicCalendarRepository) Get(ctx context.Context) (calendar.ClinicCalendar, error) {
+	today := time.Now()
+	return r.GetForPeriod(ctx, today, today)
+}
+
+func (r *SqlcClinicCalendarRepository) GetForPeriod(ctx context.Context, from, to time.Time) (calendar.ClinicCalendar, error) {
+	hourRows, err := r.queries.ListClinicOpeningHours(ctx)
+	if err != nil {
+		return calendar.ClinicCalendar{}, r.dbError(OpSelect, TableOpeningHours, "failed to list opening hours", err)
+	}
+
+	openingHours := r.toOpeningHours(hourRows)
+	if len(openingHours) == 0 {
+		openingHours = calendar.DefaultOpeningHours()
+	}
+
+	policy := calendar.DefaultBookingPolicy()
+	policyRow, err := r.queries.GetClinicBookingPolicy(ctx)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return calendar.ClinicCalendar{}, r.dbError(OpSelect, TableBookingPolicy, "failed to get booking policy", err)
+	} else if err == nil {
+		policy = calendar.BookingPolicy{MinLeadDays: int(policyRow.MinLeadDays), MaxLeadDays: int(policyRow.MaxLeadDays)}
+	}
+
+	// The booking window is always covered, the requested period may reach past it on either side
+	today := time.Now()
+	windowEnd := today.AddDate(0, 0, policy.MaxLeadDays+1)
+	if from.After(today) {
+		from = today
+	}
+	if to.Before(windowEnd) {
+		to = windowEnd
+	}
+
+	closures, err := r.FindClosures(ctx, from, to)
+	if err != nil {
+		return calendar.ClinicCalendar{}, err
+	}
+
+	return calendar.NewClinicCalendar(openingHours, closures, policy), nil
+}
+
+func (r *SqlcClinicCalendarRepository) SaveOpeningHours(ctx context.Context, openingHours []calendar.OpeningHours) error {
+	for _, hours := range openingHours {
+		if err := r.queries.UpsertClinicOpeningHours(ctx, sqlc.UpsertClinicOpeningHoursParams{
+			Weekday:   int16(hours.Day),
+			OpenHour:  int16(hours.OpenHour),
+			CloseHour: int16(hours.CloseHour),
+			IsClosed:  hours.IsClosed,
+		}); err != nil {
+			return r.dbError(OpUpdate, TableOpeningHours, "failed to save opening hours", err)
+		}
+	}
+	return nil
+}
+
+func (r *SqlcClinicCalendarRepository) SaveBookingPolicy(ctx context.Context, policy calendar.BookingPolicy) error {
+	if err := r.queries.UpsertClinicBookingPolicy(ctx, sqlc.UpsertClinicBookingPolicyParams{
+		MinLeadDays: int32(policy.MinLeadDays),
+		MaxLeadDays: int32(policy.MaxLeadDays),
+	}); err != nil {
+		return r.dbError(OpUpdate, TableBookingPolicy, "failed to save booking policy", err)
+	}
+	return nil
+}
+
+func (r *SqlcClinicCalendarRepository) FindClosures(ctx context.Context, from, to time.Time) ([]calendar.Closure, error) {
+	rows, err := r.queries.FindClinicClosuresByDateRange(ctx, sqlc.FindClinicClosuresByDateRangeParams{
+		ClosureDate:   r.pgMap.TimeToPgDate(from),
+		ClosureDate_2: r.pgMap.TimeToPgDate(to),
+	})
+	if err != nil {
+		return nil, r.dbError(OpSelect, TableClosures, "failed to list closures", err)
+	}
+
+	return r.toClosures(rows), nil
+}
+
+func (r *SqlcClinicCalendarRepository) FindClosureByID(ctx context.Context, id valueobject.ClosureID) (calendar.Closure, error) {
+	row, err := r.queries.FindClinicClosureByID(ctx, id.Int32())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return calendar.Closure{}, r.notFoundError("id", id.String())
+		}
+		return calendar.Closure{}, r.dbError(OpSelect, TableClosures, "failed to get closure by ID", err)
+	}
+
+	return r.toClosure(row), nil
+}
+
+func (r *SqlcClinicCalendarRepository) SaveClosure(ctx context.Context, closure *calendar.Closure) error {
+	if closure.ID().IsZero() {
+		row, err := r.queries.CreateClinicClosure(ctx, r.toCreateClosureParams(closure))
+		if err != nil {
+			return r.dbError(OpInsert, TableClosures, "failed to create closure", err)
+		}
+		*closure = r.toClosure(row)
+		return nil
+	}
+
+	row, err := r.queries.UpdateClinicClosure(ctx, r.toUpdateClosureParams(closure))
+	if err != nil {
+		return r.dbError(OpUpdate, TableClosures, "failed to update closure", err)
+	}
+	*closure = r.toClosure(row)
+	return nil
+}
+
+func (r *SqlcClinicCalendarRepository) DeleteClosure(ctx context.Context, id valueobject.ClosureID) error {
+	if err := r.queries.DeleteClinicClosure(ctx, id.Int32()); err != nil {
+		return r.dbError(OpDelete, TableClosures, "failed to delete closure", err)
+	}
+	return nil
+}
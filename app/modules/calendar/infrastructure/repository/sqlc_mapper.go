@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/calendar"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/sqlc"
+)
+
+func (r *SqlcClinicCalendarRepository) toOpeningHours(rows []sqlc.ClinicOpeningHour) []calendar.OpeningHours {
+	openingHours := make([]calendar.OpeningHours, len(rows))
+	for i, row := range rows {
+		openingHours[i] = calendar.OpeningHours{
+			Day:       time.Weekday(row.Weekday),
+			OpenHour:  int(row.OpenHour),
+			CloseHour: int(row.CloseHour),
+			IsClosed:  row.IsClosed,
+		}
+	}
+	return openingHours
+}
+
+func (r *SqlcClinicCalendarRepository) toClosure(row sqlc.ClinicClosure) calendar.Closure {
+	closureType, _ := enum.ParseClosureType(row.ClosureType)
+
+	return *calendar.NewClosureBuilder().
+		WithID(valueobject.NewClosureID(uint(row.ID))).
+		WithDate(r.pgMap.PgDate.ToTime(row.ClosureDate)).
+		WithClosureType(closureType).
+		WithReason(row.Reason).
+		WithHours(r.pgMap.PgInt2.ToIntPtr(row.StartHour), r.pgMap.PgInt2.ToIntPtr(row.EndHour)).
+		WithTimestamps(row.CreatedAt.Time, row.UpdatedAt.Time).
+		Build()
+}
+
+func (r *SqlcClinicCalendarRepository) toClosures(rows []sqlc.ClinicClosure) []calendar.Closure {
+	closures := make([]calendar.Closure, len(rows))
+	for i, row := range rows {
+		closures[i] = r.toClosure(row)
+	}
+	return closures
+}
+
+func (r *SqlcClinicCalendarRepository) toCreateClosureParams(closure *calendar.Closure) sqlc.CreateClinicClosureParams {
+	return sqlc.CreateClinicClosureParams{
+		ClosureDate: r.pgMap.PgDate.FromTime(closure.Date()),
+		ClosureType: closure.ClosureType().String(),
+		Reason:      closure.Reason(),
+		StartHour:   r.pgMap.PgInt2.FromInt(closure.StartHour()),
+		EndHour:     r.pgMap.PgInt2.FromInt(closure.EndHour()),
+	}
+}
+
+func (r *SqlcClinicCalendarRepository) toUpdateClosureParams(closure *calendar.Closure) sqlc.UpdateClinicClosureParams {
+	return sqlc.UpdateClinicClosureParams{
+		ID:          closure.ID().Int32(),
+		ClosureDate: r.pgMap.PgDate.FromTime(closure.Date()),
+		ClosureType: closure.ClosureType().String(),
+		Reason:      closure.Reason(),
+		StartHour:   r.pgMap.PgInt2.FromInt(closure.StartHour()),
+		EndHour:     r.pgMap.PgInt2.FromInt(closure.EndHour()),
+	}
+}
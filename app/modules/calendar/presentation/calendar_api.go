@@ -0,0 +1,102 @@
+package api
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/calendar/application"
+	"clinic-vet-api/app/modules/calendar/application/handler"
+	sqlcRepo "clinic-vet-api/app/modules/calendar/infrastructure/repository"
+	"clinic-vet-api/app/modules/calendar/presentation/controller"
+	"clinic-vet-api/app/modules/calendar/presentation/routes"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/mapper"
+	"clinic-vet-api/sqlc"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type CalendarAPIConfig struct {
+	Router         *gin.RouterGroup
+	Validator      *validator.Validate
+	AuthMiddleware *middleware.AuthMiddleware
+	Queries        *sqlc.Queries
+}
+
+type CalendarAPIComponents struct {
+	Repository repository.ClinicCalendarRepository
+	Service    application.CalendarFacadeService
+	Controller *controller.AdminCalendarController
+}
+
+type CalendarAPIModule struct {
+	config     *CalendarAPIConfig
+	isBuilt    bool
+	Components CalendarAPIComponents
+}
+
+func NewCalendarAPIModule(config *CalendarAPIConfig) *CalendarAPIModule {
+	return &CalendarAPIModule{
+		config:  config,
+		isBuilt: false,
+	}
+}
+
+func (b *CalendarAPIModule) Bootstrap() error {
+	if b.isBuilt {
+		return nil
+	}
+
+	if err := b.validateConfig(); err != nil {
+		return err
+	}
+
+	repo := sqlcRepo.NewSqlcClinicCalendarRepository(b.config.Queries, mapper.NewSqlcFieldMapper())
+
+	cmdHandler := handler.NewCalendarCommandHandler(repo)
+	qryHandler := handler.NewCalendarQueryHandler(repo)
+	service := application.NewCalendarFacadeService(qryHandler, cmdHandler)
+
+	adminController := controller.NewAdminCalendarController(service, b.config.Validator)
+	routes.CalendarRoutes(b.config.Router, adminController, b.config.AuthMiddleware)
+
+	b.Components = CalendarAPIComponents{
+		Repository: repo,
+		Service:    service,
+		Controller: adminController,
+	}
+	b.isBuilt = true
+
+	return nil
+}
+
+func (b *CalendarAPIModule) validateConfig() error {
+	if b.config == nil {
+		return errors.New("calendar api config is nil")
+	}
+
+	if b.config.Router == nil {
+		return errors.New("router is nil")
+	}
+
+	if b.config.Validator == nil {
+		return errors.New("validator is nil")
+	}
+
+	if b.config.AuthMiddleware == nil {
+		return errors.New("auth middleware is nil")
+	}
+
+	if b.config.Queries == nil {
+		return errors.New("queries is nil")
+	}
+
+	return nil
+}
+
+func (b *CalendarAPIModule) GetRepository() (repository.ClinicCalendarRepository, error) {
+	if !b.isBuilt {
+		return nil, errors.New("module not bootstrapped")
+	}
+	return b.Components.Repository, nil
+}
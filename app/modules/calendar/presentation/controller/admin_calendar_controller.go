@@ -0,0 +1,211 @@
+package controller
+
+import (
+	"clinic-vet-api/app/modules/calendar/application"
+	"clinic-vet-api/app/modules/calendar/application/command"
+	"clinic-vet-api/app/modules/calendar/presentation/dto"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+	ginutils "clinic-vet-api/app/shared/gin_utils"
+	"clinic-vet-api/app/shared/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type AdminCalendarController struct {
+	calendarService application.CalendarFacadeService
+	validator       *validator.Validate
+}
+
+func NewAdminCalendarController(
+	calendarService application.CalendarFacadeService,
+	validator *validator.Validate,
+) *AdminCalendarController {
+	return &AdminCalendarController{
+		calendarService: calendarService,
+		validator:       validator,
+	}
+}
+
+// GetCalendar godoc
+// @Summary Get clinic calendar
+// @Description Returns the weekly opening hours, the booking lead-time policy and the upcoming closures
+// @Tags admin-calendar
+// @Produce json
+// @Success 200 {object} response.APIResponse{data=dto.CalendarResponse}
+// @Failure 500 {object} response.APIResponse
+// @Router /admin/calendar [get]
+// @Security BearerAuth
+func (ctrl *AdminCalendarController) GetCalendar(c *gin.Context) {
+	result, err := ctrl.calendarService.GetCalendar(c.Request.Context())
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromCalendarResult(result), "Clinic Calendar")
+}
+
+// UpdateOpeningHours godoc
+// @Summary Update opening hours
+// @Description Replaces the opening hours of the given weekdays
+// @Tags admin-calendar
+// @Accept json
+// @Produce json
+// @Param request body dto.UpdateOpeningHoursRequest true "Opening hours"
+// @Success 200 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse
+// @Router /admin/calendar/opening-hours [put]
+// @Security BearerAuth
+func (ctrl *AdminCalendarController) UpdateOpeningHours(c *gin.Context) {
+	var req dto.UpdateOpeningHoursRequest
+	if err := ginutils.ShouldBindAndValidateBody(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	cmd, err := req.ToCommand()
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.calendarService.UpdateOpeningHours(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Updated(c, nil, "Opening Hours")
+}
+
+// UpdateBookingPolicy godoc
+// @Summary Update booking policy
+// @Description Updates the minimum and maximum days in advance an appointment can be booked
+// @Tags admin-calendar
+// @Accept json
+// @Produce json
+// @Param request body dto.UpdateBookingPolicyRequest true "Booking policy"
+// @Success 200 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse
+// @Router /admin/calendar/booking-policy [put]
+// @Security BearerAuth
+func (ctrl *AdminCalendarController) UpdateBookingPolicy(c *gin.Context) {
+	var req dto.UpdateBookingPolicyRequest
+	if err := ginutils.ShouldBindAndValidateBody(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	cmd, err := req.ToCommand()
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.calendarService.UpdateBookingPolicy(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Updated(c, nil, "Booking Policy")
+}
+
+// FindClosures godoc
+// @Summary List closures
+// @Description Lists holidays and ad-hoc closures within a date range
+// @Tags admin-calendar
+// @Produce json
+// @Param start_date query string true "Start date (YYYY-MM-DD)"
+// @Param end_date query string true "End date (YYYY-MM-DD)"
+// @Success 200 {object} response.APIResponse{data=[]dto.ClosureResponse}
+// @Failure 400 {object} response.APIResponse
+// @Router /admin/calendar/closures [get]
+// @Security BearerAuth
+func (ctrl *AdminCalendarController) FindClosures(c *gin.Context) {
+	var req dto.FindClosuresRequest
+	if err := ginutils.ShouldBindAndValidateQuery(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	qry, err := req.ToQuery()
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	results, err := ctrl.calendarService.FindClosures(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromClosureResults(results), "Clinic Closures")
+}
+
+// CreateClosure godoc
+// @Summary Create closure
+// @Description Registers a public holiday or an ad-hoc closure, for the whole day or part of it
+// @Tags admin-calendar
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateClosureRequest true "Closure"
+// @Success 201 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse
+// @Failure 409 {object} response.APIResponse
+// @Router /admin/calendar/closures [post]
+// @Security BearerAuth
+func (ctrl *AdminCalendarController) CreateClosure(c *gin.Context) {
+	var req dto.CreateClosureRequest
+	if err := ginutils.ShouldBindAndValidateBody(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	cmd, err := req.ToCommand()
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.calendarService.CreateClosure(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Created(c, result.ID(), "Clinic Closure")
+}
+
+// DeleteClosure godoc
+// @Summary Delete closure
+// @Description Removes a closure so the day becomes bookable again
+// @Tags admin-calendar
+// @Param id path int true "Closure ID"
+// @Success 204
+// @Failure 404 {object} response.APIResponse
+// @Router /admin/calendar/closures/{id} [delete]
+// @Security BearerAuth
+func (ctrl *AdminCalendarController) DeleteClosure(c *gin.Context) {
+	closureID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	cmd, err := command.NewDeleteClosureCommand(closureID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.calendarService.DeleteClosure(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.NoContent(c)
+}
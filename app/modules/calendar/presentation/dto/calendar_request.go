@@ -0,0 +1,88 @@
+package dto
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/calendar/application/command"
+	"clinic-vet-api/app/modules/calendar/application/query"
+	"clinic-vet-api/app/modules/core/domain/entity/calendar"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+)
+
+// OpeningHoursRequest represents the hours the clinic opens on a single weekday
+// @Description Opening hours of one weekday (0 = Sunday ... 6 = Saturday), close hour exclusive
+type OpeningHoursRequest struct {
+	Day       int  `json:"day" validate:"min=0,max=6" example:"1"`
+	OpenHour  int  `json:"open_hour" validate:"min=0,max=23" example:"9"`
+	CloseHour int  `json:"close_hour" validate:"min=0,max=24" example:"18"`
+	IsClosed  bool `json:"is_closed" example:"false"`
+}
+
+// UpdateOpeningHoursRequest represents the payload to replace the opening hours of one or more weekdays
+// @Description Weekly opening hours, weekdays not present keep their current hours
+type UpdateOpeningHoursRequest struct {
+	OpeningHours []OpeningHoursRequest `json:"opening_hours" validate:"required,min=1,max=7,dive"`
+}
+
+func (r *UpdateOpeningHoursRequest) ToCommand() (command.UpdateOpeningHoursCommand, error) {
+	openingHours := make([]calendar.OpeningHours, len(r.OpeningHours))
+	for i, hours := range r.OpeningHours {
+		openingHours[i] = calendar.OpeningHours{
+			Day:       time.Weekday(hours.Day),
+			OpenHour:  hours.OpenHour,
+			CloseHour: hours.CloseHour,
+			IsClosed:  hours.IsClosed,
+		}
+	}
+	return command.NewUpdateOpeningHoursCommand(openingHours)
+}
+
+// UpdateBookingPolicyRequest represents the lead-time rules applied when booking appointments
+// @Description Minimum and maximum days in advance an appointment can be booked
+type UpdateBookingPolicyRequest struct {
+	MinLeadDays int `json:"min_lead_days" validate:"min=0" example:"3"`
+	MaxLeadDays int `json:"max_lead_days" validate:"required,min=1" example:"30"`
+}
+
+func (r *UpdateBookingPolicyRequest) ToCommand() (command.UpdateBookingPolicyCommand, error) {
+	return command.NewUpdateBookingPolicyCommand(r.MinLeadDays, r.MaxLeadDays)
+}
+
+// CreateClosureRequest represents a holiday or ad-hoc closure of the clinic
+// @Description Closure for a whole day or, when start and end hour are sent, part of it
+type CreateClosureRequest struct {
+	Date        string `json:"date" validate:"required,datetime=2006-01-02" example:"2024-12-25"`
+	ClosureType string `json:"closure_type" validate:"required,oneof=public_holiday ad_hoc" example:"public_holiday"`
+	Reason      string `json:"reason" validate:"required,max=255" example:"Christmas"`
+	StartHour   *int   `json:"start_hour,omitempty" validate:"omitempty,min=0,max=23" example:"14"`
+	EndHour     *int   `json:"end_hour,omitempty" validate:"omitempty,min=1,max=24" example:"18"`
+}
+
+func (r *CreateClosureRequest) ToCommand() (command.CreateClosureCommand, error) {
+	date, err := time.ParseInLocation(time.DateOnly, r.Date, time.Local)
+	if err != nil {
+		return command.CreateClosureCommand{}, httpError.ValidationError("date", r.Date, "must use the YYYY-MM-DD format")
+	}
+
+	return command.NewCreateClosureCommand(date, r.ClosureType, r.Reason, r.StartHour, r.EndHour)
+}
+
+// FindClosuresRequest represents the query params to list closures in a date range
+type FindClosuresRequest struct {
+	StartDate string `form:"start_date" validate:"required,datetime=2006-01-02" example:"2024-01-01"`
+	EndDate   string `form:"end_date" validate:"required,datetime=2006-01-02" example:"2024-12-31"`
+}
+
+func (r *FindClosuresRequest) ToQuery() (query.FindClosuresQuery, error) {
+	startDate, err := time.ParseInLocation(time.DateOnly, r.StartDate, time.Local)
+	if err != nil {
+		return query.FindClosuresQuery{}, httpError.ValidationError("start_date", r.StartDate, "must use the YYYY-MM-DD format")
+	}
+
+	endDate, err := time.ParseInLocation(time.DateOnly, r.EndDate, time.Local)
+	if err != nil {
+		return query.FindClosuresQuery{}, httpError.ValidationError("end_date", r.EndDate, "must use the YYYY-MM-DD format")
+	}
+
+	return query.NewFindClosuresQuery(startDate, endDate)
+}
@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"clinic-vet-api/app/modules/calendar/application/handler"
+)
+
+// OpeningHoursResponse represents the opening hours of a weekday
+type OpeningHoursResponse struct {
+	Day       int    `json:"day"`
+	DayName   string `json:"day_name"`
+	OpenHour  int    `json:"open_hour"`
+	CloseHour int    `json:"close_hour"`
+	IsClosed  bool   `json:"is_closed"`
+}
+
+// ClosureResponse represents a closure of the clinic
+type ClosureResponse struct {
+	ID          uint   `json:"id"`
+	Date        string `json:"date"`
+	ClosureType string `json:"closure_type"`
+	Reason      string `json:"reason"`
+	StartHour   *int   `json:"start_hour,omitempty"`
+	EndHour     *int   `json:"end_hour,omitempty"`
+	IsFullDay   bool   `json:"is_full_day"`
+}
+
+// CalendarResponse represents the clinic calendar
+type CalendarResponse struct {
+	OpeningHours     []OpeningHoursResponse `json:"opening_hours"`
+	MinLeadDays      int                    `json:"min_lead_days"`
+	MaxLeadDays      int                    `json:"max_lead_days"`
+	UpcomingClosures []ClosureResponse      `json:"upcoming_closures"`
+}
+
+func FromCalendarResult(result handler.CalendarResult) CalendarResponse {
+	openingHours := make([]OpeningHoursResponse, len(result.OpeningHours))
+	for i, hours := range result.OpeningHours {
+		openingHours[i] = OpeningHoursResponse{
+			Day:       int(hours.Day),
+			DayName:   hours.Day.String(),
+			OpenHour:  hours.OpenHour,
+			CloseHour: hours.CloseHour,
+			IsClosed:  hours.IsClosed,
+		}
+	}
+
+	return CalendarResponse{
+		OpeningHours:     openingHours,
+		MinLeadDays:      result.MinLeadDays,
+		MaxLeadDays:      result.MaxLeadDays,
+		UpcomingClosures: FromClosureResults(result.UpcomingClosures),
+	}
+}
+
+func FromClosureResults(results []handler.ClosureResult) []ClosureResponse {
+	responses := make([]ClosureResponse, len(results))
+	for i, result := range results {
+		responses[i] = ClosureResponse{
+			ID:          result.ID,
+			Date:        result.Date.Format("2006-01-02"),
+			ClosureType: result.ClosureType,
+			Reason:      result.Reason,
+			StartHour:   result.StartHour,
+			EndHour:     result.EndHour,
+			IsFullDay:   result.IsFullDay,
+		}
+	}
+	return responses
+}
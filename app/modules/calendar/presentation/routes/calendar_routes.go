@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/calendar/presentation/controller"
+	"clinic-vet-api/app/modules/core/domain/enum"
+
+	"github.com/gin-gonic/gin"
+)
+
+func CalendarRoutes(router *gin.RouterGroup, adminController *controller.AdminCalendarController, authMiddleware *middleware.AuthMiddleware) {
+	adminGroup := router.Group("/admin/calendar")
+	adminGroup.Use(authMiddleware.Authenticate())
+	adminGroup.Use(authMiddleware.RequireAnyRole(enum.UserRoleAdmin.String()))
+	{
+		adminGroup.GET("", adminController.GetCalendar)
+		adminGroup.PUT("/opening-hours", adminController.UpdateOpeningHours)
+		adminGroup.PUT("/booking-policy", adminController.UpdateBookingPolicy)
+		adminGroup.GET("/closures", adminController.FindClosures)
+		adminGroup.POST("/closures", adminController.CreateClosure)
+		adminGroup.DELETE("/closures/:id", adminController.DeleteClosure)
+	}
+}
@@ -0,0 +1,178 @@
+package appointment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/employee"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+const (
+	MaxAbsenceDays         = 31
+	MaxAbsenceReasonLength = 500
+)
+
+// Absence is a period an employee cannot attend the appointments booked with them, e.g. a sick day
+type Absence struct {
+	EmployeeID vo.EmployeeID
+	Start      time.Time
+	End        time.Time
+	Reason     string
+	ReportedBy vo.UserID
+}
+
+func NewAbsence(ctx context.Context, employeeID vo.EmployeeID, start, end time.Time, reason string, reportedBy vo.UserID) (Absence, error) {
+	operation := "NewAbsence"
+
+	if employeeID.IsZero() {
+		return Absence{}, MissingEmployeeError(ctx, operation)
+	}
+
+	if reportedBy.IsZero() {
+		return Absence{}, InvalidAbsenceError(ctx, "reported_by", "the user reporting the absence is required", operation)
+	}
+
+	if !end.After(start) {
+		return Absence{}, InvalidAbsenceError(ctx, "end", "the absence must end after it starts", operation)
+	}
+
+	if end.Sub(start) > MaxAbsenceDays*24*time.Hour {
+		return Absence{}, InvalidAbsenceError(ctx, "end", fmt.Sprintf("an absence cannot be longer than %d days", MaxAbsenceDays), operation)
+	}
+
+	if reason == "" || len(reason) > MaxAbsenceReasonLength {
+		return Absence{}, InvalidAbsenceError(ctx, "reason", fmt.Sprintf("the reason is required and cannot exceed %d characters", MaxAbsenceReasonLength), operation)
+	}
+
+	return Absence{EmployeeID: employeeID, Start: start, End: end, Reason: reason, ReportedBy: reportedBy}, nil
+}
+
+// AbsenceFromTimeOff is the absence covered by time off a manager approved
+func AbsenceFromTimeOff(timeOff employee.ScheduleException) Absence {
+	absence := Absence{
+		EmployeeID: timeOff.EmployeeID(),
+		Start:      timeOff.StartDate(),
+		End:        timeOff.EndDate(),
+		Reason:     timeOff.Reason(),
+	}
+	if timeOff.ReviewedBy() != nil {
+		absence.ReportedBy = *timeOff.ReviewedBy()
+	}
+	return absence
+}
+
+// TimeOff is the sick leave that keeps the absent employee off the schedule for the period. It is
+// approved by whoever reports the absence, so no booking path can assign the employee again
+func (a Absence) TimeOff(ctx context.Context, now time.Time) (*employee.ScheduleException, error) {
+	timeOff := employee.NewScheduleExceptionBuilder().
+		WithEmployeeID(a.EmployeeID).
+		WithType(enum.ScheduleExceptionSickLeave).
+		WithPeriod(a.Start, a.End).
+		WithReason(a.Reason).
+		Build()
+
+	if err := timeOff.Validate(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := timeOff.Approve(ctx, a.ReportedBy, nil, now); err != nil {
+		return nil, err
+	}
+	return timeOff, nil
+}
+
+// Affects reports whether the appointment is a confirmed one of the absent employee within the absence
+func (a Absence) Affects(appt Appointment) bool {
+	return appt.status == enum.AppointmentStatusConfirmed &&
+		appt.employeeID != nil && *appt.employeeID == a.EmployeeID &&
+		appt.scheduledDate.Before(a.End) && appt.EndDate().After(a.Start)
+}
+
+// Substitute is an employee able to take over the appointments of an absent colleague, along
+// with the appointments already booked with them
+type Substitute struct {
+	EmployeeID vo.EmployeeID
+	Calendar   employee.WorkCalendar
+	Booked     []Appointment
+}
+
+// IsFreeFor reports whether the appointment falls inside a shift of the substitute, outside the
+// break and their time off, without overlapping their active appointments
+func (s Substitute) IsFreeFor(appt Appointment) bool {
+	if !s.Calendar.IsWorking(appt.scheduledDate, appt.EndDate()) {
+		return false
+	}
+
+	for _, booked := range s.Booked {
+		if !booked.status.IsFinalStatus() && appt.OverlapsWith(booked) {
+			return false
+		}
+	}
+	return true
+}
+
+// AbsenceCoverage is the outcome of handing over the appointments of an absence
+type AbsenceCoverage struct {
+	Proposed  []Appointment
+	Cancelled []Appointment
+}
+
+// CoverAbsence proposes every affected appointment to the free substitute with the fewest
+// appointments, so the load is spread among them. Appointments nobody can take are cancelled
+func CoverAbsence(ctx context.Context, absence Absence, affected []Appointment, substitutes []Substitute) (AbsenceCoverage, error) {
+	coverage := AbsenceCoverage{Proposed: []Appointment{}, Cancelled: []Appointment{}}
+
+	for _, appt := range affected {
+		if !absence.Affects(appt) {
+			continue
+		}
+
+		chosen := -1
+		for i, substitute := range substitutes {
+			if substitute.EmployeeID == absence.EmployeeID || !substitute.IsFreeFor(appt) {
+				continue
+			}
+			if chosen == -1 || len(substitute.Booked) < len(substitutes[chosen].Booked) {
+				chosen = i
+			}
+		}
+
+		if chosen == -1 {
+			if err := appt.Cancel(ctx); err != nil {
+				return AbsenceCoverage{}, err
+			}
+			coverage.Cancelled = append(coverage.Cancelled, appt)
+			continue
+		}
+
+		if err := appt.ProposeReassignment(ctx, substitutes[chosen].EmployeeID); err != nil {
+			return AbsenceCoverage{}, err
+		}
+		substitutes[chosen].Booked = append(substitutes[chosen].Booked, appt)
+		coverage.Proposed = append(coverage.Proposed, appt)
+	}
+
+	return coverage, nil
+}
+
+// ProposeReassignment hands a confirmed appointment over to another employee. It goes back to
+// pending until the staff or the owner confirms it with the proposed employee
+func (a *Appointment) ProposeReassignment(ctx context.Context, employeeID vo.EmployeeID) error {
+	operation := "ReassignAppointment"
+
+	if a.status != enum.AppointmentStatusConfirmed {
+		return CannotReassignError(ctx, a.status, operation)
+	}
+
+	if employeeID.IsZero() {
+		return MissingEmployeeError(ctx, operation)
+	}
+
+	a.employeeID = &employeeID
+	a.status = enum.AppointmentStatusPending
+	a.IncrementVersion()
+	return nil
+}
@@ -11,13 +11,18 @@ import (
 
 type Appointment struct {
 	base.Entity[valueobject.AppointmentID]
-	service       enum.ClinicService
-	scheduledDate time.Time
-	status        enum.AppointmentStatus
-	notes         *string
-	customerID    valueobject.CustomerID
-	employeeID    *valueobject.EmployeeID
-	petID         valueobject.PetID
+	service        enum.ClinicService
+	scheduledDate  time.Time
+	status         enum.AppointmentStatus
+	notes          *string
+	customerID     valueobject.CustomerID
+	employeeID     *valueobject.EmployeeID
+	petID          valueobject.PetID
+	seriesID       *valueobject.ApptSeriesID
+	sequence       int
+	isEmergency    bool
+	triagePriority *enum.TriagePriority
+	visit          VisitProgress
 }
 
 type AppointmentBuilder struct{ appt *Appointment }
@@ -70,16 +75,38 @@ func (b *AppointmentBuilder) WithCustomerID(customerID valueobject.CustomerID) *
 	return b
 }
 
+func (b *AppointmentBuilder) WithSeriesID(seriesID *valueobject.ApptSeriesID) *AppointmentBuilder {
+	b.appt.seriesID = seriesID
+	return b
+}
+
+// WithSequence sets the persisted revision number, bumped on every update of the appointment
+func (b *AppointmentBuilder) WithSequence(sequence int) *AppointmentBuilder {
+	b.appt.sequence = sequence
+	return b
+}
+
+// WithEmergency flags the appointment as an emergency or walk-in with its triage priority
+func (b *AppointmentBuilder) WithEmergency(isEmergency bool, triagePriority *enum.TriagePriority) *AppointmentBuilder {
+	b.appt.isEmergency = isEmergency
+	b.appt.triagePriority = triagePriority
+	return b
+}
+
 func (b *AppointmentBuilder) WithTimestamps(createdAt, updatedAt time.Time) *AppointmentBuilder {
 	b.appt.Entity.SetTimeStamps(createdAt, updatedAt)
 	return b
 }
 
-func (a *Appointment) ID() valueobject.AppointmentID       { return a.Entity.ID() }
-func (a *Appointment) PetID() valueobject.PetID            { return a.petID }
-func (a *Appointment) CustomerID() valueobject.CustomerID  { return a.customerID }
-func (a *Appointment) EmployeeID() *valueobject.EmployeeID { return a.employeeID }
-func (a *Appointment) Service() enum.ClinicService         { return a.service }
-func (a *Appointment) ScheduledDate() time.Time            { return a.scheduledDate }
-func (a *Appointment) Status() enum.AppointmentStatus      { return a.status }
-func (a *Appointment) Notes() *string                      { return a.notes }
+func (a *Appointment) ID() valueobject.AppointmentID        { return a.Entity.ID() }
+func (a *Appointment) PetID() valueobject.PetID             { return a.petID }
+func (a *Appointment) CustomerID() valueobject.CustomerID   { return a.customerID }
+func (a *Appointment) EmployeeID() *valueobject.EmployeeID  { return a.employeeID }
+func (a *Appointment) Service() enum.ClinicService          { return a.service }
+func (a *Appointment) ScheduledDate() time.Time             { return a.scheduledDate }
+func (a *Appointment) Status() enum.AppointmentStatus       { return a.status }
+func (a *Appointment) Notes() *string                       { return a.notes }
+func (a *Appointment) SeriesID() *valueobject.ApptSeriesID  { return a.seriesID }
+func (a *Appointment) Sequence() int                        { return a.sequence }
+func (a *Appointment) IsEmergency() bool                    { return a.isEmergency }
+func (a *Appointment) TriagePriority() *enum.TriagePriority { return a.triagePriority }
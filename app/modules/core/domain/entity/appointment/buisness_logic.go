@@ -5,16 +5,12 @@ import (
 	"slices"
 	"time"
 
+	"clinic-vet-api/app/modules/core/domain/entity/calendar"
 	"clinic-vet-api/app/modules/core/domain/enum"
 	vo "clinic-vet-api/app/modules/core/domain/valueobject"
 	domainerr "clinic-vet-api/app/modules/core/error"
 )
 
-const (
-	MinAllowedDaysToSchedule = 3
-	MaxAllowedDaysToSchedule = 30
-)
-
 func (a *Appointment) Update(ctx context.Context, notes *string, service *enum.ClinicService) error {
 	operation := "UpdateAppointment"
 
@@ -36,13 +32,35 @@ func (a *Appointment) Update(ctx context.Context, notes *string, service *enum.C
 	return nil
 }
 
-func (a *Appointment) Reschedule(ctx context.Context, newDate time.Time) error {
+func (a *Appointment) Reschedule(ctx context.Context, newDate time.Time, clinicCalendar calendar.ClinicCalendar) error {
 	operation := "RescheduleAppointment"
 
-	if err := validateScheduledDate(ctx, newDate); err != nil {
+	if err := validateScheduledDate(ctx, newDate, a.Duration(), clinicCalendar); err != nil {
+		return err
+	}
+
+	return a.moveTo(ctx, newDate, operation)
+}
+
+// RescheduleProposal moves an appointment proposed by the clinic, such as a follow-up, which is
+// only bound to the opening hours. A proposal still pending keeps its status
+func (a *Appointment) RescheduleProposal(ctx context.Context, newDate time.Time, clinicCalendar calendar.ClinicCalendar) error {
+	operation := "RescheduleProposal"
+
+	if err := validateOpeningHours(ctx, newDate, a.Duration(), clinicCalendar); err != nil {
 		return err
 	}
 
+	if a.status != enum.AppointmentStatusPending {
+		return a.moveTo(ctx, newDate, operation)
+	}
+
+	a.scheduledDate = newDate
+	a.IncrementVersion()
+	return nil
+}
+
+func (a *Appointment) moveTo(ctx context.Context, newDate time.Time, operation string) error {
 	if !a.canBeRescheduled() {
 		return CannotRescheduleError(ctx, a.status, operation)
 	}
@@ -81,7 +99,7 @@ func (a *Appointment) Complete(ctx context.Context) error {
 func (a *Appointment) MarkAsNotPresented(ctx context.Context) error {
 	operation := "MarkAppointmentNotPresented"
 
-	if !a.canTransitionTo(enum.AppointmentStatusNotPresented) {
+	if !a.canTransitionTo(enum.AppointmentStatusNotPresented) || a.HasArrived() {
 		return CannotMarkNotPresentedError(ctx, a.status, operation)
 	}
 
@@ -103,6 +121,15 @@ func (a *Appointment) Confirm(ctx context.Context, employeeID vo.EmployeeID) err
 	return nil
 }
 
+// ConfirmProposal confirms a pending appointment with the employee the clinic proposed for it,
+// such as a follow-up or the reassignment of an absence
+func (a *Appointment) ConfirmProposal(ctx context.Context) error {
+	if a.employeeID == nil {
+		return MissingEmployeeError(ctx, "ConfirmProposal")
+	}
+	return a.Confirm(ctx, *a.employeeID)
+}
+
 func (a *Appointment) canBeRescheduled() bool {
 	reschedulableStatuses := []enum.AppointmentStatus{
 		enum.AppointmentStatusPending,
@@ -143,6 +170,46 @@ func (a *Appointment) canTransitionTo(newStatus enum.AppointmentStatus) bool {
 	return slices.Contains(allowedTransitions, newStatus)
 }
 
+// Duration returns how long the appointment lasts based on its service
+func (a *Appointment) Duration() time.Duration {
+	return a.service.Duration()
+}
+
+func (a *Appointment) EndDate() time.Time {
+	return a.scheduledDate.Add(a.Duration())
+}
+
+// OverlapsWith reports whether both appointments share any instant of time
+func (a *Appointment) OverlapsWith(other Appointment) bool {
+	return a.scheduledDate.Before(other.EndDate()) && a.EndDate().After(other.scheduledDate)
+}
+
+// EnsureNoScheduleConflict rejects the appointment when it overlaps an active appointment
+// of the same employee or the same pet
+func (a *Appointment) EnsureNoScheduleConflict(ctx context.Context, others []Appointment) error {
+	operation := "ValidateScheduleConflict"
+
+	for _, other := range others {
+		if !a.ID().IsZero() && other.ID() == a.ID() {
+			continue
+		}
+
+		if other.status.IsFinalStatus() || !a.OverlapsWith(other) {
+			continue
+		}
+
+		if a.employeeID != nil && other.employeeID != nil && *a.employeeID == *other.employeeID {
+			return ScheduleConflictError(ctx, other, "employee", operation)
+		}
+
+		if a.petID == other.petID {
+			return ScheduleConflictError(ctx, other, "pet", operation)
+		}
+	}
+
+	return nil
+}
+
 func (a *Appointment) IsUpcoming() bool {
 	now := time.Now()
 	return a.status == enum.AppointmentStatusConfirmed &&
@@ -172,50 +239,44 @@ func (a *Appointment) CanBeDeleted(ctx context.Context) error {
 	return nil
 }
 
-func validateScheduledDate(ctx context.Context, date time.Time) error {
+// validateScheduledDate checks the date against the lead-time rules, opening hours and
+// closures configured in the clinic calendar
+func validateScheduledDate(ctx context.Context, date time.Time, duration time.Duration, clinicCalendar calendar.ClinicCalendar) error {
 	operation := "ValidateScheduledDate"
 
-	if date.IsZero() {
-		return ScheduledDateInvalidError(ctx, "scheduled date cannot be zero", operation)
-	}
-
-	now := time.Now()
-	if date.Before(now) {
-		return ScheduledDateInvalidError(ctx, "scheduled date cannot be in the past", operation)
-	}
-
-	minDate := now.AddDate(0, 0, MinAllowedDaysToSchedule)
-	if date.Before(minDate) {
-		return ScheduledDateInvalidError(ctx, "appointments must be scheduled at least 3 days in advance", operation)
+	if err := clinicCalendar.CheckBookingDate(date, duration, time.Now()); err != nil {
+		return ScheduledDateInvalidError(ctx, err.Error(), operation)
 	}
 
-	maxDate := now.AddDate(0, 0, MaxAllowedDaysToSchedule)
-	if date.After(maxDate) {
-		return ScheduledDateInvalidError(ctx, "appointments cannot be scheduled more than 30 days in advance", operation)
-	}
+	return nil
+}
 
-	if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
-		return ScheduledDateInvalidError(ctx, "appointments cannot be scheduled on weekends", operation)
-	}
+func validateOpeningHours(ctx context.Context, date time.Time, duration time.Duration, clinicCalendar calendar.ClinicCalendar) error {
+	operation := "ValidateOpeningHours"
 
-	hour := date.Hour()
-	if hour < 9 || hour >= 18 {
-		return ScheduledDateInvalidError(ctx, "appointments can only be scheduled during business hours (9 AM to 6 PM)", operation)
+	if err := clinicCalendar.CheckOpenBetween(date, date.Add(duration)); err != nil {
+		return ScheduledDateInvalidError(ctx, err.Error(), operation)
 	}
 
 	return nil
 }
 
-func (a *Appointment) ValidatePersistence(ctx context.Context) error {
-	if err := validateScheduledDate(ctx, a.scheduledDate); err != nil {
+// ValidateOpeningHours checks an appointment proposed by the clinic, which is not bound to the
+// booking window, against the opening hours and closures
+func (a *Appointment) ValidateOpeningHours(ctx context.Context, clinicCalendar calendar.ClinicCalendar) error {
+	return validateOpeningHours(ctx, a.scheduledDate, a.Duration(), clinicCalendar)
+}
+
+func (a *Appointment) ValidatePersistence(ctx context.Context, clinicCalendar calendar.ClinicCalendar) error {
+	if err := validateScheduledDate(ctx, a.scheduledDate, a.Duration(), clinicCalendar); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (a *Appointment) UpdateScheduledDate(ctx context.Context, newDate time.Time) error {
-	if err := validateScheduledDate(ctx, newDate); err != nil {
+func (a *Appointment) UpdateScheduledDate(ctx context.Context, newDate time.Time, clinicCalendar calendar.ClinicCalendar) error {
+	if err := validateScheduledDate(ctx, newDate, a.Duration(), clinicCalendar); err != nil {
 		return err
 	}
 
@@ -0,0 +1,58 @@
+package appointment
+
+import (
+	"context"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// EmergencyIntake is what the front desk or the veterinarian records when a patient arrives
+// without a booking
+type EmergencyIntake struct {
+	CustomerID     vo.CustomerID
+	PetID          vo.PetID
+	EmployeeID     vo.EmployeeID
+	Service        enum.ClinicService
+	TriagePriority enum.TriagePriority
+	Notes          *string
+}
+
+// NewEmergencyAppointment registers an emergency or walk-in starting right away. It
+// skips the lead-time, opening hours and closure rules every booking goes through, the patient
+// is already at the clinic and is checked in right away
+func NewEmergencyAppointment(ctx context.Context, intake EmergencyIntake) (*Appointment, error) {
+	operation := "NewEmergencyAppointment"
+
+	if !intake.Service.IsValid() {
+		return nil, InvalidServiceError(ctx, intake.Service, operation)
+	}
+
+	if !intake.TriagePriority.IsValid() {
+		return nil, InvalidTriagePriorityError(ctx, intake.TriagePriority, operation)
+	}
+
+	if intake.EmployeeID.IsZero() {
+		return nil, MissingEmployeeError(ctx, operation)
+	}
+
+	if intake.Notes != nil && len(*intake.Notes) > 1000 {
+		return nil, NotesTooLongError(ctx, operation)
+	}
+
+	now := time.Now()
+	checkedIn := enum.VisitStageCheckedIn
+	triagePriority := intake.TriagePriority
+	return NewAppointmentBuilder().
+		WithCustomerID(intake.CustomerID).
+		WithPetID(intake.PetID).
+		WithEmployeeID(&intake.EmployeeID).
+		WithService(intake.Service).
+		WithScheduledDate(now).
+		WithNotes(intake.Notes).
+		WithStatus(enum.AppointmentStatusConfirmed).
+		WithEmergency(true, &triagePriority).
+		WithVisitProgress(VisitProgress{Stage: &checkedIn, CheckedInAt: &now}).
+		Build(), nil
+}
@@ -3,8 +3,10 @@ package appointment
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
 	domainerr "clinic-vet-api/app/modules/core/error"
 )
 
@@ -23,6 +25,18 @@ const (
 	AppointmentInvalidTransition      AppointmentErrorCode = "APPOINTMENT_INVALID_TRANSITION"
 	AppointmentCannotDelete           AppointmentErrorCode = "APPOINTMENT_CANNOT_DELETE"
 	AppointmentScheduledDateInvalid   AppointmentErrorCode = "APPOINTMENT_SCHEDULED_DATE_INVALID"
+	AppointmentScheduleConflict       AppointmentErrorCode = "APPOINTMENT_SCHEDULE_CONFLICT"
+	AppointmentNoShowLimitExceeded    AppointmentErrorCode = "APPOINTMENT_NO_SHOW_LIMIT_EXCEEDED"
+	AppointmentInvalidRecurrence      AppointmentErrorCode = "APPOINTMENT_INVALID_RECURRENCE"
+	AppointmentInvalidOccurrence      AppointmentErrorCode = "APPOINTMENT_INVALID_OCCURRENCE"
+	AppointmentNotInSeries            AppointmentErrorCode = "APPOINTMENT_NOT_IN_SERIES"
+	AppointmentInvalidTriagePriority  AppointmentErrorCode = "APPOINTMENT_INVALID_TRIAGE_PRIORITY"
+	AppointmentMissingEmployee        AppointmentErrorCode = "APPOINTMENT_MISSING_EMPLOYEE"
+	AppointmentCannotAdvanceVisit     AppointmentErrorCode = "APPOINTMENT_CANNOT_ADVANCE_VISIT"
+	AppointmentCheckInOutsideDay      AppointmentErrorCode = "APPOINTMENT_CHECK_IN_OUTSIDE_DAY"
+	AppointmentInvalidVisitStage      AppointmentErrorCode = "APPOINTMENT_INVALID_VISIT_STAGE"
+	AppointmentInvalidAbsence         AppointmentErrorCode = "APPOINTMENT_INVALID_ABSENCE"
+	AppointmentCannotReassign         AppointmentErrorCode = "APPOINTMENT_CANNOT_REASSIGN"
 )
 
 func appointmentValidationError(ctx context.Context, code AppointmentErrorCode, field, message, operation string) error {
@@ -81,3 +95,66 @@ func ScheduledDateInvalidError(ctx context.Context, message, operation string) e
 	return appointmentValidationError(ctx, AppointmentScheduledDateInvalid, "scheduled_date",
 		message, operation)
 }
+
+func ScheduleConflictError(ctx context.Context, conflictWith Appointment, subject, operation string) error {
+	rule := fmt.Sprintf("the %s already has appointment %s from %s to %s", subject, conflictWith.ID().String(),
+		conflictWith.ScheduledDate().Format("2006-01-02 15:04"), conflictWith.EndDate().Format("15:04"))
+	return appointmentBusinessError(ctx, AppointmentScheduleConflict, rule, operation)
+}
+
+func NoShowLimitExceededError(ctx context.Context, noShows int64, lookbackDays int, operation string) error {
+	rule := fmt.Sprintf("the customer missed %d appointments in the last %d days, please contact the clinic to book", noShows, lookbackDays)
+	return appointmentBusinessError(ctx, AppointmentNoShowLimitExceeded, rule, operation)
+}
+
+func InvalidRecurrenceError(ctx context.Context, message, operation string) error {
+	return appointmentValidationError(ctx, AppointmentInvalidRecurrence, "recurrence", message, operation)
+}
+
+func InvalidOccurrenceError(ctx context.Context, number int, date time.Time, cause error, operation string) error {
+	return appointmentValidationError(ctx, AppointmentInvalidOccurrence, "scheduled_date",
+		fmt.Sprintf("occurrence %d on %s is invalid: %s", number, date.Format("2006-01-02 15:04"), cause.Error()), operation)
+}
+
+func NotInSeriesError(ctx context.Context, id vo.AppointmentID, operation string) error {
+	return appointmentBusinessError(ctx, AppointmentNotInSeries,
+		fmt.Sprintf("appointment %s does not belong to a series", id.String()), operation)
+}
+
+func InvalidTriagePriorityError(ctx context.Context, priority enum.TriagePriority, operation string) error {
+	return appointmentValidationError(ctx, AppointmentInvalidTriagePriority, "triage_priority",
+		fmt.Sprintf("invalid triage priority: %s", priority), operation)
+}
+
+func MissingEmployeeError(ctx context.Context, operation string) error {
+	return appointmentValidationError(ctx, AppointmentMissingEmployee, "employee_id",
+		"an employee must be assigned to the appointment", operation)
+}
+
+func CannotAdvanceVisitError(ctx context.Context, currentStatus enum.AppointmentStatus, operation string) error {
+	return appointmentBusinessError(ctx, AppointmentCannotAdvanceVisit,
+		fmt.Sprintf("only confirmed appointments move through the visit, the appointment is %s", currentStatus), operation)
+}
+
+func CheckInOutsideDayError(ctx context.Context, scheduledDate time.Time, operation string) error {
+	return appointmentBusinessError(ctx, AppointmentCheckInOutsideDay,
+		fmt.Sprintf("the appointment is scheduled on %s, patients can only check in on the day of their appointment", scheduledDate.Format("2006-01-02")), operation)
+}
+
+func InvalidVisitStageError(ctx context.Context, current *enum.VisitStage, to enum.VisitStage, operation string) error {
+	from := "not arrived"
+	if current != nil {
+		from = current.DisplayName()
+	}
+	return appointmentBusinessError(ctx, AppointmentInvalidVisitStage,
+		fmt.Sprintf("the patient cannot move from %s to %s", from, to.DisplayName()), operation)
+}
+
+func InvalidAbsenceError(ctx context.Context, field, message, operation string) error {
+	return appointmentValidationError(ctx, AppointmentInvalidAbsence, field, message, operation)
+}
+
+func CannotReassignError(ctx context.Context, currentStatus enum.AppointmentStatus, operation string) error {
+	return appointmentBusinessError(ctx, AppointmentCannotReassign,
+		fmt.Sprintf("only confirmed appointments can be handed over to another employee, the appointment is %s", currentStatus), operation)
+}
@@ -0,0 +1,46 @@
+package appointment
+
+import (
+	"context"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/enum"
+)
+
+// NoShowPolicy decides when a confirmed appointment counts as missed and how many missed
+// appointments a customer may accumulate before losing self-service booking
+type NoShowPolicy struct {
+	// GracePeriod is the time after the scheduled date before the appointment is marked as not presented
+	GracePeriod time.Duration
+	// MaxNoShows is the number of no-shows tolerated within the lookback window, 0 disables the limit
+	MaxNoShows int
+	// LookbackDays is how far back no-shows are counted
+	LookbackDays int
+}
+
+// OverdueBefore returns the scheduled date before which confirmed appointments are no-shows
+func (p NoShowPolicy) OverdueBefore(now time.Time) time.Time {
+	return now.Add(-p.GracePeriod)
+}
+
+// IsOverdue reports whether the appointment is still confirmed once its grace period is over.
+// Emergencies and checked-in patients are already at the clinic and never become no-shows
+func (p NoShowPolicy) IsOverdue(appointment Appointment, now time.Time) bool {
+	return !appointment.isEmergency && !appointment.HasArrived() &&
+		appointment.status == enum.AppointmentStatusConfirmed &&
+		appointment.scheduledDate.Before(p.OverdueBefore(now))
+}
+
+// LookbackStart returns the date from which no-shows are counted
+func (p NoShowPolicy) LookbackStart(now time.Time) time.Time {
+	return now.AddDate(0, 0, -p.LookbackDays)
+}
+
+// EnsureCanSelfBook rejects customer booking requests once the no-show limit is exceeded
+func (p NoShowPolicy) EnsureCanSelfBook(ctx context.Context, noShows int64) error {
+	if p.MaxNoShows <= 0 || noShows <= int64(p.MaxNoShows) {
+		return nil
+	}
+
+	return NoShowLimitExceededError(ctx, noShows, p.LookbackDays, "RequestAppointmentByCustomer")
+}
@@ -0,0 +1,251 @@
+package appointment
+
+import (
+	"context"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/base"
+	"clinic-vet-api/app/modules/core/domain/entity/calendar"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// MaxSeriesOccurrences caps how many appointments a single series can generate
+const MaxSeriesOccurrences = 52
+
+// Recurrence is an RRULE-like rule (FREQ, INTERVAL and either COUNT or UNTIL)
+type Recurrence struct {
+	Frequency enum.RecurrenceFrequency
+	Interval  int
+	Count     *int
+	Until     *time.Time
+}
+
+func (r Recurrence) Validate(ctx context.Context, start time.Time) error {
+	operation := "ValidateRecurrence"
+
+	if !r.Frequency.IsValid() {
+		return InvalidRecurrenceError(ctx, "invalid frequency: "+r.Frequency.String(), operation)
+	}
+
+	if r.Interval < 1 {
+		return InvalidRecurrenceError(ctx, "interval must be at least 1", operation)
+	}
+
+	if (r.Count == nil) == (r.Until == nil) {
+		return InvalidRecurrenceError(ctx, "either count or until date must be provided", operation)
+	}
+
+	if r.Count != nil && (*r.Count < 1 || *r.Count > MaxSeriesOccurrences) {
+		return InvalidRecurrenceError(ctx, "count must be between 1 and 52", operation)
+	}
+
+	if r.Until != nil {
+		if r.Until.Before(start) {
+			return InvalidRecurrenceError(ctx, "until date cannot be before the first occurrence", operation)
+		}
+
+		if r.occurrencesUntil(start, MaxSeriesOccurrences+1) > MaxSeriesOccurrences {
+			return InvalidRecurrenceError(ctx, "the series cannot have more than 52 occurrences", operation)
+		}
+	}
+
+	return nil
+}
+
+// Dates returns the start date of every occurrence, the first one being start
+func (r Recurrence) Dates(start time.Time) []time.Time {
+	limit := MaxSeriesOccurrences
+	if r.Count != nil && *r.Count < limit {
+		limit = *r.Count
+	}
+
+	dates := make([]time.Time, 0, limit)
+	for n := 0; n < limit; n++ {
+		date := r.nth(start, n)
+		if r.Until != nil && date.After(endOfDay(*r.Until)) {
+			break
+		}
+		dates = append(dates, date)
+	}
+	return dates
+}
+
+func (r Recurrence) occurrencesUntil(start time.Time, limit int) int {
+	count := 0
+	for n := 0; n < limit && !r.nth(start, n).After(endOfDay(*r.Until)); n++ {
+		count++
+	}
+	return count
+}
+
+// nth returns the n-th occurrence. Monthly occurrences keep the day of the month and fall back
+// to the last day when the month is shorter (Jan 31 -> Feb 28)
+func (r Recurrence) nth(start time.Time, n int) time.Time {
+	if r.Frequency == enum.RecurrenceFrequencyWeekly {
+		return start.AddDate(0, 0, 7*r.Interval*n)
+	}
+
+	firstOfMonth := time.Date(start.Year(), start.Month(), 1, start.Hour(), start.Minute(), 0, 0, start.Location())
+	target := firstOfMonth.AddDate(0, r.Interval*n, 0)
+	lastDay := target.AddDate(0, 1, -1).Day()
+
+	day := start.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(target.Year(), target.Month(), day, start.Hour(), start.Minute(), 0, 0, start.Location())
+}
+
+func endOfDay(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 23, 59, 59, 0, date.Location())
+}
+
+// AppointmentSeries groups the appointments generated from a recurrence, used for chronic
+// patients that come back on a fixed rhythm
+type AppointmentSeries struct {
+	base.Entity[vo.ApptSeriesID]
+	customerID vo.CustomerID
+	petID      vo.PetID
+	employeeID *vo.EmployeeID
+	service    enum.ClinicService
+	startDate  time.Time
+	recurrence Recurrence
+	notes      *string
+}
+
+type AppointmentSeriesBuilder struct{ series *AppointmentSeries }
+
+func NewAppointmentSeriesBuilder() *AppointmentSeriesBuilder {
+	return &AppointmentSeriesBuilder{series: &AppointmentSeries{}}
+}
+
+func (b *AppointmentSeriesBuilder) WithID(id vo.ApptSeriesID) *AppointmentSeriesBuilder {
+	b.series.SetID(id)
+	return b
+}
+
+func (b *AppointmentSeriesBuilder) WithCustomerID(customerID vo.CustomerID) *AppointmentSeriesBuilder {
+	b.series.customerID = customerID
+	return b
+}
+
+func (b *AppointmentSeriesBuilder) WithPetID(petID vo.PetID) *AppointmentSeriesBuilder {
+	b.series.petID = petID
+	return b
+}
+
+func (b *AppointmentSeriesBuilder) WithEmployeeID(employeeID *vo.EmployeeID) *AppointmentSeriesBuilder {
+	b.series.employeeID = employeeID
+	return b
+}
+
+func (b *AppointmentSeriesBuilder) WithService(service enum.ClinicService) *AppointmentSeriesBuilder {
+	b.series.service = service
+	return b
+}
+
+func (b *AppointmentSeriesBuilder) WithStartDate(startDate time.Time) *AppointmentSeriesBuilder {
+	b.series.startDate = startDate
+	return b
+}
+
+func (b *AppointmentSeriesBuilder) WithRecurrence(recurrence Recurrence) *AppointmentSeriesBuilder {
+	b.series.recurrence = recurrence
+	return b
+}
+
+func (b *AppointmentSeriesBuilder) WithNotes(notes *string) *AppointmentSeriesBuilder {
+	b.series.notes = notes
+	return b
+}
+
+func (b *AppointmentSeriesBuilder) WithTimestamps(createdAt, updatedAt time.Time) *AppointmentSeriesBuilder {
+	b.series.SetTimeStamps(createdAt, updatedAt)
+	return b
+}
+
+func (b *AppointmentSeriesBuilder) Build() *AppointmentSeries {
+	return b.series
+}
+
+func (s *AppointmentSeries) CustomerID() vo.CustomerID   { return s.customerID }
+func (s *AppointmentSeries) PetID() vo.PetID             { return s.petID }
+func (s *AppointmentSeries) EmployeeID() *vo.EmployeeID  { return s.employeeID }
+func (s *AppointmentSeries) Service() enum.ClinicService { return s.service }
+func (s *AppointmentSeries) StartDate() time.Time        { return s.startDate }
+func (s *AppointmentSeries) Recurrence() Recurrence      { return s.recurrence }
+func (s *AppointmentSeries) Notes() *string              { return s.notes }
+
+// LastDate returns the start date of the last occurrence of the recurrence
+func (s *AppointmentSeries) LastDate() time.Time {
+	dates := s.recurrence.Dates(s.startDate)
+	if len(dates) == 0 {
+		return s.startDate
+	}
+	return dates[len(dates)-1]
+}
+
+// GenerateOccurrences builds one appointment per date of the recurrence. Every occurrence goes
+// through the same calendar validation as a single appointment, so a recurrence reaching past
+// the booking window is rejected; the whole series is rejected when one of them does not fit
+func (s *AppointmentSeries) GenerateOccurrences(ctx context.Context, clinicCalendar calendar.ClinicCalendar) ([]Appointment, error) {
+	operation := "GenerateSeriesOccurrences"
+
+	if !s.service.IsValid() {
+		return nil, InvalidServiceError(ctx, s.service, operation)
+	}
+
+	if s.notes != nil && len(*s.notes) > 1000 {
+		return nil, NotesTooLongError(ctx, operation)
+	}
+
+	if err := s.recurrence.Validate(ctx, s.startDate); err != nil {
+		return nil, err
+	}
+
+	status := enum.AppointmentStatusPending
+	if s.employeeID != nil {
+		status = enum.AppointmentStatusConfirmed
+	}
+
+	dates := s.recurrence.Dates(s.startDate)
+	occurrences := make([]Appointment, 0, len(dates))
+	for i, date := range dates {
+		if err := validateScheduledDate(ctx, date, s.service.Duration(), clinicCalendar); err != nil {
+			return nil, InvalidOccurrenceError(ctx, i+1, date, err, operation)
+		}
+
+		occurrence := NewAppointmentBuilder().
+			WithCustomerID(s.customerID).
+			WithPetID(s.petID).
+			WithEmployeeID(s.employeeID).
+			WithService(s.service).
+			WithScheduledDate(date).
+			WithNotes(s.notes).
+			WithStatus(status).
+			Build()
+
+		occurrences = append(occurrences, *occurrence)
+	}
+
+	return occurrences, nil
+}
+
+// AttachToSeries links the appointment to the series it was generated from
+func (a *Appointment) AttachToSeries(seriesID vo.ApptSeriesID) {
+	a.seriesID = &seriesID
+}
+
+// FollowingOccurrences returns the occurrences of the series from the given one onwards that
+// can still be changed, in chronological order
+func FollowingOccurrences(occurrences []Appointment, from Appointment) []Appointment {
+	following := []Appointment{}
+	for _, occurrence := range occurrences {
+		if occurrence.ScheduledDate().Before(from.ScheduledDate()) || occurrence.Status().IsFinalStatus() {
+			continue
+		}
+		following = append(following, occurrence)
+	}
+	return following
+}
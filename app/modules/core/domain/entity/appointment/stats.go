@@ -0,0 +1,63 @@
+package appointment
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// StatsFilter scopes the statistics to the appointments scheduled in [StartDate, EndDate),
+// optionally only the ones assigned to a single employee
+type StatsFilter struct {
+	EmployeeID *vo.EmployeeID
+	StartDate  time.Time
+	EndDate    time.Time
+}
+
+type ServiceStats struct {
+	Service   enum.ClinicService
+	Total     int
+	Completed int
+	NoShows   int
+	Cancelled int
+}
+
+type WeekdayCount struct {
+	Weekday time.Weekday
+	Count   int
+}
+
+type HourCount struct {
+	Hour  int
+	Count int
+}
+
+// Stats aggregates the appointments matching a StatsFilter. Weekdays and hours are sorted from
+// the busiest to the quietest and leave cancelled appointments out
+type Stats struct {
+	Total       int
+	ByStatus    map[enum.AppointmentStatus]int
+	ByService   []ServiceStats
+	ByWeekday   []WeekdayCount
+	ByHour      []HourCount
+	AvgLeadTime time.Duration
+}
+
+// NoShowRate is the share of the appointments that were due and the pet did not show up
+func (s Stats) NoShowRate() float64 {
+	noShows := s.ByStatus[enum.AppointmentStatusNotPresented]
+	return rate(noShows, noShows+s.ByStatus[enum.AppointmentStatusCompleted])
+}
+
+// CompletionRate is the share of the appointments that were not cancelled and got completed
+func (s Stats) CompletionRate() float64 {
+	return rate(s.ByStatus[enum.AppointmentStatusCompleted], s.Total-s.ByStatus[enum.AppointmentStatusCancelled])
+}
+
+func rate(part, total int) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(part) / float64(total)
+}
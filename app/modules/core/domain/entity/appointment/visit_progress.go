@@ -0,0 +1,132 @@
+package appointment
+
+import (
+	"context"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/enum"
+)
+
+// VisitProgress records when the patient reached every stage of the visit. A nil stage means
+// the patient has not arrived yet
+type VisitProgress struct {
+	Stage              *enum.VisitStage
+	CheckedInAt        *time.Time
+	InExamRoomAt       *time.Time
+	ReadyForCheckoutAt *time.Time
+}
+
+// WaitTime is how long the patient waited between check-in and the exam room, still running
+// while the patient is waiting
+func (v VisitProgress) WaitTime(now time.Time) time.Duration {
+	if v.CheckedInAt == nil {
+		return 0
+	}
+	if v.InExamRoomAt != nil {
+		return v.InExamRoomAt.Sub(*v.CheckedInAt)
+	}
+	return now.Sub(*v.CheckedInAt)
+}
+
+// ExamTime is how long the patient spent in the exam room, still running during the exam
+func (v VisitProgress) ExamTime(now time.Time) time.Duration {
+	if v.InExamRoomAt == nil {
+		return 0
+	}
+	if v.ReadyForCheckoutAt != nil {
+		return v.ReadyForCheckoutAt.Sub(*v.InExamRoomAt)
+	}
+	return now.Sub(*v.InExamRoomAt)
+}
+
+func (b *AppointmentBuilder) WithVisitProgress(progress VisitProgress) *AppointmentBuilder {
+	b.appt.visit = progress
+	return b
+}
+
+func (a *Appointment) VisitProgress() VisitProgress { return a.visit }
+
+func (a *Appointment) VisitStage() *enum.VisitStage { return a.visit.Stage }
+
+// HasArrived reports whether the patient checked in at the clinic
+func (a *Appointment) HasArrived() bool { return a.visit.Stage != nil }
+
+// CheckIn registers the arrival of the patient of a confirmed appointment of the day
+func (a *Appointment) CheckIn(ctx context.Context, at time.Time) error {
+	operation := "CheckInAppointment"
+
+	if a.status != enum.AppointmentStatusConfirmed {
+		return CannotAdvanceVisitError(ctx, a.status, operation)
+	}
+
+	if !sameDay(a.scheduledDate, at) {
+		return CheckInOutsideDayError(ctx, a.scheduledDate, operation)
+	}
+
+	if err := a.advanceVisit(ctx, nil, enum.VisitStageCheckedIn, operation); err != nil {
+		return err
+	}
+
+	a.visit.CheckedInAt = &at
+	return nil
+}
+
+// MoveToExamRoom calls the waiting patient into the exam room
+func (a *Appointment) MoveToExamRoom(ctx context.Context, at time.Time) error {
+	operation := "MoveAppointmentToExamRoom"
+
+	from := enum.VisitStageCheckedIn
+	if err := a.advanceVisit(ctx, &from, enum.VisitStageInExamRoom, operation); err != nil {
+		return err
+	}
+
+	a.visit.InExamRoomAt = &at
+	return nil
+}
+
+// MarkReadyForCheckout sends the patient back to the front desk once the exam is over
+func (a *Appointment) MarkReadyForCheckout(ctx context.Context, at time.Time) error {
+	operation := "MarkAppointmentReadyForCheckout"
+
+	from := enum.VisitStageInExamRoom
+	if err := a.advanceVisit(ctx, &from, enum.VisitStageReadyForCheckout, operation); err != nil {
+		return err
+	}
+
+	a.visit.ReadyForCheckoutAt = &at
+	return nil
+}
+
+// AdvanceVisit moves the patient to the given stage, stamped at the given time
+func (a *Appointment) AdvanceVisit(ctx context.Context, stage enum.VisitStage, at time.Time) error {
+	switch stage {
+	case enum.VisitStageCheckedIn:
+		return a.CheckIn(ctx, at)
+	case enum.VisitStageInExamRoom:
+		return a.MoveToExamRoom(ctx, at)
+	case enum.VisitStageReadyForCheckout:
+		return a.MarkReadyForCheckout(ctx, at)
+	default:
+		return InvalidVisitStageError(ctx, a.visit.Stage, stage, "AdvanceVisit")
+	}
+}
+
+func (a *Appointment) advanceVisit(ctx context.Context, from *enum.VisitStage, to enum.VisitStage, operation string) error {
+	if a.status != enum.AppointmentStatusConfirmed {
+		return CannotAdvanceVisitError(ctx, a.status, operation)
+	}
+
+	current := a.visit.Stage
+	if (from == nil) != (current == nil) || (from != nil && *from != *current) {
+		return InvalidVisitStageError(ctx, current, to, operation)
+	}
+
+	a.visit.Stage = &to
+	a.IncrementVersion()
+	return nil
+}
+
+func sameDay(a, b time.Time) bool {
+	b = b.In(a.Location())
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
@@ -0,0 +1,103 @@
+package appointment
+
+import (
+	"slices"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/enum"
+)
+
+// QueueEntry is a patient in the clinic with the time spent at every stage so far
+type QueueEntry struct {
+	Appointment Appointment
+	// Position in the waiting line, 0 once the patient left the waiting room
+	Position int
+	WaitTime time.Duration
+	ExamTime time.Duration
+}
+
+// QueueMetrics summarizes the waiting room. Door-to-room times cover every patient of the day
+// who reached an exam room, including the visits already completed
+type QueueMetrics struct {
+	Waiting          int
+	InExamRoom       int
+	ReadyForCheckout int
+	AvgWait          time.Duration
+	LongestWait      time.Duration
+	AvgDoorToRoom    time.Duration
+	Seen             int
+}
+
+// WaitingRoomQueue is the live queue of the patients of the day still in the clinic
+type WaitingRoomQueue struct {
+	Entries []QueueEntry
+	Metrics QueueMetrics
+}
+
+// BuildWaitingRoomQueue orders the patients checked in today still in the clinic by triage
+// priority, then by scheduled time. Bookings without triage rank as non-urgent
+func BuildWaitingRoomQueue(visits []Appointment, now time.Time) WaitingRoomQueue {
+	queue := WaitingRoomQueue{Entries: []QueueEntry{}}
+
+	var totalWait, totalDoorToRoom time.Duration
+	for _, visit := range visits {
+		if visit.visit.InExamRoomAt != nil {
+			totalDoorToRoom += visit.visit.WaitTime(now)
+			queue.Metrics.Seen++
+		}
+
+		if visit.status != enum.AppointmentStatusConfirmed || !visit.HasArrived() {
+			continue
+		}
+
+		entry := QueueEntry{
+			Appointment: visit,
+			WaitTime:    visit.visit.WaitTime(now),
+			ExamTime:    visit.visit.ExamTime(now),
+		}
+
+		switch *visit.visit.Stage {
+		case enum.VisitStageCheckedIn:
+			queue.Metrics.Waiting++
+			totalWait += entry.WaitTime
+			queue.Metrics.LongestWait = max(queue.Metrics.LongestWait, entry.WaitTime)
+		case enum.VisitStageInExamRoom:
+			queue.Metrics.InExamRoom++
+		case enum.VisitStageReadyForCheckout:
+			queue.Metrics.ReadyForCheckout++
+		}
+
+		queue.Entries = append(queue.Entries, entry)
+	}
+
+	slices.SortStableFunc(queue.Entries, func(a, b QueueEntry) int {
+		if rankA, rankB := a.Appointment.queueRank(), b.Appointment.queueRank(); rankA != rankB {
+			return rankA - rankB
+		}
+		return a.Appointment.scheduledDate.Compare(b.Appointment.scheduledDate)
+	})
+
+	position := 0
+	for i := range queue.Entries {
+		if *queue.Entries[i].Appointment.visit.Stage == enum.VisitStageCheckedIn {
+			position++
+			queue.Entries[i].Position = position
+		}
+	}
+
+	if queue.Metrics.Waiting > 0 {
+		queue.Metrics.AvgWait = totalWait / time.Duration(queue.Metrics.Waiting)
+	}
+	if queue.Metrics.Seen > 0 {
+		queue.Metrics.AvgDoorToRoom = totalDoorToRoom / time.Duration(queue.Metrics.Seen)
+	}
+
+	return queue
+}
+
+func (a *Appointment) queueRank() int {
+	if a.triagePriority == nil {
+		return enum.TriagePriorityNonUrgent.Rank()
+	}
+	return a.triagePriority.Rank()
+}
@@ -0,0 +1,196 @@
+// Package calendar contains the clinic calendar: opening hours, closures and booking policy
+package calendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	DefaultMinLeadDays = 3
+	DefaultMaxLeadDays = 30
+	DefaultOpenHour    = 9
+	DefaultCloseHour   = 18
+)
+
+// OpeningHours holds the hours the clinic is open on a weekday (24h format, close hour exclusive)
+type OpeningHours struct {
+	Day       time.Weekday
+	OpenHour  int
+	CloseHour int
+	IsClosed  bool
+}
+
+// BookingPolicy holds the lead-time rules applied when customers or staff book appointments
+type BookingPolicy struct {
+	MinLeadDays int
+	MaxLeadDays int
+}
+
+type ClinicCalendar struct {
+	openingHours map[time.Weekday]OpeningHours
+	closures     []Closure
+	policy       BookingPolicy
+}
+
+func NewClinicCalendar(openingHours []OpeningHours, closures []Closure, policy BookingPolicy) ClinicCalendar {
+	hoursByDay := make(map[time.Weekday]OpeningHours, len(openingHours))
+	for _, hours := range openingHours {
+		hoursByDay[hours.Day] = hours
+	}
+
+	return ClinicCalendar{
+		openingHours: hoursByDay,
+		closures:     closures,
+		policy:       policy,
+	}
+}
+
+// DefaultOpeningHours returns Monday to Friday from 9 AM to 6 PM, weekends closed
+func DefaultOpeningHours() []OpeningHours {
+	hours := make([]OpeningHours, 0, 7)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		hours = append(hours, OpeningHours{
+			Day:       day,
+			OpenHour:  DefaultOpenHour,
+			CloseHour: DefaultCloseHour,
+			IsClosed:  day == time.Saturday || day == time.Sunday,
+		})
+	}
+	return hours
+}
+
+func DefaultBookingPolicy() BookingPolicy {
+	return BookingPolicy{MinLeadDays: DefaultMinLeadDays, MaxLeadDays: DefaultMaxLeadDays}
+}
+
+func (c ClinicCalendar) Policy() BookingPolicy { return c.policy }
+func (c ClinicCalendar) Closures() []Closure   { return c.closures }
+
+// OpeningHours returns the weekly opening hours sorted from Sunday to Saturday
+func (c ClinicCalendar) OpeningHours() []OpeningHours {
+	hours := make([]OpeningHours, 0, len(c.openingHours))
+	for _, dayHours := range c.openingHours {
+		hours = append(hours, dayHours)
+	}
+	sort.Slice(hours, func(i, j int) bool { return hours[i].Day < hours[j].Day })
+	return hours
+}
+
+// OpeningHoursFor returns the opening and closing hour of the weekday and whether the clinic opens
+func (c ClinicCalendar) OpeningHoursFor(day time.Weekday) (int, int, bool) {
+	hours, exists := c.openingHours[day]
+	if !exists || hours.IsClosed {
+		return 0, 0, false
+	}
+	return hours.OpenHour, hours.CloseHour, true
+}
+
+// ClosureOn returns the closure registered for the calendar day of date, if any
+func (c ClinicCalendar) ClosureOn(date time.Time) (Closure, bool) {
+	for _, closure := range c.closures {
+		if sameDay(closure.date, date) {
+			return closure, true
+		}
+	}
+	return Closure{}, false
+}
+
+// IsOpenBetween reports whether the clinic is open for the whole interval [start, end)
+func (c ClinicCalendar) IsOpenBetween(start, end time.Time) bool {
+	return c.CheckOpenBetween(start, end) == nil
+}
+
+// CheckBookingDate validates a booking starting at date and lasting duration against the
+// lead-time rules, the weekly opening hours and the registered closures
+func (c ClinicCalendar) CheckBookingDate(date time.Time, duration time.Duration, now time.Time) error {
+	if err := c.checkMinLeadTime(date, now); err != nil {
+		return err
+	}
+
+	if date.After(now.AddDate(0, 0, c.policy.MaxLeadDays)) {
+		return fmt.Errorf("appointments cannot be scheduled more than %d days in advance", c.policy.MaxLeadDays)
+	}
+
+	return c.CheckOpenBetween(date, date.Add(duration))
+}
+
+func (c ClinicCalendar) checkMinLeadTime(date time.Time, now time.Time) error {
+	if date.IsZero() {
+		return errors.New("scheduled date cannot be zero")
+	}
+
+	if date.Before(now) {
+		return errors.New("scheduled date cannot be in the past")
+	}
+
+	if date.Before(now.AddDate(0, 0, c.policy.MinLeadDays)) {
+		return fmt.Errorf("appointments must be scheduled at least %d days in advance", c.policy.MinLeadDays)
+	}
+
+	return nil
+}
+
+// CheckOpenBetween validates the interval [start, end) against the weekly opening hours and the
+// registered closures only
+func (c ClinicCalendar) CheckOpenBetween(start, end time.Time) error {
+	openHour, closeHour, isOpen := c.OpeningHoursFor(start.Weekday())
+	if !isOpen {
+		return fmt.Errorf("the clinic is closed on %s", start.Weekday())
+	}
+
+	opensAt := time.Date(start.Year(), start.Month(), start.Day(), openHour, 0, 0, 0, start.Location())
+	closesAt := time.Date(start.Year(), start.Month(), start.Day(), closeHour, 0, 0, 0, start.Location())
+	if start.Before(opensAt) || end.After(closesAt) {
+		return fmt.Errorf("appointments can only be scheduled during business hours (%d:00 to %d:00)", openHour, closeHour)
+	}
+
+	if closure, exists := c.ClosureOn(start); exists && closure.Covers(start, end) {
+		return fmt.Errorf("the clinic is closed on %s: %s", closure.date.Format("2006-01-02"), closure.reason)
+	}
+
+	return nil
+}
+
+func ValidateOpeningHours(ctx context.Context, openingHours []OpeningHours) error {
+	operation := "ValidateOpeningHours"
+
+	seen := make(map[time.Weekday]bool, len(openingHours))
+	for _, hours := range openingHours {
+		if hours.Day < time.Sunday || hours.Day > time.Saturday {
+			return InvalidOpeningHoursError(ctx, fmt.Sprintf("invalid weekday %d", hours.Day), operation)
+		}
+
+		if seen[hours.Day] {
+			return InvalidOpeningHoursError(ctx, fmt.Sprintf("%s is defined more than once", hours.Day), operation)
+		}
+		seen[hours.Day] = true
+
+		if hours.IsClosed {
+			continue
+		}
+
+		if hours.OpenHour < 0 || hours.CloseHour > 24 || hours.OpenHour >= hours.CloseHour {
+			return InvalidOpeningHoursError(ctx, fmt.Sprintf("%s must open before it closes, within 0 and 24", hours.Day), operation)
+		}
+	}
+
+	return nil
+}
+
+func (p BookingPolicy) Validate(ctx context.Context) error {
+	operation := "ValidateBookingPolicy"
+
+	if p.MinLeadDays < 0 {
+		return InvalidLeadTimeError(ctx, "minimum lead days cannot be negative", operation)
+	}
+
+	if p.MaxLeadDays < p.MinLeadDays {
+		return InvalidLeadTimeError(ctx, "maximum lead days cannot be lower than the minimum", operation)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/base"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// Closure is a date where the clinic does not take appointments, either for the whole
+// day or, when start and end hours are set, for part of it
+type Closure struct {
+	base.Entity[valueobject.ClosureID]
+	date        time.Time
+	closureType enum.ClosureType
+	reason      string
+	startHour   *int
+	endHour     *int
+}
+
+type ClosureBuilder struct{ closure *Closure }
+
+func NewClosureBuilder() *ClosureBuilder {
+	return &ClosureBuilder{closure: &Closure{}}
+}
+
+func (b *ClosureBuilder) WithID(id valueobject.ClosureID) *ClosureBuilder {
+	b.closure.SetID(id)
+	return b
+}
+
+func (b *ClosureBuilder) WithDate(date time.Time) *ClosureBuilder {
+	b.closure.date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	return b
+}
+
+func (b *ClosureBuilder) WithClosureType(closureType enum.ClosureType) *ClosureBuilder {
+	b.closure.closureType = closureType
+	return b
+}
+
+func (b *ClosureBuilder) WithReason(reason string) *ClosureBuilder {
+	b.closure.reason = reason
+	return b
+}
+
+func (b *ClosureBuilder) WithHours(startHour, endHour *int) *ClosureBuilder {
+	b.closure.startHour = startHour
+	b.closure.endHour = endHour
+	return b
+}
+
+func (b *ClosureBuilder) WithTimestamps(createdAt, updatedAt time.Time) *ClosureBuilder {
+	b.closure.SetTimeStamps(createdAt, updatedAt)
+	return b
+}
+
+func (b *ClosureBuilder) Build() *Closure {
+	return b.closure
+}
+
+func (c *Closure) Date() time.Time               { return c.date }
+func (c *Closure) ClosureType() enum.ClosureType { return c.closureType }
+func (c *Closure) Reason() string                { return c.reason }
+func (c *Closure) StartHour() *int               { return c.startHour }
+func (c *Closure) EndHour() *int                 { return c.endHour }
+
+func (c *Closure) IsFullDay() bool {
+	return c.startHour == nil || c.endHour == nil
+}
+
+// Covers reports whether the interval [start, end) falls, even partially, inside the closure
+func (c *Closure) Covers(start, end time.Time) bool {
+	if !sameDay(c.date, start) {
+		return false
+	}
+
+	if c.IsFullDay() {
+		return true
+	}
+
+	closedFrom := time.Date(start.Year(), start.Month(), start.Day(), *c.startHour, 0, 0, 0, start.Location())
+	closedTo := time.Date(start.Year(), start.Month(), start.Day(), *c.endHour, 0, 0, 0, start.Location())
+	return start.Before(closedTo) && end.After(closedFrom)
+}
+
+func (c *Closure) Validate(ctx context.Context) error {
+	operation := "ValidateClosure"
+
+	if c.date.IsZero() {
+		return InvalidClosureError(ctx, "date is required", operation)
+	}
+
+	if !c.closureType.IsValid() {
+		return InvalidClosureError(ctx, fmt.Sprintf("invalid closure type: %s", c.closureType), operation)
+	}
+
+	if c.reason == "" || len(c.reason) > 255 {
+		return InvalidClosureError(ctx, "reason is required and cannot exceed 255 characters", operation)
+	}
+
+	if (c.startHour == nil) != (c.endHour == nil) {
+		return InvalidClosureError(ctx, "start and end hour must be provided together", operation)
+	}
+
+	if !c.IsFullDay() && (*c.startHour < 0 || *c.endHour > 24 || *c.startHour >= *c.endHour) {
+		return InvalidClosureError(ctx, "start hour must be before end hour, within 0 and 24", operation)
+	}
+
+	return nil
+}
+
+func sameDay(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.Month() == b.Month() && a.Day() == b.Day()
+}
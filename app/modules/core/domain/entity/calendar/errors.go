@@ -0,0 +1,33 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+
+	domainerr "clinic-vet-api/app/modules/core/error"
+)
+
+type CalendarErrorCode string
+
+const (
+	CalendarInvalidOpeningHours CalendarErrorCode = "CALENDAR_INVALID_OPENING_HOURS"
+	CalendarInvalidLeadTime     CalendarErrorCode = "CALENDAR_INVALID_LEAD_TIME"
+	CalendarInvalidClosure      CalendarErrorCode = "CALENDAR_INVALID_CLOSURE"
+)
+
+func calendarValidationError(ctx context.Context, code CalendarErrorCode, field, message, operation string) error {
+	return domainerr.ValidationError(ctx, string(code), "clinic_calendar", field,
+		fmt.Sprintf("Clinic calendar %s: %s", field, message), operation)
+}
+
+func InvalidOpeningHoursError(ctx context.Context, message, operation string) error {
+	return calendarValidationError(ctx, CalendarInvalidOpeningHours, "opening_hours", message, operation)
+}
+
+func InvalidLeadTimeError(ctx context.Context, message, operation string) error {
+	return calendarValidationError(ctx, CalendarInvalidLeadTime, "booking_policy", message, operation)
+}
+
+func InvalidClosureError(ctx context.Context, message, operation string) error {
+	return calendarValidationError(ctx, CalendarInvalidClosure, "closure", message, operation)
+}
@@ -0,0 +1,65 @@
+// Package calendarfeed defines the iCalendar feeds employees and customers subscribe to
+package calendarfeed
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/base"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// CalendarFeed publishes the appointments of one employee or customer. The feed is reached
+// through a signed URL; revoking the feed invalidates every URL issued for it
+type CalendarFeed struct {
+	base.Entity[vo.CalendarFeedID]
+	ownerType enum.CalendarFeedOwner
+	ownerID   uint
+	revokedAt *time.Time
+}
+
+type CalendarFeedBuilder struct{ feed *CalendarFeed }
+
+func NewCalendarFeedBuilder() *CalendarFeedBuilder {
+	return &CalendarFeedBuilder{feed: &CalendarFeed{}}
+}
+
+func (b *CalendarFeedBuilder) WithID(id vo.CalendarFeedID) *CalendarFeedBuilder {
+	b.feed.SetID(id)
+	return b
+}
+
+func (b *CalendarFeedBuilder) WithOwner(ownerType enum.CalendarFeedOwner, ownerID uint) *CalendarFeedBuilder {
+	b.feed.ownerType = ownerType
+	b.feed.ownerID = ownerID
+	return b
+}
+
+func (b *CalendarFeedBuilder) WithRevokedAt(revokedAt *time.Time) *CalendarFeedBuilder {
+	b.feed.revokedAt = revokedAt
+	return b
+}
+
+func (b *CalendarFeedBuilder) WithCreatedAt(createdAt time.Time) *CalendarFeedBuilder {
+	b.feed.SetTimeStamps(createdAt, createdAt)
+	return b
+}
+
+func (b *CalendarFeedBuilder) Build() *CalendarFeed {
+	return b.feed
+}
+
+func (f *CalendarFeed) OwnerType() enum.CalendarFeedOwner { return f.ownerType }
+func (f *CalendarFeed) OwnerID() uint                     { return f.ownerID }
+func (f *CalendarFeed) RevokedAt() *time.Time             { return f.revokedAt }
+func (f *CalendarFeed) IsRevoked() bool                   { return f.revokedAt != nil }
+
+// EmployeeID returns the owner when the feed belongs to an employee
+func (f *CalendarFeed) EmployeeID() (vo.EmployeeID, bool) {
+	return vo.NewEmployeeID(f.ownerID), f.ownerType == enum.CalendarFeedOwnerEmployee
+}
+
+// CustomerID returns the owner when the feed belongs to a customer
+func (f *CalendarFeed) CustomerID() (vo.CustomerID, bool) {
+	return vo.NewCustomerID(f.ownerID), f.ownerType == enum.CalendarFeedOwnerCustomer
+}
@@ -0,0 +1,27 @@
+package calendarfeed
+
+import (
+	"context"
+	"fmt"
+
+	domainerr "clinic-vet-api/app/modules/core/error"
+)
+
+type CalendarFeedErrorCode string
+
+const (
+	CalendarFeedInvalidToken CalendarFeedErrorCode = "CALENDAR_FEED_INVALID_TOKEN"
+)
+
+func calendarFeedValidationError(ctx context.Context, code CalendarFeedErrorCode, field, message, operation string) error {
+	return domainerr.ValidationError(ctx, string(code), "calendar_feed", field,
+		fmt.Sprintf("Calendar feed %s: %s", field, message), operation)
+}
+
+func InvalidTokenError(ctx context.Context, operation string) error {
+	return calendarFeedValidationError(ctx, CalendarFeedInvalidToken, "token", "the feed token is not valid", operation)
+}
+
+func RevokedFeedError(ctx context.Context, operation string) error {
+	return domainerr.BusinessRuleError(ctx, "the calendar feed has been revoked", "calendar_feed", "revoked_at", operation)
+}
@@ -0,0 +1,64 @@
+package calendarfeed
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// Signer issues and checks feed tokens. A token is "<feed id>.<signature>", where the signature
+// is an HMAC of the feed id, its owner and its creation time, so a token can't be forged for
+// another feed and a re-issued feed never accepts the tokens of the previous one
+type Signer struct {
+	secret []byte
+}
+
+func NewSigner(secret string) Signer {
+	return Signer{secret: []byte(secret)}
+}
+
+func (s Signer) Token(feed CalendarFeed) string {
+	return fmt.Sprintf("%d.%s", feed.ID().Value(), s.signature(feed))
+}
+
+// ParseToken extracts the feed id, the signature is checked with Verify once the feed is loaded
+func (s Signer) ParseToken(ctx context.Context, token string) (vo.CalendarFeedID, error) {
+	idPart, _, found := strings.Cut(token, ".")
+	if !found {
+		return vo.CalendarFeedID{}, InvalidTokenError(ctx, "ParseFeedToken")
+	}
+
+	id, err := strconv.ParseUint(idPart, 10, 32)
+	if err != nil || id == 0 {
+		return vo.CalendarFeedID{}, InvalidTokenError(ctx, "ParseFeedToken")
+	}
+
+	return vo.NewCalendarFeedID(uint(id)), nil
+}
+
+func (s Signer) Verify(ctx context.Context, feed CalendarFeed, token string) error {
+	operation := "VerifyFeedToken"
+
+	if !hmac.Equal([]byte(token), []byte(s.Token(feed))) {
+		return InvalidTokenError(ctx, operation)
+	}
+
+	if feed.IsRevoked() {
+		return RevokedFeedError(ctx, operation)
+	}
+
+	return nil
+}
+
+func (s Signer) signature(feed CalendarFeed) string {
+	mac := hmac.New(sha256.New, s.secret)
+	fmt.Fprintf(mac, "calendar-feed:%d:%s:%d:%d",
+		feed.ID().Value(), feed.OwnerType(), feed.OwnerID(), feed.CreatedAt().UnixMicro())
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -17,12 +17,14 @@ type Customer struct {
 	userID   *valueobject.UserID
 	isActive bool
 	pets     []pet.Pet
+
+	preferredChannel enum.NotificationChannel
 }
 
 type CustomerBuilder struct{ customer *Customer }
 
 func NewCustomerBuilder() *CustomerBuilder {
-	return &CustomerBuilder{customer: &Customer{}}
+	return &CustomerBuilder{customer: &Customer{preferredChannel: enum.NotificationChannelEmail}}
 }
 
 func (cb *CustomerBuilder) WithID(id valueobject.CustomerID) *CustomerBuilder {
@@ -65,6 +67,12 @@ func (cb *CustomerBuilder) WithPets(pets []pet.Pet) *CustomerBuilder {
 	return cb
 }
 
+// WithPreferredChannel sets the channel used to reach the customer (email or sms)
+func (cb *CustomerBuilder) WithPreferredChannel(channel enum.NotificationChannel) *CustomerBuilder {
+	cb.customer.preferredChannel = channel
+	return cb
+}
+
 func (cb *CustomerBuilder) WithTimestamp(createdAt, updatedAt time.Time) *CustomerBuilder {
 	cb.customer.SetTimeStamps(createdAt, updatedAt)
 	return cb
@@ -72,11 +80,12 @@ func (cb *CustomerBuilder) WithTimestamp(createdAt, updatedAt time.Time) *Custom
 
 func (cb *CustomerBuilder) Build() *Customer { return cb.customer }
 
-func (o *Customer) ID() valueobject.CustomerID      { return o.Entity.ID() }
-func (o *Customer) Photo() string                   { return o.photo }
-func (o *Customer) UserID() *valueobject.UserID     { return o.userID }
-func (o *Customer) IsActive() bool                  { return o.isActive }
-func (o *Customer) Pets() []pet.Pet                 { return o.pets }
-func (o *Customer) SetID(id valueobject.CustomerID) { o.Entity.SetID(id) }
-func (o *Customer) CreatedAt() time.Time            { return o.Entity.CreatedAt() }
-func (o *Customer) UpdatedAt() time.Time            { return o.Entity.UpdatedAt() }
+func (o *Customer) ID() valueobject.CustomerID                 { return o.Entity.ID() }
+func (o *Customer) Photo() string                              { return o.photo }
+func (o *Customer) UserID() *valueobject.UserID                { return o.userID }
+func (o *Customer) IsActive() bool                             { return o.isActive }
+func (o *Customer) Pets() []pet.Pet                            { return o.pets }
+func (o *Customer) PreferredChannel() enum.NotificationChannel { return o.preferredChannel }
+func (o *Customer) SetID(id valueobject.CustomerID)            { o.Entity.SetID(id) }
+func (o *Customer) CreatedAt() time.Time                       { return o.Entity.CreatedAt() }
+func (o *Customer) UpdatedAt() time.Time                       { return o.Entity.UpdatedAt() }
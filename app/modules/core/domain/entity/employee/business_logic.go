@@ -85,18 +85,21 @@ func (v *Employee) validate(ctx context.Context) error {
 		return err
 	}
 
-	if v.schedule != nil {
-		if err := v.schedule.ValidateBuissnessLogic(ctx); err != nil {
-			return err
-		}
-	}
-
 	if !v.specialty.IsValid() {
 		return domainerr.InvalidFieldValue(ctx, "specialty", string(v.specialty), "invalid specialty", "validate employee")
 	}
 	return nil
 }
 
+// ValidateSchedule checks the employee working days against the hours the clinic is open
+func (v *Employee) ValidateSchedule(ctx context.Context, serviceHours vo.ServiceHours) error {
+	if v.schedule == nil {
+		return nil
+	}
+
+	return v.schedule.ValidateBuissnessLogic(ctx, serviceHours)
+}
+
 func (v *Employee) AssignUser(ctx context.Context, userID vo.UserID) error {
 	if v.userID != nil {
 		return domainErr.ConflictError(ctx, "userID", fmt.Sprintf("employee %s is already assigned to a user", v.ID().String()), "assinging user to employee")
@@ -0,0 +1,217 @@
+package employee
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/base"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	domainerr "clinic-vet-api/app/modules/core/error"
+)
+
+const (
+	MaxOnCallRotationDays = 90
+	MinOnCallShiftHours   = 4
+	MaxOnCallShiftHours   = 24
+)
+
+// emergencySpecialties are the rotations answering emergencies, in order of preference
+var emergencySpecialties = []enum.VetSpecialty{
+	enum.VetSpecialtyEmergencyCriticalCare,
+	enum.VetSpecialtyGeneralPractice,
+}
+
+// OnCallShift is a period a veterinarian answers the after-hours emergencies of a specialty
+type OnCallShift struct {
+	base.Entity[vo.OnCallShiftID]
+	employeeID vo.EmployeeID
+	specialty  enum.VetSpecialty
+	startsAt   time.Time
+	endsAt     time.Time
+}
+
+type OnCallShiftBuilder struct{ shift *OnCallShift }
+
+func NewOnCallShiftBuilder() *OnCallShiftBuilder {
+	return &OnCallShiftBuilder{shift: &OnCallShift{}}
+}
+
+func (b *OnCallShiftBuilder) WithID(id vo.OnCallShiftID) *OnCallShiftBuilder {
+	b.shift.SetID(id)
+	return b
+}
+
+func (b *OnCallShiftBuilder) WithEmployeeID(employeeID vo.EmployeeID) *OnCallShiftBuilder {
+	b.shift.employeeID = employeeID
+	return b
+}
+
+func (b *OnCallShiftBuilder) WithSpecialty(specialty enum.VetSpecialty) *OnCallShiftBuilder {
+	b.shift.specialty = specialty
+	return b
+}
+
+func (b *OnCallShiftBuilder) WithPeriod(startsAt, endsAt time.Time) *OnCallShiftBuilder {
+	b.shift.startsAt = startsAt
+	b.shift.endsAt = endsAt
+	return b
+}
+
+func (b *OnCallShiftBuilder) WithTimestamps(createdAt, updatedAt time.Time) *OnCallShiftBuilder {
+	b.shift.SetTimeStamps(createdAt, updatedAt)
+	return b
+}
+
+func (b *OnCallShiftBuilder) Build() *OnCallShift {
+	return b.shift
+}
+
+func (s *OnCallShift) EmployeeID() vo.EmployeeID       { return s.employeeID }
+func (s *OnCallShift) Specialty() enum.VetSpecialty    { return s.specialty }
+func (s *OnCallShift) StartsAt() time.Time             { return s.startsAt }
+func (s *OnCallShift) EndsAt() time.Time               { return s.endsAt }
+func (s *OnCallShift) Covers(at time.Time) bool        { return !at.Before(s.startsAt) && at.Before(s.endsAt) }
+func (s *OnCallShift) IsOwnedBy(id vo.EmployeeID) bool { return s.employeeID == id }
+
+// OnCallRotation is the roster to generate for a specialty: the period is split in shifts of
+// ShiftHours, the last one ending with the period
+type OnCallRotation struct {
+	Specialty  enum.VetSpecialty
+	StartsAt   time.Time
+	EndsAt     time.Time
+	ShiftHours int
+}
+
+func (r OnCallRotation) Validate(ctx context.Context) error {
+	operation := "ValidateOnCallRotation"
+
+	if !r.Specialty.IsValid() || r.Specialty == enum.VetSpecialtyUnknown {
+		return domainerr.InvalidEnumValue(ctx, "specialty", string(r.Specialty), "invalid specialty", operation)
+	}
+
+	if !r.EndsAt.After(r.StartsAt) {
+		return invalidOnCallRotationError(ctx, "ends_at", "the rotation must end after it starts", operation)
+	}
+
+	if r.EndsAt.Sub(r.StartsAt) > MaxOnCallRotationDays*24*time.Hour {
+		return invalidOnCallRotationError(ctx, "ends_at", fmt.Sprintf("a rotation cannot be longer than %d days", MaxOnCallRotationDays), operation)
+	}
+
+	if r.ShiftHours < MinOnCallShiftHours || r.ShiftHours > MaxOnCallShiftHours {
+		return invalidOnCallRotationError(ctx, "shift_hours",
+			fmt.Sprintf("shifts last between %d and %d hours", MinOnCallShiftHours, MaxOnCallShiftHours), operation)
+	}
+
+	return nil
+}
+
+// GenerateOnCallRotation hands the shifts of the rotation round the active employees of its
+// specialty in ID order, starting after the employee who was on call last so the turns carry on
+// from the previous roster. An employee on approved time off during a shift loses that turn to
+// the next one, the rotation fails when nobody can cover a shift
+func GenerateOnCallRotation(
+	ctx context.Context,
+	rotation OnCallRotation,
+	employees []Employee,
+	exceptions []ScheduleException,
+	lastOnCall *vo.EmployeeID,
+) ([]OnCallShift, error) {
+	operation := "GenerateOnCallRotation"
+
+	if err := rotation.Validate(ctx); err != nil {
+		return nil, err
+	}
+
+	candidates := []Employee{}
+	for _, employee := range employees {
+		if employee.IsActive() && employee.Specialty() == rotation.Specialty {
+			candidates = append(candidates, employee)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, domainerr.BusinessRuleError(ctx,
+			fmt.Sprintf("no active employee has the %s specialty", rotation.Specialty.DisplayName()), "on_call_rotation", "specialty", operation)
+	}
+
+	slices.SortFunc(candidates, func(a, b Employee) int { return int(a.ID().Value()) - int(b.ID().Value()) })
+
+	calendars := make(map[vo.EmployeeID]WorkCalendar, len(candidates))
+	for _, candidate := range candidates {
+		calendars[candidate.ID()] = NewWorkCalendar(nil, exceptionsOf(exceptions, candidate.ID()))
+	}
+
+	turn := 0
+	if lastOnCall != nil {
+		if last := slices.IndexFunc(candidates, func(e Employee) bool { return e.ID() == *lastOnCall }); last >= 0 {
+			turn = last + 1
+		}
+	}
+
+	shiftLength := time.Duration(rotation.ShiftHours) * time.Hour
+	shifts := []OnCallShift{}
+	for startsAt := rotation.StartsAt; startsAt.Before(rotation.EndsAt); startsAt = startsAt.Add(shiftLength) {
+		endsAt := startsAt.Add(shiftLength)
+		if endsAt.After(rotation.EndsAt) {
+			endsAt = rotation.EndsAt
+		}
+
+		assigned := false
+		for attempt := 0; attempt < len(candidates); attempt++ {
+			candidate := candidates[(turn+attempt)%len(candidates)]
+			if calendars[candidate.ID()].IsOff(startsAt, endsAt) {
+				continue
+			}
+
+			shifts = append(shifts, *NewOnCallShiftBuilder().
+				WithEmployeeID(candidate.ID()).
+				WithSpecialty(rotation.Specialty).
+				WithPeriod(startsAt, endsAt).
+				Build())
+			turn = (turn + attempt + 1) % len(candidates)
+			assigned = true
+			break
+		}
+
+		if !assigned {
+			return nil, domainerr.BusinessRuleError(ctx,
+				fmt.Sprintf("every employee is off during the shift starting %s", startsAt.Format("2006-01-02 15:04")), "on_call_rotation", "starts_at", operation)
+		}
+	}
+
+	return shifts, nil
+}
+
+// PickEmergencyOnCall chooses who attends an emergency among the shifts on call at the time,
+// preferring the emergency and critical care rotation, then general practice, then any other
+func PickEmergencyOnCall(shifts []OnCallShift) (OnCallShift, bool) {
+	for _, specialty := range emergencySpecialties {
+		for _, shift := range shifts {
+			if shift.specialty == specialty {
+				return shift, true
+			}
+		}
+	}
+
+	if len(shifts) == 0 {
+		return OnCallShift{}, false
+	}
+	return shifts[0], true
+}
+
+func exceptionsOf(exceptions []ScheduleException, employeeID vo.EmployeeID) []ScheduleException {
+	owned := []ScheduleException{}
+	for _, exception := range exceptions {
+		if exception.IsOwnedBy(employeeID) {
+			owned = append(owned, exception)
+		}
+	}
+	return owned
+}
+
+func invalidOnCallRotationError(ctx context.Context, field, message, operation string) error {
+	return domainerr.ValidationError(ctx, "ON_CALL_ROTATION_INVALID", "on_call_rotation", field,
+		fmt.Sprintf("On-call rotation %s: %s", field, message), operation)
+}
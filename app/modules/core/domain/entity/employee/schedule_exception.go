@@ -0,0 +1,233 @@
+package employee
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/base"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	domainerr "clinic-vet-api/app/modules/core/error"
+)
+
+const (
+	MaxTimeOffDays           = 60
+	MaxExtraShiftHours       = 12
+	MaxExceptionReasonLength = 500
+)
+
+// ScheduleException is a dated change to the weekly schedule of an employee: time off for a
+// vacation, a sick leave or a conference, or an extra shift. Employees request it and a manager
+// approves or rejects it, only approved exceptions change when the employee works
+type ScheduleException struct {
+	base.Entity[vo.ScheduleExcID]
+	employeeID    vo.EmployeeID
+	exceptionType enum.ScheduleExceptionType
+	startDate     time.Time
+	endDate       time.Time
+	reason        string
+	status        enum.ScheduleExceptionStatus
+	reviewedBy    *vo.UserID
+	reviewNotes   *string
+	reviewedAt    *time.Time
+}
+
+type ScheduleExceptionBuilder struct{ exception *ScheduleException }
+
+func NewScheduleExceptionBuilder() *ScheduleExceptionBuilder {
+	return &ScheduleExceptionBuilder{exception: &ScheduleException{status: enum.ScheduleExceptionStatusPending}}
+}
+
+func (b *ScheduleExceptionBuilder) WithID(id vo.ScheduleExcID) *ScheduleExceptionBuilder {
+	b.exception.SetID(id)
+	return b
+}
+
+func (b *ScheduleExceptionBuilder) WithEmployeeID(employeeID vo.EmployeeID) *ScheduleExceptionBuilder {
+	b.exception.employeeID = employeeID
+	return b
+}
+
+func (b *ScheduleExceptionBuilder) WithType(exceptionType enum.ScheduleExceptionType) *ScheduleExceptionBuilder {
+	b.exception.exceptionType = exceptionType
+	return b
+}
+
+func (b *ScheduleExceptionBuilder) WithPeriod(startDate, endDate time.Time) *ScheduleExceptionBuilder {
+	b.exception.startDate = startDate
+	b.exception.endDate = endDate
+	return b
+}
+
+func (b *ScheduleExceptionBuilder) WithReason(reason string) *ScheduleExceptionBuilder {
+	b.exception.reason = strings.TrimSpace(reason)
+	return b
+}
+
+func (b *ScheduleExceptionBuilder) WithStatus(status enum.ScheduleExceptionStatus) *ScheduleExceptionBuilder {
+	b.exception.status = status
+	return b
+}
+
+func (b *ScheduleExceptionBuilder) WithReview(reviewedBy *vo.UserID, reviewNotes *string, reviewedAt *time.Time) *ScheduleExceptionBuilder {
+	b.exception.reviewedBy = reviewedBy
+	b.exception.reviewNotes = reviewNotes
+	b.exception.reviewedAt = reviewedAt
+	return b
+}
+
+func (b *ScheduleExceptionBuilder) WithTimestamps(createdAt, updatedAt time.Time) *ScheduleExceptionBuilder {
+	b.exception.SetTimeStamps(createdAt, updatedAt)
+	return b
+}
+
+func (b *ScheduleExceptionBuilder) Build() *ScheduleException {
+	return b.exception
+}
+
+func (e *ScheduleException) EmployeeID() vo.EmployeeID            { return e.employeeID }
+func (e *ScheduleException) Type() enum.ScheduleExceptionType     { return e.exceptionType }
+func (e *ScheduleException) StartDate() time.Time                 { return e.startDate }
+func (e *ScheduleException) EndDate() time.Time                   { return e.endDate }
+func (e *ScheduleException) Reason() string                       { return e.reason }
+func (e *ScheduleException) Status() enum.ScheduleExceptionStatus { return e.status }
+func (e *ScheduleException) ReviewedBy() *vo.UserID               { return e.reviewedBy }
+func (e *ScheduleException) ReviewNotes() *string                 { return e.reviewNotes }
+func (e *ScheduleException) ReviewedAt() *time.Time               { return e.reviewedAt }
+func (e *ScheduleException) IsApproved() bool {
+	return e.status == enum.ScheduleExceptionStatusApproved
+}
+func (e *ScheduleException) IsTimeOff() bool { return e.exceptionType.IsTimeOff() }
+func (e *ScheduleException) Overlaps(start, end time.Time) bool {
+	return e.startDate.Before(end) && e.endDate.After(start)
+}
+func (e *ScheduleException) IsOwnedBy(employeeID vo.EmployeeID) bool {
+	return e.employeeID == employeeID
+}
+func (e *ScheduleException) HasEnded(now time.Time) bool { return !e.endDate.After(now) }
+func (e *ScheduleException) CanBeReviewed() bool {
+	return e.status == enum.ScheduleExceptionStatusPending
+}
+
+// RequestScheduleException opens the request of an employee, pending until a manager reviews it.
+// Sick leaves can be reported once they started, every other exception is requested ahead of time
+func RequestScheduleException(
+	ctx context.Context,
+	employeeID vo.EmployeeID,
+	exceptionType enum.ScheduleExceptionType,
+	startDate, endDate time.Time,
+	reason string,
+	now time.Time,
+) (*ScheduleException, error) {
+	exception := NewScheduleExceptionBuilder().
+		WithEmployeeID(employeeID).
+		WithType(exceptionType).
+		WithPeriod(startDate, endDate).
+		WithReason(reason).
+		Build()
+
+	if err := exception.Validate(ctx); err != nil {
+		return nil, err
+	}
+
+	if exception.HasEnded(now) || (exceptionType != enum.ScheduleExceptionSickLeave && startDate.Before(now)) {
+		return nil, invalidScheduleExceptionError(ctx, "start_date", "only sick leaves can be requested once they started", "RequestScheduleException")
+	}
+
+	return exception, nil
+}
+
+func (e *ScheduleException) Validate(ctx context.Context) error {
+	operation := "ValidateScheduleException"
+
+	if e.employeeID.IsZero() {
+		return domainerr.MissingFieldError(ctx, "employee_id", "the employee is required", operation)
+	}
+
+	if !e.exceptionType.IsValid() {
+		return domainerr.InvalidEnumValue(ctx, "type", string(e.exceptionType), "invalid schedule exception type", operation)
+	}
+
+	if !e.endDate.After(e.startDate) {
+		return invalidScheduleExceptionError(ctx, "end_date", "the exception must end after it starts", operation)
+	}
+
+	if e.IsTimeOff() && e.endDate.Sub(e.startDate) > MaxTimeOffDays*24*time.Hour {
+		return invalidScheduleExceptionError(ctx, "end_date", fmt.Sprintf("time off cannot be longer than %d days", MaxTimeOffDays), operation)
+	}
+
+	if !e.IsTimeOff() {
+		if e.endDate.Sub(e.startDate) > MaxExtraShiftHours*time.Hour {
+			return invalidScheduleExceptionError(ctx, "end_date", fmt.Sprintf("an extra shift cannot be longer than %d hours", MaxExtraShiftHours), operation)
+		}
+		startYear, startMonth, startDay := e.startDate.Date()
+		endYear, endMonth, endDay := e.endDate.Date()
+		if startYear != endYear || startMonth != endMonth || startDay != endDay {
+			return invalidScheduleExceptionError(ctx, "end_date", "an extra shift must start and end on the same day", operation)
+		}
+	}
+
+	if e.reason == "" || len(e.reason) > MaxExceptionReasonLength {
+		return invalidScheduleExceptionError(ctx, "reason", fmt.Sprintf("the reason is required and cannot exceed %d characters", MaxExceptionReasonLength), operation)
+	}
+
+	return nil
+}
+
+// Approve accepts a pending request, from then on it changes when the employee works
+func (e *ScheduleException) Approve(ctx context.Context, reviewerID vo.UserID, notes *string, at time.Time) error {
+	return e.review(ctx, enum.ScheduleExceptionStatusApproved, reviewerID, notes, at, "ApproveScheduleException")
+}
+
+// Reject turns down a pending request, the weekly schedule applies as usual
+func (e *ScheduleException) Reject(ctx context.Context, reviewerID vo.UserID, notes *string, at time.Time) error {
+	return e.review(ctx, enum.ScheduleExceptionStatusRejected, reviewerID, notes, at, "RejectScheduleException")
+}
+
+// Cancel withdraws a request that was not rejected, as long as the period is not over
+func (e *ScheduleException) Cancel(ctx context.Context, now time.Time) error {
+	operation := "CancelScheduleException"
+
+	if e.status != enum.ScheduleExceptionStatusPending && e.status != enum.ScheduleExceptionStatusApproved {
+		return domainerr.BusinessRuleError(ctx, "only pending or approved exceptions can be cancelled", "schedule_exception", "status", operation)
+	}
+
+	if e.HasEnded(now) {
+		return domainerr.BusinessRuleError(ctx, "exceptions already over cannot be cancelled", "schedule_exception", "end_date", operation)
+	}
+
+	e.status = enum.ScheduleExceptionStatusCancelled
+	e.IncrementVersion()
+	return nil
+}
+
+func (e *ScheduleException) review(
+	ctx context.Context,
+	status enum.ScheduleExceptionStatus,
+	reviewerID vo.UserID,
+	notes *string,
+	at time.Time,
+	operation string,
+) error {
+	if !e.CanBeReviewed() {
+		return domainerr.BusinessRuleError(ctx, fmt.Sprintf("the exception was already %s", e.status.DisplayName()), "schedule_exception", "status", operation)
+	}
+
+	if reviewerID.IsZero() {
+		return domainerr.MissingFieldError(ctx, "reviewed_by", "the reviewer is required", operation)
+	}
+
+	e.status = status
+	e.reviewedBy = &reviewerID
+	e.reviewNotes = notes
+	e.reviewedAt = &at
+	e.IncrementVersion()
+	return nil
+}
+
+func invalidScheduleExceptionError(ctx context.Context, field, message, operation string) error {
+	return domainerr.ValidationError(ctx, "SCHEDULE_EXCEPTION_INVALID", "schedule_exception", field,
+		fmt.Sprintf("Schedule exception %s: %s", field, message), operation)
+}
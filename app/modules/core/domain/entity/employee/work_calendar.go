@@ -0,0 +1,121 @@
+package employee
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	domainerr "clinic-vet-api/app/modules/core/error"
+)
+
+// Shift is a period an employee works on a given day. Shifts of the weekly schedule carry the
+// break of the day, extra shifts have none
+type Shift struct {
+	Start   time.Time
+	End     time.Time
+	workDay *vo.WorkDaySchedule
+}
+
+// IsWithinBreak reports whether the interval [start, end) overlaps the break of the shift
+func (s Shift) IsWithinBreak(start, end time.Time) bool {
+	return s.workDay != nil && s.workDay.IsWithinBreak(start, end)
+}
+
+// Contains reports whether the interval [start, end) falls inside the shift
+func (s Shift) Contains(start, end time.Time) bool {
+	return !start.Before(s.Start) && !end.After(s.End)
+}
+
+// WorkCalendar tells when an employee works by applying the approved schedule exceptions on top
+// of their weekly schedule: time off removes working time and extra shifts add it
+type WorkCalendar struct {
+	schedule    *vo.Schedule
+	timeOff     []ScheduleException
+	extraShifts []ScheduleException
+}
+
+// NewWorkCalendar builds the calendar of the employee, exceptions not approved are ignored
+func NewWorkCalendar(schedule *vo.Schedule, exceptions []ScheduleException) WorkCalendar {
+	calendar := WorkCalendar{schedule: schedule}
+	for _, exception := range exceptions {
+		if !exception.IsApproved() {
+			continue
+		}
+
+		if exception.IsTimeOff() {
+			calendar.timeOff = append(calendar.timeOff, exception)
+		} else {
+			calendar.extraShifts = append(calendar.extraShifts, exception)
+		}
+	}
+	return calendar
+}
+
+// HasWorkingTime reports whether the employee works at all, either weekly or on extra shifts
+func (c WorkCalendar) HasWorkingTime() bool {
+	return (c.schedule != nil && len(c.schedule.WorkDays) > 0) || len(c.extraShifts) > 0
+}
+
+// ShiftsOn returns the shifts of the calendar day of date: the weekly one for its weekday and
+// the extra shifts starting that day. Time off is not subtracted, see IsOff
+func (c WorkCalendar) ShiftsOn(date time.Time) []Shift {
+	shifts := []Shift{}
+
+	if workDay, works := c.schedule.WorkDayFor(date.Weekday()); works {
+		start, end := workDay.ShiftOn(date)
+		shifts = append(shifts, Shift{Start: start, End: end, workDay: &workDay})
+	}
+
+	year, month, day := date.Date()
+	for _, extraShift := range c.extraShifts {
+		shiftYear, shiftMonth, shiftDay := extraShift.startDate.In(date.Location()).Date()
+		if shiftYear == year && shiftMonth == month && shiftDay == day {
+			shifts = append(shifts, Shift{Start: extraShift.startDate, End: extraShift.endDate})
+		}
+	}
+
+	return shifts
+}
+
+// IsOff reports whether the interval [start, end) overlaps approved time off
+func (c WorkCalendar) IsOff(start, end time.Time) bool {
+	_, isOff := c.timeOffDuring(start, end)
+	return isOff
+}
+
+// IsWorking reports whether the interval [start, end) falls inside a shift, outside its break
+// and away from any time off
+func (c WorkCalendar) IsWorking(start, end time.Time) bool {
+	if c.IsOff(start, end) {
+		return false
+	}
+
+	for _, shift := range c.ShiftsOn(start) {
+		if shift.Contains(start, end) && !shift.IsWithinBreak(start, end) {
+			return true
+		}
+	}
+	return false
+}
+
+// EnsureNotOff fails when the interval [start, end) overlaps approved time off of the employee
+func (c WorkCalendar) EnsureNotOff(ctx context.Context, start, end time.Time) error {
+	timeOff, isOff := c.timeOffDuring(start, end)
+	if !isOff {
+		return nil
+	}
+
+	rule := fmt.Sprintf("the employee is on %s from %s to %s",
+		timeOff.exceptionType.DisplayName(), timeOff.startDate.Format("2006-01-02 15:04"), timeOff.endDate.Format("2006-01-02 15:04"))
+	return domainerr.BusinessRuleError(ctx, rule, "employee", "schedule", "EnsureEmployeeNotOff")
+}
+
+func (c WorkCalendar) timeOffDuring(start, end time.Time) (ScheduleException, bool) {
+	for _, timeOff := range c.timeOff {
+		if timeOff.Overlaps(start, end) {
+			return timeOff, true
+		}
+	}
+	return ScheduleException{}, false
+}
@@ -0,0 +1,55 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	domainerr "clinic-vet-api/app/modules/core/error"
+)
+
+type InventoryErrorCode string
+
+const (
+	ProductInvalid       InventoryErrorCode = "PRODUCT_INVALID"
+	StockLocationInvalid InventoryErrorCode = "STOCK_LOCATION_INVALID"
+	StockLotInvalid      InventoryErrorCode = "STOCK_LOT_INVALID"
+	StockMovementInvalid InventoryErrorCode = "STOCK_MOVEMENT_INVALID"
+)
+
+func inventoryValidationError(ctx context.Context, code InventoryErrorCode, entity, field, message, operation string) error {
+	return domainerr.ValidationError(ctx, string(code), entity, field,
+		fmt.Sprintf("%s %s: %s", entityDisplayNames[entity], field, message), operation)
+}
+
+var entityDisplayNames = map[string]string{
+	"product":        "Product",
+	"stock_location": "Stock location",
+	"stock_lot":      "Stock lot",
+	"stock_movement": "Stock movement",
+}
+
+func InvalidProductError(ctx context.Context, field, message, operation string) error {
+	return inventoryValidationError(ctx, ProductInvalid, "product", field, message, operation)
+}
+
+func InvalidStockLocationError(ctx context.Context, field, message, operation string) error {
+	return inventoryValidationError(ctx, StockLocationInvalid, "stock_location", field, message, operation)
+}
+
+func InvalidStockLotError(ctx context.Context, field, message, operation string) error {
+	return inventoryValidationError(ctx, StockLotInvalid, "stock_lot", field, message, operation)
+}
+
+func InvalidStockMovementError(ctx context.Context, field, message, operation string) error {
+	return inventoryValidationError(ctx, StockMovementInvalid, "stock_movement", field, message, operation)
+}
+
+func InsufficientStockError(ctx context.Context, lot StockLot, requested int, operation string) error {
+	rule := fmt.Sprintf("lot %s holds %d units, %d were requested", lot.LotNumber(), lot.QuantityOnHand(), requested)
+	return domainerr.BusinessRuleError(ctx, rule, "stock_lot", "quantity_on_hand", operation)
+}
+
+func LotExpiredError(ctx context.Context, lot StockLot, operation string) error {
+	rule := fmt.Sprintf("lot %s expired on %s and cannot be dispensed", lot.LotNumber(), lot.ExpiresOn().Format("2006-01-02"))
+	return domainerr.BusinessRuleError(ctx, rule, "stock_lot", "expires_on", operation)
+}
@@ -0,0 +1,170 @@
+// Package inventory defines the pharmacy and medical supply stock of the clinic: the products,
+// where they are stored, the lots received and every movement of their stock
+package inventory
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/base"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	domainerr "clinic-vet-api/app/modules/core/error"
+)
+
+// Product is a drug, vaccine, dewormer or supply kept in stock, counted in its unit. When the
+// usable stock falls to the reorder level the product is reported as low on stock
+type Product struct {
+	base.Entity[vo.ProductID]
+	name         string
+	category     enum.ProductCategory
+	unit         string
+	reorderLevel int
+	description  *string
+	isActive     bool
+}
+
+type ProductBuilder struct{ product *Product }
+
+func NewProductBuilder() *ProductBuilder {
+	return &ProductBuilder{product: &Product{isActive: true}}
+}
+
+func (b *ProductBuilder) WithID(id vo.ProductID) *ProductBuilder {
+	b.product.SetID(id)
+	return b
+}
+
+func (b *ProductBuilder) WithName(name string) *ProductBuilder {
+	b.product.name = strings.TrimSpace(name)
+	return b
+}
+
+func (b *ProductBuilder) WithCategory(category enum.ProductCategory) *ProductBuilder {
+	b.product.category = category
+	return b
+}
+
+func (b *ProductBuilder) WithUnit(unit string) *ProductBuilder {
+	b.product.unit = strings.TrimSpace(unit)
+	return b
+}
+
+func (b *ProductBuilder) WithReorderLevel(reorderLevel int) *ProductBuilder {
+	b.product.reorderLevel = reorderLevel
+	return b
+}
+
+func (b *ProductBuilder) WithDescription(description *string) *ProductBuilder {
+	b.product.description = description
+	return b
+}
+
+func (b *ProductBuilder) WithIsActive(isActive bool) *ProductBuilder {
+	b.product.isActive = isActive
+	return b
+}
+
+func (b *ProductBuilder) WithTimestamps(createdAt, updatedAt time.Time) *ProductBuilder {
+	b.product.SetTimeStamps(createdAt, updatedAt)
+	return b
+}
+
+func (b *ProductBuilder) Build() *Product {
+	return b.product
+}
+
+func (p *Product) Name() string                   { return p.name }
+func (p *Product) Category() enum.ProductCategory { return p.category }
+func (p *Product) Unit() string                   { return p.unit }
+func (p *Product) ReorderLevel() int              { return p.reorderLevel }
+func (p *Product) Description() *string           { return p.description }
+func (p *Product) IsActive() bool                 { return p.isActive }
+
+func (p *Product) Validate(ctx context.Context) error {
+	operation := "ValidateProduct"
+
+	if p.name == "" || len(p.name) > 150 {
+		return InvalidProductError(ctx, "name", "name is required and cannot exceed 150 characters", operation)
+	}
+
+	if !p.category.IsValid() {
+		return domainerr.InvalidEnumValue(ctx, "category", p.category.String(), "invalid product category", operation)
+	}
+
+	if p.unit == "" || len(p.unit) > 30 {
+		return InvalidProductError(ctx, "unit", "unit is required and cannot exceed 30 characters", operation)
+	}
+
+	if p.reorderLevel < 0 {
+		return InvalidProductError(ctx, "reorder_level", "reorder level cannot be negative", operation)
+	}
+
+	return nil
+}
+
+// Update replaces the details of the product, nil values keep the current ones
+func (p *Product) Update(ctx context.Context, name *string, category *enum.ProductCategory, unit *string,
+	reorderLevel *int, description *string, isActive *bool,
+) error {
+	if name != nil {
+		p.name = strings.TrimSpace(*name)
+	}
+	if category != nil {
+		p.category = *category
+	}
+	if unit != nil {
+		p.unit = strings.TrimSpace(*unit)
+	}
+	if reorderLevel != nil {
+		p.reorderLevel = *reorderLevel
+	}
+	if description != nil {
+		p.description = description
+	}
+	if isActive != nil {
+		p.isActive = *isActive
+	}
+
+	if err := p.Validate(ctx); err != nil {
+		return err
+	}
+
+	p.IncrementVersion()
+	return nil
+}
+
+// CheckDispensableAs tells whether the product can be used for a clinical record that takes
+// products of the categories, a vaccination is only recorded with a vaccine
+func (p *Product) CheckDispensableAs(ctx context.Context, categories ...enum.ProductCategory) error {
+	operation := "DispenseProduct"
+
+	if !p.isActive {
+		return domainerr.BusinessRuleError(ctx, "the product "+p.name+" is no longer stocked", "product", "is_active", operation)
+	}
+
+	if len(categories) > 0 && !slices.Contains(categories, p.category) {
+		names := make([]string, len(categories))
+		for i, category := range categories {
+			names[i] = strings.ToLower(category.DisplayName())
+		}
+		rule := "the product " + p.name + " is a " + strings.ToLower(p.category.DisplayName()) +
+			", expected a " + strings.Join(names, " or a ")
+		return domainerr.BusinessRuleError(ctx, rule, "product", "category", operation)
+	}
+
+	return nil
+}
+
+// StockLevel is the stock of a product that can still be dispensed, expired lots left out
+type StockLevel struct {
+	Product Product
+	OnHand  int
+}
+
+// IsLow tells whether the product has to be reordered
+func (s StockLevel) IsLow() bool {
+	return s.OnHand <= s.Product.reorderLevel
+}
@@ -0,0 +1,88 @@
+package inventory
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/base"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// StockLocation is where lots are stored, the pharmacy shelves, the vaccine fridge or a
+// consulting room cabinet. Inactive locations keep their lots but receive no new ones
+type StockLocation struct {
+	base.Entity[vo.StockLocationID]
+	name        string
+	description *string
+	isActive    bool
+}
+
+type StockLocationBuilder struct{ location *StockLocation }
+
+func NewStockLocationBuilder() *StockLocationBuilder {
+	return &StockLocationBuilder{location: &StockLocation{isActive: true}}
+}
+
+func (b *StockLocationBuilder) WithID(id vo.StockLocationID) *StockLocationBuilder {
+	b.location.SetID(id)
+	return b
+}
+
+func (b *StockLocationBuilder) WithName(name string) *StockLocationBuilder {
+	b.location.name = strings.TrimSpace(name)
+	return b
+}
+
+func (b *StockLocationBuilder) WithDescription(description *string) *StockLocationBuilder {
+	b.location.description = description
+	return b
+}
+
+func (b *StockLocationBuilder) WithIsActive(isActive bool) *StockLocationBuilder {
+	b.location.isActive = isActive
+	return b
+}
+
+func (b *StockLocationBuilder) WithTimestamps(createdAt, updatedAt time.Time) *StockLocationBuilder {
+	b.location.SetTimeStamps(createdAt, updatedAt)
+	return b
+}
+
+func (b *StockLocationBuilder) Build() *StockLocation {
+	return b.location
+}
+
+func (l *StockLocation) Name() string         { return l.name }
+func (l *StockLocation) Description() *string { return l.description }
+func (l *StockLocation) IsActive() bool       { return l.isActive }
+
+func (l *StockLocation) Validate(ctx context.Context) error {
+	operation := "ValidateStockLocation"
+
+	if l.name == "" || len(l.name) > 100 {
+		return InvalidStockLocationError(ctx, "name", "name is required and cannot exceed 100 characters", operation)
+	}
+
+	return nil
+}
+
+// Update replaces the details of the location, nil values keep the current ones
+func (l *StockLocation) Update(ctx context.Context, name *string, description *string, isActive *bool) error {
+	if name != nil {
+		l.name = strings.TrimSpace(*name)
+	}
+	if description != nil {
+		l.description = description
+	}
+	if isActive != nil {
+		l.isActive = *isActive
+	}
+
+	if err := l.Validate(ctx); err != nil {
+		return err
+	}
+
+	l.IncrementVersion()
+	return nil
+}
@@ -0,0 +1,298 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/base"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	domainerr "clinic-vet-api/app/modules/core/error"
+)
+
+// StockLot is a batch of a product received from the supplier and kept at a location. Units are
+// dispensed until the end of the expiry day, expired units can only be wasted
+type StockLot struct {
+	base.Entity[vo.StockLotID]
+	productID      vo.ProductID
+	locationID     vo.StockLocationID
+	lotNumber      string
+	expiresOn      time.Time
+	quantityOnHand int
+	receivedAt     time.Time
+}
+
+type StockLotBuilder struct{ lot *StockLot }
+
+func NewStockLotBuilder() *StockLotBuilder {
+	return &StockLotBuilder{lot: &StockLot{}}
+}
+
+func (b *StockLotBuilder) WithID(id vo.StockLotID) *StockLotBuilder {
+	b.lot.SetID(id)
+	return b
+}
+
+func (b *StockLotBuilder) WithProductID(productID vo.ProductID) *StockLotBuilder {
+	b.lot.productID = productID
+	return b
+}
+
+func (b *StockLotBuilder) WithLocationID(locationID vo.StockLocationID) *StockLotBuilder {
+	b.lot.locationID = locationID
+	return b
+}
+
+func (b *StockLotBuilder) WithLotNumber(lotNumber string) *StockLotBuilder {
+	b.lot.lotNumber = strings.TrimSpace(lotNumber)
+	return b
+}
+
+func (b *StockLotBuilder) WithExpiresOn(expiresOn time.Time) *StockLotBuilder {
+	b.lot.expiresOn = truncateToDay(expiresOn)
+	return b
+}
+
+func (b *StockLotBuilder) WithQuantityOnHand(quantityOnHand int) *StockLotBuilder {
+	b.lot.quantityOnHand = quantityOnHand
+	return b
+}
+
+func (b *StockLotBuilder) WithReceivedAt(receivedAt time.Time) *StockLotBuilder {
+	b.lot.receivedAt = receivedAt
+	return b
+}
+
+func (b *StockLotBuilder) WithTimestamps(createdAt, updatedAt time.Time) *StockLotBuilder {
+	b.lot.SetTimeStamps(createdAt, updatedAt)
+	return b
+}
+
+func (b *StockLotBuilder) Build() *StockLot {
+	return b.lot
+}
+
+func (l *StockLot) ProductID() vo.ProductID        { return l.productID }
+func (l *StockLot) LocationID() vo.StockLocationID { return l.locationID }
+func (l *StockLot) LotNumber() string              { return l.lotNumber }
+func (l *StockLot) ExpiresOn() time.Time           { return l.expiresOn }
+func (l *StockLot) QuantityOnHand() int            { return l.quantityOnHand }
+func (l *StockLot) ReceivedAt() time.Time          { return l.receivedAt }
+
+// IsExpiredAt tells whether the expiry day of the lot is over at the time
+func (l *StockLot) IsExpiredAt(at time.Time) bool {
+	return !at.Before(l.expiresOn.AddDate(0, 0, 1))
+}
+
+// ExpiresWithin tells whether the lot expires in the next days counted from the time
+func (l *StockLot) ExpiresWithin(at time.Time, days int) bool {
+	return l.expiresOn.Before(truncateToDay(at).AddDate(0, 0, days+1))
+}
+
+// ReceiveLot takes a new lot of the product into stock at the location
+func ReceiveLot(
+	ctx context.Context,
+	product Product,
+	location StockLocation,
+	lotNumber string,
+	expiresOn time.Time,
+	quantity int,
+	receivedBy vo.EmployeeID,
+	now time.Time,
+) (*StockLot, *StockMovement, error) {
+	if err := CheckReceivable(ctx, product, location); err != nil {
+		return nil, nil, err
+	}
+
+	lot := NewStockLotBuilder().
+		WithProductID(product.ID()).
+		WithLocationID(location.ID()).
+		WithLotNumber(lotNumber).
+		WithExpiresOn(expiresOn).
+		WithReceivedAt(now).
+		Build()
+
+	if err := lot.Validate(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	movement, err := lot.Receive(ctx, expiresOn, quantity, receivedBy, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	return lot, movement, nil
+}
+
+// CheckReceivable tells whether deliveries of the product can be taken in at the location
+func CheckReceivable(ctx context.Context, product Product, location StockLocation) error {
+	operation := "ReceiveStock"
+
+	if !product.IsActive() {
+		return domainerr.BusinessRuleError(ctx, "the product "+product.Name()+" is no longer stocked", "product", "is_active", operation)
+	}
+
+	if !location.IsActive() {
+		return domainerr.BusinessRuleError(ctx, "the location "+location.Name()+" no longer receives stock", "stock_location", "is_active", operation)
+	}
+
+	return nil
+}
+
+func (l *StockLot) Validate(ctx context.Context) error {
+	operation := "ValidateStockLot"
+
+	if l.productID.IsZero() {
+		return domainerr.MissingFieldError(ctx, "product_id", "the product is required", operation)
+	}
+
+	if l.locationID.IsZero() {
+		return domainerr.MissingFieldError(ctx, "location_id", "the stock location is required", operation)
+	}
+
+	if l.lotNumber == "" || len(l.lotNumber) > 50 {
+		return InvalidStockLotError(ctx, "lot_number", "lot number is required and cannot exceed 50 characters", operation)
+	}
+
+	if l.expiresOn.IsZero() {
+		return domainerr.MissingFieldError(ctx, "expires_on", "the expiry date is required", operation)
+	}
+
+	if l.quantityOnHand < 0 {
+		return InvalidStockLotError(ctx, "quantity_on_hand", "stock cannot be negative", operation)
+	}
+
+	return nil
+}
+
+// Receive adds units delivered with the lot number to the stock, the delivery has to carry the
+// expiry date of the lot and cannot be expired already
+func (l *StockLot) Receive(ctx context.Context, expiresOn time.Time, quantity int, receivedBy vo.EmployeeID, now time.Time) (*StockMovement, error) {
+	operation := "ReceiveStock"
+
+	if quantity <= 0 {
+		return nil, InvalidStockMovementError(ctx, "quantity", "at least one unit has to be received", operation)
+	}
+
+	if !truncateToDay(expiresOn).Equal(l.expiresOn) {
+		rule := fmt.Sprintf("lot %s expires on %s, the delivery carries %s", l.lotNumber,
+			l.expiresOn.Format("2006-01-02"), expiresOn.Format("2006-01-02"))
+		return nil, domainerr.BusinessRuleError(ctx, rule, "stock_lot", "expires_on", operation)
+	}
+
+	if l.IsExpiredAt(now) {
+		return nil, domainerr.BusinessRuleError(ctx, "expired stock cannot be received", "stock_lot", "expires_on", operation)
+	}
+
+	return l.apply(enum.StockMovementReceive, quantity, nil, nil, receivedBy, now), nil
+}
+
+// Dispense takes units out of the lot for the clinical record, the lot must not be expired and
+// has to hold the units
+func (l *StockLot) Dispense(ctx context.Context, quantity int, reference StockReference, dispensedBy vo.EmployeeID, now time.Time) (*StockMovement, error) {
+	if err := l.CheckDispensable(ctx, quantity, now); err != nil {
+		return nil, err
+	}
+
+	return l.apply(enum.StockMovementDispense, -quantity, nil, &reference, dispensedBy, now), nil
+}
+
+// DispenseFor takes the units of the dispensing out of the lot for the clinical record
+func (l *StockLot) DispenseFor(ctx context.Context, dispensing Dispensing, reference StockReference) (*StockMovement, error) {
+	return l.Dispense(ctx, dispensing.Quantity, reference, dispensing.DispensedBy, dispensing.At)
+}
+
+// CheckDispensable tells whether the units can be dispensed from the lot at the time
+func (l *StockLot) CheckDispensable(ctx context.Context, quantity int, now time.Time) error {
+	operation := "DispenseStock"
+
+	if quantity <= 0 {
+		return InvalidStockMovementError(ctx, "quantity", "at least one unit has to be dispensed", operation)
+	}
+
+	if l.IsExpiredAt(now) {
+		return LotExpiredError(ctx, *l, operation)
+	}
+
+	if quantity > l.quantityOnHand {
+		return InsufficientStockError(ctx, *l, quantity, operation)
+	}
+
+	return nil
+}
+
+// Adjust corrects the stock after a count, the difference is added to it
+func (l *StockLot) Adjust(ctx context.Context, difference int, reason string, adjustedBy vo.EmployeeID, now time.Time) (*StockMovement, error) {
+	operation := "AdjustStock"
+
+	if difference == 0 {
+		return nil, InvalidStockMovementError(ctx, "quantity", "the adjustment cannot be zero", operation)
+	}
+
+	validReason, err := validateReason(ctx, reason, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	if l.quantityOnHand+difference < 0 {
+		return nil, InsufficientStockError(ctx, *l, -difference, operation)
+	}
+
+	return l.apply(enum.StockMovementAdjust, difference, &validReason, nil, adjustedBy, now), nil
+}
+
+// Waste writes off units that cannot be used, broken, contaminated or expired
+func (l *StockLot) Waste(ctx context.Context, quantity int, reason string, wastedBy vo.EmployeeID, now time.Time) (*StockMovement, error) {
+	operation := "WasteStock"
+
+	if quantity <= 0 {
+		return nil, InvalidStockMovementError(ctx, "quantity", "at least one unit has to be wasted", operation)
+	}
+
+	validReason, err := validateReason(ctx, reason, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	if quantity > l.quantityOnHand {
+		return nil, InsufficientStockError(ctx, *l, quantity, operation)
+	}
+
+	return l.apply(enum.StockMovementWaste, -quantity, &validReason, nil, wastedBy, now), nil
+}
+
+func (l *StockLot) apply(
+	movementType enum.StockMovementType,
+	quantity int,
+	reason *string,
+	reference *StockReference,
+	performedBy vo.EmployeeID,
+	now time.Time,
+) *StockMovement {
+	l.quantityOnHand += quantity
+	l.IncrementVersion()
+
+	return NewStockMovementBuilder().
+		WithLotID(l.ID()).
+		WithProductID(l.productID).
+		WithMovementType(movementType).
+		WithQuantity(quantity, l.quantityOnHand).
+		WithReason(reason).
+		WithReference(reference).
+		WithPerformedBy(performedBy).
+		WithOccurredAt(now).
+		Build()
+}
+
+func validateReason(ctx context.Context, reason, operation string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" || len(reason) > MaxStockReasonLength {
+		return "", InvalidStockMovementError(ctx, "reason", fmt.Sprintf("the reason is required and cannot exceed %d characters", MaxStockReasonLength), operation)
+	}
+	return reason, nil
+}
+
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
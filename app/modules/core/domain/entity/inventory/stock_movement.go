@@ -0,0 +1,113 @@
+package inventory
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/base"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+const MaxStockReasonLength = 500
+
+// StockReference is the clinical record the units dispensed were used for
+type StockReference struct {
+	Type enum.StockReferenceType
+	ID   uint
+}
+
+// Dispensing is the stock a clinical record takes out of a lot. It is saved along with the record
+// so the record is only kept when its stock is taken out
+type Dispensing struct {
+	LotID       vo.StockLotID
+	Quantity    int
+	DispensedBy vo.EmployeeID
+	At          time.Time
+}
+
+// StockMovement records a change of the stock of a lot. The quantity is signed, units received
+// are positive and units dispensed or wasted negative, the balance is the stock of the lot once
+// the movement was applied
+type StockMovement struct {
+	base.Entity[vo.StockMovementID]
+	lotID        vo.StockLotID
+	productID    vo.ProductID
+	movementType enum.StockMovementType
+	quantity     int
+	balanceAfter int
+	reason       *string
+	reference    *StockReference
+	performedBy  vo.EmployeeID
+	occurredAt   time.Time
+}
+
+type StockMovementBuilder struct{ movement *StockMovement }
+
+func NewStockMovementBuilder() *StockMovementBuilder {
+	return &StockMovementBuilder{movement: &StockMovement{}}
+}
+
+func (b *StockMovementBuilder) WithID(id vo.StockMovementID) *StockMovementBuilder {
+	b.movement.SetID(id)
+	return b
+}
+
+func (b *StockMovementBuilder) WithLotID(lotID vo.StockLotID) *StockMovementBuilder {
+	b.movement.lotID = lotID
+	return b
+}
+
+func (b *StockMovementBuilder) WithProductID(productID vo.ProductID) *StockMovementBuilder {
+	b.movement.productID = productID
+	return b
+}
+
+func (b *StockMovementBuilder) WithMovementType(movementType enum.StockMovementType) *StockMovementBuilder {
+	b.movement.movementType = movementType
+	return b
+}
+
+func (b *StockMovementBuilder) WithQuantity(quantity, balanceAfter int) *StockMovementBuilder {
+	b.movement.quantity = quantity
+	b.movement.balanceAfter = balanceAfter
+	return b
+}
+
+func (b *StockMovementBuilder) WithReason(reason *string) *StockMovementBuilder {
+	b.movement.reason = reason
+	return b
+}
+
+func (b *StockMovementBuilder) WithReference(reference *StockReference) *StockMovementBuilder {
+	b.movement.reference = reference
+	return b
+}
+
+func (b *StockMovementBuilder) WithPerformedBy(performedBy vo.EmployeeID) *StockMovementBuilder {
+	b.movement.performedBy = performedBy
+	return b
+}
+
+func (b *StockMovementBuilder) WithOccurredAt(occurredAt time.Time) *StockMovementBuilder {
+	b.movement.occurredAt = occurredAt
+	return b
+}
+
+func (b *StockMovementBuilder) WithTimestamps(createdAt, updatedAt time.Time) *StockMovementBuilder {
+	b.movement.SetTimeStamps(createdAt, updatedAt)
+	return b
+}
+
+func (b *StockMovementBuilder) Build() *StockMovement {
+	return b.movement
+}
+
+func (m *StockMovement) LotID() vo.StockLotID                 { return m.lotID }
+func (m *StockMovement) ProductID() vo.ProductID              { return m.productID }
+func (m *StockMovement) MovementType() enum.StockMovementType { return m.movementType }
+func (m *StockMovement) Quantity() int                        { return m.quantity }
+func (m *StockMovement) BalanceAfter() int                    { return m.balanceAfter }
+func (m *StockMovement) Reason() *string                      { return m.reason }
+func (m *StockMovement) Reference() *StockReference           { return m.reference }
+func (m *StockMovement) PerformedBy() vo.EmployeeID           { return m.performedBy }
+func (m *StockMovement) OccurredAt() time.Time                { return m.occurredAt }
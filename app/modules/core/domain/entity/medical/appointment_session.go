@@ -0,0 +1,126 @@
+package medical
+
+import (
+	"context"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	domainerr "clinic-vet-api/app/modules/core/error"
+)
+
+var serviceVisitTypes = map[enum.ClinicService]enum.VisitType{
+	enum.ClinicServiceSurgery:      enum.VisitTypeSurgery,
+	enum.ClinicServiceVaccination:  enum.VisitTypeVaccination,
+	enum.ClinicServiceDentalCare:   enum.VisitTypeDental,
+	enum.ClinicServiceGrooming:     enum.VisitTypeGrooming,
+	enum.ClinicServiceWellnessExam: enum.VisitTypePhysicalExam,
+}
+
+// OpenAppointmentSession opens the draft medical session of the visit booked by the appointment,
+// to be filled in by the veterinarian and closed once the visit is over. Sessions of emergencies
+// are flagged and recorded as emergency visits. When the appointment is not persisted yet the
+// link is set on creation
+func OpenAppointmentSession(ctx context.Context, appt appointment.Appointment) (*MedicalSession, error) {
+	operation := "OpenAppointmentSession"
+
+	if appt.EmployeeID() == nil {
+		return nil, domainerr.MissingFieldError(ctx, "employeeID", "the appointment has no veterinarian assigned", operation)
+	}
+
+	session := NewMedicalSessionBuilder().
+		WithCustomerID(appt.CustomerID()).
+		WithEmployeeID(*appt.EmployeeID()).
+		WithService(appt.Service()).
+		WithVisitType(visitTypeOf(appt)).
+		WithVisitDate(appt.ScheduledDate()).
+		WithIsEmergency(appt.IsEmergency()).
+		WithStatus(enum.MedSessionStatusDraft).
+		WithPetDetails(*NewPetSessionSummaryBuilder().WithPetID(appt.PetID()).Build()).
+		Build()
+
+	if !appt.ID().IsZero() {
+		session.AttachToAppointment(appt.ID())
+	}
+	return session, nil
+}
+
+// RecordOutcome fills in the findings of the visit on the draft, empty values keep what was
+// recorded before
+func (mh *MedicalSession) RecordOutcome(ctx context.Context, diagnosis, treatment string, condition enum.PetCondition, notes *string) error {
+	operation := "RecordMedicalSessionOutcome"
+
+	if !mh.IsDraft() {
+		return domainerr.BusinessRuleError(ctx, "closed sessions cannot be modified", "medical session", "status", operation)
+	}
+
+	if condition != "" && !condition.IsValid() {
+		return domainerr.InvalidEnumValue(ctx, "condition", "medical session", string(condition), operation)
+	}
+
+	if diagnosis != "" {
+		mh.petDetails.diagnosis = diagnosis
+	}
+	if treatment != "" {
+		mh.petDetails.treatment = treatment
+	}
+	if condition != "" {
+		mh.petDetails.condition = condition
+	}
+	if notes != nil {
+		mh.notes = notes
+	}
+	return nil
+}
+
+// Close finalizes the draft once the diagnosis is recorded
+func (mh *MedicalSession) Close(ctx context.Context, at time.Time) error {
+	operation := "CloseMedicalSession"
+
+	if !mh.IsDraft() {
+		return domainerr.BusinessRuleError(ctx, "only draft sessions can be closed", "medical session", "status", operation)
+	}
+
+	if mh.petDetails.diagnosis == "" {
+		return domainerr.MissingFieldError(ctx, "diagnosis", "the diagnosis is required to close the session", operation)
+	}
+
+	mh.status = enum.MedSessionStatusClosed
+	mh.closedAt = &at
+	mh.IncrementVersion()
+	return nil
+}
+
+// CloseVisit closes the session and completes the appointment it was opened from, unless the
+// appointment was completed already
+func CloseVisit(ctx context.Context, session *MedicalSession, appt *appointment.Appointment, at time.Time) error {
+	if appt != nil && (session.appointmentID == nil || *session.appointmentID != appt.ID()) {
+		return domainerr.BusinessRuleError(ctx, "the session does not belong to the appointment", "medical session", "appointmentID", "CloseVisit")
+	}
+
+	if err := session.Close(ctx, at); err != nil {
+		return err
+	}
+
+	if appt == nil || appt.Status() == enum.AppointmentStatusCompleted {
+		return nil
+	}
+	return appt.Complete(ctx)
+}
+
+// AttachToAppointment links the session to the appointment of the visit
+func (mh *MedicalSession) AttachToAppointment(appointmentID vo.AppointmentID) {
+	mh.appointmentID = &appointmentID
+}
+
+func visitTypeOf(appt appointment.Appointment) enum.VisitType {
+	if appt.IsEmergency() || appt.Service() == enum.ClinicServiceEmergencyCare {
+		return enum.VisitTypeEmergencyVisit
+	}
+
+	if visitType, exists := serviceVisitTypes[appt.Service()]; exists {
+		return visitType
+	}
+	return enum.VisitTypeConsultation
+}
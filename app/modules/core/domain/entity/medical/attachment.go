@@ -0,0 +1,253 @@
+package medical
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"path"
+	"strings"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/base"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	domainerr "clinic-vet-api/app/modules/core/error"
+)
+
+const (
+	MaxAttachmentFileNameLength    = 255
+	MaxAttachmentDescriptionLength = 500
+)
+
+// attachmentExtensions are the content types accepted for the medical record with the extension
+// their files are stored with
+var attachmentExtensions = map[string]string{
+	"image/jpeg":        ".jpg",
+	"image/png":         ".png",
+	"image/gif":         ".gif",
+	"image/webp":        ".webp",
+	"image/tiff":        ".tiff",
+	"image/bmp":         ".bmp",
+	"application/dicom": ".dcm",
+	"application/pdf":   ".pdf",
+	"text/plain":        ".txt",
+}
+
+// IsAllowedAttachmentType tells whether files of the content type can be attached, parameters
+// such as the charset are left out of the check
+func IsAllowedAttachmentType(contentType string) bool {
+	_, exists := attachmentExtensions[attachmentMediaType(contentType)]
+	return exists
+}
+
+// MedicalAttachment is a file of the medical record of a pet, such as an X-ray, a photo of a
+// wound or a referral letter, optionally tied to the session it was taken in. The content lives
+// in the blob storage under the storage key, the checksum proves it was not altered
+type MedicalAttachment struct {
+	base.Entity[vo.AttachmentID]
+	petID       vo.PetID
+	sessionID   *vo.MedSessionID
+	uploadedBy  vo.EmployeeID
+	category    enum.AttachmentCategory
+	fileName    string
+	contentType string
+	sizeBytes   int64
+	checksum    string
+	storageKey  string
+	description *string
+}
+
+type MedicalAttachmentBuilder struct{ attachment *MedicalAttachment }
+
+func NewMedicalAttachmentBuilder() *MedicalAttachmentBuilder {
+	return &MedicalAttachmentBuilder{attachment: &MedicalAttachment{}}
+}
+
+func (b *MedicalAttachmentBuilder) WithID(id vo.AttachmentID) *MedicalAttachmentBuilder {
+	b.attachment.SetID(id)
+	return b
+}
+
+func (b *MedicalAttachmentBuilder) WithPetID(petID vo.PetID) *MedicalAttachmentBuilder {
+	b.attachment.petID = petID
+	return b
+}
+
+func (b *MedicalAttachmentBuilder) WithSessionID(sessionID *vo.MedSessionID) *MedicalAttachmentBuilder {
+	b.attachment.sessionID = sessionID
+	return b
+}
+
+func (b *MedicalAttachmentBuilder) WithUploadedBy(employeeID vo.EmployeeID) *MedicalAttachmentBuilder {
+	b.attachment.uploadedBy = employeeID
+	return b
+}
+
+func (b *MedicalAttachmentBuilder) WithCategory(category enum.AttachmentCategory) *MedicalAttachmentBuilder {
+	b.attachment.category = category
+	return b
+}
+
+func (b *MedicalAttachmentBuilder) WithFile(fileName, contentType string, sizeBytes int64, checksum string) *MedicalAttachmentBuilder {
+	b.attachment.fileName = fileName
+	b.attachment.contentType = contentType
+	b.attachment.sizeBytes = sizeBytes
+	b.attachment.checksum = checksum
+	return b
+}
+
+func (b *MedicalAttachmentBuilder) WithStorageKey(storageKey string) *MedicalAttachmentBuilder {
+	b.attachment.storageKey = storageKey
+	return b
+}
+
+func (b *MedicalAttachmentBuilder) WithDescription(description *string) *MedicalAttachmentBuilder {
+	b.attachment.description = description
+	return b
+}
+
+func (b *MedicalAttachmentBuilder) WithTimestamps(createdAt, updatedAt time.Time) *MedicalAttachmentBuilder {
+	b.attachment.SetTimeStamps(createdAt, updatedAt)
+	return b
+}
+
+func (b *MedicalAttachmentBuilder) Build() *MedicalAttachment {
+	return b.attachment
+}
+
+func (a *MedicalAttachment) PetID() vo.PetID                   { return a.petID }
+func (a *MedicalAttachment) SessionID() *vo.MedSessionID       { return a.sessionID }
+func (a *MedicalAttachment) UploadedBy() vo.EmployeeID         { return a.uploadedBy }
+func (a *MedicalAttachment) Category() enum.AttachmentCategory { return a.category }
+func (a *MedicalAttachment) FileName() string                  { return a.fileName }
+func (a *MedicalAttachment) ContentType() string               { return a.contentType }
+func (a *MedicalAttachment) SizeBytes() int64                  { return a.sizeBytes }
+func (a *MedicalAttachment) Checksum() string                  { return a.checksum }
+func (a *MedicalAttachment) StorageKey() string                { return a.storageKey }
+func (a *MedicalAttachment) Description() *string              { return a.description }
+
+// AttachFile describes a file uploaded to the record of the pet. The session, when given, has to
+// be one of the pet. The file is stored under a key made of the pet and a random name so names
+// given by users never reach the storage
+func AttachFile(
+	ctx context.Context,
+	petID vo.PetID,
+	session *MedicalSession,
+	uploadedBy vo.EmployeeID,
+	category enum.AttachmentCategory,
+	fileName string,
+	contentType string,
+	sizeBytes int64,
+	checksum string,
+	objectName string,
+	description *string,
+) (*MedicalAttachment, error) {
+	operation := "AttachMedicalFile"
+
+	var sessionID *vo.MedSessionID
+	if session != nil {
+		if session.PetDetails().PetID() != petID {
+			return nil, invalidAttachmentError(ctx, "medical_session_id", "the medical session belongs to another pet", operation)
+		}
+		id := session.ID()
+		sessionID = &id
+	}
+
+	if description != nil {
+		trimmed := strings.TrimSpace(*description)
+		description = &trimmed
+		if trimmed == "" {
+			description = nil
+		}
+	}
+
+	contentType = attachmentMediaType(contentType)
+	attachment := NewMedicalAttachmentBuilder().
+		WithPetID(petID).
+		WithSessionID(sessionID).
+		WithUploadedBy(uploadedBy).
+		WithCategory(category).
+		WithFile(cleanFileName(fileName), contentType, sizeBytes, strings.ToLower(checksum)).
+		WithStorageKey(fmt.Sprintf("pets/%d/%s%s", petID.Value(), objectName, attachmentExtensions[contentType])).
+		WithDescription(description).
+		Build()
+
+	if err := attachment.Validate(ctx); err != nil {
+		return nil, err
+	}
+	return attachment, nil
+}
+
+func (a *MedicalAttachment) Validate(ctx context.Context) error {
+	operation := "ValidateMedicalAttachment"
+
+	if a.petID.IsZero() {
+		return domainerr.MissingFieldError(ctx, "pet_id", "the pet is required", operation)
+	}
+
+	if a.uploadedBy.IsZero() {
+		return domainerr.MissingFieldError(ctx, "uploaded_by", "the employee uploading the file is required", operation)
+	}
+
+	if !a.category.IsValid() {
+		return domainerr.InvalidEnumValue(ctx, "category", string(a.category), "invalid attachment category", operation)
+	}
+
+	if a.fileName == "" || len(a.fileName) > MaxAttachmentFileNameLength {
+		return invalidAttachmentError(ctx, "file_name", fmt.Sprintf("the file name is required and cannot exceed %d characters", MaxAttachmentFileNameLength), operation)
+	}
+
+	if !IsAllowedAttachmentType(a.contentType) {
+		return invalidAttachmentError(ctx, "content_type", fmt.Sprintf("files of type %s cannot be attached, only images, DICOM, PDF and plain text", a.contentType), operation)
+	}
+
+	if a.sizeBytes <= 0 {
+		return invalidAttachmentError(ctx, "size_bytes", "the file is empty", operation)
+	}
+
+	if len(a.checksum) != 64 || strings.Trim(a.checksum, "0123456789abcdef") != "" {
+		return invalidAttachmentError(ctx, "checksum", "the checksum must be a SHA-256 in hexadecimal", operation)
+	}
+
+	if a.storageKey == "" {
+		return domainerr.MissingFieldError(ctx, "storage_key", "the storage key is required", operation)
+	}
+
+	if a.description != nil && len(*a.description) > MaxAttachmentDescriptionLength {
+		return invalidAttachmentError(ctx, "description", fmt.Sprintf("the description cannot exceed %d characters", MaxAttachmentDescriptionLength), operation)
+	}
+
+	return nil
+}
+
+func attachmentMediaType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(contentType))
+	}
+	return mediaType
+}
+
+// cleanFileName keeps the base name of the file as sent by the browser, some send the full path
+func cleanFileName(fileName string) string {
+	fileName = strings.TrimSpace(strings.ReplaceAll(fileName, "\\", "/"))
+	if fileName == "" {
+		return ""
+	}
+
+	base := path.Base(fileName)
+	if base == "." || base == "/" {
+		return ""
+	}
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, base)
+}
+
+func invalidAttachmentError(ctx context.Context, field, message, operation string) error {
+	return domainerr.ValidationError(ctx, "MEDICAL_ATTACHMENT_INVALID", "medical attachment", field,
+		fmt.Sprintf("Medical attachment %s: %s", field, message), operation)
+}
@@ -0,0 +1,82 @@
+package medical
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	domainerr "clinic-vet-api/app/modules/core/error"
+)
+
+// AttachmentLinkSigner issues and checks the tokens of download links. A token is
+// "<attachment id>.<expiry unix time>.<signature>", where the signature is an HMAC of the
+// attachment, its storage key and the expiry, so a link can't be extended or pointed to another
+// file and stops working once the attachment is deleted
+type AttachmentLinkSigner struct {
+	secret []byte
+}
+
+func NewAttachmentLinkSigner(secret string) AttachmentLinkSigner {
+	return AttachmentLinkSigner{secret: []byte(secret)}
+}
+
+func (s AttachmentLinkSigner) Token(attachment MedicalAttachment, expiresAt time.Time) string {
+	return fmt.Sprintf("%d.%d.%s", attachment.ID().Value(), expiresAt.Unix(), s.signature(attachment, expiresAt.Unix()))
+}
+
+// ParseToken extracts the attachment id, the signature is checked with Verify once the
+// attachment is loaded
+func (s AttachmentLinkSigner) ParseToken(ctx context.Context, token string) (vo.AttachmentID, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return vo.AttachmentID{}, invalidAttachmentLinkError(ctx, "ParseAttachmentLink")
+	}
+
+	id, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil || id == 0 {
+		return vo.AttachmentID{}, invalidAttachmentLinkError(ctx, "ParseAttachmentLink")
+	}
+
+	return vo.NewAttachmentID(uint(id)), nil
+}
+
+func (s AttachmentLinkSigner) Verify(ctx context.Context, attachment MedicalAttachment, token string, now time.Time) error {
+	operation := "VerifyAttachmentLink"
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return invalidAttachmentLinkError(ctx, operation)
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return invalidAttachmentLinkError(ctx, operation)
+	}
+
+	if !hmac.Equal([]byte(token), []byte(s.Token(attachment, time.Unix(expiresAt, 0)))) {
+		return invalidAttachmentLinkError(ctx, operation)
+	}
+
+	if now.Unix() >= expiresAt {
+		return domainerr.BusinessRuleError(ctx, "the download link has expired", "medical_attachment", "expires_at", operation)
+	}
+
+	return nil
+}
+
+func (s AttachmentLinkSigner) signature(attachment MedicalAttachment, expiresAt int64) string {
+	mac := hmac.New(sha256.New, s.secret)
+	fmt.Fprintf(mac, "medical-attachment:%d:%s:%d", attachment.ID().Value(), attachment.StorageKey(), expiresAt)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func invalidAttachmentLinkError(ctx context.Context, operation string) error {
+	return domainerr.ValidationError(ctx, "MEDICAL_ATTACHMENT_INVALID_LINK", "medical attachment", "token",
+		"Medical attachment token: the download link is not valid", operation)
+}
@@ -0,0 +1,88 @@
+package medical
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/calendar"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	domainerr "clinic-vet-api/app/modules/core/error"
+)
+
+var visitTypeServices = map[enum.VisitType]enum.ClinicService{
+	enum.VisitTypeSurgery:      enum.ClinicServiceSurgery,
+	enum.VisitTypeVaccination:  enum.ClinicServiceVaccination,
+	enum.VisitTypeDental:       enum.ClinicServiceDentalCare,
+	enum.VisitTypeGrooming:     enum.ClinicServiceGrooming,
+	enum.VisitTypePhysicalExam: enum.ClinicServiceWellnessExam,
+}
+
+// ScheduleFollowUp sets or clears the date the patient should come back on
+func (mh *MedicalSession) ScheduleFollowUp(ctx context.Context, followUpDate *time.Time) error {
+	if followUpDate != nil && !followUpDate.After(mh.visitDate) {
+		return domainerr.BusinessRuleError(ctx, "the follow-up date must be after the visit", "medical session", "followUpDate", "ScheduleFollowUp")
+	}
+
+	mh.petDetails.followUpDate = followUpDate
+	mh.IncrementVersion()
+	return nil
+}
+
+// ProposeFollowUp books the pending follow-up appointment on the follow-up date of the session,
+// for the same pet and veterinarian. The session may not be saved yet, the proposal is linked to
+// it when both are persisted. It is confirmed like any other pending appointment. The
+// follow-up date is set by the veterinarian, so it is only checked against the opening hours
+// and not against the booking window of customers
+func ProposeFollowUp(ctx context.Context, session MedicalSession, clinicCalendar calendar.ClinicCalendar) (*appointment.Appointment, error) {
+	operation := "ProposeFollowUp"
+
+	followUpDate := session.petDetails.followUpDate
+	if followUpDate == nil {
+		return nil, domainerr.MissingFieldError(ctx, "followUpDate", "the session has no follow-up date", operation)
+	}
+
+	employeeID := session.employeeID
+	notes := fmt.Sprintf("Follow-up of the visit on %s", session.visitDate.Format(time.DateOnly))
+	proposal := appointment.NewAppointmentBuilder().
+		WithCustomerID(session.customerID).
+		WithPetID(session.petDetails.petID).
+		WithEmployeeID(&employeeID).
+		WithService(followUpServiceOf(session.visitType)).
+		WithScheduledDate(*followUpDate).
+		WithNotes(&notes).
+		WithStatus(enum.AppointmentStatusPending).
+		Build()
+
+	if err := proposal.ValidateOpeningHours(ctx, clinicCalendar); err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
+// MoveFollowUp keeps the proposed appointment on the follow-up date of the session. A proposal
+// still pending is moved as is, a confirmed one is rescheduled and cancelled when the date is
+// cleared. Reports whether the proposal changed
+func MoveFollowUp(ctx context.Context, session MedicalSession, proposal *appointment.Appointment, clinicCalendar calendar.ClinicCalendar) (bool, error) {
+	followUpDate := session.petDetails.followUpDate
+	if followUpDate == nil {
+		if proposal.Status() == enum.AppointmentStatusCancelled {
+			return false, nil
+		}
+		return true, proposal.Cancel(ctx)
+	}
+
+	if followUpDate.Equal(proposal.ScheduledDate()) {
+		return false, nil
+	}
+
+	return true, proposal.RescheduleProposal(ctx, *followUpDate, clinicCalendar)
+}
+
+func followUpServiceOf(visitType enum.VisitType) enum.ClinicService {
+	if service, exists := visitTypeServices[visitType]; exists {
+		return service
+	}
+	return enum.ClinicServiceGeneralConsultation
+}
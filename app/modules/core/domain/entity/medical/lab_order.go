@@ -0,0 +1,413 @@
+package medical
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/base"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	domainerr "clinic-vet-api/app/modules/core/error"
+)
+
+const (
+	MaxLabPanelsPerOrder = 10
+	MaxLabTextLength     = 500
+)
+
+// LabOrderPanel is a panel requested to the laboratory
+type LabOrderPanel struct {
+	Code string
+	Name string
+}
+
+// LabResultEntry is the result of an analyte as reported by the laboratory. Results are either
+// numeric, flagged against the reference range when there is one, or qualitative with the flag
+// given by the laboratory
+type LabResultEntry struct {
+	PanelCode      string
+	AnalyteCode    string
+	AnalyteName    string
+	NumericValue   *float64
+	TextValue      *string
+	Unit           string
+	ReferenceRange vo.LabReferenceRange
+	Flag           *enum.LabResultFlag
+	ObservedAt     time.Time
+}
+
+// LabResult is a recorded result of an analyte of a lab order
+type LabResult struct {
+	id             vo.LabResultID
+	orderID        vo.LabOrderID
+	petID          vo.PetID
+	panelCode      string
+	analyteCode    string
+	analyteName    string
+	numericValue   *float64
+	textValue      *string
+	unit           string
+	referenceRange vo.LabReferenceRange
+	flag           *enum.LabResultFlag
+	observedAt     time.Time
+}
+
+// RestoreLabResult rebuilds a stored result, the flag is kept as recorded
+func RestoreLabResult(id vo.LabResultID, orderID vo.LabOrderID, petID vo.PetID, entry LabResultEntry) LabResult {
+	return LabResult{
+		id:             id,
+		orderID:        orderID,
+		petID:          petID,
+		panelCode:      entry.PanelCode,
+		analyteCode:    entry.AnalyteCode,
+		analyteName:    entry.AnalyteName,
+		numericValue:   entry.NumericValue,
+		textValue:      entry.TextValue,
+		unit:           entry.Unit,
+		referenceRange: entry.ReferenceRange,
+		flag:           entry.Flag,
+		observedAt:     entry.ObservedAt,
+	}
+}
+
+func (r LabResult) ID() vo.LabResultID                   { return r.id }
+func (r LabResult) OrderID() vo.LabOrderID               { return r.orderID }
+func (r LabResult) PetID() vo.PetID                      { return r.petID }
+func (r LabResult) PanelCode() string                    { return r.panelCode }
+func (r LabResult) AnalyteCode() string                  { return r.analyteCode }
+func (r LabResult) AnalyteName() string                  { return r.analyteName }
+func (r LabResult) NumericValue() *float64               { return r.numericValue }
+func (r LabResult) TextValue() *string                   { return r.textValue }
+func (r LabResult) Unit() string                         { return r.unit }
+func (r LabResult) ReferenceRange() vo.LabReferenceRange { return r.referenceRange }
+func (r LabResult) Flag() *enum.LabResultFlag            { return r.flag }
+func (r LabResult) ObservedAt() time.Time                { return r.observedAt }
+func (r LabResult) IsAbnormal() bool                     { return r.flag != nil && r.flag.IsAbnormal() }
+
+// LabOrder is a set of panels sent to a laboratory for the pet seen in a medical session. Results
+// arrive in one or several batches, the order is completed with the last one
+type LabOrder struct {
+	base.Entity[vo.LabOrderID]
+	sessionID       vo.MedSessionID
+	petID           vo.PetID
+	orderedBy       vo.EmployeeID
+	labName         string
+	accessionNumber *string
+	panels          []LabOrderPanel
+	status          enum.LabOrderStatus
+	notes           *string
+	orderedAt       time.Time
+	resultedAt      *time.Time
+	cancelledAt     *time.Time
+	cancelReason    *string
+	results         []LabResult
+}
+
+type LabOrderBuilder struct{ order *LabOrder }
+
+func NewLabOrderBuilder() *LabOrderBuilder {
+	return &LabOrderBuilder{order: &LabOrder{
+		status:  enum.LabOrderStatusOrdered,
+		panels:  []LabOrderPanel{},
+		results: []LabResult{},
+	}}
+}
+
+func (b *LabOrderBuilder) WithID(id vo.LabOrderID) *LabOrderBuilder {
+	b.order.SetID(id)
+	return b
+}
+
+func (b *LabOrderBuilder) WithSessionID(sessionID vo.MedSessionID) *LabOrderBuilder {
+	b.order.sessionID = sessionID
+	return b
+}
+
+func (b *LabOrderBuilder) WithPetID(petID vo.PetID) *LabOrderBuilder {
+	b.order.petID = petID
+	return b
+}
+
+func (b *LabOrderBuilder) WithOrderedBy(employeeID vo.EmployeeID) *LabOrderBuilder {
+	b.order.orderedBy = employeeID
+	return b
+}
+
+func (b *LabOrderBuilder) WithLab(labName string, accessionNumber *string) *LabOrderBuilder {
+	b.order.labName = strings.TrimSpace(labName)
+	if accessionNumber != nil {
+		trimmed := strings.TrimSpace(*accessionNumber)
+		if trimmed != "" {
+			b.order.accessionNumber = &trimmed
+		}
+	}
+	return b
+}
+
+func (b *LabOrderBuilder) WithPanels(panels []LabOrderPanel) *LabOrderBuilder {
+	b.order.panels = panels
+	return b
+}
+
+func (b *LabOrderBuilder) WithStatus(status enum.LabOrderStatus) *LabOrderBuilder {
+	b.order.status = status
+	return b
+}
+
+func (b *LabOrderBuilder) WithNotes(notes *string) *LabOrderBuilder {
+	b.order.notes = notes
+	return b
+}
+
+func (b *LabOrderBuilder) WithDates(orderedAt time.Time, resultedAt *time.Time) *LabOrderBuilder {
+	b.order.orderedAt = orderedAt
+	b.order.resultedAt = resultedAt
+	return b
+}
+
+func (b *LabOrderBuilder) WithCancellation(cancelledAt *time.Time, cancelReason *string) *LabOrderBuilder {
+	b.order.cancelledAt = cancelledAt
+	b.order.cancelReason = cancelReason
+	return b
+}
+
+func (b *LabOrderBuilder) WithResults(results []LabResult) *LabOrderBuilder {
+	b.order.results = results
+	return b
+}
+
+func (b *LabOrderBuilder) WithTimestamps(createdAt, updatedAt time.Time) *LabOrderBuilder {
+	b.order.SetTimeStamps(createdAt, updatedAt)
+	return b
+}
+
+func (b *LabOrderBuilder) Build() *LabOrder {
+	return b.order
+}
+
+func (o *LabOrder) SessionID() vo.MedSessionID  { return o.sessionID }
+func (o *LabOrder) PetID() vo.PetID             { return o.petID }
+func (o *LabOrder) OrderedBy() vo.EmployeeID    { return o.orderedBy }
+func (o *LabOrder) LabName() string             { return o.labName }
+func (o *LabOrder) AccessionNumber() *string    { return o.accessionNumber }
+func (o *LabOrder) Panels() []LabOrderPanel     { return o.panels }
+func (o *LabOrder) Status() enum.LabOrderStatus { return o.status }
+func (o *LabOrder) Notes() *string              { return o.notes }
+func (o *LabOrder) OrderedAt() time.Time        { return o.orderedAt }
+func (o *LabOrder) ResultedAt() *time.Time      { return o.resultedAt }
+func (o *LabOrder) CancelledAt() *time.Time     { return o.cancelledAt }
+func (o *LabOrder) CancelReason() *string       { return o.cancelReason }
+func (o *LabOrder) Results() []LabResult        { return o.results }
+
+// HasAbnormalResults tells whether any result of the order is out of its reference range
+func (o *LabOrder) HasAbnormalResults() bool {
+	for _, result := range o.results {
+		if result.IsAbnormal() {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderLabWork sends the panels to the laboratory for the pet seen in the session
+func OrderLabWork(
+	ctx context.Context,
+	session MedicalSession,
+	orderedBy vo.EmployeeID,
+	labName string,
+	accessionNumber *string,
+	panels []LabOrderPanel,
+	notes *string,
+	now time.Time,
+) (*LabOrder, error) {
+	order := NewLabOrderBuilder().
+		WithSessionID(session.ID()).
+		WithPetID(session.PetDetails().PetID()).
+		WithOrderedBy(orderedBy).
+		WithLab(labName, accessionNumber).
+		WithPanels(panels).
+		WithNotes(notes).
+		WithDates(now, nil).
+		Build()
+
+	if err := order.Validate(ctx); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+func (o *LabOrder) Validate(ctx context.Context) error {
+	operation := "ValidateLabOrder"
+
+	if o.sessionID.IsZero() {
+		return domainerr.MissingFieldError(ctx, "medical_session_id", "the medical session is required", operation)
+	}
+
+	if o.petID.IsZero() {
+		return domainerr.MissingFieldError(ctx, "pet_id", "the pet is required", operation)
+	}
+
+	if o.orderedBy.IsZero() {
+		return domainerr.MissingFieldError(ctx, "ordered_by", "the ordering veterinarian is required", operation)
+	}
+
+	if o.labName == "" || len(o.labName) > 100 {
+		return invalidLabOrderError(ctx, "lab_name", "the laboratory is required and cannot exceed 100 characters", operation)
+	}
+
+	if o.accessionNumber != nil && len(*o.accessionNumber) > 50 {
+		return invalidLabOrderError(ctx, "accession_number", "the accession number cannot exceed 50 characters", operation)
+	}
+
+	if len(o.panels) == 0 || len(o.panels) > MaxLabPanelsPerOrder {
+		return invalidLabOrderError(ctx, "panels", fmt.Sprintf("between 1 and %d panels can be ordered", MaxLabPanelsPerOrder), operation)
+	}
+
+	seen := make(map[string]bool, len(o.panels))
+	for _, panel := range o.panels {
+		code := strings.ToUpper(panel.Code)
+		if code == "" || len(code) > 30 || panel.Name == "" || len(panel.Name) > 100 {
+			return invalidLabOrderError(ctx, "panels", "each panel needs a code of up to 30 characters and a name of up to 100", operation)
+		}
+		if seen[code] {
+			return invalidLabOrderError(ctx, "panels", fmt.Sprintf("the panel %s is ordered twice", panel.Code), operation)
+		}
+		seen[code] = true
+	}
+
+	if o.notes != nil && len(*o.notes) > MaxLabTextLength {
+		return invalidLabOrderError(ctx, "notes", fmt.Sprintf("the notes cannot exceed %d characters", MaxLabTextLength), operation)
+	}
+
+	return nil
+}
+
+// RecordResults adds the results reported by the laboratory, a result replaces the previous one
+// of the same analyte as labs send corrections that way. The order is completed with the final
+// batch, until then it has partial results
+func (o *LabOrder) RecordResults(ctx context.Context, entries []LabResultEntry, final bool, now time.Time) error {
+	operation := "RecordLabResults"
+
+	if !o.status.AcceptsResults() {
+		return domainerr.BusinessRuleError(ctx, fmt.Sprintf("the lab order is %s", o.status.DisplayName()), "lab order", "status", operation)
+	}
+
+	if len(entries) == 0 && (!final || len(o.results) == 0) {
+		return invalidLabOrderError(ctx, "results", "at least one result is required", operation)
+	}
+
+	for _, entry := range entries {
+		result, err := o.newResult(ctx, entry, now)
+		if err != nil {
+			return err
+		}
+		o.putResult(result)
+	}
+
+	if final {
+		o.status = enum.LabOrderStatusCompleted
+		o.resultedAt = &now
+	} else {
+		o.status = enum.LabOrderStatusPartial
+	}
+	o.IncrementVersion()
+	return nil
+}
+
+func (o *LabOrder) newResult(ctx context.Context, entry LabResultEntry, now time.Time) (LabResult, error) {
+	operation := "RecordLabResults"
+
+	entry.AnalyteCode = strings.ToUpper(strings.TrimSpace(entry.AnalyteCode))
+	entry.AnalyteName = strings.TrimSpace(entry.AnalyteName)
+	entry.PanelCode = strings.ToUpper(strings.TrimSpace(entry.PanelCode))
+	entry.Unit = strings.TrimSpace(entry.Unit)
+
+	if entry.AnalyteCode == "" || len(entry.AnalyteCode) > 30 {
+		return LabResult{}, invalidLabOrderError(ctx, "analyte_code", "the analyte code is required and cannot exceed 30 characters", operation)
+	}
+
+	if entry.AnalyteName == "" || len(entry.AnalyteName) > 100 {
+		return LabResult{}, invalidLabOrderError(ctx, "analyte_name", fmt.Sprintf("the name of %s is required and cannot exceed 100 characters", entry.AnalyteCode), operation)
+	}
+
+	if len(entry.PanelCode) > 30 || len(entry.Unit) > 20 {
+		return LabResult{}, invalidLabOrderError(ctx, "unit", fmt.Sprintf("the panel code of %s cannot exceed 30 characters and its unit 20", entry.AnalyteCode), operation)
+	}
+
+	if entry.NumericValue == nil && (entry.TextValue == nil || strings.TrimSpace(*entry.TextValue) == "") {
+		return LabResult{}, invalidLabOrderError(ctx, "value", fmt.Sprintf("%s needs a numeric or a text value", entry.AnalyteCode), operation)
+	}
+
+	if entry.TextValue != nil && len(*entry.TextValue) > 100 {
+		return LabResult{}, invalidLabOrderError(ctx, "value", fmt.Sprintf("the value of %s cannot exceed 100 characters", entry.AnalyteCode), operation)
+	}
+
+	if entry.Flag != nil && !entry.Flag.IsValid() {
+		return LabResult{}, domainerr.InvalidEnumValue(ctx, "flag", string(*entry.Flag), "invalid lab result flag", operation)
+	}
+
+	low, high := entry.ReferenceRange.Low(), entry.ReferenceRange.High()
+	if low != nil && high != nil && *low > *high {
+		return LabResult{}, invalidLabOrderError(ctx, "reference_range", fmt.Sprintf("the reference range of %s starts above its end", entry.AnalyteCode), operation)
+	}
+
+	if entry.ObservedAt.IsZero() {
+		entry.ObservedAt = now
+	}
+	if entry.ObservedAt.After(now) {
+		return LabResult{}, invalidLabOrderError(ctx, "observed_at", fmt.Sprintf("%s cannot be observed in the future", entry.AnalyteCode), operation)
+	}
+
+	flag := entry.Flag
+	if entry.NumericValue != nil {
+		if computed := entry.ReferenceRange.Classify(*entry.NumericValue); computed != nil {
+			flag = computed
+		}
+	}
+
+	entry.Flag = flag
+	return RestoreLabResult(vo.LabResultID{}, o.ID(), o.petID, entry), nil
+}
+
+func (o *LabOrder) putResult(result LabResult) {
+	for i, existing := range o.results {
+		if existing.analyteCode == result.analyteCode {
+			result.id = existing.id
+			o.results[i] = result
+			return
+		}
+	}
+	o.results = append(o.results, result)
+}
+
+// Cancel withdraws the order before the laboratory reports anything
+func (o *LabOrder) Cancel(ctx context.Context, reason string, now time.Time) error {
+	operation := "CancelLabOrder"
+
+	if o.status != enum.LabOrderStatusOrdered {
+		return domainerr.BusinessRuleError(ctx, "only lab orders without results can be cancelled", "lab order", "status", operation)
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" || len(reason) > MaxLabTextLength {
+		return invalidLabOrderError(ctx, "reason", fmt.Sprintf("the reason is required and cannot exceed %d characters", MaxLabTextLength), operation)
+	}
+
+	o.status = enum.LabOrderStatusCancelled
+	o.cancelledAt = &now
+	o.cancelReason = &reason
+	o.IncrementVersion()
+	return nil
+}
+
+// LabOrderClosedError reports a change to an order completed or cancelled meanwhile
+func LabOrderClosedError(ctx context.Context) error {
+	return domainerr.BusinessRuleError(ctx, "the lab order was completed or cancelled meanwhile", "lab order", "status", "UpdateLabOrder")
+}
+
+func invalidLabOrderError(ctx context.Context, field, message, operation string) error {
+	return domainerr.ValidationError(ctx, "LAB_ORDER_INVALID", "lab order", field,
+		fmt.Sprintf("Lab order %s: %s", field, message), operation)
+}
@@ -0,0 +1,99 @@
+package medical
+
+import (
+	"sort"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// LabTrendPoint is a result of the analyte along the trend
+type LabTrendPoint struct {
+	ResultID       vo.LabResultID
+	OrderID        vo.LabOrderID
+	ObservedAt     time.Time
+	NumericValue   *float64
+	TextValue      *string
+	Unit           string
+	ReferenceRange vo.LabReferenceRange
+	Flag           *enum.LabResultFlag
+
+	// ChangePercent is the change since the previous numeric result, absent for the first one
+	ChangePercent *float64
+}
+
+// LabAnalyteTrend is the series of results of an analyte of a pet, oldest first, with the
+// statistics of its numeric results
+type LabAnalyteTrend struct {
+	PetID         vo.PetID
+	AnalyteCode   string
+	AnalyteName   string
+	Points        []LabTrendPoint
+	Min           *float64
+	Max           *float64
+	Average       *float64
+	ChangePercent *float64
+	AbnormalCount int
+}
+
+// NewLabAnalyteTrend lays out the results of the analyte in observation order. The change is
+// given between the first and the last numeric results
+func NewLabAnalyteTrend(petID vo.PetID, analyteCode string, results []LabResult) LabAnalyteTrend {
+	ordered := make([]LabResult, len(results))
+	copy(ordered, results)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].observedAt.Before(ordered[j].observedAt)
+	})
+
+	trend := LabAnalyteTrend{PetID: petID, AnalyteCode: analyteCode, Points: make([]LabTrendPoint, 0, len(ordered))}
+
+	var values []float64
+	for _, result := range ordered {
+		point := LabTrendPoint{
+			ResultID:       result.id,
+			OrderID:        result.orderID,
+			ObservedAt:     result.observedAt,
+			NumericValue:   result.numericValue,
+			TextValue:      result.textValue,
+			Unit:           result.unit,
+			ReferenceRange: result.referenceRange,
+			Flag:           result.flag,
+		}
+
+		if result.numericValue != nil {
+			value := *result.numericValue
+			if len(values) > 0 && values[len(values)-1] != 0 {
+				change := round(percentChange(values[len(values)-1], value), 1)
+				point.ChangePercent = &change
+			}
+			values = append(values, value)
+		}
+
+		if result.IsAbnormal() {
+			trend.AbnormalCount++
+		}
+		trend.AnalyteName = result.analyteName
+		trend.Points = append(trend.Points, point)
+	}
+
+	if len(values) == 0 {
+		return trend
+	}
+
+	minValue, maxValue := values[0], values[0]
+	for _, value := range values[1:] {
+		minValue = min(minValue, value)
+		maxValue = max(maxValue, value)
+	}
+	trend.Min = &minValue
+	trend.Max = &maxValue
+	trend.Average = rollingAverage(values, len(values))
+
+	if len(values) > 1 && values[0] != 0 {
+		change := round(percentChange(values[0], values[len(values)-1]), 1)
+		trend.ChangePercent = &change
+	}
+
+	return trend
+}
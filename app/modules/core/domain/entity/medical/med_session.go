@@ -13,13 +13,17 @@ import (
 
 type MedicalSession struct {
 	base.Entity[vo.MedSessionID]
-	customerID vo.CustomerID
-	visitType  enum.VisitType
-	service    enum.ClinicService
-	visitDate  time.Time
-	notes      *string
-	employeeID vo.EmployeeID
-	petDetails PetSessionSummary
+	customerID    vo.CustomerID
+	appointmentID *vo.AppointmentID
+	visitType     enum.VisitType
+	service       enum.ClinicService
+	visitDate     time.Time
+	notes         *string
+	employeeID    vo.EmployeeID
+	isEmergency   bool
+	status        enum.MedSessionStatus
+	closedAt      *time.Time
+	petDetails    PetSessionSummary
 }
 
 type PetSessionSummary struct {
@@ -34,6 +38,7 @@ type PetSessionSummary struct {
 	medications     []string
 	followUpDate    *time.Time
 	symptoms        []string
+	vitalFlags      VitalFlags
 }
 
 type PetSessionSummaryBuilder struct{ petSession *PetSessionSummary }
@@ -49,6 +54,7 @@ type MedicalSessionBuilder struct{ medSession *MedicalSession }
 
 func NewMedicalSessionBuilder() *MedicalSessionBuilder {
 	return &MedicalSessionBuilder{medSession: &MedicalSession{
+		status: enum.MedSessionStatusClosed,
 		petDetails: PetSessionSummary{
 			medications: []string{},
 			symptoms:    []string{},
@@ -91,6 +97,26 @@ func (b *MedicalSessionBuilder) WithEmployeeID(employeeID vo.EmployeeID) *Medica
 	return b
 }
 
+func (b *MedicalSessionBuilder) WithAppointmentID(appointmentID *vo.AppointmentID) *MedicalSessionBuilder {
+	b.medSession.appointmentID = appointmentID
+	return b
+}
+
+func (b *MedicalSessionBuilder) WithIsEmergency(isEmergency bool) *MedicalSessionBuilder {
+	b.medSession.isEmergency = isEmergency
+	return b
+}
+
+func (b *MedicalSessionBuilder) WithStatus(status enum.MedSessionStatus) *MedicalSessionBuilder {
+	b.medSession.status = status
+	return b
+}
+
+func (b *MedicalSessionBuilder) WithClosedAt(closedAt *time.Time) *MedicalSessionBuilder {
+	b.medSession.closedAt = closedAt
+	return b
+}
+
 func (b *MedicalSessionBuilder) WithPetDetails(petDetails PetSessionSummary) *MedicalSessionBuilder {
 	b.medSession.petDetails = petDetails
 	return b
@@ -162,22 +188,32 @@ func (b *PetSessionSummaryBuilder) WithSymptoms(symptoms []string) *PetSessionSu
 	return b
 }
 
+func (b *PetSessionSummaryBuilder) WithVitalFlags(vitalFlags VitalFlags) *PetSessionSummaryBuilder {
+	b.petSession.vitalFlags = vitalFlags
+	return b
+}
+
 func (b *PetSessionSummaryBuilder) Build() *PetSessionSummary {
 	return b.petSession
 }
 
 // Getters
 
-func (mh *MedicalSession) ID() vo.MedSessionID           { return mh.Entity.ID() }
-func (mh *MedicalSession) PetDetails() PetSessionSummary { return mh.petDetails }
-func (mh *MedicalSession) CustomerID() vo.CustomerID     { return mh.customerID }
-func (mh *MedicalSession) VisitDate() time.Time          { return mh.visitDate }
-func (mh *MedicalSession) Service() enum.ClinicService   { return mh.service }
-func (mh *MedicalSession) Notes() *string                { return mh.notes }
-func (mh *MedicalSession) VisitType() enum.VisitType     { return mh.visitType }
-func (mh *MedicalSession) EmployeeID() vo.EmployeeID     { return mh.employeeID }
-func (mh *MedicalSession) CreatedAt() time.Time          { return mh.Entity.CreatedAt() }
-func (mh *MedicalSession) UpdatedAt() time.Time          { return mh.Entity.UpdatedAt() }
+func (mh *MedicalSession) ID() vo.MedSessionID              { return mh.Entity.ID() }
+func (mh *MedicalSession) PetDetails() PetSessionSummary    { return mh.petDetails }
+func (mh *MedicalSession) CustomerID() vo.CustomerID        { return mh.customerID }
+func (mh *MedicalSession) AppointmentID() *vo.AppointmentID { return mh.appointmentID }
+func (mh *MedicalSession) VisitDate() time.Time             { return mh.visitDate }
+func (mh *MedicalSession) Service() enum.ClinicService      { return mh.service }
+func (mh *MedicalSession) Notes() *string                   { return mh.notes }
+func (mh *MedicalSession) VisitType() enum.VisitType        { return mh.visitType }
+func (mh *MedicalSession) EmployeeID() vo.EmployeeID        { return mh.employeeID }
+func (mh *MedicalSession) IsEmergency() bool                { return mh.isEmergency }
+func (mh *MedicalSession) Status() enum.MedSessionStatus    { return mh.status }
+func (mh *MedicalSession) ClosedAt() *time.Time             { return mh.closedAt }
+func (mh *MedicalSession) IsDraft() bool                    { return mh.status == enum.MedSessionStatusDraft }
+func (mh *MedicalSession) CreatedAt() time.Time             { return mh.Entity.CreatedAt() }
+func (mh *MedicalSession) UpdatedAt() time.Time             { return mh.Entity.UpdatedAt() }
 
 func (ps PetSessionSummary) PetID() vo.PetID              { return ps.petID }
 func (ps PetSessionSummary) Weight() *vo.Decimal          { return ps.weight }
@@ -190,6 +226,7 @@ func (ps PetSessionSummary) Condition() enum.PetCondition { return ps.condition
 func (ps PetSessionSummary) Medications() []string        { return ps.medications }
 func (ps PetSessionSummary) FollowUpDate() *time.Time     { return ps.followUpDate }
 func (ps PetSessionSummary) Symptoms() []string           { return ps.symptoms }
+func (ps PetSessionSummary) VitalFlags() VitalFlags       { return ps.vitalFlags }
 
 // Validator
 
@@ -0,0 +1,293 @@
+package medical
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/base"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	domainerr "clinic-vet-api/app/modules/core/error"
+)
+
+const (
+	MaxPrescriptionRefills      = 12
+	MaxPrescriptionDurationDays = 365
+	PrescriptionValidityDays    = 365
+	MaxPrescriptionTextLength   = 500
+)
+
+// PrescriptionOrder is what the veterinarian prescribes: the drug and strength, the dose given
+// each time, how and how often it is given, for how long and the quantity dispensed per fill
+type PrescriptionOrder struct {
+	DrugName     string
+	Strength     string
+	Dose         string
+	Route        enum.MedicationRoute
+	Frequency    string
+	DurationDays int
+	Quantity     int
+	Refills      int
+	Instructions *string
+}
+
+// Prescription is a drug prescribed to a pet during a medical session. It is dispensed when
+// prescribed and again on each refill, the pet is on the medication until the course of the last
+// fill ends. Refills are allowed for a year after the prescription
+type Prescription struct {
+	base.Entity[vo.PrescriptionID]
+	sessionID         vo.MedSessionID
+	petID             vo.PetID
+	prescribedBy      vo.EmployeeID
+	drugName          string
+	strength          string
+	dose              string
+	route             enum.MedicationRoute
+	frequency         string
+	durationDays      int
+	quantity          int
+	refillsAuthorized int
+	refillsRemaining  int
+	instructions      *string
+	status            enum.PrescriptionStatus
+	prescribedAt      time.Time
+	lastFilledAt      time.Time
+	cancelledAt       *time.Time
+	cancelReason      *string
+}
+
+type PrescriptionBuilder struct{ prescription *Prescription }
+
+func NewPrescriptionBuilder() *PrescriptionBuilder {
+	return &PrescriptionBuilder{prescription: &Prescription{status: enum.PrescriptionStatusActive}}
+}
+
+func (b *PrescriptionBuilder) WithID(id vo.PrescriptionID) *PrescriptionBuilder {
+	b.prescription.SetID(id)
+	return b
+}
+
+func (b *PrescriptionBuilder) WithSessionID(sessionID vo.MedSessionID) *PrescriptionBuilder {
+	b.prescription.sessionID = sessionID
+	return b
+}
+
+func (b *PrescriptionBuilder) WithPetID(petID vo.PetID) *PrescriptionBuilder {
+	b.prescription.petID = petID
+	return b
+}
+
+func (b *PrescriptionBuilder) WithPrescribedBy(employeeID vo.EmployeeID) *PrescriptionBuilder {
+	b.prescription.prescribedBy = employeeID
+	return b
+}
+
+func (b *PrescriptionBuilder) WithOrder(order PrescriptionOrder) *PrescriptionBuilder {
+	b.prescription.drugName = strings.TrimSpace(order.DrugName)
+	b.prescription.strength = strings.TrimSpace(order.Strength)
+	b.prescription.dose = strings.TrimSpace(order.Dose)
+	b.prescription.route = order.Route
+	b.prescription.frequency = strings.TrimSpace(order.Frequency)
+	b.prescription.durationDays = order.DurationDays
+	b.prescription.quantity = order.Quantity
+	b.prescription.refillsAuthorized = order.Refills
+	b.prescription.instructions = order.Instructions
+	return b
+}
+
+func (b *PrescriptionBuilder) WithRefillsRemaining(refillsRemaining int) *PrescriptionBuilder {
+	b.prescription.refillsRemaining = refillsRemaining
+	return b
+}
+
+func (b *PrescriptionBuilder) WithStatus(status enum.PrescriptionStatus) *PrescriptionBuilder {
+	b.prescription.status = status
+	return b
+}
+
+func (b *PrescriptionBuilder) WithFills(prescribedAt, lastFilledAt time.Time) *PrescriptionBuilder {
+	b.prescription.prescribedAt = prescribedAt
+	b.prescription.lastFilledAt = lastFilledAt
+	return b
+}
+
+func (b *PrescriptionBuilder) WithCancellation(cancelledAt *time.Time, cancelReason *string) *PrescriptionBuilder {
+	b.prescription.cancelledAt = cancelledAt
+	b.prescription.cancelReason = cancelReason
+	return b
+}
+
+func (b *PrescriptionBuilder) WithTimestamps(createdAt, updatedAt time.Time) *PrescriptionBuilder {
+	b.prescription.SetTimeStamps(createdAt, updatedAt)
+	return b
+}
+
+func (b *PrescriptionBuilder) Build() *Prescription {
+	return b.prescription
+}
+
+func (p *Prescription) SessionID() vo.MedSessionID           { return p.sessionID }
+func (p *Prescription) PetID() vo.PetID                      { return p.petID }
+func (p *Prescription) PrescribedBy() vo.EmployeeID          { return p.prescribedBy }
+func (p *Prescription) DrugName() string                     { return p.drugName }
+func (p *Prescription) Strength() string                     { return p.strength }
+func (p *Prescription) Dose() string                         { return p.dose }
+func (p *Prescription) Route() enum.MedicationRoute          { return p.route }
+func (p *Prescription) Frequency() string                    { return p.frequency }
+func (p *Prescription) DurationDays() int                    { return p.durationDays }
+func (p *Prescription) Quantity() int                        { return p.quantity }
+func (p *Prescription) RefillsAuthorized() int               { return p.refillsAuthorized }
+func (p *Prescription) RefillsRemaining() int                { return p.refillsRemaining }
+func (p *Prescription) Instructions() *string                { return p.instructions }
+func (p *Prescription) Status() enum.PrescriptionStatus      { return p.status }
+func (p *Prescription) PrescribedAt() time.Time              { return p.prescribedAt }
+func (p *Prescription) LastFilledAt() time.Time              { return p.lastFilledAt }
+func (p *Prescription) CancelledAt() *time.Time              { return p.cancelledAt }
+func (p *Prescription) CancelReason() *string                { return p.cancelReason }
+func (p *Prescription) IsPrescribedBy(id vo.EmployeeID) bool { return p.prescribedBy == id }
+
+// CourseEndsAt is when the pet runs out of the medication dispensed on the last fill
+func (p *Prescription) CourseEndsAt() time.Time {
+	return p.lastFilledAt.AddDate(0, 0, p.durationDays)
+}
+
+// ExpiresAt is the last moment the prescription can be refilled
+func (p *Prescription) ExpiresAt() time.Time {
+	return p.prescribedAt.AddDate(0, 0, PrescriptionValidityDays)
+}
+
+// IsActiveAt tells whether the pet is on the medication at the time
+func (p *Prescription) IsActiveAt(at time.Time) bool {
+	return p.status == enum.PrescriptionStatusActive && !at.Before(p.lastFilledAt) && at.Before(p.CourseEndsAt())
+}
+
+// Prescribe issues a prescription for the pet seen in the session, dispensed right away
+func Prescribe(
+	ctx context.Context,
+	session MedicalSession,
+	prescribedBy vo.EmployeeID,
+	order PrescriptionOrder,
+	now time.Time,
+) (*Prescription, error) {
+	prescription := NewPrescriptionBuilder().
+		WithSessionID(session.ID()).
+		WithPetID(session.PetDetails().PetID()).
+		WithPrescribedBy(prescribedBy).
+		WithOrder(order).
+		WithRefillsRemaining(order.Refills).
+		WithFills(now, now).
+		Build()
+
+	if err := prescription.Validate(ctx); err != nil {
+		return nil, err
+	}
+	return prescription, nil
+}
+
+func (p *Prescription) Validate(ctx context.Context) error {
+	operation := "ValidatePrescription"
+
+	if p.sessionID.IsZero() {
+		return domainerr.MissingFieldError(ctx, "medical_session_id", "the medical session is required", operation)
+	}
+
+	if p.petID.IsZero() {
+		return domainerr.MissingFieldError(ctx, "pet_id", "the pet is required", operation)
+	}
+
+	if p.prescribedBy.IsZero() {
+		return domainerr.MissingFieldError(ctx, "prescribed_by", "the prescribing veterinarian is required", operation)
+	}
+
+	if p.drugName == "" || len(p.drugName) > 150 {
+		return invalidPrescriptionError(ctx, "drug_name", "the drug is required and cannot exceed 150 characters", operation)
+	}
+
+	if p.strength == "" || len(p.strength) > 50 {
+		return invalidPrescriptionError(ctx, "strength", "the strength is required and cannot exceed 50 characters", operation)
+	}
+
+	if p.dose == "" || len(p.dose) > 100 {
+		return invalidPrescriptionError(ctx, "dose", "the dose is required and cannot exceed 100 characters", operation)
+	}
+
+	if !p.route.IsValid() {
+		return domainerr.InvalidEnumValue(ctx, "route", string(p.route), "invalid medication route", operation)
+	}
+
+	if p.frequency == "" || len(p.frequency) > 100 {
+		return invalidPrescriptionError(ctx, "frequency", "the frequency is required and cannot exceed 100 characters", operation)
+	}
+
+	if p.durationDays < 1 || p.durationDays > MaxPrescriptionDurationDays {
+		return invalidPrescriptionError(ctx, "duration_days", fmt.Sprintf("the course lasts between 1 and %d days", MaxPrescriptionDurationDays), operation)
+	}
+
+	if p.quantity < 1 {
+		return invalidPrescriptionError(ctx, "quantity", "at least one unit has to be dispensed", operation)
+	}
+
+	if p.refillsAuthorized < 0 || p.refillsAuthorized > MaxPrescriptionRefills {
+		return invalidPrescriptionError(ctx, "refills", fmt.Sprintf("between 0 and %d refills can be authorized", MaxPrescriptionRefills), operation)
+	}
+
+	if p.instructions != nil && len(*p.instructions) > MaxPrescriptionTextLength {
+		return invalidPrescriptionError(ctx, "instructions", fmt.Sprintf("the instructions cannot exceed %d characters", MaxPrescriptionTextLength), operation)
+	}
+
+	return nil
+}
+
+// Refill dispenses the prescription again, starting a new course
+func (p *Prescription) Refill(ctx context.Context, now time.Time) error {
+	operation := "RefillPrescription"
+
+	if p.status != enum.PrescriptionStatusActive {
+		return domainerr.BusinessRuleError(ctx, fmt.Sprintf("the prescription was %s", p.status.DisplayName()), "prescription", "status", operation)
+	}
+
+	if p.refillsRemaining <= 0 {
+		return domainerr.BusinessRuleError(ctx, "no refills remain on the prescription", "prescription", "refills_remaining", operation)
+	}
+
+	if !now.Before(p.ExpiresAt()) {
+		return domainerr.BusinessRuleError(ctx, "the prescription expired and cannot be refilled", "prescription", "prescribed_at", operation)
+	}
+
+	p.refillsRemaining--
+	p.lastFilledAt = now
+	p.IncrementVersion()
+	return nil
+}
+
+// Cancel stops the prescription, the pet is taken off the medication and no refill is dispensed
+func (p *Prescription) Cancel(ctx context.Context, reason string, now time.Time) error {
+	operation := "CancelPrescription"
+
+	if p.status != enum.PrescriptionStatusActive {
+		return domainerr.BusinessRuleError(ctx, "the prescription was already cancelled", "prescription", "status", operation)
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" || len(reason) > MaxPrescriptionTextLength {
+		return invalidPrescriptionError(ctx, "reason", fmt.Sprintf("the reason is required and cannot exceed %d characters", MaxPrescriptionTextLength), operation)
+	}
+
+	p.status = enum.PrescriptionStatusCancelled
+	p.cancelledAt = &now
+	p.cancelReason = &reason
+	p.IncrementVersion()
+	return nil
+}
+
+// RefillTakenError reports a refill that lost the race against another refill or a cancellation
+func RefillTakenError(ctx context.Context) error {
+	return domainerr.BusinessRuleError(ctx, "the prescription was refilled or cancelled meanwhile", "prescription", "refills_remaining", "RefillPrescription")
+}
+
+func invalidPrescriptionError(ctx context.Context, field, message, operation string) error {
+	return domainerr.ValidationError(ctx, "PRESCRIPTION_INVALID", "prescription", field,
+		fmt.Sprintf("Prescription %s: %s", field, message), operation)
+}
@@ -0,0 +1,57 @@
+package medical
+
+import (
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// VitalFlags tell how each vital of a session compares with the normal range of the pet. A flag
+// is absent when the vital was not taken or there is no range to compare it with
+type VitalFlags struct {
+	Weight          *enum.VitalFlag
+	Temperature     *enum.VitalFlag
+	HeartRate       *enum.VitalFlag
+	RespiratoryRate *enum.VitalFlag
+}
+
+func (vf VitalFlags) HasAbnormal() bool {
+	for _, flag := range []*enum.VitalFlag{vf.Weight, vf.Temperature, vf.HeartRate, vf.RespiratoryRate} {
+		if flag != nil && flag.IsAbnormal() {
+			return true
+		}
+	}
+	return false
+}
+
+// AnnotateVitals flags the vitals of the session against the reference ranges of the pet's
+// species and life stage, replacing any previous flags
+func (mh *MedicalSession) AnnotateVitals(ranges vo.VitalReferenceRanges) {
+	details := mh.petDetails
+	mh.petDetails.vitalFlags = VitalFlags{
+		Weight:          classifyVital(ranges.Weight(), decimalToFloat(details.weight)),
+		Temperature:     classifyVital(ranges.Temperature(), decimalToFloat(details.temperature)),
+		HeartRate:       classifyVital(ranges.HeartRate(), int32ToFloat(details.heartRate)),
+		RespiratoryRate: classifyVital(ranges.RespiratoryRate(), int32ToFloat(details.respiratoryRate)),
+	}
+}
+
+// ClearVitalFlags drops the flags of a session whose pet has no reference ranges
+func (mh *MedicalSession) ClearVitalFlags() {
+	mh.petDetails.vitalFlags = VitalFlags{}
+}
+
+func classifyVital(vitalRange *vo.VitalRange, value *float64) *enum.VitalFlag {
+	if vitalRange == nil || value == nil {
+		return nil
+	}
+	flag := vitalRange.Classify(*value)
+	return &flag
+}
+
+func int32ToFloat(value *int32) *float64 {
+	if value == nil {
+		return nil
+	}
+	f := float64(*value)
+	return &f
+}
@@ -0,0 +1,167 @@
+package medical
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+const (
+	DefaultVitalsAverageWindow = 3
+	MaxVitalsAverageWindow     = 10
+
+	// SuddenWeightLossPercent is the loss since the previous weighing that is flagged when both
+	// weighings are less than SuddenWeightLossWindow apart
+	SuddenWeightLossPercent = 5.0
+	SuddenWeightLossWindow  = 90 * 24 * time.Hour
+)
+
+// VitalsReading is the weight, temperature, heart rate and respiratory rate taken in a session,
+// with the trends up to it
+type VitalsReading struct {
+	SessionID       vo.MedSessionID
+	VisitDate       time.Time
+	Weight          *float64
+	Temperature     *float64
+	HeartRate       *int32
+	RespiratoryRate *int32
+
+	// WeightChangePercent is the change since the previous weighing, absent for the first one
+	WeightChangePercent *float64
+	DaysSinceWeighing   *int
+	SuddenWeightLoss    bool
+
+	// Rolling averages over the last readings of each vital, this one included
+	AvgWeight          *float64
+	AvgTemperature     *float64
+	AvgHeartRate       *float64
+	AvgRespiratoryRate *float64
+}
+
+// VitalsTimeline is the series of vitals of a pet across its sessions, oldest first
+type VitalsTimeline struct {
+	PetID    vo.PetID
+	Window   int
+	Readings []VitalsReading
+
+	// WeightChangePercent is the change between the first and the last weighing of the timeline
+	WeightChangePercent *float64
+	SuddenWeightLoss    bool
+}
+
+// NewVitalsTimeline lays out the vitals of the pet's sessions in visit order. Sessions without
+// any vital are left out and the rolling averages take the last readings given by the window
+func NewVitalsTimeline(petID vo.PetID, sessions []MedicalSession, window int) VitalsTimeline {
+	if window < 1 {
+		window = DefaultVitalsAverageWindow
+	}
+
+	ordered := make([]MedicalSession, 0, len(sessions))
+	for _, session := range sessions {
+		if session.petDetails.hasVitals() {
+			ordered = append(ordered, session)
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].visitDate.Before(ordered[j].visitDate)
+	})
+
+	timeline := VitalsTimeline{PetID: petID, Window: window, Readings: make([]VitalsReading, 0, len(ordered))}
+
+	var weights, temperatures, heartRates, respiratoryRates []float64
+	var firstWeight, lastWeight *float64
+	var lastWeighedOn time.Time
+
+	for _, session := range ordered {
+		details := session.petDetails
+		reading := VitalsReading{
+			SessionID:       session.ID(),
+			VisitDate:       session.visitDate,
+			Weight:          decimalToFloat(details.weight),
+			Temperature:     decimalToFloat(details.temperature),
+			HeartRate:       details.heartRate,
+			RespiratoryRate: details.respiratoryRate,
+		}
+
+		if reading.Weight != nil {
+			weight := *reading.Weight
+			if lastWeight != nil && *lastWeight > 0 {
+				change := round(percentChange(*lastWeight, weight), 1)
+				days := int(session.visitDate.Sub(lastWeighedOn).Hours() / 24)
+				reading.WeightChangePercent = &change
+				reading.DaysSinceWeighing = &days
+				reading.SuddenWeightLoss = change <= -SuddenWeightLossPercent &&
+					session.visitDate.Sub(lastWeighedOn) <= SuddenWeightLossWindow
+			}
+
+			if firstWeight == nil {
+				firstWeight = &weight
+			}
+			lastWeight = &weight
+			lastWeighedOn = session.visitDate
+			weights = append(weights, weight)
+			reading.AvgWeight = rollingAverage(weights, window)
+		}
+
+		if reading.Temperature != nil {
+			temperatures = append(temperatures, *reading.Temperature)
+			reading.AvgTemperature = rollingAverage(temperatures, window)
+		}
+
+		if reading.HeartRate != nil {
+			heartRates = append(heartRates, float64(*reading.HeartRate))
+			reading.AvgHeartRate = rollingAverage(heartRates, window)
+		}
+
+		if reading.RespiratoryRate != nil {
+			respiratoryRates = append(respiratoryRates, float64(*reading.RespiratoryRate))
+			reading.AvgRespiratoryRate = rollingAverage(respiratoryRates, window)
+		}
+
+		timeline.SuddenWeightLoss = timeline.SuddenWeightLoss || reading.SuddenWeightLoss
+		timeline.Readings = append(timeline.Readings, reading)
+	}
+
+	if len(weights) > 1 && *firstWeight > 0 {
+		change := round(percentChange(*firstWeight, *lastWeight), 1)
+		timeline.WeightChangePercent = &change
+	}
+
+	return timeline
+}
+
+func (ps PetSessionSummary) hasVitals() bool {
+	return ps.weight != nil || ps.temperature != nil || ps.heartRate != nil || ps.respiratoryRate != nil
+}
+
+func decimalToFloat(value *vo.Decimal) *float64 {
+	if value == nil {
+		return nil
+	}
+	f := value.Float64()
+	return &f
+}
+
+func percentChange(from, to float64) float64 {
+	return (to - from) / from * 100
+}
+
+func rollingAverage(values []float64, window int) *float64 {
+	if len(values) > window {
+		values = values[len(values)-window:]
+	}
+
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+	avg := round(sum/float64(len(values)), 2)
+	return &avg
+}
+
+func round(value float64, places int) float64 {
+	factor := math.Pow(10, float64(places))
+	return math.Round(value*factor) / factor
+}
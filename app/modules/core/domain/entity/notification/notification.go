@@ -215,6 +215,191 @@ func NewSend2FATokenSMS(userID valueobject.UserID, phone valueobject.PhoneNumber
 	return notif
 }
 
+// NewAppointmentReminder builds the reminder of an upcoming appointment on the given channel.
+// It returns nil when the channel is SMS and the user has no phone number
+func NewAppointmentReminder(
+	userID valueobject.UserID,
+	channel enum.NotificationChannel,
+	email valueobject.Email,
+	phone *valueobject.PhoneNumber,
+	name string,
+	service enum.ClinicService,
+	scheduledDate time.Time,
+) *Notification {
+	title := "Recordatorio de Cita"
+	message := fmt.Sprintf("Hola %s, te recordamos tu cita de %s el %s a las %s.",
+		name, service.DisplayName(), scheduledDate.Format("02/01/2006"), scheduledDate.Format("15:04"))
+
+	builder := NewNotificationBuilder().
+		WithUserID(userID).
+		WithNType(enum.NotificationTypeAppointmentRemind).
+		WithChannel(channel).
+		WithTitle(title).
+		WithSubject(title).
+		WithMessage(message)
+
+	if channel == enum.NotificationChannelSMS {
+		if phone == nil {
+			return nil
+		}
+		return builder.WithPhone(phone.Value).Build()
+	}
+
+	return builder.WithEmail(email.String()).Build()
+}
+
+// NewWaitlistSlotOffer offers a freed appointment slot to a waitlisted customer. The token
+// claims the slot and stops working at expiresAt. It returns nil when the channel is SMS and
+// the user has no phone number
+func NewWaitlistSlotOffer(
+	userID valueobject.UserID,
+	channel enum.NotificationChannel,
+	email valueobject.Email,
+	phone *valueobject.PhoneNumber,
+	name string,
+	service enum.ClinicService,
+	slot time.Time,
+	token string,
+	expiresAt time.Time,
+) *Notification {
+	title := "Cupo Disponible para tu Cita"
+	message := fmt.Sprintf("Hola %s, se liberó un cupo de %s el %s a las %s. Usa el siguiente código para reservarlo antes del %s a las %s: %s",
+		name, service.DisplayName(), slot.Format("02/01/2006"), slot.Format("15:04"),
+		expiresAt.Format("02/01/2006"), expiresAt.Format("15:04"), token)
+
+	builder := NewNotificationBuilder().
+		WithUserID(userID).
+		WithNType(enum.NotificationTypeWaitlistOffer).
+		WithChannel(channel).
+		WithTitle(title).
+		WithSubject(title).
+		WithMessage(message).
+		WithToken(token)
+
+	if channel == enum.NotificationChannelSMS {
+		if phone == nil {
+			return nil
+		}
+		return builder.WithPhone(phone.Value).Build()
+	}
+
+	return builder.WithEmail(email.String()).Build()
+}
+
+// NewFollowUpProposal asks the owner to confirm the follow-up appointment proposed by the
+// veterinarian, or its new date when the follow-up was moved. It returns nil when the channel
+// is SMS and the user has no phone number
+func NewFollowUpProposal(
+	userID valueobject.UserID,
+	channel enum.NotificationChannel,
+	email valueobject.Email,
+	phone *valueobject.PhoneNumber,
+	name string,
+	service enum.ClinicService,
+	scheduledDate time.Time,
+	moved bool,
+) *Notification {
+	title := "Cita de Seguimiento Propuesta"
+	message := fmt.Sprintf("Hola %s, tu veterinario propuso una cita de seguimiento de %s el %s a las %s. Por favor confírmala con la clínica.",
+		name, service.DisplayName(), scheduledDate.Format("02/01/2006"), scheduledDate.Format("15:04"))
+	if moved {
+		title = "Cita de Seguimiento Modificada"
+		message = fmt.Sprintf("Hola %s, tu cita de seguimiento de %s se movió al %s a las %s. Por favor confirma la nueva fecha con la clínica.",
+			name, service.DisplayName(), scheduledDate.Format("02/01/2006"), scheduledDate.Format("15:04"))
+	}
+
+	return appointmentNotification(userID, enum.NotificationTypeAppointmentConfirm, channel, email, phone, title, message)
+}
+
+// NewFollowUpCancelled tells the owner the proposed follow-up appointment is no longer needed.
+// It returns nil when the channel is SMS and the user has no phone number
+func NewFollowUpCancelled(
+	userID valueobject.UserID,
+	channel enum.NotificationChannel,
+	email valueobject.Email,
+	phone *valueobject.PhoneNumber,
+	name string,
+	service enum.ClinicService,
+	scheduledDate time.Time,
+) *Notification {
+	title := "Cita de Seguimiento Cancelada"
+	message := fmt.Sprintf("Hola %s, tu veterinario canceló la cita de seguimiento de %s del %s a las %s.",
+		name, service.DisplayName(), scheduledDate.Format("02/01/2006"), scheduledDate.Format("15:04"))
+
+	return appointmentNotification(userID, enum.NotificationTypeAppointmentCancel, channel, email, phone, title, message)
+}
+
+// NewReassignmentProposal proposes the owner another veterinarian for the appointment since the
+// booked one is absent, the appointment waits for the confirmation of the owner or the clinic. It
+// returns nil when the channel is SMS and the user has no phone number
+func NewReassignmentProposal(
+	userID valueobject.UserID,
+	channel enum.NotificationChannel,
+	email valueobject.Email,
+	phone *valueobject.PhoneNumber,
+	name string,
+	service enum.ClinicService,
+	scheduledDate time.Time,
+	vetName string,
+) *Notification {
+	title := "Propuesta de Cambio de Veterinario"
+	message := fmt.Sprintf("Hola %s, tu veterinario no estará disponible para tu cita de %s el %s a las %s. Te proponemos que te atienda %s, confirma el cambio en la aplicación o contacta a la clínica.",
+		name, service.DisplayName(), scheduledDate.Format("02/01/2006"), scheduledDate.Format("15:04"), vetName)
+
+	return appointmentNotification(userID, enum.NotificationTypeAppointmentConfirm, channel, email, phone, title, message)
+}
+
+// NewAppointmentCancelledForAbsence tells the owner the appointment was cancelled because no
+// veterinarian can attend it, with the link to book it again when there is one. It returns nil
+// when the channel is SMS and the user has no phone number
+func NewAppointmentCancelledForAbsence(
+	userID valueobject.UserID,
+	channel enum.NotificationChannel,
+	email valueobject.Email,
+	phone *valueobject.PhoneNumber,
+	name string,
+	service enum.ClinicService,
+	scheduledDate time.Time,
+	rescheduleLink string,
+) *Notification {
+	title := "Cita Cancelada"
+	message := fmt.Sprintf("Hola %s, lamentamos informarte que tu cita de %s el %s a las %s fue cancelada porque ningún veterinario puede atenderla.",
+		name, service.DisplayName(), scheduledDate.Format("02/01/2006"), scheduledDate.Format("15:04"))
+	if rescheduleLink != "" {
+		message += fmt.Sprintf(" Puedes reagendarla aquí: %s", rescheduleLink)
+	} else {
+		message += " Por favor contacta a la clínica para reagendarla."
+	}
+
+	return appointmentNotification(userID, enum.NotificationTypeAppointmentCancel, channel, email, phone, title, message)
+}
+
+func appointmentNotification(
+	userID valueobject.UserID,
+	nType enum.NotificationType,
+	channel enum.NotificationChannel,
+	email valueobject.Email,
+	phone *valueobject.PhoneNumber,
+	title, message string,
+) *Notification {
+	builder := NewNotificationBuilder().
+		WithUserID(userID).
+		WithNType(nType).
+		WithChannel(channel).
+		WithTitle(title).
+		WithSubject(title).
+		WithMessage(message)
+
+	if channel == enum.NotificationChannelSMS {
+		if phone == nil {
+			return nil
+		}
+		return builder.WithPhone(phone.Value).Build()
+	}
+
+	return builder.WithEmail(email.String()).Build()
+}
+
 func (b *Notification) SetID(id string) {
 	b.id = id
 }
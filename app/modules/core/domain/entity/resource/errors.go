@@ -0,0 +1,37 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domainerr "clinic-vet-api/app/modules/core/error"
+)
+
+type ResourceErrorCode string
+
+const (
+	ResourceInvalid            ResourceErrorCode = "RESOURCE_INVALID"
+	ResourceInvalidRequirement ResourceErrorCode = "RESOURCE_INVALID_REQUIREMENT"
+	ResourceUnavailable        ResourceErrorCode = "RESOURCE_UNAVAILABLE"
+)
+
+func resourceValidationError(ctx context.Context, code ResourceErrorCode, field, message, operation string) error {
+	return domainerr.ValidationError(ctx, string(code), "clinic_resource", field,
+		fmt.Sprintf("Clinic resource %s: %s", field, message), operation)
+}
+
+func InvalidResourceError(ctx context.Context, field, message, operation string) error {
+	return resourceValidationError(ctx, ResourceInvalid, field, message, operation)
+}
+
+func InvalidRequirementError(ctx context.Context, message, operation string) error {
+	return resourceValidationError(ctx, ResourceInvalidRequirement, "requirements", message, operation)
+}
+
+func ResourceUnavailableError(ctx context.Context, requirement ServiceRequirement, free int, start, end time.Time, operation string) error {
+	rule := fmt.Sprintf("%s needs %d %s from %s to %s but only %d is free",
+		requirement.Service.DisplayName(), requirement.Quantity, requirement.ResourceType.DisplayName(),
+		start.Format("2006-01-02 15:04"), end.Format("15:04"), free)
+	return domainerr.BusinessRuleError(ctx, rule, "clinic_resource", "", operation)
+}
@@ -0,0 +1,50 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"clinic-vet-api/app/modules/core/domain/enum"
+)
+
+// MaxRequiredQuantity caps how many resources of one type a single service can hold
+const MaxRequiredQuantity = 10
+
+// ServiceRequirement is the number of resources of a type every appointment of the service holds
+type ServiceRequirement struct {
+	Service      enum.ClinicService
+	ResourceType enum.ResourceType
+	Quantity     int
+}
+
+// ValidateRequirements checks the full set of requirements of a service, each resource type
+// can only be listed once
+func ValidateRequirements(ctx context.Context, service enum.ClinicService, requirements []ServiceRequirement) error {
+	operation := "ValidateServiceRequirements"
+
+	if !service.IsValid() {
+		return InvalidRequirementError(ctx, fmt.Sprintf("invalid service: %s", service), operation)
+	}
+
+	seen := make(map[enum.ResourceType]bool, len(requirements))
+	for _, requirement := range requirements {
+		if requirement.Service != service {
+			return InvalidRequirementError(ctx, "every requirement must belong to the same service", operation)
+		}
+
+		if !requirement.ResourceType.IsValid() {
+			return InvalidRequirementError(ctx, fmt.Sprintf("invalid resource type: %s", requirement.ResourceType), operation)
+		}
+
+		if requirement.Quantity < 1 || requirement.Quantity > MaxRequiredQuantity {
+			return InvalidRequirementError(ctx, fmt.Sprintf("quantity must be between 1 and %d", MaxRequiredQuantity), operation)
+		}
+
+		if seen[requirement.ResourceType] {
+			return InvalidRequirementError(ctx, fmt.Sprintf("%s is listed more than once", requirement.ResourceType.DisplayName()), operation)
+		}
+		seen[requirement.ResourceType] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package resource
+
+import (
+	"context"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// Reservation holds a resource for an appointment from its start to its end
+type Reservation struct {
+	ID            uint
+	ResourceID    valueobject.ResourceID
+	AppointmentID valueobject.AppointmentID
+	Service       enum.ClinicService
+	Status        enum.AppointmentStatus
+	StartTime     time.Time
+	EndTime       time.Time
+}
+
+// Allocate picks the resources fulfilling the requirement among the candidates of its type,
+// skipping the busy ones. Candidates are taken in order so allocations are predictable
+func Allocate(
+	ctx context.Context, requirement ServiceRequirement, candidates []ClinicResource,
+	busy map[valueobject.ResourceID]bool, start, end time.Time,
+) ([]ClinicResource, error) {
+	allocated := make([]ClinicResource, 0, requirement.Quantity)
+	for _, candidate := range candidates {
+		if len(allocated) == requirement.Quantity {
+			break
+		}
+
+		if !candidate.IsActive() || candidate.ResourceType() != requirement.ResourceType || busy[candidate.ID()] {
+			continue
+		}
+		allocated = append(allocated, candidate)
+	}
+
+	if len(allocated) < requirement.Quantity {
+		return nil, ResourceUnavailableError(ctx, requirement, len(allocated), start, end, "AllocateResources")
+	}
+
+	return allocated, nil
+}
+
+// IsHeld tells whether the reservation still blocks the resource, reservations of cancelled,
+// completed or missed appointments are kept as history only
+func (r Reservation) IsHeld() bool {
+	switch r.Status {
+	case enum.AppointmentStatusPending, enum.AppointmentStatusConfirmed, enum.AppointmentStatusRescheduled:
+		return true
+	default:
+		return false
+	}
+}
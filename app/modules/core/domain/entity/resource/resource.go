@@ -0,0 +1,115 @@
+// Package resource defines the rooms and equipment of the clinic and how appointments reserve them
+package resource
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/base"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// ClinicResource is a room or a piece of equipment that can be held by a single appointment
+// at a time. Inactive resources are kept for their reservation history but never reserved again
+type ClinicResource struct {
+	base.Entity[valueobject.ResourceID]
+	name         string
+	resourceType enum.ResourceType
+	description  *string
+	isActive     bool
+}
+
+type ClinicResourceBuilder struct{ resource *ClinicResource }
+
+func NewClinicResourceBuilder() *ClinicResourceBuilder {
+	return &ClinicResourceBuilder{resource: &ClinicResource{isActive: true}}
+}
+
+func (b *ClinicResourceBuilder) WithID(id valueobject.ResourceID) *ClinicResourceBuilder {
+	b.resource.SetID(id)
+	return b
+}
+
+func (b *ClinicResourceBuilder) WithName(name string) *ClinicResourceBuilder {
+	b.resource.name = strings.TrimSpace(name)
+	return b
+}
+
+func (b *ClinicResourceBuilder) WithResourceType(resourceType enum.ResourceType) *ClinicResourceBuilder {
+	b.resource.resourceType = resourceType
+	return b
+}
+
+func (b *ClinicResourceBuilder) WithDescription(description *string) *ClinicResourceBuilder {
+	b.resource.description = description
+	return b
+}
+
+func (b *ClinicResourceBuilder) WithIsActive(isActive bool) *ClinicResourceBuilder {
+	b.resource.isActive = isActive
+	return b
+}
+
+func (b *ClinicResourceBuilder) WithTimestamps(createdAt, updatedAt time.Time) *ClinicResourceBuilder {
+	b.resource.SetTimeStamps(createdAt, updatedAt)
+	return b
+}
+
+func (b *ClinicResourceBuilder) Build() *ClinicResource {
+	return b.resource
+}
+
+func (r *ClinicResource) Name() string                    { return r.name }
+func (r *ClinicResource) ResourceType() enum.ResourceType { return r.resourceType }
+func (r *ClinicResource) Kind() enum.ResourceKind         { return r.resourceType.Kind() }
+func (r *ClinicResource) Description() *string            { return r.description }
+func (r *ClinicResource) IsActive() bool                  { return r.isActive }
+
+func (r *ClinicResource) Validate(ctx context.Context) error {
+	operation := "ValidateClinicResource"
+
+	if r.name == "" {
+		return InvalidResourceError(ctx, "name", "name is required", operation)
+	}
+
+	if len(r.name) > 100 {
+		return InvalidResourceError(ctx, "name", "name cannot exceed 100 characters", operation)
+	}
+
+	if !r.resourceType.IsValid() {
+		return InvalidResourceError(ctx, "resource_type", "invalid resource type: "+r.resourceType.String(), operation)
+	}
+
+	return nil
+}
+
+// Update replaces the details of the resource, nil values keep the current ones
+func (r *ClinicResource) Update(ctx context.Context, name *string, resourceType *enum.ResourceType, description *string, isActive *bool) error {
+	if name != nil {
+		r.name = strings.TrimSpace(*name)
+	}
+	if resourceType != nil {
+		r.resourceType = *resourceType
+	}
+	if description != nil {
+		r.description = description
+	}
+	if isActive != nil {
+		r.isActive = *isActive
+	}
+
+	if err := r.Validate(ctx); err != nil {
+		return err
+	}
+
+	r.IncrementVersion()
+	return nil
+}
+
+// Deactivate withdraws the resource, it is no longer reserved by new bookings
+func (r *ClinicResource) Deactivate() {
+	r.isActive = false
+	r.IncrementVersion()
+}
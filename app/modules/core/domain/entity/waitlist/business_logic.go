@@ -0,0 +1,181 @@
+package waitlist
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+const claimTokenBytes = 32
+
+// MaxMissedOffers is how many offers an entry can let expire before it leaves the waitlist
+const MaxMissedOffers = 3
+
+func (w *WaitlistEntry) Validate(ctx context.Context, now time.Time) error {
+	operation := "ValidateWaitlistEntry"
+
+	if w.customerID.IsZero() {
+		return InvalidEntryError(ctx, "customer_id", "customer is required", operation)
+	}
+
+	if w.petID.IsZero() {
+		return InvalidEntryError(ctx, "pet_id", "pet is required", operation)
+	}
+
+	if !w.service.IsValid() {
+		return InvalidEntryError(ctx, "service", "invalid service: "+w.service.String(), operation)
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, w.requestedDate.Location())
+	if w.requestedDate.IsZero() || w.requestedDate.Before(today) {
+		return InvalidEntryError(ctx, "requested_date", "requested date cannot be in the past", operation)
+	}
+
+	if w.notes != nil && len(*w.notes) > 1000 {
+		return InvalidEntryError(ctx, "notes", "notes cannot exceed 1000 characters", operation)
+	}
+
+	return nil
+}
+
+// Matches reports whether the freed slot fits the date, service and preferred employee of the entry
+func (w *WaitlistEntry) Matches(slot Slot) bool {
+	if w.status != enum.WaitlistStatusWaiting || w.service != slot.Service {
+		return false
+	}
+
+	if w.requestedDate.Year() != slot.Start.Year() || w.requestedDate.YearDay() != slot.Start.YearDay() {
+		return false
+	}
+
+	if w.preferredEmployeeID == nil {
+		return true
+	}
+	return slot.EmployeeID != nil && *w.preferredEmployeeID == *slot.EmployeeID
+}
+
+// Offer reserves the slot for the entry until expiresAt. Only the hash of the claim token is kept
+func (w *WaitlistEntry) Offer(ctx context.Context, slot Slot, claimToken string, expiresAt time.Time) error {
+	operation := "OfferWaitlistSlot"
+
+	if w.status != enum.WaitlistStatusWaiting {
+		return CannotOfferError(ctx, *w, operation)
+	}
+
+	tokenHash := HashClaimToken(claimToken)
+	start := slot.Start
+
+	w.offeredSlot = &start
+	w.offeredEmployeeID = slot.EmployeeID
+	w.claimTokenHash = &tokenHash
+	w.offerExpiresAt = &expiresAt
+	w.status = enum.WaitlistStatusOffered
+	w.IncrementVersion()
+	return nil
+}
+
+// MissOffer records an offer that expired unclaimed. The entry goes back to waiting for the next
+// freed slot until it has missed MaxMissedOffers offers, then it expires
+func (w *WaitlistEntry) MissOffer(ctx context.Context) error {
+	operation := "MissWaitlistOffer"
+
+	if w.status != enum.WaitlistStatusOffered {
+		return CannotMissOfferError(ctx, *w, operation)
+	}
+
+	w.missedOffers++
+	w.claimTokenHash = nil
+	if w.missedOffers >= MaxMissedOffers {
+		w.status = enum.WaitlistStatusExpired
+	} else {
+		w.status = enum.WaitlistStatusWaiting
+		w.offeredSlot = nil
+		w.offeredEmployeeID = nil
+		w.offerExpiresAt = nil
+	}
+	w.IncrementVersion()
+	return nil
+}
+
+func (w *WaitlistEntry) IsOfferExpired(now time.Time) bool {
+	return w.status == enum.WaitlistStatusOffered && w.offerExpiresAt != nil && now.After(*w.offerExpiresAt)
+}
+
+// Slot returns the slot offered to the entry, if any
+func (w *WaitlistEntry) Slot() (Slot, bool) {
+	if w.offeredSlot == nil {
+		return Slot{}, false
+	}
+	return Slot{Start: *w.offeredSlot, Service: w.service, EmployeeID: w.offeredEmployeeID}, true
+}
+
+// Claim converts the offer into a pending appointment for the offered slot
+func (w *WaitlistEntry) Claim(ctx context.Context, claimToken string, now time.Time) (appointment.Appointment, error) {
+	operation := "ClaimWaitlistSlot"
+
+	if w.status != enum.WaitlistStatusOffered || w.offeredSlot == nil {
+		return appointment.Appointment{}, CannotClaimError(ctx, *w, operation)
+	}
+
+	if w.claimTokenHash == nil || subtle.ConstantTimeCompare([]byte(*w.claimTokenHash), []byte(HashClaimToken(claimToken))) != 1 {
+		return appointment.Appointment{}, InvalidClaimTokenError(ctx, operation)
+	}
+
+	if w.IsOfferExpired(now) {
+		return appointment.Appointment{}, OfferExpiredError(ctx, operation)
+	}
+
+	appt := appointment.NewAppointmentBuilder().
+		WithCustomerID(w.customerID).
+		WithPetID(w.petID).
+		WithService(w.service).
+		WithScheduledDate(*w.offeredSlot).
+		WithEmployeeID(w.offeredEmployeeID).
+		WithNotes(w.notes).
+		WithStatus(enum.AppointmentStatusPending).
+		Build()
+
+	w.status = enum.WaitlistStatusClaimed
+	w.IncrementVersion()
+	return *appt, nil
+}
+
+// LinkAppointment records the appointment created from a claimed offer
+func (w *WaitlistEntry) LinkAppointment(appointmentID valueobject.AppointmentID) {
+	w.appointmentID = &appointmentID
+	w.claimTokenHash = nil
+}
+
+func (w *WaitlistEntry) Cancel(ctx context.Context) error {
+	operation := "CancelWaitlistEntry"
+
+	if !w.status.IsActive() {
+		return CannotCancelError(ctx, *w, operation)
+	}
+
+	w.status = enum.WaitlistStatusCancelled
+	w.claimTokenHash = nil
+	w.IncrementVersion()
+	return nil
+}
+
+// GenerateClaimToken returns a random URL-safe token sent to the customer with the offer
+func GenerateClaimToken(ctx context.Context) (string, error) {
+	bytes := make([]byte, claimTokenBytes)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", TokenGenerationError(ctx, err, "GenerateClaimToken")
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
+func HashClaimToken(claimToken string) string {
+	hash := sha256.Sum256([]byte(claimToken))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,69 @@
+package waitlist
+
+import (
+	"context"
+	"fmt"
+
+	domainerr "clinic-vet-api/app/modules/core/error"
+)
+
+type WaitlistErrorCode string
+
+const (
+	WaitlistInvalidEntry   WaitlistErrorCode = "WAITLIST_INVALID_ENTRY"
+	WaitlistCannotOffer    WaitlistErrorCode = "WAITLIST_CANNOT_OFFER"
+	WaitlistCannotClaim    WaitlistErrorCode = "WAITLIST_CANNOT_CLAIM"
+	WaitlistCannotCancel   WaitlistErrorCode = "WAITLIST_CANNOT_CANCEL"
+	WaitlistOfferExpired   WaitlistErrorCode = "WAITLIST_OFFER_EXPIRED"
+	WaitlistInvalidToken   WaitlistErrorCode = "WAITLIST_INVALID_TOKEN"
+	WaitlistTokenGenFailed WaitlistErrorCode = "WAITLIST_TOKEN_GENERATION_FAILED"
+)
+
+func waitlistValidationError(ctx context.Context, code WaitlistErrorCode, field, message, operation string) error {
+	return domainerr.ValidationError(ctx, string(code), "waitlist", field,
+		fmt.Sprintf("Waitlist %s: %s", field, message), operation)
+}
+
+func waitlistBusinessError(ctx context.Context, rule, operation string) error {
+	return domainerr.BusinessRuleError(ctx, rule, "waitlist", "status", operation)
+}
+
+func InvalidEntryError(ctx context.Context, field, message, operation string) error {
+	return waitlistValidationError(ctx, WaitlistInvalidEntry, field, message, operation)
+}
+
+func CannotOfferError(ctx context.Context, entry WaitlistEntry, operation string) error {
+	return waitlistBusinessError(ctx, fmt.Sprintf("a slot cannot be offered to a %s entry", entry.status), operation)
+}
+
+func CannotClaimError(ctx context.Context, entry WaitlistEntry, operation string) error {
+	return waitlistBusinessError(ctx, fmt.Sprintf("a %s entry has no slot to claim", entry.status), operation)
+}
+
+func CannotCancelError(ctx context.Context, entry WaitlistEntry, operation string) error {
+	return waitlistBusinessError(ctx, fmt.Sprintf("a %s entry cannot be cancelled", entry.status), operation)
+}
+
+func CannotMissOfferError(ctx context.Context, entry WaitlistEntry, operation string) error {
+	return waitlistBusinessError(ctx, fmt.Sprintf("a %s entry has no offer to miss", entry.status), operation)
+}
+
+func OfferExpiredError(ctx context.Context, operation string) error {
+	return waitlistBusinessError(ctx, "the slot offer has expired", operation)
+}
+
+func OfferNoLongerAvailableError(ctx context.Context, operation string) error {
+	return waitlistBusinessError(ctx, "the slot offer is no longer available", operation)
+}
+
+func DayNotFullError(ctx context.Context, operation string) error {
+	return waitlistBusinessError(ctx, "the requested day still has free slots for the service, book one of them instead", operation)
+}
+
+func InvalidClaimTokenError(ctx context.Context, operation string) error {
+	return waitlistValidationError(ctx, WaitlistInvalidToken, "claim_token", "the claim token is not valid", operation)
+}
+
+func TokenGenerationError(ctx context.Context, err error, operation string) error {
+	return waitlistValidationError(ctx, WaitlistTokenGenFailed, "claim_token", err.Error(), operation)
+}
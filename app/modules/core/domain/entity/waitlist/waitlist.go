@@ -0,0 +1,129 @@
+// Package waitlist defines the WaitlistEntry entity used to offer freed appointment slots
+package waitlist
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/base"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// Slot is an appointment slot freed by a cancellation or a reschedule
+type Slot struct {
+	Start      time.Time
+	Service    enum.ClinicService
+	EmployeeID *valueobject.EmployeeID
+}
+
+// WaitlistEntry is a customer waiting for a slot of a service on a given date, optionally
+// with a preferred employee
+type WaitlistEntry struct {
+	base.Entity[valueobject.WaitlistID]
+	customerID          valueobject.CustomerID
+	petID               valueobject.PetID
+	service             enum.ClinicService
+	requestedDate       time.Time
+	preferredEmployeeID *valueobject.EmployeeID
+	notes               *string
+	status              enum.WaitlistStatus
+
+	offeredSlot       *time.Time
+	offeredEmployeeID *valueobject.EmployeeID
+	claimTokenHash    *string
+	offerExpiresAt    *time.Time
+	appointmentID     *valueobject.AppointmentID
+	missedOffers      int
+}
+
+type WaitlistEntryBuilder struct{ entry *WaitlistEntry }
+
+func NewWaitlistEntryBuilder() *WaitlistEntryBuilder {
+	return &WaitlistEntryBuilder{entry: &WaitlistEntry{status: enum.WaitlistStatusWaiting}}
+}
+
+func (b *WaitlistEntryBuilder) WithID(id valueobject.WaitlistID) *WaitlistEntryBuilder {
+	b.entry.SetID(id)
+	return b
+}
+
+func (b *WaitlistEntryBuilder) WithCustomerID(customerID valueobject.CustomerID) *WaitlistEntryBuilder {
+	b.entry.customerID = customerID
+	return b
+}
+
+func (b *WaitlistEntryBuilder) WithPetID(petID valueobject.PetID) *WaitlistEntryBuilder {
+	b.entry.petID = petID
+	return b
+}
+
+func (b *WaitlistEntryBuilder) WithService(service enum.ClinicService) *WaitlistEntryBuilder {
+	b.entry.service = service
+	return b
+}
+
+func (b *WaitlistEntryBuilder) WithRequestedDate(date time.Time) *WaitlistEntryBuilder {
+	b.entry.requestedDate = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	return b
+}
+
+func (b *WaitlistEntryBuilder) WithPreferredEmployeeID(employeeID *valueobject.EmployeeID) *WaitlistEntryBuilder {
+	b.entry.preferredEmployeeID = employeeID
+	return b
+}
+
+func (b *WaitlistEntryBuilder) WithNotes(notes *string) *WaitlistEntryBuilder {
+	b.entry.notes = notes
+	return b
+}
+
+func (b *WaitlistEntryBuilder) WithStatus(status enum.WaitlistStatus) *WaitlistEntryBuilder {
+	b.entry.status = status
+	return b
+}
+
+func (b *WaitlistEntryBuilder) WithOffer(
+	slot *time.Time,
+	employeeID *valueobject.EmployeeID,
+	claimTokenHash *string,
+	expiresAt *time.Time,
+) *WaitlistEntryBuilder {
+	b.entry.offeredSlot = slot
+	b.entry.offeredEmployeeID = employeeID
+	b.entry.claimTokenHash = claimTokenHash
+	b.entry.offerExpiresAt = expiresAt
+	return b
+}
+
+func (b *WaitlistEntryBuilder) WithAppointmentID(appointmentID *valueobject.AppointmentID) *WaitlistEntryBuilder {
+	b.entry.appointmentID = appointmentID
+	return b
+}
+
+func (b *WaitlistEntryBuilder) WithMissedOffers(missedOffers int) *WaitlistEntryBuilder {
+	b.entry.missedOffers = missedOffers
+	return b
+}
+
+func (b *WaitlistEntryBuilder) WithTimestamps(createdAt, updatedAt time.Time) *WaitlistEntryBuilder {
+	b.entry.SetTimeStamps(createdAt, updatedAt)
+	return b
+}
+
+func (b *WaitlistEntryBuilder) Build() *WaitlistEntry {
+	return b.entry
+}
+
+func (w *WaitlistEntry) CustomerID() valueobject.CustomerID           { return w.customerID }
+func (w *WaitlistEntry) PetID() valueobject.PetID                     { return w.petID }
+func (w *WaitlistEntry) Service() enum.ClinicService                  { return w.service }
+func (w *WaitlistEntry) RequestedDate() time.Time                     { return w.requestedDate }
+func (w *WaitlistEntry) PreferredEmployeeID() *valueobject.EmployeeID { return w.preferredEmployeeID }
+func (w *WaitlistEntry) Notes() *string                               { return w.notes }
+func (w *WaitlistEntry) Status() enum.WaitlistStatus                  { return w.status }
+func (w *WaitlistEntry) OfferedSlot() *time.Time                      { return w.offeredSlot }
+func (w *WaitlistEntry) OfferedEmployeeID() *valueobject.EmployeeID   { return w.offeredEmployeeID }
+func (w *WaitlistEntry) ClaimTokenHash() *string                      { return w.claimTokenHash }
+func (w *WaitlistEntry) OfferExpiresAt() *time.Time                   { return w.offerExpiresAt }
+func (w *WaitlistEntry) AppointmentID() *valueobject.AppointmentID    { return w.appointmentID }
+func (w *WaitlistEntry) MissedOffers() int                            { return w.missedOffers }
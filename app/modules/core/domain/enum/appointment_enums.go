@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 )
 
 func InvalidEnumParserError(enumName, enumValue string) error {
@@ -258,6 +259,20 @@ func (cs ClinicService) DefaultDuration() int {
 	return 30 // default duration in minutes
 }
 
+// Duration returns how long the service blocks the employee and the pet
+func (cs ClinicService) Duration() time.Duration {
+	return time.Duration(cs.DefaultDuration()) * time.Minute
+}
+
+// LongestClinicServiceDuration returns the duration of the longest service offered
+func LongestClinicServiceDuration() time.Duration {
+	longest := 0
+	for _, duration := range clinicServiceDurations {
+		longest = max(longest, duration)
+	}
+	return time.Duration(longest) * time.Minute
+}
+
 func (cs ClinicService) IsMedicalService() bool {
 	medicalServices := []ClinicService{
 		ClinicServiceGeneralConsultation,
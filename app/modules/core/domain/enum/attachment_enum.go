@@ -0,0 +1,67 @@
+package enum
+
+// AttachmentCategory tells what kind of file is attached to the medical record
+type AttachmentCategory string
+
+const (
+	AttachmentCategoryXRay     AttachmentCategory = "xray"
+	AttachmentCategoryPhoto    AttachmentCategory = "photo"
+	AttachmentCategoryDocument AttachmentCategory = "document"
+	AttachmentCategoryOther    AttachmentCategory = "other"
+)
+
+var (
+	ValidAttachmentCategories = []AttachmentCategory{
+		AttachmentCategoryXRay,
+		AttachmentCategoryPhoto,
+		AttachmentCategoryDocument,
+		AttachmentCategoryOther,
+	}
+
+	attachmentCategoryMap = map[string]AttachmentCategory{
+		"xray":       AttachmentCategoryXRay,
+		"x-ray":      AttachmentCategoryXRay,
+		"x_ray":      AttachmentCategoryXRay,
+		"radiograph": AttachmentCategoryXRay,
+		"photo":      AttachmentCategoryPhoto,
+		"image":      AttachmentCategoryPhoto,
+		"document":   AttachmentCategoryDocument,
+		"doc":        AttachmentCategoryDocument,
+		"other":      AttachmentCategoryOther,
+	}
+
+	attachmentCategoryDisplayNames = map[AttachmentCategory]string{
+		AttachmentCategoryXRay:     "X-Ray",
+		AttachmentCategoryPhoto:    "Photo",
+		AttachmentCategoryDocument: "Document",
+		AttachmentCategoryOther:    "Other",
+	}
+)
+
+func (ac AttachmentCategory) IsValid() bool {
+	_, exists := attachmentCategoryDisplayNames[ac]
+	return exists
+}
+
+func ParseAttachmentCategory(category string) (AttachmentCategory, error) {
+	normalized := normalizeInput(category)
+	if val, exists := attachmentCategoryMap[normalized]; exists {
+		return val, nil
+	}
+	return "", InvalidEnumParserError("AttachmentCategory", category)
+}
+
+func (ac AttachmentCategory) String() string {
+	return string(ac)
+}
+
+func (ac AttachmentCategory) DisplayName() string {
+	if displayName, exists := attachmentCategoryDisplayNames[ac]; exists {
+		return displayName
+	}
+	return "Unknown Category"
+}
+
+func (ac AttachmentCategory) Values() []AttachmentCategory {
+	return ValidAttachmentCategories
+}
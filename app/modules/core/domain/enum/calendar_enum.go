@@ -0,0 +1,58 @@
+package enum
+
+// ClosureType represents why the clinic is closed on a given date
+type ClosureType string
+
+const (
+	ClosureTypePublicHoliday ClosureType = "public_holiday"
+	ClosureTypeAdHoc         ClosureType = "ad_hoc"
+)
+
+var (
+	ValidClosureTypes = []ClosureType{
+		ClosureTypePublicHoliday,
+		ClosureTypeAdHoc,
+	}
+
+	closureTypeMap = map[string]ClosureType{
+		"public_holiday": ClosureTypePublicHoliday,
+		"public holiday": ClosureTypePublicHoliday,
+		"holiday":        ClosureTypePublicHoliday,
+		"ad_hoc":         ClosureTypeAdHoc,
+		"ad hoc":         ClosureTypeAdHoc,
+		"closure":        ClosureTypeAdHoc,
+	}
+
+	closureTypeDisplayNames = map[ClosureType]string{
+		ClosureTypePublicHoliday: "Public Holiday",
+		ClosureTypeAdHoc:         "Ad-hoc Closure",
+	}
+)
+
+func (ct ClosureType) IsValid() bool {
+	_, exists := closureTypeDisplayNames[ct]
+	return exists
+}
+
+func ParseClosureType(closureType string) (ClosureType, error) {
+	normalized := normalizeInput(closureType)
+	if val, exists := closureTypeMap[normalized]; exists {
+		return val, nil
+	}
+	return "", InvalidEnumParserError("ClosureType", closureType)
+}
+
+func (ct ClosureType) String() string {
+	return string(ct)
+}
+
+func (ct ClosureType) DisplayName() string {
+	if displayName, exists := closureTypeDisplayNames[ct]; exists {
+		return displayName
+	}
+	return "Unknown Closure Type"
+}
+
+func (ct ClosureType) Values() []ClosureType {
+	return ValidClosureTypes
+}
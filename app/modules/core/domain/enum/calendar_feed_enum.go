@@ -0,0 +1,56 @@
+package enum
+
+// CalendarFeedOwner is the kind of account a calendar feed publishes the appointments of
+type CalendarFeedOwner string
+
+const (
+	CalendarFeedOwnerEmployee CalendarFeedOwner = "employee"
+	CalendarFeedOwnerCustomer CalendarFeedOwner = "customer"
+)
+
+var (
+	ValidCalendarFeedOwners = []CalendarFeedOwner{
+		CalendarFeedOwnerEmployee,
+		CalendarFeedOwnerCustomer,
+	}
+
+	calendarFeedOwnerMap = map[string]CalendarFeedOwner{
+		"employee":     CalendarFeedOwnerEmployee,
+		"veterinarian": CalendarFeedOwnerEmployee,
+		"customer":     CalendarFeedOwnerCustomer,
+		"owner":        CalendarFeedOwnerCustomer,
+	}
+
+	calendarFeedOwnerDisplayNames = map[CalendarFeedOwner]string{
+		CalendarFeedOwnerEmployee: "Employee",
+		CalendarFeedOwnerCustomer: "Customer",
+	}
+)
+
+func (cfo CalendarFeedOwner) IsValid() bool {
+	_, exists := calendarFeedOwnerDisplayNames[cfo]
+	return exists
+}
+
+func ParseCalendarFeedOwner(owner string) (CalendarFeedOwner, error) {
+	normalized := normalizeInput(owner)
+	if val, exists := calendarFeedOwnerMap[normalized]; exists {
+		return val, nil
+	}
+	return "", InvalidEnumParserError("CalendarFeedOwner", owner)
+}
+
+func (cfo CalendarFeedOwner) String() string {
+	return string(cfo)
+}
+
+func (cfo CalendarFeedOwner) DisplayName() string {
+	if displayName, exists := calendarFeedOwnerDisplayNames[cfo]; exists {
+		return displayName
+	}
+	return "Unknown Calendar Feed Owner"
+}
+
+func (cfo CalendarFeedOwner) Values() []CalendarFeedOwner {
+	return ValidCalendarFeedOwners
+}
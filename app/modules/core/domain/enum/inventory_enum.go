@@ -0,0 +1,162 @@
+package enum
+
+// ProductCategory groups the pharmacy and medical supply products kept in stock
+type ProductCategory string
+
+const (
+	ProductCategoryMedication ProductCategory = "medication"
+	ProductCategoryVaccine    ProductCategory = "vaccine"
+	ProductCategoryDewormer   ProductCategory = "dewormer"
+	ProductCategorySupply     ProductCategory = "supply"
+)
+
+var (
+	ValidProductCategories = []ProductCategory{
+		ProductCategoryMedication,
+		ProductCategoryVaccine,
+		ProductCategoryDewormer,
+		ProductCategorySupply,
+	}
+
+	productCategoryMap = map[string]ProductCategory{
+		"medication":       ProductCategoryMedication,
+		"drug":             ProductCategoryMedication,
+		"vaccine":          ProductCategoryVaccine,
+		"dewormer":         ProductCategoryDewormer,
+		"antiparasitic":    ProductCategoryDewormer,
+		"supply":           ProductCategorySupply,
+		"medical_supply":   ProductCategorySupply,
+		"medical_supplies": ProductCategorySupply,
+	}
+
+	productCategoryDisplayNames = map[ProductCategory]string{
+		ProductCategoryMedication: "Medication",
+		ProductCategoryVaccine:    "Vaccine",
+		ProductCategoryDewormer:   "Dewormer",
+		ProductCategorySupply:     "Medical Supply",
+	}
+)
+
+func (pc ProductCategory) IsValid() bool {
+	_, exists := productCategoryDisplayNames[pc]
+	return exists
+}
+
+func ParseProductCategory(category string) (ProductCategory, error) {
+	normalized := normalizeInput(category)
+	if val, exists := productCategoryMap[normalized]; exists {
+		return val, nil
+	}
+	return "", InvalidEnumParserError("ProductCategory", category)
+}
+
+func (pc ProductCategory) String() string {
+	return string(pc)
+}
+
+func (pc ProductCategory) DisplayName() string {
+	if displayName, exists := productCategoryDisplayNames[pc]; exists {
+		return displayName
+	}
+	return "Unknown Category"
+}
+
+func (pc ProductCategory) Values() []ProductCategory {
+	return ValidProductCategories
+}
+
+// StockMovementType is the reason the stock of a lot changed
+type StockMovementType string
+
+const (
+	StockMovementReceive  StockMovementType = "receive"
+	StockMovementDispense StockMovementType = "dispense"
+	StockMovementAdjust   StockMovementType = "adjust"
+	StockMovementWaste    StockMovementType = "waste"
+)
+
+var (
+	ValidStockMovementTypes = []StockMovementType{
+		StockMovementReceive,
+		StockMovementDispense,
+		StockMovementAdjust,
+		StockMovementWaste,
+	}
+
+	stockMovementTypeMap = map[string]StockMovementType{
+		"receive":    StockMovementReceive,
+		"received":   StockMovementReceive,
+		"dispense":   StockMovementDispense,
+		"dispensed":  StockMovementDispense,
+		"adjust":     StockMovementAdjust,
+		"adjustment": StockMovementAdjust,
+		"waste":      StockMovementWaste,
+		"wasted":     StockMovementWaste,
+	}
+
+	stockMovementTypeDisplayNames = map[StockMovementType]string{
+		StockMovementReceive:  "Received",
+		StockMovementDispense: "Dispensed",
+		StockMovementAdjust:   "Adjusted",
+		StockMovementWaste:    "Wasted",
+	}
+)
+
+func (smt StockMovementType) IsValid() bool {
+	_, exists := stockMovementTypeDisplayNames[smt]
+	return exists
+}
+
+func ParseStockMovementType(movementType string) (StockMovementType, error) {
+	normalized := normalizeInput(movementType)
+	if val, exists := stockMovementTypeMap[normalized]; exists {
+		return val, nil
+	}
+	return "", InvalidEnumParserError("StockMovementType", movementType)
+}
+
+func (smt StockMovementType) String() string {
+	return string(smt)
+}
+
+func (smt StockMovementType) DisplayName() string {
+	if displayName, exists := stockMovementTypeDisplayNames[smt]; exists {
+		return displayName
+	}
+	return "Unknown Movement"
+}
+
+func (smt StockMovementType) Values() []StockMovementType {
+	return ValidStockMovementTypes
+}
+
+// StockReferenceType is the clinical record a dispensed unit was used for
+type StockReferenceType string
+
+const (
+	StockReferenceVaccination  StockReferenceType = "vaccination"
+	StockReferenceDeworming    StockReferenceType = "deworming"
+	StockReferencePrescription StockReferenceType = "prescription"
+)
+
+var stockReferenceTypeDisplayNames = map[StockReferenceType]string{
+	StockReferenceVaccination:  "Vaccination",
+	StockReferenceDeworming:    "Deworming",
+	StockReferencePrescription: "Prescription",
+}
+
+func (srt StockReferenceType) IsValid() bool {
+	_, exists := stockReferenceTypeDisplayNames[srt]
+	return exists
+}
+
+func (srt StockReferenceType) String() string {
+	return string(srt)
+}
+
+func (srt StockReferenceType) DisplayName() string {
+	if displayName, exists := stockReferenceTypeDisplayNames[srt]; exists {
+		return displayName
+	}
+	return "Unknown Reference"
+}
@@ -0,0 +1,136 @@
+package enum
+
+// LabOrderStatus tells how far the laboratory got with an order
+type LabOrderStatus string
+
+const (
+	LabOrderStatusOrdered   LabOrderStatus = "ordered"
+	LabOrderStatusPartial   LabOrderStatus = "partial"
+	LabOrderStatusCompleted LabOrderStatus = "completed"
+	LabOrderStatusCancelled LabOrderStatus = "cancelled"
+)
+
+var (
+	ValidLabOrderStatuses = []LabOrderStatus{
+		LabOrderStatusOrdered,
+		LabOrderStatusPartial,
+		LabOrderStatusCompleted,
+		LabOrderStatusCancelled,
+	}
+
+	labOrderStatusMap = map[string]LabOrderStatus{
+		"ordered":   LabOrderStatusOrdered,
+		"pending":   LabOrderStatusOrdered,
+		"partial":   LabOrderStatusPartial,
+		"completed": LabOrderStatusCompleted,
+		"final":     LabOrderStatusCompleted,
+		"cancelled": LabOrderStatusCancelled,
+		"canceled":  LabOrderStatusCancelled,
+	}
+
+	labOrderStatusDisplayNames = map[LabOrderStatus]string{
+		LabOrderStatusOrdered:   "Ordered",
+		LabOrderStatusPartial:   "Partial Results",
+		LabOrderStatusCompleted: "Completed",
+		LabOrderStatusCancelled: "Cancelled",
+	}
+)
+
+func (ls LabOrderStatus) IsValid() bool {
+	_, exists := labOrderStatusDisplayNames[ls]
+	return exists
+}
+
+func ParseLabOrderStatus(status string) (LabOrderStatus, error) {
+	normalized := normalizeInput(status)
+	if val, exists := labOrderStatusMap[normalized]; exists {
+		return val, nil
+	}
+	return "", InvalidEnumParserError("LabOrderStatus", status)
+}
+
+func (ls LabOrderStatus) String() string {
+	return string(ls)
+}
+
+func (ls LabOrderStatus) DisplayName() string {
+	if displayName, exists := labOrderStatusDisplayNames[ls]; exists {
+		return displayName
+	}
+	return "Unknown Status"
+}
+
+func (ls LabOrderStatus) Values() []LabOrderStatus {
+	return ValidLabOrderStatuses
+}
+
+// AcceptsResults tells whether results can still be recorded on the order
+func (ls LabOrderStatus) AcceptsResults() bool {
+	return ls == LabOrderStatusOrdered || ls == LabOrderStatusPartial
+}
+
+// LabResultFlag tells how a lab result compares with its reference range. Qualitative results
+// out of normal, such as a positive test, are abnormal
+type LabResultFlag string
+
+const (
+	LabResultFlagLow      LabResultFlag = "low"
+	LabResultFlagNormal   LabResultFlag = "normal"
+	LabResultFlagHigh     LabResultFlag = "high"
+	LabResultFlagAbnormal LabResultFlag = "abnormal"
+)
+
+var (
+	ValidLabResultFlags = []LabResultFlag{
+		LabResultFlagLow,
+		LabResultFlagNormal,
+		LabResultFlagHigh,
+		LabResultFlagAbnormal,
+	}
+
+	labResultFlagMap = map[string]LabResultFlag{
+		"low":      LabResultFlagLow,
+		"l":        LabResultFlagLow,
+		"normal":   LabResultFlagNormal,
+		"n":        LabResultFlagNormal,
+		"high":     LabResultFlagHigh,
+		"h":        LabResultFlagHigh,
+		"abnormal": LabResultFlagAbnormal,
+		"a":        LabResultFlagAbnormal,
+	}
+
+	labResultFlagDisplayNames = map[LabResultFlag]string{
+		LabResultFlagLow:      "Low",
+		LabResultFlagNormal:   "Normal",
+		LabResultFlagHigh:     "High",
+		LabResultFlagAbnormal: "Abnormal",
+	}
+)
+
+func (lf LabResultFlag) IsValid() bool {
+	_, exists := labResultFlagDisplayNames[lf]
+	return exists
+}
+
+func ParseLabResultFlag(flag string) (LabResultFlag, error) {
+	normalized := normalizeInput(flag)
+	if val, exists := labResultFlagMap[normalized]; exists {
+		return val, nil
+	}
+	return "", InvalidEnumParserError("LabResultFlag", flag)
+}
+
+func (lf LabResultFlag) String() string {
+	return string(lf)
+}
+
+func (lf LabResultFlag) DisplayName() string {
+	if displayName, exists := labResultFlagDisplayNames[lf]; exists {
+		return displayName
+	}
+	return "Unknown Flag"
+}
+
+func (lf LabResultFlag) IsAbnormal() bool {
+	return lf != LabResultFlagNormal
+}
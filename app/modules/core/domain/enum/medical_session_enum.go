@@ -235,6 +235,61 @@ func (pc PetCondition) RequiresImmediateAttention() bool {
 }
 
 // Utility functions
+// MedSessionStatus tells whether a medical session is still being filled in or final
+type MedSessionStatus string
+
+const (
+	MedSessionStatusDraft  MedSessionStatus = "draft"
+	MedSessionStatusClosed MedSessionStatus = "closed"
+)
+
+var (
+	ValidMedSessionStatuses = []MedSessionStatus{
+		MedSessionStatusDraft,
+		MedSessionStatusClosed,
+	}
+
+	medSessionStatusMap = map[string]MedSessionStatus{
+		"draft":  MedSessionStatusDraft,
+		"open":   MedSessionStatusDraft,
+		"closed": MedSessionStatusClosed,
+		"final":  MedSessionStatusClosed,
+	}
+
+	medSessionStatusDisplayNames = map[MedSessionStatus]string{
+		MedSessionStatusDraft:  "Draft",
+		MedSessionStatusClosed: "Closed",
+	}
+)
+
+func (ms MedSessionStatus) IsValid() bool {
+	_, exists := medSessionStatusDisplayNames[ms]
+	return exists
+}
+
+func ParseMedSessionStatus(status string) (MedSessionStatus, error) {
+	normalized := normalizeInput(status)
+	if val, exists := medSessionStatusMap[normalized]; exists {
+		return val, nil
+	}
+	return "", InvalidEnumParserError("MedSessionStatus", status)
+}
+
+func (ms MedSessionStatus) String() string {
+	return string(ms)
+}
+
+func (ms MedSessionStatus) DisplayName() string {
+	if displayName, exists := medSessionStatusDisplayNames[ms]; exists {
+		return displayName
+	}
+	return "Unknown Session Status"
+}
+
+func (ms MedSessionStatus) Values() []MedSessionStatus {
+	return ValidMedSessionStatuses
+}
+
 func normalizeInput(input string) string {
 	input = strings.TrimSpace(strings.ToLower(input))
 	input = strings.ReplaceAll(input, " ", "_")
@@ -248,3 +303,55 @@ func GetAllVisitTypes() []VisitType {
 func GetAllPetConditions() []PetCondition {
 	return ValidPetConditions
 }
+
+// VitalFlag tells whether a vital taken in a session falls below, within or above the normal
+// range of the pet's species and life stage
+type VitalFlag string
+
+const (
+	VitalFlagLow    VitalFlag = "low"
+	VitalFlagNormal VitalFlag = "normal"
+	VitalFlagHigh   VitalFlag = "high"
+)
+
+var (
+	ValidVitalFlags = []VitalFlag{
+		VitalFlagLow,
+		VitalFlagNormal,
+		VitalFlagHigh,
+	}
+
+	vitalFlagDisplayNames = map[VitalFlag]string{
+		VitalFlagLow:    "Below Normal",
+		VitalFlagNormal: "Normal",
+		VitalFlagHigh:   "Above Normal",
+	}
+)
+
+func (vf VitalFlag) IsValid() bool {
+	_, exists := vitalFlagDisplayNames[vf]
+	return exists
+}
+
+func ParseVitalFlag(flag string) (VitalFlag, error) {
+	normalized := VitalFlag(normalizeInput(flag))
+	if normalized.IsValid() {
+		return normalized, nil
+	}
+	return "", InvalidEnumParserError("VitalFlag", flag)
+}
+
+func (vf VitalFlag) String() string {
+	return string(vf)
+}
+
+func (vf VitalFlag) DisplayName() string {
+	if displayName, exists := vitalFlagDisplayNames[vf]; exists {
+		return displayName
+	}
+	return "Unknown Vital Flag"
+}
+
+func (vf VitalFlag) IsAbnormal() bool {
+	return vf == VitalFlagLow || vf == VitalFlagHigh
+}
@@ -18,6 +18,7 @@ const (
 	NotificationTypeAppointmentConfirm NotificationType = "appointment_confirm"
 	NotificationTypeAppointmentRemind  NotificationType = "appointment_remind"
 	NotificationTypeAppointmentCancel  NotificationType = "appointment_cancel"
+	NotificationTypeWaitlistOffer      NotificationType = "waitlist_offer"
 	NotificationTypePaymentReceipt     NotificationType = "payment_receipt"
 	NotificationTypePaymentReminder    NotificationType = "payment_reminder"
 	NotificationTypeWelcome            NotificationType = "welcome"
@@ -39,6 +40,7 @@ var (
 		NotificationTypeAppointmentConfirm,
 		NotificationTypeAppointmentRemind,
 		NotificationTypeAppointmentCancel,
+		NotificationTypeWaitlistOffer,
 		NotificationTypePaymentReceipt,
 		NotificationTypePaymentReminder,
 		NotificationTypeWelcome,
@@ -70,6 +72,8 @@ var (
 		"appointment_cancel":  NotificationTypeAppointmentCancel,
 		"appointment cancel":  NotificationTypeAppointmentCancel,
 		"cancel":              NotificationTypeAppointmentCancel,
+		"waitlist_offer":      NotificationTypeWaitlistOffer,
+		"waitlist offer":      NotificationTypeWaitlistOffer,
 		"payment_receipt":     NotificationTypePaymentReceipt,
 		"payment receipt":     NotificationTypePaymentReceipt,
 		"receipt":             NotificationTypePaymentReceipt,
@@ -92,6 +96,7 @@ var (
 		NotificationTypeAppointmentConfirm: "Appointment Confirmation",
 		NotificationTypeAppointmentRemind:  "Appointment Reminder",
 		NotificationTypeAppointmentCancel:  "Appointment Cancellation",
+		NotificationTypeWaitlistOffer:      "Waitlist Slot Offer",
 		NotificationTypePaymentReceipt:     "Payment Receipt",
 		NotificationTypePaymentReminder:    "Payment Reminder",
 		NotificationTypeWelcome:            "Welcome Message",
@@ -110,6 +115,7 @@ var (
 		NotificationTypeAppointmentConfirm: "appointment",
 		NotificationTypeAppointmentRemind:  "appointment",
 		NotificationTypeAppointmentCancel:  "appointment",
+		NotificationTypeWaitlistOffer:      "appointment",
 		NotificationTypePaymentReceipt:     "financial",
 		NotificationTypePaymentReminder:    "financial",
 		NotificationTypeWelcome:            "onboarding",
@@ -125,6 +131,7 @@ var (
 		NotificationTypeActivationToken:    2,
 		NotificationTypePasswordReset:      2,
 		NotificationTypeAppointmentCancel:  2,
+		NotificationTypeWaitlistOffer:      2,
 		NotificationTypePaymentReminder:    3,
 		NotificationTypeAppointmentRemind:  3,
 		NotificationTypeAppointmentConfirm: 4,
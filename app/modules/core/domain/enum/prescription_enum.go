@@ -0,0 +1,141 @@
+package enum
+
+// MedicationRoute is the way a prescribed drug is given to the pet
+type MedicationRoute string
+
+const (
+	MedicationRouteOral          MedicationRoute = "oral"
+	MedicationRouteTopical       MedicationRoute = "topical"
+	MedicationRouteSubcutaneous  MedicationRoute = "subcutaneous"
+	MedicationRouteIntramuscular MedicationRoute = "intramuscular"
+	MedicationRouteIntravenous   MedicationRoute = "intravenous"
+	MedicationRouteOphthalmic    MedicationRoute = "ophthalmic"
+	MedicationRouteOtic          MedicationRoute = "otic"
+	MedicationRouteInhaled       MedicationRoute = "inhaled"
+	MedicationRouteRectal        MedicationRoute = "rectal"
+)
+
+var (
+	ValidMedicationRoutes = []MedicationRoute{
+		MedicationRouteOral,
+		MedicationRouteTopical,
+		MedicationRouteSubcutaneous,
+		MedicationRouteIntramuscular,
+		MedicationRouteIntravenous,
+		MedicationRouteOphthalmic,
+		MedicationRouteOtic,
+		MedicationRouteInhaled,
+		MedicationRouteRectal,
+	}
+
+	medicationRouteMap = map[string]MedicationRoute{
+		"oral":          MedicationRouteOral,
+		"po":            MedicationRouteOral,
+		"topical":       MedicationRouteTopical,
+		"subcutaneous":  MedicationRouteSubcutaneous,
+		"sc":            MedicationRouteSubcutaneous,
+		"sq":            MedicationRouteSubcutaneous,
+		"intramuscular": MedicationRouteIntramuscular,
+		"im":            MedicationRouteIntramuscular,
+		"intravenous":   MedicationRouteIntravenous,
+		"iv":            MedicationRouteIntravenous,
+		"ophthalmic":    MedicationRouteOphthalmic,
+		"otic":          MedicationRouteOtic,
+		"inhaled":       MedicationRouteInhaled,
+		"rectal":        MedicationRouteRectal,
+	}
+
+	medicationRouteDisplayNames = map[MedicationRoute]string{
+		MedicationRouteOral:          "Oral",
+		MedicationRouteTopical:       "Topical",
+		MedicationRouteSubcutaneous:  "Subcutaneous Injection",
+		MedicationRouteIntramuscular: "Intramuscular Injection",
+		MedicationRouteIntravenous:   "Intravenous",
+		MedicationRouteOphthalmic:    "Ophthalmic",
+		MedicationRouteOtic:          "Otic",
+		MedicationRouteInhaled:       "Inhaled",
+		MedicationRouteRectal:        "Rectal",
+	}
+)
+
+func (mr MedicationRoute) IsValid() bool {
+	_, exists := medicationRouteDisplayNames[mr]
+	return exists
+}
+
+func ParseMedicationRoute(route string) (MedicationRoute, error) {
+	normalized := normalizeInput(route)
+	if val, exists := medicationRouteMap[normalized]; exists {
+		return val, nil
+	}
+	return "", InvalidEnumParserError("MedicationRoute", route)
+}
+
+func (mr MedicationRoute) String() string {
+	return string(mr)
+}
+
+func (mr MedicationRoute) DisplayName() string {
+	if displayName, exists := medicationRouteDisplayNames[mr]; exists {
+		return displayName
+	}
+	return "Unknown Route"
+}
+
+func (mr MedicationRoute) Values() []MedicationRoute {
+	return ValidMedicationRoutes
+}
+
+// PrescriptionStatus tells whether a prescription can still be dispensed
+type PrescriptionStatus string
+
+const (
+	PrescriptionStatusActive    PrescriptionStatus = "active"
+	PrescriptionStatusCancelled PrescriptionStatus = "cancelled"
+)
+
+var (
+	ValidPrescriptionStatuses = []PrescriptionStatus{
+		PrescriptionStatusActive,
+		PrescriptionStatusCancelled,
+	}
+
+	prescriptionStatusMap = map[string]PrescriptionStatus{
+		"active":    PrescriptionStatusActive,
+		"cancelled": PrescriptionStatusCancelled,
+		"canceled":  PrescriptionStatusCancelled,
+	}
+
+	prescriptionStatusDisplayNames = map[PrescriptionStatus]string{
+		PrescriptionStatusActive:    "Active",
+		PrescriptionStatusCancelled: "Cancelled",
+	}
+)
+
+func (ps PrescriptionStatus) IsValid() bool {
+	_, exists := prescriptionStatusDisplayNames[ps]
+	return exists
+}
+
+func ParsePrescriptionStatus(status string) (PrescriptionStatus, error) {
+	normalized := normalizeInput(status)
+	if val, exists := prescriptionStatusMap[normalized]; exists {
+		return val, nil
+	}
+	return "", InvalidEnumParserError("PrescriptionStatus", status)
+}
+
+func (ps PrescriptionStatus) String() string {
+	return string(ps)
+}
+
+func (ps PrescriptionStatus) DisplayName() string {
+	if displayName, exists := prescriptionStatusDisplayNames[ps]; exists {
+		return displayName
+	}
+	return "Unknown Status"
+}
+
+func (ps PrescriptionStatus) Values() []PrescriptionStatus {
+	return ValidPrescriptionStatuses
+}
@@ -0,0 +1,112 @@
+package enum
+
+// RecurrenceFrequency represents how often an appointment series repeats
+type RecurrenceFrequency string
+
+const (
+	RecurrenceFrequencyWeekly  RecurrenceFrequency = "weekly"
+	RecurrenceFrequencyMonthly RecurrenceFrequency = "monthly"
+)
+
+var (
+	ValidRecurrenceFrequencies = []RecurrenceFrequency{
+		RecurrenceFrequencyWeekly,
+		RecurrenceFrequencyMonthly,
+	}
+
+	recurrenceFrequencyMap = map[string]RecurrenceFrequency{
+		"weekly":  RecurrenceFrequencyWeekly,
+		"week":    RecurrenceFrequencyWeekly,
+		"monthly": RecurrenceFrequencyMonthly,
+		"month":   RecurrenceFrequencyMonthly,
+	}
+
+	recurrenceFrequencyDisplayNames = map[RecurrenceFrequency]string{
+		RecurrenceFrequencyWeekly:  "Weekly",
+		RecurrenceFrequencyMonthly: "Monthly",
+	}
+)
+
+func (rf RecurrenceFrequency) IsValid() bool {
+	_, exists := recurrenceFrequencyDisplayNames[rf]
+	return exists
+}
+
+func ParseRecurrenceFrequency(frequency string) (RecurrenceFrequency, error) {
+	normalized := normalizeInput(frequency)
+	if val, exists := recurrenceFrequencyMap[normalized]; exists {
+		return val, nil
+	}
+	return "", InvalidEnumParserError("RecurrenceFrequency", frequency)
+}
+
+func (rf RecurrenceFrequency) String() string {
+	return string(rf)
+}
+
+func (rf RecurrenceFrequency) DisplayName() string {
+	if displayName, exists := recurrenceFrequencyDisplayNames[rf]; exists {
+		return displayName
+	}
+	return "Unknown Frequency"
+}
+
+func (rf RecurrenceFrequency) Values() []RecurrenceFrequency {
+	return ValidRecurrenceFrequencies
+}
+
+// SeriesScope selects which occurrences of an appointment series a change applies to
+type SeriesScope string
+
+const (
+	SeriesScopeOccurrence SeriesScope = "occurrence"
+	SeriesScopeFollowing  SeriesScope = "following"
+)
+
+var (
+	ValidSeriesScopes = []SeriesScope{
+		SeriesScopeOccurrence,
+		SeriesScopeFollowing,
+	}
+
+	seriesScopeMap = map[string]SeriesScope{
+		"occurrence": SeriesScopeOccurrence,
+		"single":     SeriesScopeOccurrence,
+		"this":       SeriesScopeOccurrence,
+		"following":  SeriesScopeFollowing,
+		"rest":       SeriesScopeFollowing,
+	}
+
+	seriesScopeDisplayNames = map[SeriesScope]string{
+		SeriesScopeOccurrence: "This Occurrence",
+		SeriesScopeFollowing:  "This and Following Occurrences",
+	}
+)
+
+func (ss SeriesScope) IsValid() bool {
+	_, exists := seriesScopeDisplayNames[ss]
+	return exists
+}
+
+func ParseSeriesScope(scope string) (SeriesScope, error) {
+	normalized := normalizeInput(scope)
+	if val, exists := seriesScopeMap[normalized]; exists {
+		return val, nil
+	}
+	return "", InvalidEnumParserError("SeriesScope", scope)
+}
+
+func (ss SeriesScope) String() string {
+	return string(ss)
+}
+
+func (ss SeriesScope) DisplayName() string {
+	if displayName, exists := seriesScopeDisplayNames[ss]; exists {
+		return displayName
+	}
+	return "Unknown Scope"
+}
+
+func (ss SeriesScope) Values() []SeriesScope {
+	return ValidSeriesScopes
+}
@@ -0,0 +1,100 @@
+package enum
+
+// ResourceKind separates the rooms of the clinic from its movable equipment
+type ResourceKind string
+
+const (
+	ResourceKindRoom      ResourceKind = "room"
+	ResourceKindEquipment ResourceKind = "equipment"
+)
+
+func (rk ResourceKind) String() string {
+	return string(rk)
+}
+
+// ResourceType is the kind of room or equipment a service can require
+type ResourceType string
+
+const (
+	ResourceTypeExamRoom          ResourceType = "exam_room"
+	ResourceTypeSurgeryTheatre    ResourceType = "surgery_theatre"
+	ResourceTypeXRayRoom          ResourceType = "xray_room"
+	ResourceTypeDentalSuite       ResourceType = "dental_suite"
+	ResourceTypeAnesthesiaMachine ResourceType = "anesthesia_machine"
+	ResourceTypeUltrasoundMachine ResourceType = "ultrasound_machine"
+)
+
+var (
+	ValidResourceTypes = []ResourceType{
+		ResourceTypeExamRoom,
+		ResourceTypeSurgeryTheatre,
+		ResourceTypeXRayRoom,
+		ResourceTypeDentalSuite,
+		ResourceTypeAnesthesiaMachine,
+		ResourceTypeUltrasoundMachine,
+	}
+
+	resourceTypeMap = map[string]ResourceType{
+		"exam_room":          ResourceTypeExamRoom,
+		"surgery_theatre":    ResourceTypeSurgeryTheatre,
+		"surgery_theater":    ResourceTypeSurgeryTheatre,
+		"operating_room":     ResourceTypeSurgeryTheatre,
+		"xray_room":          ResourceTypeXRayRoom,
+		"x-ray_room":         ResourceTypeXRayRoom,
+		"dental_suite":       ResourceTypeDentalSuite,
+		"anesthesia_machine": ResourceTypeAnesthesiaMachine,
+		"ultrasound_machine": ResourceTypeUltrasoundMachine,
+		"ultrasound":         ResourceTypeUltrasoundMachine,
+	}
+
+	resourceTypeDisplayNames = map[ResourceType]string{
+		ResourceTypeExamRoom:          "Exam Room",
+		ResourceTypeSurgeryTheatre:    "Surgery Theatre",
+		ResourceTypeXRayRoom:          "X-Ray Room",
+		ResourceTypeDentalSuite:       "Dental Suite",
+		ResourceTypeAnesthesiaMachine: "Anesthesia Machine",
+		ResourceTypeUltrasoundMachine: "Ultrasound Machine",
+	}
+
+	resourceTypeKinds = map[ResourceType]ResourceKind{
+		ResourceTypeExamRoom:          ResourceKindRoom,
+		ResourceTypeSurgeryTheatre:    ResourceKindRoom,
+		ResourceTypeXRayRoom:          ResourceKindRoom,
+		ResourceTypeDentalSuite:       ResourceKindRoom,
+		ResourceTypeAnesthesiaMachine: ResourceKindEquipment,
+		ResourceTypeUltrasoundMachine: ResourceKindEquipment,
+	}
+)
+
+func (rt ResourceType) IsValid() bool {
+	_, exists := resourceTypeDisplayNames[rt]
+	return exists
+}
+
+func ParseResourceType(resourceType string) (ResourceType, error) {
+	normalized := normalizeInput(resourceType)
+	if val, exists := resourceTypeMap[normalized]; exists {
+		return val, nil
+	}
+	return "", InvalidEnumParserError("ResourceType", resourceType)
+}
+
+func (rt ResourceType) String() string {
+	return string(rt)
+}
+
+func (rt ResourceType) DisplayName() string {
+	if displayName, exists := resourceTypeDisplayNames[rt]; exists {
+		return displayName
+	}
+	return "Unknown Resource Type"
+}
+
+func (rt ResourceType) Values() []ResourceType {
+	return ValidResourceTypes
+}
+
+// Kind tells whether the resource is a room or a piece of equipment
+func (rt ResourceType) Kind() ResourceKind {
+	return resourceTypeKinds[rt]
+}
@@ -0,0 +1,133 @@
+package enum
+
+// ScheduleExceptionType is the reason an employee works outside their weekly schedule on given dates
+type ScheduleExceptionType string
+
+const (
+	ScheduleExceptionVacation   ScheduleExceptionType = "vacation"
+	ScheduleExceptionSickLeave  ScheduleExceptionType = "sick_leave"
+	ScheduleExceptionConference ScheduleExceptionType = "conference"
+	ScheduleExceptionExtraShift ScheduleExceptionType = "extra_shift"
+)
+
+var (
+	ValidScheduleExceptionTypes = []ScheduleExceptionType{
+		ScheduleExceptionVacation,
+		ScheduleExceptionSickLeave,
+		ScheduleExceptionConference,
+		ScheduleExceptionExtraShift,
+	}
+
+	scheduleExceptionTypeMap = map[string]ScheduleExceptionType{
+		"vacation":    ScheduleExceptionVacation,
+		"holiday":     ScheduleExceptionVacation,
+		"sick_leave":  ScheduleExceptionSickLeave,
+		"sick":        ScheduleExceptionSickLeave,
+		"conference":  ScheduleExceptionConference,
+		"extra_shift": ScheduleExceptionExtraShift,
+		"extra":       ScheduleExceptionExtraShift,
+	}
+
+	scheduleExceptionTypeDisplayNames = map[ScheduleExceptionType]string{
+		ScheduleExceptionVacation:   "Vacation",
+		ScheduleExceptionSickLeave:  "Sick Leave",
+		ScheduleExceptionConference: "Conference",
+		ScheduleExceptionExtraShift: "Extra Shift",
+	}
+)
+
+func (st ScheduleExceptionType) IsValid() bool {
+	_, exists := scheduleExceptionTypeDisplayNames[st]
+	return exists
+}
+
+func ParseScheduleExceptionType(exceptionType string) (ScheduleExceptionType, error) {
+	normalized := normalizeInput(exceptionType)
+	if val, exists := scheduleExceptionTypeMap[normalized]; exists {
+		return val, nil
+	}
+	return "", InvalidEnumParserError("ScheduleExceptionType", exceptionType)
+}
+
+func (st ScheduleExceptionType) String() string {
+	return string(st)
+}
+
+func (st ScheduleExceptionType) DisplayName() string {
+	if displayName, exists := scheduleExceptionTypeDisplayNames[st]; exists {
+		return displayName
+	}
+	return "Unknown Schedule Exception"
+}
+
+func (st ScheduleExceptionType) Values() []ScheduleExceptionType {
+	return ValidScheduleExceptionTypes
+}
+
+// IsTimeOff tells whether the employee is away during the exception, extra shifts are the
+// only exception adding working time
+func (st ScheduleExceptionType) IsTimeOff() bool {
+	return st != ScheduleExceptionExtraShift
+}
+
+// ScheduleExceptionStatus represents the review of a schedule exception by a manager
+type ScheduleExceptionStatus string
+
+const (
+	ScheduleExceptionStatusPending   ScheduleExceptionStatus = "pending"
+	ScheduleExceptionStatusApproved  ScheduleExceptionStatus = "approved"
+	ScheduleExceptionStatusRejected  ScheduleExceptionStatus = "rejected"
+	ScheduleExceptionStatusCancelled ScheduleExceptionStatus = "cancelled"
+)
+
+var (
+	ValidScheduleExceptionStatuses = []ScheduleExceptionStatus{
+		ScheduleExceptionStatusPending,
+		ScheduleExceptionStatusApproved,
+		ScheduleExceptionStatusRejected,
+		ScheduleExceptionStatusCancelled,
+	}
+
+	scheduleExceptionStatusMap = map[string]ScheduleExceptionStatus{
+		"pending":   ScheduleExceptionStatusPending,
+		"approved":  ScheduleExceptionStatusApproved,
+		"rejected":  ScheduleExceptionStatusRejected,
+		"cancelled": ScheduleExceptionStatusCancelled,
+		"canceled":  ScheduleExceptionStatusCancelled,
+	}
+
+	scheduleExceptionStatusDisplayNames = map[ScheduleExceptionStatus]string{
+		ScheduleExceptionStatusPending:   "Pending Approval",
+		ScheduleExceptionStatusApproved:  "Approved",
+		ScheduleExceptionStatusRejected:  "Rejected",
+		ScheduleExceptionStatusCancelled: "Cancelled",
+	}
+)
+
+func (ss ScheduleExceptionStatus) IsValid() bool {
+	_, exists := scheduleExceptionStatusDisplayNames[ss]
+	return exists
+}
+
+func ParseScheduleExceptionStatus(status string) (ScheduleExceptionStatus, error) {
+	normalized := normalizeInput(status)
+	if val, exists := scheduleExceptionStatusMap[normalized]; exists {
+		return val, nil
+	}
+	return "", InvalidEnumParserError("ScheduleExceptionStatus", status)
+}
+
+func (ss ScheduleExceptionStatus) String() string {
+	return string(ss)
+}
+
+func (ss ScheduleExceptionStatus) DisplayName() string {
+	if displayName, exists := scheduleExceptionStatusDisplayNames[ss]; exists {
+		return displayName
+	}
+	return "Unknown Status"
+}
+
+func (ss ScheduleExceptionStatus) Values() []ScheduleExceptionStatus {
+	return ValidScheduleExceptionStatuses
+}
@@ -0,0 +1,81 @@
+package enum
+
+// TriagePriority is how urgently an emergency or walk-in patient has to be seen, from
+// critical (seen immediately) to non-urgent (seen when a veterinarian is free)
+type TriagePriority string
+
+const (
+	TriagePriorityCritical   TriagePriority = "critical"
+	TriagePriorityUrgent     TriagePriority = "urgent"
+	TriagePrioritySemiUrgent TriagePriority = "semi_urgent"
+	TriagePriorityNonUrgent  TriagePriority = "non_urgent"
+)
+
+var (
+	ValidTriagePriorities = []TriagePriority{
+		TriagePriorityCritical,
+		TriagePriorityUrgent,
+		TriagePrioritySemiUrgent,
+		TriagePriorityNonUrgent,
+	}
+
+	triagePriorityMap = map[string]TriagePriority{
+		"critical":    TriagePriorityCritical,
+		"immediate":   TriagePriorityCritical,
+		"urgent":      TriagePriorityUrgent,
+		"semi_urgent": TriagePrioritySemiUrgent,
+		"semi-urgent": TriagePrioritySemiUrgent,
+		"non_urgent":  TriagePriorityNonUrgent,
+		"non-urgent":  TriagePriorityNonUrgent,
+	}
+
+	triagePriorityDisplayNames = map[TriagePriority]string{
+		TriagePriorityCritical:   "Critical",
+		TriagePriorityUrgent:     "Urgent",
+		TriagePrioritySemiUrgent: "Semi-Urgent",
+		TriagePriorityNonUrgent:  "Non-Urgent",
+	}
+
+	triagePriorityRanks = map[TriagePriority]int{
+		TriagePriorityCritical:   1,
+		TriagePriorityUrgent:     2,
+		TriagePrioritySemiUrgent: 3,
+		TriagePriorityNonUrgent:  4,
+	}
+)
+
+func (tp TriagePriority) IsValid() bool {
+	_, exists := triagePriorityDisplayNames[tp]
+	return exists
+}
+
+func ParseTriagePriority(priority string) (TriagePriority, error) {
+	normalized := normalizeInput(priority)
+	if val, exists := triagePriorityMap[normalized]; exists {
+		return val, nil
+	}
+	return "", InvalidEnumParserError("TriagePriority", priority)
+}
+
+func (tp TriagePriority) String() string {
+	return string(tp)
+}
+
+func (tp TriagePriority) DisplayName() string {
+	if displayName, exists := triagePriorityDisplayNames[tp]; exists {
+		return displayName
+	}
+	return "Unknown Triage Priority"
+}
+
+func (tp TriagePriority) Values() []TriagePriority {
+	return ValidTriagePriorities
+}
+
+// Rank orders the priorities, 1 being the most urgent. Unknown priorities rank last
+func (tp TriagePriority) Rank() int {
+	if rank, exists := triagePriorityRanks[tp]; exists {
+		return rank
+	}
+	return len(triagePriorityRanks) + 1
+}
@@ -0,0 +1,62 @@
+package enum
+
+// VisitStage tracks a patient inside the clinic once the appointment is confirmed, alongside
+// the AppointmentStatus
+type VisitStage string
+
+const (
+	VisitStageCheckedIn        VisitStage = "checked_in"
+	VisitStageInExamRoom       VisitStage = "in_exam_room"
+	VisitStageReadyForCheckout VisitStage = "ready_for_checkout"
+)
+
+var (
+	ValidVisitStages = []VisitStage{
+		VisitStageCheckedIn,
+		VisitStageInExamRoom,
+		VisitStageReadyForCheckout,
+	}
+
+	visitStageMap = map[string]VisitStage{
+		"checked_in":         VisitStageCheckedIn,
+		"check_in":           VisitStageCheckedIn,
+		"in_exam_room":       VisitStageInExamRoom,
+		"exam_room":          VisitStageInExamRoom,
+		"ready_for_checkout": VisitStageReadyForCheckout,
+		"checkout":           VisitStageReadyForCheckout,
+	}
+
+	visitStageDisplayNames = map[VisitStage]string{
+		VisitStageCheckedIn:        "Checked In",
+		VisitStageInExamRoom:       "In Exam Room",
+		VisitStageReadyForCheckout: "Ready for Checkout",
+	}
+)
+
+func (vs VisitStage) IsValid() bool {
+	_, exists := visitStageDisplayNames[vs]
+	return exists
+}
+
+func ParseVisitStage(stage string) (VisitStage, error) {
+	normalized := normalizeInput(stage)
+	if val, exists := visitStageMap[normalized]; exists {
+		return val, nil
+	}
+	return "", InvalidEnumParserError("VisitStage", stage)
+}
+
+func (vs VisitStage) String() string {
+	return string(vs)
+}
+
+func (vs VisitStage) DisplayName() string {
+	if displayName, exists := visitStageDisplayNames[vs]; exists {
+		return displayName
+	}
+	return "Unknown Visit Stage"
+}
+
+func (vs VisitStage) Values() []VisitStage {
+	return ValidVisitStages
+}
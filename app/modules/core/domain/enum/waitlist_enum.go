@@ -0,0 +1,72 @@
+package enum
+
+// WaitlistStatus represents the lifecycle of a waitlist entry
+type WaitlistStatus string
+
+const (
+	WaitlistStatusWaiting   WaitlistStatus = "waiting"
+	WaitlistStatusOffered   WaitlistStatus = "offered"
+	WaitlistStatusClaimed   WaitlistStatus = "claimed"
+	WaitlistStatusExpired   WaitlistStatus = "expired"
+	WaitlistStatusCancelled WaitlistStatus = "cancelled"
+)
+
+var (
+	ValidWaitlistStatuses = []WaitlistStatus{
+		WaitlistStatusWaiting,
+		WaitlistStatusOffered,
+		WaitlistStatusClaimed,
+		WaitlistStatusExpired,
+		WaitlistStatusCancelled,
+	}
+
+	waitlistStatusMap = map[string]WaitlistStatus{
+		"waiting":   WaitlistStatusWaiting,
+		"offered":   WaitlistStatusOffered,
+		"claimed":   WaitlistStatusClaimed,
+		"expired":   WaitlistStatusExpired,
+		"cancelled": WaitlistStatusCancelled,
+		"canceled":  WaitlistStatusCancelled,
+	}
+
+	waitlistStatusDisplayNames = map[WaitlistStatus]string{
+		WaitlistStatusWaiting:   "Waiting",
+		WaitlistStatusOffered:   "Slot Offered",
+		WaitlistStatusClaimed:   "Claimed",
+		WaitlistStatusExpired:   "Offer Expired",
+		WaitlistStatusCancelled: "Cancelled",
+	}
+)
+
+func (ws WaitlistStatus) IsValid() bool {
+	_, exists := waitlistStatusDisplayNames[ws]
+	return exists
+}
+
+func ParseWaitlistStatus(status string) (WaitlistStatus, error) {
+	normalized := normalizeInput(status)
+	if val, exists := waitlistStatusMap[normalized]; exists {
+		return val, nil
+	}
+	return "", InvalidEnumParserError("WaitlistStatus", status)
+}
+
+func (ws WaitlistStatus) String() string {
+	return string(ws)
+}
+
+func (ws WaitlistStatus) DisplayName() string {
+	if displayName, exists := waitlistStatusDisplayNames[ws]; exists {
+		return displayName
+	}
+	return "Unknown Waitlist Status"
+}
+
+// IsActive reports whether the entry still takes part in the waitlist
+func (ws WaitlistStatus) IsActive() bool {
+	return ws == WaitlistStatusWaiting || ws == WaitlistStatusOffered
+}
+
+func (ws WaitlistStatus) Values() []WaitlistStatus {
+	return ValidWaitlistStatuses
+}
@@ -0,0 +1,111 @@
+package valueobject
+
+import "math"
+
+// DoseRange is the dose of a drug for a species in mg per kg of body weight, optionally capped by
+// a maximum dose in mg per administration however heavy the pet is
+type DoseRange struct {
+	species    string
+	minMgPerKg float64
+	maxMgPerKg float64
+	maxDoseMg  *float64
+}
+
+func NewDoseRange(species string, minMgPerKg, maxMgPerKg float64) DoseRange {
+	return DoseRange{
+		species:    species,
+		minMgPerKg: minMgPerKg,
+		maxMgPerKg: maxMgPerKg,
+	}
+}
+
+func (dr DoseRange) Species() string     { return dr.species }
+func (dr DoseRange) MinMgPerKg() float64 { return dr.minMgPerKg }
+func (dr DoseRange) MaxMgPerKg() float64 { return dr.maxMgPerKg }
+func (dr DoseRange) MaxDoseMg() *float64 { return dr.maxDoseMg }
+
+func (dr DoseRange) WithMaxDose(maxDoseMg float64) DoseRange {
+	dr.maxDoseMg = &maxDoseMg
+	return dr
+}
+
+// ForWeight returns the dose range in mg for a pet of the weight, both ends capped by the maximum
+// dose. Doses are rounded to two decimals
+func (dr DoseRange) ForWeight(weightKg float64) (minMg, maxMg float64) {
+	minMg = dr.minMgPerKg * weightKg
+	maxMg = dr.maxMgPerKg * weightKg
+	if dr.maxDoseMg != nil {
+		minMg = math.Min(minMg, *dr.maxDoseMg)
+		maxMg = math.Min(maxMg, *dr.maxDoseMg)
+	}
+	return roundDose(minMg), roundDose(maxMg)
+}
+
+func roundDose(mg float64) float64 {
+	return math.Round(mg*100) / 100
+}
+
+type DrugDefinition struct {
+	name                   string
+	drugClass              string
+	description            string
+	doseRanges             []DoseRange
+	contraindic            []string
+	contraindicatedSpecies []string
+}
+
+func NewDrugDefinition(name, drugClass string, doseRanges []DoseRange) DrugDefinition {
+	return DrugDefinition{
+		name:       name,
+		drugClass:  drugClass,
+		doseRanges: doseRanges,
+	}
+}
+
+func (dd DrugDefinition) Name() string                     { return dd.name }
+func (dd DrugDefinition) DrugClass() string                { return dd.drugClass }
+func (dd DrugDefinition) Description() string              { return dd.description }
+func (dd DrugDefinition) DoseRanges() []DoseRange          { return dd.doseRanges }
+func (dd DrugDefinition) Contraindications() []string      { return dd.contraindic }
+func (dd DrugDefinition) ContraindicatedSpecies() []string { return dd.contraindicatedSpecies }
+
+func (dd DrugDefinition) WithDescription(desc string) DrugDefinition {
+	dd.description = desc
+	return dd
+}
+
+func (dd DrugDefinition) WithContraindications(contraindic []string) DrugDefinition {
+	dd.contraindic = contraindic
+	return dd
+}
+
+// WithContraindicatedSpecies marks the species the drug must never be given to, usually because
+// it is toxic to them
+func (dd DrugDefinition) WithContraindicatedSpecies(species []string) DrugDefinition {
+	dd.contraindicatedSpecies = species
+	return dd
+}
+
+// DoseRangeFor returns the dose range of the species, if the formulary has one
+func (dd DrugDefinition) DoseRangeFor(species string) (DoseRange, bool) {
+	for _, doseRange := range dd.doseRanges {
+		if doseRange.species == species {
+			return doseRange, true
+		}
+	}
+	return DoseRange{}, false
+}
+
+func (dd DrugDefinition) IsApplicableForSpecies(species string) bool {
+	_, found := dd.DoseRangeFor(species)
+	return found && !dd.IsContraindicatedFor(species)
+}
+
+func (dd DrugDefinition) IsContraindicatedFor(species string) bool {
+	for _, s := range dd.contraindicatedSpecies {
+		if s == species {
+			return true
+		}
+	}
+	return false
+}
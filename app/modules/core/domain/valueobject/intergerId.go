@@ -39,15 +39,30 @@ func (id baseID) IsZero() bool {
 }
 
 type (
-	PaymentID     struct{ baseID }
-	EmployeeID    struct{ baseID }
-	PetID         struct{ baseID }
-	AppointmentID struct{ baseID }
-	UserID        struct{ baseID }
-	CustomerID    struct{ baseID }
-	MedSessionID  struct{ baseID }
-	VaccinationID struct{ baseID }
-	DewormID      struct{ baseID }
+	PaymentID       struct{ baseID }
+	EmployeeID      struct{ baseID }
+	PetID           struct{ baseID }
+	AppointmentID   struct{ baseID }
+	UserID          struct{ baseID }
+	CustomerID      struct{ baseID }
+	MedSessionID    struct{ baseID }
+	VaccinationID   struct{ baseID }
+	DewormID        struct{ baseID }
+	ClosureID       struct{ baseID }
+	WaitlistID      struct{ baseID }
+	ApptSeriesID    struct{ baseID }
+	CalendarFeedID  struct{ baseID }
+	ResourceID      struct{ baseID }
+	ScheduleExcID   struct{ baseID }
+	OnCallShiftID   struct{ baseID }
+	PrescriptionID  struct{ baseID }
+	ProductID       struct{ baseID }
+	StockLocationID struct{ baseID }
+	StockLotID      struct{ baseID }
+	StockMovementID struct{ baseID }
+	LabOrderID      struct{ baseID }
+	LabResultID     struct{ baseID }
+	AttachmentID    struct{ baseID }
 )
 
 func NewPetID(value uint) PetID {
@@ -86,6 +101,66 @@ func NewDewormID(value uint) DewormID {
 	return DewormID{baseID{value}}
 }
 
+func NewClosureID(value uint) ClosureID {
+	return ClosureID{baseID{value}}
+}
+
+func NewWaitlistID(value uint) WaitlistID {
+	return WaitlistID{baseID{value}}
+}
+
+func NewApptSeriesID(value uint) ApptSeriesID {
+	return ApptSeriesID{baseID{value}}
+}
+
+func NewCalendarFeedID(value uint) CalendarFeedID {
+	return CalendarFeedID{baseID{value}}
+}
+
+func NewResourceID(value uint) ResourceID {
+	return ResourceID{baseID{value}}
+}
+
+func NewScheduleExcID(value uint) ScheduleExcID {
+	return ScheduleExcID{baseID{value}}
+}
+
+func NewOnCallShiftID(value uint) OnCallShiftID {
+	return OnCallShiftID{baseID{value}}
+}
+
+func NewPrescriptionID(value uint) PrescriptionID {
+	return PrescriptionID{baseID{value}}
+}
+
+func NewProductID(value uint) ProductID {
+	return ProductID{baseID{value}}
+}
+
+func NewStockLocationID(value uint) StockLocationID {
+	return StockLocationID{baseID{value}}
+}
+
+func NewStockLotID(value uint) StockLotID {
+	return StockLotID{baseID{value}}
+}
+
+func NewStockMovementID(value uint) StockMovementID {
+	return StockMovementID{baseID{value}}
+}
+
+func NewLabOrderID(value uint) LabOrderID {
+	return LabOrderID{baseID{value}}
+}
+
+func NewLabResultID(value uint) LabResultID {
+	return LabResultID{baseID{value}}
+}
+
+func NewAttachmentID(value uint) AttachmentID {
+	return AttachmentID{baseID{value}}
+}
+
 func NewOptEmployeeID(value *uint) *EmployeeID {
 	if value == nil {
 		return nil
@@ -155,6 +230,14 @@ func NewOptDewormID(value *uint) *DewormID {
 	return &id
 }
 
+func NewOptStockLotID(value *uint) *StockLotID {
+	if value == nil {
+		return nil
+	}
+	id := NewStockLotID(*value)
+	return &id
+}
+
 func OptEmployeeIDToUint(id *EmployeeID) *uint {
 	if id == nil {
 		return nil
@@ -0,0 +1,102 @@
+package valueobject
+
+import "clinic-vet-api/app/modules/core/domain/enum"
+
+// LabReferenceRange is the interval a lab result is expected in, both ends included. Either end
+// may be open, as for analytes only reported when too high
+type LabReferenceRange struct {
+	low  *float64
+	high *float64
+}
+
+func NewLabReferenceRange(low, high *float64) LabReferenceRange {
+	return LabReferenceRange{low: low, high: high}
+}
+
+func NewClosedLabReferenceRange(low, high float64) LabReferenceRange {
+	return LabReferenceRange{low: &low, high: &high}
+}
+
+func (lr LabReferenceRange) Low() *float64  { return lr.low }
+func (lr LabReferenceRange) High() *float64 { return lr.high }
+func (lr LabReferenceRange) IsEmpty() bool  { return lr.low == nil && lr.high == nil }
+
+// Classify flags the value against the range, nil is returned when the range is empty
+func (lr LabReferenceRange) Classify(value float64) *enum.LabResultFlag {
+	if lr.IsEmpty() {
+		return nil
+	}
+
+	flag := enum.LabResultFlagNormal
+	switch {
+	case lr.low != nil && value < *lr.low:
+		flag = enum.LabResultFlagLow
+	case lr.high != nil && value > *lr.high:
+		flag = enum.LabResultFlagHigh
+	}
+	return &flag
+}
+
+// LabAnalyteDefinition is a substance or property measured by a lab, with its unit and the
+// reference range of each species
+type LabAnalyteDefinition struct {
+	code   string
+	name   string
+	unit   string
+	ranges map[enum.PetSpecies]LabReferenceRange
+}
+
+func NewLabAnalyteDefinition(code, name, unit string) LabAnalyteDefinition {
+	return LabAnalyteDefinition{
+		code:   code,
+		name:   name,
+		unit:   unit,
+		ranges: make(map[enum.PetSpecies]LabReferenceRange),
+	}
+}
+
+func (ad LabAnalyteDefinition) Code() string { return ad.code }
+func (ad LabAnalyteDefinition) Name() string { return ad.name }
+func (ad LabAnalyteDefinition) Unit() string { return ad.unit }
+
+func (ad LabAnalyteDefinition) Ranges() map[enum.PetSpecies]LabReferenceRange { return ad.ranges }
+
+func (ad LabAnalyteDefinition) WithRange(species enum.PetSpecies, low, high float64) LabAnalyteDefinition {
+	ad.ranges[species] = NewClosedLabReferenceRange(low, high)
+	return ad
+}
+
+// RangeFor returns the reference range of the species, false when the analyte has none for it
+func (ad LabAnalyteDefinition) RangeFor(species enum.PetSpecies) (LabReferenceRange, bool) {
+	r, exists := ad.ranges[species]
+	return r, exists
+}
+
+// LabPanelDefinition is a group of analytes ordered together from a single specimen
+type LabPanelDefinition struct {
+	code        string
+	name        string
+	specimen    string
+	description string
+	analytes    []LabAnalyteDefinition
+}
+
+func NewLabPanelDefinition(code, name, specimen string, analytes []LabAnalyteDefinition) LabPanelDefinition {
+	return LabPanelDefinition{
+		code:     code,
+		name:     name,
+		specimen: specimen,
+		analytes: analytes,
+	}
+}
+
+func (pd LabPanelDefinition) Code() string                     { return pd.code }
+func (pd LabPanelDefinition) Name() string                     { return pd.name }
+func (pd LabPanelDefinition) Specimen() string                 { return pd.specimen }
+func (pd LabPanelDefinition) Description() string              { return pd.description }
+func (pd LabPanelDefinition) Analytes() []LabAnalyteDefinition { return pd.analytes }
+
+func (pd LabPanelDefinition) WithDescription(desc string) LabPanelDefinition {
+	pd.description = desc
+	return pd
+}
@@ -8,6 +8,11 @@ import (
 	"time"
 )
 
+// ServiceHours exposes the hours the clinic is open, used to bound employee shifts
+type ServiceHours interface {
+	OpeningHoursFor(day time.Weekday) (openHour int, closeHour int, isOpen bool)
+}
+
 type Schedule struct {
 	WorkDays []WorkDaySchedule `json:"work_days"`
 }
@@ -26,14 +31,14 @@ func (s *Schedule) validateDaysWorked() error {
 	return nil
 }
 
-func (s *Schedule) validateHoursWorked() error {
+func (s *Schedule) validateHoursWorked(serviceHours ServiceHours) error {
 	vetDaysWorked := getWeekDayMap()
 	for _, workDay := range s.WorkDays {
 		if isDayDuplicated(workDay.Day, vetDaysWorked) {
 			return errors.New("vet cannot work the same day more than once a week")
 		}
 
-		if err := s.isValidWorkDay(workDay); err != nil {
+		if err := s.isValidWorkDay(workDay, serviceHours); err != nil {
 			return err
 		}
 
@@ -42,35 +47,39 @@ func (s *Schedule) validateHoursWorked() error {
 	return nil
 }
 
-func (s *Schedule) isValidWorkDay(workDay WorkDaySchedule) error {
+func (s *Schedule) isValidWorkDay(workDay WorkDaySchedule, serviceHours ServiceHours) error {
 	// Validar horario laboral principal
-	if err := s.isHoursWithinServiceSchedule(workDay.StartHour, workDay.EndHour); err != nil {
+	if err := s.isHoursWithinServiceSchedule(workDay.Day, workDay.StartHour, workDay.EndHour, serviceHours); err != nil {
 		return err
 	}
 
 	// Validar descansos
-	if err := s.isValidBreak(workDay.Breaks, workDay); err != nil {
+	if err := s.isValidBreak(workDay.Breaks, workDay, serviceHours); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (s *Schedule) ValidateBuissnessLogic(ctx context.Context) error {
+func (s *Schedule) ValidateBuissnessLogic(ctx context.Context, serviceHours ServiceHours) error {
 	if err := s.validateDaysWorked(); err != nil {
 		return domainerr.BusinessRuleError(ctx, "Invalid schedule (Days Worked): "+err.Error(), "Schedule", "WorkDays", "Schedule ValidateBuissnessLogic")
 	}
 
-	if err := s.validateHoursWorked(); err != nil {
+	if err := s.validateHoursWorked(serviceHours); err != nil {
 		return domainerr.BusinessRuleError(ctx, "Invalid schedule (Hours Worked): "+err.Error(), "Schedule", "WorkDays", "Schedule ValidateBuissnessLogic")
 	}
 
 	return nil
 }
 
-func (s *Schedule) isValidBreak(brk Break, workDay WorkDaySchedule) error {
+func (s *Schedule) isValidBreak(brk Break, workDay WorkDaySchedule, serviceHours ServiceHours) error {
+	if !brk.IsSet() {
+		return nil
+	}
+
 	// Validar que el descanso esté dentro del horario de la clínica
-	if err := s.isHoursWithinServiceSchedule(brk.StartHour, brk.EndHour); err != nil {
+	if err := s.isHoursWithinServiceSchedule(workDay.Day, brk.StartHour, brk.EndHour, serviceHours); err != nil {
 		return err
 	}
 
@@ -87,13 +96,18 @@ func (s *Schedule) isValidBreak(brk Break, workDay WorkDaySchedule) error {
 	return nil
 }
 
-func (s *Schedule) isHoursWithinServiceSchedule(startHour, endHour int) error {
+func (s *Schedule) isHoursWithinServiceSchedule(day time.Weekday, startHour, endHour int, serviceHours ServiceHours) error {
 	if startHour == -1 || endHour == 0 {
 		return errors.New("start hour and end hour must be provided")
 	}
 
-	if startHour < 7 || endHour > 22 {
-		return errors.New("the working hours must be between 7 AM and 10 PM")
+	openHour, closeHour, isOpen := serviceHours.OpeningHoursFor(day)
+	if !isOpen {
+		return fmt.Errorf("the clinic is closed on %s", day)
+	}
+
+	if startHour < openHour || endHour > closeHour {
+		return fmt.Errorf("the working hours on %s must be between %d:00 and %d:00", day, openHour, closeHour)
 	}
 
 	if startHour >= endHour {
@@ -102,11 +116,47 @@ func (s *Schedule) isHoursWithinServiceSchedule(startHour, endHour int) error {
 	return nil
 }
 
+// WorkDayFor returns the schedule configured for the given weekday, if any
+func (s *Schedule) WorkDayFor(day time.Weekday) (WorkDaySchedule, bool) {
+	if s == nil {
+		return WorkDaySchedule{}, false
+	}
+
+	for _, workDay := range s.WorkDays {
+		if workDay.Day == day {
+			return workDay, true
+		}
+	}
+	return WorkDaySchedule{}, false
+}
+
+// ShiftOn returns the start and end of the shift for the calendar day of date
+func (w WorkDaySchedule) ShiftOn(date time.Time) (time.Time, time.Time) {
+	start := time.Date(date.Year(), date.Month(), date.Day(), w.StartHour, 0, 0, 0, date.Location())
+	end := time.Date(date.Year(), date.Month(), date.Day(), w.EndHour, 0, 0, 0, date.Location())
+	return start, end
+}
+
+// IsWithinBreak reports whether the interval [start, end) overlaps the break of the day
+func (w WorkDaySchedule) IsWithinBreak(start, end time.Time) bool {
+	if !w.Breaks.IsSet() {
+		return false
+	}
+
+	breakStart := time.Date(start.Year(), start.Month(), start.Day(), w.Breaks.StartHour, 0, 0, 0, start.Location())
+	breakEnd := time.Date(start.Year(), start.Month(), start.Day(), w.Breaks.EndHour, 0, 0, 0, start.Location())
+	return start.Before(breakEnd) && end.After(breakStart)
+}
+
 type Break struct {
 	StartHour int `json:"start_hour"`
 	EndHour   int `json:"end_hour"`
 }
 
+func (b Break) IsSet() bool {
+	return b.EndHour > b.StartHour
+}
+
 // Métodos auxiliares (sin cambios)
 func setDaysAsWorked(dayNumber time.Weekday, vetDaysWorked map[time.Weekday]bool) {
 	vetDaysWorked[dayNumber] = true
@@ -0,0 +1,70 @@
+package valueobject
+
+import "clinic-vet-api/app/modules/core/domain/enum"
+
+// VitalRange is the normal interval of a vital, both ends included
+type VitalRange struct {
+	min float64
+	max float64
+}
+
+func NewVitalRange(min, max float64) *VitalRange {
+	return &VitalRange{min: min, max: max}
+}
+
+func (vr VitalRange) Min() float64 { return vr.min }
+func (vr VitalRange) Max() float64 { return vr.max }
+
+func (vr VitalRange) Classify(value float64) enum.VitalFlag {
+	switch {
+	case value < vr.min:
+		return enum.VitalFlagLow
+	case value > vr.max:
+		return enum.VitalFlagHigh
+	default:
+		return enum.VitalFlagNormal
+	}
+}
+
+// VitalReferenceRanges are the normal ranges of the vitals of a species at a life stage. Weight is
+// in kg, temperature in °C and heart and respiratory rates per minute. A vital without range is
+// not flagged, weight is left out for species whose breeds differ too much in size
+type VitalReferenceRanges struct {
+	species         enum.PetSpecies
+	lifeStage       string
+	weight          *VitalRange
+	temperature     *VitalRange
+	heartRate       *VitalRange
+	respiratoryRate *VitalRange
+}
+
+func NewVitalReferenceRanges(species enum.PetSpecies, lifeStage string) VitalReferenceRanges {
+	return VitalReferenceRanges{species: species, lifeStage: lifeStage}
+}
+
+func (vr VitalReferenceRanges) Species() enum.PetSpecies     { return vr.species }
+func (vr VitalReferenceRanges) LifeStage() string            { return vr.lifeStage }
+func (vr VitalReferenceRanges) Weight() *VitalRange          { return vr.weight }
+func (vr VitalReferenceRanges) Temperature() *VitalRange     { return vr.temperature }
+func (vr VitalReferenceRanges) HeartRate() *VitalRange       { return vr.heartRate }
+func (vr VitalReferenceRanges) RespiratoryRate() *VitalRange { return vr.respiratoryRate }
+
+func (vr VitalReferenceRanges) WithWeight(min, max float64) VitalReferenceRanges {
+	vr.weight = NewVitalRange(min, max)
+	return vr
+}
+
+func (vr VitalReferenceRanges) WithTemperature(min, max float64) VitalReferenceRanges {
+	vr.temperature = NewVitalRange(min, max)
+	return vr
+}
+
+func (vr VitalReferenceRanges) WithHeartRate(min, max float64) VitalReferenceRanges {
+	vr.heartRate = NewVitalRange(min, max)
+	return vr
+}
+
+func (vr VitalReferenceRanges) WithRespiratoryRate(min, max float64) VitalReferenceRanges {
+	vr.respiratoryRate = NewVitalRange(min, max)
+	return vr
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/employee"
+)
+
+// AbsenceCoverageRepository persists the time off of an absent employee together with the
+// handover of the appointments it affects
+type AbsenceCoverageRepository interface {
+	// SaveCoverage creates or approves the time off and saves the appointments with their
+	// reservations in a single transaction, so an absence is never recorded without its handover
+	SaveCoverage(ctx context.Context, timeOff *employee.ScheduleException, appointments []appointment.Appointment) error
+}
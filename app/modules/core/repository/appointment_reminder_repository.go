@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// AppointmentReminderRepository records the reminders dispatched per appointment and scheduled
+// date so that several API replicas never send the same reminder twice
+type AppointmentReminderRepository interface {
+	// Claim reserves the reminder for the dispatcher. It returns false when the reminder was
+	// already sent or another dispatcher holds a claim younger than staleAfter
+	Claim(ctx context.Context, id vo.AppointmentID, scheduledDate time.Time, staleAfter time.Duration) (bool, error)
+	MarkSent(ctx context.Context, id vo.AppointmentID, scheduledDate time.Time, channel enum.NotificationChannel) error
+	// Release drops an unsent claim so the reminder is retried on the next run
+	Release(ctx context.Context, id vo.AppointmentID, scheduledDate time.Time) error
+}
@@ -3,6 +3,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	appoint "clinic-vet-api/app/modules/core/domain/entity/appointment"
 	"clinic-vet-api/app/modules/core/domain/specification"
@@ -18,4 +19,9 @@ type AppointmentRepository interface {
 
 	Find(ctx context.Context, spec specification.ApptSearchSpecification) (p.Page[appoint.Appointment], error)
 	Count(ctx context.Context, spec specification.ApptSearchSpecification) (int64, error)
+	Stats(ctx context.Context, filter appoint.StatsFilter) (appoint.Stats, error)
+
+	// FindCheckedInBetween lists the visits checked in during the period, of a single
+	// employee when given
+	FindCheckedInBetween(ctx context.Context, start, end time.Time, employeeID *vo.EmployeeID) ([]appoint.Appointment, error)
 }
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	appoint "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type AppointmentSeriesRepository interface {
+	FindByID(ctx context.Context, id vo.ApptSeriesID) (appoint.AppointmentSeries, error)
+	// FindOccurrences returns the appointments of the series in chronological order
+	FindOccurrences(ctx context.Context, id vo.ApptSeriesID) ([]appoint.Appointment, error)
+
+	// Create persists the series together with its occurrences and their resource reservations,
+	// either all of them are saved or none
+	Create(ctx context.Context, series *appoint.AppointmentSeries, occurrences []appoint.Appointment) error
+	// SaveOccurrences updates several occurrences of a series in a single transaction
+	SaveOccurrences(ctx context.Context, occurrences []appoint.Appointment) error
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	appoint "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// AppointmentVisitRepository persists the appointments together with the medical session of
+// the visit, keeping both sides consistent
+type AppointmentVisitRepository interface {
+	// CreateWithSession saves a new appointment and opens its medical session in a single transaction
+	CreateWithSession(ctx context.Context, appointment *appoint.Appointment, session *medical.MedicalSession) error
+	// SaveAndOpenSession updates the appointment and opens the draft session unless the visit has
+	// one already, in a single transaction. Reports whether the session was opened
+	SaveAndOpenSession(ctx context.Context, appointment *appoint.Appointment, session *medical.MedicalSession) (bool, error)
+	// CloseSession closes the draft session with its follow-up date and, when given, saves its appointment
+	// and its follow-up proposal in a single transaction
+	CloseSession(ctx context.Context, session *medical.MedicalSession, appointment *appoint.Appointment, followUp *appoint.Appointment) error
+
+	// FindFollowUpID returns the appointment proposed from the follow-up date of the session, nil when none was
+	FindFollowUpID(ctx context.Context, sessionID vo.MedSessionID) (*vo.AppointmentID, error)
+	// SaveFollowUp saves the follow-up date of the session and, when given, its proposed appointment in a
+	// single transaction. New proposals are linked to the session
+	SaveFollowUp(ctx context.Context, session *medical.MedicalSession, proposal *appoint.Appointment) error
+}
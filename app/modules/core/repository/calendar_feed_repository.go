@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"clinic-vet-api/app/modules/core/domain/entity/calendarfeed"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type CalendarFeedRepository interface {
+	FindByID(ctx context.Context, id vo.CalendarFeedID) (calendarfeed.CalendarFeed, error)
+	FindActiveByOwner(ctx context.Context, ownerType enum.CalendarFeedOwner, ownerID uint) (calendarfeed.CalendarFeed, error)
+
+	// Replace revokes the active feed of the owner, if any, and stores the new one
+	Replace(ctx context.Context, feed *calendarfeed.CalendarFeed) error
+	// RevokeByOwner reports whether there was an active feed to revoke
+	RevokeByOwner(ctx context.Context, ownerType enum.CalendarFeedOwner, ownerID uint) (bool, error)
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"clinic-vet-api/app/modules/core/domain/entity/calendar"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"context"
+	"time"
+)
+
+type ClinicCalendarRepository interface {
+	// Get loads the opening hours, the booking policy and the closures of the booking window
+	Get(ctx context.Context) (calendar.ClinicCalendar, error)
+	// GetForPeriod is Get with the closures between from and to loaded as well, for checks on
+	// dates outside the booking window
+	GetForPeriod(ctx context.Context, from, to time.Time) (calendar.ClinicCalendar, error)
+
+	SaveOpeningHours(ctx context.Context, openingHours []calendar.OpeningHours) error
+	SaveBookingPolicy(ctx context.Context, policy calendar.BookingPolicy) error
+
+	FindClosures(ctx context.Context, from, to time.Time) ([]calendar.Closure, error)
+	FindClosureByID(ctx context.Context, id valueobject.ClosureID) (calendar.Closure, error)
+	SaveClosure(ctx context.Context, closure *calendar.Closure) error
+	DeleteClosure(ctx context.Context, id valueobject.ClosureID) error
+}
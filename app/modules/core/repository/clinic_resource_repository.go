@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	appoint "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/resource"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type ClinicResourceRepository interface {
+	FindByID(ctx context.Context, id vo.ResourceID) (resource.ClinicResource, error)
+	FindAll(ctx context.Context, includeInactive bool) ([]resource.ClinicResource, error)
+	ExistsByName(ctx context.Context, name string, excludeID *vo.ResourceID) (bool, error)
+	Save(ctx context.Context, clinicResource *resource.ClinicResource) error
+
+	FindRequirements(ctx context.Context, service enum.ClinicService) ([]resource.ServiceRequirement, error)
+	FindAllRequirements(ctx context.Context) ([]resource.ServiceRequirement, error)
+	// ReplaceRequirements swaps every requirement of the service in a single transaction
+	ReplaceRequirements(ctx context.Context, service enum.ClinicService, requirements []resource.ServiceRequirement) error
+
+	// FindReservations returns the reservations of the resource overlapping the range, including
+	// the ones of appointments no longer holding it
+	FindReservations(ctx context.Context, id vo.ResourceID, start, end time.Time) ([]resource.Reservation, error)
+}
+
+// AppointmentReservationRepository persists appointments together with the rooms and equipment
+// their service requires
+type AppointmentReservationRepository interface {
+	// SaveWithReservations creates or updates the appointments and reserves the resources of their
+	// time slot in a single transaction. When any resource is unavailable nothing is saved
+	SaveWithReservations(ctx context.Context, appointments []appoint.Appointment) error
+}
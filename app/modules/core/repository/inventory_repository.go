@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/inventory"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/page"
+)
+
+type InventoryRepository interface {
+	FindProductByID(ctx context.Context, id vo.ProductID) (inventory.Product, error)
+	FindProducts(ctx context.Context, includeInactive bool) ([]inventory.Product, error)
+	ExistsProductByName(ctx context.Context, name string, excludeID *vo.ProductID) (bool, error)
+	SaveProduct(ctx context.Context, product *inventory.Product) error
+
+	FindLocationByID(ctx context.Context, id vo.StockLocationID) (inventory.StockLocation, error)
+	FindLocations(ctx context.Context, includeInactive bool) ([]inventory.StockLocation, error)
+	ExistsLocationByName(ctx context.Context, name string, excludeID *vo.StockLocationID) (bool, error)
+	SaveLocation(ctx context.Context, location *inventory.StockLocation) error
+
+	FindLotByID(ctx context.Context, id vo.StockLotID) (inventory.StockLot, error)
+	// FindLotByNumber returns the lot of the product received at the location with the number,
+	// nil when it was never received there
+	FindLotByNumber(ctx context.Context, productID vo.ProductID, locationID vo.StockLocationID, lotNumber string) (*inventory.StockLot, error)
+	// FindLotsByProduct lists the lots of the product, the first to expire first
+	FindLotsByProduct(ctx context.Context, productID vo.ProductID, includeEmpty bool) ([]inventory.StockLot, error)
+	// FindExpiringLots lists the lots still holding stock that expire before the date, expired
+	// ones included, the first to expire first
+	FindExpiringLots(ctx context.Context, before time.Time) ([]inventory.StockLot, error)
+	// FindLowStock returns the active products whose stock not expired at the time is at or
+	// below their reorder level
+	FindLowStock(ctx context.Context, at time.Time) ([]inventory.StockLevel, error)
+
+	// CreateLot saves a lot received for the first time together with its receipt in a single
+	// transaction
+	CreateLot(ctx context.Context, lot *inventory.StockLot, receipt *inventory.StockMovement) (inventory.StockMovement, error)
+	// ApplyMovement locks the lot, lets change move its stock and saves the lot with the movement
+	// in a single transaction, so concurrent movements never take out more than the lot holds.
+	// When change fails nothing is saved
+	ApplyMovement(ctx context.Context, id vo.StockLotID, change func(lot *inventory.StockLot) (*inventory.StockMovement, error)) (inventory.StockMovement, error)
+	// FindMovementsByLot lists the movements of the lot, the latest first
+	FindMovementsByLot(ctx context.Context, id vo.StockLotID, pagination page.PaginationRequest) (page.Page[inventory.StockMovement], error)
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/page"
+)
+
+// LabOrderRepository stores the lab orders with their panels and results, orders are always
+// returned with both
+type LabOrderRepository interface {
+	FindByID(ctx context.Context, id vo.LabOrderID) (medical.LabOrder, error)
+	// FindBySession returns the orders placed during the session, the first ordered first
+	FindBySession(ctx context.Context, sessionID vo.MedSessionID) ([]medical.LabOrder, error)
+	// FindByPet lists every order of the pet, the latest first
+	FindByPet(ctx context.Context, petID vo.PetID, pagination page.PaginationRequest) (page.Page[medical.LabOrder], error)
+	// FindOpenByAccession returns the orders still waiting for results under the accession number,
+	// the latest first. Different laboratories may reuse a number
+	FindOpenByAccession(ctx context.Context, accessionNumber string) ([]medical.LabOrder, error)
+	// FindOpenByPet returns the orders of the pet still waiting for results, the latest first
+	FindOpenByPet(ctx context.Context, petID vo.PetID) ([]medical.LabOrder, error)
+	// FindResultsByAnalyte returns the results of the analyte for the pet observed in the range,
+	// leaving out cancelled orders
+	FindResultsByAnalyte(ctx context.Context, petID vo.PetID, analyteCode string, start, end time.Time) ([]medical.LabResult, error)
+	// Save creates or updates the order, its results are inserted or replaced by analyte
+	Save(ctx context.Context, order *medical.LabOrder) error
+}
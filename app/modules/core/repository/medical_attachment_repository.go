@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/page"
+)
+
+// MedicalAttachmentRepository stores the metadata of the files attached to medical records, the
+// files themselves are kept in the blob storage
+type MedicalAttachmentRepository interface {
+	FindByID(ctx context.Context, id vo.AttachmentID) (medical.MedicalAttachment, error)
+	// FindByPet lists the attachments of the pet, the latest first. A nil category lists them all
+	FindByPet(ctx context.Context, petID vo.PetID, category *enum.AttachmentCategory, pagination page.PaginationRequest) (page.Page[medical.MedicalAttachment], error)
+	// FindBySession returns the attachments of the session, the first uploaded first
+	FindBySession(ctx context.Context, sessionID vo.MedSessionID) ([]medical.MedicalAttachment, error)
+	Save(ctx context.Context, attachment *medical.MedicalAttachment) error
+	Delete(ctx context.Context, id vo.AttachmentID) error
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	appoint "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/inventory"
 	med "clinic-vet-api/app/modules/core/domain/entity/medical"
 	"clinic-vet-api/app/modules/core/domain/specification"
 	vo "clinic-vet-api/app/modules/core/domain/valueobject"
@@ -31,6 +33,9 @@ type MedicalSessionRepository interface {
 	ExistsByPetAndDate(ctx context.Context, petID vo.PetID, date time.Time) (bool, error)
 
 	Save(ctx context.Context, medSession *med.MedicalSession) error
+	// SaveWithFollowUp inserts a new session together with its proposed follow-up appointment in a
+	// single transaction
+	SaveWithFollowUp(ctx context.Context, medSession *med.MedicalSession, followUp *appoint.Appointment) error
 	Delete(ctx context.Context, medSessionID vo.MedSessionID, isHard bool) error
 }
 
@@ -45,6 +50,8 @@ type DewormRepository interface {
 	FindByDateRange(ctx context.Context, startDate, endDate time.Time, pagination p.PaginationRequest) (p.Page[med.PetDeworming], error)
 
 	Save(ctx context.Context, dewormation med.PetDeworming) (med.PetDeworming, error)
+	// SaveDispensed creates the deworming and takes the dose out of stock in a single transaction
+	SaveDispensed(ctx context.Context, dewormation med.PetDeworming, dispensing inventory.Dispensing) (med.PetDeworming, error)
 	Delete(ctx context.Context, dewormationID vo.DewormID, isHard bool) error
 }
 
@@ -63,5 +70,7 @@ type VaccinationRepository interface {
 	FindAllByPetID(ctx context.Context, petID vo.PetID) ([]med.PetVaccination, error)
 
 	Save(ctx context.Context, vaccination med.PetVaccination) (med.PetVaccination, error)
+	// SaveDispensed creates the vaccination and takes the dose out of stock in a single transaction
+	SaveDispensed(ctx context.Context, vaccination med.PetVaccination, dispensing inventory.Dispensing) (med.PetVaccination, error)
 	Delete(ctx context.Context, vaccinationID vo.VaccinationID) error
 }
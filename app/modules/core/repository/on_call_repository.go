@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/employee"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/shared/page"
+)
+
+type OnCallRepository interface {
+	// FindAt returns the shifts on call at the given time, one per rotation, optionally of a single specialty
+	FindAt(ctx context.Context, at time.Time, specialty *enum.VetSpecialty) ([]employee.OnCallShift, error)
+	FindByPeriod(ctx context.Context, start, end time.Time, specialty *enum.VetSpecialty, pagination page.PaginationRequest) (page.Page[employee.OnCallShift], error)
+	// FindLastBefore returns the latest shift of the specialty starting before the given time, nil when there is none
+	FindLastBefore(ctx context.Context, specialty enum.VetSpecialty, before time.Time) (*employee.OnCallShift, error)
+
+	// ReplaceRotation swaps the shifts of the specialty overlapping [start, end) for the given ones
+	ReplaceRotation(ctx context.Context, specialty enum.VetSpecialty, start, end time.Time, shifts []employee.OnCallShift) error
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/inventory"
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/page"
+)
+
+type PrescriptionRepository interface {
+	FindByID(ctx context.Context, id vo.PrescriptionID) (medical.Prescription, error)
+	// FindBySession returns the prescriptions issued during the session, the first prescribed first
+	FindBySession(ctx context.Context, sessionID vo.MedSessionID) ([]medical.Prescription, error)
+	// FindByPet lists every prescription of the pet, the latest first
+	FindByPet(ctx context.Context, petID vo.PetID, pagination page.PaginationRequest) (page.Page[medical.Prescription], error)
+	// FindActiveByPet returns the prescriptions the pet is on at the time
+	FindActiveByPet(ctx context.Context, petID vo.PetID, at time.Time) ([]medical.Prescription, error)
+	Save(ctx context.Context, prescription *medical.Prescription) error
+	// SaveDispensed creates the prescription and takes its first fill out of stock in a single
+	// transaction
+	SaveDispensed(ctx context.Context, prescription *medical.Prescription, dispensing inventory.Dispensing) error
+	// Refill saves the fill taken by Refill only while refills remain on the active prescription,
+	// so concurrent refills never dispense more fills than were authorized. The fill is taken out
+	// of stock in the same transaction when dispensing is given. Reports false when the
+	// prescription was refilled or cancelled meanwhile and nothing was saved
+	Refill(ctx context.Context, prescription *medical.Prescription, dispensing *inventory.Dispensing) (bool, error)
+}
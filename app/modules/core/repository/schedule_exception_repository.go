@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/employee"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/page"
+)
+
+type ScheduleExceptionRepository interface {
+	FindByID(ctx context.Context, id vo.ScheduleExcID) (employee.ScheduleException, error)
+	// FindByEmployee lists the exceptions of the employee, the latest first, optionally of a single status
+	FindByEmployee(ctx context.Context, employeeID vo.EmployeeID, status *enum.ScheduleExceptionStatus, pagination page.PaginationRequest) (page.Page[employee.ScheduleException], error)
+	// FindByStatus lists the exceptions of every employee in the status, the soonest first
+	FindByStatus(ctx context.Context, status enum.ScheduleExceptionStatus, pagination page.PaginationRequest) (page.Page[employee.ScheduleException], error)
+	// FindApproved returns the approved exceptions of the employee overlapping the range
+	FindApproved(ctx context.Context, employeeID vo.EmployeeID, start, end time.Time) ([]employee.ScheduleException, error)
+	Save(ctx context.Context, exception *employee.ScheduleException) error
+}
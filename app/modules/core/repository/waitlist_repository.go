@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/waitlist"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type WaitlistRepository interface {
+	FindByID(ctx context.Context, id vo.WaitlistID) (waitlist.WaitlistEntry, error)
+	FindByClaimToken(ctx context.Context, claimToken string) (waitlist.WaitlistEntry, error)
+	FindByCustomer(ctx context.Context, customerID vo.CustomerID) ([]waitlist.WaitlistEntry, error)
+
+	// FindWaitingForSlot returns the waiting entries for the slot date and service that accept
+	// the slot employee, oldest first
+	FindWaitingForSlot(ctx context.Context, slot waitlist.Slot) ([]waitlist.WaitlistEntry, error)
+	FindExpiredOffers(ctx context.Context, now time.Time) ([]waitlist.WaitlistEntry, error)
+
+	Save(ctx context.Context, entry *waitlist.WaitlistEntry) error
+	// SaveOffer stores the offer of an entry that is still waiting and reports whether this call
+	// did it, so two slots freed at once are never offered to the same entry
+	SaveOffer(ctx context.Context, entry *waitlist.WaitlistEntry) (bool, error)
+	// SaveClaim creates the appointment of a claimed offer and links it to the entry in one
+	// transaction. It reports false, creating nothing, when the offer was already claimed,
+	// cancelled or expired by someone else
+	SaveClaim(ctx context.Context, entry *waitlist.WaitlistEntry, appt *appointment.Appointment) (bool, error)
+	// SaveMissedOffer stores an offer that expired unclaimed, with the entry back to waiting or
+	// expired, and reports whether this call did it, so only one replica re-offers the slot
+	SaveMissedOffer(ctx context.Context, entry *waitlist.WaitlistEntry) (bool, error)
+}
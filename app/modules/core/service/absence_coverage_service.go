@@ -0,0 +1,218 @@
+package service
+
+import (
+	"clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/employee"
+	"clinic-vet-api/app/modules/core/domain/entity/notification"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/specification"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/log"
+	"clinic-vet-api/app/shared/page"
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// AbsenceCoverageService records the absence of an employee as approved time off and proposes
+// their confirmed appointments to the active colleagues of the same specialty who are free at the
+// time according to their schedule and its approved exceptions, cancelling the ones nobody can
+// take. Owners are notified of every change, a failed notification is logged since the
+// appointments are already saved
+type AbsenceCoverageService struct {
+	apptRepo            repository.AppointmentRepository
+	employeeRepo        repository.EmployeeRepository
+	exceptionRepo       repository.ScheduleExceptionRepository
+	coverageRepo        repository.AbsenceCoverageRepository
+	contactService      *CustomerContactService
+	notificationService NotificationService
+	rescheduleURL       string
+}
+
+func NewAbsenceCoverageService(
+	apptRepo repository.AppointmentRepository,
+	employeeRepo repository.EmployeeRepository,
+	exceptionRepo repository.ScheduleExceptionRepository,
+	coverageRepo repository.AbsenceCoverageRepository,
+	contactService *CustomerContactService,
+	notificationService NotificationService,
+	rescheduleURL string,
+) *AbsenceCoverageService {
+	return &AbsenceCoverageService{
+		apptRepo:            apptRepo,
+		employeeRepo:        employeeRepo,
+		exceptionRepo:       exceptionRepo,
+		coverageRepo:        coverageRepo,
+		contactService:      contactService,
+		notificationService: notificationService,
+		rescheduleURL:       rescheduleURL,
+	}
+}
+
+// Cover saves the absence as approved time off and, in the same transaction, proposes another
+// employee for the affected appointments or cancels them
+func (s *AbsenceCoverageService) Cover(ctx context.Context, absence appointment.Absence) (appointment.AbsenceCoverage, error) {
+	timeOff, err := absence.TimeOff(ctx, time.Now())
+	if err != nil {
+		return appointment.AbsenceCoverage{}, err
+	}
+
+	return s.cover(ctx, absence, timeOff)
+}
+
+// CoverTimeOff hands over the appointments booked during time off a manager just approved, the
+// approval is saved in the same transaction as the appointments
+func (s *AbsenceCoverageService) CoverTimeOff(ctx context.Context, timeOff *employee.ScheduleException) (appointment.AbsenceCoverage, error) {
+	return s.cover(ctx, appointment.AbsenceFromTimeOff(*timeOff), timeOff)
+}
+
+func (s *AbsenceCoverageService) cover(ctx context.Context, absence appointment.Absence, timeOff *employee.ScheduleException) (appointment.AbsenceCoverage, error) {
+	absent, err := s.employeeRepo.FindByID(ctx, absence.EmployeeID)
+	if err != nil {
+		return appointment.AbsenceCoverage{}, err
+	}
+
+	windowStart := absence.Start.Add(-enum.LongestClinicServiceDuration())
+	affected, err := s.bookedWith(ctx, absence.EmployeeID, windowStart, absence.End)
+	if err != nil {
+		return appointment.AbsenceCoverage{}, err
+	}
+
+	substitutes, err := s.substitutesOf(ctx, absent, windowStart, absence.End)
+	if err != nil {
+		return appointment.AbsenceCoverage{}, err
+	}
+
+	coverage, err := appointment.CoverAbsence(ctx, absence, affected, substitutes)
+	if err != nil {
+		return appointment.AbsenceCoverage{}, err
+	}
+
+	changed := append(append([]appointment.Appointment{}, coverage.Proposed...), coverage.Cancelled...)
+	if err := s.coverageRepo.SaveCoverage(ctx, timeOff, changed); err != nil {
+		return appointment.AbsenceCoverage{}, err
+	}
+
+	for _, appt := range coverage.Proposed {
+		s.notifyProposed(ctx, appt)
+	}
+	for _, appt := range coverage.Cancelled {
+		s.notifyCancelled(ctx, appt)
+	}
+
+	return coverage, nil
+}
+
+// substitutesOf lists the active employees sharing the specialty of the absent one that work at
+// some point, along with their calendar and the appointments booked with them in the period
+func (s *AbsenceCoverageService) substitutesOf(ctx context.Context, absent employee.Employee, start, end time.Time) ([]appointment.Substitute, error) {
+	var substitutes []appointment.Substitute
+
+	for pageNumber := int32(page.DefaultPage); ; pageNumber++ {
+		colleagues, err := s.employeeRepo.FindBySpeciality(ctx, absent.Specialty(), page.PaginationRequest{
+			Page:     pageNumber,
+			PageSize: page.MaxPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, colleague := range colleagues.Items {
+			if colleague.ID() == absent.ID() || !colleague.IsActive() {
+				continue
+			}
+
+			exceptions, err := s.exceptionRepo.FindApproved(ctx, colleague.ID(), start, end)
+			if err != nil {
+				return nil, err
+			}
+
+			workCalendar := employee.NewWorkCalendar(colleague.Schedule(), exceptions)
+			if !workCalendar.HasWorkingTime() {
+				continue
+			}
+
+			booked, err := s.bookedWith(ctx, colleague.ID(), start, end)
+			if err != nil {
+				return nil, err
+			}
+
+			substitutes = append(substitutes, appointment.Substitute{
+				EmployeeID: colleague.ID(),
+				Calendar:   workCalendar,
+				Booked:     booked,
+			})
+		}
+
+		if len(colleagues.Items) < page.MaxPageSize {
+			return substitutes, nil
+		}
+	}
+}
+
+func (s *AbsenceCoverageService) bookedWith(ctx context.Context, employeeID valueobject.EmployeeID, start, end time.Time) ([]appointment.Appointment, error) {
+	spec := specification.ApptByEmployee(employeeID).
+		And(specification.ApptByDateRange(start, end)).
+		WithPagination(specification.Pagination{Limit: math.MaxInt32})
+
+	appts, err := s.apptRepo.Find(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	return appts.Items, nil
+}
+
+func (s *AbsenceCoverageService) notifyProposed(ctx context.Context, appt appointment.Appointment) {
+	contact, err := s.contactService.Find(ctx, appt.CustomerID())
+	if err != nil {
+		log.Error("failed to find the owner to notify of the reassignment", err,
+			zap.String("appointment_id", appt.ID().String()))
+		return
+	}
+
+	vet, err := s.employeeRepo.FindByID(ctx, *appt.EmployeeID())
+	if err != nil {
+		log.Error("failed to find the veterinarian proposed for the appointment", err,
+			zap.String("appointment_id", appt.ID().String()))
+		return
+	}
+
+	notif := notification.NewReassignmentProposal(
+		contact.UserID, contact.Channel, contact.Email, contact.Phone,
+		contact.Name, appt.Service(), appt.ScheduledDate(), vet.FullName().FullName(),
+	)
+	s.send(ctx, notif, appt)
+}
+
+func (s *AbsenceCoverageService) notifyCancelled(ctx context.Context, appt appointment.Appointment) {
+	contact, err := s.contactService.Find(ctx, appt.CustomerID())
+	if err != nil {
+		log.Error("failed to find the owner to notify of the cancellation", err,
+			zap.String("appointment_id", appt.ID().String()))
+		return
+	}
+
+	notif := notification.NewAppointmentCancelledForAbsence(
+		contact.UserID, contact.Channel, contact.Email, contact.Phone,
+		contact.Name, appt.Service(), appt.ScheduledDate(), s.rescheduleLink(appt),
+	)
+	s.send(ctx, notif, appt)
+}
+
+func (s *AbsenceCoverageService) send(ctx context.Context, notif *notification.Notification, appt appointment.Appointment) {
+	if err := s.notificationService.Send(ctx, notif); err != nil {
+		log.Error("failed to notify the owner of the absence", err,
+			zap.String("appointment_id", appt.ID().String()))
+	}
+}
+
+func (s *AbsenceCoverageService) rescheduleLink(appt appointment.Appointment) string {
+	if s.rescheduleURL == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s?appointment_id=%s", s.rescheduleURL, appt.ID().String())
+}
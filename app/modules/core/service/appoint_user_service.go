@@ -131,10 +131,8 @@ func ValidateEmployeeCanAttendAppointment(ctx context.Context, employee employee
 		return nil
 	}
 
-	sessionMaxDuration := time.Duration(MAX_MEDICAL_SESSION_DURATION_MINUTES) * time.Minute
 	appointmentStart := appointment.ScheduledDate()
-
-	appointmentEnd := appointmentStart.Add(sessionMaxDuration)
+	appointmentEnd := appointment.EndDate()
 
 	if employee.IsWithinWorkdayBreak(appointmentStart, appointmentEnd) {
 		return ErrEmployeeNotAvailable(ctx, "employee is not available during the requested time")
@@ -142,10 +140,7 @@ func ValidateEmployeeCanAttendAppointment(ctx context.Context, employee employee
 	}
 
 	for _, existingAppointment := range appointmentPage.Items {
-		existingStart := existingAppointment.ScheduledDate()
-		existingEnd := existingStart.Add(sessionMaxDuration)
-
-		if hasTimeOverlap(appointmentStart, appointmentEnd, existingStart, existingEnd) {
+		if appointment.OverlapsWith(existingAppointment) {
 			return ErrEmployeeNotAvailable(ctx, "employee is not available during the requested time")
 		}
 
@@ -0,0 +1,198 @@
+package service
+
+import (
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/calendar"
+	"clinic-vet-api/app/modules/core/domain/entity/employee"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/specification"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"context"
+	"math"
+	"slices"
+	"time"
+)
+
+const MAX_AVAILABILITY_RANGE_DAYS = 31
+
+type TimeSlot struct {
+	Start time.Time
+	End   time.Time
+}
+
+type EmployeeAvailability struct {
+	EmployeeID valueobject.EmployeeID
+	Date       time.Time
+	Slots      []TimeSlot
+}
+
+// AppointmentAvailabilityService computes the free slots of an employee by combining
+// the employee weekly schedule and its approved exceptions with the appointments already booked
+type AppointmentAvailabilityService struct {
+	appointmentRepo repository.AppointmentRepository
+	exceptionRepo   repository.ScheduleExceptionRepository
+}
+
+func NewAppointmentAvailabilityService(
+	appointmentRepo repository.AppointmentRepository,
+	exceptionRepo repository.ScheduleExceptionRepository,
+) *AppointmentAvailabilityService {
+	return &AppointmentAvailabilityService{appointmentRepo: appointmentRepo, exceptionRepo: exceptionRepo}
+}
+
+// FindAvailableSlots returns, per working day in [startDate, endDate], the slots where the
+// given service fits inside the employee shifts and the clinic opening hours without touching
+// breaks, time off, closures or booked appointments. Extra shifts count as working days. The
+// range is clamped to the booking window of the clinic, so every slot listed can be booked
+func (s *AppointmentAvailabilityService) FindAvailableSlots(
+	ctx context.Context,
+	emp employee.Employee,
+	service enum.ClinicService,
+	startDate, endDate time.Time,
+	clinicCalendar calendar.ClinicCalendar,
+) ([]EmployeeAvailability, error) {
+	now := time.Now()
+	rangeStart, rangeEnd, ok := bookableRange(startDate, endDate, clinicCalendar.Policy(), now)
+	if !ok {
+		return []EmployeeAvailability{}, nil
+	}
+
+	exceptions, err := s.exceptionRepo.FindApproved(ctx, emp.ID(), rangeStart, rangeEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	workCalendar := employee.NewWorkCalendar(emp.Schedule(), exceptions)
+	if !workCalendar.HasWorkingTime() {
+		return []EmployeeAvailability{}, nil
+	}
+
+	booked, err := s.findBookedAppointments(ctx, emp.ID(), rangeStart, rangeEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	slotDuration := service.Duration()
+
+	availability := []EmployeeAvailability{}
+	for day := rangeStart; day.Before(rangeEnd); day = day.AddDate(0, 0, 1) {
+		shifts := workCalendar.ShiftsOn(day)
+		if len(shifts) == 0 {
+			continue
+		}
+
+		slots := []TimeSlot{}
+		for _, shift := range shifts {
+			for slotStart := shift.Start; !slotStart.Add(slotDuration).After(shift.End); slotStart = slotStart.Add(slotDuration) {
+				slotEnd := slotStart.Add(slotDuration)
+				if shift.IsWithinBreak(slotStart, slotEnd) || workCalendar.IsOff(slotStart, slotEnd) {
+					continue
+				}
+
+				if clinicCalendar.CheckBookingDate(slotStart, slotDuration, now) != nil {
+					continue
+				}
+
+				if overlapsAny(slotStart, slotEnd, booked) || containsSlot(slots, slotStart) {
+					continue
+				}
+
+				slots = append(slots, TimeSlot{Start: slotStart, End: slotEnd})
+			}
+		}
+		slices.SortFunc(slots, func(a, b TimeSlot) int { return a.Start.Compare(b.Start) })
+
+		availability = append(availability, EmployeeAvailability{
+			EmployeeID: emp.ID(),
+			Date:       day,
+			Slots:      slots,
+		})
+	}
+
+	return availability, nil
+}
+
+// HasAvailableSlot reports whether any of the employees still has a slot for the service on the
+// given day
+func (s *AppointmentAvailabilityService) HasAvailableSlot(
+	ctx context.Context,
+	employees []employee.Employee,
+	service enum.ClinicService,
+	day time.Time,
+	clinicCalendar calendar.ClinicCalendar,
+) (bool, error) {
+	for _, emp := range employees {
+		availability, err := s.FindAvailableSlots(ctx, emp, service, day, day, clinicCalendar)
+		if err != nil {
+			return false, err
+		}
+
+		for _, dayAvailability := range availability {
+			if len(dayAvailability.Slots) > 0 {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func (s *AppointmentAvailabilityService) findBookedAppointments(
+	ctx context.Context,
+	employeeID valueobject.EmployeeID,
+	start, end time.Time,
+) ([]appt.Appointment, error) {
+	spec := specification.ApptByEmployee(employeeID).
+		And(specification.ApptByDateRange(start, end)).
+		WithPagination(specification.Pagination{Limit: math.MaxInt32})
+
+	appointmentPage, err := s.appointmentRepo.Find(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	booked := make([]appt.Appointment, 0, len(appointmentPage.Items))
+	for _, appointment := range appointmentPage.Items {
+		if appointment.Status().IsFinalStatus() && appointment.Status() != enum.AppointmentStatusCompleted {
+			continue
+		}
+		booked = append(booked, appointment)
+	}
+
+	return booked, nil
+}
+
+func overlapsAny(start, end time.Time, appointments []appt.Appointment) bool {
+	for _, appointment := range appointments {
+		if hasTimeOverlap(start, end, appointment.ScheduledDate(), appointment.EndDate()) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsSlot tells whether a slot starting at start was already listed, shifts of the same
+// day can overlap when an extra shift extends the weekly one
+func containsSlot(slots []TimeSlot, start time.Time) bool {
+	return slices.ContainsFunc(slots, func(slot TimeSlot) bool { return slot.Start.Equal(start) })
+}
+
+// bookableRange narrows the whole days of [startDate, endDate] to the days of the booking window,
+// false when they don't meet
+func bookableRange(startDate, endDate time.Time, policy calendar.BookingPolicy, now time.Time) (time.Time, time.Time, bool) {
+	rangeStart := startOfDay(startDate)
+	if earliest := startOfDay(now.AddDate(0, 0, policy.MinLeadDays)); rangeStart.Before(earliest) {
+		rangeStart = earliest
+	}
+
+	rangeEnd := startOfDay(endDate).Add(24*time.Hour - time.Nanosecond)
+	if latest := startOfDay(now.AddDate(0, 0, policy.MaxLeadDays)).Add(24*time.Hour - time.Nanosecond); rangeEnd.After(latest) {
+		rangeEnd = latest
+	}
+
+	return rangeStart, rangeEnd, rangeStart.Before(rangeEnd)
+}
+
+func startOfDay(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+}
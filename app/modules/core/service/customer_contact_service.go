@@ -0,0 +1,58 @@
+package service
+
+import (
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"context"
+	"fmt"
+)
+
+// CustomerContact holds what is needed to notify a customer through its user account
+type CustomerContact struct {
+	UserID  valueobject.UserID
+	Name    string
+	Email   valueobject.Email
+	Phone   *valueobject.PhoneNumber
+	Channel enum.NotificationChannel
+}
+
+// CustomerContactService resolves how a customer is notified. The preferred channel falls back
+// to email when the customer prefers SMS but has no phone number
+type CustomerContactService struct {
+	customerRepo repository.CustomerRepository
+	userRepo     repository.UserRepository
+}
+
+func NewCustomerContactService(customerRepo repository.CustomerRepository, userRepo repository.UserRepository) *CustomerContactService {
+	return &CustomerContactService{customerRepo: customerRepo, userRepo: userRepo}
+}
+
+func (s *CustomerContactService) Find(ctx context.Context, customerID valueobject.CustomerID) (CustomerContact, error) {
+	customer, err := s.customerRepo.FindByID(ctx, customerID)
+	if err != nil {
+		return CustomerContact{}, err
+	}
+
+	if customer.UserID() == nil {
+		return CustomerContact{}, fmt.Errorf("customer %s has no user account to notify", customer.ID().String())
+	}
+
+	user, err := s.userRepo.FindByID(ctx, *customer.UserID())
+	if err != nil {
+		return CustomerContact{}, err
+	}
+
+	channel := customer.PreferredChannel()
+	if channel == enum.NotificationChannelSMS && user.PhoneNumber() == nil {
+		channel = enum.NotificationChannelEmail
+	}
+
+	return CustomerContact{
+		UserID:  user.ID(),
+		Name:    customer.FirstName(),
+		Email:   user.Email(),
+		Phone:   user.PhoneNumber(),
+		Channel: channel,
+	}, nil
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	domainerr "clinic-vet-api/app/modules/core/error"
+	"clinic-vet-api/app/modules/core/repository"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// weightLookupSessions is how many of the latest medical sessions are searched for a weight, the
+// weight is not recorded on every visit
+const weightLookupSessions = 10
+
+type DoseWarningCode string
+
+const (
+	DoseWarningNoWeight               DoseWarningCode = "no_weight"
+	DoseWarningNoSpeciesDose          DoseWarningCode = "no_species_dose"
+	DoseWarningSpeciesContraindicated DoseWarningCode = "species_contraindicated"
+	DoseWarningBelowRange             DoseWarningCode = "below_range"
+	DoseWarningAboveRange             DoseWarningCode = "above_range"
+	DoseWarningAboveMaxDose           DoseWarningCode = "above_max_dose"
+)
+
+type DoseWarning struct {
+	Code    DoseWarningCode
+	Message string
+}
+
+// DoseCalculation is the dose of a drug recommended for a pet. The recommended range is only
+// known when the formulary has a dose for the species and the pet was weighed
+type DoseCalculation struct {
+	Drug              valueobject.DrugDefinition
+	Species           enum.PetSpecies
+	WeightKg          *float64
+	WeighedAt         *time.Time
+	DoseRange         *valueobject.DoseRange
+	RecommendedMinMg  *float64
+	RecommendedMaxMg  *float64
+	PrescribedDoseMg  *float64
+	PrescribedMgPerKg *float64
+	Warnings          []DoseWarning
+}
+
+// DoseCalculatorService works out the dose of the formulary drugs from the weight recorded in the
+// latest medical session of the pet
+type DoseCalculatorService struct {
+	formulary   *DrugFormulary
+	petRepo     repository.PetRepository
+	sessionRepo repository.MedicalSessionRepository
+}
+
+func NewDoseCalculatorService(
+	formulary *DrugFormulary,
+	petRepo repository.PetRepository,
+	sessionRepo repository.MedicalSessionRepository,
+) *DoseCalculatorService {
+	return &DoseCalculatorService{
+		formulary:   formulary,
+		petRepo:     petRepo,
+		sessionRepo: sessionRepo,
+	}
+}
+
+// Calculate returns the dose range of the drug for the pet. When a dose in mg is given it is
+// checked against the range, a dose outside it is reported as a warning and not as an error so
+// the veterinarian can still prescribe it
+func (s *DoseCalculatorService) Calculate(ctx context.Context, petID valueobject.PetID, drugName string, doseMg *float64) (DoseCalculation, error) {
+	operation := "CalculateDose"
+
+	drug, err := s.formulary.GetDrugByName(drugName)
+	if err != nil {
+		return DoseCalculation{}, domainerr.EntityNotFoundError(ctx, "drug", drugName, operation)
+	}
+
+	pet, err := s.petRepo.FindByID(ctx, petID)
+	if err != nil {
+		return DoseCalculation{}, err
+	}
+
+	calculation := DoseCalculation{
+		Drug:             drug,
+		Species:          pet.Species(),
+		PrescribedDoseMg: doseMg,
+		Warnings:         []DoseWarning{},
+	}
+
+	if err := s.setLatestWeight(ctx, petID, &calculation); err != nil {
+		return DoseCalculation{}, err
+	}
+
+	species := pet.Species().String()
+	if drug.IsContraindicatedFor(species) {
+		calculation.addWarning(DoseWarningSpeciesContraindicated,
+			fmt.Sprintf("%s is contraindicated for %s patients", drug.Name(), strings.ToLower(pet.Species().DisplayName())))
+	}
+
+	doseRange, found := drug.DoseRangeFor(species)
+	if !found {
+		if !drug.IsContraindicatedFor(species) {
+			calculation.addWarning(DoseWarningNoSpeciesDose,
+				fmt.Sprintf("the formulary has no dose of %s for %s patients", drug.Name(), strings.ToLower(pet.Species().DisplayName())))
+		}
+		return calculation, nil
+	}
+	calculation.DoseRange = &doseRange
+
+	if calculation.WeightKg == nil {
+		calculation.addWarning(DoseWarningNoWeight, "the pet has no weight recorded in its recent medical sessions")
+		return calculation, nil
+	}
+
+	minMg, maxMg := doseRange.ForWeight(*calculation.WeightKg)
+	calculation.RecommendedMinMg = &minMg
+	calculation.RecommendedMaxMg = &maxMg
+
+	if doseMg != nil {
+		mgPerKg := *doseMg / *calculation.WeightKg
+		calculation.PrescribedMgPerKg = &mgPerKg
+		calculation.checkDose(*doseMg, doseRange)
+	}
+
+	return calculation, nil
+}
+
+func (s *DoseCalculatorService) setLatestWeight(ctx context.Context, petID valueobject.PetID, calculation *DoseCalculation) error {
+	sessions, err := s.sessionRepo.FindRecentByPetID(ctx, petID, weightLookupSessions)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		weight := session.PetDetails().Weight()
+		if weight == nil || weight.Float64() <= 0 {
+			continue
+		}
+
+		weightKg := weight.Float64()
+		weighedAt := session.VisitDate()
+		calculation.WeightKg = &weightKg
+		calculation.WeighedAt = &weighedAt
+		return nil
+	}
+	return nil
+}
+
+func (c *DoseCalculation) checkDose(doseMg float64, doseRange valueobject.DoseRange) {
+	switch {
+	case doseRange.MaxDoseMg() != nil && doseMg > *doseRange.MaxDoseMg():
+		c.addWarning(DoseWarningAboveMaxDose,
+			fmt.Sprintf("%.2f mg exceeds the maximum dose of %.2f mg", doseMg, *doseRange.MaxDoseMg()))
+	case doseMg > *c.RecommendedMaxMg:
+		c.addWarning(DoseWarningAboveRange,
+			fmt.Sprintf("%.2f mg is above the recommended range of %.2f to %.2f mg", doseMg, *c.RecommendedMinMg, *c.RecommendedMaxMg))
+	case doseMg < *c.RecommendedMinMg:
+		c.addWarning(DoseWarningBelowRange,
+			fmt.Sprintf("%.2f mg is below the recommended range of %.2f to %.2f mg", doseMg, *c.RecommendedMinMg, *c.RecommendedMaxMg))
+	}
+}
+
+func (c *DoseCalculation) addWarning(code DoseWarningCode, message string) {
+	c.Warnings = append(c.Warnings, DoseWarning{Code: code, Message: message})
+}
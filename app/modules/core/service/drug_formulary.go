@@ -0,0 +1,236 @@
+package service
+
+import (
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"errors"
+	"sort"
+	"strings"
+)
+
+// DrugFormulary is the catalog of the drugs the clinic prescribes, with the dose per kg of each
+// species. Drugs are looked up by name regardless of case
+type DrugFormulary struct {
+	drugs map[string]valueobject.DrugDefinition
+}
+
+func NewDrugFormulary() *DrugFormulary {
+	formulary := &DrugFormulary{
+		drugs: make(map[string]valueobject.DrugDefinition),
+	}
+	formulary.initializeDefaultDrugs()
+	return formulary
+}
+
+var nsaidContraindications = []string{
+	"Gastrointestinal ulceration or bleeding",
+	"Renal or hepatic impairment",
+	"Dehydration or hypotension",
+	"Concurrent corticosteroids or other NSAIDs",
+}
+
+func (df *DrugFormulary) initializeDefaultDrugs() {
+	drugs := []valueobject.DrugDefinition{
+		// Antibiotics
+		df.createAmoxicillinClavulanate(),
+		df.createEnrofloxacin(),
+		df.createMetronidazole(),
+
+		// Anti-inflammatories and analgesics
+		df.createMeloxicam(),
+		df.createCarprofen(),
+		df.createPrednisolone(),
+		df.createGabapentin(),
+		df.createTramadol(),
+		df.createAcetaminophen(),
+
+		// Others
+		df.createMaropitant(),
+		df.createFurosemide(),
+	}
+
+	for _, drug := range drugs {
+		df.drugs[formularyKey(drug.Name())] = drug
+	}
+}
+
+// Antibiotics
+func (df *DrugFormulary) createAmoxicillinClavulanate() valueobject.DrugDefinition {
+	return valueobject.NewDrugDefinition(
+		"Amoxicillin-Clavulanate",
+		"Antibiotic",
+		[]valueobject.DoseRange{
+			valueobject.NewDoseRange("dog", 12.5, 25),
+			valueobject.NewDoseRange("cat", 12.5, 25),
+		},
+	).WithDescription("Broad spectrum potentiated penicillin, given every 12 hours.").
+		WithContraindications([]string{"Penicillin or cephalosporin hypersensitivity"}).
+		WithContraindicatedSpecies([]string{"rabbit", "guinea_pig", "hamster"})
+}
+
+func (df *DrugFormulary) createEnrofloxacin() valueobject.DrugDefinition {
+	return valueobject.NewDrugDefinition(
+		"Enrofloxacin",
+		"Antibiotic",
+		[]valueobject.DoseRange{
+			valueobject.NewDoseRange("dog", 5, 20),
+			valueobject.NewDoseRange("cat", 5, 5), // retinal toxicity above 5 mg/kg
+			valueobject.NewDoseRange("rabbit", 5, 20),
+		},
+	).WithDescription("Fluoroquinolone, given once a day.").
+		WithContraindications([]string{"Growing dogs of small and medium breeds under 8 months", "Seizure disorders"})
+}
+
+func (df *DrugFormulary) createMetronidazole() valueobject.DrugDefinition {
+	return valueobject.NewDrugDefinition(
+		"Metronidazole",
+		"Antibiotic",
+		[]valueobject.DoseRange{
+			valueobject.NewDoseRange("dog", 10, 15).WithMaxDose(500),
+			valueobject.NewDoseRange("cat", 7.5, 10),
+		},
+	).WithDescription("Anaerobic antibacterial and antiprotozoal, given every 12 hours.").
+		WithContraindications([]string{"Hepatic impairment", "Pregnancy", "Neurological signs"})
+}
+
+// Anti-inflammatories and analgesics
+func (df *DrugFormulary) createMeloxicam() valueobject.DrugDefinition {
+	return valueobject.NewDrugDefinition(
+		"Meloxicam",
+		"NSAID",
+		[]valueobject.DoseRange{
+			valueobject.NewDoseRange("dog", 0.1, 0.2),
+			valueobject.NewDoseRange("cat", 0.05, 0.1),
+			valueobject.NewDoseRange("rabbit", 0.3, 1),
+		},
+	).WithDescription("Once a day, the upper end of the range is the loading dose of the first day.").
+		WithContraindications(nsaidContraindications)
+}
+
+func (df *DrugFormulary) createCarprofen() valueobject.DrugDefinition {
+	return valueobject.NewDrugDefinition(
+		"Carprofen",
+		"NSAID",
+		[]valueobject.DoseRange{
+			valueobject.NewDoseRange("dog", 2.2, 4.4),
+		},
+	).WithDescription("2.2 mg/kg every 12 hours or 4.4 mg/kg once a day.").
+		WithContraindications(nsaidContraindications).
+		WithContraindicatedSpecies([]string{"cat"})
+}
+
+func (df *DrugFormulary) createPrednisolone() valueobject.DrugDefinition {
+	return valueobject.NewDrugDefinition(
+		"Prednisolone",
+		"Corticosteroid",
+		[]valueobject.DoseRange{
+			valueobject.NewDoseRange("dog", 0.5, 1),
+			valueobject.NewDoseRange("cat", 1, 2),
+		},
+	).WithDescription("Anti-inflammatory dose, immunosuppressive doses are prescribed by a specialist.").
+		WithContraindications([]string{"Systemic fungal infections", "Concurrent NSAIDs", "Diabetes mellitus", "Corneal ulcers"})
+}
+
+func (df *DrugFormulary) createGabapentin() valueobject.DrugDefinition {
+	return valueobject.NewDrugDefinition(
+		"Gabapentin",
+		"Analgesic",
+		[]valueobject.DoseRange{
+			valueobject.NewDoseRange("dog", 5, 20).WithMaxDose(600),
+			valueobject.NewDoseRange("cat", 5, 10),
+		},
+	).WithDescription("Neuropathic pain and pre-visit anxiolysis, given every 8 to 12 hours.").
+		WithContraindications([]string{"Oral solutions containing xylitol in dogs", "Severe renal impairment"})
+}
+
+func (df *DrugFormulary) createTramadol() valueobject.DrugDefinition {
+	return valueobject.NewDrugDefinition(
+		"Tramadol",
+		"Opioid analgesic",
+		[]valueobject.DoseRange{
+			valueobject.NewDoseRange("dog", 2, 5),
+			valueobject.NewDoseRange("cat", 1, 2),
+		},
+	).WithDescription("Given every 8 to 12 hours.").
+		WithContraindications([]string{"Concurrent SSRIs or MAO inhibitors", "Seizure disorders"})
+}
+
+func (df *DrugFormulary) createAcetaminophen() valueobject.DrugDefinition {
+	return valueobject.NewDrugDefinition(
+		"Acetaminophen",
+		"Analgesic",
+		[]valueobject.DoseRange{
+			valueobject.NewDoseRange("dog", 10, 15).WithMaxDose(1000),
+		},
+	).WithDescription("Given every 8 to 12 hours in dogs only.").
+		WithContraindications([]string{"Hepatic impairment"}).
+		WithContraindicatedSpecies([]string{"cat", "ferret"})
+}
+
+// Others
+func (df *DrugFormulary) createMaropitant() valueobject.DrugDefinition {
+	return valueobject.NewDrugDefinition(
+		"Maropitant",
+		"Antiemetic",
+		[]valueobject.DoseRange{
+			valueobject.NewDoseRange("dog", 1, 2),
+			valueobject.NewDoseRange("cat", 1, 1),
+		},
+	).WithDescription("Once a day, 2 mg/kg by mouth to prevent motion sickness in dogs.").
+		WithContraindications([]string{"Gastrointestinal obstruction", "Puppies under 8 weeks"})
+}
+
+func (df *DrugFormulary) createFurosemide() valueobject.DrugDefinition {
+	return valueobject.NewDrugDefinition(
+		"Furosemide",
+		"Diuretic",
+		[]valueobject.DoseRange{
+			valueobject.NewDoseRange("dog", 1, 4),
+			valueobject.NewDoseRange("cat", 1, 2),
+		},
+	).WithDescription("Loop diuretic, given every 8 to 24 hours.").
+		WithContraindications([]string{"Anuria", "Dehydration", "Electrolyte depletion"})
+}
+
+// GetDrugByName obtains a drug of the formulary
+func (df *DrugFormulary) GetDrugByName(name string) (valueobject.DrugDefinition, error) {
+	drug, exists := df.drugs[formularyKey(name)]
+	if !exists {
+		return valueobject.DrugDefinition{}, errors.New("drug not found in formulary")
+	}
+	return drug, nil
+}
+
+// GetDrugs obtains every drug of the formulary sorted by name
+func (df *DrugFormulary) GetDrugs() []valueobject.DrugDefinition {
+	result := make([]valueobject.DrugDefinition, 0, len(df.drugs))
+	for _, drug := range df.drugs {
+		result = append(result, drug)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name() < result[j].Name() })
+	return result
+}
+
+// GetDrugsForSpecies obtains the drugs with a dose for the species that are not contraindicated
+// for it, sorted by name
+func (df *DrugFormulary) GetDrugsForSpecies(species string) []valueobject.DrugDefinition {
+	var result []valueobject.DrugDefinition
+	for _, drug := range df.GetDrugs() {
+		if drug.IsApplicableForSpecies(species) {
+			result = append(result, drug)
+		}
+	}
+	return result
+}
+
+// AddDrug allows adding a new drug to the formulary
+func (df *DrugFormulary) AddDrug(drug valueobject.DrugDefinition) error {
+	if _, exists := df.drugs[formularyKey(drug.Name())]; exists {
+		return errors.New("drug already exists in formulary")
+	}
+	df.drugs[formularyKey(drug.Name())] = drug
+	return nil
+}
+
+func formularyKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/calendar"
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/entity/notification"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/log"
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// FollowUpService keeps the follow-up appointment proposed for a medical session in line with
+// its follow-up date. The owner is notified of every change, a failed notification is logged
+// since the appointment is already saved
+type FollowUpService struct {
+	apptRepo            repository.AppointmentRepository
+	visitRepo           repository.AppointmentVisitRepository
+	calendarRepo        repository.ClinicCalendarRepository
+	schedule            *ScheduleGuard
+	contactService      *CustomerContactService
+	notificationService NotificationService
+}
+
+func NewFollowUpService(
+	apptRepo repository.AppointmentRepository,
+	visitRepo repository.AppointmentVisitRepository,
+	calendarRepo repository.ClinicCalendarRepository,
+	exceptionRepo repository.ScheduleExceptionRepository,
+	contactService *CustomerContactService,
+	notificationService NotificationService,
+) *FollowUpService {
+	return &FollowUpService{
+		apptRepo:            apptRepo,
+		visitRepo:           visitRepo,
+		calendarRepo:        calendarRepo,
+		schedule:            NewScheduleGuard(apptRepo, exceptionRepo),
+		contactService:      contactService,
+		notificationService: notificationService,
+	}
+}
+
+// Sync saves the follow-up date of the session, proposing the follow-up appointment the first
+// time one is set and moving or cancelling it afterwards
+func (s *FollowUpService) Sync(ctx context.Context, session *medical.MedicalSession) error {
+	proposal, moved, err := s.Prepare(ctx, session)
+	if err != nil {
+		return err
+	}
+
+	if err := s.visitRepo.SaveFollowUp(ctx, session, proposal); err != nil {
+		return err
+	}
+
+	s.Notify(ctx, proposal, moved)
+	return nil
+}
+
+// Prepare computes the follow-up appointment to save along with the session: a new proposal the
+// first time a follow-up date is set, the existing one moved or cancelled afterwards. It returns
+// nil when the proposal stays as is and reports whether an existing proposal was changed. The
+// caller saves the proposal in the same transaction as the session and then calls Notify
+func (s *FollowUpService) Prepare(ctx context.Context, session *medical.MedicalSession) (*appointment.Appointment, bool, error) {
+	var proposalID *valueobject.AppointmentID
+	if !session.ID().IsZero() {
+		found, err := s.visitRepo.FindFollowUpID(ctx, session.ID())
+		if err != nil {
+			return nil, false, err
+		}
+		proposalID = found
+	}
+
+	if proposalID == nil && session.PetDetails().FollowUpDate() == nil {
+		return nil, false, nil
+	}
+
+	clinicCalendar, err := s.loadCalendar(ctx, session.PetDetails().FollowUpDate())
+	if err != nil {
+		return nil, false, err
+	}
+
+	if proposalID == nil {
+		proposal, err := medical.ProposeFollowUp(ctx, *session, clinicCalendar)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if err := s.ensureCanBook(ctx, *proposal); err != nil {
+			return nil, false, err
+		}
+		return proposal, false, nil
+	}
+
+	proposal, err := s.apptRepo.FindByID(ctx, *proposalID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	changed, err := medical.MoveFollowUp(ctx, *session, &proposal, clinicCalendar)
+	if err != nil || !changed {
+		return nil, false, err
+	}
+
+	if proposal.Status() != enum.AppointmentStatusCancelled {
+		if err := s.ensureCanBook(ctx, proposal); err != nil {
+			return nil, false, err
+		}
+	}
+	return &proposal, true, nil
+}
+
+// Notify tells the owner about the saved proposal, nothing is sent when there is none. A failed
+// notification is logged since the appointment is already saved
+func (s *FollowUpService) Notify(ctx context.Context, proposal *appointment.Appointment, moved bool) {
+	if proposal == nil {
+		return
+	}
+	s.notifyOwner(ctx, *proposal, moved)
+}
+
+// ensureCanBook runs the conflict and time-off checks of any other booking on the proposal
+func (s *FollowUpService) ensureCanBook(ctx context.Context, proposal appointment.Appointment) error {
+	if err := s.schedule.EnsureNoConflict(ctx, proposal); err != nil {
+		return err
+	}
+	return s.schedule.EnsureEmployeeAvailable(ctx, proposal)
+}
+
+// loadCalendar loads the closures around the follow-up date, which can be months away
+func (s *FollowUpService) loadCalendar(ctx context.Context, followUpDate *time.Time) (calendar.ClinicCalendar, error) {
+	if followUpDate == nil {
+		return s.calendarRepo.Get(ctx)
+	}
+	return s.calendarRepo.GetForPeriod(ctx, *followUpDate, *followUpDate)
+}
+
+func (s *FollowUpService) notifyOwner(ctx context.Context, proposal appointment.Appointment, moved bool) {
+	contact, err := s.contactService.Find(ctx, proposal.CustomerID())
+	if err != nil {
+		log.Error("failed to find the owner to notify of the follow-up", err,
+			zap.String("appointment_id", proposal.ID().String()))
+		return
+	}
+
+	var notif *notification.Notification
+	if proposal.Status() == enum.AppointmentStatusCancelled {
+		notif = notification.NewFollowUpCancelled(
+			contact.UserID, contact.Channel, contact.Email, contact.Phone,
+			contact.Name, proposal.Service(), proposal.ScheduledDate(),
+		)
+	} else {
+		notif = notification.NewFollowUpProposal(
+			contact.UserID, contact.Channel, contact.Email, contact.Phone,
+			contact.Name, proposal.Service(), proposal.ScheduledDate(), moved,
+		)
+	}
+
+	if err := s.notificationService.Send(ctx, notif); err != nil {
+		log.Error("failed to notify the owner of the follow-up", err,
+			zap.String("appointment_id", proposal.ID().String()))
+	}
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"errors"
+	"sort"
+	"strings"
+)
+
+// LabPanelCatalog is the catalog of the panels the clinic sends to the laboratories, with the
+// analytes of each one and their reference ranges for dogs and cats. Panels and analytes are
+// looked up by code regardless of case
+type LabPanelCatalog struct {
+	panels   map[string]valueobject.LabPanelDefinition
+	analytes map[string]valueobject.LabAnalyteDefinition
+}
+
+func NewLabPanelCatalog() *LabPanelCatalog {
+	catalog := &LabPanelCatalog{
+		panels:   make(map[string]valueobject.LabPanelDefinition),
+		analytes: make(map[string]valueobject.LabAnalyteDefinition),
+	}
+	catalog.initializeDefaultPanels()
+	return catalog
+}
+
+func (lc *LabPanelCatalog) initializeDefaultPanels() {
+	panels := []valueobject.LabPanelDefinition{
+		// Blood
+		lc.createCBC(),
+		lc.createChemistry(),
+		lc.createSDMA(),
+		lc.createTotalT4(),
+
+		// Urine
+		lc.createUrinalysis(),
+	}
+
+	for _, panel := range panels {
+		lc.AddPanel(panel)
+	}
+}
+
+// Blood
+func (lc *LabPanelCatalog) createCBC() valueobject.LabPanelDefinition {
+	return valueobject.NewLabPanelDefinition(
+		"CBC",
+		"Complete Blood Count",
+		"EDTA whole blood",
+		[]valueobject.LabAnalyteDefinition{
+			valueobject.NewLabAnalyteDefinition("WBC", "White Blood Cells", "10^9/L").
+				WithRange(enum.PetSpeciesDog, 5.05, 16.76).
+				WithRange(enum.PetSpeciesCat, 2.87, 17.02),
+			valueobject.NewLabAnalyteDefinition("RBC", "Red Blood Cells", "10^12/L").
+				WithRange(enum.PetSpeciesDog, 5.65, 8.87).
+				WithRange(enum.PetSpeciesCat, 6.54, 12.2),
+			valueobject.NewLabAnalyteDefinition("HGB", "Hemoglobin", "g/dL").
+				WithRange(enum.PetSpeciesDog, 13.1, 20.5).
+				WithRange(enum.PetSpeciesCat, 9.8, 16.2),
+			valueobject.NewLabAnalyteDefinition("HCT", "Hematocrit", "%").
+				WithRange(enum.PetSpeciesDog, 37.3, 61.7).
+				WithRange(enum.PetSpeciesCat, 30.3, 52.3),
+			valueobject.NewLabAnalyteDefinition("PLT", "Platelets", "10^9/L").
+				WithRange(enum.PetSpeciesDog, 148, 484).
+				WithRange(enum.PetSpeciesCat, 151, 600),
+		},
+	).WithDescription("Red and white cell counts and platelets.")
+}
+
+func (lc *LabPanelCatalog) createChemistry() valueobject.LabPanelDefinition {
+	return valueobject.NewLabPanelDefinition(
+		"CHEM",
+		"Chemistry Panel",
+		"Serum",
+		[]valueobject.LabAnalyteDefinition{
+			valueobject.NewLabAnalyteDefinition("GLU", "Glucose", "mg/dL").
+				WithRange(enum.PetSpeciesDog, 74, 143).
+				WithRange(enum.PetSpeciesCat, 71, 159),
+			valueobject.NewLabAnalyteDefinition("BUN", "Blood Urea Nitrogen", "mg/dL").
+				WithRange(enum.PetSpeciesDog, 7, 27).
+				WithRange(enum.PetSpeciesCat, 16, 36),
+			valueobject.NewLabAnalyteDefinition("CREA", "Creatinine", "mg/dL").
+				WithRange(enum.PetSpeciesDog, 0.5, 1.8).
+				WithRange(enum.PetSpeciesCat, 0.8, 2.4),
+			valueobject.NewLabAnalyteDefinition("ALT", "Alanine Aminotransferase", "U/L").
+				WithRange(enum.PetSpeciesDog, 10, 125).
+				WithRange(enum.PetSpeciesCat, 12, 130),
+			valueobject.NewLabAnalyteDefinition("ALKP", "Alkaline Phosphatase", "U/L").
+				WithRange(enum.PetSpeciesDog, 23, 212).
+				WithRange(enum.PetSpeciesCat, 14, 111),
+			valueobject.NewLabAnalyteDefinition("TP", "Total Protein", "g/dL").
+				WithRange(enum.PetSpeciesDog, 5.2, 8.2).
+				WithRange(enum.PetSpeciesCat, 5.7, 8.9),
+			valueobject.NewLabAnalyteDefinition("ALB", "Albumin", "g/dL").
+				WithRange(enum.PetSpeciesDog, 2.3, 4).
+				WithRange(enum.PetSpeciesCat, 2.2, 4),
+			valueobject.NewLabAnalyteDefinition("PHOS", "Phosphorus", "mg/dL").
+				WithRange(enum.PetSpeciesDog, 2.5, 6.8).
+				WithRange(enum.PetSpeciesCat, 3.1, 7.5),
+		},
+	).WithDescription("Kidney and liver values, glucose and proteins.")
+}
+
+func (lc *LabPanelCatalog) createSDMA() valueobject.LabPanelDefinition {
+	return valueobject.NewLabPanelDefinition(
+		"SDMA",
+		"Symmetric Dimethylarginine",
+		"Serum",
+		[]valueobject.LabAnalyteDefinition{
+			valueobject.NewLabAnalyteDefinition("SDMA", "SDMA", "ug/dL").
+				WithRange(enum.PetSpeciesDog, 0, 14).
+				WithRange(enum.PetSpeciesCat, 0, 14),
+		},
+	).WithDescription("Early marker of reduced kidney function.")
+}
+
+func (lc *LabPanelCatalog) createTotalT4() valueobject.LabPanelDefinition {
+	return valueobject.NewLabPanelDefinition(
+		"T4",
+		"Total Thyroxine",
+		"Serum",
+		[]valueobject.LabAnalyteDefinition{
+			valueobject.NewLabAnalyteDefinition("TT4", "Total T4", "ug/dL").
+				WithRange(enum.PetSpeciesDog, 1, 4).
+				WithRange(enum.PetSpeciesCat, 0.8, 4.7),
+		},
+	).WithDescription("Thyroid screening and monitoring of hyperthyroid cats.")
+}
+
+// Urine
+func (lc *LabPanelCatalog) createUrinalysis() valueobject.LabPanelDefinition {
+	return valueobject.NewLabPanelDefinition(
+		"UA",
+		"Urinalysis",
+		"Urine",
+		[]valueobject.LabAnalyteDefinition{
+			valueobject.NewLabAnalyteDefinition("USG", "Urine Specific Gravity", "").
+				WithRange(enum.PetSpeciesDog, 1.015, 1.045).
+				WithRange(enum.PetSpeciesCat, 1.035, 1.06),
+			valueobject.NewLabAnalyteDefinition("UPH", "Urine pH", "pH").
+				WithRange(enum.PetSpeciesDog, 5.5, 7.5).
+				WithRange(enum.PetSpeciesCat, 6, 7.5),
+			valueobject.NewLabAnalyteDefinition("UPRO", "Urine Protein", ""),
+			valueobject.NewLabAnalyteDefinition("UGLU", "Urine Glucose", ""),
+			valueobject.NewLabAnalyteDefinition("UBLD", "Urine Blood", ""),
+		},
+	).WithDescription("Concentration, dipstick chemistry and sediment.")
+}
+
+func (lc *LabPanelCatalog) GetPanelByCode(code string) (valueobject.LabPanelDefinition, error) {
+	panel, exists := lc.panels[catalogCode(code)]
+	if !exists {
+		return valueobject.LabPanelDefinition{}, errors.New("lab panel not found in catalog")
+	}
+	return panel, nil
+}
+
+// GetPanels returns the panels of the catalog sorted by code
+func (lc *LabPanelCatalog) GetPanels() []valueobject.LabPanelDefinition {
+	panels := make([]valueobject.LabPanelDefinition, 0, len(lc.panels))
+	for _, panel := range lc.panels {
+		panels = append(panels, panel)
+	}
+	sort.Slice(panels, func(i, j int) bool { return panels[i].Code() < panels[j].Code() })
+	return panels
+}
+
+// GetAnalyte returns the analyte with the code whatever panel it belongs to
+func (lc *LabPanelCatalog) GetAnalyte(code string) (valueobject.LabAnalyteDefinition, bool) {
+	analyte, exists := lc.analytes[catalogCode(code)]
+	return analyte, exists
+}
+
+func (lc *LabPanelCatalog) AddPanel(panel valueobject.LabPanelDefinition) error {
+	key := catalogCode(panel.Code())
+	if _, exists := lc.panels[key]; exists {
+		return errors.New("lab panel already exists in catalog")
+	}
+
+	lc.panels[key] = panel
+	for _, analyte := range panel.Analytes() {
+		if _, exists := lc.analytes[catalogCode(analyte.Code())]; !exists {
+			lc.analytes[catalogCode(analyte.Code())] = analyte
+		}
+	}
+	return nil
+}
+
+func catalogCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"clinic-vet-api/app/modules/core/domain/entity/employee"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	domainerr "clinic-vet-api/app/modules/core/error"
+	"clinic-vet-api/app/modules/core/repository"
+	"context"
+	"time"
+)
+
+// OnCallService tells who is on call at a given time. The roster is generated ahead of time, so
+// shifts of employees who got time off approved afterwards are left out
+type OnCallService struct {
+	rosterRepo    repository.OnCallRepository
+	exceptionRepo repository.ScheduleExceptionRepository
+}
+
+func NewOnCallService(rosterRepo repository.OnCallRepository, exceptionRepo repository.ScheduleExceptionRepository) *OnCallService {
+	return &OnCallService{rosterRepo: rosterRepo, exceptionRepo: exceptionRepo}
+}
+
+// OnCallAt returns the shifts on call at the time, optionally of a single specialty
+func (s *OnCallService) OnCallAt(ctx context.Context, at time.Time, specialty *enum.VetSpecialty) ([]employee.OnCallShift, error) {
+	shifts, err := s.rosterRepo.FindAt(ctx, at, specialty)
+	if err != nil {
+		return nil, err
+	}
+
+	available := []employee.OnCallShift{}
+	for _, shift := range shifts {
+		exceptions, err := s.exceptionRepo.FindApproved(ctx, shift.EmployeeID(), at, at.Add(time.Minute))
+		if err != nil {
+			return nil, err
+		}
+
+		if employee.NewWorkCalendar(nil, exceptions).IsOff(at, at.Add(time.Minute)) {
+			continue
+		}
+		available = append(available, shift)
+	}
+	return available, nil
+}
+
+// EmergencyVet returns the veterinarian attending the emergencies arriving at the time
+func (s *OnCallService) EmergencyVet(ctx context.Context, at time.Time) (valueobject.EmployeeID, error) {
+	shifts, err := s.OnCallAt(ctx, at, nil)
+	if err != nil {
+		return valueobject.EmployeeID{}, err
+	}
+
+	shift, found := employee.PickEmergencyOnCall(shifts)
+	if !found {
+		return valueobject.EmployeeID{}, domainerr.BusinessRuleError(ctx, "no veterinarian is on call", "on_call_shift", "employee_id", "FindEmergencyOnCall")
+	}
+	return shift.EmployeeID(), nil
+}
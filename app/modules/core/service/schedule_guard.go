@@ -0,0 +1,84 @@
+package service
+
+import (
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/employee"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/specification"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"context"
+	"math"
+	"slices"
+)
+
+// ScheduleGuard runs the checks every booking path goes through before an appointment is saved:
+// no overlap with the appointments of the same employee or pet and no booking during the
+// approved time off of the employee. The conflict check runs again when the appointment is saved,
+// with the employee and the pet locked, so this one rejects most conflicts early and the saved
+// one settles bookings made at the same time
+type ScheduleGuard struct {
+	apptRepo      repository.AppointmentRepository
+	exceptionRepo repository.ScheduleExceptionRepository
+}
+
+func NewScheduleGuard(
+	apptRepo repository.AppointmentRepository,
+	exceptionRepo repository.ScheduleExceptionRepository,
+) *ScheduleGuard {
+	return &ScheduleGuard{apptRepo: apptRepo, exceptionRepo: exceptionRepo}
+}
+
+// EnsureNoConflict loads the active appointments of the same employee and pet that could overlap
+// the given one and delegates the overlap rule to the domain. Ignored appointments are left out
+// of the check, used when several occurrences of a series move together
+func (g *ScheduleGuard) EnsureNoConflict(ctx context.Context, appointment appt.Appointment, ignored ...valueobject.AppointmentID) error {
+	windowStart := appointment.ScheduledDate().Add(-enum.LongestClinicServiceDuration())
+	windowEnd := appointment.EndDate()
+	pagination := specification.Pagination{Limit: math.MaxInt32}
+
+	petSpec := specification.ApptByPet(appointment.PetID()).
+		And(specification.ApptByDateRange(windowStart, windowEnd)).
+		WithPagination(pagination)
+
+	candidates, err := g.apptRepo.Find(ctx, petSpec)
+	if err != nil {
+		return err
+	}
+	others := candidates.Items
+
+	if appointment.EmployeeID() != nil {
+		employeeSpec := specification.ApptByEmployee(*appointment.EmployeeID()).
+			And(specification.ApptByDateRange(windowStart, windowEnd)).
+			WithPagination(pagination)
+
+		employeeAppts, err := g.apptRepo.Find(ctx, employeeSpec)
+		if err != nil {
+			return err
+		}
+		others = append(others, employeeAppts.Items...)
+	}
+
+	if len(ignored) > 0 {
+		others = slices.DeleteFunc(others, func(other appt.Appointment) bool {
+			return slices.Contains(ignored, other.ID())
+		})
+	}
+
+	return appointment.EnsureNoScheduleConflict(ctx, others)
+}
+
+// EnsureEmployeeAvailable rejects appointments booked with a veterinarian during their approved
+// time off. Appointments without a veterinarian are left to the confirmation
+func (g *ScheduleGuard) EnsureEmployeeAvailable(ctx context.Context, appointment appt.Appointment) error {
+	if appointment.EmployeeID() == nil {
+		return nil
+	}
+
+	exceptions, err := g.exceptionRepo.FindApproved(ctx, *appointment.EmployeeID(), appointment.ScheduledDate(), appointment.EndDate())
+	if err != nil {
+		return err
+	}
+
+	return employee.NewWorkCalendar(nil, exceptions).EnsureNotOff(ctx, appointment.ScheduledDate(), appointment.EndDate())
+}
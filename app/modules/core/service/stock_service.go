@@ -0,0 +1,51 @@
+package service
+
+import (
+	"clinic-vet-api/app/modules/core/domain/entity/inventory"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"context"
+	"time"
+)
+
+// StockService checks the lot units are dispensed from for vaccinations, dewormings and
+// prescriptions before the clinical record is built. The stock itself is taken out by the
+// repository of the record, in the transaction the record is saved in
+type StockService struct {
+	inventoryRepo repository.InventoryRepository
+}
+
+func NewStockService(inventoryRepo repository.InventoryRepository) *StockService {
+	return &StockService{inventoryRepo: inventoryRepo}
+}
+
+// CheckDispensable returns the lot when the units can be dispensed from it for a record taking
+// products of the categories
+func (s *StockService) CheckDispensable(
+	ctx context.Context,
+	lotID valueobject.StockLotID,
+	quantity int,
+	now time.Time,
+	categories ...enum.ProductCategory,
+) (inventory.StockLot, error) {
+	lot, err := s.inventoryRepo.FindLotByID(ctx, lotID)
+	if err != nil {
+		return inventory.StockLot{}, err
+	}
+
+	product, err := s.inventoryRepo.FindProductByID(ctx, lot.ProductID())
+	if err != nil {
+		return inventory.StockLot{}, err
+	}
+
+	if err := product.CheckDispensableAs(ctx, categories...); err != nil {
+		return inventory.StockLot{}, err
+	}
+
+	if err := lot.CheckDispensable(ctx, quantity, now); err != nil {
+		return inventory.StockLot{}, err
+	}
+
+	return lot, nil
+}
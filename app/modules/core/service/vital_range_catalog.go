@@ -0,0 +1,166 @@
+package service
+
+import (
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// Life stages as given by the pet's age
+const (
+	LifeStageBaby    = "baby"
+	LifeStageYoung   = "young"
+	LifeStageAdult   = "adult"
+	LifeStageSenior  = "senior"
+	LifeStageUnknown = "unknown"
+)
+
+// VitalRangeCatalog is the catalog of the normal vitals of each species by life stage. Species
+// listed only with adult ranges use them for every life stage
+type VitalRangeCatalog struct {
+	ranges map[enum.PetSpecies]map[string]valueobject.VitalReferenceRanges
+}
+
+func NewVitalRangeCatalog() *VitalRangeCatalog {
+	catalog := &VitalRangeCatalog{
+		ranges: make(map[enum.PetSpecies]map[string]valueobject.VitalReferenceRanges),
+	}
+	catalog.initializeDefaultRanges()
+	return catalog
+}
+
+func (vc *VitalRangeCatalog) initializeDefaultRanges() {
+	ranges := []valueobject.VitalReferenceRanges{
+		// Dogs, weight depends too much on the breed
+		vc.createDogAdult(),
+		vc.createDogBaby(),
+
+		// Cats
+		vc.createCatAdult(),
+		vc.createCatBaby(),
+
+		// Small mammals
+		vc.createRabbit(),
+		vc.createFerret(),
+		vc.createGuineaPig(),
+		vc.createHamster(),
+
+		// Others
+		vc.createBird(),
+		vc.createHorseAdult(),
+		vc.createHorseBaby(),
+	}
+
+	for _, r := range ranges {
+		vc.AddRanges(r)
+	}
+}
+
+// Dogs
+func (vc *VitalRangeCatalog) createDogAdult() valueobject.VitalReferenceRanges {
+	return valueobject.NewVitalReferenceRanges(enum.PetSpeciesDog, LifeStageAdult).
+		WithTemperature(37.5, 39.2).
+		WithHeartRate(60, 140).
+		WithRespiratoryRate(10, 30)
+}
+
+func (vc *VitalRangeCatalog) createDogBaby() valueobject.VitalReferenceRanges {
+	return valueobject.NewVitalReferenceRanges(enum.PetSpeciesDog, LifeStageBaby).
+		WithTemperature(37.5, 39.4).
+		WithHeartRate(70, 220).
+		WithRespiratoryRate(15, 40)
+}
+
+// Cats
+func (vc *VitalRangeCatalog) createCatAdult() valueobject.VitalReferenceRanges {
+	return valueobject.NewVitalReferenceRanges(enum.PetSpeciesCat, LifeStageAdult).
+		WithWeight(2.5, 7).
+		WithTemperature(37.8, 39.2).
+		WithHeartRate(140, 220).
+		WithRespiratoryRate(20, 30)
+}
+
+func (vc *VitalRangeCatalog) createCatBaby() valueobject.VitalReferenceRanges {
+	return valueobject.NewVitalReferenceRanges(enum.PetSpeciesCat, LifeStageBaby).
+		WithTemperature(37.8, 39.4).
+		WithHeartRate(160, 240).
+		WithRespiratoryRate(20, 40)
+}
+
+// Small mammals
+func (vc *VitalRangeCatalog) createRabbit() valueobject.VitalReferenceRanges {
+	return valueobject.NewVitalReferenceRanges(enum.PetSpeciesRabbit, LifeStageAdult).
+		WithWeight(1, 6).
+		WithTemperature(38.5, 40).
+		WithHeartRate(130, 325).
+		WithRespiratoryRate(30, 60)
+}
+
+func (vc *VitalRangeCatalog) createFerret() valueobject.VitalReferenceRanges {
+	return valueobject.NewVitalReferenceRanges(enum.PetSpeciesFerret, LifeStageAdult).
+		WithWeight(0.6, 2).
+		WithTemperature(37.8, 40).
+		WithHeartRate(180, 250).
+		WithRespiratoryRate(30, 40)
+}
+
+func (vc *VitalRangeCatalog) createGuineaPig() valueobject.VitalReferenceRanges {
+	return valueobject.NewVitalReferenceRanges(enum.PetSpeciesGuinea, LifeStageAdult).
+		WithWeight(0.7, 1.2).
+		WithTemperature(37.2, 39.5).
+		WithHeartRate(230, 380).
+		WithRespiratoryRate(42, 104)
+}
+
+func (vc *VitalRangeCatalog) createHamster() valueobject.VitalReferenceRanges {
+	return valueobject.NewVitalReferenceRanges(enum.PetSpeciesHamster, LifeStageAdult).
+		WithTemperature(37, 38.5).
+		WithHeartRate(250, 500).
+		WithRespiratoryRate(35, 135)
+}
+
+// Others
+func (vc *VitalRangeCatalog) createBird() valueobject.VitalReferenceRanges {
+	// Companion birds range from finches to macaws, only the temperature is shared by all of them
+	return valueobject.NewVitalReferenceRanges(enum.PetSpeciesBird, LifeStageAdult).
+		WithTemperature(40, 42.5)
+}
+
+func (vc *VitalRangeCatalog) createHorseAdult() valueobject.VitalReferenceRanges {
+	return valueobject.NewVitalReferenceRanges(enum.PetSpeciesHorse, LifeStageAdult).
+		WithWeight(380, 1000).
+		WithTemperature(37.2, 38.3).
+		WithHeartRate(28, 44).
+		WithRespiratoryRate(8, 16)
+}
+
+func (vc *VitalRangeCatalog) createHorseBaby() valueobject.VitalReferenceRanges {
+	return valueobject.NewVitalReferenceRanges(enum.PetSpeciesHorse, LifeStageBaby).
+		WithTemperature(37.5, 38.9).
+		WithHeartRate(60, 120).
+		WithRespiratoryRate(20, 40)
+}
+
+// GetRanges returns the normal vitals of the species at the life stage, falling back to the adult
+// ones. False is returned for species the catalog does not cover
+func (vc *VitalRangeCatalog) GetRanges(species enum.PetSpecies, lifeStage string) (valueobject.VitalReferenceRanges, bool) {
+	byStage, exists := vc.ranges[species]
+	if !exists {
+		return valueobject.VitalReferenceRanges{}, false
+	}
+
+	if ranges, exists := byStage[lifeStage]; exists {
+		return ranges, true
+	}
+
+	ranges, exists := byStage[LifeStageAdult]
+	return ranges, exists
+}
+
+func (vc *VitalRangeCatalog) AddRanges(ranges valueobject.VitalReferenceRanges) {
+	byStage, exists := vc.ranges[ranges.Species()]
+	if !exists {
+		byStage = make(map[string]valueobject.VitalReferenceRanges)
+		vc.ranges[ranges.Species()] = byStage
+	}
+	byStage[ranges.LifeStage()] = ranges
+}
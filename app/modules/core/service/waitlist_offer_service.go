@@ -0,0 +1,144 @@
+package service
+
+import (
+	"clinic-vet-api/app/modules/core/domain/entity/notification"
+	"clinic-vet-api/app/modules/core/domain/entity/waitlist"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"context"
+	"errors"
+	"time"
+)
+
+// WaitlistOfferService offers freed appointment slots to the waitlist, oldest entry first.
+// Only one entry holds an offer for a slot at a time; when the offer expires the slot moves
+// on to the next matching entry and the entry that missed it goes back to waiting, until it
+// has missed waitlist.MaxMissedOffers offers
+type WaitlistOfferService struct {
+	waitlistRepo        repository.WaitlistRepository
+	contactService      *CustomerContactService
+	notificationService NotificationService
+	offerTTL            time.Duration
+}
+
+func NewWaitlistOfferService(
+	waitlistRepo repository.WaitlistRepository,
+	contactService *CustomerContactService,
+	notificationService NotificationService,
+	offerTTL time.Duration,
+) *WaitlistOfferService {
+	return &WaitlistOfferService{
+		waitlistRepo:        waitlistRepo,
+		contactService:      contactService,
+		notificationService: notificationService,
+		offerTTL:            offerTTL,
+	}
+}
+
+// OfferSlot offers the slot to the first waiting entry that matches it. An entry taken by a
+// concurrent offer is skipped. It returns false when the slot is already in the past or nobody
+// is waiting for it
+func (s *WaitlistOfferService) OfferSlot(ctx context.Context, slot waitlist.Slot) (bool, error) {
+	return s.offerSlot(ctx, slot, nil)
+}
+
+// ExpireOffers takes back the offers that were not claimed in time and passes their slots on.
+// The entry that missed an offer is not offered the same slot again
+func (s *WaitlistOfferService) ExpireOffers(ctx context.Context) error {
+	entries, err := s.waitlistRepo.FindExpiredOffers(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		slot, hasSlot := entry.Slot()
+		if err := entry.MissOffer(ctx); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		missed, err := s.waitlistRepo.SaveMissedOffer(ctx, &entry)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		// another replica took the offer back first and is re-offering the slot
+		if !missed || !hasSlot {
+			continue
+		}
+
+		missedBy := entry.ID()
+		if _, err := s.offerSlot(ctx, slot, &missedBy); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// offerSlot is OfferSlot leaving out the entry that just missed an offer for the slot, if any
+func (s *WaitlistOfferService) offerSlot(ctx context.Context, slot waitlist.Slot, missedBy *valueobject.WaitlistID) (bool, error) {
+	now := time.Now()
+	if !slot.Start.After(now) {
+		return false, nil
+	}
+
+	entries, err := s.waitlistRepo.FindWaitingForSlot(ctx, slot)
+	if err != nil {
+		return false, err
+	}
+
+	for _, entry := range entries {
+		if missedBy != nil && entry.ID() == *missedBy {
+			continue
+		}
+		if !entry.Matches(slot) {
+			continue
+		}
+
+		expiresAt := now.Add(s.offerTTL)
+		if expiresAt.After(slot.Start) {
+			expiresAt = slot.Start
+		}
+
+		offered, err := s.offer(ctx, &entry, slot, expiresAt)
+		if err != nil || offered {
+			return offered, err
+		}
+	}
+
+	return false, nil
+}
+
+func (s *WaitlistOfferService) offer(ctx context.Context, entry *waitlist.WaitlistEntry, slot waitlist.Slot, expiresAt time.Time) (bool, error) {
+	claimToken, err := waitlist.GenerateClaimToken(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if err := entry.Offer(ctx, slot, claimToken, expiresAt); err != nil {
+		return false, err
+	}
+
+	offered, err := s.waitlistRepo.SaveOffer(ctx, entry)
+	if err != nil || !offered {
+		return false, err
+	}
+
+	contact, err := s.contactService.Find(ctx, entry.CustomerID())
+	if err != nil {
+		return true, err
+	}
+
+	offer := notification.NewWaitlistSlotOffer(
+		contact.UserID, contact.Channel, contact.Email, contact.Phone,
+		contact.Name, slot.Service, slot.Start, claimToken, expiresAt,
+	)
+	return true, s.notificationService.Send(ctx, offer)
+}
@@ -16,9 +16,11 @@ type UpdateCustomerCommand struct {
 	name        *valueobject.PersonName
 	gender      *enum.PersonGender
 	dateOfBirth *time.Time
+
+	preferredChannel *enum.NotificationChannel
 }
 
-func NewUpdateCustomerCommand(id uint, photo, firstName *string, lastName, gender *string, dateOfBirth *time.Time) (UpdateCustomerCommand, error) {
+func NewUpdateCustomerCommand(id uint, photo, firstName *string, lastName, gender *string, dateOfBirth *time.Time, preferredChannel *string) (UpdateCustomerCommand, error) {
 	cmd := UpdateCustomerCommand{
 		id:          valueobject.NewCustomerID(id),
 		name:        valueobject.NewOptPersonName(firstName, lastName),
@@ -27,6 +29,14 @@ func NewUpdateCustomerCommand(id uint, photo, firstName *string, lastName, gende
 		gender:      enum.NullableGender(gender),
 	}
 
+	if preferredChannel != nil {
+		channel, err := enum.ParseNotificationChannel(*preferredChannel)
+		if err != nil {
+			return UpdateCustomerCommand{}, UpdateCustomerCmdErr("preferred_channel", err.Error())
+		}
+		cmd.preferredChannel = &channel
+	}
+
 	if err := cmd.validate(); err != nil {
 		return UpdateCustomerCommand{}, err
 	}
@@ -61,6 +71,12 @@ func (cmd *UpdateCustomerCommand) UpdateEntity(existingCustomer c.Customer) c.Cu
 		builder.WithDateOfBirth(existingCustomer.DateOfBirth())
 	}
 
+	if cmd.preferredChannel != nil {
+		builder.WithPreferredChannel(*cmd.preferredChannel)
+	} else {
+		builder.WithPreferredChannel(existingCustomer.PreferredChannel())
+	}
+
 	return *builder.Build()
 }
 
@@ -91,6 +107,12 @@ func (cmd *UpdateCustomerCommand) validate() error {
 		}
 	}
 
+	if cmd.preferredChannel != nil {
+		if *cmd.preferredChannel != enum.NotificationChannelEmail && *cmd.preferredChannel != enum.NotificationChannelSMS {
+			return UpdateCustomerCmdErr("preferred_channel", "Preferred channel must be email or sms")
+		}
+	}
+
 	return nil
 }
 
@@ -99,6 +121,9 @@ func (cmd *UpdateCustomerCommand) Photo() *string                { return cmd.ph
 func (cmd *UpdateCustomerCommand) Name() *valueobject.PersonName { return cmd.name }
 func (cmd *UpdateCustomerCommand) DateOfBirth() *time.Time       { return cmd.dateOfBirth }
 func (cmd *UpdateCustomerCommand) Gender() *enum.PersonGender    { return cmd.gender }
+func (cmd *UpdateCustomerCommand) PreferredChannel() *enum.NotificationChannel {
+	return cmd.preferredChannel
+}
 
 type DeactivateCustomerCommand struct {
 	id valueobject.CustomerID
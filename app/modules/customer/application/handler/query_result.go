@@ -18,6 +18,7 @@ type CustomerResult struct {
 	UserID      *valueobject.UserID
 	IsActive    bool
 	PetsCount   int
+	Channel     enum.NotificationChannel
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
@@ -33,6 +34,7 @@ func customerToResult(customer customer.Customer) CustomerResult {
 		FirstName:   customer.FirstName(),
 		LastName:    customer.LastName(),
 		DateOfBirth: customer.DateOfBirth(),
+		Channel:     customer.PreferredChannel(),
 		UpdatedAt:   customer.UpdatedAt(),
 		CreatedAt:   customer.CreatedAt(),
 	}
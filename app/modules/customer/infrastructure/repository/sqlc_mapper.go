@@ -18,6 +18,8 @@ func (r *SqlcCustomerRepository) toCreateParams(customer customer.Customer) *sql
 		Gender:      models.PersonGender(customer.Gender().String()),
 		IsActive:    customer.IsActive(),
 		UserID:      r.mapper.UserIDPtrToInt32(customer.UserID()),
+
+		PreferredNotificationChannel: customer.PreferredChannel().String(),
 	}
 }
 
@@ -31,6 +33,8 @@ func (r *SqlcCustomerRepository) ToUpdateParams(customer customer.Customer) *sql
 		DateOfBirth: r.mapper.TimeToPgDate(customer.DateOfBirth()),
 		IsActive:    customer.IsActive(),
 		UserID:      r.mapper.UserIDPtrToInt32(customer.UserID()),
+
+		PreferredNotificationChannel: customer.PreferredChannel().String(),
 	}
 }
 
@@ -43,6 +47,7 @@ func (r *SqlcCustomerRepository) sqlcRowToEntityWithPets(row sqlc.Customer, pets
 		WithGender(enum.PersonGender(string(row.Gender))).
 		WithIsActive(row.IsActive).
 		WithUserID(r.mapper.Int32ToUserIDPtr(row.UserID.Int32)).
+		WithPreferredChannel(enum.NotificationChannel(row.PreferredNotificationChannel)).
 		WithPets(pets).
 		WithTimestamp(row.CreatedAt.Time, row.UpdatedAt.Time).
 		Build()
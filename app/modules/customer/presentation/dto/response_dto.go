@@ -29,6 +29,9 @@ type CustomerResponse struct {
 	// Whether the customer is active
 	IsActive bool `json:"is_active" example:"true"`
 
+	// Channel used to send reminders to the customer
+	PreferredChannel string `json:"preferred_channel" example:"email"`
+
 	// Creation timestamp
 	CreatedAt string `json:"created_at" example:"2024-01-01T12:00:00Z"`
 
@@ -38,15 +41,16 @@ type CustomerResponse struct {
 
 func FromResult(result handler.CustomerResult) *CustomerResponse {
 	return &CustomerResponse{
-		ID:          result.ID.Value(),
-		FirstName:   result.FirstName,
-		LastName:    result.LastName,
-		Gender:      result.Gender.DisplayName(),
-		DateOfBirth: result.DateOfBirth.Format(time.DateOnly),
-		PetCount:    result.PetsCount,
-		IsActive:    result.IsActive,
-		CreatedAt:   result.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:   result.UpdatedAt.Format(time.RFC3339),
+		ID:               result.ID.Value(),
+		FirstName:        result.FirstName,
+		LastName:         result.LastName,
+		Gender:           result.Gender.DisplayName(),
+		DateOfBirth:      result.DateOfBirth.Format(time.DateOnly),
+		PetCount:         result.PetsCount,
+		IsActive:         result.IsActive,
+		PreferredChannel: result.Channel.String(),
+		CreatedAt:        result.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:        result.UpdatedAt.Format(time.RFC3339),
 	}
 }
 
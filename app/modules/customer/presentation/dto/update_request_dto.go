@@ -31,6 +31,11 @@ type UpdateCustomerRequest struct {
 	// URL to customer's photo
 	// Required: false
 	Photo *string `json:"photo,omitempty" example:"https://example.com/photo.jpg"`
+
+	// Channel used to send reminders and notices to the customer
+	// Required: false
+	// Enum: email, sms
+	PreferredChannel *string `json:"preferred_channel,omitempty" binding:"omitempty,oneof=email sms" example:"email"`
 }
 
 // ToCommand converts UpdateCustomerRequest to UpdateCustomerCommand
@@ -42,6 +47,7 @@ func (r *UpdateCustomerRequest) ToCommand(customerID uint) (command.UpdateCustom
 		r.LastName,
 		r.Gender,
 		r.DateOfBirth,
+		r.PreferredChannel,
 	)
 
 }
@@ -0,0 +1,57 @@
+package command
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/employee"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+const DefaultOnCallShiftHours = 24
+
+type GenerateOnCallRotationCommand struct {
+	specialty  enum.VetSpecialty
+	startsAt   time.Time
+	endsAt     time.Time
+	shiftHours int
+}
+
+// NewGenerateOnCallRotationCommand builds the roster of a specialty for the period, shifts last a
+// day unless shiftHours is given
+func NewGenerateOnCallRotationCommand(specialty string, startsAt, endsAt time.Time, shiftHours *int) (GenerateOnCallRotationCommand, error) {
+	operation := "GenerateOnCallRotationCommand"
+
+	parsedSpecialty, err := enum.ParseVetSpecialty(specialty)
+	if err != nil {
+		return GenerateOnCallRotationCommand{}, apperror.CommandDataValidationError("specialty", err.Error(), operation)
+	}
+
+	if !endsAt.After(startsAt) {
+		return GenerateOnCallRotationCommand{}, apperror.CommandDataValidationError("ends_at", "End must be after the start", operation)
+	}
+
+	cmd := GenerateOnCallRotationCommand{
+		specialty:  parsedSpecialty,
+		startsAt:   startsAt,
+		endsAt:     endsAt,
+		shiftHours: DefaultOnCallShiftHours,
+	}
+	if shiftHours != nil {
+		cmd.shiftHours = *shiftHours
+	}
+
+	return cmd, nil
+}
+
+func (c GenerateOnCallRotationCommand) Specialty() enum.VetSpecialty { return c.specialty }
+func (c GenerateOnCallRotationCommand) StartsAt() time.Time          { return c.startsAt }
+func (c GenerateOnCallRotationCommand) EndsAt() time.Time            { return c.endsAt }
+func (c GenerateOnCallRotationCommand) Rotation() employee.OnCallRotation {
+	return employee.OnCallRotation{
+		Specialty:  c.specialty,
+		StartsAt:   c.startsAt,
+		EndsAt:     c.endsAt,
+		ShiftHours: c.shiftHours,
+	}
+}
@@ -0,0 +1,111 @@
+package command
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+type RequestScheduleExceptionCommand struct {
+	employeeID    valueobject.EmployeeID
+	exceptionType enum.ScheduleExceptionType
+	startDate     time.Time
+	endDate       time.Time
+	reason        string
+}
+
+// NewRequestScheduleExceptionCommand asks for time off or an extra shift, pending the approval of a manager
+func NewRequestScheduleExceptionCommand(employeeID uint, exceptionType string, startDate, endDate time.Time, reason string) (RequestScheduleExceptionCommand, error) {
+	operation := "RequestScheduleExceptionCommand"
+
+	if employeeID == 0 {
+		return RequestScheduleExceptionCommand{}, apperror.CommandDataValidationError("employee_id", "Employee ID is required", operation)
+	}
+
+	parsedType, err := enum.ParseScheduleExceptionType(exceptionType)
+	if err != nil {
+		return RequestScheduleExceptionCommand{}, apperror.CommandDataValidationError("type", err.Error(), operation)
+	}
+
+	if !endDate.After(startDate) {
+		return RequestScheduleExceptionCommand{}, apperror.CommandDataValidationError("end_date", "End date must be after the start date", operation)
+	}
+
+	if reason == "" {
+		return RequestScheduleExceptionCommand{}, apperror.CommandDataValidationError("reason", "Reason is required", operation)
+	}
+
+	return RequestScheduleExceptionCommand{
+		employeeID:    valueobject.NewEmployeeID(employeeID),
+		exceptionType: parsedType,
+		startDate:     startDate,
+		endDate:       endDate,
+		reason:        reason,
+	}, nil
+}
+
+func (c RequestScheduleExceptionCommand) EmployeeID() valueobject.EmployeeID { return c.employeeID }
+func (c RequestScheduleExceptionCommand) Type() enum.ScheduleExceptionType   { return c.exceptionType }
+func (c RequestScheduleExceptionCommand) StartDate() time.Time               { return c.startDate }
+func (c RequestScheduleExceptionCommand) EndDate() time.Time                 { return c.endDate }
+func (c RequestScheduleExceptionCommand) Reason() string                     { return c.reason }
+
+type ReviewScheduleExceptionCommand struct {
+	id         valueobject.ScheduleExcID
+	reviewerID valueobject.UserID
+	approve    bool
+	notes      *string
+}
+
+// NewReviewScheduleExceptionCommand approves or rejects a pending request on behalf of a manager
+func NewReviewScheduleExceptionCommand(id, reviewerID uint, approve bool, notes *string) (ReviewScheduleExceptionCommand, error) {
+	operation := "ReviewScheduleExceptionCommand"
+
+	if id == 0 {
+		return ReviewScheduleExceptionCommand{}, apperror.CommandDataValidationError("id", "Schedule exception ID is required", operation)
+	}
+
+	if reviewerID == 0 {
+		return ReviewScheduleExceptionCommand{}, apperror.CommandDataValidationError("reviewed_by", "Reviewer is required", operation)
+	}
+
+	return ReviewScheduleExceptionCommand{
+		id:         valueobject.NewScheduleExcID(id),
+		reviewerID: valueobject.NewUserID(reviewerID),
+		approve:    approve,
+		notes:      notes,
+	}, nil
+}
+
+func (c ReviewScheduleExceptionCommand) ID() valueobject.ScheduleExcID  { return c.id }
+func (c ReviewScheduleExceptionCommand) ReviewerID() valueobject.UserID { return c.reviewerID }
+func (c ReviewScheduleExceptionCommand) Approve() bool                  { return c.approve }
+func (c ReviewScheduleExceptionCommand) Notes() *string                 { return c.notes }
+
+type CancelScheduleExceptionCommand struct {
+	id         valueobject.ScheduleExcID
+	employeeID valueobject.EmployeeID
+}
+
+// NewCancelScheduleExceptionCommand withdraws a request of the employee
+func NewCancelScheduleExceptionCommand(id, employeeID uint) (CancelScheduleExceptionCommand, error) {
+	operation := "CancelScheduleExceptionCommand"
+
+	if id == 0 {
+		return CancelScheduleExceptionCommand{}, apperror.CommandDataValidationError("id", "Schedule exception ID is required", operation)
+	}
+
+	if employeeID == 0 {
+		return CancelScheduleExceptionCommand{}, apperror.CommandDataValidationError("employee_id", "Employee ID is required", operation)
+	}
+
+	return CancelScheduleExceptionCommand{
+		id:         valueobject.NewScheduleExcID(id),
+		employeeID: valueobject.NewEmployeeID(employeeID),
+	}, nil
+}
+
+func (c CancelScheduleExceptionCommand) ID() valueobject.ScheduleExcID      { return c.id }
+func (c CancelScheduleExceptionCommand) EmployeeID() valueobject.EmployeeID { return c.employeeID }
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
 	c "clinic-vet-api/app/modules/employee/application/command"
 	"clinic-vet-api/app/shared/cqrs"
 	"context"
@@ -12,19 +13,53 @@ var (
 	FailSaveEmployeeMsg   = "an error occurred saving employee"
 	FailDeleteEmployeeMsg = "failing deleting employee"
 	FailBuissnessLogicMsg = "employee business logic validation failed"
+	FailLoadCalendarMsg   = "an error occurred loading the clinic calendar"
 	EmployeeNotFoundMsg   = "employee not found"
 
+	FailFindScheduleExceptionMsg = "an error occurred finding schedule exception"
+	FailSaveScheduleExceptionMsg = "an error occurred saving schedule exception"
+	FailGenerateOnCallMsg        = "an error occurred generating the on-call rotation"
+	FailCoverTimeOffMsg          = "an error occurred handing over the appointments booked during the time off"
+
 	SuccessEmployeeCreatedMsg = "Employee created successfully"
 	SuccessEmployeeUpdatedMsg = "Employee updated successfully"
 	SuccessEmployeeDeletedMsg = "Employee deleted successfully"
+
+	SuccessScheduleExceptionRequestedMsg = "Schedule exception requested successfully, pending approval"
+	SuccessScheduleExceptionApprovedMsg  = "Schedule exception approved successfully"
+	SuccessTimeOffApprovedMsg            = "Time off approved successfully, %d appointments proposed to another veterinarian and %d cancelled"
+	SuccessScheduleExceptionRejectedMsg  = "Schedule exception rejected successfully"
+	SuccessScheduleExceptionCancelledMsg = "Schedule exception cancelled successfully"
+
+	SuccessOnCallGeneratedMsg = "On-call rotation generated successfully with %d shifts"
 )
 
 type EmployeeCommandHandler struct {
-	employeeRepo repository.EmployeeRepository
+	employeeRepo  repository.EmployeeRepository
+	calendarRepo  repository.ClinicCalendarRepository
+	exceptionRepo repository.ScheduleExceptionRepository
+	onCallRepo    repository.OnCallRepository
+	timeOff       *service.AbsenceCoverageService
+}
+
+func NewEmployeeCommandHandler(
+	employeeRepo repository.EmployeeRepository,
+	calendarRepo repository.ClinicCalendarRepository,
+	exceptionRepo repository.ScheduleExceptionRepository,
+	onCallRepo repository.OnCallRepository,
+) *EmployeeCommandHandler {
+	return &EmployeeCommandHandler{
+		employeeRepo:  employeeRepo,
+		calendarRepo:  calendarRepo,
+		exceptionRepo: exceptionRepo,
+		onCallRepo:    onCallRepo,
+	}
 }
 
-func NewEmployeeCommandHandler(employeeRepo repository.EmployeeRepository) *EmployeeCommandHandler {
-	return &EmployeeCommandHandler{employeeRepo: employeeRepo}
+// SetTimeOffCoverage hands the appointments booked during approved time off over to the absence
+// coverage of the appointment module, which is built after the employee module
+func (h *EmployeeCommandHandler) SetTimeOffCoverage(coverage *service.AbsenceCoverageService) {
+	h.timeOff = coverage
 }
 
 func (h *EmployeeCommandHandler) HandleCreate(ctx context.Context, cmd c.CreateEmployeeCommand) cqrs.CommandResult {
@@ -34,6 +69,15 @@ func (h *EmployeeCommandHandler) HandleCreate(ctx context.Context, cmd c.CreateE
 		return cqrs.FailureResult(FailBuissnessLogicMsg, err)
 	}
 
+	clinicCalendar, err := h.calendarRepo.Get(ctx)
+	if err != nil {
+		return cqrs.FailureResult(FailLoadCalendarMsg, err)
+	}
+
+	if err := employee.ValidateSchedule(ctx, clinicCalendar); err != nil {
+		return cqrs.FailureResult(FailBuissnessLogicMsg, err)
+	}
+
 	if err := h.employeeRepo.Save(ctx, &employee); err != nil {
 		return cqrs.FailureResult(FailSaveEmployeeMsg, err)
 	}
@@ -48,6 +92,16 @@ func (h *EmployeeCommandHandler) HandleUpdate(ctx context.Context, cmd c.UpdateE
 	}
 
 	employeeUpdated := cmd.UpdateEmployee(existingEmployee)
+
+	clinicCalendar, err := h.calendarRepo.Get(ctx)
+	if err != nil {
+		return cqrs.FailureResult(FailLoadCalendarMsg, err)
+	}
+
+	if err := employeeUpdated.ValidateSchedule(ctx, clinicCalendar); err != nil {
+		return cqrs.FailureResult(FailBuissnessLogicMsg, err)
+	}
+
 	if err := h.employeeRepo.Save(ctx, &employeeUpdated); err != nil {
 		return cqrs.FailureResult(FailSaveEmployeeMsg, err)
 	}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/employee"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	c "clinic-vet-api/app/modules/employee/application/command"
+	q "clinic-vet-api/app/modules/employee/application/query"
+	"clinic-vet-api/app/shared/cqrs"
+	"clinic-vet-api/app/shared/page"
+)
+
+// HandleGenerateOnCallRotation builds the roster of the specialty for the period and replaces the
+// shifts it overlaps. Time off approved by then is honored, later approvals are handled when
+// looking up who is on call
+func (h *EmployeeCommandHandler) HandleGenerateOnCallRotation(ctx context.Context, cmd c.GenerateOnCallRotationCommand) cqrs.CommandResult {
+	rotation := cmd.Rotation()
+	if err := rotation.Validate(ctx); err != nil {
+		return cqrs.FailureResult(FailBuissnessLogicMsg, err)
+	}
+
+	candidates, err := h.employeesOf(ctx, rotation.Specialty)
+	if err != nil {
+		return cqrs.FailureResult(FailFindEmployeeMsg, err)
+	}
+
+	var exceptions []employee.ScheduleException
+	for _, candidate := range candidates {
+		approved, err := h.exceptionRepo.FindApproved(ctx, candidate.ID(), rotation.StartsAt, rotation.EndsAt)
+		if err != nil {
+			return cqrs.FailureResult(FailFindScheduleExceptionMsg, err)
+		}
+		exceptions = append(exceptions, approved...)
+	}
+
+	lastShift, err := h.onCallRepo.FindLastBefore(ctx, rotation.Specialty, rotation.StartsAt)
+	if err != nil {
+		return cqrs.FailureResult(FailGenerateOnCallMsg, err)
+	}
+
+	shifts, err := employee.GenerateOnCallRotation(ctx, rotation, candidates, exceptions, lastOnCallID(lastShift))
+	if err != nil {
+		return cqrs.FailureResult(FailBuissnessLogicMsg, err)
+	}
+
+	if err := h.onCallRepo.ReplaceRotation(ctx, rotation.Specialty, rotation.StartsAt, rotation.EndsAt, shifts); err != nil {
+		return cqrs.FailureResult(FailGenerateOnCallMsg, err)
+	}
+
+	return cqrs.SuccessResult(fmt.Sprintf(SuccessOnCallGeneratedMsg, len(shifts)))
+}
+
+// employeesOf pages through every employee of the specialty, eligibility is left to the rotation
+func (h *EmployeeCommandHandler) employeesOf(ctx context.Context, specialty enum.VetSpecialty) ([]employee.Employee, error) {
+	var employees []employee.Employee
+
+	for pageNumber := int32(page.DefaultPage); ; pageNumber++ {
+		employeePage, err := h.employeeRepo.FindBySpeciality(ctx, specialty, page.PaginationRequest{
+			Page:     pageNumber,
+			PageSize: page.MaxPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		employees = append(employees, employeePage.Items...)
+		if len(employeePage.Items) < page.MaxPageSize {
+			return employees, nil
+		}
+	}
+}
+
+// HandleFindOnCallNow lists who is on call right now, one shift per rotation
+func (h *EmployeeQueryHandler) HandleFindOnCallNow(ctx context.Context, query q.FindOnCallNowQuery) ([]OnCallShiftResult, error) {
+	shifts, err := h.onCall.OnCallAt(ctx, time.Now(), query.Specialty())
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]OnCallShiftResult, len(shifts))
+	for i, shift := range shifts {
+		onCallEmployee, err := h.employeeRepo.FindByID(ctx, shift.EmployeeID())
+		if err != nil {
+			return nil, err
+		}
+		results[i] = onCallShiftToResult(shift, &onCallEmployee)
+	}
+	return results, nil
+}
+
+func (h *EmployeeQueryHandler) HandleFindOnCallRoster(ctx context.Context, query q.FindOnCallRosterQuery) (page.Page[OnCallShiftResult], error) {
+	shiftPage, err := h.onCallRepo.FindByPeriod(ctx, query.StartsAt(), query.EndsAt(), query.Specialty(), query.Pagination())
+	if err != nil {
+		return page.Page[OnCallShiftResult]{}, err
+	}
+
+	return page.MapItems(shiftPage, func(shift employee.OnCallShift) OnCallShiftResult {
+		return onCallShiftToResult(shift, nil)
+	}), nil
+}
+
+func lastOnCallID(shift *employee.OnCallShift) *valueobject.EmployeeID {
+	if shift == nil {
+		return nil
+	}
+	employeeID := shift.EmployeeID()
+	return &employeeID
+}
@@ -2,18 +2,30 @@ package handler
 
 import (
 	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
 	q "clinic-vet-api/app/modules/employee/application/query"
 	"clinic-vet-api/app/shared/page"
 	"context"
 )
 
 type EmployeeQueryHandler struct {
-	employeeRepo repository.EmployeeRepository
+	employeeRepo  repository.EmployeeRepository
+	exceptionRepo repository.ScheduleExceptionRepository
+	onCallRepo    repository.OnCallRepository
+	onCall        *service.OnCallService
 }
 
-func NewEmployeeQueryHandler(employeeRepo repository.EmployeeRepository) *EmployeeQueryHandler {
+func NewEmployeeQueryHandler(
+	employeeRepo repository.EmployeeRepository,
+	exceptionRepo repository.ScheduleExceptionRepository,
+	onCallRepo repository.OnCallRepository,
+	onCall *service.OnCallService,
+) *EmployeeQueryHandler {
 	return &EmployeeQueryHandler{
-		employeeRepo: employeeRepo,
+		employeeRepo:  employeeRepo,
+		exceptionRepo: exceptionRepo,
+		onCallRepo:    onCallRepo,
+		onCall:        onCall,
 	}
 }
 
@@ -71,3 +71,65 @@ func employeeToResult(employee employee.Employee) EmployeeResult {
 		UserID:          userID,
 	}
 }
+
+type ScheduleExceptionResult struct {
+	ID          uint
+	EmployeeID  uint
+	Type        string
+	StartDate   time.Time
+	EndDate     time.Time
+	Reason      string
+	Status      string
+	ReviewedBy  *uint
+	ReviewNotes *string
+	ReviewedAt  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func scheduleExceptionToResult(exception employee.ScheduleException) ScheduleExceptionResult {
+	result := ScheduleExceptionResult{
+		ID:          exception.ID().Value(),
+		EmployeeID:  exception.EmployeeID().Value(),
+		Type:        exception.Type().String(),
+		StartDate:   exception.StartDate(),
+		EndDate:     exception.EndDate(),
+		Reason:      exception.Reason(),
+		Status:      exception.Status().String(),
+		ReviewNotes: exception.ReviewNotes(),
+		ReviewedAt:  exception.ReviewedAt(),
+		CreatedAt:   exception.CreatedAt(),
+		UpdatedAt:   exception.UpdatedAt(),
+	}
+
+	if exception.ReviewedBy() != nil {
+		reviewedBy := exception.ReviewedBy().Value()
+		result.ReviewedBy = &reviewedBy
+	}
+	return result
+}
+
+type OnCallShiftResult struct {
+	ID           uint
+	EmployeeID   uint
+	EmployeeName string
+	Specialty    string
+	StartsAt     time.Time
+	EndsAt       time.Time
+}
+
+// onCallShiftToResult maps the shift, naming the employee when it was loaded
+func onCallShiftToResult(shift employee.OnCallShift, onCallEmployee *employee.Employee) OnCallShiftResult {
+	result := OnCallShiftResult{
+		ID:         shift.ID().Value(),
+		EmployeeID: shift.EmployeeID().Value(),
+		Specialty:  shift.Specialty().String(),
+		StartsAt:   shift.StartsAt(),
+		EndsAt:     shift.EndsAt(),
+	}
+
+	if onCallEmployee != nil {
+		result.EmployeeName = onCallEmployee.FullName().FullName()
+	}
+	return result
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/employee"
+	c "clinic-vet-api/app/modules/employee/application/command"
+	q "clinic-vet-api/app/modules/employee/application/query"
+	"clinic-vet-api/app/shared/cqrs"
+	apperror "clinic-vet-api/app/shared/error/application"
+	"clinic-vet-api/app/shared/page"
+)
+
+// HandleRequestScheduleException files the request of the employee, it has no effect on their
+// availability until a manager approves it
+func (h *EmployeeCommandHandler) HandleRequestScheduleException(ctx context.Context, cmd c.RequestScheduleExceptionCommand) cqrs.CommandResult {
+	if _, err := h.employeeRepo.FindByID(ctx, cmd.EmployeeID()); err != nil {
+		return cqrs.FailureResult(FailFindEmployeeMsg, err)
+	}
+
+	exception, err := employee.RequestScheduleException(
+		ctx, cmd.EmployeeID(), cmd.Type(), cmd.StartDate(), cmd.EndDate(), cmd.Reason(), time.Now(),
+	)
+	if err != nil {
+		return cqrs.FailureResult(FailBuissnessLogicMsg, err)
+	}
+
+	if err := h.exceptionRepo.Save(ctx, exception); err != nil {
+		return cqrs.FailureResult(FailSaveScheduleExceptionMsg, err)
+	}
+
+	return cqrs.SuccessCreateResult(exception.ID().String(), SuccessScheduleExceptionRequestedMsg)
+}
+
+// HandleReviewScheduleException approves or rejects a pending request. Approving time off hands the
+// appointments confirmed during it over to a colleague or cancels them, in the same transaction
+func (h *EmployeeCommandHandler) HandleReviewScheduleException(ctx context.Context, cmd c.ReviewScheduleExceptionCommand) cqrs.CommandResult {
+	exception, err := h.exceptionRepo.FindByID(ctx, cmd.ID())
+	if err != nil {
+		return cqrs.FailureResult(FailFindScheduleExceptionMsg, err)
+	}
+
+	successMsg := SuccessScheduleExceptionApprovedMsg
+	if cmd.Approve() {
+		err = exception.Approve(ctx, cmd.ReviewerID(), cmd.Notes(), time.Now())
+	} else {
+		successMsg = SuccessScheduleExceptionRejectedMsg
+		err = exception.Reject(ctx, cmd.ReviewerID(), cmd.Notes(), time.Now())
+	}
+	if err != nil {
+		return cqrs.FailureResult(FailBuissnessLogicMsg, err)
+	}
+
+	if exception.IsApproved() && exception.IsTimeOff() && h.timeOff != nil {
+		coverage, err := h.timeOff.CoverTimeOff(ctx, &exception)
+		if err != nil {
+			return cqrs.FailureResult(FailCoverTimeOffMsg, err)
+		}
+		return cqrs.SuccessResult(fmt.Sprintf(SuccessTimeOffApprovedMsg, len(coverage.Proposed), len(coverage.Cancelled)))
+	}
+
+	if err := h.exceptionRepo.Save(ctx, &exception); err != nil {
+		return cqrs.FailureResult(FailSaveScheduleExceptionMsg, err)
+	}
+
+	return cqrs.SuccessResult(successMsg)
+}
+
+// HandleCancelScheduleException withdraws a request of the employee, only its owner can cancel it
+func (h *EmployeeCommandHandler) HandleCancelScheduleException(ctx context.Context, cmd c.CancelScheduleExceptionCommand) cqrs.CommandResult {
+	exception, err := h.exceptionRepo.FindByID(ctx, cmd.ID())
+	if err != nil {
+		return cqrs.FailureResult(FailFindScheduleExceptionMsg, err)
+	}
+
+	if !exception.IsOwnedBy(cmd.EmployeeID()) {
+		return cqrs.FailureResult(FailFindScheduleExceptionMsg,
+			apperror.EntityNotFoundValidationError("ScheduleException", "id", cmd.ID().String()))
+	}
+
+	if err := exception.Cancel(ctx, time.Now()); err != nil {
+		return cqrs.FailureResult(FailBuissnessLogicMsg, err)
+	}
+
+	if err := h.exceptionRepo.Save(ctx, &exception); err != nil {
+		return cqrs.FailureResult(FailSaveScheduleExceptionMsg, err)
+	}
+
+	return cqrs.SuccessResult(SuccessScheduleExceptionCancelledMsg)
+}
+
+func (h *EmployeeQueryHandler) HandleFindScheduleExceptions(ctx context.Context, query q.FindScheduleExceptionsQuery) (page.Page[ScheduleExceptionResult], error) {
+	var exceptionsPage page.Page[employee.ScheduleException]
+	var err error
+
+	if query.EmployeeID() != nil {
+		exceptionsPage, err = h.exceptionRepo.FindByEmployee(ctx, *query.EmployeeID(), query.Status(), query.Pagination())
+	} else {
+		exceptionsPage, err = h.exceptionRepo.FindByStatus(ctx, *query.Status(), query.Pagination())
+	}
+	if err != nil {
+		return page.Page[ScheduleExceptionResult]{}, err
+	}
+
+	return page.MapItems(exceptionsPage, scheduleExceptionToResult), nil
+}
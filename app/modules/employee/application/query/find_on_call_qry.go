@@ -0,0 +1,67 @@
+package query
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/enum"
+	apperror "clinic-vet-api/app/shared/error/application"
+	"clinic-vet-api/app/shared/page"
+)
+
+// FindOnCallNowQuery looks up who is on call right now, optionally for a single specialty
+type FindOnCallNowQuery struct {
+	specialty *enum.VetSpecialty
+}
+
+func NewFindOnCallNowQuery(specialty string) (FindOnCallNowQuery, error) {
+	parsedSpecialty, err := parseOptSpecialty(specialty)
+	if err != nil {
+		return FindOnCallNowQuery{}, err
+	}
+	return FindOnCallNowQuery{specialty: parsedSpecialty}, nil
+}
+
+func (q FindOnCallNowQuery) Specialty() *enum.VetSpecialty { return q.specialty }
+
+// FindOnCallRosterQuery lists the on-call shifts overlapping the period
+type FindOnCallRosterQuery struct {
+	startsAt   time.Time
+	endsAt     time.Time
+	specialty  *enum.VetSpecialty
+	pagination page.PaginationRequest
+}
+
+func NewFindOnCallRosterQuery(startsAt, endsAt time.Time, specialty string, pagination page.PaginationRequest) (FindOnCallRosterQuery, error) {
+	if !endsAt.After(startsAt) {
+		return FindOnCallRosterQuery{}, apperror.FieldValidationError("end_date", endsAt.Format(time.RFC3339), "end date must be after the start date")
+	}
+
+	parsedSpecialty, err := parseOptSpecialty(specialty)
+	if err != nil {
+		return FindOnCallRosterQuery{}, err
+	}
+
+	return FindOnCallRosterQuery{
+		startsAt:   startsAt,
+		endsAt:     endsAt,
+		specialty:  parsedSpecialty,
+		pagination: pagination,
+	}, nil
+}
+
+func (q FindOnCallRosterQuery) StartsAt() time.Time                { return q.startsAt }
+func (q FindOnCallRosterQuery) EndsAt() time.Time                  { return q.endsAt }
+func (q FindOnCallRosterQuery) Specialty() *enum.VetSpecialty      { return q.specialty }
+func (q FindOnCallRosterQuery) Pagination() page.PaginationRequest { return q.pagination }
+
+func parseOptSpecialty(specialty string) (*enum.VetSpecialty, error) {
+	if specialty == "" {
+		return nil, nil
+	}
+
+	parsedSpecialty, err := enum.ParseVetSpecialty(specialty)
+	if err != nil {
+		return nil, apperror.FieldValidationError("specialty", specialty, err.Error())
+	}
+	return &parsedSpecialty, nil
+}
@@ -0,0 +1,43 @@
+package query
+
+import (
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+	"clinic-vet-api/app/shared/page"
+)
+
+// FindScheduleExceptionsQuery lists the exceptions of a single employee, or of every employee in
+// the status when no employee is given. Without an employee the status defaults to pending, the
+// requests waiting for a manager
+type FindScheduleExceptionsQuery struct {
+	employeeID *valueobject.EmployeeID
+	status     *enum.ScheduleExceptionStatus
+	pagination page.PaginationRequest
+}
+
+func NewFindScheduleExceptionsQuery(employeeID *uint, status string, pagination page.PaginationRequest) (FindScheduleExceptionsQuery, error) {
+	query := FindScheduleExceptionsQuery{
+		employeeID: valueobject.NewOptEmployeeID(employeeID),
+		pagination: pagination,
+	}
+
+	if status != "" {
+		parsedStatus, err := enum.ParseScheduleExceptionStatus(status)
+		if err != nil {
+			return FindScheduleExceptionsQuery{}, apperror.FieldValidationError("status", status, err.Error())
+		}
+		query.status = &parsedStatus
+	}
+
+	if query.employeeID == nil && query.status == nil {
+		pending := enum.ScheduleExceptionStatusPending
+		query.status = &pending
+	}
+
+	return query, nil
+}
+
+func (q FindScheduleExceptionsQuery) EmployeeID() *valueobject.EmployeeID   { return q.employeeID }
+func (q FindScheduleExceptionsQuery) Status() *enum.ScheduleExceptionStatus { return q.status }
+func (q FindScheduleExceptionsQuery) Pagination() page.PaginationRequest    { return q.pagination }
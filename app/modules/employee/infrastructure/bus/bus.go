@@ -16,16 +16,25 @@ type EmployeeCqrsBus interface {
 
 	FindEmployeeByID(ctx context.Context, qry q.FindEmployeeByIDQuery) (h.EmployeeResult, error)
 	FindActiveEmployees(ctx context.Context, qry q.FindActiveEmployeesQuery) (p.Page[h.EmployeeResult], error)
+
+	RequestScheduleException(ctx context.Context, cmd c.RequestScheduleExceptionCommand) cqrs.CommandResult
+	ReviewScheduleException(ctx context.Context, cmd c.ReviewScheduleExceptionCommand) cqrs.CommandResult
+	CancelScheduleException(ctx context.Context, cmd c.CancelScheduleExceptionCommand) cqrs.CommandResult
+	FindScheduleExceptions(ctx context.Context, qry q.FindScheduleExceptionsQuery) (p.Page[h.ScheduleExceptionResult], error)
+
+	GenerateOnCallRotation(ctx context.Context, cmd c.GenerateOnCallRotationCommand) cqrs.CommandResult
+	FindOnCallNow(ctx context.Context, qry q.FindOnCallNowQuery) ([]h.OnCallShiftResult, error)
+	FindOnCallRoster(ctx context.Context, qry q.FindOnCallRosterQuery) (p.Page[h.OnCallShiftResult], error)
 }
 
 type employeeQueryBus struct {
 	queryHandler   h.EmployeeQueryHandler
-	commandHandler h.EmployeeCommandHandler
+	commandHandler *h.EmployeeCommandHandler
 }
 
 func NewEmployeeCqrsBus(
 	queryHandler h.EmployeeQueryHandler,
-	commandHandler h.EmployeeCommandHandler,
+	commandHandler *h.EmployeeCommandHandler,
 ) EmployeeCqrsBus {
 	return &employeeQueryBus{
 		queryHandler:   queryHandler,
@@ -52,3 +61,31 @@ func (b *employeeQueryBus) FindEmployeeByID(ctx context.Context, qry q.FindEmplo
 func (b *employeeQueryBus) FindActiveEmployees(ctx context.Context, qry q.FindActiveEmployeesQuery) (p.Page[h.EmployeeResult], error) {
 	return b.queryHandler.HandleFindActives(ctx, qry)
 }
+
+func (b *employeeQueryBus) RequestScheduleException(ctx context.Context, cmd c.RequestScheduleExceptionCommand) cqrs.CommandResult {
+	return b.commandHandler.HandleRequestScheduleException(ctx, cmd)
+}
+
+func (b *employeeQueryBus) ReviewScheduleException(ctx context.Context, cmd c.ReviewScheduleExceptionCommand) cqrs.CommandResult {
+	return b.commandHandler.HandleReviewScheduleException(ctx, cmd)
+}
+
+func (b *employeeQueryBus) CancelScheduleException(ctx context.Context, cmd c.CancelScheduleExceptionCommand) cqrs.CommandResult {
+	return b.commandHandler.HandleCancelScheduleException(ctx, cmd)
+}
+
+func (b *employeeQueryBus) FindScheduleExceptions(ctx context.Context, qry q.FindScheduleExceptionsQuery) (p.Page[h.ScheduleExceptionResult], error) {
+	return b.queryHandler.HandleFindScheduleExceptions(ctx, qry)
+}
+
+func (b *employeeQueryBus) GenerateOnCallRotation(ctx context.Context, cmd c.GenerateOnCallRotationCommand) cqrs.CommandResult {
+	return b.commandHandler.HandleGenerateOnCallRotation(ctx, cmd)
+}
+
+func (b *employeeQueryBus) FindOnCallNow(ctx context.Context, qry q.FindOnCallNowQuery) ([]h.OnCallShiftResult, error) {
+	return b.queryHandler.HandleFindOnCallNow(ctx, qry)
+}
+
+func (b *employeeQueryBus) FindOnCallRoster(ctx context.Context, qry q.FindOnCallRosterQuery) (p.Page[h.OnCallShiftResult], error) {
+	return b.queryHandler.HandleFindOnCallRoster(ctx, qry)
+}
@@ -23,6 +23,17 @@ const (
 	ErrMsgSoftDeleteEmployee      = "failed to soft delete veterinarian"
 	ErrMsgCheckEmployeeExists     = "failed to check if veterinarian exists"
 	ErrMsgConvertEmployeeToDomain = "failed to convert veterinarian to domain entity"
+
+	TableScheduleExceptions       = "employee_schedule_exceptions"
+	ErrMsgGetScheduleException    = "failed to get schedule exception"
+	ErrMsgListScheduleExceptions  = "failed to list schedule exceptions"
+	ErrMsgCreateScheduleException = "failed to create schedule exception"
+	ErrMsgUpdateScheduleException = "failed to update schedule exception"
+
+	TableOnCallShifts         = "on_call_shifts"
+	ErrMsgGetOnCallShift      = "failed to get on-call shift"
+	ErrMsgListOnCallShifts    = "failed to list on-call shifts"
+	ErrMsgReplaceOnCallShifts = "failed to replace on-call rotation"
 )
 
 func (r *SqlcEmployeeRepository) dbError(operation, message string, err error) error {
@@ -32,3 +43,15 @@ func (r *SqlcEmployeeRepository) dbError(operation, message string, err error) e
 func (r *SqlcEmployeeRepository) notFoundError(parameterName, parameterValue string) error {
 	return dberr.EntityNotFoundError(parameterName, parameterValue, OpSelect, TableEmployees, DriverSQL)
 }
+
+func (r *SqlcScheduleExceptionRepository) dbError(operation, message string, err error) error {
+	return dberr.DatabaseOperationError(operation, TableScheduleExceptions, DriverSQL, fmt.Errorf("%s: %v", message, err))
+}
+
+func (r *SqlcScheduleExceptionRepository) notFoundError(parameterName, parameterValue string) error {
+	return dberr.EntityNotFoundError(parameterName, parameterValue, OpSelect, TableScheduleExceptions, DriverSQL)
+}
+
+func (r *SqlcOnCallRepository) dbError(operation, message string, err error) error {
+	return dberr.DatabaseOperationError(operation, TableOnCallShifts, DriverSQL, fmt.Errorf("%s: %v", message, err))
+}
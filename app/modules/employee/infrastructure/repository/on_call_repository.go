@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	e "clinic-vet-api/app/modules/core/domain/entity/employee"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/database"
+	"clinic-vet-api/app/shared/mapper"
+	p "clinic-vet-api/app/shared/page"
+	"clinic-vet-api/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type SqlcOnCallRepository struct {
+	queries    *sqlc.Queries
+	transactor *database.Transactor
+	pgMap      *mapper.SqlcFieldMapper
+}
+
+func NewSqlcOnCallRepository(queries *sqlc.Queries, transactor *database.Transactor, pgMap *mapper.SqlcFieldMapper) repository.OnCallRepository {
+	return &SqlcOnCallRepository{queries: queries, transactor: transactor, pgMap: pgMap}
+}
+
+func (r *SqlcOnCallRepository) FindAt(ctx context.Context, at time.Time, specialty *enum.VetSpecialty) ([]e.OnCallShift, error) {
+	rows, err := r.queries.FindOnCallShiftsAt(ctx, sqlc.FindOnCallShiftsAtParams{
+		At:        r.pgMap.PgTimestamptz.FromTime(at),
+		Specialty: r.specialtyFilter(specialty),
+	})
+	if err != nil {
+		return nil, r.dbError(OpSelect, ErrMsgListOnCallShifts, err)
+	}
+
+	return r.toEntities(rows), nil
+}
+
+func (r *SqlcOnCallRepository) FindByPeriod(
+	ctx context.Context,
+	start, end time.Time,
+	specialty *enum.VetSpecialty,
+	pagination p.PaginationRequest,
+) (p.Page[e.OnCallShift], error) {
+	rows, err := r.queries.FindOnCallShifts(ctx, sqlc.FindOnCallShiftsParams{
+		PeriodEnd:   r.pgMap.PgTimestamptz.FromTime(end),
+		PeriodStart: r.pgMap.PgTimestamptz.FromTime(start),
+		Specialty:   r.specialtyFilter(specialty),
+		LimitVal:    pagination.Limit(),
+		OffsetVal:   pagination.Offset(),
+	})
+	if err != nil {
+		return p.Page[e.OnCallShift]{}, r.dbError(OpSelect, ErrMsgListOnCallShifts, err)
+	}
+
+	total, err := r.queries.CountOnCallShifts(ctx, sqlc.CountOnCallShiftsParams{
+		PeriodEnd:   r.pgMap.PgTimestamptz.FromTime(end),
+		PeriodStart: r.pgMap.PgTimestamptz.FromTime(start),
+		Specialty:   r.specialtyFilter(specialty),
+	})
+	if err != nil {
+		return p.Page[e.OnCallShift]{}, r.dbError(OpCount, ErrMsgListOnCallShifts, err)
+	}
+
+	return p.NewPage(r.toEntities(rows), total, pagination), nil
+}
+
+func (r *SqlcOnCallRepository) FindLastBefore(ctx context.Context, specialty enum.VetSpecialty, before time.Time) (*e.OnCallShift, error) {
+	row, err := r.queries.FindLastOnCallShiftBefore(ctx, sqlc.FindLastOnCallShiftBeforeParams{
+		Specialty: specialty.String(),
+		Before:    r.pgMap.PgTimestamptz.FromTime(before),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, r.dbError(OpSelect, ErrMsgGetOnCallShift, err)
+	}
+
+	shift := r.toEntity(row)
+	return &shift, nil
+}
+
+// ReplaceRotation deletes the overlapping shifts and inserts the new ones in a single
+// transaction, so the roster is never left half generated
+func (r *SqlcOnCallRepository) ReplaceRotation(ctx context.Context, specialty enum.VetSpecialty, start, end time.Time, shifts []e.OnCallShift) error {
+	return r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+		err := queries.DeleteOnCallShiftsInPeriod(ctx, sqlc.DeleteOnCallShiftsInPeriodParams{
+			Specialty:   specialty.String(),
+			PeriodEnd:   r.pgMap.PgTimestamptz.FromTime(end),
+			PeriodStart: r.pgMap.PgTimestamptz.FromTime(start),
+		})
+		if err != nil {
+			return r.dbError(OpDelete, ErrMsgReplaceOnCallShifts, err)
+		}
+
+		for i := range shifts {
+			row, err := queries.CreateOnCallShift(ctx, sqlc.CreateOnCallShiftParams{
+				EmployeeID: shifts[i].EmployeeID().Int32(),
+				Specialty:  shifts[i].Specialty().String(),
+				StartsAt:   r.pgMap.PgTimestamptz.FromTime(shifts[i].StartsAt()),
+				EndsAt:     r.pgMap.PgTimestamptz.FromTime(shifts[i].EndsAt()),
+			})
+			if err != nil {
+				return r.dbError(OpInsert, ErrMsgReplaceOnCallShifts, err)
+			}
+			shifts[i] = r.toEntity(row)
+		}
+		return nil
+	})
+}
+
+func (r *SqlcOnCallRepository) specialtyFilter(specialty *enum.VetSpecialty) pgtype.Text {
+	if specialty == nil {
+		return pgtype.Text{}
+	}
+	return r.pgMap.PgText.FromString(specialty.String())
+}
+
+func (r *SqlcOnCallRepository) toEntity(row sqlc.OnCallShift) e.OnCallShift {
+	return *e.NewOnCallShiftBuilder().
+		WithID(valueobject.NewOnCallShiftID(uint(row.ID))).
+		WithEmployeeID(valueobject.NewEmployeeID(uint(row.EmployeeID))).
+		WithSpecialty(enum.VetSpecialty(row.Specialty)).
+		WithPeriod(row.StartsAt.Time, row.EndsAt.Time).
+		WithTimestamps(row.CreatedAt.Time, row.UpdatedAt.Time).
+		Build()
+}
+
+func (r *SqlcOnCallRepository) toEntities(rows []sqlc.OnCallShift) []e.OnCallShift {
+	shifts := make([]e.OnCallShift, len(rows))
+	for i, row := range rows {
+		shifts[i] = r.toEntity(row)
+	}
+	return shifts
+}
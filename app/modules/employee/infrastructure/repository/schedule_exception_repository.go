@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	e "clinic-vet-api/app/modules/core/domain/entity/employee"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/mapper"
+	p "clinic-vet-api/app/shared/page"
+	"clinic-vet-api/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type SqlcScheduleExceptionRepository struct {
+	queries *sqlc.Queries
+	pgMap   *mapper.SqlcFieldMapper
+}
+
+func NewSqlcScheduleExceptionRepository(queries *sqlc.Queries, pgMap *mapper.SqlcFieldMapper) repository.ScheduleExceptionRepository {
+	return &SqlcScheduleExceptionRepository{queries: queries, pgMap: pgMap}
+}
+
+func (r *SqlcScheduleExceptionRepository) FindByID(ctx context.Context, id valueobject.ScheduleExcID) (e.ScheduleException, error) {
+	row, err := r.queries.FindScheduleExceptionByID(ctx, id.Int32())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return e.ScheduleException{}, r.notFoundError("id", id.String())
+		}
+		return e.ScheduleException{}, r.dbError(OpSelect, ErrMsgGetScheduleException, err)
+	}
+
+	return r.toEntity(row), nil
+}
+
+func (r *SqlcScheduleExceptionRepository) FindByEmployee(
+	ctx context.Context,
+	employeeID valueobject.EmployeeID,
+	status *enum.ScheduleExceptionStatus,
+	pagination p.PaginationRequest,
+) (p.Page[e.ScheduleException], error) {
+	statusFilter := pgtype.Text{}
+	if status != nil {
+		statusFilter = r.pgMap.PgText.FromString(status.String())
+	}
+
+	rows, err := r.queries.FindScheduleExceptionsByEmployee(ctx, sqlc.FindScheduleExceptionsByEmployeeParams{
+		EmployeeID: employeeID.Int32(),
+		Limit:      pagination.Limit(),
+		Offset:     pagination.Offset(),
+		Status:     statusFilter,
+	})
+	if err != nil {
+		return p.Page[e.ScheduleException]{}, r.dbError(OpSelect, ErrMsgListScheduleExceptions, err)
+	}
+
+	total, err := r.queries.CountScheduleExceptionsByEmployee(ctx, sqlc.CountScheduleExceptionsByEmployeeParams{
+		EmployeeID: employeeID.Int32(),
+		Status:     statusFilter,
+	})
+	if err != nil {
+		return p.Page[e.ScheduleException]{}, r.dbError(OpCount, ErrMsgListScheduleExceptions, err)
+	}
+
+	return p.NewPage(r.toEntities(rows), total, pagination), nil
+}
+
+func (r *SqlcScheduleExceptionRepository) FindByStatus(
+	ctx context.Context,
+	status enum.ScheduleExceptionStatus,
+	pagination p.PaginationRequest,
+) (p.Page[e.ScheduleException], error) {
+	rows, err := r.queries.FindScheduleExceptionsByStatus(ctx, sqlc.FindScheduleExceptionsByStatusParams{
+		Status: status.String(),
+		Limit:  pagination.Limit(),
+		Offset: pagination.Offset(),
+	})
+	if err != nil {
+		return p.Page[e.ScheduleException]{}, r.dbError(OpSelect, ErrMsgListScheduleExceptions, err)
+	}
+
+	total, err := r.queries.CountScheduleExceptionsByStatus(ctx, status.String())
+	if err != nil {
+		return p.Page[e.ScheduleException]{}, r.dbError(OpCount, ErrMsgListScheduleExceptions, err)
+	}
+
+	return p.NewPage(r.toEntities(rows), total, pagination), nil
+}
+
+func (r *SqlcScheduleExceptionRepository) FindApproved(ctx context.Context, employeeID valueobject.EmployeeID, start, end time.Time) ([]e.ScheduleException, error) {
+	rows, err := r.queries.FindApprovedScheduleExceptions(ctx, sqlc.FindApprovedScheduleExceptionsParams{
+		EmployeeID: employeeID.Int32(),
+		EndDate:    r.pgMap.PgTimestamptz.FromTime(end),
+		StartDate:  r.pgMap.PgTimestamptz.FromTime(start),
+	})
+	if err != nil {
+		return nil, r.dbError(OpSelect, ErrMsgListScheduleExceptions, err)
+	}
+
+	return r.toEntities(rows), nil
+}
+
+func (r *SqlcScheduleExceptionRepository) Save(ctx context.Context, exception *e.ScheduleException) error {
+	if exception.ID().IsZero() {
+		row, err := r.queries.CreateScheduleException(ctx, sqlc.CreateScheduleExceptionParams{
+			EmployeeID:    exception.EmployeeID().Int32(),
+			ExceptionType: exception.Type().String(),
+			StartDate:     r.pgMap.PgTimestamptz.FromTime(exception.StartDate()),
+			EndDate:       r.pgMap.PgTimestamptz.FromTime(exception.EndDate()),
+			Reason:        exception.Reason(),
+			Status:        exception.Status().String(),
+		})
+		if err != nil {
+			return r.dbError(OpInsert, ErrMsgCreateScheduleException, err)
+		}
+		*exception = r.toEntity(row)
+		return nil
+	}
+
+	err := r.queries.UpdateScheduleExceptionStatus(ctx, sqlc.UpdateScheduleExceptionStatusParams{
+		ID:          exception.ID().Int32(),
+		Status:      exception.Status().String(),
+		ReviewedBy:  r.pgMap.PgInt4.FromUserIDPtr(exception.ReviewedBy()),
+		ReviewNotes: r.pgMap.PgText.FromStringPtr(exception.ReviewNotes()),
+		ReviewedAt:  r.pgMap.PgTimestamptz.FromTimePtr(exception.ReviewedAt()),
+	})
+	if err != nil {
+		return r.dbError(OpUpdate, ErrMsgUpdateScheduleException, err)
+	}
+	return nil
+}
+
+func (r *SqlcScheduleExceptionRepository) toEntity(row sqlc.EmployeeScheduleException) e.ScheduleException {
+	return *e.NewScheduleExceptionBuilder().
+		WithID(valueobject.NewScheduleExcID(uint(row.ID))).
+		WithEmployeeID(valueobject.NewEmployeeID(uint(row.EmployeeID))).
+		WithType(enum.ScheduleExceptionType(row.ExceptionType)).
+		WithPeriod(row.StartDate.Time, row.EndDate.Time).
+		WithReason(row.Reason).
+		WithStatus(enum.ScheduleExceptionStatus(row.Status)).
+		WithReview(
+			r.pgMap.PgInt4.ToUserIDPtr(row.ReviewedBy),
+			r.pgMap.PgText.ToStringPtr(row.ReviewNotes),
+			r.pgMap.PgTimestamptz.ToTimePtr(row.ReviewedAt),
+		).
+		WithTimestamps(row.CreatedAt.Time, row.UpdatedAt.Time).
+		Build()
+}
+
+func (r *SqlcScheduleExceptionRepository) toEntities(rows []sqlc.EmployeeScheduleException) []e.ScheduleException {
+	exceptions := make([]e.ScheduleException, len(rows))
+	for i, row := range rows {
+		exceptions[i] = r.toEntity(row)
+	}
+	return exceptions
+}
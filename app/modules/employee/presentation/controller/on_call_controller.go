@@ -0,0 +1,136 @@
+package controller
+
+import (
+	"clinic-vet-api/app/modules/employee/infrastructure/bus"
+	"clinic-vet-api/app/modules/employee/presentation/dto"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+	ginUtils "clinic-vet-api/app/shared/gin_utils"
+	"clinic-vet-api/app/shared/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// OnCallController handles the on-call roster of the veterinarians covering after-hours emergencies
+type OnCallController struct {
+	validator *validator.Validate
+	bus       bus.EmployeeCqrsBus
+}
+
+func NewOnCallController(
+	validator *validator.Validate,
+	bus bus.EmployeeCqrsBus,
+) *OnCallController {
+	return &OnCallController{
+		validator: validator,
+		bus:       bus,
+	}
+}
+
+// GetOnCallNow godoc
+// @Summary Who is on call now
+// @Description Retrieves the veterinarians on call right now, one per specialty rotation. Veterinarians who got time off approved after the roster was generated are left out
+// @Tags employee-on-call
+// @Produce json
+// @Param specialty query string false "Specialty filter"
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=[]dto.OnCallShiftResponse} "On-call veterinarians"
+// @Failure 400 {object} response.APIResponse "Invalid specialty"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Router /employees/on-call/now [get]
+func (ctrl *OnCallController) GetOnCallNow(c *gin.Context) {
+	var params dto.OnCallNowParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		response.BadRequest(c, httpError.RequestURLQueryError(err, c.Request.URL.RawQuery))
+		return
+	}
+
+	onCallQuery, err := params.ToQuery()
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	results, err := ctrl.bus.FindOnCallNow(c.Request.Context(), onCallQuery)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.ToOnCallShiftResponseList(results), "On-call veterinarians")
+}
+
+// GetOnCallRoster godoc
+// @Summary List the on-call roster
+// @Description Retrieves the on-call shifts overlapping the period, optionally of a single specialty
+// @Tags admin-on-call
+// @Produce json
+// @Param start_date query string true "First day of the period (YYYY-MM-DD)"
+// @Param end_date query string true "Last day of the period (YYYY-MM-DD)"
+// @Param specialty query string false "Specialty filter"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=[]dto.OnCallShiftResponse} "On-call roster retrieved"
+// @Failure 400 {object} response.APIResponse "Invalid query parameters"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 403 {object} response.APIResponse "Forbidden - Admin role required"
+// @Router /admin/on-call [get]
+func (ctrl *OnCallController) GetOnCallRoster(c *gin.Context) {
+	var params dto.OnCallRosterParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		response.BadRequest(c, httpError.RequestURLQueryError(err, c.Request.URL.RawQuery))
+		return
+	}
+
+	rosterQuery, err := params.ToQuery()
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	shiftPage, err := ctrl.bus.FindOnCallRoster(c.Request.Context(), rosterQuery)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	shiftResponses := dto.ToOnCallShiftResponseList(shiftPage.Items)
+	response.SuccessWithPagination(c, shiftResponses, "On-call roster retrieved successfully", shiftPage.Metadata)
+}
+
+// GenerateOnCallRotation godoc
+// @Summary Generate an on-call rotation
+// @Description Splits the period in shifts and hands them round the active veterinarians of the specialty in turn, carrying on from the previous roster. Veterinarians on approved time off skip their turn. The shifts of the specialty overlapping the period are replaced
+// @Tags admin-on-call
+// @Accept json
+// @Produce json
+// @Param rotation body dto.GenerateOnCallRotationRequest true "Rotation"
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse "Rotation generated, with the number of shifts"
+// @Failure 400 {object} response.APIResponse "Invalid input data"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 403 {object} response.APIResponse "Forbidden - Admin role required"
+// @Failure 422 {object} response.APIResponse "No veterinarian can cover the rotation"
+// @Router /admin/on-call/rotations [post]
+func (ctrl *OnCallController) GenerateOnCallRotation(c *gin.Context) {
+	var requestData dto.GenerateOnCallRotationRequest
+	if err := ginUtils.ShouldBindAndValidateBody(c, &requestData, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	generateCommand, err := requestData.ToCommand()
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	result := ctrl.bus.GenerateOnCallRotation(c.Request.Context(), generateCommand)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Success(c, nil, result.Message())
+}
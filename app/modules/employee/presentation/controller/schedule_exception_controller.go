@@ -0,0 +1,259 @@
+package controller
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/employee/application/command"
+	"clinic-vet-api/app/modules/employee/infrastructure/bus"
+	"clinic-vet-api/app/modules/employee/presentation/dto"
+	autherror "clinic-vet-api/app/shared/error/auth"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+	ginUtils "clinic-vet-api/app/shared/gin_utils"
+	"clinic-vet-api/app/shared/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// ScheduleExceptionController handles the time off and extra shifts employees request and
+// managers review
+type ScheduleExceptionController struct {
+	validator *validator.Validate
+	bus       bus.EmployeeCqrsBus
+}
+
+func NewScheduleExceptionController(
+	validator *validator.Validate,
+	bus bus.EmployeeCqrsBus,
+) *ScheduleExceptionController {
+	return &ScheduleExceptionController{
+		validator: validator,
+		bus:       bus,
+	}
+}
+
+// RequestScheduleException godoc
+// @Summary Request time off or an extra shift
+// @Description Files a schedule exception for the authenticated employee. Vacation, sick leave and conference days take the employee off the booking calendar and extra shifts open slots outside the weekly schedule, once a manager approves them. Only sick leave may start in the past
+// @Tags employee-schedule-exceptions
+// @Accept json
+// @Produce json
+// @Param exception body dto.RequestScheduleExceptionRequest true "Schedule exception"
+// @Security BearerAuth
+// @Success 201 {object} response.APIResponse "Schedule exception requested"
+// @Failure 400 {object} response.APIResponse "Invalid input data"
+// @Failure 401 {object} response.APIResponse "Unauthorized - Employee not authenticated"
+// @Failure 422 {object} response.APIResponse "Invalid period or reason"
+// @Router /employees/schedule-exceptions [post]
+func (ctrl *ScheduleExceptionController) RequestScheduleException(c *gin.Context) {
+	userCTX, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, autherror.UnauthorizedCTXError())
+		return
+	}
+
+	var requestData dto.RequestScheduleExceptionRequest
+	if err := ginUtils.ShouldBindAndValidateBody(c, &requestData, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	requestCommand, err := requestData.ToCommand(userCTX.EmployeeID)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	result := ctrl.bus.RequestScheduleException(c.Request.Context(), requestCommand)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Created(c, result.ID(), "Schedule exception")
+}
+
+// GetMyScheduleExceptions godoc
+// @Summary List my schedule exceptions
+// @Description Retrieves the time off and extra shifts of the authenticated employee, optionally filtered by status
+// @Tags employee-schedule-exceptions
+// @Produce json
+// @Param status query string false "Status filter" Enums(pending, approved, rejected, cancelled)
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=[]dto.ScheduleExceptionResponse} "Schedule exceptions retrieved"
+// @Failure 400 {object} response.APIResponse "Invalid query parameters"
+// @Failure 401 {object} response.APIResponse "Unauthorized - Employee not authenticated"
+// @Router /employees/schedule-exceptions [get]
+func (ctrl *ScheduleExceptionController) GetMyScheduleExceptions(c *gin.Context) {
+	userCTX, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, autherror.UnauthorizedCTXError())
+		return
+	}
+
+	ctrl.findScheduleExceptions(c, &userCTX.EmployeeID)
+}
+
+// CancelScheduleException godoc
+// @Summary Cancel one of my schedule exceptions
+// @Description Withdraws a pending request or cancels approved time off that has not ended yet
+// @Tags employee-schedule-exceptions
+// @Produce json
+// @Param id path int true "Schedule exception ID"
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse "Schedule exception cancelled"
+// @Failure 400 {object} response.APIResponse "Invalid ID supplied"
+// @Failure 401 {object} response.APIResponse "Unauthorized - Employee not authenticated"
+// @Failure 404 {object} response.APIResponse "Schedule exception not found"
+// @Failure 422 {object} response.APIResponse "The exception can no longer be cancelled"
+// @Router /employees/schedule-exceptions/{id}/cancel [put]
+func (ctrl *ScheduleExceptionController) CancelScheduleException(c *gin.Context) {
+	userCTX, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, autherror.UnauthorizedCTXError())
+		return
+	}
+
+	id, err := ginUtils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	cancelCommand, err := command.NewCancelScheduleExceptionCommand(id, userCTX.EmployeeID)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	result := ctrl.bus.CancelScheduleException(c.Request.Context(), cancelCommand)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Success(c, nil, result.Message())
+}
+
+// GetScheduleExceptions godoc
+// @Summary List schedule exceptions
+// @Description Retrieves the schedule exceptions of every employee or of a single one. Without filters the pending requests are listed
+// @Tags admin-schedule-exceptions
+// @Produce json
+// @Param employee_id query int false "Employee ID"
+// @Param status query string false "Status filter" Enums(pending, approved, rejected, cancelled)
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse{data=[]dto.ScheduleExceptionResponse} "Schedule exceptions retrieved"
+// @Failure 400 {object} response.APIResponse "Invalid query parameters"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 403 {object} response.APIResponse "Forbidden - Admin role required"
+// @Router /admin/schedule-exceptions [get]
+func (ctrl *ScheduleExceptionController) GetScheduleExceptions(c *gin.Context) {
+	ctrl.findScheduleExceptions(c, nil)
+}
+
+// ApproveScheduleException godoc
+// @Summary Approve a schedule exception
+// @Description Approves a pending request, the availability and booking of the employee honor it from then on. Appointments already booked during approved time off are handed over by registering the absence
+// @Tags admin-schedule-exceptions
+// @Accept json
+// @Produce json
+// @Param id path int true "Schedule exception ID"
+// @Param review body dto.ReviewScheduleExceptionRequest false "Review notes"
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse "Schedule exception approved"
+// @Failure 400 {object} response.APIResponse "Invalid input data"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 403 {object} response.APIResponse "Forbidden - Admin role required"
+// @Failure 404 {object} response.APIResponse "Schedule exception not found"
+// @Failure 422 {object} response.APIResponse "The exception is not pending"
+// @Router /admin/schedule-exceptions/{id}/approve [put]
+func (ctrl *ScheduleExceptionController) ApproveScheduleException(c *gin.Context) {
+	ctrl.reviewScheduleException(c, true)
+}
+
+// RejectScheduleException godoc
+// @Summary Reject a schedule exception
+// @Description Rejects a pending request, the notes tell the employee why
+// @Tags admin-schedule-exceptions
+// @Accept json
+// @Produce json
+// @Param id path int true "Schedule exception ID"
+// @Param review body dto.ReviewScheduleExceptionRequest false "Review notes"
+// @Security BearerAuth
+// @Success 200 {object} response.APIResponse "Schedule exception rejected"
+// @Failure 400 {object} response.APIResponse "Invalid input data"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 403 {object} response.APIResponse "Forbidden - Admin role required"
+// @Failure 404 {object} response.APIResponse "Schedule exception not found"
+// @Failure 422 {object} response.APIResponse "The exception is not pending"
+// @Router /admin/schedule-exceptions/{id}/reject [put]
+func (ctrl *ScheduleExceptionController) RejectScheduleException(c *gin.Context) {
+	ctrl.reviewScheduleException(c, false)
+}
+
+func (ctrl *ScheduleExceptionController) findScheduleExceptions(c *gin.Context, employeeID *uint) {
+	var searchParams dto.ScheduleExceptionSearchParams
+	if err := c.ShouldBindQuery(&searchParams); err != nil {
+		response.BadRequest(c, httpError.RequestURLQueryError(err, c.Request.URL.RawQuery))
+		return
+	}
+
+	if employeeID == nil {
+		employeeID = searchParams.EmployeeID
+	}
+
+	findQuery, err := searchParams.ToQuery(employeeID)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	exceptionPage, err := ctrl.bus.FindScheduleExceptions(c.Request.Context(), findQuery)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	exceptionResponses := dto.ToScheduleExceptionResponseList(exceptionPage.Items)
+	response.SuccessWithPagination(c, exceptionResponses, "Schedule exceptions retrieved successfully", exceptionPage.Metadata)
+}
+
+func (ctrl *ScheduleExceptionController) reviewScheduleException(c *gin.Context, approve bool) {
+	userCTX, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, autherror.UnauthorizedCTXError())
+		return
+	}
+
+	id, err := ginUtils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	var requestData dto.ReviewScheduleExceptionRequest
+	if c.Request.ContentLength > 0 {
+		if err := ginUtils.ShouldBindAndValidateBody(c, &requestData, ctrl.validator); err != nil {
+			response.BadRequest(c, err)
+			return
+		}
+	}
+
+	reviewCommand, err := requestData.ToCommand(id, userCTX.UserID, approve)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	result := ctrl.bus.ReviewScheduleException(c.Request.Context(), reviewCommand)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Success(c, nil, result.Message())
+}
@@ -0,0 +1,98 @@
+package dto
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/employee/application/command"
+	"clinic-vet-api/app/modules/employee/application/handler"
+	"clinic-vet-api/app/modules/employee/application/query"
+	"clinic-vet-api/app/shared/page"
+)
+
+// GenerateOnCallRotationRequest represents the roster to generate for a specialty
+// @Description Request body for generating an on-call rotation. The shifts overlapping the period are replaced
+type GenerateOnCallRotationRequest struct {
+	// Specialty of the rotation, its active employees take turns
+	// Required: true
+	Specialty string `json:"specialty" binding:"required" example:"emergency_critical_care"`
+
+	// Start of the first shift
+	// Required: true
+	StartsAt time.Time `json:"starts_at" binding:"required" example:"2024-07-01T20:00:00Z"`
+
+	// End of the last shift, at most 90 days after the start
+	// Required: true
+	EndsAt time.Time `json:"ends_at" binding:"required" example:"2024-07-31T20:00:00Z"`
+
+	// Length of each shift in hours, between 4 and 24. Defaults to 24
+	// Required: false
+	ShiftHours *int `json:"shift_hours,omitempty" binding:"omitempty,min=4,max=24" example:"12"`
+}
+
+func (r *GenerateOnCallRotationRequest) ToCommand() (command.GenerateOnCallRotationCommand, error) {
+	return command.NewGenerateOnCallRotationCommand(r.Specialty, r.StartsAt, r.EndsAt, r.ShiftHours)
+}
+
+// OnCallNowParams represents the filter of the on-call lookup
+// @Description Query parameters for finding who is on call now
+type OnCallNowParams struct {
+	// Filter by specialty
+	// Required: false
+	Specialty string `form:"specialty" example:"surgery"`
+}
+
+func (p *OnCallNowParams) ToQuery() (query.FindOnCallNowQuery, error) {
+	return query.NewFindOnCallNowQuery(p.Specialty)
+}
+
+// OnCallRosterParams represents the filters of the on-call roster listing
+// @Description Query parameters for listing the on-call roster of a period
+type OnCallRosterParams struct {
+	page.PaginationRequest
+
+	// Start of the period
+	// Required: true
+	StartDate time.Time `form:"start_date" binding:"required" time_format:"2006-01-02" example:"2024-07-01"`
+
+	// Last day of the period, included
+	// Required: true
+	EndDate time.Time `form:"end_date" binding:"required" time_format:"2006-01-02" example:"2024-07-31"`
+
+	// Filter by specialty
+	// Required: false
+	Specialty string `form:"specialty" example:"surgery"`
+}
+
+func (p *OnCallRosterParams) ToQuery() (query.FindOnCallRosterQuery, error) {
+	return query.NewFindOnCallRosterQuery(p.StartDate, p.EndDate.AddDate(0, 0, 1), p.Specialty, p.WithDefaults())
+}
+
+// OnCallShiftResponse represents a shift of the on-call roster
+// @Description Period a veterinarian answers the emergencies of a specialty
+type OnCallShiftResponse struct {
+	ID           uint      `json:"id" example:"31"`
+	EmployeeID   uint      `json:"employee_id" example:"12"`
+	EmployeeName string    `json:"employee_name,omitempty" example:"Jane Doe"`
+	Specialty    string    `json:"specialty" example:"emergency_critical_care"`
+	StartsAt     time.Time `json:"starts_at" example:"2024-07-01T20:00:00Z"`
+	EndsAt       time.Time `json:"ends_at" example:"2024-07-02T08:00:00Z"`
+}
+
+func ToOnCallShiftResponse(result handler.OnCallShiftResult) OnCallShiftResponse {
+	return OnCallShiftResponse{
+		ID:           result.ID,
+		EmployeeID:   result.EmployeeID,
+		EmployeeName: result.EmployeeName,
+		Specialty:    result.Specialty,
+		StartsAt:     result.StartsAt,
+		EndsAt:       result.EndsAt,
+	}
+}
+
+func ToOnCallShiftResponseList(results []handler.OnCallShiftResult) []OnCallShiftResponse {
+	responses := make([]OnCallShiftResponse, len(results))
+	for i, result := range results {
+		responses[i] = ToOnCallShiftResponse(result)
+	}
+	return responses
+}
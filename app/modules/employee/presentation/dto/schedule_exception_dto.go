@@ -0,0 +1,108 @@
+package dto
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/employee/application/command"
+	"clinic-vet-api/app/modules/employee/application/handler"
+	"clinic-vet-api/app/modules/employee/application/query"
+	"clinic-vet-api/app/shared/page"
+)
+
+// RequestScheduleExceptionRequest represents a dated exception to the weekly schedule
+// @Description Request body for requesting time off or an extra shift. It takes effect once a manager approves it
+type RequestScheduleExceptionRequest struct {
+	// Kind of exception
+	// Required: true
+	// Enum: vacation, sick_leave, conference, extra_shift
+	Type string `json:"type" binding:"required,oneof=vacation sick_leave conference extra_shift" example:"vacation"`
+
+	// Start of the exception
+	// Required: true
+	StartDate time.Time `json:"start_date" binding:"required" example:"2024-07-01T00:00:00Z"`
+
+	// End of the exception. Time off lasts at most 60 days, an extra shift at most 12 hours on the same day
+	// Required: true
+	EndDate time.Time `json:"end_date" binding:"required" example:"2024-07-15T00:00:00Z"`
+
+	// Why the exception is requested
+	// Required: true
+	Reason string `json:"reason" binding:"required,max=500" example:"Summer vacation"`
+}
+
+func (r *RequestScheduleExceptionRequest) ToCommand(employeeID uint) (command.RequestScheduleExceptionCommand, error) {
+	return command.NewRequestScheduleExceptionCommand(employeeID, r.Type, r.StartDate, r.EndDate, r.Reason)
+}
+
+// ReviewScheduleExceptionRequest represents the decision of a manager on a request
+// @Description Request body for approving or rejecting a schedule exception
+type ReviewScheduleExceptionRequest struct {
+	// Notes for the employee
+	// Required: false
+	Notes *string `json:"notes,omitempty" binding:"omitempty,max=500" example:"Enjoy your vacation"`
+}
+
+func (r *ReviewScheduleExceptionRequest) ToCommand(id, reviewerID uint, approve bool) (command.ReviewScheduleExceptionCommand, error) {
+	return command.NewReviewScheduleExceptionCommand(id, reviewerID, approve, r.Notes)
+}
+
+// ScheduleExceptionSearchParams represents the filters of the schedule exception listings
+// @Description Query parameters for listing schedule exceptions. Managers see the pending requests when no filter is given
+type ScheduleExceptionSearchParams struct {
+	page.PaginationRequest
+
+	// Filter by employee, ignored on the employee's own listing
+	// Required: false
+	EmployeeID *uint `form:"employee_id" binding:"omitempty,min=1" example:"12"`
+
+	// Filter by status
+	// Required: false
+	// Enum: pending, approved, rejected, cancelled
+	Status string `form:"status" binding:"omitempty,oneof=pending approved rejected cancelled" example:"pending"`
+}
+
+func (p *ScheduleExceptionSearchParams) ToQuery(employeeID *uint) (query.FindScheduleExceptionsQuery, error) {
+	return query.NewFindScheduleExceptionsQuery(employeeID, p.Status, p.WithDefaults())
+}
+
+// ScheduleExceptionResponse represents a schedule exception
+// @Description Time off or extra shift of an employee along with its review
+type ScheduleExceptionResponse struct {
+	ID          uint       `json:"id" example:"7"`
+	EmployeeID  uint       `json:"employee_id" example:"12"`
+	Type        string     `json:"type" example:"vacation"`
+	StartDate   time.Time  `json:"start_date" example:"2024-07-01T00:00:00Z"`
+	EndDate     time.Time  `json:"end_date" example:"2024-07-15T00:00:00Z"`
+	Reason      string     `json:"reason" example:"Summer vacation"`
+	Status      string     `json:"status" example:"approved"`
+	ReviewedBy  *uint      `json:"reviewed_by,omitempty" example:"3"`
+	ReviewNotes *string    `json:"review_notes,omitempty" example:"Enjoy your vacation"`
+	ReviewedAt  *time.Time `json:"reviewed_at,omitempty" example:"2024-06-10T09:00:00Z"`
+	CreatedAt   time.Time  `json:"created_at" example:"2024-06-01T12:00:00Z"`
+	UpdatedAt   time.Time  `json:"updated_at" example:"2024-06-10T09:00:00Z"`
+}
+
+func ToScheduleExceptionResponse(result handler.ScheduleExceptionResult) ScheduleExceptionResponse {
+	return ScheduleExceptionResponse{
+		ID:          result.ID,
+		EmployeeID:  result.EmployeeID,
+		Type:        result.Type,
+		StartDate:   result.StartDate,
+		EndDate:     result.EndDate,
+		Reason:      result.Reason,
+		Status:      result.Status,
+		ReviewedBy:  result.ReviewedBy,
+		ReviewNotes: result.ReviewNotes,
+		ReviewedAt:  result.ReviewedAt,
+		CreatedAt:   result.CreatedAt,
+		UpdatedAt:   result.UpdatedAt,
+	}
+}
+
+func ToScheduleExceptionResponseList(results []handler.ScheduleExceptionResult) []ScheduleExceptionResponse {
+	responses := make([]ScheduleExceptionResponse, len(results))
+	for i, result := range results {
+		responses[i] = ToScheduleExceptionResponse(result)
+	}
+	return responses
+}
@@ -2,6 +2,7 @@ package routes
 
 import (
 	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/core/domain/enum"
 	"clinic-vet-api/app/modules/employee/presentation/controller"
 
 	"github.com/gin-gonic/gin"
@@ -21,3 +22,40 @@ func EmployeeRoutes(appGroup *gin.RouterGroup, employeeController *controller.Em
 	employeeGroup.PATCH("/:id", employeeController.UpdateEmployee)
 	employeeGroup.DELETE("/:id", employeeController.DeleteEmployee)
 }
+
+func ScheduleExceptionRoutes(appGroup *gin.RouterGroup, exceptionController *controller.ScheduleExceptionController, authMiddleware *middleware.AuthMiddleware) {
+	employeeGroup := appGroup.Group("/employees/schedule-exceptions")
+	employeeGroup.Use(authMiddleware.Authenticate())
+	employeeGroup.Use(authMiddleware.RequireAnyRole(enum.UserRoleVeterinarian.String(), enum.UserRoleReceptionist.String()))
+	{
+		employeeGroup.POST("", exceptionController.RequestScheduleException)
+		employeeGroup.GET("", exceptionController.GetMyScheduleExceptions)
+		employeeGroup.PUT("/:id/cancel", exceptionController.CancelScheduleException)
+	}
+
+	adminGroup := appGroup.Group("/admin/schedule-exceptions")
+	adminGroup.Use(authMiddleware.Authenticate())
+	adminGroup.Use(authMiddleware.RequireAnyRole(enum.UserRoleAdmin.String()))
+	{
+		adminGroup.GET("", exceptionController.GetScheduleExceptions)
+		adminGroup.PUT("/:id/approve", exceptionController.ApproveScheduleException)
+		adminGroup.PUT("/:id/reject", exceptionController.RejectScheduleException)
+	}
+}
+
+func OnCallRoutes(appGroup *gin.RouterGroup, onCallController *controller.OnCallController, authMiddleware *middleware.AuthMiddleware) {
+	employeeGroup := appGroup.Group("/employees/on-call")
+	employeeGroup.Use(authMiddleware.Authenticate())
+	employeeGroup.Use(authMiddleware.RequireAnyRole(enum.UserRoleVeterinarian.String(), enum.UserRoleReceptionist.String(), enum.UserRoleAdmin.String()))
+	{
+		employeeGroup.GET("/now", onCallController.GetOnCallNow)
+	}
+
+	adminGroup := appGroup.Group("/admin/on-call")
+	adminGroup.Use(authMiddleware.Authenticate())
+	adminGroup.Use(authMiddleware.RequireAnyRole(enum.UserRoleAdmin.String()))
+	{
+		adminGroup.GET("", onCallController.GetOnCallRoster)
+		adminGroup.POST("/rotations", onCallController.GenerateOnCallRotation)
+	}
+}
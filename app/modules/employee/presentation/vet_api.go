@@ -3,11 +3,13 @@ package api
 import (
 	"clinic-vet-api/app/middleware"
 	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
 	"clinic-vet-api/app/modules/employee/application/handler"
 	"clinic-vet-api/app/modules/employee/infrastructure/bus"
 	repositoryimpl "clinic-vet-api/app/modules/employee/infrastructure/repository"
 	"clinic-vet-api/app/modules/employee/presentation/controller"
 	"clinic-vet-api/app/modules/employee/presentation/routes"
+	"clinic-vet-api/app/shared/database"
 	"clinic-vet-api/app/shared/mapper"
 	"clinic-vet-api/sqlc"
 	"errors"
@@ -19,15 +21,20 @@ import (
 
 type EmployeeAPIConfig struct {
 	Queries        *sqlc.Queries
+	Transactor     *database.Transactor
 	Router         *gin.RouterGroup
 	DataValidator  *validator.Validate
 	AuthMiddleware *middleware.AuthMiddleware
+	CalendarRepo   repository.ClinicCalendarRepository
 }
 
 type EmployeeAPIComponents struct {
-	bus        *bus.EmployeeCqrsBus
-	controller *controller.EmployeeController
-	repository repository.EmployeeRepository
+	bus                 *bus.EmployeeCqrsBus
+	controller          *controller.EmployeeController
+	repository          repository.EmployeeRepository
+	exceptionRepository repository.ScheduleExceptionRepository
+	onCallService       *service.OnCallService
+	commandHandler      *handler.EmployeeCommandHandler
 }
 
 type EmployeeModule struct {
@@ -54,18 +61,29 @@ func (f *EmployeeModule) Bootstrap() error {
 
 	f.components = &EmployeeAPIComponents{}
 	vetRepo := repositoryimpl.NewSqlcEmployeeRepository(f.config.Queries, mapper.NewSqlcFieldMapper())
+	exceptionRepo := repositoryimpl.NewSqlcScheduleExceptionRepository(f.config.Queries, mapper.NewSqlcFieldMapper())
+	onCallRepo := repositoryimpl.NewSqlcOnCallRepository(f.config.Queries, f.config.Transactor, mapper.NewSqlcFieldMapper())
+	onCallService := service.NewOnCallService(onCallRepo, exceptionRepo)
 
-	employeeQueryHandler := handler.NewEmployeeQueryHandler(vetRepo)
-	employeeCommandHandler := handler.NewEmployeeCommandHandler(vetRepo)
+	employeeQueryHandler := handler.NewEmployeeQueryHandler(vetRepo, exceptionRepo, onCallRepo, onCallService)
+	employeeCommandHandler := handler.NewEmployeeCommandHandler(vetRepo, f.config.CalendarRepo, exceptionRepo, onCallRepo)
 
-	vetCqrsBus := bus.NewEmployeeCqrsBus(*employeeQueryHandler, *employeeCommandHandler)
+	vetCqrsBus := bus.NewEmployeeCqrsBus(*employeeQueryHandler, employeeCommandHandler)
 	vetControllers := controller.NewEmployeeController(f.config.DataValidator, vetCqrsBus)
 
+	exceptionController := controller.NewScheduleExceptionController(f.config.DataValidator, vetCqrsBus)
+	onCallController := controller.NewOnCallController(f.config.DataValidator, vetCqrsBus)
+
 	routes.EmployeeRoutes(f.config.Router, vetControllers, f.config.AuthMiddleware)
+	routes.ScheduleExceptionRoutes(f.config.Router, exceptionController, f.config.AuthMiddleware)
+	routes.OnCallRoutes(f.config.Router, onCallController, f.config.AuthMiddleware)
 
 	f.components.controller = vetControllers
 	f.components.bus = &vetCqrsBus
 	f.components.repository = vetRepo
+	f.components.exceptionRepository = exceptionRepo
+	f.components.onCallService = onCallService
+	f.components.commandHandler = employeeCommandHandler
 	f.isBuilt = true
 
 	return nil
@@ -84,6 +102,10 @@ func (f *EmployeeModule) validateConfig() error {
 		return fmt.Errorf("queries cannot be nil")
 	}
 
+	if f.config.Transactor == nil {
+		return fmt.Errorf("transactor cannot be nil")
+	}
+
 	if f.config.DataValidator == nil {
 		return fmt.Errorf("validator cannot be nil")
 	}
@@ -92,6 +114,10 @@ func (f *EmployeeModule) validateConfig() error {
 		return fmt.Errorf("auth middleware cannot be nil")
 	}
 
+	if f.config.CalendarRepo == nil {
+		return fmt.Errorf("calendar repository cannot be nil")
+	}
+
 	return nil
 }
 
@@ -102,3 +128,27 @@ func (f *EmployeeModule) GetRepository() (repository.EmployeeRepository, error)
 	return f.components.repository, nil
 
 }
+
+// SetTimeOffCoverage lets approved time off hand over the appointments booked during it, the
+// coverage is built by the appointment module once this module is bootstrapped
+func (f *EmployeeModule) SetTimeOffCoverage(coverage *service.AbsenceCoverageService) error {
+	if !f.isBuilt {
+		return errors.New("module not bootstrapped")
+	}
+	f.components.commandHandler.SetTimeOffCoverage(coverage)
+	return nil
+}
+
+func (f *EmployeeModule) GetScheduleExceptionRepository() (repository.ScheduleExceptionRepository, error) {
+	if !f.isBuilt {
+		return nil, errors.New("module not bootstrapped")
+	}
+	return f.components.exceptionRepository, nil
+}
+
+func (f *EmployeeModule) GetOnCallService() (*service.OnCallService, error) {
+	if !f.isBuilt {
+		return nil, errors.New("module not bootstrapped")
+	}
+	return f.components.onCallService, nil
+}
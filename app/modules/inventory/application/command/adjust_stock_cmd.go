@@ -0,0 +1,44 @@
+package command
+
+import (
+	"strings"
+
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type AdjustStockCommand struct {
+	lotID      valueobject.StockLotID
+	adjustedBy valueobject.EmployeeID
+	difference int
+	reason     string
+}
+
+func NewAdjustStockCommand(lotID, adjustedBy uint, difference int, reason string) (AdjustStockCommand, error) {
+	if lotID == 0 {
+		return AdjustStockCommand{}, adjustStockCmdErr("lotID", "is required")
+	}
+
+	if adjustedBy == 0 {
+		return AdjustStockCommand{}, adjustStockCmdErr("adjustedBy", "is required")
+	}
+
+	if difference == 0 {
+		return AdjustStockCommand{}, adjustStockCmdErr("difference", "cannot be zero")
+	}
+
+	if strings.TrimSpace(reason) == "" {
+		return AdjustStockCommand{}, adjustStockCmdErr("reason", "is required")
+	}
+
+	return AdjustStockCommand{
+		lotID:      valueobject.NewStockLotID(lotID),
+		adjustedBy: valueobject.NewEmployeeID(adjustedBy),
+		difference: difference,
+		reason:     reason,
+	}, nil
+}
+
+func (c AdjustStockCommand) LotID() valueobject.StockLotID      { return c.lotID }
+func (c AdjustStockCommand) AdjustedBy() valueobject.EmployeeID { return c.adjustedBy }
+func (c AdjustStockCommand) Difference() int                    { return c.difference }
+func (c AdjustStockCommand) Reason() string                     { return c.reason }
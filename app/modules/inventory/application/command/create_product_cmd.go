@@ -0,0 +1,53 @@
+package command
+
+import (
+	"strings"
+
+	"clinic-vet-api/app/modules/core/domain/entity/inventory"
+	"clinic-vet-api/app/modules/core/domain/enum"
+)
+
+type CreateProductCommand struct {
+	name         string
+	category     enum.ProductCategory
+	unit         string
+	reorderLevel int
+	description  *string
+}
+
+func NewCreateProductCommand(name, category, unit string, reorderLevel int, description *string) (CreateProductCommand, error) {
+	categoryEnum, err := enum.ParseProductCategory(category)
+	if err != nil {
+		return CreateProductCommand{}, createProductCmdErr("category", err.Error())
+	}
+
+	if strings.TrimSpace(name) == "" {
+		return CreateProductCommand{}, createProductCmdErr("name", "is required")
+	}
+
+	if strings.TrimSpace(unit) == "" {
+		return CreateProductCommand{}, createProductCmdErr("unit", "is required")
+	}
+
+	if reorderLevel < 0 {
+		return CreateProductCommand{}, createProductCmdErr("reorderLevel", "cannot be negative")
+	}
+
+	return CreateProductCommand{
+		name:         name,
+		category:     categoryEnum,
+		unit:         unit,
+		reorderLevel: reorderLevel,
+		description:  description,
+	}, nil
+}
+
+func (c *CreateProductCommand) ToEntity() *inventory.Product {
+	return inventory.NewProductBuilder().
+		WithName(c.name).
+		WithCategory(c.category).
+		WithUnit(c.unit).
+		WithReorderLevel(c.reorderLevel).
+		WithDescription(c.description).
+		Build()
+}
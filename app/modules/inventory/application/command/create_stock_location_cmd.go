@@ -0,0 +1,27 @@
+package command
+
+import (
+	"strings"
+
+	"clinic-vet-api/app/modules/core/domain/entity/inventory"
+)
+
+type CreateStockLocationCommand struct {
+	name        string
+	description *string
+}
+
+func NewCreateStockLocationCommand(name string, description *string) (CreateStockLocationCommand, error) {
+	if strings.TrimSpace(name) == "" {
+		return CreateStockLocationCommand{}, createLocationCmdErr("name", "is required")
+	}
+
+	return CreateStockLocationCommand{name: name, description: description}, nil
+}
+
+func (c *CreateStockLocationCommand) ToEntity() *inventory.StockLocation {
+	return inventory.NewStockLocationBuilder().
+		WithName(c.name).
+		WithDescription(c.description).
+		Build()
+}
@@ -0,0 +1,33 @@
+package command
+
+import (
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+func createProductCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "CreateProductCommand")
+}
+
+func updateProductCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "UpdateProductCommand")
+}
+
+func createLocationCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "CreateStockLocationCommand")
+}
+
+func updateLocationCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "UpdateStockLocationCommand")
+}
+
+func receiveStockCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "ReceiveStockCommand")
+}
+
+func adjustStockCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "AdjustStockCommand")
+}
+
+func wasteStockCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "WasteStockCommand")
+}
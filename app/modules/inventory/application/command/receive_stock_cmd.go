@@ -0,0 +1,64 @@
+package command
+
+import (
+	"strings"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type ReceiveStockCommand struct {
+	productID  valueobject.ProductID
+	locationID valueobject.StockLocationID
+	receivedBy valueobject.EmployeeID
+	lotNumber  string
+	expiresOn  time.Time
+	quantity   int
+}
+
+func NewReceiveStockCommand(
+	productID, locationID, receivedBy uint,
+	lotNumber string,
+	expiresOn time.Time,
+	quantity int,
+) (ReceiveStockCommand, error) {
+	if productID == 0 {
+		return ReceiveStockCommand{}, receiveStockCmdErr("productID", "is required")
+	}
+
+	if locationID == 0 {
+		return ReceiveStockCommand{}, receiveStockCmdErr("locationID", "is required")
+	}
+
+	if receivedBy == 0 {
+		return ReceiveStockCommand{}, receiveStockCmdErr("receivedBy", "is required")
+	}
+
+	if strings.TrimSpace(lotNumber) == "" {
+		return ReceiveStockCommand{}, receiveStockCmdErr("lotNumber", "is required")
+	}
+
+	if expiresOn.IsZero() {
+		return ReceiveStockCommand{}, receiveStockCmdErr("expiresOn", "is required")
+	}
+
+	if quantity <= 0 {
+		return ReceiveStockCommand{}, receiveStockCmdErr("quantity", "must be greater than zero")
+	}
+
+	return ReceiveStockCommand{
+		productID:  valueobject.NewProductID(productID),
+		locationID: valueobject.NewStockLocationID(locationID),
+		receivedBy: valueobject.NewEmployeeID(receivedBy),
+		lotNumber:  strings.TrimSpace(lotNumber),
+		expiresOn:  expiresOn,
+		quantity:   quantity,
+	}, nil
+}
+
+func (c ReceiveStockCommand) ProductID() valueobject.ProductID        { return c.productID }
+func (c ReceiveStockCommand) LocationID() valueobject.StockLocationID { return c.locationID }
+func (c ReceiveStockCommand) ReceivedBy() valueobject.EmployeeID      { return c.receivedBy }
+func (c ReceiveStockCommand) LotNumber() string                       { return c.lotNumber }
+func (c ReceiveStockCommand) ExpiresOn() time.Time                    { return c.expiresOn }
+func (c ReceiveStockCommand) Quantity() int                           { return c.quantity }
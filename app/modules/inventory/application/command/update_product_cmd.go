@@ -0,0 +1,55 @@
+package command
+
+import (
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type UpdateProductCommand struct {
+	productID    valueobject.ProductID
+	name         *string
+	category     *enum.ProductCategory
+	unit         *string
+	reorderLevel *int
+	description  *string
+	isActive     *bool
+}
+
+func NewUpdateProductCommand(
+	productID uint,
+	name, category, unit *string,
+	reorderLevel *int,
+	description *string,
+	isActive *bool,
+) (UpdateProductCommand, error) {
+	if productID == 0 {
+		return UpdateProductCommand{}, updateProductCmdErr("productID", "is required")
+	}
+
+	cmd := UpdateProductCommand{
+		productID:    valueobject.NewProductID(productID),
+		name:         name,
+		unit:         unit,
+		reorderLevel: reorderLevel,
+		description:  description,
+		isActive:     isActive,
+	}
+
+	if category != nil {
+		categoryEnum, err := enum.ParseProductCategory(*category)
+		if err != nil {
+			return UpdateProductCommand{}, updateProductCmdErr("category", err.Error())
+		}
+		cmd.category = &categoryEnum
+	}
+
+	return cmd, nil
+}
+
+func (c UpdateProductCommand) ProductID() valueobject.ProductID { return c.productID }
+func (c UpdateProductCommand) Name() *string                    { return c.name }
+func (c UpdateProductCommand) Category() *enum.ProductCategory  { return c.category }
+func (c UpdateProductCommand) Unit() *string                    { return c.unit }
+func (c UpdateProductCommand) ReorderLevel() *int               { return c.reorderLevel }
+func (c UpdateProductCommand) Description() *string             { return c.description }
+func (c UpdateProductCommand) IsActive() *bool                  { return c.isActive }
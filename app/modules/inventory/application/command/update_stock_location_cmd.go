@@ -0,0 +1,30 @@
+package command
+
+import (
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type UpdateStockLocationCommand struct {
+	locationID  valueobject.StockLocationID
+	name        *string
+	description *string
+	isActive    *bool
+}
+
+func NewUpdateStockLocationCommand(locationID uint, name, description *string, isActive *bool) (UpdateStockLocationCommand, error) {
+	if locationID == 0 {
+		return UpdateStockLocationCommand{}, updateLocationCmdErr("locationID", "is required")
+	}
+
+	return UpdateStockLocationCommand{
+		locationID:  valueobject.NewStockLocationID(locationID),
+		name:        name,
+		description: description,
+		isActive:    isActive,
+	}, nil
+}
+
+func (c UpdateStockLocationCommand) LocationID() valueobject.StockLocationID { return c.locationID }
+func (c UpdateStockLocationCommand) Name() *string                           { return c.name }
+func (c UpdateStockLocationCommand) Description() *string                    { return c.description }
+func (c UpdateStockLocationCommand) IsActive() *bool                         { return c.isActive }
@@ -0,0 +1,44 @@
+package command
+
+import (
+	"strings"
+
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type WasteStockCommand struct {
+	lotID    valueobject.StockLotID
+	wastedBy valueobject.EmployeeID
+	quantity int
+	reason   string
+}
+
+func NewWasteStockCommand(lotID, wastedBy uint, quantity int, reason string) (WasteStockCommand, error) {
+	if lotID == 0 {
+		return WasteStockCommand{}, wasteStockCmdErr("lotID", "is required")
+	}
+
+	if wastedBy == 0 {
+		return WasteStockCommand{}, wasteStockCmdErr("wastedBy", "is required")
+	}
+
+	if quantity <= 0 {
+		return WasteStockCommand{}, wasteStockCmdErr("quantity", "must be greater than zero")
+	}
+
+	if strings.TrimSpace(reason) == "" {
+		return WasteStockCommand{}, wasteStockCmdErr("reason", "is required")
+	}
+
+	return WasteStockCommand{
+		lotID:    valueobject.NewStockLotID(lotID),
+		wastedBy: valueobject.NewEmployeeID(wastedBy),
+		quantity: quantity,
+		reason:   reason,
+	}, nil
+}
+
+func (c WasteStockCommand) LotID() valueobject.StockLotID    { return c.lotID }
+func (c WasteStockCommand) WastedBy() valueobject.EmployeeID { return c.wastedBy }
+func (c WasteStockCommand) Quantity() int                    { return c.quantity }
+func (c WasteStockCommand) Reason() string                   { return c.reason }
@@ -0,0 +1,92 @@
+package application
+
+import (
+	"context"
+
+	c "clinic-vet-api/app/modules/inventory/application/command"
+	h "clinic-vet-api/app/modules/inventory/application/handler"
+	q "clinic-vet-api/app/modules/inventory/application/query"
+	"clinic-vet-api/app/shared/cqrs"
+	"clinic-vet-api/app/shared/page"
+)
+
+type InventoryFacadeService interface {
+	FindProducts(ctx context.Context, includeInactive bool) ([]h.ProductResult, error)
+	FindProductByID(ctx context.Context, qry q.FindProductByIDQuery) (h.ProductStockResult, error)
+	FindLocations(ctx context.Context, includeInactive bool) ([]h.LocationResult, error)
+	FindLotMovements(ctx context.Context, qry q.FindLotMovementsQuery) (page.Page[h.MovementResult], error)
+	FindLowStock(ctx context.Context) ([]h.LowStockResult, error)
+	FindExpiringLots(ctx context.Context, qry q.FindExpiringLotsQuery) ([]h.ExpiringLotResult, error)
+
+	CreateProduct(ctx context.Context, cmd c.CreateProductCommand) cqrs.CommandResult
+	UpdateProduct(ctx context.Context, cmd c.UpdateProductCommand) cqrs.CommandResult
+	CreateLocation(ctx context.Context, cmd c.CreateStockLocationCommand) cqrs.CommandResult
+	UpdateLocation(ctx context.Context, cmd c.UpdateStockLocationCommand) cqrs.CommandResult
+	ReceiveStock(ctx context.Context, cmd c.ReceiveStockCommand) cqrs.CommandResult
+	AdjustStock(ctx context.Context, cmd c.AdjustStockCommand) cqrs.CommandResult
+	WasteStock(ctx context.Context, cmd c.WasteStockCommand) cqrs.CommandResult
+}
+
+type inventoryFacadeService struct {
+	qryHandler *h.InventoryQueryHandler
+	cmdHandler *h.InventoryCommandHandler
+}
+
+func NewInventoryFacadeService(qryHandler *h.InventoryQueryHandler, cmdHandler *h.InventoryCommandHandler) InventoryFacadeService {
+	return &inventoryFacadeService{
+		qryHandler: qryHandler,
+		cmdHandler: cmdHandler,
+	}
+}
+
+func (s *inventoryFacadeService) FindProducts(ctx context.Context, includeInactive bool) ([]h.ProductResult, error) {
+	return s.qryHandler.HandleFindProducts(ctx, includeInactive)
+}
+
+func (s *inventoryFacadeService) FindProductByID(ctx context.Context, qry q.FindProductByIDQuery) (h.ProductStockResult, error) {
+	return s.qryHandler.HandleFindProductByID(ctx, qry)
+}
+
+func (s *inventoryFacadeService) FindLocations(ctx context.Context, includeInactive bool) ([]h.LocationResult, error) {
+	return s.qryHandler.HandleFindLocations(ctx, includeInactive)
+}
+
+func (s *inventoryFacadeService) FindLotMovements(ctx context.Context, qry q.FindLotMovementsQuery) (page.Page[h.MovementResult], error) {
+	return s.qryHandler.HandleFindLotMovements(ctx, qry)
+}
+
+func (s *inventoryFacadeService) FindLowStock(ctx context.Context) ([]h.LowStockResult, error) {
+	return s.qryHandler.HandleFindLowStock(ctx)
+}
+
+func (s *inventoryFacadeService) FindExpiringLots(ctx context.Context, qry q.FindExpiringLotsQuery) ([]h.ExpiringLotResult, error) {
+	return s.qryHandler.HandleFindExpiringLots(ctx, qry)
+}
+
+func (s *inventoryFacadeService) CreateProduct(ctx context.Context, cmd c.CreateProductCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleCreateProduct(ctx, cmd)
+}
+
+func (s *inventoryFacadeService) UpdateProduct(ctx context.Context, cmd c.UpdateProductCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleUpdateProduct(ctx, cmd)
+}
+
+func (s *inventoryFacadeService) CreateLocation(ctx context.Context, cmd c.CreateStockLocationCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleCreateLocation(ctx, cmd)
+}
+
+func (s *inventoryFacadeService) UpdateLocation(ctx context.Context, cmd c.UpdateStockLocationCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleUpdateLocation(ctx, cmd)
+}
+
+func (s *inventoryFacadeService) ReceiveStock(ctx context.Context, cmd c.ReceiveStockCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleReceiveStock(ctx, cmd)
+}
+
+func (s *inventoryFacadeService) AdjustStock(ctx context.Context, cmd c.AdjustStockCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleAdjustStock(ctx, cmd)
+}
+
+func (s *inventoryFacadeService) WasteStock(ctx context.Context, cmd c.WasteStockCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleWasteStock(ctx, cmd)
+}
@@ -0,0 +1,226 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/inventory"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/inventory/application/command"
+	"clinic-vet-api/app/shared/cqrs"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+var (
+	FailFindProductMsg        = "failed to find product"
+	FailValidateProductMsg    = "product validation failed"
+	FailSaveProductMsg        = "failed to save product"
+	FailProductNameTakenMsg   = "a product with that name already exists"
+	FailFindLocationMsg       = "failed to find stock location"
+	FailValidateLocationMsg   = "stock location validation failed"
+	FailSaveLocationMsg       = "failed to save stock location"
+	FailLocationNameTakenMsg  = "a stock location with that name already exists"
+	FailFindLotMsg            = "failed to find stock lot"
+	FailReceiveStockMsg       = "failed to receive stock"
+	FailAdjustStockMsg        = "failed to adjust stock"
+	FailWasteStockMsg         = "failed to waste stock"
+	SuccessProductCreatedMsg  = "product created successfully"
+	SuccessProductUpdatedMsg  = "product updated successfully"
+	SuccessLocationCreatedMsg = "stock location created successfully"
+	SuccessLocationUpdatedMsg = "stock location updated successfully"
+	SuccessStockReceivedMsg   = "stock received successfully"
+	SuccessStockAdjustedMsg   = "stock adjusted successfully"
+	SuccessStockWastedMsg     = "stock wasted successfully"
+)
+
+type InventoryCommandHandler struct {
+	inventoryRepo repository.InventoryRepository
+}
+
+func NewInventoryCommandHandler(inventoryRepo repository.InventoryRepository) *InventoryCommandHandler {
+	return &InventoryCommandHandler{inventoryRepo: inventoryRepo}
+}
+
+func (h *InventoryCommandHandler) HandleCreateProduct(ctx context.Context, cmd command.CreateProductCommand) cqrs.CommandResult {
+	product := cmd.ToEntity()
+	if err := product.Validate(ctx); err != nil {
+		return cqrs.FailureResult(FailValidateProductMsg, err)
+	}
+
+	if err := h.ensureProductNameAvailable(ctx, product); err != nil {
+		return cqrs.FailureResult(FailProductNameTakenMsg, err)
+	}
+
+	if err := h.inventoryRepo.SaveProduct(ctx, product); err != nil {
+		return cqrs.FailureResult(FailSaveProductMsg, err)
+	}
+
+	return cqrs.SuccessCreateResult(product.ID().String(), SuccessProductCreatedMsg)
+}
+
+func (h *InventoryCommandHandler) HandleUpdateProduct(ctx context.Context, cmd command.UpdateProductCommand) cqrs.CommandResult {
+	product, err := h.inventoryRepo.FindProductByID(ctx, cmd.ProductID())
+	if err != nil {
+		return cqrs.FailureResult(FailFindProductMsg, err)
+	}
+
+	if err := product.Update(ctx, cmd.Name(), cmd.Category(), cmd.Unit(), cmd.ReorderLevel(), cmd.Description(), cmd.IsActive()); err != nil {
+		return cqrs.FailureResult(FailValidateProductMsg, err)
+	}
+
+	if err := h.ensureProductNameAvailable(ctx, &product); err != nil {
+		return cqrs.FailureResult(FailProductNameTakenMsg, err)
+	}
+
+	if err := h.inventoryRepo.SaveProduct(ctx, &product); err != nil {
+		return cqrs.FailureResult(FailSaveProductMsg, err)
+	}
+
+	return cqrs.SuccessResult(SuccessProductUpdatedMsg)
+}
+
+func (h *InventoryCommandHandler) HandleCreateLocation(ctx context.Context, cmd command.CreateStockLocationCommand) cqrs.CommandResult {
+	location := cmd.ToEntity()
+	if err := location.Validate(ctx); err != nil {
+		return cqrs.FailureResult(FailValidateLocationMsg, err)
+	}
+
+	if err := h.ensureLocationNameAvailable(ctx, location); err != nil {
+		return cqrs.FailureResult(FailLocationNameTakenMsg, err)
+	}
+
+	if err := h.inventoryRepo.SaveLocation(ctx, location); err != nil {
+		return cqrs.FailureResult(FailSaveLocationMsg, err)
+	}
+
+	return cqrs.SuccessCreateResult(location.ID().String(), SuccessLocationCreatedMsg)
+}
+
+func (h *InventoryCommandHandler) HandleUpdateLocation(ctx context.Context, cmd command.UpdateStockLocationCommand) cqrs.CommandResult {
+	location, err := h.inventoryRepo.FindLocationByID(ctx, cmd.LocationID())
+	if err != nil {
+		return cqrs.FailureResult(FailFindLocationMsg, err)
+	}
+
+	if err := location.Update(ctx, cmd.Name(), cmd.Description(), cmd.IsActive()); err != nil {
+		return cqrs.FailureResult(FailValidateLocationMsg, err)
+	}
+
+	if err := h.ensureLocationNameAvailable(ctx, &location); err != nil {
+		return cqrs.FailureResult(FailLocationNameTakenMsg, err)
+	}
+
+	if err := h.inventoryRepo.SaveLocation(ctx, &location); err != nil {
+		return cqrs.FailureResult(FailSaveLocationMsg, err)
+	}
+
+	return cqrs.SuccessResult(SuccessLocationUpdatedMsg)
+}
+
+// HandleReceiveStock takes a delivery into stock. A lot number already received at the location
+// is topped up, otherwise a new lot is created. The ID of the lot is returned
+func (h *InventoryCommandHandler) HandleReceiveStock(ctx context.Context, cmd command.ReceiveStockCommand) cqrs.CommandResult {
+	now := time.Now()
+
+	product, err := h.inventoryRepo.FindProductByID(ctx, cmd.ProductID())
+	if err != nil {
+		return cqrs.FailureResult(FailFindProductMsg, err)
+	}
+
+	location, err := h.inventoryRepo.FindLocationByID(ctx, cmd.LocationID())
+	if err != nil {
+		return cqrs.FailureResult(FailFindLocationMsg, err)
+	}
+
+	existingLot, err := h.inventoryRepo.FindLotByNumber(ctx, cmd.ProductID(), cmd.LocationID(), cmd.LotNumber())
+	if err != nil {
+		return cqrs.FailureResult(FailFindLotMsg, err)
+	}
+
+	if existingLot != nil {
+		if err := inventory.CheckReceivable(ctx, product, location); err != nil {
+			return cqrs.FailureResult(FailReceiveStockMsg, err)
+		}
+
+		_, err := h.inventoryRepo.ApplyMovement(ctx, existingLot.ID(), func(lot *inventory.StockLot) (*inventory.StockMovement, error) {
+			return lot.Receive(ctx, cmd.ExpiresOn(), cmd.Quantity(), cmd.ReceivedBy(), now)
+		})
+		if err != nil {
+			return cqrs.FailureResult(FailReceiveStockMsg, err)
+		}
+		return cqrs.SuccessCreateResult(existingLot.ID().String(), SuccessStockReceivedMsg)
+	}
+
+	lot, receipt, err := inventory.ReceiveLot(ctx, product, location, cmd.LotNumber(), cmd.ExpiresOn(), cmd.Quantity(), cmd.ReceivedBy(), now)
+	if err != nil {
+		return cqrs.FailureResult(FailReceiveStockMsg, err)
+	}
+
+	if _, err := h.inventoryRepo.CreateLot(ctx, lot, receipt); err != nil {
+		return cqrs.FailureResult(FailReceiveStockMsg, err)
+	}
+
+	return cqrs.SuccessCreateResult(lot.ID().String(), SuccessStockReceivedMsg)
+}
+
+// HandleAdjustStock corrects the stock of the lot after a count
+func (h *InventoryCommandHandler) HandleAdjustStock(ctx context.Context, cmd command.AdjustStockCommand) cqrs.CommandResult {
+	_, err := h.inventoryRepo.ApplyMovement(ctx, cmd.LotID(), func(lot *inventory.StockLot) (*inventory.StockMovement, error) {
+		return lot.Adjust(ctx, cmd.Difference(), cmd.Reason(), cmd.AdjustedBy(), time.Now())
+	})
+	if err != nil {
+		return cqrs.FailureResult(FailAdjustStockMsg, err)
+	}
+
+	return cqrs.SuccessResult(SuccessStockAdjustedMsg)
+}
+
+// HandleWasteStock writes off units of the lot that cannot be used
+func (h *InventoryCommandHandler) HandleWasteStock(ctx context.Context, cmd command.WasteStockCommand) cqrs.CommandResult {
+	_, err := h.inventoryRepo.ApplyMovement(ctx, cmd.LotID(), func(lot *inventory.StockLot) (*inventory.StockMovement, error) {
+		return lot.Waste(ctx, cmd.Quantity(), cmd.Reason(), cmd.WastedBy(), time.Now())
+	})
+	if err != nil {
+		return cqrs.FailureResult(FailWasteStockMsg, err)
+	}
+
+	return cqrs.SuccessResult(SuccessStockWastedMsg)
+}
+
+func (h *InventoryCommandHandler) ensureProductNameAvailable(ctx context.Context, product *inventory.Product) error {
+	var excludeID *valueobject.ProductID
+	if !product.ID().IsZero() {
+		id := product.ID()
+		excludeID = &id
+	}
+
+	exists, err := h.inventoryRepo.ExistsProductByName(ctx, product.Name(), excludeID)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return apperror.ConflictError("product", fmt.Sprintf("a product named %s already exists", product.Name()))
+	}
+	return nil
+}
+
+func (h *InventoryCommandHandler) ensureLocationNameAvailable(ctx context.Context, location *inventory.StockLocation) error {
+	var excludeID *valueobject.StockLocationID
+	if !location.ID().IsZero() {
+		id := location.ID()
+		excludeID = &id
+	}
+
+	exists, err := h.inventoryRepo.ExistsLocationByName(ctx, location.Name(), excludeID)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return apperror.ConflictError("stock_location", fmt.Sprintf("a stock location named %s already exists", location.Name()))
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/inventory"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/inventory/application/query"
+	"clinic-vet-api/app/shared/page"
+)
+
+type InventoryQueryHandler struct {
+	inventoryRepo repository.InventoryRepository
+}
+
+func NewInventoryQueryHandler(inventoryRepo repository.InventoryRepository) *InventoryQueryHandler {
+	return &InventoryQueryHandler{inventoryRepo: inventoryRepo}
+}
+
+func (h *InventoryQueryHandler) HandleFindProducts(ctx context.Context, includeInactive bool) ([]ProductResult, error) {
+	products, err := h.inventoryRepo.FindProducts(ctx, includeInactive)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]ProductResult, len(products))
+	for i, product := range products {
+		results[i] = toProductResult(product)
+	}
+	return results, nil
+}
+
+// HandleFindProductByID returns the product with the lots still holding stock, the first to
+// expire first
+func (h *InventoryQueryHandler) HandleFindProductByID(ctx context.Context, qry query.FindProductByIDQuery) (ProductStockResult, error) {
+	product, err := h.inventoryRepo.FindProductByID(ctx, qry.ProductID())
+	if err != nil {
+		return ProductStockResult{}, err
+	}
+
+	lots, err := h.inventoryRepo.FindLotsByProduct(ctx, qry.ProductID(), false)
+	if err != nil {
+		return ProductStockResult{}, err
+	}
+
+	now := time.Now()
+	level := inventory.StockLevel{Product: product}
+	lotResults := make([]LotResult, len(lots))
+	for i, lot := range lots {
+		if !lot.IsExpiredAt(now) {
+			level.OnHand += lot.QuantityOnHand()
+		}
+		lotResults[i] = toLotResult(lot, now)
+	}
+
+	return ProductStockResult{
+		Product:    toProductResult(product),
+		OnHand:     level.OnHand,
+		IsLowStock: level.IsLow(),
+		Lots:       lotResults,
+	}, nil
+}
+
+func (h *InventoryQueryHandler) HandleFindLocations(ctx context.Context, includeInactive bool) ([]LocationResult, error) {
+	locations, err := h.inventoryRepo.FindLocations(ctx, includeInactive)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]LocationResult, len(locations))
+	for i, location := range locations {
+		results[i] = toLocationResult(location)
+	}
+	return results, nil
+}
+
+func (h *InventoryQueryHandler) HandleFindLotMovements(ctx context.Context, qry query.FindLotMovementsQuery) (page.Page[MovementResult], error) {
+	if _, err := h.inventoryRepo.FindLotByID(ctx, qry.LotID()); err != nil {
+		return page.Page[MovementResult]{}, err
+	}
+
+	movementPage, err := h.inventoryRepo.FindMovementsByLot(ctx, qry.LotID(), qry.Pagination())
+	if err != nil {
+		return page.Page[MovementResult]{}, err
+	}
+
+	return page.MapItems(movementPage, toMovementResult), nil
+}
+
+// HandleFindLowStock lists the active products to reorder, their stock not expired is at or
+// below the reorder level
+func (h *InventoryQueryHandler) HandleFindLowStock(ctx context.Context) ([]LowStockResult, error) {
+	levels, err := h.inventoryRepo.FindLowStock(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]LowStockResult, len(levels))
+	for i, level := range levels {
+		results[i] = LowStockResult{Product: toProductResult(level.Product), OnHand: level.OnHand}
+	}
+	return results, nil
+}
+
+// HandleFindExpiringLots lists the lots holding stock that expire within the window, the ones
+// already expired and still waiting to be wasted included
+func (h *InventoryQueryHandler) HandleFindExpiringLots(ctx context.Context, qry query.FindExpiringLotsQuery) ([]ExpiringLotResult, error) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	lots, err := h.inventoryRepo.FindExpiringLots(ctx, today.AddDate(0, 0, qry.WithinDays()+1))
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := h.inventoryRepo.FindProducts(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	locations, err := h.inventoryRepo.FindLocations(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	productsByID := make(map[uint]inventory.Product, len(products))
+	for _, product := range products {
+		productsByID[product.ID().Value()] = product
+	}
+
+	locationNames := make(map[uint]string, len(locations))
+	for _, location := range locations {
+		locationNames[location.ID().Value()] = location.Name()
+	}
+
+	results := make([]ExpiringLotResult, len(lots))
+	for i, lot := range lots {
+		product := productsByID[lot.ProductID().Value()]
+		results[i] = ExpiringLotResult{
+			Lot:          toLotResult(lot, now),
+			ProductName:  product.Name(),
+			Unit:         product.Unit(),
+			LocationName: locationNames[lot.LocationID().Value()],
+			DaysLeft:     int(math.Round(lot.ExpiresOn().Sub(today).Hours() / 24)),
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/inventory"
+)
+
+type ProductResult struct {
+	ID           uint
+	Name         string
+	Category     string
+	Unit         string
+	ReorderLevel int
+	Description  *string
+	IsActive     bool
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// ProductStockResult is the product with its lots, the stock counts the units not expired
+type ProductStockResult struct {
+	Product    ProductResult
+	OnHand     int
+	IsLowStock bool
+	Lots       []LotResult
+}
+
+type LocationResult struct {
+	ID          uint
+	Name        string
+	Description *string
+	IsActive    bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type LotResult struct {
+	ID             uint
+	ProductID      uint
+	LocationID     uint
+	LotNumber      string
+	ExpiresOn      time.Time
+	QuantityOnHand int
+	ReceivedAt     time.Time
+	IsExpired      bool
+}
+
+type MovementResult struct {
+	ID            uint
+	LotID         uint
+	ProductID     uint
+	MovementType  string
+	Quantity      int
+	BalanceAfter  int
+	Reason        *string
+	ReferenceType *string
+	ReferenceID   *uint
+	PerformedBy   uint
+	OccurredAt    time.Time
+}
+
+type LowStockResult struct {
+	Product ProductResult
+	OnHand  int
+}
+
+// ExpiringLotResult is a lot about to expire, or already expired, that still holds stock
+type ExpiringLotResult struct {
+	Lot          LotResult
+	ProductName  string
+	Unit         string
+	LocationName string
+	DaysLeft     int
+}
+
+func toProductResult(product inventory.Product) ProductResult {
+	return ProductResult{
+		ID:           product.ID().Value(),
+		Name:         product.Name(),
+		Category:     product.Category().String(),
+		Unit:         product.Unit(),
+		ReorderLevel: product.ReorderLevel(),
+		Description:  product.Description(),
+		IsActive:     product.IsActive(),
+		CreatedAt:    product.CreatedAt(),
+		UpdatedAt:    product.UpdatedAt(),
+	}
+}
+
+func toLocationResult(location inventory.StockLocation) LocationResult {
+	return LocationResult{
+		ID:          location.ID().Value(),
+		Name:        location.Name(),
+		Description: location.Description(),
+		IsActive:    location.IsActive(),
+		CreatedAt:   location.CreatedAt(),
+		UpdatedAt:   location.UpdatedAt(),
+	}
+}
+
+func toLotResult(lot inventory.StockLot, now time.Time) LotResult {
+	return LotResult{
+		ID:             lot.ID().Value(),
+		ProductID:      lot.ProductID().Value(),
+		LocationID:     lot.LocationID().Value(),
+		LotNumber:      lot.LotNumber(),
+		ExpiresOn:      lot.ExpiresOn(),
+		QuantityOnHand: lot.QuantityOnHand(),
+		ReceivedAt:     lot.ReceivedAt(),
+		IsExpired:      lot.IsExpiredAt(now),
+	}
+}
+
+func toMovementResult(movement inventory.StockMovement) MovementResult {
+	result := MovementResult{
+		ID:           movement.ID().Value(),
+		LotID:        movement.LotID().Value(),
+		ProductID:    movement.ProductID().Value(),
+		MovementType: movement.MovementType().String(),
+		Quantity:     movement.Quantity(),
+		BalanceAfter: movement.BalanceAfter(),
+		Reason:       movement.Reason(),
+		PerformedBy:  movement.PerformedBy().Value(),
+		OccurredAt:   movement.OccurredAt(),
+	}
+
+	if reference := movement.Reference(); reference != nil {
+		referenceType := reference.Type.String()
+		referenceID := reference.ID
+		result.ReferenceType = &referenceType
+		result.ReferenceID = &referenceID
+	}
+	return result
+}
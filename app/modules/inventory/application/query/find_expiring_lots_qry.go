@@ -0,0 +1,31 @@
+package query
+
+import (
+	"strconv"
+
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+const (
+	DefaultExpiryWindowDays = 30
+	MaxExpiryWindowDays     = 365
+)
+
+type FindExpiringLotsQuery struct {
+	withinDays int
+}
+
+// NewFindExpiringLotsQuery looks for the lots expiring in the next days, 30 when not given
+func NewFindExpiringLotsQuery(withinDays *int) (FindExpiringLotsQuery, error) {
+	if withinDays == nil {
+		return FindExpiringLotsQuery{withinDays: DefaultExpiryWindowDays}, nil
+	}
+
+	if *withinDays < 0 || *withinDays > MaxExpiryWindowDays {
+		return FindExpiringLotsQuery{}, apperror.FieldValidationError("within_days", strconv.Itoa(*withinDays), "the window goes from 0 to 365 days")
+	}
+
+	return FindExpiringLotsQuery{withinDays: *withinDays}, nil
+}
+
+func (q FindExpiringLotsQuery) WithinDays() int { return q.withinDays }
@@ -0,0 +1,23 @@
+package query
+
+import (
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+	"clinic-vet-api/app/shared/page"
+)
+
+type FindLotMovementsQuery struct {
+	lotID      valueobject.StockLotID
+	pagination page.PaginationRequest
+}
+
+func NewFindLotMovementsQuery(lotID uint, pagination page.PaginationRequest) (FindLotMovementsQuery, error) {
+	if lotID == 0 {
+		return FindLotMovementsQuery{}, apperror.FieldValidationError("id", "", "lot ID is required")
+	}
+
+	return FindLotMovementsQuery{lotID: valueobject.NewStockLotID(lotID), pagination: pagination}, nil
+}
+
+func (q FindLotMovementsQuery) LotID() valueobject.StockLotID      { return q.lotID }
+func (q FindLotMovementsQuery) Pagination() page.PaginationRequest { return q.pagination }
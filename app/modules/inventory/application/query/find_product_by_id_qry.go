@@ -0,0 +1,20 @@
+package query
+
+import (
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+type FindProductByIDQuery struct {
+	productID valueobject.ProductID
+}
+
+func NewFindProductByIDQuery(productID uint) (FindProductByIDQuery, error) {
+	if productID == 0 {
+		return FindProductByIDQuery{}, apperror.FieldValidationError("id", "", "product ID is required")
+	}
+
+	return FindProductByIDQuery{productID: valueobject.NewProductID(productID)}, nil
+}
+
+func (q FindProductByIDQuery) ProductID() valueobject.ProductID { return q.productID }
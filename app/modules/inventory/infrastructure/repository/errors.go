@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"fmt"
+
+	dberr "clinic-vet-api/app/shared/error/infrastructure/database"
+)
+
+const (
+	TableProducts  = "inventory_products"
+	TableLocations = "stock_locations"
+	TableLots      = "stock_lots"
+	TableMovements = "stock_movements"
+	OpSelect       = "select"
+	OpInsert       = "insert"
+	OpUpdate       = "update"
+	OpCount        = "count"
+	DriverSQL      = "sqlc"
+
+	ErrMsgGetProduct     = "failed to get product"
+	ErrMsgListProducts   = "failed to list products"
+	ErrMsgCheckProduct   = "failed to check product name"
+	ErrMsgCreateProduct  = "failed to create product"
+	ErrMsgUpdateProduct  = "failed to update product"
+	ErrMsgListLowStock   = "failed to list products low on stock"
+	ErrMsgGetLocation    = "failed to get stock location"
+	ErrMsgListLocations  = "failed to list stock locations"
+	ErrMsgCheckLocation  = "failed to check stock location name"
+	ErrMsgCreateLocation = "failed to create stock location"
+	ErrMsgUpdateLocation = "failed to update stock location"
+	ErrMsgGetLot         = "failed to get stock lot"
+	ErrMsgLockLot        = "failed to lock stock lot"
+	ErrMsgListLots       = "failed to list stock lots"
+	ErrMsgCreateLot      = "failed to create stock lot"
+	ErrMsgUpdateLot      = "failed to update stock lot"
+	ErrMsgCreateMovement = "failed to create stock movement"
+	ErrMsgListMovements  = "failed to list stock movements"
+	ErrMsgCountMovements = "failed to count stock movements"
+)
+
+func (r *SqlcInventoryRepository) dbError(operation, table, message string, err error) error {
+	return dberr.DatabaseOperationError(operation, table, DriverSQL, fmt.Errorf("%s: %v", message, err))
+}
+
+func (r *SqlcInventoryRepository) notFoundError(table, parameterName, parameterValue string) error {
+	return dberr.EntityNotFoundError(parameterName, parameterValue, OpSelect, table, DriverSQL)
+}
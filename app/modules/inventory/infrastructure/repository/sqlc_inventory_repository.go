@@ -0,0 +1,382 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/inventory"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/database"
+	"clinic-vet-api/app/shared/mapper"
+	p "clinic-vet-api/app/shared/page"
+	"clinic-vet-api/sqlc"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type SqlcInventoryRepository struct {
+	queries    *sqlc.Queries
+	transactor *database.Transactor
+	pgMap      *mapper.SqlcFieldMapper
+}
+
+func NewSqlcInventoryRepository(queries *sqlc.Queries, transactor *database.Transactor, pgMap *mapper.SqlcFieldMapper) repository.InventoryRepository {
+	return &SqlcInventoryRepository{queries: queries, transactor: transactor, pgMap: pgMap}
+}
+
+func (r *SqlcInventoryRepository) FindProductByID(ctx context.Context, id valueobject.ProductID) (inventory.Product, error) {
+	row, err := r.queries.FindInventoryProductByID(ctx, id.Int32())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return inventory.Product{}, r.notFoundError(TableProducts, "id", id.String())
+		}
+		return inventory.Product{}, r.dbError(OpSelect, TableProducts, ErrMsgGetProduct, err)
+	}
+
+	return r.toProduct(row), nil
+}
+
+func (r *SqlcInventoryRepository) FindProducts(ctx context.Context, includeInactive bool) ([]inventory.Product, error) {
+	rows, err := r.queries.ListInventoryProducts(ctx, includeInactive)
+	if err != nil {
+		return nil, r.dbError(OpSelect, TableProducts, ErrMsgListProducts, err)
+	}
+
+	products := make([]inventory.Product, len(rows))
+	for i, row := range rows {
+		products[i] = r.toProduct(row)
+	}
+	return products, nil
+}
+
+func (r *SqlcInventoryRepository) ExistsProductByName(ctx context.Context, name string, excludeID *valueobject.ProductID) (bool, error) {
+	var excluded int32
+	if excludeID != nil {
+		excluded = excludeID.Int32()
+	}
+
+	exists, err := r.queries.ExistsInventoryProductByName(ctx, sqlc.ExistsInventoryProductByNameParams{Name: name, ExcludeID: excluded})
+	if err != nil {
+		return false, r.dbError(OpSelect, TableProducts, ErrMsgCheckProduct, err)
+	}
+	return exists, nil
+}
+
+func (r *SqlcInventoryRepository) SaveProduct(ctx context.Context, product *inventory.Product) error {
+	if product.ID().IsZero() {
+		row, err := r.queries.CreateInventoryProduct(ctx, sqlc.CreateInventoryProductParams{
+			Name:         product.Name(),
+			Category:     product.Category().String(),
+			Unit:         product.Unit(),
+			ReorderLevel: int32(product.ReorderLevel()),
+			Description:  r.pgMap.PgText.FromStringPtr(product.Description()),
+			IsActive:     product.IsActive(),
+		})
+		if err != nil {
+			return r.dbError(OpInsert, TableProducts, ErrMsgCreateProduct, err)
+		}
+		*product = r.toProduct(row)
+		return nil
+	}
+
+	row, err := r.queries.UpdateInventoryProduct(ctx, sqlc.UpdateInventoryProductParams{
+		ID:           product.ID().Int32(),
+		Name:         product.Name(),
+		Category:     product.Category().String(),
+		Unit:         product.Unit(),
+		ReorderLevel: int32(product.ReorderLevel()),
+		Description:  r.pgMap.PgText.FromStringPtr(product.Description()),
+		IsActive:     product.IsActive(),
+	})
+	if err != nil {
+		return r.dbError(OpUpdate, TableProducts, ErrMsgUpdateProduct, err)
+	}
+	*product = r.toProduct(row)
+	return nil
+}
+
+func (r *SqlcInventoryRepository) FindLocationByID(ctx context.Context, id valueobject.StockLocationID) (inventory.StockLocation, error) {
+	row, err := r.queries.FindStockLocationByID(ctx, id.Int32())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return inventory.StockLocation{}, r.notFoundError(TableLocations, "id", id.String())
+		}
+		return inventory.StockLocation{}, r.dbError(OpSelect, TableLocations, ErrMsgGetLocation, err)
+	}
+
+	return r.toLocation(row), nil
+}
+
+func (r *SqlcInventoryRepository) FindLocations(ctx context.Context, includeInactive bool) ([]inventory.StockLocation, error) {
+	rows, err := r.queries.ListStockLocations(ctx, includeInactive)
+	if err != nil {
+		return nil, r.dbError(OpSelect, TableLocations, ErrMsgListLocations, err)
+	}
+
+	locations := make([]inventory.StockLocation, len(rows))
+	for i, row := range rows {
+		locations[i] = r.toLocation(row)
+	}
+	return locations, nil
+}
+
+func (r *SqlcInventoryRepository) ExistsLocationByName(ctx context.Context, name string, excludeID *valueobject.StockLocationID) (bool, error) {
+	var excluded int32
+	if excludeID != nil {
+		excluded = excludeID.Int32()
+	}
+
+	exists, err := r.queries.ExistsStockLocationByName(ctx, sqlc.ExistsStockLocationByNameParams{Name: name, ExcludeID: excluded})
+	if err != nil {
+		return false, r.dbError(OpSelect, TableLocations, ErrMsgCheckLocation, err)
+	}
+	return exists, nil
+}
+
+func (r *SqlcInventoryRepository) SaveLocation(ctx context.Context, location *inventory.StockLocation) error {
+	if location.ID().IsZero() {
+		row, err := r.queries.CreateStockLocation(ctx, sqlc.CreateStockLocationParams{
+			Name:        location.Name(),
+			Description: r.pgMap.PgText.FromStringPtr(location.Description()),
+			IsActive:    location.IsActive(),
+		})
+		if err != nil {
+			return r.dbError(OpInsert, TableLocations, ErrMsgCreateLocation, err)
+		}
+		*location = r.toLocation(row)
+		return nil
+	}
+
+	row, err := r.queries.UpdateStockLocation(ctx, sqlc.UpdateStockLocationParams{
+		ID:          location.ID().Int32(),
+		Name:        location.Name(),
+		Description: r.pgMap.PgText.FromStringPtr(location.Description()),
+		IsActive:    location.IsActive(),
+	})
+	if err != nil {
+		return r.dbError(OpUpdate, TableLocations, ErrMsgUpdateLocation, err)
+	}
+	*location = r.toLocation(row)
+	return nil
+}
+
+func (r *SqlcInventoryRepository) FindLotByID(ctx context.Context, id valueobject.StockLotID) (inventory.StockLot, error) {
+	row, err := r.queries.FindStockLotByID(ctx, id.Int32())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return inventory.StockLot{}, r.notFoundError(TableLots, "id", id.String())
+		}
+		return inventory.StockLot{}, r.dbError(OpSelect, TableLots, ErrMsgGetLot, err)
+	}
+
+	return r.toLot(row), nil
+}
+
+func (r *SqlcInventoryRepository) FindLotByNumber(
+	ctx context.Context,
+	productID valueobject.ProductID,
+	locationID valueobject.StockLocationID,
+	lotNumber string,
+) (*inventory.StockLot, error) {
+	row, err := r.queries.FindStockLotByNumber(ctx, sqlc.FindStockLotByNumberParams{
+		ProductID:  productID.Int32(),
+		LocationID: locationID.Int32(),
+		LotNumber:  lotNumber,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, r.dbError(OpSelect, TableLots, ErrMsgGetLot, err)
+	}
+
+	lot := r.toLot(row)
+	return &lot, nil
+}
+
+func (r *SqlcInventoryRepository) FindLotsByProduct(ctx context.Context, productID valueobject.ProductID, includeEmpty bool) ([]inventory.StockLot, error) {
+	rows, err := r.queries.ListStockLotsByProduct(ctx, sqlc.ListStockLotsByProductParams{
+		ProductID:    productID.Int32(),
+		IncludeEmpty: includeEmpty,
+	})
+	if err != nil {
+		return nil, r.dbError(OpSelect, TableLots, ErrMsgListLots, err)
+	}
+
+	return r.toLots(rows), nil
+}
+
+func (r *SqlcInventoryRepository) FindExpiringLots(ctx context.Context, before time.Time) ([]inventory.StockLot, error) {
+	rows, err := r.queries.ListExpiringStockLots(ctx, r.pgMap.PgDate.FromTime(before))
+	if err != nil {
+		return nil, r.dbError(OpSelect, TableLots, ErrMsgListLots, err)
+	}
+
+	return r.toLots(rows), nil
+}
+
+func (r *SqlcInventoryRepository) FindLowStock(ctx context.Context, at time.Time) ([]inventory.StockLevel, error) {
+	rows, err := r.queries.ListLowStockProducts(ctx, r.pgMap.PgDate.FromTime(at))
+	if err != nil {
+		return nil, r.dbError(OpSelect, TableProducts, ErrMsgListLowStock, err)
+	}
+
+	levels := make([]inventory.StockLevel, len(rows))
+	for i, row := range rows {
+		levels[i] = r.toStockLevel(row)
+	}
+	return levels, nil
+}
+
+func (r *SqlcInventoryRepository) CreateLot(ctx context.Context, lot *inventory.StockLot, receipt *inventory.StockMovement) (inventory.StockMovement, error) {
+	var saved inventory.StockMovement
+	err := r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+		row, err := queries.CreateStockLot(ctx, sqlc.CreateStockLotParams{
+			ProductID:      lot.ProductID().Int32(),
+			LocationID:     lot.LocationID().Int32(),
+			LotNumber:      lot.LotNumber(),
+			ExpiresOn:      r.pgMap.PgDate.FromTime(lot.ExpiresOn()),
+			QuantityOnHand: int32(lot.QuantityOnHand()),
+			ReceivedAt:     r.pgMap.PgTimestamptz.FromTime(lot.ReceivedAt()),
+		})
+		if err != nil {
+			return r.dbError(OpInsert, TableLots, ErrMsgCreateLot, err)
+		}
+		*lot = r.toLot(row)
+
+		saved, err = r.createMovement(ctx, queries, lot.ID(), receipt)
+		return err
+	})
+	if err != nil {
+		return inventory.StockMovement{}, err
+	}
+	return saved, nil
+}
+
+func (r *SqlcInventoryRepository) ApplyMovement(
+	ctx context.Context,
+	id valueobject.StockLotID,
+	change func(lot *inventory.StockLot) (*inventory.StockMovement, error),
+) (inventory.StockMovement, error) {
+	var saved inventory.StockMovement
+	err := r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+		var err error
+		saved, err = r.applyMovement(ctx, queries, id, change)
+		return err
+	})
+	if err != nil {
+		return inventory.StockMovement{}, err
+	}
+	return saved, nil
+}
+
+// DispenseWithin takes the stock of the dispensing out of its lot within the transaction the
+// queries are bound to. The lot row is locked before record saves the clinical record and
+// returns what the movement references, so the record and its stock are saved together and
+// concurrent dispensings never take out more than the lot holds
+func DispenseWithin(
+	ctx context.Context,
+	queries *sqlc.Queries,
+	dispensing inventory.Dispensing,
+	record func() (inventory.StockReference, error),
+) (inventory.StockMovement, error) {
+	r := &SqlcInventoryRepository{pgMap: mapper.NewSqlcFieldMapper()}
+	return r.applyMovement(ctx, queries, dispensing.LotID, func(lot *inventory.StockLot) (*inventory.StockMovement, error) {
+		if err := lot.CheckDispensable(ctx, dispensing.Quantity, dispensing.At); err != nil {
+			return nil, err
+		}
+
+		reference, err := record()
+		if err != nil {
+			return nil, err
+		}
+		return lot.DispenseFor(ctx, dispensing, reference)
+	})
+}
+
+// applyMovement locks the lot, lets change move its stock and saves the lot with the movement
+func (r *SqlcInventoryRepository) applyMovement(
+	ctx context.Context,
+	queries *sqlc.Queries,
+	id valueobject.StockLotID,
+	change func(lot *inventory.StockLot) (*inventory.StockMovement, error),
+) (inventory.StockMovement, error) {
+	row, err := queries.LockStockLot(ctx, id.Int32())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return inventory.StockMovement{}, r.notFoundError(TableLots, "id", id.String())
+		}
+		return inventory.StockMovement{}, r.dbError(OpSelect, TableLots, ErrMsgLockLot, err)
+	}
+
+	lot := r.toLot(row)
+	movement, err := change(&lot)
+	if err != nil {
+		return inventory.StockMovement{}, err
+	}
+
+	if err := queries.UpdateStockLotQuantity(ctx, sqlc.UpdateStockLotQuantityParams{
+		ID:             lot.ID().Int32(),
+		QuantityOnHand: int32(lot.QuantityOnHand()),
+	}); err != nil {
+		return inventory.StockMovement{}, r.dbError(OpUpdate, TableLots, ErrMsgUpdateLot, err)
+	}
+
+	return r.createMovement(ctx, queries, lot.ID(), movement)
+}
+
+func (r *SqlcInventoryRepository) FindMovementsByLot(
+	ctx context.Context,
+	id valueobject.StockLotID,
+	pagination p.PaginationRequest,
+) (p.Page[inventory.StockMovement], error) {
+	rows, err := r.queries.ListStockMovementsByLot(ctx, sqlc.ListStockMovementsByLotParams{
+		LotID:  id.Int32(),
+		Limit:  pagination.Limit(),
+		Offset: pagination.Offset(),
+	})
+	if err != nil {
+		return p.Page[inventory.StockMovement]{}, r.dbError(OpSelect, TableMovements, ErrMsgListMovements, err)
+	}
+
+	total, err := r.queries.CountStockMovementsByLot(ctx, id.Int32())
+	if err != nil {
+		return p.Page[inventory.StockMovement]{}, r.dbError(OpCount, TableMovements, ErrMsgCountMovements, err)
+	}
+
+	movements := make([]inventory.StockMovement, len(rows))
+	for i, row := range rows {
+		movements[i] = r.toMovement(row)
+	}
+	return p.NewPage(movements, total, pagination), nil
+}
+
+func (r *SqlcInventoryRepository) createMovement(
+	ctx context.Context,
+	queries *sqlc.Queries,
+	lotID valueobject.StockLotID,
+	movement *inventory.StockMovement,
+) (inventory.StockMovement, error) {
+	params := sqlc.CreateStockMovementParams{
+		LotID:        lotID.Int32(),
+		ProductID:    movement.ProductID().Int32(),
+		MovementType: movement.MovementType().String(),
+		Quantity:     int32(movement.Quantity()),
+		BalanceAfter: int32(movement.BalanceAfter()),
+		Reason:       r.pgMap.PgText.FromStringPtr(movement.Reason()),
+		PerformedBy:  movement.PerformedBy().Int32(),
+		OccurredAt:   r.pgMap.PgTimestamptz.FromTime(movement.OccurredAt()),
+	}
+	if reference := movement.Reference(); reference != nil {
+		params.ReferenceType = r.pgMap.PgText.FromString(reference.Type.String())
+		params.ReferenceID = r.pgMap.PgInt4.FromUint(reference.ID)
+	}
+
+	row, err := queries.CreateStockMovement(ctx, params)
+	if err != nil {
+		return inventory.StockMovement{}, r.dbError(OpInsert, TableMovements, ErrMsgCreateMovement, err)
+	}
+	return r.toMovement(row), nil
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"clinic-vet-api/app/modules/core/domain/entity/inventory"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/sqlc"
+)
+
+func (r *SqlcInventoryRepository) toProduct(row sqlc.InventoryProduct) inventory.Product {
+	return *inventory.NewProductBuilder().
+		WithID(valueobject.NewProductID(uint(row.ID))).
+		WithName(row.Name).
+		WithCategory(enum.ProductCategory(row.Category)).
+		WithUnit(row.Unit).
+		WithReorderLevel(int(row.ReorderLevel)).
+		WithDescription(r.pgMap.PgText.ToStringPtr(row.Description)).
+		WithIsActive(row.IsActive).
+		WithTimestamps(row.CreatedAt.Time, row.UpdatedAt.Time).
+		Build()
+}
+
+func (r *SqlcInventoryRepository) toStockLevel(row sqlc.ListLowStockProductsRow) inventory.StockLevel {
+	return inventory.StockLevel{
+		Product: r.toProduct(sqlc.InventoryProduct{
+			ID:           row.ID,
+			Name:         row.Name,
+			Category:     row.Category,
+			Unit:         row.Unit,
+			ReorderLevel: row.ReorderLevel,
+			Description:  row.Description,
+			IsActive:     row.IsActive,
+			CreatedAt:    row.CreatedAt,
+			UpdatedAt:    row.UpdatedAt,
+		}),
+		OnHand: int(row.OnHand),
+	}
+}
+
+func (r *SqlcInventoryRepository) toLocation(row sqlc.StockLocation) inventory.StockLocation {
+	return *inventory.NewStockLocationBuilder().
+		WithID(valueobject.NewStockLocationID(uint(row.ID))).
+		WithName(row.Name).
+		WithDescription(r.pgMap.PgText.ToStringPtr(row.Description)).
+		WithIsActive(row.IsActive).
+		WithTimestamps(row.CreatedAt.Time, row.UpdatedAt.Time).
+		Build()
+}
+
+func (r *SqlcInventoryRepository) toLot(row sqlc.StockLot) inventory.StockLot {
+	return *inventory.NewStockLotBuilder().
+		WithID(valueobject.NewStockLotID(uint(row.ID))).
+		WithProductID(valueobject.NewProductID(uint(row.ProductID))).
+		WithLocationID(valueobject.NewStockLocationID(uint(row.LocationID))).
+		WithLotNumber(row.LotNumber).
+		WithExpiresOn(r.pgMap.PgDate.ToTime(row.ExpiresOn)).
+		WithQuantityOnHand(int(row.QuantityOnHand)).
+		WithReceivedAt(row.ReceivedAt.Time).
+		WithTimestamps(row.CreatedAt.Time, row.UpdatedAt.Time).
+		Build()
+}
+
+func (r *SqlcInventoryRepository) toLots(rows []sqlc.StockLot) []inventory.StockLot {
+	lots := make([]inventory.StockLot, len(rows))
+	for i, row := range rows {
+		lots[i] = r.toLot(row)
+	}
+	return lots
+}
+
+func (r *SqlcInventoryRepository) toMovement(row sqlc.StockMovement) inventory.StockMovement {
+	var reference *inventory.StockReference
+	if row.ReferenceType.Valid && row.ReferenceID.Valid {
+		reference = &inventory.StockReference{
+			Type: enum.StockReferenceType(row.ReferenceType.String),
+			ID:   uint(row.ReferenceID.Int32),
+		}
+	}
+
+	return *inventory.NewStockMovementBuilder().
+		WithID(valueobject.NewStockMovementID(uint(row.ID))).
+		WithLotID(valueobject.NewStockLotID(uint(row.LotID))).
+		WithProductID(valueobject.NewProductID(uint(row.ProductID))).
+		WithMovementType(enum.StockMovementType(row.MovementType)).
+		WithQuantity(int(row.Quantity), int(row.BalanceAfter)).
+		WithReason(r.pgMap.PgText.ToStringPtr(row.Reason)).
+		WithReference(reference).
+		WithPerformedBy(valueobject.NewEmployeeID(uint(row.PerformedBy))).
+		WithOccurredAt(row.OccurredAt.Time).
+		WithTimestamps(row.CreatedAt.Time, row.UpdatedAt.Time).
+		Build()
+}
@@ -0,0 +1,207 @@
+package controller
+
+import (
+	"clinic-vet-api/app/modules/inventory/application"
+	"clinic-vet-api/app/modules/inventory/presentation/dto"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+	ginutils "clinic-vet-api/app/shared/gin_utils"
+	"clinic-vet-api/app/shared/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// AdminInventoryController manages the catalog of products kept in stock and the locations
+// holding them
+type AdminInventoryController struct {
+	inventoryService application.InventoryFacadeService
+	validator        *validator.Validate
+}
+
+func NewAdminInventoryController(
+	inventoryService application.InventoryFacadeService,
+	validator *validator.Validate,
+) *AdminInventoryController {
+	return &AdminInventoryController{
+		inventoryService: inventoryService,
+		validator:        validator,
+	}
+}
+
+// CreateProduct godoc
+// @Summary Create inventory product
+// @Description Registers a medication, vaccine, dewormer or medical supply whose lots are kept in stock. Stock is counted in the unit of the product and a low-stock alert is raised at the reorder level
+// @Tags admin-inventory
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateProductRequest true "Product"
+// @Success 201 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse
+// @Failure 409 {object} response.APIResponse
+// @Router /admin/inventory/products [post]
+// @Security BearerAuth
+func (ctrl *AdminInventoryController) CreateProduct(c *gin.Context) {
+	var req dto.CreateProductRequest
+	if err := ginutils.ShouldBindAndValidateBody(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	cmd, err := req.ToCommand()
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.inventoryService.CreateProduct(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Created(c, result.ID(), "Product")
+}
+
+// UpdateProduct godoc
+// @Summary Update inventory product
+// @Description Updates the name, category, unit, reorder level, description or status of a product. Inactive products receive no more stock and cannot be dispensed
+// @Tags admin-inventory
+// @Accept json
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param request body dto.UpdateProductRequest true "Changes"
+// @Success 200 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse
+// @Failure 404 {object} response.APIResponse
+// @Failure 409 {object} response.APIResponse
+// @Router /admin/inventory/products/{id} [put]
+// @Security BearerAuth
+func (ctrl *AdminInventoryController) UpdateProduct(c *gin.Context) {
+	productID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	var req dto.UpdateProductRequest
+	if err := ginutils.ShouldBindAndValidateBody(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	cmd, err := req.ToCommand(productID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.inventoryService.UpdateProduct(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Success(c, nil, result.Message())
+}
+
+// FindLocations godoc
+// @Summary List stock locations
+// @Description Lists the shelves, fridges and cabinets holding stock, inactive ones only when requested
+// @Tags admin-inventory
+// @Produce json
+// @Param include_inactive query bool false "Include inactive locations"
+// @Success 200 {object} response.APIResponse{data=[]dto.StockLocationResponse}
+// @Failure 400 {object} response.APIResponse
+// @Router /admin/inventory/locations [get]
+// @Security BearerAuth
+func (ctrl *AdminInventoryController) FindLocations(c *gin.Context) {
+	var req dto.FindInventoryRequest
+	if err := ginutils.ShouldBindAndValidateQuery(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	results, err := ctrl.inventoryService.FindLocations(c.Request.Context(), req.IncludeInactive)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromLocationResults(results), "Stock Locations")
+}
+
+// CreateLocation godoc
+// @Summary Create stock location
+// @Description Registers a shelf, fridge or cabinet where lots are received
+// @Tags admin-inventory
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateStockLocationRequest true "Stock location"
+// @Success 201 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse
+// @Failure 409 {object} response.APIResponse
+// @Router /admin/inventory/locations [post]
+// @Security BearerAuth
+func (ctrl *AdminInventoryController) CreateLocation(c *gin.Context) {
+	var req dto.CreateStockLocationRequest
+	if err := ginutils.ShouldBindAndValidateBody(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	cmd, err := req.ToCommand()
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.inventoryService.CreateLocation(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Created(c, result.ID(), "Stock Location")
+}
+
+// UpdateLocation godoc
+// @Summary Update stock location
+// @Description Updates the name, description or status of a stock location. Inactive locations receive no more stock
+// @Tags admin-inventory
+// @Accept json
+// @Produce json
+// @Param id path int true "Stock location ID"
+// @Param request body dto.UpdateStockLocationRequest true "Changes"
+// @Success 200 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse
+// @Failure 404 {object} response.APIResponse
+// @Failure 409 {object} response.APIResponse
+// @Router /admin/inventory/locations/{id} [put]
+// @Security BearerAuth
+func (ctrl *AdminInventoryController) UpdateLocation(c *gin.Context) {
+	locationID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	var req dto.UpdateStockLocationRequest
+	if err := ginutils.ShouldBindAndValidateBody(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	cmd, err := req.ToCommand(locationID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.inventoryService.UpdateLocation(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Success(c, nil, result.Message())
+}
@@ -0,0 +1,322 @@
+package controller
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/inventory/application"
+	"clinic-vet-api/app/modules/inventory/application/query"
+	"clinic-vet-api/app/modules/inventory/presentation/dto"
+	autherror "clinic-vet-api/app/shared/error/auth"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+	ginutils "clinic-vet-api/app/shared/gin_utils"
+	"clinic-vet-api/app/shared/page"
+	"clinic-vet-api/app/shared/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// InventoryController lets the clinic staff look up the stock, take deliveries in, correct and
+// write off lots and follow the low-stock and near-expiry alerts
+type InventoryController struct {
+	inventoryService application.InventoryFacadeService
+	validator        *validator.Validate
+}
+
+func NewInventoryController(
+	inventoryService application.InventoryFacadeService,
+	validator *validator.Validate,
+) *InventoryController {
+	return &InventoryController{
+		inventoryService: inventoryService,
+		validator:        validator,
+	}
+}
+
+// FindProducts godoc
+// @Summary List inventory products
+// @Description Lists the medications, vaccines, dewormers and supplies kept in stock, inactive ones only when requested
+// @Tags employee-inventory
+// @Produce json
+// @Param include_inactive query bool false "Include inactive products"
+// @Success 200 {object} response.APIResponse{data=[]dto.ProductResponse}
+// @Failure 400 {object} response.APIResponse
+// @Router /employees/inventory/products [get]
+// @Security BearerAuth
+func (ctrl *InventoryController) FindProducts(c *gin.Context) {
+	var req dto.FindInventoryRequest
+	if err := ginutils.ShouldBindAndValidateQuery(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	results, err := ctrl.inventoryService.FindProducts(c.Request.Context(), req.IncludeInactive)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromProductResults(results), "Products")
+}
+
+// GetProductStock godoc
+// @Summary Get product stock
+// @Description Returns a product with its units on hand and the lots holding them, soonest to expire first. The lot IDs are the ones to dispense vaccinations, dewormings and prescriptions from
+// @Tags employee-inventory
+// @Produce json
+// @Param id path int true "Product ID"
+// @Success 200 {object} response.APIResponse{data=dto.ProductStockResponse}
+// @Failure 404 {object} response.APIResponse
+// @Router /employees/inventory/products/{id} [get]
+// @Security BearerAuth
+func (ctrl *InventoryController) GetProductStock(c *gin.Context) {
+	productID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	qry, err := query.NewFindProductByIDQuery(productID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result, err := ctrl.inventoryService.FindProductByID(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromProductStockResult(result), "Product Stock")
+}
+
+// ReceiveStock godoc
+// @Summary Receive stock
+// @Description Takes a delivery of a lot into stock at a location. A lot number already received at the location is topped up and has to carry the same expiry date. Returns the ID of the lot
+// @Tags employee-inventory
+// @Accept json
+// @Produce json
+// @Param request body dto.ReceiveStockRequest true "Delivery"
+// @Success 201 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse
+// @Failure 401 {object} response.APIResponse
+// @Failure 404 {object} response.APIResponse
+// @Failure 422 {object} response.APIResponse
+// @Router /employees/inventory/receipts [post]
+// @Security BearerAuth
+func (ctrl *InventoryController) ReceiveStock(c *gin.Context) {
+	userCTX, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, autherror.UnauthorizedCTXError())
+		return
+	}
+
+	var req dto.ReceiveStockRequest
+	if err := ginutils.ShouldBindAndValidateBody(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	cmd, err := req.ToCommand(userCTX.EmployeeID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.inventoryService.ReceiveStock(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Created(c, result.ID(), "Stock Lot")
+}
+
+// AdjustStock godoc
+// @Summary Adjust lot stock
+// @Description Corrects the stock of a lot after a count, the difference is added to the units on hand
+// @Tags employee-inventory
+// @Accept json
+// @Produce json
+// @Param id path int true "Lot ID"
+// @Param request body dto.AdjustStockRequest true "Adjustment"
+// @Success 200 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse
+// @Failure 401 {object} response.APIResponse
+// @Failure 404 {object} response.APIResponse
+// @Failure 422 {object} response.APIResponse
+// @Router /employees/inventory/lots/{id}/adjustments [post]
+// @Security BearerAuth
+func (ctrl *InventoryController) AdjustStock(c *gin.Context) {
+	userCTX, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, autherror.UnauthorizedCTXError())
+		return
+	}
+
+	lotID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	var req dto.AdjustStockRequest
+	if err := ginutils.ShouldBindAndValidateBody(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	cmd, err := req.ToCommand(lotID, userCTX.EmployeeID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.inventoryService.AdjustStock(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Success(c, nil, result.Message())
+}
+
+// WasteStock godoc
+// @Summary Waste lot stock
+// @Description Writes off broken, contaminated or expired units of a lot
+// @Tags employee-inventory
+// @Accept json
+// @Produce json
+// @Param id path int true "Lot ID"
+// @Param request body dto.WasteStockRequest true "Write-off"
+// @Success 200 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse
+// @Failure 401 {object} response.APIResponse
+// @Failure 404 {object} response.APIResponse
+// @Failure 422 {object} response.APIResponse
+// @Router /employees/inventory/lots/{id}/waste [post]
+// @Security BearerAuth
+func (ctrl *InventoryController) WasteStock(c *gin.Context) {
+	userCTX, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, autherror.UnauthorizedCTXError())
+		return
+	}
+
+	lotID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	var req dto.WasteStockRequest
+	if err := ginutils.ShouldBindAndValidateBody(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	cmd, err := req.ToCommand(lotID, userCTX.EmployeeID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.inventoryService.WasteStock(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Success(c, nil, result.Message())
+}
+
+// FindLotMovements godoc
+// @Summary List lot movements
+// @Description Lists the receipts, dispenses, adjustments and write-offs of a lot, most recent first, with the balance after each one
+// @Tags employee-inventory
+// @Produce json
+// @Param id path int true "Lot ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.APIResponse{data=[]dto.StockMovementResponse}
+// @Failure 400 {object} response.APIResponse
+// @Failure 404 {object} response.APIResponse
+// @Router /employees/inventory/lots/{id}/movements [get]
+// @Security BearerAuth
+func (ctrl *InventoryController) FindLotMovements(c *gin.Context) {
+	lotID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	var pagination page.PaginationRequest
+	if err := ginutils.ShouldBindPageParams(&pagination, c, ctrl.validator); err != nil {
+		response.BadRequest(c, httpError.RequestURLQueryError(err, c.Request.URL.RawQuery))
+		return
+	}
+
+	qry, err := dto.ToFindLotMovementsQuery(lotID, pagination)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	movementPage, err := ctrl.inventoryService.FindLotMovements(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.SuccessWithPagination(c, dto.FromMovementResults(movementPage.Items), "Stock movements retrieved successfully", movementPage.Metadata)
+}
+
+// FindLowStock godoc
+// @Summary Low-stock alert
+// @Description Lists the active products whose usable units, the ones in lots not yet expired, are at or below the reorder level
+// @Tags employee-inventory
+// @Produce json
+// @Success 200 {object} response.APIResponse{data=[]dto.LowStockResponse}
+// @Router /employees/inventory/alerts/low-stock [get]
+// @Security BearerAuth
+func (ctrl *InventoryController) FindLowStock(c *gin.Context) {
+	results, err := ctrl.inventoryService.FindLowStock(c.Request.Context())
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromLowStockResults(results), "Low Stock Products")
+}
+
+// FindExpiringLots godoc
+// @Summary Near-expiry alert
+// @Description Lists the lots still holding units that are expired or expire within the days given, 30 by default
+// @Tags employee-inventory
+// @Produce json
+// @Param within_days query int false "Days ahead to look for expiring lots" default(30)
+// @Success 200 {object} response.APIResponse{data=[]dto.ExpiringLotResponse}
+// @Failure 400 {object} response.APIResponse
+// @Router /employees/inventory/alerts/expiring [get]
+// @Security BearerAuth
+func (ctrl *InventoryController) FindExpiringLots(c *gin.Context) {
+	var req dto.FindExpiringLotsRequest
+	if err := ginutils.ShouldBindAndValidateQuery(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	qry, err := req.ToQuery()
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	results, err := ctrl.inventoryService.FindExpiringLots(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromExpiringLotResults(results), "Expiring Lots")
+}
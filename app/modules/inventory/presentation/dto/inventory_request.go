@@ -0,0 +1,119 @@
+package dto
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/inventory/application/command"
+	"clinic-vet-api/app/modules/inventory/application/query"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+	"clinic-vet-api/app/shared/page"
+)
+
+// CreateProductRequest represents a drug, vaccine, dewormer or supply kept in stock
+// @Description Product of the pharmacy or medical supplies, its stock is counted in the unit
+type CreateProductRequest struct {
+	Name         string  `json:"name" validate:"required,max=150" example:"Nobivac DHPPi"`
+	Category     string  `json:"category" validate:"required" example:"vaccine"`
+	Unit         string  `json:"unit" validate:"required,max=30" example:"dose"`
+	ReorderLevel int     `json:"reorder_level" validate:"min=0" example:"10"`
+	Description  *string `json:"description,omitempty" validate:"omitempty,max=500" example:"Canine distemper, hepatitis, parvovirus and parainfluenza"`
+}
+
+func (r *CreateProductRequest) ToCommand() (command.CreateProductCommand, error) {
+	return command.NewCreateProductCommand(r.Name, r.Category, r.Unit, r.ReorderLevel, r.Description)
+}
+
+// UpdateProductRequest represents the changes to a product, omitted fields are kept
+// @Description Partial update of a product
+type UpdateProductRequest struct {
+	Name         *string `json:"name,omitempty" validate:"omitempty,min=1,max=150" example:"Nobivac DHPPi"`
+	Category     *string `json:"category,omitempty" example:"vaccine"`
+	Unit         *string `json:"unit,omitempty" validate:"omitempty,min=1,max=30" example:"dose"`
+	ReorderLevel *int    `json:"reorder_level,omitempty" validate:"omitempty,min=0" example:"10"`
+	Description  *string `json:"description,omitempty" validate:"omitempty,max=500" example:"Canine distemper, hepatitis, parvovirus and parainfluenza"`
+	IsActive     *bool   `json:"is_active,omitempty" example:"true"`
+}
+
+func (r *UpdateProductRequest) ToCommand(productID uint) (command.UpdateProductCommand, error) {
+	return command.NewUpdateProductCommand(productID, r.Name, r.Category, r.Unit, r.ReorderLevel, r.Description, r.IsActive)
+}
+
+// CreateStockLocationRequest represents where lots are stored
+// @Description Shelf, fridge or cabinet holding stock
+type CreateStockLocationRequest struct {
+	Name        string  `json:"name" validate:"required,max=100" example:"Vaccine Fridge"`
+	Description *string `json:"description,omitempty" validate:"omitempty,max=500" example:"Pharmacy, kept between 2 and 8 °C"`
+}
+
+func (r *CreateStockLocationRequest) ToCommand() (command.CreateStockLocationCommand, error) {
+	return command.NewCreateStockLocationCommand(r.Name, r.Description)
+}
+
+// UpdateStockLocationRequest represents the changes to a stock location, omitted fields are kept
+type UpdateStockLocationRequest struct {
+	Name        *string `json:"name,omitempty" validate:"omitempty,min=1,max=100" example:"Vaccine Fridge"`
+	Description *string `json:"description,omitempty" validate:"omitempty,max=500" example:"Pharmacy, kept between 2 and 8 °C"`
+	IsActive    *bool   `json:"is_active,omitempty" example:"true"`
+}
+
+func (r *UpdateStockLocationRequest) ToCommand(locationID uint) (command.UpdateStockLocationCommand, error) {
+	return command.NewUpdateStockLocationCommand(locationID, r.Name, r.Description, r.IsActive)
+}
+
+// ReceiveStockRequest represents a delivery of a lot of the product
+// @Description Units of a lot taken into stock at a location, a lot number already there is topped up
+type ReceiveStockRequest struct {
+	ProductID  uint   `json:"product_id" validate:"required,gt=0" example:"3"`
+	LocationID uint   `json:"location_id" validate:"required,gt=0" example:"1"`
+	LotNumber  string `json:"lot_number" validate:"required,max=50" example:"A123B45"`
+	ExpiresOn  string `json:"expires_on" validate:"required,datetime=2006-01-02" example:"2026-06-30"`
+	Quantity   int    `json:"quantity" validate:"required,min=1" example:"25"`
+}
+
+func (r *ReceiveStockRequest) ToCommand(receivedBy uint) (command.ReceiveStockCommand, error) {
+	expiresOn, err := time.ParseInLocation(time.DateOnly, r.ExpiresOn, time.UTC)
+	if err != nil {
+		return command.ReceiveStockCommand{}, httpError.ValidationError("expires_on", r.ExpiresOn, "must use the YYYY-MM-DD format")
+	}
+
+	return command.NewReceiveStockCommand(r.ProductID, r.LocationID, receivedBy, r.LotNumber, expiresOn, r.Quantity)
+}
+
+// AdjustStockRequest represents a correction of the stock of a lot after a count
+// @Description Units added to the lot, negative to take them out
+type AdjustStockRequest struct {
+	Difference int    `json:"difference" validate:"required,ne=0" example:"-2"`
+	Reason     string `json:"reason" validate:"required,max=500" example:"Monthly count, two vials missing"`
+}
+
+func (r *AdjustStockRequest) ToCommand(lotID, adjustedBy uint) (command.AdjustStockCommand, error) {
+	return command.NewAdjustStockCommand(lotID, adjustedBy, r.Difference, r.Reason)
+}
+
+// WasteStockRequest represents units of a lot written off
+type WasteStockRequest struct {
+	Quantity int    `json:"quantity" validate:"required,min=1" example:"1"`
+	Reason   string `json:"reason" validate:"required,max=500" example:"Vial dropped and broken"`
+}
+
+func (r *WasteStockRequest) ToCommand(lotID, wastedBy uint) (command.WasteStockCommand, error) {
+	return command.NewWasteStockCommand(lotID, wastedBy, r.Quantity, r.Reason)
+}
+
+// FindInventoryRequest represents the query params to list the products or the stock locations
+type FindInventoryRequest struct {
+	IncludeInactive bool `form:"include_inactive" example:"false"`
+}
+
+// FindExpiringLotsRequest represents the query params of the near-expiry alert
+type FindExpiringLotsRequest struct {
+	WithinDays *int `form:"within_days" validate:"omitempty,min=0,max=365" example:"30"`
+}
+
+func (r *FindExpiringLotsRequest) ToQuery() (query.FindExpiringLotsQuery, error) {
+	return query.NewFindExpiringLotsQuery(r.WithinDays)
+}
+
+func ToFindLotMovementsQuery(lotID uint, pagination page.PaginationRequest) (query.FindLotMovementsQuery, error) {
+	return query.NewFindLotMovementsQuery(lotID, pagination)
+}
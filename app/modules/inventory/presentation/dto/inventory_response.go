@@ -0,0 +1,189 @@
+package dto
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/inventory/application/handler"
+)
+
+// ProductResponse represents a product kept in stock
+type ProductResponse struct {
+	ID           uint      `json:"id"`
+	Name         string    `json:"name"`
+	Category     string    `json:"category"`
+	Unit         string    `json:"unit"`
+	ReorderLevel int       `json:"reorder_level"`
+	Description  *string   `json:"description,omitempty"`
+	IsActive     bool      `json:"is_active"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// ProductStockResponse represents a product with the lots holding its stock
+type ProductStockResponse struct {
+	ProductResponse
+	OnHand     int           `json:"on_hand"`
+	IsLowStock bool          `json:"is_low_stock"`
+	Lots       []LotResponse `json:"lots"`
+}
+
+// StockLocationResponse represents where lots are stored
+type StockLocationResponse struct {
+	ID          uint      `json:"id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description,omitempty"`
+	IsActive    bool      `json:"is_active"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// LotResponse represents a lot of a product at a location
+type LotResponse struct {
+	ID             uint      `json:"id"`
+	ProductID      uint      `json:"product_id"`
+	LocationID     uint      `json:"location_id"`
+	LotNumber      string    `json:"lot_number"`
+	ExpiresOn      string    `json:"expires_on"`
+	QuantityOnHand int       `json:"quantity_on_hand"`
+	ReceivedAt     time.Time `json:"received_at"`
+	IsExpired      bool      `json:"is_expired"`
+}
+
+// StockMovementResponse represents a change of the stock of a lot
+type StockMovementResponse struct {
+	ID            uint      `json:"id"`
+	LotID         uint      `json:"lot_id"`
+	ProductID     uint      `json:"product_id"`
+	MovementType  string    `json:"movement_type"`
+	Quantity      int       `json:"quantity"`
+	BalanceAfter  int       `json:"balance_after"`
+	Reason        *string   `json:"reason,omitempty"`
+	ReferenceType *string   `json:"reference_type,omitempty"`
+	ReferenceID   *uint     `json:"reference_id,omitempty"`
+	PerformedBy   uint      `json:"performed_by"`
+	OccurredAt    time.Time `json:"occurred_at"`
+}
+
+// LowStockResponse represents a product to reorder
+type LowStockResponse struct {
+	ProductResponse
+	OnHand int `json:"on_hand"`
+}
+
+// ExpiringLotResponse represents a lot holding stock about to expire or already expired
+type ExpiringLotResponse struct {
+	LotResponse
+	ProductName  string `json:"product_name"`
+	Unit         string `json:"unit"`
+	LocationName string `json:"location_name"`
+	DaysLeft     int    `json:"days_left"`
+}
+
+func FromProductResult(result handler.ProductResult) ProductResponse {
+	return ProductResponse{
+		ID:           result.ID,
+		Name:         result.Name,
+		Category:     result.Category,
+		Unit:         result.Unit,
+		ReorderLevel: result.ReorderLevel,
+		Description:  result.Description,
+		IsActive:     result.IsActive,
+		CreatedAt:    result.CreatedAt,
+		UpdatedAt:    result.UpdatedAt,
+	}
+}
+
+func FromProductResults(results []handler.ProductResult) []ProductResponse {
+	responses := make([]ProductResponse, len(results))
+	for i, result := range results {
+		responses[i] = FromProductResult(result)
+	}
+	return responses
+}
+
+func FromProductStockResult(result handler.ProductStockResult) ProductStockResponse {
+	lots := make([]LotResponse, len(result.Lots))
+	for i, lot := range result.Lots {
+		lots[i] = FromLotResult(lot)
+	}
+
+	return ProductStockResponse{
+		ProductResponse: FromProductResult(result.Product),
+		OnHand:          result.OnHand,
+		IsLowStock:      result.IsLowStock,
+		Lots:            lots,
+	}
+}
+
+func FromLocationResults(results []handler.LocationResult) []StockLocationResponse {
+	responses := make([]StockLocationResponse, len(results))
+	for i, result := range results {
+		responses[i] = StockLocationResponse{
+			ID:          result.ID,
+			Name:        result.Name,
+			Description: result.Description,
+			IsActive:    result.IsActive,
+			CreatedAt:   result.CreatedAt,
+			UpdatedAt:   result.UpdatedAt,
+		}
+	}
+	return responses
+}
+
+func FromLotResult(result handler.LotResult) LotResponse {
+	return LotResponse{
+		ID:             result.ID,
+		ProductID:      result.ProductID,
+		LocationID:     result.LocationID,
+		LotNumber:      result.LotNumber,
+		ExpiresOn:      result.ExpiresOn.Format(time.DateOnly),
+		QuantityOnHand: result.QuantityOnHand,
+		ReceivedAt:     result.ReceivedAt,
+		IsExpired:      result.IsExpired,
+	}
+}
+
+func FromMovementResults(results []handler.MovementResult) []StockMovementResponse {
+	responses := make([]StockMovementResponse, len(results))
+	for i, result := range results {
+		responses[i] = StockMovementResponse{
+			ID:            result.ID,
+			LotID:         result.LotID,
+			ProductID:     result.ProductID,
+			MovementType:  result.MovementType,
+			Quantity:      result.Quantity,
+			BalanceAfter:  result.BalanceAfter,
+			Reason:        result.Reason,
+			ReferenceType: result.ReferenceType,
+			ReferenceID:   result.ReferenceID,
+			PerformedBy:   result.PerformedBy,
+			OccurredAt:    result.OccurredAt,
+		}
+	}
+	return responses
+}
+
+func FromLowStockResults(results []handler.LowStockResult) []LowStockResponse {
+	responses := make([]LowStockResponse, len(results))
+	for i, result := range results {
+		responses[i] = LowStockResponse{
+			ProductResponse: FromProductResult(result.Product),
+			OnHand:          result.OnHand,
+		}
+	}
+	return responses
+}
+
+func FromExpiringLotResults(results []handler.ExpiringLotResult) []ExpiringLotResponse {
+	responses := make([]ExpiringLotResponse, len(results))
+	for i, result := range results {
+		responses[i] = ExpiringLotResponse{
+			LotResponse:  FromLotResult(result.Lot),
+			ProductName:  result.ProductName,
+			Unit:         result.Unit,
+			LocationName: result.LocationName,
+			DaysLeft:     result.DaysLeft,
+		}
+	}
+	return responses
+}
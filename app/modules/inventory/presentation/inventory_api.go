@@ -0,0 +1,115 @@
+package api
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
+	"clinic-vet-api/app/modules/inventory/application"
+	"clinic-vet-api/app/modules/inventory/application/handler"
+	sqlcRepo "clinic-vet-api/app/modules/inventory/infrastructure/repository"
+	"clinic-vet-api/app/modules/inventory/presentation/controller"
+	"clinic-vet-api/app/modules/inventory/presentation/routes"
+	"clinic-vet-api/app/shared/database"
+	"clinic-vet-api/app/shared/mapper"
+	"clinic-vet-api/sqlc"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type InventoryAPIConfig struct {
+	Router         *gin.RouterGroup
+	Validator      *validator.Validate
+	AuthMiddleware *middleware.AuthMiddleware
+	Queries        *sqlc.Queries
+	Transactor     *database.Transactor
+}
+
+type InventoryAPIComponents struct {
+	Repository      repository.InventoryRepository
+	Service         application.InventoryFacadeService
+	StockService    *service.StockService
+	AdminController *controller.AdminInventoryController
+	Controller      *controller.InventoryController
+}
+
+type InventoryAPIModule struct {
+	config     *InventoryAPIConfig
+	isBuilt    bool
+	Components InventoryAPIComponents
+}
+
+func NewInventoryAPIModule(config *InventoryAPIConfig) *InventoryAPIModule {
+	return &InventoryAPIModule{
+		config:  config,
+		isBuilt: false,
+	}
+}
+
+func (b *InventoryAPIModule) Bootstrap() error {
+	if b.isBuilt {
+		return nil
+	}
+
+	if err := b.validateConfig(); err != nil {
+		return err
+	}
+
+	repo := sqlcRepo.NewSqlcInventoryRepository(b.config.Queries, b.config.Transactor, mapper.NewSqlcFieldMapper())
+
+	cmdHandler := handler.NewInventoryCommandHandler(repo)
+	qryHandler := handler.NewInventoryQueryHandler(repo)
+	facadeService := application.NewInventoryFacadeService(qryHandler, cmdHandler)
+
+	adminController := controller.NewAdminInventoryController(facadeService, b.config.Validator)
+	inventoryController := controller.NewInventoryController(facadeService, b.config.Validator)
+	routes.InventoryRoutes(b.config.Router, adminController, inventoryController, b.config.AuthMiddleware)
+
+	b.Components = InventoryAPIComponents{
+		Repository:      repo,
+		Service:         facadeService,
+		StockService:    service.NewStockService(repo),
+		AdminController: adminController,
+		Controller:      inventoryController,
+	}
+	b.isBuilt = true
+
+	return nil
+}
+
+// GetStockService returns the service the clinical modules dispense stock with
+func (b *InventoryAPIModule) GetStockService() (*service.StockService, error) {
+	if !b.isBuilt {
+		return nil, errors.New("module not bootstrapped")
+	}
+	return b.Components.StockService, nil
+}
+
+func (b *InventoryAPIModule) validateConfig() error {
+	if b.config == nil {
+		return errors.New("inventory api config is nil")
+	}
+
+	if b.config.Router == nil {
+		return errors.New("router is nil")
+	}
+
+	if b.config.Validator == nil {
+		return errors.New("validator is nil")
+	}
+
+	if b.config.AuthMiddleware == nil {
+		return errors.New("auth middleware is nil")
+	}
+
+	if b.config.Queries == nil {
+		return errors.New("queries is nil")
+	}
+
+	if b.config.Transactor == nil {
+		return errors.New("transactor is nil")
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package routes
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/inventory/presentation/controller"
+
+	"github.com/gin-gonic/gin"
+)
+
+func InventoryRoutes(
+	router *gin.RouterGroup,
+	adminController *controller.AdminInventoryController,
+	inventoryController *controller.InventoryController,
+	authMiddleware *middleware.AuthMiddleware,
+) {
+	adminGroup := router.Group("/admin/inventory")
+	adminGroup.Use(authMiddleware.Authenticate())
+	adminGroup.Use(authMiddleware.RequireAnyRole(enum.UserRoleAdmin.String()))
+	{
+		adminGroup.POST("/products", adminController.CreateProduct)
+		adminGroup.PUT("/products/:id", adminController.UpdateProduct)
+		adminGroup.GET("/locations", adminController.FindLocations)
+		adminGroup.POST("/locations", adminController.CreateLocation)
+		adminGroup.PUT("/locations/:id", adminController.UpdateLocation)
+	}
+
+	employeeGroup := router.Group("/employees/inventory")
+	employeeGroup.Use(authMiddleware.Authenticate())
+	employeeGroup.Use(authMiddleware.RequireAnyRole(
+		enum.UserRoleVeterinarian.String(),
+		enum.UserRoleReceptionist.String(),
+		enum.UserRoleAdmin.String(),
+	))
+	{
+		employeeGroup.GET("/products", inventoryController.FindProducts)
+		employeeGroup.GET("/products/:id", inventoryController.GetProductStock)
+		employeeGroup.POST("/receipts", inventoryController.ReceiveStock)
+		employeeGroup.POST("/lots/:id/adjustments", inventoryController.AdjustStock)
+		employeeGroup.POST("/lots/:id/waste", inventoryController.WasteStock)
+		employeeGroup.GET("/lots/:id/movements", inventoryController.FindLotMovements)
+		employeeGroup.GET("/alerts/low-stock", inventoryController.FindLowStock)
+		employeeGroup.GET("/alerts/expiring", inventoryController.FindExpiringLots)
+	}
+}
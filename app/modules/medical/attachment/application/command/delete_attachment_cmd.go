@@ -0,0 +1,19 @@
+package command
+
+import (
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type DeleteAttachmentCommand struct {
+	id valueobject.AttachmentID
+}
+
+func NewDeleteAttachmentCommand(id uint) (DeleteAttachmentCommand, error) {
+	if id == 0 {
+		return DeleteAttachmentCommand{}, deleteCmdErr("id", "is required")
+	}
+
+	return DeleteAttachmentCommand{id: valueobject.NewAttachmentID(id)}, nil
+}
+
+func (c DeleteAttachmentCommand) ID() valueobject.AttachmentID { return c.id }
@@ -0,0 +1,13 @@
+package command
+
+import (
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+func uploadCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "UploadAttachmentCommand")
+}
+
+func deleteCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "DeleteAttachmentCommand")
+}
@@ -0,0 +1,82 @@
+package command
+
+import (
+	"io"
+	"strings"
+
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// UploadAttachmentCommand attaches a file to the medical record of a pet, optionally to one of
+// its sessions. The content is read twice, once to check and hash it and once to store it
+type UploadAttachmentCommand struct {
+	petID       valueobject.PetID
+	sessionID   *valueobject.MedSessionID
+	uploadedBy  valueobject.EmployeeID
+	category    enum.AttachmentCategory
+	fileName    string
+	description *string
+	content     io.ReadSeeker
+	size        int64
+}
+
+func NewUploadAttachmentCommand(
+	petID uint,
+	sessionID *uint,
+	uploadedBy uint,
+	category string,
+	fileName string,
+	description *string,
+	content io.ReadSeeker,
+	size int64,
+) (UploadAttachmentCommand, error) {
+	if petID == 0 {
+		return UploadAttachmentCommand{}, uploadCmdErr("petId", "is required")
+	}
+
+	if uploadedBy == 0 {
+		return UploadAttachmentCommand{}, uploadCmdErr("uploadedBy", "only employees can upload attachments")
+	}
+
+	attachmentCategory := enum.AttachmentCategoryOther
+	if strings.TrimSpace(category) != "" {
+		parsed, err := enum.ParseAttachmentCategory(category)
+		if err != nil {
+			return UploadAttachmentCommand{}, uploadCmdErr("category", err.Error())
+		}
+		attachmentCategory = parsed
+	}
+
+	if strings.TrimSpace(fileName) == "" {
+		return UploadAttachmentCommand{}, uploadCmdErr("file", "the file name is required")
+	}
+
+	if content == nil || size <= 0 {
+		return UploadAttachmentCommand{}, uploadCmdErr("file", "the file is empty")
+	}
+
+	if sessionID != nil && *sessionID == 0 {
+		sessionID = nil
+	}
+
+	return UploadAttachmentCommand{
+		petID:       valueobject.NewPetID(petID),
+		sessionID:   valueobject.NewOptMedSessionID(sessionID),
+		uploadedBy:  valueobject.NewEmployeeID(uploadedBy),
+		category:    attachmentCategory,
+		fileName:    fileName,
+		description: description,
+		content:     content,
+		size:        size,
+	}, nil
+}
+
+func (c UploadAttachmentCommand) PetID() valueobject.PetID             { return c.petID }
+func (c UploadAttachmentCommand) SessionID() *valueobject.MedSessionID { return c.sessionID }
+func (c UploadAttachmentCommand) UploadedBy() valueobject.EmployeeID   { return c.uploadedBy }
+func (c UploadAttachmentCommand) Category() enum.AttachmentCategory    { return c.category }
+func (c UploadAttachmentCommand) FileName() string                     { return c.fileName }
+func (c UploadAttachmentCommand) Description() *string                 { return c.description }
+func (c UploadAttachmentCommand) Content() io.ReadSeeker               { return c.content }
+func (c UploadAttachmentCommand) Size() int64                          { return c.size }
@@ -0,0 +1,62 @@
+package application
+
+import (
+	"context"
+
+	c "clinic-vet-api/app/modules/medical/attachment/application/command"
+	h "clinic-vet-api/app/modules/medical/attachment/application/handler"
+	q "clinic-vet-api/app/modules/medical/attachment/application/query"
+	"clinic-vet-api/app/shared/cqrs"
+	"clinic-vet-api/app/shared/page"
+)
+
+type AttachmentFacadeService interface {
+	FindAttachmentByID(ctx context.Context, qry q.FindAttachmentByIDQuery) (h.AttachmentResult, error)
+	FindAttachmentsByPet(ctx context.Context, qry q.FindAttachmentsByPetQuery) (page.Page[h.AttachmentResult], error)
+	FindAttachmentsBySession(ctx context.Context, qry q.FindAttachmentsBySessionQuery) ([]h.AttachmentResult, error)
+	FindAttachmentDownload(ctx context.Context, qry q.FindAttachmentDownloadQuery) (h.AttachmentDownloadResult, error)
+	OpenAttachmentContent(ctx context.Context, qry q.FindAttachmentContentQuery) (h.AttachmentContentResult, error)
+
+	UploadAttachment(ctx context.Context, cmd c.UploadAttachmentCommand) cqrs.CommandResult
+	DeleteAttachment(ctx context.Context, cmd c.DeleteAttachmentCommand) cqrs.CommandResult
+}
+
+type attachmentFacadeService struct {
+	qryHandler *h.AttachmentQueryHandler
+	cmdHandler *h.AttachmentCommandHandler
+}
+
+func NewAttachmentFacadeService(qryHandler *h.AttachmentQueryHandler, cmdHandler *h.AttachmentCommandHandler) AttachmentFacadeService {
+	return &attachmentFacadeService{
+		qryHandler: qryHandler,
+		cmdHandler: cmdHandler,
+	}
+}
+
+func (s *attachmentFacadeService) FindAttachmentByID(ctx context.Context, qry q.FindAttachmentByIDQuery) (h.AttachmentResult, error) {
+	return s.qryHandler.HandleFindByID(ctx, qry)
+}
+
+func (s *attachmentFacadeService) FindAttachmentsByPet(ctx context.Context, qry q.FindAttachmentsByPetQuery) (page.Page[h.AttachmentResult], error) {
+	return s.qryHandler.HandleFindByPet(ctx, qry)
+}
+
+func (s *attachmentFacadeService) FindAttachmentsBySession(ctx context.Context, qry q.FindAttachmentsBySessionQuery) ([]h.AttachmentResult, error) {
+	return s.qryHandler.HandleFindBySession(ctx, qry)
+}
+
+func (s *attachmentFacadeService) FindAttachmentDownload(ctx context.Context, qry q.FindAttachmentDownloadQuery) (h.AttachmentDownloadResult, error) {
+	return s.qryHandler.HandleDownloadLink(ctx, qry)
+}
+
+func (s *attachmentFacadeService) OpenAttachmentContent(ctx context.Context, qry q.FindAttachmentContentQuery) (h.AttachmentContentResult, error) {
+	return s.qryHandler.HandleOpenContent(ctx, qry)
+}
+
+func (s *attachmentFacadeService) UploadAttachment(ctx context.Context, cmd c.UploadAttachmentCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleUpload(ctx, cmd)
+}
+
+func (s *attachmentFacadeService) DeleteAttachment(ctx context.Context, cmd c.DeleteAttachmentCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleDelete(ctx, cmd)
+}
@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/medical/attachment/application/command"
+	"clinic-vet-api/app/shared/cqrs"
+	"clinic-vet-api/app/shared/log"
+	"clinic-vet-api/app/shared/storage"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	FailFindPetMsg               = "failed to find pet"
+	FailFindSessionMsg           = "failed to find medical session"
+	FailFindAttachmentMsg        = "failed to find medical attachment"
+	FailReadAttachmentMsg        = "failed to read the uploaded file"
+	FailValidateAttachmentMsg    = "medical attachment validation failed"
+	FailStoreAttachmentMsg       = "failed to store the uploaded file"
+	FailSaveAttachmentMsg        = "failed to save medical attachment"
+	FailDeleteAttachmentMsg      = "failed to delete medical attachment"
+	SuccessAttachmentUploadedMsg = "medical attachment uploaded successfully"
+	SuccessAttachmentDeletedMsg  = "medical attachment deleted successfully"
+)
+
+// dicomPreamble is the length of the preamble preceding the "DICM" prefix of DICOM files
+const dicomPreamble = 128
+
+type AttachmentCommandHandler struct {
+	attachmentRepo repository.MedicalAttachmentRepository
+	petRepo        repository.PetRepository
+	sessionRepo    repository.MedicalSessionRepository
+	storage        storage.BlobStorage
+}
+
+func NewAttachmentCommandHandler(
+	attachmentRepo repository.MedicalAttachmentRepository,
+	petRepo repository.PetRepository,
+	sessionRepo repository.MedicalSessionRepository,
+	blobStorage storage.BlobStorage,
+) *AttachmentCommandHandler {
+	return &AttachmentCommandHandler{
+		attachmentRepo: attachmentRepo,
+		petRepo:        petRepo,
+		sessionRepo:    sessionRepo,
+		storage:        blobStorage,
+	}
+}
+
+// HandleUpload stores the file and records it in the medical record of the pet. The content type
+// is detected from the content rather than trusted from the client. The file is stored before the
+// record is saved and removed again when saving fails, so no record points to a missing file
+func (h *AttachmentCommandHandler) HandleUpload(ctx context.Context, cmd command.UploadAttachmentCommand) cqrs.CommandResult {
+	if _, err := h.petRepo.FindByID(ctx, cmd.PetID()); err != nil {
+		return cqrs.FailureResult(FailFindPetMsg, err)
+	}
+
+	var session *medical.MedicalSession
+	if cmd.SessionID() != nil {
+		found, err := h.sessionRepo.FindByID(ctx, *cmd.SessionID())
+		if err != nil {
+			return cqrs.FailureResult(FailFindSessionMsg, err)
+		}
+		session = found
+	}
+
+	contentType, checksum, err := inspectContent(cmd.Content())
+	if err != nil {
+		return cqrs.FailureResult(FailReadAttachmentMsg, err)
+	}
+
+	attachment, err := medical.AttachFile(ctx, cmd.PetID(), session, cmd.UploadedBy(), cmd.Category(),
+		cmd.FileName(), contentType, cmd.Size(), checksum, uuid.NewString(), cmd.Description())
+	if err != nil {
+		return cqrs.FailureResult(FailValidateAttachmentMsg, err)
+	}
+
+	if _, err := cmd.Content().Seek(0, io.SeekStart); err != nil {
+		return cqrs.FailureResult(FailReadAttachmentMsg, err)
+	}
+
+	if err := h.storage.Put(ctx, attachment.StorageKey(), cmd.Content(), attachment.SizeBytes(), attachment.ContentType()); err != nil {
+		return cqrs.FailureResult(FailStoreAttachmentMsg, err)
+	}
+
+	if err := h.attachmentRepo.Save(ctx, attachment); err != nil {
+		h.removeFile(attachment.StorageKey())
+		return cqrs.FailureResult(FailSaveAttachmentMsg, err)
+	}
+
+	return cqrs.SuccessCreateResult(attachment.ID().String(), SuccessAttachmentUploadedMsg)
+}
+
+// HandleDelete removes the attachment from the record and then its file. A file that could not be
+// removed is only logged, once the record is gone no link can reach it
+func (h *AttachmentCommandHandler) HandleDelete(ctx context.Context, cmd command.DeleteAttachmentCommand) cqrs.CommandResult {
+	attachment, err := h.attachmentRepo.FindByID(ctx, cmd.ID())
+	if err != nil {
+		return cqrs.FailureResult(FailFindAttachmentMsg, err)
+	}
+
+	if err := h.attachmentRepo.Delete(ctx, cmd.ID()); err != nil {
+		return cqrs.FailureResult(FailDeleteAttachmentMsg, err)
+	}
+
+	h.removeFile(attachment.StorageKey())
+	return cqrs.SuccessResult(SuccessAttachmentDeletedMsg)
+}
+
+// removeFile runs on its own context, the request may be cancelled by then
+func (h *AttachmentCommandHandler) removeFile(key string) {
+	if err := h.storage.Delete(context.Background(), key); err != nil {
+		log.Warn("failed to remove medical attachment file",
+			zap.String("storage_key", key),
+			zap.Error(err),
+		)
+	}
+}
+
+// inspectContent reads the whole content once, detecting its type from the first bytes and
+// computing its SHA-256 checksum
+func inspectContent(content io.ReadSeeker) (string, string, error) {
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return "", "", err
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(content, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", "", err
+	}
+	head = head[:n]
+
+	hash := sha256.New()
+	hash.Write(head)
+	if _, err := io.Copy(hash, content); err != nil {
+		return "", "", err
+	}
+
+	return detectContentType(head), hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// detectContentType relies on the sniffing of net/http, which doesn't know about DICOM files
+func detectContentType(head []byte) string {
+	if len(head) >= dicomPreamble+4 && string(head[dicomPreamble:dicomPreamble+4]) == "DICM" {
+		return "application/dicom"
+	}
+	return http.DetectContentType(head)
+}
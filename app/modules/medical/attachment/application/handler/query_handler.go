@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	domainerr "clinic-vet-api/app/modules/core/error"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/medical/attachment/application/query"
+	"clinic-vet-api/app/shared/page"
+	"clinic-vet-api/app/shared/storage"
+)
+
+type AttachmentQueryHandler struct {
+	attachmentRepo repository.MedicalAttachmentRepository
+	petRepo        repository.PetRepository
+	storage        storage.BlobStorage
+	signer         medical.AttachmentLinkSigner
+	urlExpiration  time.Duration
+}
+
+func NewAttachmentQueryHandler(
+	attachmentRepo repository.MedicalAttachmentRepository,
+	petRepo repository.PetRepository,
+	blobStorage storage.BlobStorage,
+	signer medical.AttachmentLinkSigner,
+	urlExpiration time.Duration,
+) *AttachmentQueryHandler {
+	return &AttachmentQueryHandler{
+		attachmentRepo: attachmentRepo,
+		petRepo:        petRepo,
+		storage:        blobStorage,
+		signer:         signer,
+		urlExpiration:  urlExpiration,
+	}
+}
+
+func (h *AttachmentQueryHandler) HandleFindByID(ctx context.Context, qry query.FindAttachmentByIDQuery) (AttachmentResult, error) {
+	attachment, err := h.attachmentRepo.FindByID(ctx, qry.ID())
+	if err != nil {
+		return AttachmentResult{}, err
+	}
+
+	if err := h.checkPet(ctx, attachment.PetID(), qry.CustomerID()); err != nil {
+		return AttachmentResult{}, err
+	}
+
+	return toAttachmentResult(attachment), nil
+}
+
+func (h *AttachmentQueryHandler) HandleFindByPet(ctx context.Context, qry query.FindAttachmentsByPetQuery) (page.Page[AttachmentResult], error) {
+	if err := h.checkPet(ctx, qry.PetID(), qry.CustomerID()); err != nil {
+		return page.Page[AttachmentResult]{}, err
+	}
+
+	attachmentPage, err := h.attachmentRepo.FindByPet(ctx, qry.PetID(), qry.Category(), qry.Pagination())
+	if err != nil {
+		return page.Page[AttachmentResult]{}, err
+	}
+
+	return page.MapItems(attachmentPage, toAttachmentResult), nil
+}
+
+func (h *AttachmentQueryHandler) HandleFindBySession(ctx context.Context, qry query.FindAttachmentsBySessionQuery) ([]AttachmentResult, error) {
+	attachments, err := h.attachmentRepo.FindBySession(ctx, qry.SessionID())
+	if err != nil {
+		return nil, err
+	}
+
+	return toAttachmentResults(attachments), nil
+}
+
+// HandleDownloadLink issues a link valid for the configured time. Storages able to presign URLs
+// serve the file themselves, otherwise the link carries a signed token the API serves it with
+func (h *AttachmentQueryHandler) HandleDownloadLink(ctx context.Context, qry query.FindAttachmentDownloadQuery) (AttachmentDownloadResult, error) {
+	attachment, err := h.attachmentRepo.FindByID(ctx, qry.ID())
+	if err != nil {
+		return AttachmentDownloadResult{}, err
+	}
+
+	if err := h.checkPet(ctx, attachment.PetID(), qry.CustomerID()); err != nil {
+		return AttachmentDownloadResult{}, err
+	}
+
+	expiresAt := time.Now().Add(h.urlExpiration)
+	if presigner, ok := h.storage.(storage.Presigner); ok {
+		url, err := presigner.PresignGet(ctx, attachment.StorageKey(), h.urlExpiration, storage.DownloadOptions{
+			FileName:    attachment.FileName(),
+			ContentType: attachment.ContentType(),
+		})
+		if err != nil {
+			return AttachmentDownloadResult{}, err
+		}
+		return AttachmentDownloadResult{URL: url, ExpiresAt: expiresAt}, nil
+	}
+
+	return AttachmentDownloadResult{Token: h.signer.Token(attachment, expiresAt), ExpiresAt: expiresAt}, nil
+}
+
+// HandleOpenContent opens the file of a download link issued by HandleDownloadLink
+func (h *AttachmentQueryHandler) HandleOpenContent(ctx context.Context, qry query.FindAttachmentContentQuery) (AttachmentContentResult, error) {
+	id, err := h.signer.ParseToken(ctx, qry.Token())
+	if err != nil {
+		return AttachmentContentResult{}, err
+	}
+
+	attachment, err := h.attachmentRepo.FindByID(ctx, id)
+	if err != nil {
+		return AttachmentContentResult{}, err
+	}
+
+	if err := h.signer.Verify(ctx, attachment, qry.Token(), time.Now()); err != nil {
+		return AttachmentContentResult{}, err
+	}
+
+	content, err := h.storage.Get(ctx, attachment.StorageKey())
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return AttachmentContentResult{}, domainerr.EntityNotFoundError(ctx, "medical attachment file", attachment.ID().String(), "OpenMedicalAttachment")
+		}
+		return AttachmentContentResult{}, err
+	}
+
+	return AttachmentContentResult{Attachment: toAttachmentResult(attachment), Content: content}, nil
+}
+
+// checkPet makes sure the pet exists and, when a customer asks, that it is theirs
+func (h *AttachmentQueryHandler) checkPet(ctx context.Context, petID valueobject.PetID, customerID *valueobject.CustomerID) error {
+	if customerID != nil {
+		_, err := h.petRepo.FindByIDAndCustomerID(ctx, petID, *customerID)
+		return err
+	}
+
+	_, err := h.petRepo.FindByID(ctx, petID)
+	return err
+}
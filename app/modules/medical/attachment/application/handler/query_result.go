@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"io"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+)
+
+type AttachmentResult struct {
+	ID          uint
+	PetID       uint
+	SessionID   *uint
+	UploadedBy  uint
+	Category    string
+	FileName    string
+	ContentType string
+	SizeBytes   int64
+	Checksum    string
+	Description *string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// AttachmentDownloadResult is a link downloading the file until it expires. The token is set when
+// the file is served by the API rather than by the storage
+type AttachmentDownloadResult struct {
+	URL       string
+	Token     string
+	ExpiresAt time.Time
+}
+
+// AttachmentContentResult is the open file of an attachment, the caller closes the content
+type AttachmentContentResult struct {
+	Attachment AttachmentResult
+	Content    io.ReadCloser
+}
+
+func toAttachmentResult(attachment medical.MedicalAttachment) AttachmentResult {
+	var sessionID *uint
+	if attachment.SessionID() != nil {
+		id := attachment.SessionID().Value()
+		sessionID = &id
+	}
+
+	return AttachmentResult{
+		ID:          attachment.ID().Value(),
+		PetID:       attachment.PetID().Value(),
+		SessionID:   sessionID,
+		UploadedBy:  attachment.UploadedBy().Value(),
+		Category:    attachment.Category().String(),
+		FileName:    attachment.FileName(),
+		ContentType: attachment.ContentType(),
+		SizeBytes:   attachment.SizeBytes(),
+		Checksum:    attachment.Checksum(),
+		Description: attachment.Description(),
+		CreatedAt:   attachment.CreatedAt(),
+		UpdatedAt:   attachment.UpdatedAt(),
+	}
+}
+
+func toAttachmentResults(attachments []medical.MedicalAttachment) []AttachmentResult {
+	results := make([]AttachmentResult, len(attachments))
+	for i, attachment := range attachments {
+		results[i] = toAttachmentResult(attachment)
+	}
+	return results
+}
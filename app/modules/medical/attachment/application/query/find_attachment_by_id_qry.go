@@ -0,0 +1,27 @@
+package query
+
+import (
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+// FindAttachmentByIDQuery returns the metadata of an attachment. When a customer asks, the pet
+// has to be theirs
+type FindAttachmentByIDQuery struct {
+	id         valueobject.AttachmentID
+	customerID *valueobject.CustomerID
+}
+
+func NewFindAttachmentByIDQuery(id uint, customerID *uint) (FindAttachmentByIDQuery, error) {
+	if id == 0 {
+		return FindAttachmentByIDQuery{}, apperror.FieldValidationError("id", "", "attachment ID is required")
+	}
+
+	return FindAttachmentByIDQuery{
+		id:         valueobject.NewAttachmentID(id),
+		customerID: valueobject.NewOptCustomerID(customerID),
+	}, nil
+}
+
+func (q FindAttachmentByIDQuery) ID() valueobject.AttachmentID        { return q.id }
+func (q FindAttachmentByIDQuery) CustomerID() *valueobject.CustomerID { return q.customerID }
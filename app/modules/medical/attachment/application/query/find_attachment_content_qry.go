@@ -0,0 +1,23 @@
+package query
+
+import (
+	"strings"
+
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+// FindAttachmentContentQuery opens the file of a download link, the signed token grants access
+type FindAttachmentContentQuery struct {
+	token string
+}
+
+func NewFindAttachmentContentQuery(token string) (FindAttachmentContentQuery, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return FindAttachmentContentQuery{}, apperror.FieldValidationError("token", "", "download token is required")
+	}
+
+	return FindAttachmentContentQuery{token: token}, nil
+}
+
+func (q FindAttachmentContentQuery) Token() string { return q.token }
@@ -0,0 +1,27 @@
+package query
+
+import (
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+// FindAttachmentDownloadQuery issues a time-limited link downloading the file of the attachment.
+// When a customer asks, the pet has to be theirs
+type FindAttachmentDownloadQuery struct {
+	id         valueobject.AttachmentID
+	customerID *valueobject.CustomerID
+}
+
+func NewFindAttachmentDownloadQuery(id uint, customerID *uint) (FindAttachmentDownloadQuery, error) {
+	if id == 0 {
+		return FindAttachmentDownloadQuery{}, apperror.FieldValidationError("id", "", "attachment ID is required")
+	}
+
+	return FindAttachmentDownloadQuery{
+		id:         valueobject.NewAttachmentID(id),
+		customerID: valueobject.NewOptCustomerID(customerID),
+	}, nil
+}
+
+func (q FindAttachmentDownloadQuery) ID() valueobject.AttachmentID        { return q.id }
+func (q FindAttachmentDownloadQuery) CustomerID() *valueobject.CustomerID { return q.customerID }
@@ -0,0 +1,46 @@
+package query
+
+import (
+	"strings"
+
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+	"clinic-vet-api/app/shared/page"
+)
+
+// FindAttachmentsByPetQuery lists the attachments of a pet, optionally of a single category. When
+// a customer asks, the pet has to be theirs
+type FindAttachmentsByPetQuery struct {
+	petID      valueobject.PetID
+	customerID *valueobject.CustomerID
+	category   *enum.AttachmentCategory
+	pagination page.PaginationRequest
+}
+
+func NewFindAttachmentsByPetQuery(petID uint, customerID *uint, category string, pagination page.PaginationRequest) (FindAttachmentsByPetQuery, error) {
+	if petID == 0 {
+		return FindAttachmentsByPetQuery{}, apperror.FieldValidationError("id", "", "pet ID is required")
+	}
+
+	var attachmentCategory *enum.AttachmentCategory
+	if strings.TrimSpace(category) != "" {
+		parsed, err := enum.ParseAttachmentCategory(category)
+		if err != nil {
+			return FindAttachmentsByPetQuery{}, apperror.FieldValidationError("category", category, err.Error())
+		}
+		attachmentCategory = &parsed
+	}
+
+	return FindAttachmentsByPetQuery{
+		petID:      valueobject.NewPetID(petID),
+		customerID: valueobject.NewOptCustomerID(customerID),
+		category:   attachmentCategory,
+		pagination: pagination,
+	}, nil
+}
+
+func (q FindAttachmentsByPetQuery) PetID() valueobject.PetID            { return q.petID }
+func (q FindAttachmentsByPetQuery) CustomerID() *valueobject.CustomerID { return q.customerID }
+func (q FindAttachmentsByPetQuery) Category() *enum.AttachmentCategory  { return q.category }
+func (q FindAttachmentsByPetQuery) Pagination() page.PaginationRequest  { return q.pagination }
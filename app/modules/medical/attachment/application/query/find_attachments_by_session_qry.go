@@ -0,0 +1,20 @@
+package query
+
+import (
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+type FindAttachmentsBySessionQuery struct {
+	sessionID valueobject.MedSessionID
+}
+
+func NewFindAttachmentsBySessionQuery(sessionID uint) (FindAttachmentsBySessionQuery, error) {
+	if sessionID == 0 {
+		return FindAttachmentsBySessionQuery{}, apperror.FieldValidationError("id", "", "medical session ID is required")
+	}
+
+	return FindAttachmentsBySessionQuery{sessionID: valueobject.NewMedSessionID(sessionID)}, nil
+}
+
+func (q FindAttachmentsBySessionQuery) SessionID() valueobject.MedSessionID { return q.sessionID }
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"fmt"
+
+	dberr "clinic-vet-api/app/shared/error/infrastructure/database"
+)
+
+const (
+	TableMedicalAttachments = "medical_attachments"
+	OpSelect                = "select"
+	OpInsert                = "insert"
+	OpDelete                = "delete"
+	OpCount                 = "count"
+	DriverSQL               = "sqlc"
+
+	ErrMsgGetAttachment    = "failed to get medical attachment"
+	ErrMsgListAttachments  = "failed to list medical attachments"
+	ErrMsgCountAttachments = "failed to count medical attachments"
+	ErrMsgCreateAttachment = "failed to create medical attachment"
+	ErrMsgDeleteAttachment = "failed to delete medical attachment"
+)
+
+func (r *SqlcMedicalAttachmentRepository) dbError(operation, table, message string, err error) error {
+	return dberr.DatabaseOperationError(operation, table, DriverSQL, fmt.Errorf("%s: %v", message, err))
+}
+
+func (r *SqlcMedicalAttachmentRepository) notFoundError(parameterName, parameterValue string) error {
+	return dberr.EntityNotFoundError(parameterName, parameterValue, OpSelect, TableMedicalAttachments, DriverSQL)
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/sqlc"
+)
+
+func (r *SqlcMedicalAttachmentRepository) toEntity(row sqlc.MedicalAttachment) medical.MedicalAttachment {
+	return *medical.NewMedicalAttachmentBuilder().
+		WithID(valueobject.NewAttachmentID(uint(row.ID))).
+		WithPetID(valueobject.NewPetID(uint(row.PetID))).
+		WithSessionID(r.pgMap.PgInt4.ToMedSessionIDPtr(row.MedicalSessionID)).
+		WithUploadedBy(valueobject.NewEmployeeID(uint(row.UploadedBy))).
+		WithCategory(enum.AttachmentCategory(row.Category)).
+		WithFile(row.FileName, row.ContentType, row.SizeBytes, row.ChecksumSha256).
+		WithStorageKey(row.StorageKey).
+		WithDescription(r.pgMap.PgText.ToStringPtr(row.Description)).
+		WithTimestamps(row.CreatedAt.Time, row.UpdatedAt.Time).
+		Build()
+}
+
+func (r *SqlcMedicalAttachmentRepository) toEntities(rows []sqlc.MedicalAttachment) []medical.MedicalAttachment {
+	attachments := make([]medical.MedicalAttachment, len(rows))
+	for i, row := range rows {
+		attachments[i] = r.toEntity(row)
+	}
+	return attachments
+}
+
+func (r *SqlcMedicalAttachmentRepository) toCreateParams(attachment *medical.MedicalAttachment) sqlc.CreateMedicalAttachmentParams {
+	return sqlc.CreateMedicalAttachmentParams{
+		PetID:            attachment.PetID().Int32(),
+		MedicalSessionID: r.pgMap.PgInt4.FromMedSessionIDPtr(attachment.SessionID()),
+		UploadedBy:       attachment.UploadedBy().Int32(),
+		Category:         attachment.Category().String(),
+		FileName:         attachment.FileName(),
+		ContentType:      attachment.ContentType(),
+		SizeBytes:        attachment.SizeBytes(),
+		ChecksumSha256:   attachment.Checksum(),
+		StorageKey:       attachment.StorageKey(),
+		Description:      r.pgMap.PgText.FromStringPtr(attachment.Description()),
+	}
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/mapper"
+	p "clinic-vet-api/app/shared/page"
+	"clinic-vet-api/sqlc"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type SqlcMedicalAttachmentRepository struct {
+	queries *sqlc.Queries
+	pgMap   *mapper.SqlcFieldMapper
+}
+
+func NewSqlcMedicalAttachmentRepository(queries *sqlc.Queries, pgMap *mapper.SqlcFieldMapper) repository.MedicalAttachmentRepository {
+	return &SqlcMedicalAttachmentRepository{queries: queries, pgMap: pgMap}
+}
+
+func (r *SqlcMedicalAttachmentRepository) FindByID(ctx context.Context, id valueobject.AttachmentID) (medical.MedicalAttachment, error) {
+	row, err := r.queries.FindMedicalAttachmentByID(ctx, id.Int32())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return medical.MedicalAttachment{}, r.notFoundError("id", id.String())
+		}
+		return medical.MedicalAttachment{}, r.dbError(OpSelect, TableMedicalAttachments, ErrMsgGetAttachment, err)
+	}
+
+	return r.toEntity(row), nil
+}
+
+func (r *SqlcMedicalAttachmentRepository) FindByPet(
+	ctx context.Context,
+	petID valueobject.PetID,
+	category *enum.AttachmentCategory,
+	pagination p.PaginationRequest,
+) (p.Page[medical.MedicalAttachment], error) {
+	var categoryFilter string
+	if category != nil {
+		categoryFilter = category.String()
+	}
+
+	rows, err := r.queries.FindMedicalAttachmentsByPet(ctx, sqlc.FindMedicalAttachmentsByPetParams{
+		PetID:    petID.Int32(),
+		Limit:    pagination.Limit(),
+		Offset:   pagination.Offset(),
+		Category: categoryFilter,
+	})
+	if err != nil {
+		return p.Page[medical.MedicalAttachment]{}, r.dbError(OpSelect, TableMedicalAttachments, ErrMsgListAttachments, err)
+	}
+
+	total, err := r.queries.CountMedicalAttachmentsByPet(ctx, sqlc.CountMedicalAttachmentsByPetParams{
+		PetID:    petID.Int32(),
+		Category: categoryFilter,
+	})
+	if err != nil {
+		return p.Page[medical.MedicalAttachment]{}, r.dbError(OpCount, TableMedicalAttachments, ErrMsgCountAttachments, err)
+	}
+
+	return p.NewPage(r.toEntities(rows), total, pagination), nil
+}
+
+func (r *SqlcMedicalAttachmentRepository) FindBySession(ctx context.Context, sessionID valueobject.MedSessionID) ([]medical.MedicalAttachment, error) {
+	rows, err := r.queries.FindMedicalAttachmentsBySession(ctx, r.pgMap.PgInt4.FromMedSessionIDPtr(&sessionID))
+	if err != nil {
+		return nil, r.dbError(OpSelect, TableMedicalAttachments, ErrMsgListAttachments, err)
+	}
+
+	return r.toEntities(rows), nil
+}
+
+// Save inserts the attachment. Stored files never change, a new version is a new attachment
+func (r *SqlcMedicalAttachmentRepository) Save(ctx context.Context, attachment *medical.MedicalAttachment) error {
+	row, err := r.queries.CreateMedicalAttachment(ctx, r.toCreateParams(attachment))
+	if err != nil {
+		return r.dbError(OpInsert, TableMedicalAttachments, ErrMsgCreateAttachment, err)
+	}
+
+	*attachment = r.toEntity(row)
+	return nil
+}
+
+func (r *SqlcMedicalAttachmentRepository) Delete(ctx context.Context, id valueobject.AttachmentID) error {
+	if err := r.queries.DeleteMedicalAttachment(ctx, id.Int32()); err != nil {
+		return r.dbError(OpDelete, TableMedicalAttachments, ErrMsgDeleteAttachment, err)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package api
+
+import (
+	"errors"
+	"time"
+
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/medical/attachment/application"
+	"clinic-vet-api/app/modules/medical/attachment/application/handler"
+	sqlcRepo "clinic-vet-api/app/modules/medical/attachment/infrastructure/repository"
+	"clinic-vet-api/app/modules/medical/attachment/presentation/controller"
+	"clinic-vet-api/app/modules/medical/attachment/presentation/routes"
+	"clinic-vet-api/app/shared/mapper"
+	"clinic-vet-api/app/shared/storage"
+	"clinic-vet-api/sqlc"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type AttachmentAPIConfig struct {
+	Router         *gin.RouterGroup
+	Validator      *validator.Validate
+	AuthMiddleware *middleware.AuthMiddleware
+	Queries        *sqlc.Queries
+
+	PetRepo            repository.PetRepository
+	MedicalSessionRepo repository.MedicalSessionRepository
+
+	Storage storage.BlobStorage
+	// SigningSecret signs the download URLs served by the API
+	SigningSecret string
+	// DownloadBaseURL is the absolute public URL downloads are served under
+	DownloadBaseURL string
+	URLExpiration   time.Duration
+	// MaxFileSize is the largest file accepted, in bytes
+	MaxFileSize int64
+}
+
+type AttachmentAPIComponents struct {
+	Repository         repository.MedicalAttachmentRepository
+	Service            application.AttachmentFacadeService
+	EmployeeController *controller.EmployeeAttachmentController
+	CustomerController *controller.CustomerAttachmentController
+	DownloadController *controller.AttachmentDownloadController
+}
+
+type AttachmentAPIModule struct {
+	config     *AttachmentAPIConfig
+	isBuilt    bool
+	Components AttachmentAPIComponents
+}
+
+func NewAttachmentAPIModule(config *AttachmentAPIConfig) *AttachmentAPIModule {
+	return &AttachmentAPIModule{
+		config:  config,
+		isBuilt: false,
+	}
+}
+
+func (b *AttachmentAPIModule) Bootstrap() error {
+	if b.isBuilt {
+		return nil
+	}
+
+	if err := b.validateConfig(); err != nil {
+		return err
+	}
+
+	repo := sqlcRepo.NewSqlcMedicalAttachmentRepository(b.config.Queries, mapper.NewSqlcFieldMapper())
+	signer := medical.NewAttachmentLinkSigner(b.config.SigningSecret)
+
+	cmdHandler := handler.NewAttachmentCommandHandler(repo, b.config.PetRepo, b.config.MedicalSessionRepo, b.config.Storage)
+	qryHandler := handler.NewAttachmentQueryHandler(repo, b.config.PetRepo, b.config.Storage, signer, b.config.URLExpiration)
+	attachmentService := application.NewAttachmentFacadeService(qryHandler, cmdHandler)
+
+	employeeController := controller.NewEmployeeAttachmentController(attachmentService, b.config.Validator, b.config.MaxFileSize, b.config.DownloadBaseURL)
+	customerController := controller.NewCustomerAttachmentController(attachmentService, b.config.Validator, b.config.DownloadBaseURL)
+	downloadController := controller.NewAttachmentDownloadController(attachmentService)
+	routes.AttachmentRoutes(b.config.Router, employeeController, customerController, downloadController, b.config.AuthMiddleware)
+
+	b.Components = AttachmentAPIComponents{
+		Repository:         repo,
+		Service:            attachmentService,
+		EmployeeController: employeeController,
+		CustomerController: customerController,
+		DownloadController: downloadController,
+	}
+	b.isBuilt = true
+
+	return nil
+}
+
+func (b *AttachmentAPIModule) validateConfig() error {
+	if b.config == nil {
+		return errors.New("attachment api config is nil")
+	}
+
+	if b.config.Router == nil {
+		return errors.New("router is nil")
+	}
+
+	if b.config.Validator == nil {
+		return errors.New("validator is nil")
+	}
+
+	if b.config.AuthMiddleware == nil {
+		return errors.New("auth middleware is nil")
+	}
+
+	if b.config.Queries == nil {
+		return errors.New("queries is nil")
+	}
+
+	if b.config.PetRepo == nil {
+		return errors.New("pet repository is nil")
+	}
+
+	if b.config.MedicalSessionRepo == nil {
+		return errors.New("medical session repository is nil")
+	}
+
+	if b.config.Storage == nil {
+		return errors.New("attachment storage is nil")
+	}
+
+	if b.config.SigningSecret == "" {
+		return errors.New("attachment signing secret is empty")
+	}
+
+	if b.config.DownloadBaseURL == "" {
+		return errors.New("attachment download base url is empty")
+	}
+
+	if b.config.URLExpiration <= 0 {
+		return errors.New("attachment url expiration must be positive")
+	}
+
+	if b.config.MaxFileSize <= 0 {
+		return errors.New("attachment max file size must be positive")
+	}
+
+	return nil
+}
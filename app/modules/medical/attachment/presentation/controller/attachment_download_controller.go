@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"mime"
+	"net/http"
+
+	"clinic-vet-api/app/modules/medical/attachment/application"
+	"clinic-vet-api/app/modules/medical/attachment/application/query"
+	"clinic-vet-api/app/shared/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AttachmentDownloadController serves the files of the download URLs issued when the storage
+// can't serve them itself. The signed token in the URL grants access, so they can be opened in a
+// browser or an image viewer without a bearer token
+type AttachmentDownloadController struct {
+	attachmentService application.AttachmentFacadeService
+}
+
+func NewAttachmentDownloadController(attachmentService application.AttachmentFacadeService) *AttachmentDownloadController {
+	return &AttachmentDownloadController{attachmentService: attachmentService}
+}
+
+// DownloadAttachment godoc
+// @Summary Download a medical attachment
+// @Description Public download of the file, the signed token in the URL grants access until it expires
+// @Tags medical-attachment-downloads
+// @Produce octet-stream
+// @Param token path string true "Download token"
+// @Success 200 {file} file "The file"
+// @Failure 400 {object} response.APIResponse "Invalid download token"
+// @Failure 404 {object} response.APIResponse "Attachment not found"
+// @Failure 422 {object} response.APIResponse "The download URL has expired"
+// @Router /medical-attachments/downloads/{token} [get]
+func (ctrl *AttachmentDownloadController) DownloadAttachment(c *gin.Context) {
+	qry, err := query.NewFindAttachmentContentQuery(c.Param("token"))
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result, err := ctrl.attachmentService.OpenAttachmentContent(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+	defer result.Content.Close()
+
+	c.DataFromReader(http.StatusOK, result.Attachment.SizeBytes, result.Attachment.ContentType, result.Content, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType("attachment", map[string]string{"filename": result.Attachment.FileName}),
+		"Cache-Control":          "private, no-store",
+		"X-Content-Type-Options": "nosniff",
+	})
+}
@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/medical/attachment/application"
+	autherror "clinic-vet-api/app/shared/error/auth"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+	ginutils "clinic-vet-api/app/shared/gin_utils"
+	"clinic-vet-api/app/shared/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type CustomerAttachmentController struct {
+	attachmentService application.AttachmentFacadeService
+	validator         *validator.Validate
+	downloadBaseURL   string
+}
+
+func NewCustomerAttachmentController(
+	attachmentService application.AttachmentFacadeService,
+	validator *validator.Validate,
+	downloadBaseURL string,
+) *CustomerAttachmentController {
+	return &CustomerAttachmentController{
+		attachmentService: attachmentService,
+		validator:         validator,
+		downloadBaseURL:   downloadBaseURL,
+	}
+}
+
+// GetMyPetAttachments godoc
+// @Summary List the medical attachments of my pet
+// @Description Returns the X-rays, photos and documents of the medical record of the pet of the authenticated customer, the latest first
+// @Tags customer-pet-attachments
+// @Produce json
+// @Param id path int true "Pet ID"
+// @Param category query string false "Category filter: xray, photo, document or other"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.APIResponse{data=[]dto.AttachmentResponse}
+// @Failure 400 {object} response.APIResponse "Invalid query parameters"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 404 {object} response.APIResponse "Pet not found"
+// @Router /customers/pets/{id}/medical-attachments [get]
+// @Security BearerAuth
+func (ctrl *CustomerAttachmentController) GetMyPetAttachments(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, autherror.UnauthorizedCTXError())
+		return
+	}
+
+	petID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	getPetAttachments(c, ctrl.attachmentService, ctrl.validator, petID, &user.CustomerID)
+}
+
+// GetMyAttachmentDownloadURL godoc
+// @Summary Get the download URL of a medical attachment of my pet
+// @Description Returns a URL downloading the file until it expires
+// @Tags customer-pet-attachments
+// @Produce json
+// @Param id path int true "Attachment ID"
+// @Success 200 {object} response.APIResponse{data=dto.AttachmentDownloadResponse}
+// @Failure 400 {object} response.APIResponse "Invalid attachment ID"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 404 {object} response.APIResponse "Attachment not found"
+// @Router /customers/medical-attachments/{id}/download-url [get]
+// @Security BearerAuth
+func (ctrl *CustomerAttachmentController) GetMyAttachmentDownloadURL(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, autherror.UnauthorizedCTXError())
+		return
+	}
+
+	attachmentID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	getAttachmentDownloadURL(c, ctrl.attachmentService, attachmentID, &user.CustomerID, ctrl.downloadBaseURL)
+}
@@ -0,0 +1,314 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/medical/attachment/application"
+	"clinic-vet-api/app/modules/medical/attachment/application/command"
+	"clinic-vet-api/app/modules/medical/attachment/application/query"
+	"clinic-vet-api/app/modules/medical/attachment/presentation/dto"
+	autherror "clinic-vet-api/app/shared/error/auth"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+	ginutils "clinic-vet-api/app/shared/gin_utils"
+	"clinic-vet-api/app/shared/page"
+	"clinic-vet-api/app/shared/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// multipartOverhead is the room left to the form fields and boundaries on top of the file
+const multipartOverhead = 1 << 20
+
+type EmployeeAttachmentController struct {
+	attachmentService application.AttachmentFacadeService
+	validator         *validator.Validate
+	maxFileSize       int64
+	downloadBaseURL   string
+}
+
+// NewEmployeeAttachmentController takes the largest file accepted, in bytes, and the URL the API
+// serves downloads under
+func NewEmployeeAttachmentController(
+	attachmentService application.AttachmentFacadeService,
+	validator *validator.Validate,
+	maxFileSize int64,
+	downloadBaseURL string,
+) *EmployeeAttachmentController {
+	return &EmployeeAttachmentController{
+		attachmentService: attachmentService,
+		validator:         validator,
+		maxFileSize:       maxFileSize,
+		downloadBaseURL:   downloadBaseURL,
+	}
+}
+
+// UploadAttachment godoc
+// @Summary Upload a medical attachment
+// @Description Attaches an X-ray, photo or document to the medical record of the pet, optionally to one of its medical sessions. Images, DICOM, PDF and plain text files are accepted, the type is detected from the content
+// @Tags employee-medical-attachments
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "File"
+// @Param pet_id formData int true "Pet ID"
+// @Param medical_session_id formData int false "Medical session ID"
+// @Param category formData string false "xray, photo, document or other" default(other)
+// @Param description formData string false "Description"
+// @Success 201 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse "Invalid input data"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 404 {object} response.APIResponse "Pet or medical session not found"
+// @Failure 413 {object} response.APIResponse "File too large"
+// @Router /employees/medical-attachments [post]
+// @Security BearerAuth
+func (ctrl *EmployeeAttachmentController) UploadAttachment(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, autherror.UnauthorizedCTXError())
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, ctrl.maxFileSize+multipartOverhead)
+	if err := c.Request.ParseMultipartForm(multipartOverhead); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			response.ApplicationError(c, httpError.RequestTooLargeError(ctrl.maxFileSize, c.Request.ContentLength))
+			return
+		}
+		response.BadRequest(c, httpError.RequestBodyDataError(err))
+		return
+	}
+
+	var req dto.UploadAttachmentRequest
+	if err := ginutils.ShouldBindAndValidateForm(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	if req.File.Size > ctrl.maxFileSize {
+		response.ApplicationError(c, httpError.RequestTooLargeError(ctrl.maxFileSize, req.File.Size))
+		return
+	}
+
+	file, err := req.File.Open()
+	if err != nil {
+		response.BadRequest(c, httpError.RequestBodyDataError(err))
+		return
+	}
+	defer file.Close()
+
+	cmd, err := req.ToCommand(user.EmployeeID, file)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.attachmentService.UploadAttachment(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Created(c, result.ID(), "Medical Attachment")
+}
+
+// GetAttachment godoc
+// @Summary Get a medical attachment
+// @Description Returns the metadata of the attachment, the file is downloaded through its download URL
+// @Tags employee-medical-attachments
+// @Produce json
+// @Param id path int true "Attachment ID"
+// @Success 200 {object} response.APIResponse{data=dto.AttachmentResponse}
+// @Failure 400 {object} response.APIResponse "Invalid attachment ID"
+// @Failure 404 {object} response.APIResponse "Attachment not found"
+// @Router /employees/medical-attachments/{id} [get]
+// @Security BearerAuth
+func (ctrl *EmployeeAttachmentController) GetAttachment(c *gin.Context) {
+	attachmentID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	qry, err := query.NewFindAttachmentByIDQuery(attachmentID, nil)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result, err := ctrl.attachmentService.FindAttachmentByID(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromAttachmentResult(result), "Medical Attachment")
+}
+
+// GetAttachmentDownloadURL godoc
+// @Summary Get the download URL of a medical attachment
+// @Description Returns a URL downloading the file until it expires, no authentication is needed to follow it
+// @Tags employee-medical-attachments
+// @Produce json
+// @Param id path int true "Attachment ID"
+// @Success 200 {object} response.APIResponse{data=dto.AttachmentDownloadResponse}
+// @Failure 400 {object} response.APIResponse "Invalid attachment ID"
+// @Failure 404 {object} response.APIResponse "Attachment not found"
+// @Router /employees/medical-attachments/{id}/download-url [get]
+// @Security BearerAuth
+func (ctrl *EmployeeAttachmentController) GetAttachmentDownloadURL(c *gin.Context) {
+	attachmentID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	getAttachmentDownloadURL(c, ctrl.attachmentService, attachmentID, nil, ctrl.downloadBaseURL)
+}
+
+// GetPetAttachments godoc
+// @Summary List the medical attachments of a pet
+// @Description Returns the attachments of the medical record of the pet, the latest first
+// @Tags employee-medical-attachments
+// @Produce json
+// @Param id path int true "Pet ID"
+// @Param category query string false "Category filter: xray, photo, document or other"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.APIResponse{data=[]dto.AttachmentResponse}
+// @Failure 400 {object} response.APIResponse "Invalid query parameters"
+// @Failure 404 {object} response.APIResponse "Pet not found"
+// @Router /employees/medical-attachments/pets/{id} [get]
+// @Security BearerAuth
+func (ctrl *EmployeeAttachmentController) GetPetAttachments(c *gin.Context) {
+	petID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	getPetAttachments(c, ctrl.attachmentService, ctrl.validator, petID, nil)
+}
+
+// GetSessionAttachments godoc
+// @Summary List the medical attachments of a medical session
+// @Description Returns the attachments of the medical session, the first uploaded first
+// @Tags employee-medical-attachments
+// @Produce json
+// @Param id path int true "Medical session ID"
+// @Success 200 {object} response.APIResponse{data=[]dto.AttachmentResponse}
+// @Failure 400 {object} response.APIResponse "Invalid medical session ID"
+// @Router /employees/medical-attachments/sessions/{id} [get]
+// @Security BearerAuth
+func (ctrl *EmployeeAttachmentController) GetSessionAttachments(c *gin.Context) {
+	sessionID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	qry, err := query.NewFindAttachmentsBySessionQuery(sessionID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	results, err := ctrl.attachmentService.FindAttachmentsBySession(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromAttachmentResults(results), "Medical Attachments")
+}
+
+// DeleteAttachment godoc
+// @Summary Delete a medical attachment
+// @Description Removes the attachment from the medical record together with its file, download URLs issued before stop working
+// @Tags employee-medical-attachments
+// @Produce json
+// @Param id path int true "Attachment ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.APIResponse "Invalid attachment ID"
+// @Failure 404 {object} response.APIResponse "Attachment not found"
+// @Router /employees/medical-attachments/{id} [delete]
+// @Security BearerAuth
+func (ctrl *EmployeeAttachmentController) DeleteAttachment(c *gin.Context) {
+	attachmentID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	cmd, err := command.NewDeleteAttachmentCommand(attachmentID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.attachmentService.DeleteAttachment(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.NoContent(c)
+}
+
+func getPetAttachments(
+	c *gin.Context,
+	attachmentService application.AttachmentFacadeService,
+	validator *validator.Validate,
+	petID uint,
+	customerID *uint,
+) {
+	var pagination page.PaginationRequest
+	if err := ginutils.ShouldBindPageParams(&pagination, c, validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	var req dto.FindPetAttachmentsRequest
+	if err := ginutils.ShouldBindAndValidateQuery(c, &req, validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	qry, err := req.ToQuery(petID, customerID, pagination)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	attachmentPage, err := attachmentService.FindAttachmentsByPet(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.SuccessWithPagination(c, dto.FromAttachmentResults(attachmentPage.Items), "Medical attachments retrieved successfully", attachmentPage.Metadata)
+}
+
+func getAttachmentDownloadURL(
+	c *gin.Context,
+	attachmentService application.AttachmentFacadeService,
+	attachmentID uint,
+	customerID *uint,
+	downloadBaseURL string,
+) {
+	qry, err := query.NewFindAttachmentDownloadQuery(attachmentID, customerID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result, err := attachmentService.FindAttachmentDownload(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromAttachmentDownloadResult(result, downloadBaseURL), "Download URL")
+}
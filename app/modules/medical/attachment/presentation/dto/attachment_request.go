@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"io"
+	"mime/multipart"
+
+	"clinic-vet-api/app/modules/medical/attachment/application/command"
+	"clinic-vet-api/app/modules/medical/attachment/application/query"
+	"clinic-vet-api/app/shared/page"
+)
+
+// UploadAttachmentRequest represents a file attached to the medical record of a pet, sent as a
+// multipart form. The content type is detected from the file itself
+type UploadAttachmentRequest struct {
+	PetID            uint                  `form:"pet_id" validate:"required,gt=0" example:"7"`
+	MedicalSessionID *uint                 `form:"medical_session_id" validate:"omitempty,gt=0" example:"42"`
+	Category         string                `form:"category" validate:"omitempty,max=20" example:"xray"`
+	Description      *string               `form:"description" validate:"omitempty,max=500" example:"Lateral thoracic radiograph"`
+	File             *multipart.FileHeader `form:"file" validate:"required" swaggerignore:"true"`
+}
+
+func (r *UploadAttachmentRequest) ToCommand(uploadedBy uint, content io.ReadSeeker) (command.UploadAttachmentCommand, error) {
+	return command.NewUploadAttachmentCommand(
+		r.PetID,
+		r.MedicalSessionID,
+		uploadedBy,
+		r.Category,
+		r.File.Filename,
+		r.Description,
+		content,
+		r.File.Size,
+	)
+}
+
+// FindPetAttachmentsRequest filters the attachments of a pet by category
+type FindPetAttachmentsRequest struct {
+	Category string `form:"category" validate:"omitempty,max=20" example:"photo"`
+}
+
+func (r *FindPetAttachmentsRequest) ToQuery(petID uint, customerID *uint, pagination page.PaginationRequest) (query.FindAttachmentsByPetQuery, error) {
+	return query.NewFindAttachmentsByPetQuery(petID, customerID, r.Category, pagination)
+}
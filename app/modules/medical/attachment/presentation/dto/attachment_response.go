@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/medical/attachment/application/handler"
+)
+
+// AttachmentResponse represents the metadata of a file of the medical record, the file itself is
+// downloaded through a download URL
+type AttachmentResponse struct {
+	ID               uint      `json:"id"`
+	PetID            uint      `json:"pet_id"`
+	MedicalSessionID *uint     `json:"medical_session_id,omitempty"`
+	UploadedBy       uint      `json:"uploaded_by"`
+	Category         string    `json:"category"`
+	FileName         string    `json:"file_name"`
+	ContentType      string    `json:"content_type"`
+	SizeBytes        int64     `json:"size_bytes"`
+	ChecksumSHA256   string    `json:"checksum_sha256"`
+	Description      *string   `json:"description,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// AttachmentDownloadResponse represents a URL downloading the file until it expires
+type AttachmentDownloadResponse struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func FromAttachmentResult(result handler.AttachmentResult) AttachmentResponse {
+	return AttachmentResponse{
+		ID:               result.ID,
+		PetID:            result.PetID,
+		MedicalSessionID: result.SessionID,
+		UploadedBy:       result.UploadedBy,
+		Category:         result.Category,
+		FileName:         result.FileName,
+		ContentType:      result.ContentType,
+		SizeBytes:        result.SizeBytes,
+		ChecksumSHA256:   result.Checksum,
+		Description:      result.Description,
+		CreatedAt:        result.CreatedAt,
+		UpdatedAt:        result.UpdatedAt,
+	}
+}
+
+func FromAttachmentResults(results []handler.AttachmentResult) []AttachmentResponse {
+	responses := make([]AttachmentResponse, len(results))
+	for i, result := range results {
+		responses[i] = FromAttachmentResult(result)
+	}
+	return responses
+}
+
+// FromAttachmentDownloadResult takes the URL of the storage when it serves the file, otherwise
+// the token is appended to the download URL of the API
+func FromAttachmentDownloadResult(result handler.AttachmentDownloadResult, downloadBaseURL string) AttachmentDownloadResponse {
+	url := result.URL
+	if url == "" {
+		url = downloadBaseURL + "/" + result.Token
+	}
+
+	return AttachmentDownloadResponse{URL: url, ExpiresAt: result.ExpiresAt.UTC()}
+}
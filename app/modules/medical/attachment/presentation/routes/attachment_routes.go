@@ -0,0 +1,60 @@
+package routes
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/medical/attachment/presentation/controller"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DownloadPath is where the API serves the files of download URLs, relative to the API router
+const DownloadPath = "/medical-attachments/downloads"
+
+func AttachmentRoutes(
+	router *gin.RouterGroup,
+	employeeController *controller.EmployeeAttachmentController,
+	customerController *controller.CustomerAttachmentController,
+	downloadController *controller.AttachmentDownloadController,
+	authMiddleware *middleware.AuthMiddleware,
+) {
+	employeeGroup := router.Group("/employees/medical-attachments")
+	employeeGroup.Use(authMiddleware.Authenticate())
+	employeeGroup.Use(authMiddleware.RequireAnyRole(
+		enum.UserRoleVeterinarian.String(),
+		enum.UserRoleReceptionist.String(),
+		enum.UserRoleAdmin.String(),
+	))
+	{
+		employeeGroup.POST("", employeeController.UploadAttachment)
+		employeeGroup.GET("/:id", employeeController.GetAttachment)
+		employeeGroup.GET("/:id/download-url", employeeController.GetAttachmentDownloadURL)
+		employeeGroup.GET("/pets/:id", employeeController.GetPetAttachments)
+		employeeGroup.GET("/sessions/:id", employeeController.GetSessionAttachments)
+	}
+
+	// Removing files from the medical record is left to veterinarians and admins
+	deletionGroup := router.Group("/employees/medical-attachments")
+	deletionGroup.Use(authMiddleware.Authenticate())
+	deletionGroup.Use(authMiddleware.RequireAnyRole(
+		enum.UserRoleVeterinarian.String(),
+		enum.UserRoleAdmin.String(),
+	))
+	{
+		deletionGroup.DELETE("/:id", employeeController.DeleteAttachment)
+	}
+
+	customerGroup := router.Group("/customers")
+	customerGroup.Use(authMiddleware.Authenticate())
+	customerGroup.Use(authMiddleware.RequireAnyRole(enum.UserRoleCustomer.String()))
+	{
+		customerGroup.GET("/pets/:id/medical-attachments", customerController.GetMyPetAttachments)
+		customerGroup.GET("/medical-attachments/:id/download-url", customerController.GetMyAttachmentDownloadURL)
+	}
+
+	// Download URLs are signed, they are opened without authentication
+	downloadGroup := router.Group(DownloadPath)
+	{
+		downloadGroup.GET("/:token", downloadController.DownloadAttachment)
+	}
+}
@@ -5,23 +5,27 @@ import (
 
 	"clinic-vet-api/app/modules/core/domain/valueobject"
 	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
 )
 
 type DewormCommandHandler struct {
 	dewormRepo   repository.DewormRepository
 	employeeRepo repository.EmployeeRepository
 	petRepo      repository.PetRepository
+	stockService *service.StockService
 }
 
 func NewDewormCommandHandler(
 	dewormRepo repository.DewormRepository,
 	employeeRepo repository.EmployeeRepository,
 	petRepo repository.PetRepository,
+	stockService *service.StockService,
 ) *DewormCommandHandler {
 	return &DewormCommandHandler{
 		dewormRepo:   dewormRepo,
 		employeeRepo: employeeRepo,
 		petRepo:      petRepo,
+		stockService: stockService,
 	}
 }
 
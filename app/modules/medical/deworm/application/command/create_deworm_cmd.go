@@ -5,7 +5,9 @@ import (
 	"errors"
 	"time"
 
+	"clinic-vet-api/app/modules/core/domain/entity/inventory"
 	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
 	"clinic-vet-api/app/modules/core/domain/valueobject"
 	"clinic-vet-api/app/shared/cqrs"
 )
@@ -17,6 +19,7 @@ type DewormCreateCommand struct {
 	administeredDate time.Time
 	nextDueDate      *time.Time
 	notes            *string
+	lotID            *valueobject.StockLotID
 }
 
 func NewDewormCreateCommand(
@@ -26,6 +29,7 @@ func NewDewormCreateCommand(
 	administeredDate time.Time,
 	nextDueDate *time.Time,
 	notes *string,
+	lotID *uint,
 ) DewormCreateCommand {
 	return DewormCreateCommand{
 		petID:            valueobject.NewPetID(petID),
@@ -34,6 +38,7 @@ func NewDewormCreateCommand(
 		administeredDate: administeredDate,
 		nextDueDate:      nextDueDate,
 		notes:            notes,
+		lotID:            valueobject.NewOptStockLotID(lotID),
 	}
 }
 
@@ -47,8 +52,23 @@ func (h *DewormCommandHandler) HandleCreate(ctx context.Context, cmd DewormCreat
 		return cqrs.FailureResult("entity validation error", err)
 	}
 
+	now := time.Now()
+	if cmd.lotID != nil {
+		_, err := h.stockService.CheckDispensable(ctx, *cmd.lotID, 1, now, enum.ProductCategoryDewormer, enum.ProductCategoryMedication)
+		if err != nil {
+			return cqrs.FailureResult("dewormer lot cannot be dispensed", err)
+		}
+	}
+
 	entity := cmd.toEntity()
-	dewormCreated, err := h.dewormRepo.Save(ctx, *entity)
+	var dewormCreated medical.PetDeworming
+	var err error
+	if cmd.lotID != nil {
+		dose := inventory.Dispensing{LotID: *cmd.lotID, Quantity: 1, DispensedBy: cmd.administeredBy, At: now}
+		dewormCreated, err = h.dewormRepo.SaveDispensed(ctx, *entity, dose)
+	} else {
+		dewormCreated, err = h.dewormRepo.Save(ctx, *entity)
+	}
 	if err != nil {
 		return cqrs.FailureResult("failed to create deworming record", err)
 	}
@@ -7,10 +7,14 @@ import (
 	"fmt"
 	"time"
 
+	"clinic-vet-api/app/modules/core/domain/entity/inventory"
 	med "clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
 	"clinic-vet-api/app/modules/core/domain/specification"
 	vo "clinic-vet-api/app/modules/core/domain/valueobject"
 	"clinic-vet-api/app/modules/core/repository"
+	inventoryRepo "clinic-vet-api/app/modules/inventory/infrastructure/repository"
+	"clinic-vet-api/app/shared/database"
 	"clinic-vet-api/app/shared/mapper"
 	"clinic-vet-api/app/shared/page"
 	p "clinic-vet-api/app/shared/page"
@@ -18,14 +22,16 @@ import (
 )
 
 type SqlcPetDeworming struct {
-	queries *sqlc.Queries
-	mapper  mapper.SqlcFieldMapper
+	queries    *sqlc.Queries
+	transactor *database.Transactor
+	mapper     mapper.SqlcFieldMapper
 }
 
-func NewSqlcPetDeworming(queries *sqlc.Queries) repository.DewormRepository {
+func NewSqlcPetDeworming(queries *sqlc.Queries, transactor *database.Transactor) repository.DewormRepository {
 	return &SqlcPetDeworming{
-		queries: queries,
-		mapper:  mapper.SqlcFieldMapper{},
+		queries:    queries,
+		transactor: transactor,
+		mapper:     mapper.SqlcFieldMapper{},
 	}
 }
 
@@ -36,6 +42,26 @@ func (r *SqlcPetDeworming) Save(ctx context.Context, deworming med.PetDeworming)
 	return r.update(ctx, deworming)
 }
 
+func (r *SqlcPetDeworming) SaveDispensed(ctx context.Context, deworming med.PetDeworming, dispensing inventory.Dispensing) (med.PetDeworming, error) {
+	var created med.PetDeworming
+	err := r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+		_, err := inventoryRepo.DispenseWithin(ctx, queries, dispensing, func() (inventory.StockReference, error) {
+			result, err := queries.CreateDeworming(ctx, r.domainToCreateParams(deworming))
+			if err != nil {
+				return inventory.StockReference{}, fmt.Errorf("failed to create pet deworming: %w", err)
+			}
+			created = *r.mapRowToDomain(result)
+			return inventory.StockReference{Type: enum.StockReferenceDeworming, ID: uint(result.ID)}, nil
+		})
+		return err
+	})
+	if err != nil {
+		return med.PetDeworming{}, err
+	}
+
+	return created, nil
+}
+
 func (r *SqlcPetDeworming) FindByID(ctx context.Context, id vo.DewormID) (*med.PetDeworming, error) {
 	result, err := r.queries.FindDewormingByID(ctx, id.Int32())
 	if err != nil {
@@ -3,12 +3,14 @@ package api
 import (
 	"clinic-vet-api/app/middleware"
 	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
 	"clinic-vet-api/app/modules/medical/deworm/application"
 	"clinic-vet-api/app/modules/medical/deworm/application/command"
 	"clinic-vet-api/app/modules/medical/deworm/application/query"
 	sqlcRepo "clinic-vet-api/app/modules/medical/deworm/infrastructure/repository"
 	"clinic-vet-api/app/modules/medical/deworm/presentation/controller"
 	"clinic-vet-api/app/modules/medical/deworm/presentation/routes"
+	"clinic-vet-api/app/shared/database"
 	"clinic-vet-api/sqlc"
 	"errors"
 
@@ -26,11 +28,13 @@ type DewormAPIConfig struct {
 	RouterGroup    *gin.RouterGroup
 	Validator      *validator.Validate
 	Queries        *sqlc.Queries
+	Transactor     *database.Transactor
 	AuthMiddleware *middleware.AuthMiddleware
 
 	CustomerRepo repository.CustomerRepository
 	PetRepo      repository.PetRepository
 	EmployeeRepo repository.EmployeeRepository
+	StockService *service.StockService
 }
 
 type DewormAPIComponents struct {
@@ -69,6 +73,9 @@ func (b *DewormAPIModule) validateConfig() error {
 	if b.Config.Queries == nil {
 		return errors.New("queries is nil")
 	}
+	if b.Config.Transactor == nil {
+		return errors.New("transactor is nil")
+	}
 
 	if b.Config.CustomerRepo == nil {
 		return errors.New("customer repository is nil")
@@ -82,6 +89,10 @@ func (b *DewormAPIModule) validateConfig() error {
 		return errors.New("employee repository is nil")
 	}
 
+	if b.Config.StockService == nil {
+		return errors.New("stock service is nil")
+	}
+
 	if b.Config.AuthMiddleware == nil {
 		return errors.New("auth middleware is nil")
 	}
@@ -98,9 +109,9 @@ func (b *DewormAPIModule) Bootstrap() error {
 		return nil
 	}
 
-	repo := sqlcRepo.NewSqlcPetDeworming(b.Config.Queries)
+	repo := sqlcRepo.NewSqlcPetDeworming(b.Config.Queries, b.Config.Transactor)
 
-	cmdHandler := command.NewDewormCommandHandler(repo, b.Config.EmployeeRepo, b.Config.PetRepo)
+	cmdHandler := command.NewDewormCommandHandler(repo, b.Config.EmployeeRepo, b.Config.PetRepo, b.Config.StockService)
 	qryHandler := query.NewDewormQueryHandler(repo, b.Config.EmployeeRepo, b.Config.PetRepo)
 
 	facadeService := application.NewDewormingFacadeService(qryHandler, cmdHandler)
@@ -17,6 +17,7 @@ type CreateDewormRequest struct {
 	NextDueDate      *time.Time `json:"nextDueDate,omitempty" binding:"omitempty,datetime=2006-01-02" example:"2024-04-15" description:"Optional next due date for deworming. If not provided, system will calculate based on pet's age and medication type. Format: YYYY-MM-DD. Must be after administered date."`
 	AdministeredBy   uint       `json:"administeredBy" binding:"required,min=1" example:"3" description:"ID of the employee/veterinarian who administered the treatment. Must be a positive integer representing an existing employee."`
 	Notes            *string    `json:"notes,omitempty" binding:"omitempty,max=1000" example:"Administered with food. No adverse reactions. Pet weight: 8.5kg" description:"Optional observations about the treatment. Maximum 1000 characters."`
+	LotID            *uint      `json:"lotId,omitempty" binding:"omitempty,min=1" example:"12" description:"Optional stock lot the dose was taken from. One unit of a dewormer or medication lot is dispensed, the lot cannot be expired."`
 }
 
 // UpdateDewormRequest represents the payload for updating an existing deworming record
@@ -73,8 +74,8 @@ func (r *CreateDewormRequest) ToCommand() command.DewormCreateCommand {
 		r.AdministeredDate,
 		r.NextDueDate,
 		r.Notes,
+		r.LotID,
 	)
-
 }
 
 func (r *UpdateDewormRequest) ToCommand(dewormID uint) command.DewormUpdateCommand {
@@ -0,0 +1,27 @@
+package command
+
+import (
+	"strings"
+
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type CancelLabOrderCommand struct {
+	id     valueobject.LabOrderID
+	reason string
+}
+
+func NewCancelLabOrderCommand(id uint, reason string) (CancelLabOrderCommand, error) {
+	if id == 0 {
+		return CancelLabOrderCommand{}, cancelCmdErr("id", "is required")
+	}
+
+	if strings.TrimSpace(reason) == "" {
+		return CancelLabOrderCommand{}, cancelCmdErr("reason", "is required")
+	}
+
+	return CancelLabOrderCommand{id: valueobject.NewLabOrderID(id), reason: reason}, nil
+}
+
+func (c CancelLabOrderCommand) ID() valueobject.LabOrderID { return c.id }
+func (c CancelLabOrderCommand) Reason() string             { return c.reason }
@@ -0,0 +1,21 @@
+package command
+
+import (
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+func orderCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "OrderLabWorkCommand")
+}
+
+func recordCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "RecordLabResultsCommand")
+}
+
+func cancelCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "CancelLabOrderCommand")
+}
+
+func ingestCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "IngestLabResultsCommand")
+}
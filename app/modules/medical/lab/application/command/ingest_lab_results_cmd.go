@@ -0,0 +1,75 @@
+package command
+
+import (
+	"strings"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// IngestLabResultsCommand records results sent by an analyzer or a laboratory interface. They
+// go to the open order under the accession number or, without one, to the open order of the pet
+// with the panels reported
+type IngestLabResultsCommand struct {
+	accessionNumber *string
+	petID           *valueobject.PetID
+	panelCodes      []string
+	entries         []medical.LabResultEntry
+	final           bool
+}
+
+func NewIngestLabResultsCommand(
+	accessionNumber *string,
+	petID *uint,
+	panelCodes []string,
+	inputs []LabResultInput,
+	final bool,
+) (IngestLabResultsCommand, error) {
+	if accessionNumber != nil {
+		trimmed := strings.TrimSpace(*accessionNumber)
+		accessionNumber = &trimmed
+		if trimmed == "" {
+			accessionNumber = nil
+		}
+	}
+
+	if accessionNumber == nil && (petID == nil || *petID == 0) {
+		return IngestLabResultsCommand{}, ingestCmdErr("accessionNumber", "or the pet ID is required to find the lab order")
+	}
+
+	if len(inputs) == 0 {
+		return IngestLabResultsCommand{}, ingestCmdErr("results", "at least one result is required")
+	}
+
+	entries, err := toResultEntries(inputs, ingestCmdErr)
+	if err != nil {
+		return IngestLabResultsCommand{}, err
+	}
+
+	codes := make([]string, 0, len(panelCodes))
+	for _, code := range panelCodes {
+		if code = strings.ToUpper(strings.TrimSpace(code)); code != "" {
+			codes = append(codes, code)
+		}
+	}
+
+	var pet *valueobject.PetID
+	if petID != nil && *petID != 0 {
+		id := valueobject.NewPetID(*petID)
+		pet = &id
+	}
+
+	return IngestLabResultsCommand{
+		accessionNumber: accessionNumber,
+		petID:           pet,
+		panelCodes:      codes,
+		entries:         entries,
+		final:           final,
+	}, nil
+}
+
+func (c IngestLabResultsCommand) AccessionNumber() *string          { return c.accessionNumber }
+func (c IngestLabResultsCommand) PetID() *valueobject.PetID         { return c.petID }
+func (c IngestLabResultsCommand) PanelCodes() []string              { return c.panelCodes }
+func (c IngestLabResultsCommand) Entries() []medical.LabResultEntry { return c.entries }
+func (c IngestLabResultsCommand) IsFinal() bool                     { return c.final }
@@ -0,0 +1,68 @@
+package command
+
+import (
+	"strings"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// OrderLabWorkCommand sends panels to a laboratory. Panels of the catalog are ordered by code, any
+// other panel needs its name
+type OrderLabWorkCommand struct {
+	sessionID       valueobject.MedSessionID
+	orderedBy       valueobject.EmployeeID
+	labName         string
+	accessionNumber *string
+	panels          []medical.LabOrderPanel
+	notes           *string
+}
+
+func NewOrderLabWorkCommand(
+	sessionID uint,
+	orderedBy uint,
+	labName string,
+	accessionNumber *string,
+	panels []medical.LabOrderPanel,
+	notes *string,
+) (OrderLabWorkCommand, error) {
+	if sessionID == 0 {
+		return OrderLabWorkCommand{}, orderCmdErr("sessionID", "is required")
+	}
+
+	if orderedBy == 0 {
+		return OrderLabWorkCommand{}, orderCmdErr("orderedBy", "is required")
+	}
+
+	if strings.TrimSpace(labName) == "" {
+		return OrderLabWorkCommand{}, orderCmdErr("labName", "is required")
+	}
+
+	if len(panels) == 0 {
+		return OrderLabWorkCommand{}, orderCmdErr("panels", "at least one panel is required")
+	}
+
+	normalized := make([]medical.LabOrderPanel, len(panels))
+	for i, panel := range panels {
+		normalized[i] = medical.LabOrderPanel{
+			Code: strings.ToUpper(strings.TrimSpace(panel.Code)),
+			Name: strings.TrimSpace(panel.Name),
+		}
+	}
+
+	return OrderLabWorkCommand{
+		sessionID:       valueobject.NewMedSessionID(sessionID),
+		orderedBy:       valueobject.NewEmployeeID(orderedBy),
+		labName:         labName,
+		accessionNumber: accessionNumber,
+		panels:          normalized,
+		notes:           notes,
+	}, nil
+}
+
+func (c OrderLabWorkCommand) SessionID() valueobject.MedSessionID { return c.sessionID }
+func (c OrderLabWorkCommand) OrderedBy() valueobject.EmployeeID   { return c.orderedBy }
+func (c OrderLabWorkCommand) LabName() string                     { return c.labName }
+func (c OrderLabWorkCommand) AccessionNumber() *string            { return c.accessionNumber }
+func (c OrderLabWorkCommand) Panels() []medical.LabOrderPanel     { return c.panels }
+func (c OrderLabWorkCommand) Notes() *string                      { return c.notes }
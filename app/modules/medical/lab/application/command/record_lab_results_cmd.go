@@ -0,0 +1,85 @@
+package command
+
+import (
+	"fmt"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+// LabResultInput is a result as typed in or received from the laboratory. Name, unit and
+// reference range are taken from the catalog when left out
+type LabResultInput struct {
+	PanelCode     string
+	AnalyteCode   string
+	AnalyteName   string
+	NumericValue  *float64
+	TextValue     *string
+	Unit          string
+	ReferenceLow  *float64
+	ReferenceHigh *float64
+	Flag          string
+	ObservedAt    *time.Time
+}
+
+type RecordLabResultsCommand struct {
+	orderID valueobject.LabOrderID
+	entries []medical.LabResultEntry
+	final   bool
+}
+
+func NewRecordLabResultsCommand(orderID uint, inputs []LabResultInput, final bool) (RecordLabResultsCommand, error) {
+	if orderID == 0 {
+		return RecordLabResultsCommand{}, recordCmdErr("id", "is required")
+	}
+
+	if len(inputs) == 0 && !final {
+		return RecordLabResultsCommand{}, recordCmdErr("results", "at least one result is required")
+	}
+
+	entries, err := toResultEntries(inputs, recordCmdErr)
+	if err != nil {
+		return RecordLabResultsCommand{}, err
+	}
+
+	return RecordLabResultsCommand{
+		orderID: valueobject.NewLabOrderID(orderID),
+		entries: entries,
+		final:   final,
+	}, nil
+}
+
+func (c RecordLabResultsCommand) OrderID() valueobject.LabOrderID   { return c.orderID }
+func (c RecordLabResultsCommand) Entries() []medical.LabResultEntry { return c.entries }
+func (c RecordLabResultsCommand) IsFinal() bool                     { return c.final }
+
+func toResultEntries(inputs []LabResultInput, cmdErr func(field, issue string) error) ([]medical.LabResultEntry, error) {
+	entries := make([]medical.LabResultEntry, len(inputs))
+	for i, input := range inputs {
+		entry := medical.LabResultEntry{
+			PanelCode:      input.PanelCode,
+			AnalyteCode:    input.AnalyteCode,
+			AnalyteName:    input.AnalyteName,
+			NumericValue:   input.NumericValue,
+			TextValue:      input.TextValue,
+			Unit:           input.Unit,
+			ReferenceRange: valueobject.NewLabReferenceRange(input.ReferenceLow, input.ReferenceHigh),
+		}
+
+		if input.Flag != "" {
+			flag, err := enum.ParseLabResultFlag(input.Flag)
+			if err != nil {
+				return nil, cmdErr(fmt.Sprintf("results[%d].flag", i), err.Error())
+			}
+			entry.Flag = &flag
+		}
+
+		if input.ObservedAt != nil {
+			entry.ObservedAt = *input.ObservedAt
+		}
+		entries[i] = entry
+	}
+	return entries, nil
+}
@@ -0,0 +1,72 @@
+package application
+
+import (
+	"context"
+
+	c "clinic-vet-api/app/modules/medical/lab/application/command"
+	h "clinic-vet-api/app/modules/medical/lab/application/handler"
+	q "clinic-vet-api/app/modules/medical/lab/application/query"
+	"clinic-vet-api/app/shared/cqrs"
+	"clinic-vet-api/app/shared/page"
+)
+
+type LabFacadeService interface {
+	FindLabOrderByID(ctx context.Context, qry q.FindLabOrderByIDQuery) (h.LabOrderResult, error)
+	FindLabOrdersBySession(ctx context.Context, qry q.FindLabOrdersBySessionQuery) ([]h.LabOrderResult, error)
+	FindLabOrdersByPet(ctx context.Context, qry q.FindLabOrdersByPetQuery) (page.Page[h.LabOrderResult], error)
+	FindLabTrend(ctx context.Context, qry q.FindLabTrendQuery) (h.LabTrendResult, error)
+	FindLabPanels(ctx context.Context, qry q.FindLabPanelsQuery) ([]h.LabPanelResult, error)
+
+	OrderLabWork(ctx context.Context, cmd c.OrderLabWorkCommand) cqrs.CommandResult
+	RecordLabResults(ctx context.Context, cmd c.RecordLabResultsCommand) cqrs.CommandResult
+	CancelLabOrder(ctx context.Context, cmd c.CancelLabOrderCommand) cqrs.CommandResult
+	IngestLabResults(ctx context.Context, cmd c.IngestLabResultsCommand) cqrs.CommandResult
+}
+
+type labFacadeService struct {
+	qryHandler *h.LabOrderQueryHandler
+	cmdHandler *h.LabOrderCommandHandler
+}
+
+func NewLabFacadeService(qryHandler *h.LabOrderQueryHandler, cmdHandler *h.LabOrderCommandHandler) LabFacadeService {
+	return &labFacadeService{
+		qryHandler: qryHandler,
+		cmdHandler: cmdHandler,
+	}
+}
+
+func (s *labFacadeService) FindLabOrderByID(ctx context.Context, qry q.FindLabOrderByIDQuery) (h.LabOrderResult, error) {
+	return s.qryHandler.HandleFindByID(ctx, qry)
+}
+
+func (s *labFacadeService) FindLabOrdersBySession(ctx context.Context, qry q.FindLabOrdersBySessionQuery) ([]h.LabOrderResult, error) {
+	return s.qryHandler.HandleFindBySession(ctx, qry)
+}
+
+func (s *labFacadeService) FindLabOrdersByPet(ctx context.Context, qry q.FindLabOrdersByPetQuery) (page.Page[h.LabOrderResult], error) {
+	return s.qryHandler.HandleFindByPet(ctx, qry)
+}
+
+func (s *labFacadeService) FindLabTrend(ctx context.Context, qry q.FindLabTrendQuery) (h.LabTrendResult, error) {
+	return s.qryHandler.HandleFindTrend(ctx, qry)
+}
+
+func (s *labFacadeService) FindLabPanels(ctx context.Context, qry q.FindLabPanelsQuery) ([]h.LabPanelResult, error) {
+	return s.qryHandler.HandleFindPanels(ctx, qry)
+}
+
+func (s *labFacadeService) OrderLabWork(ctx context.Context, cmd c.OrderLabWorkCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleOrder(ctx, cmd)
+}
+
+func (s *labFacadeService) RecordLabResults(ctx context.Context, cmd c.RecordLabResultsCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleRecordResults(ctx, cmd)
+}
+
+func (s *labFacadeService) CancelLabOrder(ctx context.Context, cmd c.CancelLabOrderCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleCancel(ctx, cmd)
+}
+
+func (s *labFacadeService) IngestLabResults(ctx context.Context, cmd c.IngestLabResultsCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleIngest(ctx, cmd)
+}
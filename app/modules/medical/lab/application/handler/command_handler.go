@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
+	"clinic-vet-api/app/modules/medical/lab/application/command"
+	"clinic-vet-api/app/shared/cqrs"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+var (
+	FailFindSessionMsg         = "failed to find medical session"
+	FailFindLabOrderMsg        = "failed to find lab order"
+	FailFindPetMsg             = "failed to find pet"
+	FailUnknownPanelMsg        = "lab panel is not in the catalog"
+	FailMatchLabOrderMsg       = "failed to match the results to an open lab order"
+	FailValidateLabOrderMsg    = "lab order validation failed"
+	FailSaveLabOrderMsg        = "failed to save lab order"
+	SuccessLabOrderCreatedMsg  = "lab order created successfully"
+	SuccessLabResultsRecordMsg = "lab results recorded successfully"
+	SuccessLabOrderCancelMsg   = "lab order cancelled successfully"
+	SuccessLabResultsIngestMsg = "lab results received successfully"
+)
+
+type LabOrderCommandHandler struct {
+	labOrderRepo repository.LabOrderRepository
+	sessionRepo  repository.MedicalSessionRepository
+	petRepo      repository.PetRepository
+	catalog      *service.LabPanelCatalog
+}
+
+func NewLabOrderCommandHandler(
+	labOrderRepo repository.LabOrderRepository,
+	sessionRepo repository.MedicalSessionRepository,
+	petRepo repository.PetRepository,
+	catalog *service.LabPanelCatalog,
+) *LabOrderCommandHandler {
+	return &LabOrderCommandHandler{
+		labOrderRepo: labOrderRepo,
+		sessionRepo:  sessionRepo,
+		petRepo:      petRepo,
+		catalog:      catalog,
+	}
+}
+
+// HandleOrder sends the panels to the laboratory for the pet seen in the session, panels of the
+// catalog take their name from it
+func (h *LabOrderCommandHandler) HandleOrder(ctx context.Context, cmd command.OrderLabWorkCommand) cqrs.CommandResult {
+	session, err := h.sessionRepo.FindByID(ctx, cmd.SessionID())
+	if err != nil {
+		return cqrs.FailureResult(FailFindSessionMsg, err)
+	}
+
+	panels, err := h.catalogPanels(cmd.Panels())
+	if err != nil {
+		return cqrs.FailureResult(FailUnknownPanelMsg, err)
+	}
+
+	order, err := medical.OrderLabWork(ctx, *session, cmd.OrderedBy(), cmd.LabName(), cmd.AccessionNumber(), panels, cmd.Notes(), time.Now())
+	if err != nil {
+		return cqrs.FailureResult(FailValidateLabOrderMsg, err)
+	}
+
+	if err := h.labOrderRepo.Save(ctx, order); err != nil {
+		return cqrs.FailureResult(FailSaveLabOrderMsg, err)
+	}
+
+	return cqrs.SuccessCreateResult(order.ID().String(), SuccessLabOrderCreatedMsg)
+}
+
+// HandleRecordResults records the results on the order. Analytes of the catalog get their name,
+// unit and the reference range of the species of the pet when the laboratory left them out
+func (h *LabOrderCommandHandler) HandleRecordResults(ctx context.Context, cmd command.RecordLabResultsCommand) cqrs.CommandResult {
+	order, err := h.labOrderRepo.FindByID(ctx, cmd.OrderID())
+	if err != nil {
+		return cqrs.FailureResult(FailFindLabOrderMsg, err)
+	}
+
+	pet, err := h.petRepo.FindByID(ctx, order.PetID())
+	if err != nil {
+		return cqrs.FailureResult(FailFindPetMsg, err)
+	}
+
+	entries := h.completeEntries(cmd.Entries(), pet.Species())
+	if err := order.RecordResults(ctx, entries, cmd.IsFinal(), time.Now()); err != nil {
+		return cqrs.FailureResult(FailValidateLabOrderMsg, err)
+	}
+
+	if err := h.labOrderRepo.Save(ctx, &order); err != nil {
+		return cqrs.FailureResult(FailSaveLabOrderMsg, err)
+	}
+
+	return cqrs.SuccessResult(SuccessLabResultsRecordMsg)
+}
+
+// HandleIngest records the results sent by an analyzer on the open order they belong to. It
+// fails when no open order matches or when several do and the results cannot tell them apart
+func (h *LabOrderCommandHandler) HandleIngest(ctx context.Context, cmd command.IngestLabResultsCommand) cqrs.CommandResult {
+	order, err := h.matchOpenOrder(ctx, cmd)
+	if err != nil {
+		return cqrs.FailureResult(FailMatchLabOrderMsg, err)
+	}
+
+	pet, err := h.petRepo.FindByID(ctx, order.PetID())
+	if err != nil {
+		return cqrs.FailureResult(FailFindPetMsg, err)
+	}
+
+	entries := h.completeEntries(cmd.Entries(), pet.Species())
+	if err := order.RecordResults(ctx, entries, cmd.IsFinal(), time.Now()); err != nil {
+		return cqrs.FailureResult(FailValidateLabOrderMsg, err)
+	}
+
+	if err := h.labOrderRepo.Save(ctx, &order); err != nil {
+		return cqrs.FailureResult(FailSaveLabOrderMsg, err)
+	}
+
+	return cqrs.SuccessCreateResult(order.ID().String(), SuccessLabResultsIngestMsg)
+}
+
+func (h *LabOrderCommandHandler) HandleCancel(ctx context.Context, cmd command.CancelLabOrderCommand) cqrs.CommandResult {
+	order, err := h.labOrderRepo.FindByID(ctx, cmd.ID())
+	if err != nil {
+		return cqrs.FailureResult(FailFindLabOrderMsg, err)
+	}
+
+	if err := order.Cancel(ctx, cmd.Reason(), time.Now()); err != nil {
+		return cqrs.FailureResult(FailValidateLabOrderMsg, err)
+	}
+
+	if err := h.labOrderRepo.Save(ctx, &order); err != nil {
+		return cqrs.FailureResult(FailSaveLabOrderMsg, err)
+	}
+
+	return cqrs.SuccessResult(SuccessLabOrderCancelMsg)
+}
+
+// matchOpenOrder looks the order up by accession number, keeping only those of the pet when it
+// is also given as laboratories may reuse numbers, or else among the open orders of the pet.
+// Orders with the reported panels are preferred when there are several
+func (h *LabOrderCommandHandler) matchOpenOrder(ctx context.Context, cmd command.IngestLabResultsCommand) (medical.LabOrder, error) {
+	var orders []medical.LabOrder
+	var identifier, value string
+	var err error
+
+	if cmd.AccessionNumber() != nil {
+		identifier, value = "accession number", *cmd.AccessionNumber()
+		orders, err = h.labOrderRepo.FindOpenByAccession(ctx, *cmd.AccessionNumber())
+		if err == nil && cmd.PetID() != nil {
+			orders = filterOrders(orders, func(order medical.LabOrder) bool { return order.PetID() == *cmd.PetID() })
+		}
+	} else {
+		identifier, value = "pet", cmd.PetID().String()
+		orders, err = h.labOrderRepo.FindOpenByPet(ctx, *cmd.PetID())
+	}
+	if err != nil {
+		return medical.LabOrder{}, err
+	}
+
+	if len(orders) > 1 && len(cmd.PanelCodes()) > 0 {
+		withPanels := filterOrders(orders, func(order medical.LabOrder) bool { return hasAnyPanel(order, cmd.PanelCodes()) })
+		if len(withPanels) > 0 {
+			orders = withPanels
+		}
+	}
+
+	switch len(orders) {
+	case 0:
+		return medical.LabOrder{}, apperror.EntityNotFoundValidationError("open lab order", identifier, value)
+	case 1:
+		return orders[0], nil
+	default:
+		return medical.LabOrder{}, apperror.ConflictError("lab order",
+			fmt.Sprintf("%d open lab orders match the %s %s, the accession number of the order is needed", len(orders), identifier, value))
+	}
+}
+
+func filterOrders(orders []medical.LabOrder, keep func(order medical.LabOrder) bool) []medical.LabOrder {
+	var kept []medical.LabOrder
+	for _, order := range orders {
+		if keep(order) {
+			kept = append(kept, order)
+		}
+	}
+	return kept
+}
+
+func hasAnyPanel(order medical.LabOrder, codes []string) bool {
+	for _, panel := range order.Panels() {
+		for _, code := range codes {
+			if strings.EqualFold(panel.Code, code) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (h *LabOrderCommandHandler) catalogPanels(panels []medical.LabOrderPanel) ([]medical.LabOrderPanel, error) {
+	named := make([]medical.LabOrderPanel, len(panels))
+	for i, panel := range panels {
+		named[i] = panel
+		if panel.Name != "" {
+			continue
+		}
+
+		definition, err := h.catalog.GetPanelByCode(panel.Code)
+		if err != nil {
+			return nil, apperror.FieldValidationError("panels", panel.Code, fmt.Sprintf("panel %s is not in the catalog, its name is required", panel.Code))
+		}
+		named[i].Name = definition.Name()
+	}
+	return named, nil
+}
+
+func (h *LabOrderCommandHandler) completeEntries(entries []medical.LabResultEntry, species enum.PetSpecies) []medical.LabResultEntry {
+	completed := make([]medical.LabResultEntry, len(entries))
+	for i, entry := range entries {
+		completed[i] = entry
+
+		analyte, exists := h.catalog.GetAnalyte(entry.AnalyteCode)
+		if !exists {
+			continue
+		}
+
+		if entry.AnalyteName == "" {
+			completed[i].AnalyteName = analyte.Name()
+		}
+		if entry.Unit == "" {
+			completed[i].Unit = analyte.Unit()
+		}
+		if entry.ReferenceRange.IsEmpty() {
+			if referenceRange, ok := analyte.RangeFor(species); ok {
+				completed[i].ReferenceRange = referenceRange
+			}
+		}
+	}
+	return completed
+}
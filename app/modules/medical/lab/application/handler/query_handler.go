@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"context"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
+	"clinic-vet-api/app/modules/medical/lab/application/query"
+	"clinic-vet-api/app/shared/page"
+)
+
+type LabOrderQueryHandler struct {
+	labOrderRepo repository.LabOrderRepository
+	petRepo      repository.PetRepository
+	catalog      *service.LabPanelCatalog
+}
+
+func NewLabOrderQueryHandler(
+	labOrderRepo repository.LabOrderRepository,
+	petRepo repository.PetRepository,
+	catalog *service.LabPanelCatalog,
+) *LabOrderQueryHandler {
+	return &LabOrderQueryHandler{
+		labOrderRepo: labOrderRepo,
+		petRepo:      petRepo,
+		catalog:      catalog,
+	}
+}
+
+func (h *LabOrderQueryHandler) HandleFindByID(ctx context.Context, qry query.FindLabOrderByIDQuery) (LabOrderResult, error) {
+	order, err := h.labOrderRepo.FindByID(ctx, qry.ID())
+	if err != nil {
+		return LabOrderResult{}, err
+	}
+
+	return toLabOrderResult(order), nil
+}
+
+func (h *LabOrderQueryHandler) HandleFindBySession(ctx context.Context, qry query.FindLabOrdersBySessionQuery) ([]LabOrderResult, error) {
+	orders, err := h.labOrderRepo.FindBySession(ctx, qry.SessionID())
+	if err != nil {
+		return nil, err
+	}
+
+	return toLabOrderResults(orders), nil
+}
+
+func (h *LabOrderQueryHandler) HandleFindByPet(ctx context.Context, qry query.FindLabOrdersByPetQuery) (page.Page[LabOrderResult], error) {
+	if err := h.checkPet(ctx, qry.PetID(), qry.CustomerID()); err != nil {
+		return page.Page[LabOrderResult]{}, err
+	}
+
+	orderPage, err := h.labOrderRepo.FindByPet(ctx, qry.PetID(), qry.Pagination())
+	if err != nil {
+		return page.Page[LabOrderResult]{}, err
+	}
+
+	return page.MapItems(orderPage, toLabOrderResult), nil
+}
+
+// HandleFindTrend lays out the results of the analyte for the pet in the period, oldest first
+func (h *LabOrderQueryHandler) HandleFindTrend(ctx context.Context, qry query.FindLabTrendQuery) (LabTrendResult, error) {
+	if err := h.checkPet(ctx, qry.PetID(), qry.CustomerID()); err != nil {
+		return LabTrendResult{}, err
+	}
+
+	results, err := h.labOrderRepo.FindResultsByAnalyte(ctx, qry.PetID(), qry.AnalyteCode(), qry.StartDate(), qry.EndDate())
+	if err != nil {
+		return LabTrendResult{}, err
+	}
+
+	trend := medical.NewLabAnalyteTrend(qry.PetID(), qry.AnalyteCode(), results)
+	if trend.AnalyteName == "" {
+		if analyte, exists := h.catalog.GetAnalyte(qry.AnalyteCode()); exists {
+			trend.AnalyteName = analyte.Name()
+		}
+	}
+
+	return toLabTrendResult(trend, qry.StartDate(), qry.EndDate()), nil
+}
+
+func (h *LabOrderQueryHandler) HandleFindPanels(ctx context.Context, qry query.FindLabPanelsQuery) ([]LabPanelResult, error) {
+	panels := h.catalog.GetPanels()
+
+	results := make([]LabPanelResult, len(panels))
+	for i, panel := range panels {
+		results[i] = toLabPanelResult(panel, qry.Species())
+	}
+	return results, nil
+}
+
+// checkPet makes sure the pet exists and, when a customer asks, that it is theirs
+func (h *LabOrderQueryHandler) checkPet(ctx context.Context, petID valueobject.PetID, customerID *valueobject.CustomerID) error {
+	if customerID != nil {
+		_, err := h.petRepo.FindByIDAndCustomerID(ctx, petID, *customerID)
+		return err
+	}
+
+	_, err := h.petRepo.FindByID(ctx, petID)
+	return err
+}
@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type LabOrderPanelResult struct {
+	Code string
+	Name string
+}
+
+type LabResultResult struct {
+	ID            uint
+	PanelCode     string
+	AnalyteCode   string
+	AnalyteName   string
+	NumericValue  *float64
+	TextValue     *string
+	Unit          string
+	ReferenceLow  *float64
+	ReferenceHigh *float64
+	Flag          *string
+	IsAbnormal    bool
+	ObservedAt    time.Time
+}
+
+type LabOrderResult struct {
+	ID                 uint
+	SessionID          uint
+	PetID              uint
+	OrderedBy          uint
+	LabName            string
+	AccessionNumber    *string
+	Panels             []LabOrderPanelResult
+	Status             string
+	Notes              *string
+	OrderedAt          time.Time
+	ResultedAt         *time.Time
+	CancelledAt        *time.Time
+	CancelReason       *string
+	Results            []LabResultResult
+	HasAbnormalResults bool
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+func toLabOrderResult(order medical.LabOrder) LabOrderResult {
+	panels := make([]LabOrderPanelResult, len(order.Panels()))
+	for i, panel := range order.Panels() {
+		panels[i] = LabOrderPanelResult{Code: panel.Code, Name: panel.Name}
+	}
+
+	results := make([]LabResultResult, len(order.Results()))
+	for i, result := range order.Results() {
+		results[i] = toLabResultResult(result)
+	}
+
+	return LabOrderResult{
+		ID:                 order.ID().Value(),
+		SessionID:          order.SessionID().Value(),
+		PetID:              order.PetID().Value(),
+		OrderedBy:          order.OrderedBy().Value(),
+		LabName:            order.LabName(),
+		AccessionNumber:    order.AccessionNumber(),
+		Panels:             panels,
+		Status:             order.Status().String(),
+		Notes:              order.Notes(),
+		OrderedAt:          order.OrderedAt(),
+		ResultedAt:         order.ResultedAt(),
+		CancelledAt:        order.CancelledAt(),
+		CancelReason:       order.CancelReason(),
+		Results:            results,
+		HasAbnormalResults: order.HasAbnormalResults(),
+		CreatedAt:          order.CreatedAt(),
+		UpdatedAt:          order.UpdatedAt(),
+	}
+}
+
+func toLabOrderResults(orders []medical.LabOrder) []LabOrderResult {
+	results := make([]LabOrderResult, len(orders))
+	for i, order := range orders {
+		results[i] = toLabOrderResult(order)
+	}
+	return results
+}
+
+func toLabResultResult(result medical.LabResult) LabResultResult {
+	return LabResultResult{
+		ID:            result.ID().Value(),
+		PanelCode:     result.PanelCode(),
+		AnalyteCode:   result.AnalyteCode(),
+		AnalyteName:   result.AnalyteName(),
+		NumericValue:  result.NumericValue(),
+		TextValue:     result.TextValue(),
+		Unit:          result.Unit(),
+		ReferenceLow:  result.ReferenceRange().Low(),
+		ReferenceHigh: result.ReferenceRange().High(),
+		Flag:          labFlagToStringPtr(result.Flag()),
+		IsAbnormal:    result.IsAbnormal(),
+		ObservedAt:    result.ObservedAt(),
+	}
+}
+
+type LabTrendPointResult struct {
+	ResultID      uint
+	OrderID       uint
+	ObservedAt    time.Time
+	NumericValue  *float64
+	TextValue     *string
+	Unit          string
+	ReferenceLow  *float64
+	ReferenceHigh *float64
+	Flag          *string
+	ChangePercent *float64
+}
+
+type LabTrendResult struct {
+	PetID         uint
+	AnalyteCode   string
+	AnalyteName   string
+	StartDate     time.Time
+	EndDate       time.Time
+	Points        []LabTrendPointResult
+	Min           *float64
+	Max           *float64
+	Average       *float64
+	ChangePercent *float64
+	AbnormalCount int
+}
+
+func toLabTrendResult(trend medical.LabAnalyteTrend, startDate, endDate time.Time) LabTrendResult {
+	points := make([]LabTrendPointResult, len(trend.Points))
+	for i, point := range trend.Points {
+		points[i] = LabTrendPointResult{
+			ResultID:      point.ResultID.Value(),
+			OrderID:       point.OrderID.Value(),
+			ObservedAt:    point.ObservedAt,
+			NumericValue:  point.NumericValue,
+			TextValue:     point.TextValue,
+			Unit:          point.Unit,
+			ReferenceLow:  point.ReferenceRange.Low(),
+			ReferenceHigh: point.ReferenceRange.High(),
+			Flag:          labFlagToStringPtr(point.Flag),
+			ChangePercent: point.ChangePercent,
+		}
+	}
+
+	return LabTrendResult{
+		PetID:         trend.PetID.Value(),
+		AnalyteCode:   trend.AnalyteCode,
+		AnalyteName:   trend.AnalyteName,
+		StartDate:     startDate,
+		EndDate:       endDate,
+		Points:        points,
+		Min:           trend.Min,
+		Max:           trend.Max,
+		Average:       trend.Average,
+		ChangePercent: trend.ChangePercent,
+		AbnormalCount: trend.AbnormalCount,
+	}
+}
+
+type LabReferenceRangeResult struct {
+	Species string
+	Low     *float64
+	High    *float64
+}
+
+type LabAnalyteResult struct {
+	Code   string
+	Name   string
+	Unit   string
+	Ranges []LabReferenceRangeResult
+}
+
+type LabPanelResult struct {
+	Code        string
+	Name        string
+	Specimen    string
+	Description string
+	Analytes    []LabAnalyteResult
+}
+
+// toLabPanelResult lays out the panel with the ranges of every species, or only those of the
+// species when one is given
+func toLabPanelResult(panel valueobject.LabPanelDefinition, species *enum.PetSpecies) LabPanelResult {
+	analytes := make([]LabAnalyteResult, len(panel.Analytes()))
+	for i, analyte := range panel.Analytes() {
+		ranges := []LabReferenceRangeResult{}
+		for _, s := range enum.ValidPetSpeciess {
+			if species != nil && s != *species {
+				continue
+			}
+			if referenceRange, ok := analyte.RangeFor(s); ok {
+				ranges = append(ranges, LabReferenceRangeResult{
+					Species: s.String(),
+					Low:     referenceRange.Low(),
+					High:    referenceRange.High(),
+				})
+			}
+		}
+
+		analytes[i] = LabAnalyteResult{
+			Code:   analyte.Code(),
+			Name:   analyte.Name(),
+			Unit:   analyte.Unit(),
+			Ranges: ranges,
+		}
+	}
+
+	return LabPanelResult{
+		Code:        panel.Code(),
+		Name:        panel.Name(),
+		Specimen:    panel.Specimen(),
+		Description: panel.Description(),
+		Analytes:    analytes,
+	}
+}
+
+func labFlagToStringPtr(flag *enum.LabResultFlag) *string {
+	if flag == nil {
+		return nil
+	}
+	value := flag.String()
+	return &value
+}
@@ -0,0 +1,20 @@
+package query
+
+import (
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+type FindLabOrderByIDQuery struct {
+	id valueobject.LabOrderID
+}
+
+func NewFindLabOrderByIDQuery(id uint) (FindLabOrderByIDQuery, error) {
+	if id == 0 {
+		return FindLabOrderByIDQuery{}, apperror.FieldValidationError("id", "", "lab order ID is required")
+	}
+
+	return FindLabOrderByIDQuery{id: valueobject.NewLabOrderID(id)}, nil
+}
+
+func (q FindLabOrderByIDQuery) ID() valueobject.LabOrderID { return q.id }
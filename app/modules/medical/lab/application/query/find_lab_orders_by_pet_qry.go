@@ -0,0 +1,31 @@
+package query
+
+import (
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+	"clinic-vet-api/app/shared/page"
+)
+
+// FindLabOrdersByPetQuery lists the lab orders of a pet with their results. When a customer asks,
+// the pet has to be theirs
+type FindLabOrdersByPetQuery struct {
+	petID      valueobject.PetID
+	customerID *valueobject.CustomerID
+	pagination page.PaginationRequest
+}
+
+func NewFindLabOrdersByPetQuery(petID uint, customerID *uint, pagination page.PaginationRequest) (FindLabOrdersByPetQuery, error) {
+	if petID == 0 {
+		return FindLabOrdersByPetQuery{}, apperror.FieldValidationError("id", "", "pet ID is required")
+	}
+
+	return FindLabOrdersByPetQuery{
+		petID:      valueobject.NewPetID(petID),
+		customerID: valueobject.NewOptCustomerID(customerID),
+		pagination: pagination,
+	}, nil
+}
+
+func (q FindLabOrdersByPetQuery) PetID() valueobject.PetID            { return q.petID }
+func (q FindLabOrdersByPetQuery) CustomerID() *valueobject.CustomerID { return q.customerID }
+func (q FindLabOrdersByPetQuery) Pagination() page.PaginationRequest  { return q.pagination }
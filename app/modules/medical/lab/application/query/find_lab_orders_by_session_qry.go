@@ -0,0 +1,20 @@
+package query
+
+import (
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+type FindLabOrdersBySessionQuery struct {
+	sessionID valueobject.MedSessionID
+}
+
+func NewFindLabOrdersBySessionQuery(sessionID uint) (FindLabOrdersBySessionQuery, error) {
+	if sessionID == 0 {
+		return FindLabOrdersBySessionQuery{}, apperror.FieldValidationError("id", "", "medical session ID is required")
+	}
+
+	return FindLabOrdersBySessionQuery{sessionID: valueobject.NewMedSessionID(sessionID)}, nil
+}
+
+func (q FindLabOrdersBySessionQuery) SessionID() valueobject.MedSessionID { return q.sessionID }
@@ -0,0 +1,26 @@
+package query
+
+import (
+	"clinic-vet-api/app/modules/core/domain/enum"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+// FindLabPanelsQuery lists the panels of the catalog, with the reference ranges of the species only
+// when one is given
+type FindLabPanelsQuery struct {
+	species *enum.PetSpecies
+}
+
+func NewFindLabPanelsQuery(species string) (FindLabPanelsQuery, error) {
+	if species == "" {
+		return FindLabPanelsQuery{}, nil
+	}
+
+	parsedSpecies, err := enum.ParsePetSpecies(species)
+	if err != nil {
+		return FindLabPanelsQuery{}, apperror.FieldValidationError("species", species, err.Error())
+	}
+	return FindLabPanelsQuery{species: &parsedSpecies}, nil
+}
+
+func (q FindLabPanelsQuery) Species() *enum.PetSpecies { return q.species }
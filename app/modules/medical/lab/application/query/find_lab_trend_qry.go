@@ -0,0 +1,65 @@
+package query
+
+import (
+	"strings"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+const DefaultLabTrendYears = 3
+
+// FindLabTrendQuery represents a query for the results of an analyte of a pet in the period, the
+// last three years unless given. When a customer asks, the pet has to be theirs
+type FindLabTrendQuery struct {
+	petID       valueobject.PetID
+	customerID  *valueobject.CustomerID
+	analyteCode string
+	startDate   time.Time
+	endDate     time.Time
+}
+
+func NewFindLabTrendQuery(
+	petID uint,
+	customerID *uint,
+	analyteCode string,
+	startDate, endDate *time.Time,
+) (FindLabTrendQuery, error) {
+	if petID == 0 {
+		return FindLabTrendQuery{}, apperror.FieldValidationError("id", "", "pet ID is required")
+	}
+
+	analyteCode = strings.ToUpper(strings.TrimSpace(analyteCode))
+	if analyteCode == "" || len(analyteCode) > 30 {
+		return FindLabTrendQuery{}, apperror.FieldValidationError("analyte", analyteCode, "analyte code is required and cannot exceed 30 characters")
+	}
+
+	end := time.Now()
+	if endDate != nil {
+		end = *endDate
+	}
+
+	start := end.AddDate(-DefaultLabTrendYears, 0, 0)
+	if startDate != nil {
+		start = *startDate
+	}
+
+	if !start.Before(end) {
+		return FindLabTrendQuery{}, apperror.FieldValidationError("start_date", start.Format(time.DateOnly), "start date must be before the end date")
+	}
+
+	return FindLabTrendQuery{
+		petID:       valueobject.NewPetID(petID),
+		customerID:  valueobject.NewOptCustomerID(customerID),
+		analyteCode: analyteCode,
+		startDate:   start,
+		endDate:     end,
+	}, nil
+}
+
+func (q FindLabTrendQuery) PetID() valueobject.PetID            { return q.petID }
+func (q FindLabTrendQuery) CustomerID() *valueobject.CustomerID { return q.customerID }
+func (q FindLabTrendQuery) AnalyteCode() string                 { return q.analyteCode }
+func (q FindLabTrendQuery) StartDate() time.Time                { return q.startDate }
+func (q FindLabTrendQuery) EndDate() time.Time                  { return q.endDate }
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"fmt"
+
+	dberr "clinic-vet-api/app/shared/error/infrastructure/database"
+)
+
+const (
+	TableLabOrders      = "lab_orders"
+	TableLabOrderPanels = "lab_order_panels"
+	TableLabResults     = "lab_results"
+	OpSelect            = "select"
+	OpInsert            = "insert"
+	OpUpdate            = "update"
+	OpCount             = "count"
+	DriverSQL           = "sqlc"
+
+	ErrMsgGetLabOrder     = "failed to get lab order"
+	ErrMsgListLabOrders   = "failed to list lab orders"
+	ErrMsgCountLabOrders  = "failed to count lab orders"
+	ErrMsgCreateLabOrder  = "failed to create lab order"
+	ErrMsgUpdateLabOrder  = "failed to update lab order"
+	ErrMsgListPanels      = "failed to list lab order panels"
+	ErrMsgCreatePanel     = "failed to create lab order panel"
+	ErrMsgListLabResults  = "failed to list lab results"
+	ErrMsgUpsertLabResult = "failed to save lab result"
+)
+
+func (r *SqlcLabOrderRepository) dbError(operation, table, message string, err error) error {
+	return dberr.DatabaseOperationError(operation, table, DriverSQL, fmt.Errorf("%s: %v", message, err))
+}
+
+func (r *SqlcLabOrderRepository) notFoundError(parameterName, parameterValue string) error {
+	return dberr.EntityNotFoundError(parameterName, parameterValue, OpSelect, TableLabOrders, DriverSQL)
+}
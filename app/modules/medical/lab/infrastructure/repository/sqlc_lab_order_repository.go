@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/database"
+	"clinic-vet-api/app/shared/mapper"
+	p "clinic-vet-api/app/shared/page"
+	"clinic-vet-api/sqlc"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type SqlcLabOrderRepository struct {
+	queries    *sqlc.Queries
+	transactor *database.Transactor
+	pgMap      *mapper.SqlcFieldMapper
+}
+
+func NewSqlcLabOrderRepository(queries *sqlc.Queries, transactor *database.Transactor, pgMap *mapper.SqlcFieldMapper) repository.LabOrderRepository {
+	return &SqlcLabOrderRepository{queries: queries, transactor: transactor, pgMap: pgMap}
+}
+
+func (r *SqlcLabOrderRepository) FindByID(ctx context.Context, id valueobject.LabOrderID) (medical.LabOrder, error) {
+	row, err := r.queries.FindLabOrderByID(ctx, id.Int32())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return medical.LabOrder{}, r.notFoundError("id", id.String())
+		}
+		return medical.LabOrder{}, r.dbError(OpSelect, TableLabOrders, ErrMsgGetLabOrder, err)
+	}
+
+	orders, err := r.loadDetails(ctx, r.queries, []sqlc.LabOrder{row})
+	if err != nil {
+		return medical.LabOrder{}, err
+	}
+	return orders[0], nil
+}
+
+func (r *SqlcLabOrderRepository) FindBySession(ctx context.Context, sessionID valueobject.MedSessionID) ([]medical.LabOrder, error) {
+	rows, err := r.queries.FindLabOrdersBySession(ctx, sessionID.Int32())
+	if err != nil {
+		return nil, r.dbError(OpSelect, TableLabOrders, ErrMsgListLabOrders, err)
+	}
+
+	return r.loadDetails(ctx, r.queries, rows)
+}
+
+func (r *SqlcLabOrderRepository) FindByPet(
+	ctx context.Context,
+	petID valueobject.PetID,
+	pagination p.PaginationRequest,
+) (p.Page[medical.LabOrder], error) {
+	rows, err := r.queries.FindLabOrdersByPet(ctx, sqlc.FindLabOrdersByPetParams{
+		PetID:  petID.Int32(),
+		Limit:  pagination.Limit(),
+		Offset: pagination.Offset(),
+	})
+	if err != nil {
+		return p.Page[medical.LabOrder]{}, r.dbError(OpSelect, TableLabOrders, ErrMsgListLabOrders, err)
+	}
+
+	total, err := r.queries.CountLabOrdersByPet(ctx, petID.Int32())
+	if err != nil {
+		return p.Page[medical.LabOrder]{}, r.dbError(OpCount, TableLabOrders, ErrMsgCountLabOrders, err)
+	}
+
+	orders, err := r.loadDetails(ctx, r.queries, rows)
+	if err != nil {
+		return p.Page[medical.LabOrder]{}, err
+	}
+	return p.NewPage(orders, total, pagination), nil
+}
+
+func (r *SqlcLabOrderRepository) FindOpenByAccession(ctx context.Context, accessionNumber string) ([]medical.LabOrder, error) {
+	rows, err := r.queries.FindOpenLabOrdersByAccession(ctx, r.pgMap.PgText.FromString(accessionNumber))
+	if err != nil {
+		return nil, r.dbError(OpSelect, TableLabOrders, ErrMsgListLabOrders, err)
+	}
+
+	return r.loadDetails(ctx, r.queries, rows)
+}
+
+func (r *SqlcLabOrderRepository) FindOpenByPet(ctx context.Context, petID valueobject.PetID) ([]medical.LabOrder, error) {
+	rows, err := r.queries.FindOpenLabOrdersByPet(ctx, petID.Int32())
+	if err != nil {
+		return nil, r.dbError(OpSelect, TableLabOrders, ErrMsgListLabOrders, err)
+	}
+
+	return r.loadDetails(ctx, r.queries, rows)
+}
+
+func (r *SqlcLabOrderRepository) FindResultsByAnalyte(
+	ctx context.Context,
+	petID valueobject.PetID,
+	analyteCode string,
+	start, end time.Time,
+) ([]medical.LabResult, error) {
+	rows, err := r.queries.FindLabResultsByAnalyte(ctx, sqlc.FindLabResultsByAnalyteParams{
+		PetID:        petID.Int32(),
+		AnalyteCode:  analyteCode,
+		ObservedFrom: r.pgMap.PgTimestamptz.FromTime(start),
+		ObservedTo:   r.pgMap.PgTimestamptz.FromTime(end),
+	})
+	if err != nil {
+		return nil, r.dbError(OpSelect, TableLabResults, ErrMsgListLabResults, err)
+	}
+
+	return r.toResults(rows), nil
+}
+
+// Save inserts a new order with its panels, an existing one gets its status updated and every
+// result upserted by analyte in the same transaction. Only open orders are updated and only
+// orders without results cancelled, so a completed or cancelled order is never reopened by a
+// change made on a stale copy
+func (r *SqlcLabOrderRepository) Save(ctx context.Context, order *medical.LabOrder) error {
+	var saved medical.LabOrder
+	err := r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+		if order.ID().IsZero() {
+			return r.create(ctx, queries, order, &saved)
+		}
+		return r.update(ctx, queries, order, &saved)
+	})
+	if err != nil {
+		return err
+	}
+
+	*order = saved
+	return nil
+}
+
+func (r *SqlcLabOrderRepository) create(ctx context.Context, queries *sqlc.Queries, order *medical.LabOrder, saved *medical.LabOrder) error {
+	row, err := queries.CreateLabOrder(ctx, sqlc.CreateLabOrderParams{
+		MedicalSessionID: order.SessionID().Int32(),
+		PetID:            order.PetID().Int32(),
+		OrderedBy:        order.OrderedBy().Int32(),
+		LabName:          order.LabName(),
+		AccessionNumber:  r.pgMap.PgText.FromStringPtr(order.AccessionNumber()),
+		Status:           order.Status().String(),
+		Notes:            r.pgMap.PgText.FromStringPtr(order.Notes()),
+		OrderedAt:        r.pgMap.PgTimestamptz.FromTime(order.OrderedAt()),
+	})
+	if err != nil {
+		return r.dbError(OpInsert, TableLabOrders, ErrMsgCreateLabOrder, err)
+	}
+
+	panels := make([]sqlc.LabOrderPanel, len(order.Panels()))
+	for i, panel := range order.Panels() {
+		params := sqlc.CreateLabOrderPanelParams{LabOrderID: row.ID, PanelCode: panel.Code, PanelName: panel.Name}
+		if err := queries.CreateLabOrderPanel(ctx, params); err != nil {
+			return r.dbError(OpInsert, TableLabOrderPanels, ErrMsgCreatePanel, err)
+		}
+		panels[i] = sqlc.LabOrderPanel(params)
+	}
+
+	*saved = r.toEntity(row, panels, nil)
+	return nil
+}
+
+func (r *SqlcLabOrderRepository) update(ctx context.Context, queries *sqlc.Queries, order *medical.LabOrder, saved *medical.LabOrder) error {
+	rowsAffected, err := queries.UpdateLabOrderStatus(ctx, sqlc.UpdateLabOrderStatusParams{
+		ID:           order.ID().Int32(),
+		Status:       order.Status().String(),
+		ResultedAt:   r.pgMap.PgTimestamptz.FromTimePtr(order.ResultedAt()),
+		CancelledAt:  r.pgMap.PgTimestamptz.FromTimePtr(order.CancelledAt()),
+		CancelReason: r.pgMap.PgText.FromStringPtr(order.CancelReason()),
+	})
+	if err != nil {
+		return r.dbError(OpUpdate, TableLabOrders, ErrMsgUpdateLabOrder, err)
+	}
+
+	if rowsAffected == 0 {
+		return medical.LabOrderClosedError(ctx)
+	}
+
+	for _, result := range order.Results() {
+		if _, err := queries.UpsertLabResult(ctx, r.toUpsertParams(order, result)); err != nil {
+			return r.dbError(OpInsert, TableLabResults, ErrMsgUpsertLabResult, err)
+		}
+	}
+
+	row, err := queries.FindLabOrderByID(ctx, order.ID().Int32())
+	if err != nil {
+		return r.dbError(OpSelect, TableLabOrders, ErrMsgGetLabOrder, err)
+	}
+
+	orders, err := r.loadDetails(ctx, queries, []sqlc.LabOrder{row})
+	if err != nil {
+		return err
+	}
+	*saved = orders[0]
+	return nil
+}
+
+// loadDetails fetches the panels and results of all the orders in two queries
+func (r *SqlcLabOrderRepository) loadDetails(ctx context.Context, queries *sqlc.Queries, rows []sqlc.LabOrder) ([]medical.LabOrder, error) {
+	if len(rows) == 0 {
+		return []medical.LabOrder{}, nil
+	}
+
+	ids := make([]int32, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+
+	panels, err := queries.FindLabOrderPanelsByOrders(ctx, ids)
+	if err != nil {
+		return nil, r.dbError(OpSelect, TableLabOrderPanels, ErrMsgListPanels, err)
+	}
+
+	results, err := queries.FindLabResultsByOrders(ctx, ids)
+	if err != nil {
+		return nil, r.dbError(OpSelect, TableLabResults, ErrMsgListLabResults, err)
+	}
+
+	return r.toEntities(rows, panels, results), nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/sqlc"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *SqlcLabOrderRepository) toEntity(row sqlc.LabOrder, panels []sqlc.LabOrderPanel, results []sqlc.LabResult) medical.LabOrder {
+	orderPanels := make([]medical.LabOrderPanel, len(panels))
+	for i, panel := range panels {
+		orderPanels[i] = medical.LabOrderPanel{Code: panel.PanelCode, Name: panel.PanelName}
+	}
+
+	return *medical.NewLabOrderBuilder().
+		WithID(valueobject.NewLabOrderID(uint(row.ID))).
+		WithSessionID(valueobject.NewMedSessionID(uint(row.MedicalSessionID))).
+		WithPetID(valueobject.NewPetID(uint(row.PetID))).
+		WithOrderedBy(valueobject.NewEmployeeID(uint(row.OrderedBy))).
+		WithLab(row.LabName, r.pgMap.PgText.ToStringPtr(row.AccessionNumber)).
+		WithPanels(orderPanels).
+		WithStatus(enum.LabOrderStatus(row.Status)).
+		WithNotes(r.pgMap.PgText.ToStringPtr(row.Notes)).
+		WithDates(row.OrderedAt.Time, r.pgMap.PgTimestamptz.ToTimePtr(row.ResultedAt)).
+		WithCancellation(
+			r.pgMap.PgTimestamptz.ToTimePtr(row.CancelledAt),
+			r.pgMap.PgText.ToStringPtr(row.CancelReason),
+		).
+		WithResults(r.toResults(results)).
+		WithTimestamps(row.CreatedAt.Time, row.UpdatedAt.Time).
+		Build()
+}
+
+// toEntities puts every order together with its own panels and results
+func (r *SqlcLabOrderRepository) toEntities(rows []sqlc.LabOrder, panels []sqlc.LabOrderPanel, results []sqlc.LabResult) []medical.LabOrder {
+	panelsByOrder := make(map[int32][]sqlc.LabOrderPanel, len(rows))
+	for _, panel := range panels {
+		panelsByOrder[panel.LabOrderID] = append(panelsByOrder[panel.LabOrderID], panel)
+	}
+
+	resultsByOrder := make(map[int32][]sqlc.LabResult, len(rows))
+	for _, result := range results {
+		resultsByOrder[result.LabOrderID] = append(resultsByOrder[result.LabOrderID], result)
+	}
+
+	orders := make([]medical.LabOrder, len(rows))
+	for i, row := range rows {
+		orders[i] = r.toEntity(row, panelsByOrder[row.ID], resultsByOrder[row.ID])
+	}
+	return orders
+}
+
+func (r *SqlcLabOrderRepository) toResult(row sqlc.LabResult) medical.LabResult {
+	var flag *enum.LabResultFlag
+	if row.Flag.Valid {
+		value := enum.LabResultFlag(row.Flag.String)
+		flag = &value
+	}
+
+	return medical.RestoreLabResult(
+		valueobject.NewLabResultID(uint(row.ID)),
+		valueobject.NewLabOrderID(uint(row.LabOrderID)),
+		valueobject.NewPetID(uint(row.PetID)),
+		medical.LabResultEntry{
+			PanelCode:      row.PanelCode,
+			AnalyteCode:    row.AnalyteCode,
+			AnalyteName:    row.AnalyteName,
+			NumericValue:   toFloatPtr(row.NumericValue),
+			TextValue:      r.pgMap.PgText.ToStringPtr(row.TextValue),
+			Unit:           row.Unit,
+			ReferenceRange: valueobject.NewLabReferenceRange(toFloatPtr(row.ReferenceLow), toFloatPtr(row.ReferenceHigh)),
+			Flag:           flag,
+			ObservedAt:     row.ObservedAt.Time,
+		},
+	)
+}
+
+func (r *SqlcLabOrderRepository) toResults(rows []sqlc.LabResult) []medical.LabResult {
+	results := make([]medical.LabResult, len(rows))
+	for i, row := range rows {
+		results[i] = r.toResult(row)
+	}
+	return results
+}
+
+func (r *SqlcLabOrderRepository) toUpsertParams(order *medical.LabOrder, result medical.LabResult) sqlc.UpsertLabResultParams {
+	var flag pgtype.Text
+	if result.Flag() != nil {
+		flag = r.pgMap.PgText.FromString(result.Flag().String())
+	}
+
+	return sqlc.UpsertLabResultParams{
+		LabOrderID:    order.ID().Int32(),
+		PetID:         order.PetID().Int32(),
+		PanelCode:     result.PanelCode(),
+		AnalyteCode:   result.AnalyteCode(),
+		AnalyteName:   result.AnalyteName(),
+		NumericValue:  fromFloatPtr(result.NumericValue()),
+		TextValue:     r.pgMap.PgText.FromStringPtr(result.TextValue()),
+		Unit:          result.Unit(),
+		ReferenceLow:  fromFloatPtr(result.ReferenceRange().Low()),
+		ReferenceHigh: fromFloatPtr(result.ReferenceRange().High()),
+		Flag:          flag,
+		ObservedAt:    r.pgMap.PgTimestamptz.FromTime(result.ObservedAt()),
+	}
+}
+
+func toFloatPtr(value pgtype.Float8) *float64 {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Float64
+}
+
+func fromFloatPtr(value *float64) pgtype.Float8 {
+	if value == nil {
+		return pgtype.Float8{}
+	}
+	return pgtype.Float8{Float64: *value, Valid: true}
+}
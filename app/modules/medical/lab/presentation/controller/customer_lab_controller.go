@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/medical/lab/application"
+	autherror "clinic-vet-api/app/shared/error/auth"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+	ginutils "clinic-vet-api/app/shared/gin_utils"
+	"clinic-vet-api/app/shared/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type CustomerLabController struct {
+	labService application.LabFacadeService
+	validator  *validator.Validate
+}
+
+func NewCustomerLabController(labService application.LabFacadeService, validator *validator.Validate) *CustomerLabController {
+	return &CustomerLabController{
+		labService: labService,
+		validator:  validator,
+	}
+}
+
+// GetMyPetLabOrders godoc
+// @Summary List the lab results of my pet
+// @Description Returns the lab orders of the pet of the authenticated customer with their results, the latest first
+// @Tags customer-pet-labs
+// @Produce json
+// @Param id path int true "Pet ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.APIResponse{data=[]dto.LabOrderResponse}
+// @Failure 400 {object} response.APIResponse "Invalid query parameters"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 404 {object} response.APIResponse "Pet not found"
+// @Router /customers/pets/{id}/lab-orders [get]
+// @Security BearerAuth
+func (ctrl *CustomerLabController) GetMyPetLabOrders(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, autherror.UnauthorizedCTXError())
+		return
+	}
+
+	petID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	getPetLabOrders(c, ctrl.labService, ctrl.validator, petID, &user.CustomerID)
+}
+
+// GetMyPetLabTrend godoc
+// @Summary Get the trend of an analyte for my pet
+// @Description Returns the results of the analyte for the pet of the authenticated customer, oldest first
+// @Tags customer-pet-labs
+// @Produce json
+// @Param id path int true "Pet ID"
+// @Param analyte path string true "Analyte code" example(CREA)
+// @Param start_date query string false "First day, three years before the end date when omitted" format(date)
+// @Param end_date query string false "Last day, today when omitted" format(date)
+// @Success 200 {object} response.APIResponse{data=dto.LabTrendResponse}
+// @Failure 400 {object} response.APIResponse "Invalid query parameters"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 404 {object} response.APIResponse "Pet not found"
+// @Router /customers/pets/{id}/lab-trends/{analyte} [get]
+// @Security BearerAuth
+func (ctrl *CustomerLabController) GetMyPetLabTrend(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, autherror.UnauthorizedCTXError())
+		return
+	}
+
+	petID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	getPetLabTrend(c, ctrl.labService, ctrl.validator, petID, &user.CustomerID)
+}
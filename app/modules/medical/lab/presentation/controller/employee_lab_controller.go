@@ -0,0 +1,353 @@
+package controller
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/medical/lab/application"
+	"clinic-vet-api/app/modules/medical/lab/application/query"
+	"clinic-vet-api/app/modules/medical/lab/presentation/dto"
+	autherror "clinic-vet-api/app/shared/error/auth"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+	ginutils "clinic-vet-api/app/shared/gin_utils"
+	"clinic-vet-api/app/shared/page"
+	"clinic-vet-api/app/shared/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type EmployeeLabController struct {
+	labService application.LabFacadeService
+	validator  *validator.Validate
+}
+
+func NewEmployeeLabController(labService application.LabFacadeService, validator *validator.Validate) *EmployeeLabController {
+	return &EmployeeLabController{
+		labService: labService,
+		validator:  validator,
+	}
+}
+
+// OrderLabWork godoc
+// @Summary Order lab work
+// @Description Sends panels to a laboratory for the pet seen in the medical session, ordered by the authenticated veterinarian. Panels of the catalog only need their code
+// @Tags employee-lab-orders
+// @Accept json
+// @Produce json
+// @Param request body dto.OrderLabWorkRequest true "Lab order"
+// @Success 201 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse "Invalid input data"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 404 {object} response.APIResponse "Medical session not found"
+// @Router /employees/lab-orders [post]
+// @Security BearerAuth
+func (ctrl *EmployeeLabController) OrderLabWork(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, autherror.UnauthorizedCTXError())
+		return
+	}
+
+	var req dto.OrderLabWorkRequest
+	if err := ginutils.ShouldBindAndValidateBody(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	cmd, err := req.ToCommand(user.EmployeeID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.labService.OrderLabWork(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Created(c, result.ID(), "Lab Order")
+}
+
+// RecordLabResults godoc
+// @Summary Record lab results
+// @Description Records a batch of results on the order, a result replaces the previous one of the same analyte. Numeric results are flagged against the reference range, taken from the catalog for the species of the pet when left out. The final batch completes the order
+// @Tags employee-lab-orders
+// @Accept json
+// @Produce json
+// @Param id path int true "Lab order ID"
+// @Param request body dto.RecordLabResultsRequest true "Results"
+// @Success 200 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse "Invalid input data"
+// @Failure 404 {object} response.APIResponse "Lab order not found"
+// @Failure 422 {object} response.APIResponse "The lab order is completed or cancelled"
+// @Router /employees/lab-orders/{id}/results [put]
+// @Security BearerAuth
+func (ctrl *EmployeeLabController) RecordLabResults(c *gin.Context) {
+	orderID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	var req dto.RecordLabResultsRequest
+	if err := ginutils.ShouldBindAndValidateBody(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	cmd, err := req.ToCommand(orderID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.labService.RecordLabResults(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Success(c, nil, result.Message())
+}
+
+// CancelLabOrder godoc
+// @Summary Cancel a lab order
+// @Description Withdraws the lab work before the laboratory reports any result
+// @Tags employee-lab-orders
+// @Accept json
+// @Produce json
+// @Param id path int true "Lab order ID"
+// @Param request body dto.CancelLabOrderRequest true "Cancellation"
+// @Success 200 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse "Invalid input data"
+// @Failure 404 {object} response.APIResponse "Lab order not found"
+// @Failure 422 {object} response.APIResponse "The lab order already has results"
+// @Router /employees/lab-orders/{id}/cancel [put]
+// @Security BearerAuth
+func (ctrl *EmployeeLabController) CancelLabOrder(c *gin.Context) {
+	orderID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	var req dto.CancelLabOrderRequest
+	if err := ginutils.ShouldBindAndValidateBody(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	cmd, err := req.ToCommand(orderID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.labService.CancelLabOrder(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Success(c, nil, result.Message())
+}
+
+// GetLabOrder godoc
+// @Summary Get a lab order
+// @Description Returns a lab order by ID with its panels and the results reported so far
+// @Tags employee-lab-orders
+// @Produce json
+// @Param id path int true "Lab order ID"
+// @Success 200 {object} response.APIResponse{data=dto.LabOrderResponse}
+// @Failure 400 {object} response.APIResponse "Invalid lab order ID"
+// @Failure 404 {object} response.APIResponse "Lab order not found"
+// @Router /employees/lab-orders/{id} [get]
+// @Security BearerAuth
+func (ctrl *EmployeeLabController) GetLabOrder(c *gin.Context) {
+	orderID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	qry, err := query.NewFindLabOrderByIDQuery(orderID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result, err := ctrl.labService.FindLabOrderByID(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromLabOrderResult(result), "Lab Order")
+}
+
+// GetSessionLabOrders godoc
+// @Summary List the lab orders of a medical session
+// @Description Returns the lab work ordered during the medical session with its results, the first ordered first
+// @Tags employee-lab-orders
+// @Produce json
+// @Param id path int true "Medical session ID"
+// @Success 200 {object} response.APIResponse{data=[]dto.LabOrderResponse}
+// @Failure 400 {object} response.APIResponse "Invalid medical session ID"
+// @Router /employees/lab-orders/sessions/{id} [get]
+// @Security BearerAuth
+func (ctrl *EmployeeLabController) GetSessionLabOrders(c *gin.Context) {
+	sessionID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	qry, err := query.NewFindLabOrdersBySessionQuery(sessionID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	results, err := ctrl.labService.FindLabOrdersBySession(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromLabOrderResults(results), "Lab Orders")
+}
+
+// GetPetLabOrders godoc
+// @Summary List the lab orders of a pet
+// @Description Returns every lab order of the pet with its results, the latest first
+// @Tags employee-lab-orders
+// @Produce json
+// @Param id path int true "Pet ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.APIResponse{data=[]dto.LabOrderResponse}
+// @Failure 400 {object} response.APIResponse "Invalid query parameters"
+// @Failure 404 {object} response.APIResponse "Pet not found"
+// @Router /employees/lab-orders/pets/{id} [get]
+// @Security BearerAuth
+func (ctrl *EmployeeLabController) GetPetLabOrders(c *gin.Context) {
+	petID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	getPetLabOrders(c, ctrl.labService, ctrl.validator, petID, nil)
+}
+
+// GetPetLabTrend godoc
+// @Summary Get the trend of an analyte for a pet
+// @Description Returns the results of the analyte for the pet oldest first, with the change between results, the minimum, maximum and average and how many were abnormal. Cancelled orders are left out
+// @Tags employee-lab-orders
+// @Produce json
+// @Param id path int true "Pet ID"
+// @Param analyte path string true "Analyte code" example(CREA)
+// @Param start_date query string false "First day, three years before the end date when omitted" format(date)
+// @Param end_date query string false "Last day, today when omitted" format(date)
+// @Success 200 {object} response.APIResponse{data=dto.LabTrendResponse}
+// @Failure 400 {object} response.APIResponse "Invalid query parameters"
+// @Failure 404 {object} response.APIResponse "Pet not found"
+// @Router /employees/lab-orders/pets/{id}/trends/{analyte} [get]
+// @Security BearerAuth
+func (ctrl *EmployeeLabController) GetPetLabTrend(c *gin.Context) {
+	petID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	getPetLabTrend(c, ctrl.labService, ctrl.validator, petID, nil)
+}
+
+// FindLabPanels godoc
+// @Summary List the lab panel catalog
+// @Description Lists the panels that can be ordered with their analytes, units and reference ranges. With a species only its reference ranges are given
+// @Tags employee-lab-orders
+// @Produce json
+// @Param species query string false "Species filter"
+// @Success 200 {object} response.APIResponse{data=[]dto.LabPanelResponse}
+// @Failure 400 {object} response.APIResponse "Invalid species"
+// @Router /employees/lab-panels [get]
+// @Security BearerAuth
+func (ctrl *EmployeeLabController) FindLabPanels(c *gin.Context) {
+	var req dto.FindLabPanelsRequest
+	if err := ginutils.ShouldBindAndValidateQuery(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	qry, err := req.ToQuery()
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	results, err := ctrl.labService.FindLabPanels(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromLabPanelResults(results), "Lab Panels")
+}
+
+func getPetLabOrders(
+	c *gin.Context,
+	labService application.LabFacadeService,
+	validator *validator.Validate,
+	petID uint,
+	customerID *uint,
+) {
+	var pagination page.PaginationRequest
+	if err := ginutils.ShouldBindPageParams(&pagination, c, validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	qry, err := query.NewFindLabOrdersByPetQuery(petID, customerID, pagination)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	orderPage, err := labService.FindLabOrdersByPet(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.SuccessWithPagination(c, dto.FromLabOrderResults(orderPage.Items), "Lab orders retrieved successfully", orderPage.Metadata)
+}
+
+func getPetLabTrend(
+	c *gin.Context,
+	labService application.LabFacadeService,
+	validator *validator.Validate,
+	petID uint,
+	customerID *uint,
+) {
+	var req dto.LabTrendRequest
+	if err := ginutils.ShouldBindAndValidateQuery(c, &req, validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	qry, err := req.ToQuery(petID, customerID, c.Param("analyte"))
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result, err := labService.FindLabTrend(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromLabTrendResult(result), "Lab Trend")
+}
@@ -0,0 +1,63 @@
+package controller
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"clinic-vet-api/app/modules/medical/lab/presentation/oru"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+	"clinic-vet-api/app/shared/hl7"
+	"clinic-vet-api/app/shared/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LabInterfaceController receives the results sent by analyzers and laboratory interfaces
+// over HTTP, the same messages the MLLP listener takes on TCP
+type LabInterfaceController struct {
+	ingestor *oru.Ingestor
+}
+
+func NewLabInterfaceController(ingestor *oru.Ingestor) *LabInterfaceController {
+	return &LabInterfaceController{ingestor: ingestor}
+}
+
+// ReceiveORU godoc
+// @Summary Receive HL7 lab results
+// @Description Takes an HL7 v2 ORU^R01 message and records its observations on the open lab order matching the accession number of OBR-3, or the pet ID of PID-3 when there is none. The reply is the HL7 ACK, AA when the results were stored, AE when they could not be matched or stored and AR when the message is not understood
+// @Tags employee-lab-orders
+// @Accept plain
+// @Produce plain
+// @Param request body string true "ORU^R01 message, segments separated by CR or LF"
+// @Success 200 {string} string "ACK with MSA-1 AA"
+// @Failure 400 {string} string "ACK with MSA-1 AR"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 413 {object} response.APIResponse "Message too large"
+// @Failure 422 {string} string "ACK with MSA-1 AE"
+// @Router /employees/lab-orders/hl7/oru [post]
+// @Security BearerAuth
+func (ctrl *LabInterfaceController) ReceiveORU(c *gin.Context) {
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, hl7.DefaultMaxMessageSize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			response.ApplicationError(c, httpError.RequestTooLargeError(tooLarge.Limit, c.Request.ContentLength))
+			return
+		}
+		response.BadRequest(c, httpError.RequestBodyDataError(err))
+		return
+	}
+
+	ack, outcome := ctrl.ingestor.Ingest(c.Request.Context(), body)
+
+	status := http.StatusOK
+	switch outcome.Code {
+	case hl7.AckError:
+		status = http.StatusUnprocessableEntity
+	case hl7.AckReject:
+		status = http.StatusBadRequest
+	}
+
+	c.Data(status, hl7.ContentType, ack)
+}
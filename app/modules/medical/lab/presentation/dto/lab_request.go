@@ -0,0 +1,135 @@
+package dto
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/medical/lab/application/command"
+	"clinic-vet-api/app/modules/medical/lab/application/query"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+)
+
+// LabOrderPanelRequest represents a panel sent to the laboratory, the name is taken from the
+// catalog when the code is in it
+type LabOrderPanelRequest struct {
+	Code string `json:"code" validate:"required,max=30" example:"CHEM"`
+	Name string `json:"name,omitempty" validate:"omitempty,max=100" example:"Chemistry Panel"`
+}
+
+// OrderLabWorkRequest represents lab work ordered for the pet seen in a medical session
+// @Description Panels sent to an external or in-house laboratory
+type OrderLabWorkRequest struct {
+	MedicalSessionID uint                   `json:"medical_session_id" validate:"required,gt=0" example:"42"`
+	LabName          string                 `json:"lab_name" validate:"required,max=100" example:"IDEXX Reference Laboratories"`
+	AccessionNumber  *string                `json:"accession_number,omitempty" validate:"omitempty,max=50" example:"A240611-0087"`
+	Panels           []LabOrderPanelRequest `json:"panels" validate:"required,min=1,max=10,dive"`
+	Notes            *string                `json:"notes,omitempty" validate:"omitempty,max=500" example:"Fasted 12 hours"`
+}
+
+func (r *OrderLabWorkRequest) ToCommand(orderedBy uint) (command.OrderLabWorkCommand, error) {
+	panels := make([]medical.LabOrderPanel, len(r.Panels))
+	for i, panel := range r.Panels {
+		panels[i] = medical.LabOrderPanel{Code: panel.Code, Name: panel.Name}
+	}
+
+	return command.NewOrderLabWorkCommand(r.MedicalSessionID, orderedBy, r.LabName, r.AccessionNumber, panels, r.Notes)
+}
+
+// LabResultRequest represents the result of an analyte. Name, unit and reference range are taken
+// from the catalog when left out, numeric results are flagged against the range
+type LabResultRequest struct {
+	PanelCode     string     `json:"panel_code,omitempty" validate:"omitempty,max=30" example:"CHEM"`
+	AnalyteCode   string     `json:"analyte_code" validate:"required,max=30" example:"CREA"`
+	AnalyteName   string     `json:"analyte_name,omitempty" validate:"omitempty,max=100" example:"Creatinine"`
+	NumericValue  *float64   `json:"numeric_value,omitempty" example:"2.1"`
+	TextValue     *string    `json:"text_value,omitempty" validate:"omitempty,max=100" example:"Negative"`
+	Unit          string     `json:"unit,omitempty" validate:"omitempty,max=20" example:"mg/dL"`
+	ReferenceLow  *float64   `json:"reference_low,omitempty" example:"0.5"`
+	ReferenceHigh *float64   `json:"reference_high,omitempty" example:"1.8"`
+	Flag          string     `json:"flag,omitempty" validate:"omitempty,oneof=low normal high abnormal L N H A" example:"high"`
+	ObservedAt    *time.Time `json:"observed_at,omitempty" example:"2025-06-11T09:30:00Z"`
+}
+
+// RecordLabResultsRequest represents a batch of results reported by the laboratory, the final
+// batch completes the order
+type RecordLabResultsRequest struct {
+	Results []LabResultRequest `json:"results" validate:"omitempty,max=100,dive"`
+	Final   bool               `json:"final" example:"true"`
+}
+
+func (r *RecordLabResultsRequest) ToCommand(orderID uint) (command.RecordLabResultsCommand, error) {
+	inputs := make([]command.LabResultInput, len(r.Results))
+	for i, result := range r.Results {
+		inputs[i] = command.LabResultInput{
+			PanelCode:     result.PanelCode,
+			AnalyteCode:   result.AnalyteCode,
+			AnalyteName:   result.AnalyteName,
+			NumericValue:  result.NumericValue,
+			TextValue:     result.TextValue,
+			Unit:          result.Unit,
+			ReferenceLow:  result.ReferenceLow,
+			ReferenceHigh: result.ReferenceHigh,
+			Flag:          result.Flag,
+			ObservedAt:    result.ObservedAt,
+		}
+	}
+
+	return command.NewRecordLabResultsCommand(orderID, inputs, r.Final)
+}
+
+// CancelLabOrderRequest represents why the lab work is withdrawn
+type CancelLabOrderRequest struct {
+	Reason string `json:"reason" validate:"required,max=500" example:"Owner declined the blood draw"`
+}
+
+func (r *CancelLabOrderRequest) ToCommand(id uint) (command.CancelLabOrderCommand, error) {
+	return command.NewCancelLabOrderCommand(id, r.Reason)
+}
+
+// FindLabPanelsRequest represents the query params to list the panels of the catalog
+type FindLabPanelsRequest struct {
+	Species string `form:"species" example:"dog"`
+}
+
+func (r *FindLabPanelsRequest) ToQuery() (query.FindLabPanelsQuery, error) {
+	return query.NewFindLabPanelsQuery(r.Species)
+}
+
+// LabTrendRequest represents the period of the trend of an analyte
+type LabTrendRequest struct {
+	// First day of the trend, three years before the end date when omitted
+	StartDate *string `form:"start_date" validate:"omitempty,datetime=2006-01-02" example:"2023-01-01"`
+	// Last day of the trend, today when omitted
+	EndDate *string `form:"end_date" validate:"omitempty,datetime=2006-01-02" example:"2025-06-30"`
+}
+
+func (r *LabTrendRequest) ToQuery(petID uint, customerID *uint, analyteCode string) (query.FindLabTrendQuery, error) {
+	startDate, err := parseOptDate("start_date", r.StartDate)
+	if err != nil {
+		return query.FindLabTrendQuery{}, err
+	}
+
+	endDate, err := parseOptDate("end_date", r.EndDate)
+	if err != nil {
+		return query.FindLabTrendQuery{}, err
+	}
+
+	if endDate != nil {
+		endOfDay := endDate.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		endDate = &endOfDay
+	}
+
+	return query.NewFindLabTrendQuery(petID, customerID, analyteCode, startDate, endDate)
+}
+
+func parseOptDate(field string, value *string) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	date, err := time.ParseInLocation(time.DateOnly, *value, time.Local)
+	if err != nil {
+		return nil, httpError.ValidationError(field, *value, "must use the YYYY-MM-DD format")
+	}
+	return &date, nil
+}
@@ -0,0 +1,211 @@
+package dto
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/medical/lab/application/handler"
+)
+
+// LabOrderPanelResponse represents a panel of a lab order
+type LabOrderPanelResponse struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+// LabResultResponse represents the result of an analyte with its reference range and flag
+type LabResultResponse struct {
+	ID            uint      `json:"id"`
+	PanelCode     string    `json:"panel_code,omitempty"`
+	AnalyteCode   string    `json:"analyte_code"`
+	AnalyteName   string    `json:"analyte_name"`
+	NumericValue  *float64  `json:"numeric_value,omitempty"`
+	TextValue     *string   `json:"text_value,omitempty"`
+	Unit          string    `json:"unit,omitempty"`
+	ReferenceLow  *float64  `json:"reference_low,omitempty"`
+	ReferenceHigh *float64  `json:"reference_high,omitempty"`
+	Flag          *string   `json:"flag,omitempty"`
+	IsAbnormal    bool      `json:"is_abnormal"`
+	ObservedAt    time.Time `json:"observed_at"`
+}
+
+// LabOrderResponse represents a lab order with the results reported so far
+type LabOrderResponse struct {
+	ID                 uint                    `json:"id"`
+	MedicalSessionID   uint                    `json:"medical_session_id"`
+	PetID              uint                    `json:"pet_id"`
+	OrderedBy          uint                    `json:"ordered_by"`
+	LabName            string                  `json:"lab_name"`
+	AccessionNumber    *string                 `json:"accession_number,omitempty"`
+	Panels             []LabOrderPanelResponse `json:"panels"`
+	Status             string                  `json:"status"`
+	Notes              *string                 `json:"notes,omitempty"`
+	OrderedAt          time.Time               `json:"ordered_at"`
+	ResultedAt         *time.Time              `json:"resulted_at,omitempty"`
+	CancelledAt        *time.Time              `json:"cancelled_at,omitempty"`
+	CancelReason       *string                 `json:"cancel_reason,omitempty"`
+	Results            []LabResultResponse     `json:"results"`
+	HasAbnormalResults bool                    `json:"has_abnormal_results"`
+	CreatedAt          time.Time               `json:"created_at"`
+	UpdatedAt          time.Time               `json:"updated_at"`
+}
+
+func FromLabOrderResult(result handler.LabOrderResult) LabOrderResponse {
+	panels := make([]LabOrderPanelResponse, len(result.Panels))
+	for i, panel := range result.Panels {
+		panels[i] = LabOrderPanelResponse{Code: panel.Code, Name: panel.Name}
+	}
+
+	results := make([]LabResultResponse, len(result.Results))
+	for i, labResult := range result.Results {
+		results[i] = LabResultResponse{
+			ID:            labResult.ID,
+			PanelCode:     labResult.PanelCode,
+			AnalyteCode:   labResult.AnalyteCode,
+			AnalyteName:   labResult.AnalyteName,
+			NumericValue:  labResult.NumericValue,
+			TextValue:     labResult.TextValue,
+			Unit:          labResult.Unit,
+			ReferenceLow:  labResult.ReferenceLow,
+			ReferenceHigh: labResult.ReferenceHigh,
+			Flag:          labResult.Flag,
+			IsAbnormal:    labResult.IsAbnormal,
+			ObservedAt:    labResult.ObservedAt,
+		}
+	}
+
+	return LabOrderResponse{
+		ID:                 result.ID,
+		MedicalSessionID:   result.SessionID,
+		PetID:              result.PetID,
+		OrderedBy:          result.OrderedBy,
+		LabName:            result.LabName,
+		AccessionNumber:    result.AccessionNumber,
+		Panels:             panels,
+		Status:             result.Status,
+		Notes:              result.Notes,
+		OrderedAt:          result.OrderedAt,
+		ResultedAt:         result.ResultedAt,
+		CancelledAt:        result.CancelledAt,
+		CancelReason:       result.CancelReason,
+		Results:            results,
+		HasAbnormalResults: result.HasAbnormalResults,
+		CreatedAt:          result.CreatedAt,
+		UpdatedAt:          result.UpdatedAt,
+	}
+}
+
+func FromLabOrderResults(results []handler.LabOrderResult) []LabOrderResponse {
+	responses := make([]LabOrderResponse, len(results))
+	for i, result := range results {
+		responses[i] = FromLabOrderResult(result)
+	}
+	return responses
+}
+
+// LabTrendPointResponse represents a result along the trend of an analyte
+type LabTrendPointResponse struct {
+	ResultID      uint      `json:"result_id"`
+	LabOrderID    uint      `json:"lab_order_id"`
+	ObservedAt    time.Time `json:"observed_at"`
+	NumericValue  *float64  `json:"numeric_value,omitempty"`
+	TextValue     *string   `json:"text_value,omitempty"`
+	Unit          string    `json:"unit,omitempty"`
+	ReferenceLow  *float64  `json:"reference_low,omitempty"`
+	ReferenceHigh *float64  `json:"reference_high,omitempty"`
+	Flag          *string   `json:"flag,omitempty"`
+	ChangePercent *float64  `json:"change_percent,omitempty"`
+}
+
+// LabTrendResponse represents the results of an analyte of a pet, oldest first
+type LabTrendResponse struct {
+	PetID         uint                    `json:"pet_id"`
+	AnalyteCode   string                  `json:"analyte_code"`
+	AnalyteName   string                  `json:"analyte_name,omitempty"`
+	StartDate     time.Time               `json:"start_date"`
+	EndDate       time.Time               `json:"end_date"`
+	Points        []LabTrendPointResponse `json:"points"`
+	Min           *float64                `json:"min,omitempty"`
+	Max           *float64                `json:"max,omitempty"`
+	Average       *float64                `json:"average,omitempty"`
+	ChangePercent *float64                `json:"change_percent,omitempty"`
+	AbnormalCount int                     `json:"abnormal_count"`
+}
+
+func FromLabTrendResult(result handler.LabTrendResult) LabTrendResponse {
+	points := make([]LabTrendPointResponse, len(result.Points))
+	for i, point := range result.Points {
+		points[i] = LabTrendPointResponse{
+			ResultID:      point.ResultID,
+			LabOrderID:    point.OrderID,
+			ObservedAt:    point.ObservedAt,
+			NumericValue:  point.NumericValue,
+			TextValue:     point.TextValue,
+			Unit:          point.Unit,
+			ReferenceLow:  point.ReferenceLow,
+			ReferenceHigh: point.ReferenceHigh,
+			Flag:          point.Flag,
+			ChangePercent: point.ChangePercent,
+		}
+	}
+
+	return LabTrendResponse{
+		PetID:         result.PetID,
+		AnalyteCode:   result.AnalyteCode,
+		AnalyteName:   result.AnalyteName,
+		StartDate:     result.StartDate,
+		EndDate:       result.EndDate,
+		Points:        points,
+		Min:           result.Min,
+		Max:           result.Max,
+		Average:       result.Average,
+		ChangePercent: result.ChangePercent,
+		AbnormalCount: result.AbnormalCount,
+	}
+}
+
+// LabReferenceRangeResponse represents the reference range of an analyte for a species
+type LabReferenceRangeResponse struct {
+	Species string   `json:"species"`
+	Low     *float64 `json:"low,omitempty"`
+	High    *float64 `json:"high,omitempty"`
+}
+
+// LabAnalyteResponse represents an analyte of a panel of the catalog
+type LabAnalyteResponse struct {
+	Code   string                      `json:"code"`
+	Name   string                      `json:"name"`
+	Unit   string                      `json:"unit,omitempty"`
+	Ranges []LabReferenceRangeResponse `json:"reference_ranges"`
+}
+
+// LabPanelResponse represents a panel of the catalog
+type LabPanelResponse struct {
+	Code        string               `json:"code"`
+	Name        string               `json:"name"`
+	Specimen    string               `json:"specimen"`
+	Description string               `json:"description,omitempty"`
+	Analytes    []LabAnalyteResponse `json:"analytes"`
+}
+
+func FromLabPanelResults(results []handler.LabPanelResult) []LabPanelResponse {
+	responses := make([]LabPanelResponse, len(results))
+	for i, panel := range results {
+		analytes := make([]LabAnalyteResponse, len(panel.Analytes))
+		for j, analyte := range panel.Analytes {
+			ranges := make([]LabReferenceRangeResponse, len(analyte.Ranges))
+			for k, r := range analyte.Ranges {
+				ranges[k] = LabReferenceRangeResponse{Species: r.Species, Low: r.Low, High: r.High}
+			}
+			analytes[j] = LabAnalyteResponse{Code: analyte.Code, Name: analyte.Name, Unit: analyte.Unit, Ranges: ranges}
+		}
+
+		responses[i] = LabPanelResponse{
+			Code:        panel.Code,
+			Name:        panel.Name,
+			Specimen:    panel.Specimen,
+			Description: panel.Description,
+			Analytes:    analytes,
+		}
+	}
+	return responses
+}
@@ -0,0 +1,126 @@
+package api
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
+	"clinic-vet-api/app/modules/medical/lab/application"
+	"clinic-vet-api/app/modules/medical/lab/application/handler"
+	sqlcRepo "clinic-vet-api/app/modules/medical/lab/infrastructure/repository"
+	"clinic-vet-api/app/modules/medical/lab/presentation/controller"
+	"clinic-vet-api/app/modules/medical/lab/presentation/oru"
+	"clinic-vet-api/app/modules/medical/lab/presentation/routes"
+	"clinic-vet-api/app/shared/database"
+	"clinic-vet-api/app/shared/mapper"
+	"clinic-vet-api/sqlc"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type LabAPIConfig struct {
+	Router         *gin.RouterGroup
+	Validator      *validator.Validate
+	AuthMiddleware *middleware.AuthMiddleware
+	Queries        *sqlc.Queries
+	Transactor     *database.Transactor
+
+	PetRepo            repository.PetRepository
+	MedicalSessionRepo repository.MedicalSessionRepository
+}
+
+type LabAPIComponents struct {
+	Repository          repository.LabOrderRepository
+	Service             application.LabFacadeService
+	Catalog             *service.LabPanelCatalog
+	EmployeeController  *controller.EmployeeLabController
+	CustomerController  *controller.CustomerLabController
+	ORUIngestor         *oru.Ingestor
+	InterfaceController *controller.LabInterfaceController
+}
+
+type LabAPIModule struct {
+	config     *LabAPIConfig
+	isBuilt    bool
+	Components LabAPIComponents
+}
+
+func NewLabAPIModule(config *LabAPIConfig) *LabAPIModule {
+	return &LabAPIModule{
+		config:  config,
+		isBuilt: false,
+	}
+}
+
+func (b *LabAPIModule) Bootstrap() error {
+	if b.isBuilt {
+		return nil
+	}
+
+	if err := b.validateConfig(); err != nil {
+		return err
+	}
+
+	repo := sqlcRepo.NewSqlcLabOrderRepository(b.config.Queries, b.config.Transactor, mapper.NewSqlcFieldMapper())
+	catalog := service.NewLabPanelCatalog()
+
+	cmdHandler := handler.NewLabOrderCommandHandler(repo, b.config.MedicalSessionRepo, b.config.PetRepo, catalog)
+	qryHandler := handler.NewLabOrderQueryHandler(repo, b.config.PetRepo, catalog)
+	labService := application.NewLabFacadeService(qryHandler, cmdHandler)
+
+	employeeController := controller.NewEmployeeLabController(labService, b.config.Validator)
+	customerController := controller.NewCustomerLabController(labService, b.config.Validator)
+	ingestor := oru.NewIngestor(labService)
+	interfaceController := controller.NewLabInterfaceController(ingestor)
+	routes.LabRoutes(b.config.Router, employeeController, customerController, interfaceController, b.config.AuthMiddleware)
+
+	b.Components = LabAPIComponents{
+		Repository:          repo,
+		Service:             labService,
+		Catalog:             catalog,
+		EmployeeController:  employeeController,
+		CustomerController:  customerController,
+		ORUIngestor:         ingestor,
+		InterfaceController: interfaceController,
+	}
+	b.isBuilt = true
+
+	return nil
+}
+
+func (b *LabAPIModule) validateConfig() error {
+	if b.config == nil {
+		return errors.New("lab api config is nil")
+	}
+
+	if b.config.Router == nil {
+		return errors.New("router is nil")
+	}
+
+	if b.config.Validator == nil {
+		return errors.New("validator is nil")
+	}
+
+	if b.config.AuthMiddleware == nil {
+		return errors.New("auth middleware is nil")
+	}
+
+	if b.config.Queries == nil {
+		return errors.New("queries is nil")
+	}
+
+	if b.config.Transactor == nil {
+		return errors.New("transactor is nil")
+	}
+
+	if b.config.PetRepo == nil {
+		return errors.New("pet repository is nil")
+	}
+
+	if b.config.MedicalSessionRepo == nil {
+		return errors.New("medical session repository is nil")
+	}
+
+	return nil
+}
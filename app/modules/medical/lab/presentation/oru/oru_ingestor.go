@@ -0,0 +1,389 @@
+// Package oru turns HL7 v2 ORU^R01 messages sent by in-house analyzers into lab results. The
+// same ingestor serves the HTTP endpoint and the MLLP listener, so both answer with the same ACK
+package oru
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"clinic-vet-api/app/modules/medical/lab/application"
+	"clinic-vet-api/app/modules/medical/lab/application/command"
+	"clinic-vet-api/app/shared/hl7"
+	"clinic-vet-api/app/shared/log"
+
+	"go.uber.org/zap"
+)
+
+const (
+	messageCode     = "ORU"
+	triggerEvent    = "R01"
+	versionMajor    = "2."
+	maxAccessionLen = 50
+)
+
+// Result statuses of OBR-25 and OBX-11 closing the order, final or corrected
+var finalStatuses = map[string]bool{"F": true, "C": true}
+
+// Observations deleted, wrong, not asked or not obtained carry no value to store
+var skippedObservationStatuses = map[string]bool{"D": true, "W": true, "X": true, "N": true}
+
+// Abnormal flags of OBX-8, HL7 table 0078, reduced to the flags of the lab results
+var abnormalFlags = map[string]string{
+	"L":  "low",
+	"LL": "low",
+	"<":  "low",
+	"H":  "high",
+	"HH": "high",
+	">":  "high",
+	"N":  "normal",
+	"A":  "abnormal",
+	"AA": "abnormal",
+}
+
+type Ingestor struct {
+	labService application.LabFacadeService
+}
+
+func NewIngestor(labService application.LabFacadeService) *Ingestor {
+	return &Ingestor{labService: labService}
+}
+
+// Handle ingests the message and returns the encoded acknowledgment, as expected by the
+// MLLP listener
+func (i *Ingestor) Handle(ctx context.Context, raw []byte) []byte {
+	ack, _ := i.Ingest(ctx, raw)
+	return ack
+}
+
+// Ingest records the results of the message on the open lab order it refers to and returns
+// the encoded acknowledgment along with its outcome
+func (i *Ingestor) Ingest(ctx context.Context, raw []byte) ([]byte, hl7.Ack) {
+	message, err := hl7.Parse(raw)
+	if err != nil {
+		return i.reply(nil, hl7.Rejected(hl7.ErrorSegmentSequence, err.Error()))
+	}
+
+	if code, trigger := message.Type(); code != messageCode || trigger != triggerEvent {
+		return i.reply(message, hl7.Rejected(hl7.ErrorUnsupportedMessageType,
+			fmt.Sprintf("message type %s^%s is not supported, only %s^%s", code, trigger, messageCode, triggerEvent)))
+	}
+
+	if version := message.Version(); version != "" && !strings.HasPrefix(version, versionMajor) {
+		return i.reply(message, hl7.Rejected(hl7.ErrorUnsupportedVersion,
+			fmt.Sprintf("version %s is not supported", version)))
+	}
+
+	cmd, ack := toIngestCommand(message)
+	if !ack.IsAccepted() {
+		return i.reply(message, ack)
+	}
+
+	result := i.labService.IngestLabResults(ctx, cmd)
+	if !result.IsSuccess() {
+		log.Warn("hl7 lab results rejected",
+			zap.String("control_id", message.ControlID()),
+			zap.Error(result.Error()),
+		)
+		return i.reply(message, hl7.Failed(toErrorCode(result.Error()), result.Error().Error()))
+	}
+
+	log.Info("hl7 lab results received",
+		zap.String("control_id", message.ControlID()),
+		zap.String("lab_order_id", result.ID()),
+	)
+	return i.reply(message, hl7.Accepted())
+}
+
+func (i *Ingestor) reply(message *hl7.Message, ack hl7.Ack) ([]byte, hl7.Ack) {
+	now := time.Now()
+	return ack.Encode(message, strconv.FormatInt(now.UnixNano(), 36), now), ack
+}
+
+// observationGroup is an OBR segment with the OBX segments following it
+type observationGroup struct {
+	request      hl7.Segment
+	observations []hl7.Segment
+}
+
+func toIngestCommand(message *hl7.Message) (command.IngestLabResultsCommand, hl7.Ack) {
+	var groups []*observationGroup
+	var orderControl *hl7.Segment
+	for _, segment := range message.Segments {
+		switch segment.Name {
+		case "ORC":
+			if orderControl == nil {
+				s := segment
+				orderControl = &s
+			}
+		case "OBR":
+			groups = append(groups, &observationGroup{request: segment})
+		case "OBX":
+			if len(groups) == 0 {
+				return command.IngestLabResultsCommand{}, hl7.Rejected(hl7.ErrorSegmentSequence, "OBX segment found before any OBR segment")
+			}
+			current := groups[len(groups)-1]
+			current.observations = append(current.observations, segment)
+		}
+	}
+
+	if len(groups) == 0 {
+		return command.IngestLabResultsCommand{}, hl7.Failed(hl7.ErrorRequiredFieldMissing, "the message has no OBR segment")
+	}
+
+	accessionNumber, ack := findAccessionNumber(groups, orderControl)
+	if !ack.IsAccepted() {
+		return command.IngestLabResultsCommand{}, ack
+	}
+
+	petID, ack := findPetID(message)
+	if !ack.IsAccepted() {
+		return command.IngestLabResultsCommand{}, ack
+	}
+
+	final := true
+	var panelCodes []string
+	var inputs []command.LabResultInput
+	for _, group := range groups {
+		panelCode := group.request.Value(4)
+		if panelCode != "" {
+			panelCodes = append(panelCodes, panelCode)
+		}
+
+		if !isFinalGroup(group) {
+			final = false
+		}
+
+		var collectedAt *time.Time
+		if value := group.request.Value(7); value != "" {
+			parsed, err := hl7.ParseTimestamp(value)
+			if err != nil {
+				return command.IngestLabResultsCommand{}, hl7.Failed(hl7.ErrorDataTypeError, "OBR-7: "+err.Error())
+			}
+			collectedAt = &parsed
+		}
+
+		for _, observation := range group.observations {
+			if skippedObservationStatuses[strings.ToUpper(observation.Value(11))] {
+				continue
+			}
+
+			input, ack := toResultInput(observation, panelCode, collectedAt)
+			if !ack.IsAccepted() {
+				return command.IngestLabResultsCommand{}, ack
+			}
+			inputs = append(inputs, input)
+		}
+	}
+
+	if len(inputs) == 0 {
+		return command.IngestLabResultsCommand{}, hl7.Failed(hl7.ErrorRequiredFieldMissing, "the message has no OBX segment with a result")
+	}
+
+	cmd, err := command.NewIngestLabResultsCommand(accessionNumber, petID, panelCodes, inputs, final)
+	if err != nil {
+		return command.IngestLabResultsCommand{}, hl7.Failed(hl7.ErrorRequiredFieldMissing, err.Error())
+	}
+
+	return cmd, hl7.Accepted()
+}
+
+// findAccessionNumber reads the filler order number of OBR-3, or the placer order number of
+// OBR-2, falling back to the same fields of ORC. All the OBR segments must share it
+func findAccessionNumber(groups []*observationGroup, orderControl *hl7.Segment) (*string, hl7.Ack) {
+	var accessionNumber string
+	for _, group := range groups {
+		value := firstNonEmpty(group.request.Value(3), group.request.Value(2))
+		if value == "" {
+			continue
+		}
+		if accessionNumber != "" && accessionNumber != value {
+			return nil, hl7.Failed(hl7.ErrorDuplicateKeyIdentifier,
+				fmt.Sprintf("OBR segments refer to different accession numbers %s and %s", accessionNumber, value))
+		}
+		accessionNumber = value
+	}
+
+	if accessionNumber == "" && orderControl != nil {
+		accessionNumber = firstNonEmpty(orderControl.Value(3), orderControl.Value(2))
+	}
+
+	if accessionNumber == "" {
+		return nil, hl7.Accepted()
+	}
+
+	if len(accessionNumber) > maxAccessionLen {
+		return nil, hl7.Failed(hl7.ErrorDataTypeError, fmt.Sprintf("accession number must be at most %d characters", maxAccessionLen))
+	}
+	return &accessionNumber, hl7.Accepted()
+}
+
+// findPetID reads the patient identifier of PID-3, or PID-2 for older analyzers, which must be
+// the pet ID of the clinic
+func findPetID(message *hl7.Message) (*uint, hl7.Ack) {
+	patient, exists := message.First("PID")
+	if !exists {
+		return nil, hl7.Accepted()
+	}
+
+	value := firstNonEmpty(patient.Value(3), patient.Value(2))
+	if value == "" {
+		return nil, hl7.Accepted()
+	}
+
+	id, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || id == 0 {
+		return nil, hl7.Failed(hl7.ErrorDataTypeError, fmt.Sprintf("PID-3: patient identifier %q is not a pet ID", value))
+	}
+
+	petID := uint(id)
+	return &petID, hl7.Accepted()
+}
+
+func toResultInput(observation hl7.Segment, panelCode string, collectedAt *time.Time) (command.LabResultInput, hl7.Ack) {
+	input := command.LabResultInput{
+		PanelCode:   panelCode,
+		AnalyteCode: observation.Component(3, 1),
+		AnalyteName: observation.Component(3, 2),
+		Unit:        observation.Value(6),
+		ObservedAt:  collectedAt,
+	}
+
+	if input.AnalyteCode == "" {
+		return input, hl7.Failed(hl7.ErrorRequiredFieldMissing, "OBX-3: observation identifier is required")
+	}
+
+	valueType := strings.ToUpper(observation.Value(2))
+	switch valueType {
+	case "NM":
+		raw := strings.TrimSpace(observation.Value(5))
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return input, hl7.Failed(hl7.ErrorDataTypeError,
+				fmt.Sprintf("OBX-5: value %q of %s is not numeric", raw, input.AnalyteCode))
+		}
+		input.NumericValue = &value
+	case "SN":
+		// Structured numeric values with a comparator, such as <^0.5, are kept as text
+		comparator := observation.Component(5, 1)
+		number := observation.Component(5, 2)
+		if value, err := strconv.ParseFloat(number, 64); err == nil && (comparator == "" || comparator == "=") {
+			input.NumericValue = &value
+		} else {
+			text := strings.TrimSpace(comparator + number)
+			input.TextValue = &text
+		}
+	default:
+		text := strings.TrimSpace(observation.Value(5))
+		if text != "" {
+			input.TextValue = &text
+		}
+	}
+
+	if input.NumericValue == nil && input.TextValue == nil {
+		return input, hl7.Failed(hl7.ErrorRequiredFieldMissing,
+			fmt.Sprintf("OBX-5: observation %s has no value", input.AnalyteCode))
+	}
+
+	input.ReferenceLow, input.ReferenceHigh = parseReferenceRange(observation.Value(7))
+
+	if flag := strings.ToUpper(strings.TrimSpace(observation.Value(8))); flag != "" {
+		input.Flag = abnormalFlags[flag]
+	}
+
+	if value := observation.Value(14); value != "" {
+		observedAt, err := hl7.ParseTimestamp(value)
+		if err != nil {
+			return input, hl7.Failed(hl7.ErrorDataTypeError, "OBX-14: "+err.Error())
+		}
+		input.ObservedAt = &observedAt
+	}
+
+	return input, hl7.Accepted()
+}
+
+// parseReferenceRange reads OBX-7 written as low-high, <high or >low. Ranges in other forms are
+// left out so the catalog range applies
+func parseReferenceRange(value string) (*float64, *float64) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	switch {
+	case strings.HasPrefix(value, "<"):
+		return nil, parseNumber(strings.TrimPrefix(strings.TrimPrefix(value, "<"), "="))
+	case strings.HasPrefix(value, ">"):
+		return parseNumber(strings.TrimPrefix(strings.TrimPrefix(value, ">"), "=")), nil
+	}
+
+	// The separator is the first dash after the first character, so a negative low bound is kept
+	separator := strings.Index(value[1:], "-")
+	if separator < 0 {
+		return nil, nil
+	}
+	separator++
+
+	low, high := parseNumber(value[:separator]), parseNumber(value[separator+1:])
+	if low == nil || high == nil {
+		return nil, nil
+	}
+	return low, high
+}
+
+func parseNumber(value string) *float64 {
+	number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return nil
+	}
+	return &number
+}
+
+// isFinalGroup tells whether the results of the OBR are final. OBR-25 is checked first, the
+// status of every observation when the analyzer leaves it empty
+func isFinalGroup(group *observationGroup) bool {
+	if status := strings.ToUpper(group.request.Value(25)); status != "" {
+		return finalStatuses[status]
+	}
+
+	if len(group.observations) == 0 {
+		return false
+	}
+	for _, observation := range group.observations {
+		if !finalStatuses[strings.ToUpper(observation.Value(11))] {
+			return false
+		}
+	}
+	return true
+}
+
+// toErrorCode maps the application error of the lab service to the HL7 error reported in ERR
+func toErrorCode(err error) hl7.ErrorCode {
+	var coded interface{ ErrorCode() string }
+	if !errors.As(err, &coded) {
+		return hl7.ErrorApplicationInternal
+	}
+
+	switch coded.ErrorCode() {
+	case "INVALID_ENTITY", "ENTITY_NOT_FOUND":
+		return hl7.ErrorUnknownKeyIdentifier
+	case "RESOURCE_CONFLICT":
+		return hl7.ErrorDuplicateKeyIdentifier
+	case "INVALID_COMMAND_DATA", "VALIDATION_ERROR", "FIELD_DATA_ERROR":
+		return hl7.ErrorDataTypeError
+	default:
+		return hl7.ErrorApplicationInternal
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,73 @@
+package routes
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/medical/lab/presentation/controller"
+
+	"github.com/gin-gonic/gin"
+)
+
+func LabRoutes(
+	router *gin.RouterGroup,
+	employeeController *controller.EmployeeLabController,
+	customerController *controller.CustomerLabController,
+	interfaceController *controller.LabInterfaceController,
+	authMiddleware *middleware.AuthMiddleware,
+) {
+	// Only veterinarians order and cancel lab work, results are also typed in by the front desk
+	orderingGroup := router.Group("/employees/lab-orders")
+	orderingGroup.Use(authMiddleware.Authenticate())
+	orderingGroup.Use(authMiddleware.RequireAnyRole(enum.UserRoleVeterinarian.String()))
+	{
+		orderingGroup.POST("", employeeController.OrderLabWork)
+		orderingGroup.PUT("/:id/cancel", employeeController.CancelLabOrder)
+	}
+
+	employeeGroup := router.Group("/employees/lab-orders")
+	employeeGroup.Use(authMiddleware.Authenticate())
+	employeeGroup.Use(authMiddleware.RequireAnyRole(
+		enum.UserRoleVeterinarian.String(),
+		enum.UserRoleReceptionist.String(),
+		enum.UserRoleAdmin.String(),
+	))
+	{
+		employeeGroup.GET("/:id", employeeController.GetLabOrder)
+		employeeGroup.PUT("/:id/results", employeeController.RecordLabResults)
+		employeeGroup.GET("/sessions/:id", employeeController.GetSessionLabOrders)
+		employeeGroup.GET("/pets/:id", employeeController.GetPetLabOrders)
+		employeeGroup.GET("/pets/:id/trends/:analyte", employeeController.GetPetLabTrend)
+	}
+
+	// Analyzers and laboratory interfaces post their results with an employee account, usually
+	// the admin one set up for the device
+	interfaceGroup := router.Group("/employees/lab-orders/hl7")
+	interfaceGroup.Use(authMiddleware.Authenticate())
+	interfaceGroup.Use(authMiddleware.RequireAnyRole(
+		enum.UserRoleVeterinarian.String(),
+		enum.UserRoleReceptionist.String(),
+		enum.UserRoleAdmin.String(),
+	))
+	{
+		interfaceGroup.POST("/oru", interfaceController.ReceiveORU)
+	}
+
+	panelGroup := router.Group("/employees/lab-panels")
+	panelGroup.Use(authMiddleware.Authenticate())
+	panelGroup.Use(authMiddleware.RequireAnyRole(
+		enum.UserRoleVeterinarian.String(),
+		enum.UserRoleReceptionist.String(),
+		enum.UserRoleAdmin.String(),
+	))
+	{
+		panelGroup.GET("", employeeController.FindLabPanels)
+	}
+
+	customerGroup := router.Group("/customers/pets")
+	customerGroup.Use(authMiddleware.Authenticate())
+	customerGroup.Use(authMiddleware.RequireAnyRole(enum.UserRoleCustomer.String()))
+	{
+		customerGroup.GET("/:id/lab-orders", customerController.GetMyPetLabOrders)
+		customerGroup.GET("/:id/lab-trends/:analyte", customerController.GetMyPetLabTrend)
+	}
+}
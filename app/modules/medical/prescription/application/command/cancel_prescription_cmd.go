@@ -0,0 +1,27 @@
+package command
+
+import (
+	"strings"
+
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type CancelPrescriptionCommand struct {
+	id     valueobject.PrescriptionID
+	reason string
+}
+
+func NewCancelPrescriptionCommand(id uint, reason string) (CancelPrescriptionCommand, error) {
+	if id == 0 {
+		return CancelPrescriptionCommand{}, cancelCmdErr("id", "is required")
+	}
+
+	if strings.TrimSpace(reason) == "" {
+		return CancelPrescriptionCommand{}, cancelCmdErr("reason", "is required")
+	}
+
+	return CancelPrescriptionCommand{id: valueobject.NewPrescriptionID(id), reason: reason}, nil
+}
+
+func (c CancelPrescriptionCommand) ID() valueobject.PrescriptionID { return c.id }
+func (c CancelPrescriptionCommand) Reason() string                 { return c.reason }
@@ -0,0 +1,17 @@
+package command
+
+import (
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+func prescribeCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "PrescribeMedicationCommand")
+}
+
+func refillCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "RefillPrescriptionCommand")
+}
+
+func cancelCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "CancelPrescriptionCommand")
+}
@@ -0,0 +1,58 @@
+package command
+
+import (
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type PrescribeMedicationCommand struct {
+	sessionID    valueobject.MedSessionID
+	prescribedBy valueobject.EmployeeID
+	order        medical.PrescriptionOrder
+	lotID        *valueobject.StockLotID
+}
+
+func NewPrescribeMedicationCommand(
+	sessionID uint,
+	prescribedBy uint,
+	drugName, strength, dose, route, frequency string,
+	durationDays, quantity, refills int,
+	instructions *string,
+	lotID *uint,
+) (PrescribeMedicationCommand, error) {
+	if sessionID == 0 {
+		return PrescribeMedicationCommand{}, prescribeCmdErr("sessionID", "is required")
+	}
+
+	if prescribedBy == 0 {
+		return PrescribeMedicationCommand{}, prescribeCmdErr("prescribedBy", "is required")
+	}
+
+	routeEnum, err := enum.ParseMedicationRoute(route)
+	if err != nil {
+		return PrescribeMedicationCommand{}, prescribeCmdErr("route", err.Error())
+	}
+
+	return PrescribeMedicationCommand{
+		sessionID:    valueobject.NewMedSessionID(sessionID),
+		prescribedBy: valueobject.NewEmployeeID(prescribedBy),
+		order: medical.PrescriptionOrder{
+			DrugName:     drugName,
+			Strength:     strength,
+			Dose:         dose,
+			Route:        routeEnum,
+			Frequency:    frequency,
+			DurationDays: durationDays,
+			Quantity:     quantity,
+			Refills:      refills,
+			Instructions: instructions,
+		},
+		lotID: valueobject.NewOptStockLotID(lotID),
+	}, nil
+}
+
+func (c PrescribeMedicationCommand) SessionID() valueobject.MedSessionID  { return c.sessionID }
+func (c PrescribeMedicationCommand) PrescribedBy() valueobject.EmployeeID { return c.prescribedBy }
+func (c PrescribeMedicationCommand) Order() medical.PrescriptionOrder     { return c.order }
+func (c PrescribeMedicationCommand) LotID() *valueobject.StockLotID       { return c.lotID }
@@ -0,0 +1,31 @@
+package command
+
+import (
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type RefillPrescriptionCommand struct {
+	id          valueobject.PrescriptionID
+	dispensedBy valueobject.EmployeeID
+	lotID       *valueobject.StockLotID
+}
+
+func NewRefillPrescriptionCommand(id, dispensedBy uint, lotID *uint) (RefillPrescriptionCommand, error) {
+	if id == 0 {
+		return RefillPrescriptionCommand{}, refillCmdErr("id", "is required")
+	}
+
+	if dispensedBy == 0 {
+		return RefillPrescriptionCommand{}, refillCmdErr("dispensedBy", "is required")
+	}
+
+	return RefillPrescriptionCommand{
+		id:          valueobject.NewPrescriptionID(id),
+		dispensedBy: valueobject.NewEmployeeID(dispensedBy),
+		lotID:       valueobject.NewOptStockLotID(lotID),
+	}, nil
+}
+
+func (c RefillPrescriptionCommand) ID() valueobject.PrescriptionID      { return c.id }
+func (c RefillPrescriptionCommand) DispensedBy() valueobject.EmployeeID { return c.dispensedBy }
+func (c RefillPrescriptionCommand) LotID() *valueobject.StockLotID      { return c.lotID }
@@ -0,0 +1,72 @@
+package application
+
+import (
+	"context"
+
+	c "clinic-vet-api/app/modules/medical/prescription/application/command"
+	h "clinic-vet-api/app/modules/medical/prescription/application/handler"
+	q "clinic-vet-api/app/modules/medical/prescription/application/query"
+	"clinic-vet-api/app/shared/cqrs"
+	"clinic-vet-api/app/shared/page"
+)
+
+type PrescriptionFacadeService interface {
+	FindPrescriptionByID(ctx context.Context, qry q.FindPrescriptionByIDQuery) (h.PrescriptionResult, error)
+	FindPrescriptionsBySession(ctx context.Context, qry q.FindPrescriptionsBySessionQuery) ([]h.PrescriptionResult, error)
+	FindPrescriptionsByPet(ctx context.Context, qry q.FindPrescriptionsByPetQuery) (page.Page[h.PrescriptionResult], error)
+	FindActiveMedications(ctx context.Context, qry q.FindActiveMedicationsQuery) ([]h.PrescriptionResult, error)
+	FindFormulary(ctx context.Context, qry q.FindFormularyQuery) ([]h.DrugResult, error)
+	CalculateDose(ctx context.Context, qry q.CalculateDoseQuery) (h.DoseCalculationResult, error)
+
+	PrescribeMedication(ctx context.Context, cmd c.PrescribeMedicationCommand) cqrs.CommandResult
+	RefillPrescription(ctx context.Context, cmd c.RefillPrescriptionCommand) cqrs.CommandResult
+	CancelPrescription(ctx context.Context, cmd c.CancelPrescriptionCommand) cqrs.CommandResult
+}
+
+type prescriptionFacadeService struct {
+	qryHandler *h.PrescriptionQueryHandler
+	cmdHandler *h.PrescriptionCommandHandler
+}
+
+func NewPrescriptionFacadeService(qryHandler *h.PrescriptionQueryHandler, cmdHandler *h.PrescriptionCommandHandler) PrescriptionFacadeService {
+	return &prescriptionFacadeService{
+		qryHandler: qryHandler,
+		cmdHandler: cmdHandler,
+	}
+}
+
+func (s *prescriptionFacadeService) FindPrescriptionByID(ctx context.Context, qry q.FindPrescriptionByIDQuery) (h.PrescriptionResult, error) {
+	return s.qryHandler.HandleFindByID(ctx, qry)
+}
+
+func (s *prescriptionFacadeService) FindPrescriptionsBySession(ctx context.Context, qry q.FindPrescriptionsBySessionQuery) ([]h.PrescriptionResult, error) {
+	return s.qryHandler.HandleFindBySession(ctx, qry)
+}
+
+func (s *prescriptionFacadeService) FindPrescriptionsByPet(ctx context.Context, qry q.FindPrescriptionsByPetQuery) (page.Page[h.PrescriptionResult], error) {
+	return s.qryHandler.HandleFindByPet(ctx, qry)
+}
+
+func (s *prescriptionFacadeService) FindActiveMedications(ctx context.Context, qry q.FindActiveMedicationsQuery) ([]h.PrescriptionResult, error) {
+	return s.qryHandler.HandleFindActiveMedications(ctx, qry)
+}
+
+func (s *prescriptionFacadeService) FindFormulary(ctx context.Context, qry q.FindFormularyQuery) ([]h.DrugResult, error) {
+	return s.qryHandler.HandleFindFormulary(ctx, qry)
+}
+
+func (s *prescriptionFacadeService) CalculateDose(ctx context.Context, qry q.CalculateDoseQuery) (h.DoseCalculationResult, error) {
+	return s.qryHandler.HandleCalculateDose(ctx, qry)
+}
+
+func (s *prescriptionFacadeService) PrescribeMedication(ctx context.Context, cmd c.PrescribeMedicationCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandlePrescribe(ctx, cmd)
+}
+
+func (s *prescriptionFacadeService) RefillPrescription(ctx context.Context, cmd c.RefillPrescriptionCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleRefill(ctx, cmd)
+}
+
+func (s *prescriptionFacadeService) CancelPrescription(ctx context.Context, cmd c.CancelPrescriptionCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleCancel(ctx, cmd)
+}
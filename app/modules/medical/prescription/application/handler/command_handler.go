@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/inventory"
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
+	"clinic-vet-api/app/modules/medical/prescription/application/command"
+	"clinic-vet-api/app/shared/cqrs"
+)
+
+var (
+	FailFindSessionMsg            = "failed to find medical session"
+	FailFindPrescriptionMsg       = "failed to find prescription"
+	FailValidatePrescriptionMsg   = "prescription validation failed"
+	FailSavePrescriptionMsg       = "failed to save prescription"
+	FailMedicationLotMsg          = "medication lot cannot be dispensed"
+	SuccessPrescriptionCreatedMsg = "prescription created successfully"
+	SuccessPrescriptionRefillMsg  = "prescription refilled successfully"
+	SuccessPrescriptionCancelMsg  = "prescription cancelled successfully"
+)
+
+type PrescriptionCommandHandler struct {
+	prescriptionRepo repository.PrescriptionRepository
+	sessionRepo      repository.MedicalSessionRepository
+	stockService     *service.StockService
+}
+
+func NewPrescriptionCommandHandler(
+	prescriptionRepo repository.PrescriptionRepository,
+	sessionRepo repository.MedicalSessionRepository,
+	stockService *service.StockService,
+) *PrescriptionCommandHandler {
+	return &PrescriptionCommandHandler{
+		prescriptionRepo: prescriptionRepo,
+		sessionRepo:      sessionRepo,
+		stockService:     stockService,
+	}
+}
+
+// HandlePrescribe issues the prescription for the pet seen in the session, the first fill is
+// dispensed with it, out of the stock lot when one is given
+func (h *PrescriptionCommandHandler) HandlePrescribe(ctx context.Context, cmd command.PrescribeMedicationCommand) cqrs.CommandResult {
+	now := time.Now()
+
+	session, err := h.sessionRepo.FindByID(ctx, cmd.SessionID())
+	if err != nil {
+		return cqrs.FailureResult(FailFindSessionMsg, err)
+	}
+
+	prescription, err := medical.Prescribe(ctx, *session, cmd.PrescribedBy(), cmd.Order(), now)
+	if err != nil {
+		return cqrs.FailureResult(FailValidatePrescriptionMsg, err)
+	}
+
+	if err := h.checkLot(ctx, cmd.LotID(), prescription.Quantity(), now); err != nil {
+		return cqrs.FailureResult(FailMedicationLotMsg, err)
+	}
+
+	if err := h.savePrescription(ctx, prescription, fillOf(cmd.LotID(), prescription, cmd.PrescribedBy(), now)); err != nil {
+		return cqrs.FailureResult(FailSavePrescriptionMsg, err)
+	}
+
+	return cqrs.SuccessCreateResult(prescription.ID().String(), SuccessPrescriptionCreatedMsg)
+}
+
+func (h *PrescriptionCommandHandler) HandleRefill(ctx context.Context, cmd command.RefillPrescriptionCommand) cqrs.CommandResult {
+	prescription, err := h.prescriptionRepo.FindByID(ctx, cmd.ID())
+	if err != nil {
+		return cqrs.FailureResult(FailFindPrescriptionMsg, err)
+	}
+
+	now := time.Now()
+	if err := prescription.Refill(ctx, now); err != nil {
+		return cqrs.FailureResult(FailValidatePrescriptionMsg, err)
+	}
+
+	if err := h.checkLot(ctx, cmd.LotID(), prescription.Quantity(), now); err != nil {
+		return cqrs.FailureResult(FailMedicationLotMsg, err)
+	}
+
+	refilled, err := h.prescriptionRepo.Refill(ctx, &prescription, fillOf(cmd.LotID(), &prescription, cmd.DispensedBy(), now))
+	if err != nil {
+		return cqrs.FailureResult(FailSavePrescriptionMsg, err)
+	}
+	if !refilled {
+		return cqrs.FailureResult(FailValidatePrescriptionMsg, medical.RefillTakenError(ctx))
+	}
+
+	return cqrs.SuccessResult(SuccessPrescriptionRefillMsg)
+}
+
+func (h *PrescriptionCommandHandler) HandleCancel(ctx context.Context, cmd command.CancelPrescriptionCommand) cqrs.CommandResult {
+	prescription, err := h.prescriptionRepo.FindByID(ctx, cmd.ID())
+	if err != nil {
+		return cqrs.FailureResult(FailFindPrescriptionMsg, err)
+	}
+
+	if err := prescription.Cancel(ctx, cmd.Reason(), time.Now()); err != nil {
+		return cqrs.FailureResult(FailValidatePrescriptionMsg, err)
+	}
+
+	if err := h.prescriptionRepo.Save(ctx, &prescription); err != nil {
+		return cqrs.FailureResult(FailSavePrescriptionMsg, err)
+	}
+
+	return cqrs.SuccessResult(SuccessPrescriptionCancelMsg)
+}
+
+func (h *PrescriptionCommandHandler) checkLot(ctx context.Context, lotID *valueobject.StockLotID, quantity int, now time.Time) error {
+	if lotID == nil {
+		return nil
+	}
+
+	_, err := h.stockService.CheckDispensable(ctx, *lotID, quantity, now, enum.ProductCategoryMedication)
+	return err
+}
+
+// savePrescription creates the prescription, along with its first fill taken out of stock when
+// it is dispensed from a lot
+func (h *PrescriptionCommandHandler) savePrescription(
+	ctx context.Context,
+	prescription *medical.Prescription,
+	fill *inventory.Dispensing,
+) error {
+	if fill == nil {
+		return h.prescriptionRepo.Save(ctx, prescription)
+	}
+	return h.prescriptionRepo.SaveDispensed(ctx, prescription, *fill)
+}
+
+// fillOf is the stock a fill of the prescription takes out of the lot, nil when it is not
+// dispensed from stock
+func fillOf(
+	lotID *valueobject.StockLotID,
+	prescription *medical.Prescription,
+	dispensedBy valueobject.EmployeeID,
+	now time.Time,
+) *inventory.Dispensing {
+	if lotID == nil {
+		return nil
+	}
+
+	return &inventory.Dispensing{
+		LotID:       *lotID,
+		Quantity:    prescription.Quantity(),
+		DispensedBy: dispensedBy,
+		At:          now,
+	}
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"context"
+
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/medical/prescription/application/query"
+)
+
+func (h *PrescriptionQueryHandler) HandleFindFormulary(ctx context.Context, qry query.FindFormularyQuery) ([]DrugResult, error) {
+	var drugs []valueobject.DrugDefinition
+	if qry.Species() != nil {
+		drugs = h.formulary.GetDrugsForSpecies(qry.Species().String())
+	} else {
+		drugs = h.formulary.GetDrugs()
+	}
+
+	results := make([]DrugResult, len(drugs))
+	for i, drug := range drugs {
+		results[i] = toDrugResult(drug)
+	}
+	return results, nil
+}
+
+// HandleCalculateDose works the dose out from the weight recorded in the latest sessions of the
+// pet, a dose outside the formulary range comes back as a warning
+func (h *PrescriptionQueryHandler) HandleCalculateDose(ctx context.Context, qry query.CalculateDoseQuery) (DoseCalculationResult, error) {
+	calculation, err := h.doseCalculator.Calculate(ctx, qry.PetID(), qry.DrugName(), qry.DoseMg())
+	if err != nil {
+		return DoseCalculationResult{}, err
+	}
+
+	return toDoseCalculationResult(qry.PetID(), calculation), nil
+}
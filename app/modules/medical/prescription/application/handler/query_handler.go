@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
+	"clinic-vet-api/app/modules/medical/prescription/application/query"
+	"clinic-vet-api/app/shared/page"
+)
+
+type PrescriptionQueryHandler struct {
+	prescriptionRepo repository.PrescriptionRepository
+	petRepo          repository.PetRepository
+	formulary        *service.DrugFormulary
+	doseCalculator   *service.DoseCalculatorService
+}
+
+func NewPrescriptionQueryHandler(
+	prescriptionRepo repository.PrescriptionRepository,
+	petRepo repository.PetRepository,
+	formulary *service.DrugFormulary,
+	doseCalculator *service.DoseCalculatorService,
+) *PrescriptionQueryHandler {
+	return &PrescriptionQueryHandler{
+		prescriptionRepo: prescriptionRepo,
+		petRepo:          petRepo,
+		formulary:        formulary,
+		doseCalculator:   doseCalculator,
+	}
+}
+
+func (h *PrescriptionQueryHandler) HandleFindByID(ctx context.Context, qry query.FindPrescriptionByIDQuery) (PrescriptionResult, error) {
+	prescription, err := h.prescriptionRepo.FindByID(ctx, qry.ID())
+	if err != nil {
+		return PrescriptionResult{}, err
+	}
+
+	return toPrescriptionResult(prescription, time.Now()), nil
+}
+
+func (h *PrescriptionQueryHandler) HandleFindBySession(ctx context.Context, qry query.FindPrescriptionsBySessionQuery) ([]PrescriptionResult, error) {
+	prescriptions, err := h.prescriptionRepo.FindBySession(ctx, qry.SessionID())
+	if err != nil {
+		return nil, err
+	}
+
+	return toPrescriptionResults(prescriptions, time.Now()), nil
+}
+
+func (h *PrescriptionQueryHandler) HandleFindByPet(ctx context.Context, qry query.FindPrescriptionsByPetQuery) (page.Page[PrescriptionResult], error) {
+	prescriptionPage, err := h.prescriptionRepo.FindByPet(ctx, qry.PetID(), qry.Pagination())
+	if err != nil {
+		return page.Page[PrescriptionResult]{}, err
+	}
+
+	now := time.Now()
+	return page.MapItems(prescriptionPage, func(prescription medical.Prescription) PrescriptionResult {
+		return toPrescriptionResult(prescription, now)
+	}), nil
+}
+
+// HandleFindActiveMedications derives the medications of the pet from its prescriptions, a
+// prescription counts while the course of its last fill lasts and it was not cancelled
+func (h *PrescriptionQueryHandler) HandleFindActiveMedications(ctx context.Context, qry query.FindActiveMedicationsQuery) ([]PrescriptionResult, error) {
+	if qry.CustomerID() != nil {
+		if _, err := h.petRepo.FindByIDAndCustomerID(ctx, qry.PetID(), *qry.CustomerID()); err != nil {
+			return nil, err
+		}
+	} else if _, err := h.petRepo.FindByID(ctx, qry.PetID()); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	prescriptions, err := h.prescriptionRepo.FindActiveByPet(ctx, qry.PetID(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPrescriptionResults(prescriptions, now), nil
+}
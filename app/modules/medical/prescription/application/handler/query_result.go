@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/service"
+)
+
+type PrescriptionResult struct {
+	ID                uint
+	SessionID         uint
+	PetID             uint
+	PrescribedBy      uint
+	DrugName          string
+	Strength          string
+	Dose              string
+	Route             string
+	Frequency         string
+	DurationDays      int
+	Quantity          int
+	RefillsAuthorized int
+	RefillsRemaining  int
+	Instructions      *string
+	Status            string
+	IsActive          bool
+	PrescribedAt      time.Time
+	LastFilledAt      time.Time
+	CourseEndsAt      time.Time
+	ExpiresAt         time.Time
+	CancelledAt       *time.Time
+	CancelReason      *string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func toPrescriptionResult(prescription medical.Prescription, now time.Time) PrescriptionResult {
+	return PrescriptionResult{
+		ID:                prescription.ID().Value(),
+		SessionID:         prescription.SessionID().Value(),
+		PetID:             prescription.PetID().Value(),
+		PrescribedBy:      prescription.PrescribedBy().Value(),
+		DrugName:          prescription.DrugName(),
+		Strength:          prescription.Strength(),
+		Dose:              prescription.Dose(),
+		Route:             prescription.Route().String(),
+		Frequency:         prescription.Frequency(),
+		DurationDays:      prescription.DurationDays(),
+		Quantity:          prescription.Quantity(),
+		RefillsAuthorized: prescription.RefillsAuthorized(),
+		RefillsRemaining:  prescription.RefillsRemaining(),
+		Instructions:      prescription.Instructions(),
+		Status:            prescription.Status().String(),
+		IsActive:          prescription.IsActiveAt(now),
+		PrescribedAt:      prescription.PrescribedAt(),
+		LastFilledAt:      prescription.LastFilledAt(),
+		CourseEndsAt:      prescription.CourseEndsAt(),
+		ExpiresAt:         prescription.ExpiresAt(),
+		CancelledAt:       prescription.CancelledAt(),
+		CancelReason:      prescription.CancelReason(),
+		CreatedAt:         prescription.CreatedAt(),
+		UpdatedAt:         prescription.UpdatedAt(),
+	}
+}
+
+func toPrescriptionResults(prescriptions []medical.Prescription, now time.Time) []PrescriptionResult {
+	results := make([]PrescriptionResult, len(prescriptions))
+	for i, prescription := range prescriptions {
+		results[i] = toPrescriptionResult(prescription, now)
+	}
+	return results
+}
+
+type DoseRangeResult struct {
+	Species    string
+	MinMgPerKg float64
+	MaxMgPerKg float64
+	MaxDoseMg  *float64
+}
+
+type DrugResult struct {
+	Name                   string
+	DrugClass              string
+	Description            string
+	DoseRanges             []DoseRangeResult
+	Contraindications      []string
+	ContraindicatedSpecies []string
+}
+
+type DoseWarningResult struct {
+	Code    string
+	Message string
+}
+
+type DoseCalculationResult struct {
+	PetID             uint
+	Species           string
+	Drug              DrugResult
+	WeightKg          *float64
+	WeighedAt         *time.Time
+	DoseRange         *DoseRangeResult
+	RecommendedMinMg  *float64
+	RecommendedMaxMg  *float64
+	PrescribedDoseMg  *float64
+	PrescribedMgPerKg *float64
+	Warnings          []DoseWarningResult
+}
+
+func toDoseRangeResult(doseRange valueobject.DoseRange) DoseRangeResult {
+	return DoseRangeResult{
+		Species:    doseRange.Species(),
+		MinMgPerKg: doseRange.MinMgPerKg(),
+		MaxMgPerKg: doseRange.MaxMgPerKg(),
+		MaxDoseMg:  doseRange.MaxDoseMg(),
+	}
+}
+
+func toDrugResult(drug valueobject.DrugDefinition) DrugResult {
+	doseRanges := make([]DoseRangeResult, len(drug.DoseRanges()))
+	for i, doseRange := range drug.DoseRanges() {
+		doseRanges[i] = toDoseRangeResult(doseRange)
+	}
+
+	return DrugResult{
+		Name:                   drug.Name(),
+		DrugClass:              drug.DrugClass(),
+		Description:            drug.Description(),
+		DoseRanges:             doseRanges,
+		Contraindications:      drug.Contraindications(),
+		ContraindicatedSpecies: drug.ContraindicatedSpecies(),
+	}
+}
+
+func toDoseCalculationResult(petID valueobject.PetID, calculation service.DoseCalculation) DoseCalculationResult {
+	result := DoseCalculationResult{
+		PetID:             petID.Value(),
+		Species:           calculation.Species.String(),
+		Drug:              toDrugResult(calculation.Drug),
+		WeightKg:          calculation.WeightKg,
+		WeighedAt:         calculation.WeighedAt,
+		RecommendedMinMg:  calculation.RecommendedMinMg,
+		RecommendedMaxMg:  calculation.RecommendedMaxMg,
+		PrescribedDoseMg:  calculation.PrescribedDoseMg,
+		PrescribedMgPerKg: calculation.PrescribedMgPerKg,
+		Warnings:          make([]DoseWarningResult, len(calculation.Warnings)),
+	}
+
+	if calculation.DoseRange != nil {
+		doseRange := toDoseRangeResult(*calculation.DoseRange)
+		result.DoseRange = &doseRange
+	}
+
+	for i, warning := range calculation.Warnings {
+		result.Warnings[i] = DoseWarningResult{Code: string(warning.Code), Message: warning.Message}
+	}
+	return result
+}
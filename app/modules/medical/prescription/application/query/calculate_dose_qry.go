@@ -0,0 +1,36 @@
+package query
+
+import (
+	"strings"
+
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+// CalculateDoseQuery asks for the dose of a drug for a pet, optionally checking the dose in mg the
+// veterinarian means to prescribe
+type CalculateDoseQuery struct {
+	petID    valueobject.PetID
+	drugName string
+	doseMg   *float64
+}
+
+func NewCalculateDoseQuery(petID uint, drugName string, doseMg *float64) (CalculateDoseQuery, error) {
+	if petID == 0 {
+		return CalculateDoseQuery{}, apperror.FieldValidationError("pet_id", "", "pet ID is required")
+	}
+
+	if strings.TrimSpace(drugName) == "" {
+		return CalculateDoseQuery{}, apperror.FieldValidationError("drug", "", "drug is required")
+	}
+
+	if doseMg != nil && *doseMg <= 0 {
+		return CalculateDoseQuery{}, apperror.FieldValidationError("dose_mg", "", "dose must be greater than zero")
+	}
+
+	return CalculateDoseQuery{petID: valueobject.NewPetID(petID), drugName: drugName, doseMg: doseMg}, nil
+}
+
+func (q CalculateDoseQuery) PetID() valueobject.PetID { return q.petID }
+func (q CalculateDoseQuery) DrugName() string         { return q.drugName }
+func (q CalculateDoseQuery) DoseMg() *float64         { return q.doseMg }
@@ -0,0 +1,27 @@
+package query
+
+import (
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+// FindActiveMedicationsQuery lists the medications the pet is on right now. When a customer asks,
+// the pet has to be theirs
+type FindActiveMedicationsQuery struct {
+	petID      valueobject.PetID
+	customerID *valueobject.CustomerID
+}
+
+func NewFindActiveMedicationsQuery(petID uint, customerID *uint) (FindActiveMedicationsQuery, error) {
+	if petID == 0 {
+		return FindActiveMedicationsQuery{}, apperror.FieldValidationError("id", "", "pet ID is required")
+	}
+
+	return FindActiveMedicationsQuery{
+		petID:      valueobject.NewPetID(petID),
+		customerID: valueobject.NewOptCustomerID(customerID),
+	}, nil
+}
+
+func (q FindActiveMedicationsQuery) PetID() valueobject.PetID            { return q.petID }
+func (q FindActiveMedicationsQuery) CustomerID() *valueobject.CustomerID { return q.customerID }
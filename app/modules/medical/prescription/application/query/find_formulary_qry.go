@@ -0,0 +1,26 @@
+package query
+
+import (
+	"clinic-vet-api/app/modules/core/domain/enum"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+// FindFormularyQuery lists the drugs of the formulary, only those that can be given to the species
+// when one is given
+type FindFormularyQuery struct {
+	species *enum.PetSpecies
+}
+
+func NewFindFormularyQuery(species string) (FindFormularyQuery, error) {
+	if species == "" {
+		return FindFormularyQuery{}, nil
+	}
+
+	parsedSpecies, err := enum.ParsePetSpecies(species)
+	if err != nil {
+		return FindFormularyQuery{}, apperror.FieldValidationError("species", species, err.Error())
+	}
+	return FindFormularyQuery{species: &parsedSpecies}, nil
+}
+
+func (q FindFormularyQuery) Species() *enum.PetSpecies { return q.species }
@@ -0,0 +1,20 @@
+package query
+
+import (
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+type FindPrescriptionByIDQuery struct {
+	id valueobject.PrescriptionID
+}
+
+func NewFindPrescriptionByIDQuery(id uint) (FindPrescriptionByIDQuery, error) {
+	if id == 0 {
+		return FindPrescriptionByIDQuery{}, apperror.FieldValidationError("id", "", "prescription ID is required")
+	}
+
+	return FindPrescriptionByIDQuery{id: valueobject.NewPrescriptionID(id)}, nil
+}
+
+func (q FindPrescriptionByIDQuery) ID() valueobject.PrescriptionID { return q.id }
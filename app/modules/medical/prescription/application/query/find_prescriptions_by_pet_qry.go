@@ -0,0 +1,23 @@
+package query
+
+import (
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+	"clinic-vet-api/app/shared/page"
+)
+
+type FindPrescriptionsByPetQuery struct {
+	petID      valueobject.PetID
+	pagination page.PaginationRequest
+}
+
+func NewFindPrescriptionsByPetQuery(petID uint, pagination page.PaginationRequest) (FindPrescriptionsByPetQuery, error) {
+	if petID == 0 {
+		return FindPrescriptionsByPetQuery{}, apperror.FieldValidationError("id", "", "pet ID is required")
+	}
+
+	return FindPrescriptionsByPetQuery{petID: valueobject.NewPetID(petID), pagination: pagination}, nil
+}
+
+func (q FindPrescriptionsByPetQuery) PetID() valueobject.PetID           { return q.petID }
+func (q FindPrescriptionsByPetQuery) Pagination() page.PaginationRequest { return q.pagination }
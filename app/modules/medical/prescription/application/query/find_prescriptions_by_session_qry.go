@@ -0,0 +1,20 @@
+package query
+
+import (
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+type FindPrescriptionsBySessionQuery struct {
+	sessionID valueobject.MedSessionID
+}
+
+func NewFindPrescriptionsBySessionQuery(sessionID uint) (FindPrescriptionsBySessionQuery, error) {
+	if sessionID == 0 {
+		return FindPrescriptionsBySessionQuery{}, apperror.FieldValidationError("id", "", "medical session ID is required")
+	}
+
+	return FindPrescriptionsBySessionQuery{sessionID: valueobject.NewMedSessionID(sessionID)}, nil
+}
+
+func (q FindPrescriptionsBySessionQuery) SessionID() valueobject.MedSessionID { return q.sessionID }
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"fmt"
+
+	dberr "clinic-vet-api/app/shared/error/infrastructure/database"
+)
+
+const (
+	TablePrescriptions = "prescriptions"
+	OpSelect           = "select"
+	OpInsert           = "insert"
+	OpUpdate           = "update"
+	OpCount            = "count"
+	DriverSQL          = "sqlc"
+
+	ErrMsgGetPrescription    = "failed to get prescription"
+	ErrMsgListPrescriptions  = "failed to list prescriptions"
+	ErrMsgCountPrescriptions = "failed to count prescriptions"
+	ErrMsgCreatePrescription = "failed to create prescription"
+	ErrMsgUpdatePrescription = "failed to update prescription"
+	ErrMsgRefillPrescription = "failed to refill prescription"
+)
+
+func (r *SqlcPrescriptionRepository) dbError(operation, message string, err error) error {
+	return dberr.DatabaseOperationError(operation, TablePrescriptions, DriverSQL, fmt.Errorf("%s: %v", message, err))
+}
+
+func (r *SqlcPrescriptionRepository) notFoundError(parameterName, parameterValue string) error {
+	return dberr.EntityNotFoundError(parameterName, parameterValue, OpSelect, TablePrescriptions, DriverSQL)
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/sqlc"
+)
+
+func (r *SqlcPrescriptionRepository) toEntity(row sqlc.Prescription) medical.Prescription {
+	return *medical.NewPrescriptionBuilder().
+		WithID(valueobject.NewPrescriptionID(uint(row.ID))).
+		WithSessionID(valueobject.NewMedSessionID(uint(row.MedicalSessionID))).
+		WithPetID(valueobject.NewPetID(uint(row.PetID))).
+		WithPrescribedBy(valueobject.NewEmployeeID(uint(row.PrescribedBy))).
+		WithOrder(medical.PrescriptionOrder{
+			DrugName:     row.DrugName,
+			Strength:     row.Strength,
+			Dose:         row.Dose,
+			Route:        enum.MedicationRoute(row.Route),
+			Frequency:    row.Frequency,
+			DurationDays: int(row.DurationDays),
+			Quantity:     int(row.Quantity),
+			Refills:      int(row.RefillsAuthorized),
+			Instructions: r.pgMap.PgText.ToStringPtr(row.Instructions),
+		}).
+		WithRefillsRemaining(int(row.RefillsRemaining)).
+		WithStatus(enum.PrescriptionStatus(row.Status)).
+		WithFills(row.PrescribedAt.Time, row.LastFilledAt.Time).
+		WithCancellation(
+			r.pgMap.PgTimestamptz.ToTimePtr(row.CancelledAt),
+			r.pgMap.PgText.ToStringPtr(row.CancelReason),
+		).
+		WithTimestamps(row.CreatedAt.Time, row.UpdatedAt.Time).
+		Build()
+}
+
+func (r *SqlcPrescriptionRepository) toEntities(rows []sqlc.Prescription) []medical.Prescription {
+	prescriptions := make([]medical.Prescription, len(rows))
+	for i, row := range rows {
+		prescriptions[i] = r.toEntity(row)
+	}
+	return prescriptions
+}
@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/inventory"
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	inventoryRepo "clinic-vet-api/app/modules/inventory/infrastructure/repository"
+	"clinic-vet-api/app/shared/database"
+	"clinic-vet-api/app/shared/mapper"
+	p "clinic-vet-api/app/shared/page"
+	"clinic-vet-api/sqlc"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// errRefillNotTaken rolls back a refill that lost the race for the last refills
+var errRefillNotTaken = errors.New("prescription refill was not taken")
+
+type SqlcPrescriptionRepository struct {
+	queries    *sqlc.Queries
+	transactor *database.Transactor
+	pgMap      *mapper.SqlcFieldMapper
+}
+
+func NewSqlcPrescriptionRepository(
+	queries *sqlc.Queries,
+	transactor *database.Transactor,
+	pgMap *mapper.SqlcFieldMapper,
+) repository.PrescriptionRepository {
+	return &SqlcPrescriptionRepository{queries: queries, transactor: transactor, pgMap: pgMap}
+}
+
+func (r *SqlcPrescriptionRepository) FindByID(ctx context.Context, id valueobject.PrescriptionID) (medical.Prescription, error) {
+	row, err := r.queries.FindPrescriptionByID(ctx, id.Int32())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return medical.Prescription{}, r.notFoundError("id", id.String())
+		}
+		return medical.Prescription{}, r.dbError(OpSelect, ErrMsgGetPrescription, err)
+	}
+
+	return r.toEntity(row), nil
+}
+
+func (r *SqlcPrescriptionRepository) FindBySession(ctx context.Context, sessionID valueobject.MedSessionID) ([]medical.Prescription, error) {
+	rows, err := r.queries.FindPrescriptionsBySession(ctx, sessionID.Int32())
+	if err != nil {
+		return nil, r.dbError(OpSelect, ErrMsgListPrescriptions, err)
+	}
+
+	return r.toEntities(rows), nil
+}
+
+func (r *SqlcPrescriptionRepository) FindByPet(
+	ctx context.Context,
+	petID valueobject.PetID,
+	pagination p.PaginationRequest,
+) (p.Page[medical.Prescription], error) {
+	rows, err := r.queries.FindPrescriptionsByPet(ctx, sqlc.FindPrescriptionsByPetParams{
+		PetID:  petID.Int32(),
+		Limit:  pagination.Limit(),
+		Offset: pagination.Offset(),
+	})
+	if err != nil {
+		return p.Page[medical.Prescription]{}, r.dbError(OpSelect, ErrMsgListPrescriptions, err)
+	}
+
+	total, err := r.queries.CountPrescriptionsByPet(ctx, petID.Int32())
+	if err != nil {
+		return p.Page[medical.Prescription]{}, r.dbError(OpCount, ErrMsgCountPrescriptions, err)
+	}
+
+	return p.NewPage(r.toEntities(rows), total, pagination), nil
+}
+
+func (r *SqlcPrescriptionRepository) FindActiveByPet(ctx context.Context, petID valueobject.PetID, at time.Time) ([]medical.Prescription, error) {
+	rows, err := r.queries.FindActivePrescriptionsByPet(ctx, sqlc.FindActivePrescriptionsByPetParams{
+		PetID: petID.Int32(),
+		At:    r.pgMap.PgTimestamptz.FromTime(at),
+	})
+	if err != nil {
+		return nil, r.dbError(OpSelect, ErrMsgListPrescriptions, err)
+	}
+
+	return r.toEntities(rows), nil
+}
+
+func (r *SqlcPrescriptionRepository) Save(ctx context.Context, prescription *medical.Prescription) error {
+	if prescription.ID().IsZero() {
+		row, err := r.create(ctx, r.queries, prescription)
+		if err != nil {
+			return err
+		}
+		*prescription = r.toEntity(row)
+		return nil
+	}
+
+	err := r.queries.UpdatePrescriptionFills(ctx, sqlc.UpdatePrescriptionFillsParams{
+		ID:               prescription.ID().Int32(),
+		RefillsRemaining: int32(prescription.RefillsRemaining()),
+		Status:           prescription.Status().String(),
+		LastFilledAt:     r.pgMap.PgTimestamptz.FromTime(prescription.LastFilledAt()),
+		CancelledAt:      r.pgMap.PgTimestamptz.FromTimePtr(prescription.CancelledAt()),
+		CancelReason:     r.pgMap.PgText.FromStringPtr(prescription.CancelReason()),
+	})
+	if err != nil {
+		return r.dbError(OpUpdate, ErrMsgUpdatePrescription, err)
+	}
+	return nil
+}
+
+func (r *SqlcPrescriptionRepository) SaveDispensed(
+	ctx context.Context,
+	prescription *medical.Prescription,
+	dispensing inventory.Dispensing,
+) error {
+	var created sqlc.Prescription
+	err := r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+		_, err := inventoryRepo.DispenseWithin(ctx, queries, dispensing, func() (inventory.StockReference, error) {
+			row, err := r.create(ctx, queries, prescription)
+			if err != nil {
+				return inventory.StockReference{}, err
+			}
+			created = row
+			return inventory.StockReference{Type: enum.StockReferencePrescription, ID: uint(row.ID)}, nil
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	*prescription = r.toEntity(created)
+	return nil
+}
+
+func (r *SqlcPrescriptionRepository) Refill(
+	ctx context.Context,
+	prescription *medical.Prescription,
+	dispensing *inventory.Dispensing,
+) (bool, error) {
+	var err error
+	if dispensing == nil {
+		err = r.refill(ctx, r.queries, prescription)
+	} else {
+		err = r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+			_, err := inventoryRepo.DispenseWithin(ctx, queries, *dispensing, func() (inventory.StockReference, error) {
+				if err := r.refill(ctx, queries, prescription); err != nil {
+					return inventory.StockReference{}, err
+				}
+				return inventory.StockReference{Type: enum.StockReferencePrescription, ID: prescription.ID().Value()}, nil
+			})
+			return err
+		})
+	}
+
+	if errors.Is(err, errRefillNotTaken) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *SqlcPrescriptionRepository) create(ctx context.Context, queries *sqlc.Queries, prescription *medical.Prescription) (sqlc.Prescription, error) {
+	row, err := queries.CreatePrescription(ctx, sqlc.CreatePrescriptionParams{
+		MedicalSessionID:  prescription.SessionID().Int32(),
+		PetID:             prescription.PetID().Int32(),
+		PrescribedBy:      prescription.PrescribedBy().Int32(),
+		DrugName:          prescription.DrugName(),
+		Strength:          prescription.Strength(),
+		Dose:              prescription.Dose(),
+		Route:             prescription.Route().String(),
+		Frequency:         prescription.Frequency(),
+		DurationDays:      int32(prescription.DurationDays()),
+		Quantity:          int32(prescription.Quantity()),
+		RefillsAuthorized: int32(prescription.RefillsAuthorized()),
+		RefillsRemaining:  int32(prescription.RefillsRemaining()),
+		Instructions:      r.pgMap.PgText.FromStringPtr(prescription.Instructions()),
+		Status:            prescription.Status().String(),
+		PrescribedAt:      r.pgMap.PgTimestamptz.FromTime(prescription.PrescribedAt()),
+		LastFilledAt:      r.pgMap.PgTimestamptz.FromTime(prescription.LastFilledAt()),
+	})
+	if err != nil {
+		return sqlc.Prescription{}, r.dbError(OpInsert, ErrMsgCreatePrescription, err)
+	}
+	return row, nil
+}
+
+func (r *SqlcPrescriptionRepository) refill(ctx context.Context, queries *sqlc.Queries, prescription *medical.Prescription) error {
+	rowsAffected, err := queries.RefillPrescription(ctx, sqlc.RefillPrescriptionParams{
+		ID:           prescription.ID().Int32(),
+		LastFilledAt: r.pgMap.PgTimestamptz.FromTime(prescription.LastFilledAt()),
+	})
+	if err != nil {
+		return r.dbError(OpUpdate, ErrMsgRefillPrescription, err)
+	}
+
+	if rowsAffected == 0 {
+		return errRefillNotTaken
+	}
+	return nil
+}
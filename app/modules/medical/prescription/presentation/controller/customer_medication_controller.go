@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/medical/prescription/application"
+	"clinic-vet-api/app/modules/medical/prescription/application/query"
+	"clinic-vet-api/app/modules/medical/prescription/presentation/dto"
+	autherror "clinic-vet-api/app/shared/error/auth"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+	ginutils "clinic-vet-api/app/shared/gin_utils"
+	"clinic-vet-api/app/shared/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CustomerMedicationController struct {
+	prescriptionService application.PrescriptionFacadeService
+}
+
+func NewCustomerMedicationController(prescriptionService application.PrescriptionFacadeService) *CustomerMedicationController {
+	return &CustomerMedicationController{prescriptionService: prescriptionService}
+}
+
+// GetMyPetMedications godoc
+// @Summary List the medications of my pet
+// @Description Returns the medications the pet of the authenticated customer is on right now, taken from its active prescriptions
+// @Tags customer-pet-medications
+// @Produce json
+// @Param id path int true "Pet ID"
+// @Success 200 {object} response.APIResponse{data=[]dto.MedicationResponse}
+// @Failure 400 {object} response.APIResponse "Invalid pet ID"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 404 {object} response.APIResponse "Pet not found"
+// @Router /customers/pets/{id}/medications [get]
+// @Security BearerAuth
+func (ctrl *CustomerMedicationController) GetMyPetMedications(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, autherror.UnauthorizedCTXError())
+		return
+	}
+
+	petID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	qry, err := query.NewFindActiveMedicationsQuery(petID, &user.CustomerID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	results, err := ctrl.prescriptionService.FindActiveMedications(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromMedicationResults(results), "Pet Medications")
+}
@@ -0,0 +1,304 @@
+package controller
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/medical/prescription/application"
+	"clinic-vet-api/app/modules/medical/prescription/application/query"
+	"clinic-vet-api/app/modules/medical/prescription/presentation/dto"
+	autherror "clinic-vet-api/app/shared/error/auth"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+	ginutils "clinic-vet-api/app/shared/gin_utils"
+	"clinic-vet-api/app/shared/page"
+	"clinic-vet-api/app/shared/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type EmployeePrescriptionController struct {
+	prescriptionService application.PrescriptionFacadeService
+	validator           *validator.Validate
+}
+
+func NewEmployeePrescriptionController(
+	prescriptionService application.PrescriptionFacadeService,
+	validator *validator.Validate,
+) *EmployeePrescriptionController {
+	return &EmployeePrescriptionController{
+		prescriptionService: prescriptionService,
+		validator:           validator,
+	}
+}
+
+// PrescribeMedication godoc
+// @Summary Prescribe a medication
+// @Description Prescribes a drug to the pet seen in the medical session, signed by the authenticated veterinarian. The first fill is dispensed with the prescription, out of the stock lot when one is given
+// @Tags employee-prescriptions
+// @Accept json
+// @Produce json
+// @Param request body dto.PrescribeMedicationRequest true "Prescription"
+// @Success 201 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse "Invalid input data"
+// @Failure 401 {object} response.APIResponse "Unauthorized"
+// @Failure 404 {object} response.APIResponse "Medical session not found"
+// @Router /employees/prescriptions [post]
+// @Security BearerAuth
+func (ctrl *EmployeePrescriptionController) PrescribeMedication(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, autherror.UnauthorizedCTXError())
+		return
+	}
+
+	var req dto.PrescribeMedicationRequest
+	if err := ginutils.ShouldBindAndValidateBody(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	cmd, err := req.ToCommand(user.EmployeeID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.prescriptionService.PrescribeMedication(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Created(c, result.ID(), "Prescription")
+}
+
+// RefillPrescription godoc
+// @Summary Refill a prescription
+// @Description Dispenses the prescription again and starts a new course, out of the stock lot when one is given. Cancelled prescriptions, prescriptions without refills left and prescriptions older than a year cannot be refilled
+// @Tags employee-prescriptions
+// @Accept json
+// @Produce json
+// @Param id path int true "Prescription ID"
+// @Param request body dto.RefillPrescriptionRequest false "Stock lot to dispense from"
+// @Success 200 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse "Invalid prescription ID"
+// @Failure 401 {object} response.APIResponse
+// @Failure 404 {object} response.APIResponse "Prescription not found"
+// @Failure 422 {object} response.APIResponse "The prescription cannot be refilled"
+// @Router /employees/prescriptions/{id}/refill [put]
+// @Security BearerAuth
+func (ctrl *EmployeePrescriptionController) RefillPrescription(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, autherror.UnauthorizedCTXError())
+		return
+	}
+
+	prescriptionID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	var req dto.RefillPrescriptionRequest
+	if c.Request.ContentLength != 0 {
+		if err := ginutils.ShouldBindAndValidateBody(c, &req, ctrl.validator); err != nil {
+			response.BadRequest(c, err)
+			return
+		}
+	}
+
+	cmd, err := req.ToCommand(prescriptionID, user.EmployeeID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.prescriptionService.RefillPrescription(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Success(c, nil, result.Message())
+}
+
+// CancelPrescription godoc
+// @Summary Cancel a prescription
+// @Description Takes the pet off the medication, the prescription cannot be refilled afterwards
+// @Tags employee-prescriptions
+// @Accept json
+// @Produce json
+// @Param id path int true "Prescription ID"
+// @Param request body dto.CancelPrescriptionRequest true "Cancellation"
+// @Success 200 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse "Invalid input data"
+// @Failure 404 {object} response.APIResponse "Prescription not found"
+// @Failure 422 {object} response.APIResponse "The prescription was already cancelled"
+// @Router /employees/prescriptions/{id}/cancel [put]
+// @Security BearerAuth
+func (ctrl *EmployeePrescriptionController) CancelPrescription(c *gin.Context) {
+	prescriptionID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	var req dto.CancelPrescriptionRequest
+	if err := ginutils.ShouldBindAndValidateBody(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	cmd, err := req.ToCommand(prescriptionID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.prescriptionService.CancelPrescription(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Success(c, nil, result.Message())
+}
+
+// GetPrescription godoc
+// @Summary Get a prescription
+// @Description Returns a prescription by ID with its remaining refills and the end of the current course
+// @Tags employee-prescriptions
+// @Produce json
+// @Param id path int true "Prescription ID"
+// @Success 200 {object} response.APIResponse{data=dto.PrescriptionResponse}
+// @Failure 400 {object} response.APIResponse "Invalid prescription ID"
+// @Failure 404 {object} response.APIResponse "Prescription not found"
+// @Router /employees/prescriptions/{id} [get]
+// @Security BearerAuth
+func (ctrl *EmployeePrescriptionController) GetPrescription(c *gin.Context) {
+	prescriptionID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	qry, err := query.NewFindPrescriptionByIDQuery(prescriptionID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result, err := ctrl.prescriptionService.FindPrescriptionByID(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromPrescriptionResult(result), "Prescription")
+}
+
+// GetSessionPrescriptions godoc
+// @Summary List the prescriptions of a medical session
+// @Description Returns the prescriptions issued during the medical session, the first prescribed first
+// @Tags employee-prescriptions
+// @Produce json
+// @Param id path int true "Medical session ID"
+// @Success 200 {object} response.APIResponse{data=[]dto.PrescriptionResponse}
+// @Failure 400 {object} response.APIResponse "Invalid medical session ID"
+// @Router /employees/prescriptions/sessions/{id} [get]
+// @Security BearerAuth
+func (ctrl *EmployeePrescriptionController) GetSessionPrescriptions(c *gin.Context) {
+	sessionID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	qry, err := query.NewFindPrescriptionsBySessionQuery(sessionID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	results, err := ctrl.prescriptionService.FindPrescriptionsBySession(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromPrescriptionResults(results), "Prescriptions")
+}
+
+// GetPetPrescriptions godoc
+// @Summary List the prescriptions of a pet
+// @Description Returns every prescription of the pet, active or not, the latest first
+// @Tags employee-prescriptions
+// @Produce json
+// @Param id path int true "Pet ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.APIResponse{data=[]dto.PrescriptionResponse}
+// @Failure 400 {object} response.APIResponse "Invalid query parameters"
+// @Router /employees/prescriptions/pets/{id} [get]
+// @Security BearerAuth
+func (ctrl *EmployeePrescriptionController) GetPetPrescriptions(c *gin.Context) {
+	petID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	var pagination page.PaginationRequest
+	if err := ginutils.ShouldBindPageParams(&pagination, c, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	qry, err := query.NewFindPrescriptionsByPetQuery(petID, pagination)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	prescriptionPage, err := ctrl.prescriptionService.FindPrescriptionsByPet(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	prescriptionResponses := dto.FromPrescriptionResults(prescriptionPage.Items)
+	response.SuccessWithPagination(c, prescriptionResponses, "Prescriptions retrieved successfully", prescriptionPage.Metadata)
+}
+
+// GetPetActiveMedications godoc
+// @Summary List the active medications of a pet
+// @Description Returns the prescriptions the pet is on right now, those not cancelled whose last fill still lasts
+// @Tags employee-prescriptions
+// @Produce json
+// @Param id path int true "Pet ID"
+// @Success 200 {object} response.APIResponse{data=[]dto.PrescriptionResponse}
+// @Failure 400 {object} response.APIResponse "Invalid pet ID"
+// @Failure 404 {object} response.APIResponse "Pet not found"
+// @Router /employees/prescriptions/pets/{id}/active [get]
+// @Security BearerAuth
+func (ctrl *EmployeePrescriptionController) GetPetActiveMedications(c *gin.Context) {
+	petID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	qry, err := query.NewFindActiveMedicationsQuery(petID, nil)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	results, err := ctrl.prescriptionService.FindActiveMedications(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromPrescriptionResults(results), "Active Medications")
+}
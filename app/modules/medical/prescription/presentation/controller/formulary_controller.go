@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"clinic-vet-api/app/modules/medical/prescription/application"
+	"clinic-vet-api/app/modules/medical/prescription/presentation/dto"
+	ginutils "clinic-vet-api/app/shared/gin_utils"
+	"clinic-vet-api/app/shared/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// FormularyController exposes the drug formulary and the weight based dose calculator
+type FormularyController struct {
+	prescriptionService application.PrescriptionFacadeService
+	validator           *validator.Validate
+}
+
+func NewFormularyController(
+	prescriptionService application.PrescriptionFacadeService,
+	validator *validator.Validate,
+) *FormularyController {
+	return &FormularyController{
+		prescriptionService: prescriptionService,
+		validator:           validator,
+	}
+}
+
+// FindFormulary godoc
+// @Summary List the drug formulary
+// @Description Lists the drugs of the formulary with their mg/kg dose per species, maximum doses and contraindications. With a species only the drugs that can be given to it are listed
+// @Tags employee-formulary
+// @Produce json
+// @Param species query string false "Species filter"
+// @Success 200 {object} response.APIResponse{data=[]dto.DrugResponse}
+// @Failure 400 {object} response.APIResponse "Invalid species"
+// @Router /employees/formulary [get]
+// @Security BearerAuth
+func (ctrl *FormularyController) FindFormulary(c *gin.Context) {
+	var req dto.FindFormularyRequest
+	if err := ginutils.ShouldBindAndValidateQuery(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	qry, err := req.ToQuery()
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	results, err := ctrl.prescriptionService.FindFormulary(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromDrugResults(results), "Formulary")
+}
+
+// CalculateDose godoc
+// @Summary Calculate the dose of a drug for a pet
+// @Description Returns the recommended dose range in mg from the weight recorded in the latest medical sessions of the pet. A dose in mg can be given to check it, doses outside the range, species without a dose or contraindicated and pets without a recorded weight come back as warnings
+// @Tags employee-formulary
+// @Produce json
+// @Param pet_id query int true "Pet ID"
+// @Param drug query string true "Drug name"
+// @Param dose_mg query number false "Dose to check in mg"
+// @Success 200 {object} response.APIResponse{data=dto.DoseCalculationResponse}
+// @Failure 400 {object} response.APIResponse "Invalid query parameters"
+// @Failure 404 {object} response.APIResponse "Pet or drug not found"
+// @Router /employees/formulary/dose [get]
+// @Security BearerAuth
+func (ctrl *FormularyController) CalculateDose(c *gin.Context) {
+	var req dto.CalculateDoseRequest
+	if err := ginutils.ShouldBindAndValidateQuery(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	qry, err := req.ToQuery()
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result, err := ctrl.prescriptionService.CalculateDose(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromDoseCalculationResult(result), "Dose Calculation")
+}
@@ -0,0 +1,126 @@
+package dto
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/medical/prescription/application/handler"
+	"clinic-vet-api/app/modules/medical/prescription/application/query"
+)
+
+// FindFormularyRequest represents the query params to list the drugs of the formulary
+type FindFormularyRequest struct {
+	Species string `form:"species" example:"cat"`
+}
+
+func (r *FindFormularyRequest) ToQuery() (query.FindFormularyQuery, error) {
+	return query.NewFindFormularyQuery(r.Species)
+}
+
+// CalculateDoseRequest represents the query params to calculate the dose of a drug for a pet
+type CalculateDoseRequest struct {
+	PetID  uint     `form:"pet_id" validate:"required,gt=0" example:"7"`
+	Drug   string   `form:"drug" validate:"required,max=150" example:"Meloxicam"`
+	DoseMg *float64 `form:"dose_mg" validate:"omitempty,gt=0" example:"1.5"`
+}
+
+func (r *CalculateDoseRequest) ToQuery() (query.CalculateDoseQuery, error) {
+	return query.NewCalculateDoseQuery(r.PetID, r.Drug, r.DoseMg)
+}
+
+// DoseRangeResponse represents the dose of a drug for a species
+type DoseRangeResponse struct {
+	Species    string   `json:"species"`
+	MinMgPerKg float64  `json:"min_mg_per_kg"`
+	MaxMgPerKg float64  `json:"max_mg_per_kg"`
+	MaxDoseMg  *float64 `json:"max_dose_mg,omitempty"`
+}
+
+// DrugResponse represents a drug of the formulary
+type DrugResponse struct {
+	Name                   string              `json:"name"`
+	DrugClass              string              `json:"drug_class"`
+	Description            string              `json:"description"`
+	DoseRanges             []DoseRangeResponse `json:"dose_ranges"`
+	Contraindications      []string            `json:"contraindications"`
+	ContraindicatedSpecies []string            `json:"contraindicated_species,omitempty"`
+}
+
+// DoseWarningResponse represents a reason to double check the dose
+type DoseWarningResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// DoseCalculationResponse represents the dose of a drug recommended for a pet from its latest
+// recorded weight
+type DoseCalculationResponse struct {
+	PetID             uint                  `json:"pet_id"`
+	Species           string                `json:"species"`
+	Drug              DrugResponse          `json:"drug"`
+	WeightKg          *float64              `json:"weight_kg,omitempty"`
+	WeighedAt         *time.Time            `json:"weighed_at,omitempty"`
+	DoseRange         *DoseRangeResponse    `json:"dose_range,omitempty"`
+	RecommendedMinMg  *float64              `json:"recommended_min_mg,omitempty"`
+	RecommendedMaxMg  *float64              `json:"recommended_max_mg,omitempty"`
+	PrescribedDoseMg  *float64              `json:"prescribed_dose_mg,omitempty"`
+	PrescribedMgPerKg *float64              `json:"prescribed_mg_per_kg,omitempty"`
+	Warnings          []DoseWarningResponse `json:"warnings"`
+}
+
+func fromDoseRangeResult(result handler.DoseRangeResult) DoseRangeResponse {
+	return DoseRangeResponse{
+		Species:    result.Species,
+		MinMgPerKg: result.MinMgPerKg,
+		MaxMgPerKg: result.MaxMgPerKg,
+		MaxDoseMg:  result.MaxDoseMg,
+	}
+}
+
+func FromDrugResult(result handler.DrugResult) DrugResponse {
+	doseRanges := make([]DoseRangeResponse, len(result.DoseRanges))
+	for i, doseRange := range result.DoseRanges {
+		doseRanges[i] = fromDoseRangeResult(doseRange)
+	}
+
+	return DrugResponse{
+		Name:                   result.Name,
+		DrugClass:              result.DrugClass,
+		Description:            result.Description,
+		DoseRanges:             doseRanges,
+		Contraindications:      result.Contraindications,
+		ContraindicatedSpecies: result.ContraindicatedSpecies,
+	}
+}
+
+func FromDrugResults(results []handler.DrugResult) []DrugResponse {
+	responses := make([]DrugResponse, len(results))
+	for i, result := range results {
+		responses[i] = FromDrugResult(result)
+	}
+	return responses
+}
+
+func FromDoseCalculationResult(result handler.DoseCalculationResult) DoseCalculationResponse {
+	response := DoseCalculationResponse{
+		PetID:             result.PetID,
+		Species:           result.Species,
+		Drug:              FromDrugResult(result.Drug),
+		WeightKg:          result.WeightKg,
+		WeighedAt:         result.WeighedAt,
+		RecommendedMinMg:  result.RecommendedMinMg,
+		RecommendedMaxMg:  result.RecommendedMaxMg,
+		PrescribedDoseMg:  result.PrescribedDoseMg,
+		PrescribedMgPerKg: result.PrescribedMgPerKg,
+		Warnings:          make([]DoseWarningResponse, len(result.Warnings)),
+	}
+
+	if result.DoseRange != nil {
+		doseRange := fromDoseRangeResult(*result.DoseRange)
+		response.DoseRange = &doseRange
+	}
+
+	for i, warning := range result.Warnings {
+		response.Warnings[i] = DoseWarningResponse{Code: warning.Code, Message: warning.Message}
+	}
+	return response
+}
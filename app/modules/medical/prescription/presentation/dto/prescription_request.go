@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"clinic-vet-api/app/modules/medical/prescription/application/command"
+)
+
+// PrescribeMedicationRequest represents a drug prescribed to the pet seen in a medical session
+// @Description Prescription issued by the veterinarian, the first fill is dispensed with it
+type PrescribeMedicationRequest struct {
+	MedicalSessionID uint    `json:"medical_session_id" validate:"required,gt=0" example:"42"`
+	DrugName         string  `json:"drug_name" validate:"required,max=150" example:"Amoxicillin / Clavulanic Acid"`
+	Strength         string  `json:"strength" validate:"required,max=50" example:"250 mg"`
+	Dose             string  `json:"dose" validate:"required,max=100" example:"1 tablet"`
+	Route            string  `json:"route" validate:"required" example:"oral"`
+	Frequency        string  `json:"frequency" validate:"required,max=100" example:"every 12 hours"`
+	DurationDays     int     `json:"duration_days" validate:"required,min=1,max=365" example:"10"`
+	Quantity         int     `json:"quantity" validate:"required,min=1" example:"20"`
+	Refills          int     `json:"refills" validate:"min=0,max=12" example:"1"`
+	Instructions     *string `json:"instructions,omitempty" validate:"omitempty,max=500" example:"Give with food"`
+	LotID            *uint   `json:"lot_id,omitempty" validate:"omitempty,gt=0" example:"12"`
+}
+
+func (r *PrescribeMedicationRequest) ToCommand(prescribedBy uint) (command.PrescribeMedicationCommand, error) {
+	return command.NewPrescribeMedicationCommand(
+		r.MedicalSessionID,
+		prescribedBy,
+		r.DrugName,
+		r.Strength,
+		r.Dose,
+		r.Route,
+		r.Frequency,
+		r.DurationDays,
+		r.Quantity,
+		r.Refills,
+		r.Instructions,
+		r.LotID,
+	)
+}
+
+// RefillPrescriptionRequest represents the stock lot the refill is dispensed from, the body is optional
+type RefillPrescriptionRequest struct {
+	LotID *uint `json:"lot_id,omitempty" validate:"omitempty,gt=0" example:"12"`
+}
+
+func (r *RefillPrescriptionRequest) ToCommand(id, dispensedBy uint) (command.RefillPrescriptionCommand, error) {
+	return command.NewRefillPrescriptionCommand(id, dispensedBy, r.LotID)
+}
+
+// CancelPrescriptionRequest represents why the pet is taken off the medication
+type CancelPrescriptionRequest struct {
+	Reason string `json:"reason" validate:"required,max=500" example:"Adverse reaction, vomiting after each dose"`
+}
+
+func (r *CancelPrescriptionRequest) ToCommand(id uint) (command.CancelPrescriptionCommand, error) {
+	return command.NewCancelPrescriptionCommand(id, r.Reason)
+}
@@ -0,0 +1,103 @@
+package dto
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/medical/prescription/application/handler"
+)
+
+// PrescriptionResponse represents a prescription with its fills and refills
+type PrescriptionResponse struct {
+	ID                uint       `json:"id"`
+	MedicalSessionID  uint       `json:"medical_session_id"`
+	PetID             uint       `json:"pet_id"`
+	PrescribedBy      uint       `json:"prescribed_by"`
+	DrugName          string     `json:"drug_name"`
+	Strength          string     `json:"strength"`
+	Dose              string     `json:"dose"`
+	Route             string     `json:"route"`
+	Frequency         string     `json:"frequency"`
+	DurationDays      int        `json:"duration_days"`
+	Quantity          int        `json:"quantity"`
+	RefillsAuthorized int        `json:"refills_authorized"`
+	RefillsRemaining  int        `json:"refills_remaining"`
+	Instructions      *string    `json:"instructions,omitempty"`
+	Status            string     `json:"status"`
+	IsActive          bool       `json:"is_active"`
+	PrescribedAt      time.Time  `json:"prescribed_at"`
+	LastFilledAt      time.Time  `json:"last_filled_at"`
+	CourseEndsAt      time.Time  `json:"course_ends_at"`
+	ExpiresAt         time.Time  `json:"expires_at"`
+	CancelledAt       *time.Time `json:"cancelled_at,omitempty"`
+	CancelReason      *string    `json:"cancel_reason,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// MedicationResponse represents a medication the pet is currently on, as shown to its owner
+type MedicationResponse struct {
+	PrescriptionID   uint      `json:"prescription_id"`
+	DrugName         string    `json:"drug_name"`
+	Strength         string    `json:"strength"`
+	Dose             string    `json:"dose"`
+	Route            string    `json:"route"`
+	Frequency        string    `json:"frequency"`
+	Instructions     *string   `json:"instructions,omitempty"`
+	CourseEndsAt     time.Time `json:"course_ends_at"`
+	RefillsRemaining int       `json:"refills_remaining"`
+}
+
+func FromPrescriptionResult(result handler.PrescriptionResult) PrescriptionResponse {
+	return PrescriptionResponse{
+		ID:                result.ID,
+		MedicalSessionID:  result.SessionID,
+		PetID:             result.PetID,
+		PrescribedBy:      result.PrescribedBy,
+		DrugName:          result.DrugName,
+		Strength:          result.Strength,
+		Dose:              result.Dose,
+		Route:             result.Route,
+		Frequency:         result.Frequency,
+		DurationDays:      result.DurationDays,
+		Quantity:          result.Quantity,
+		RefillsAuthorized: result.RefillsAuthorized,
+		RefillsRemaining:  result.RefillsRemaining,
+		Instructions:      result.Instructions,
+		Status:            result.Status,
+		IsActive:          result.IsActive,
+		PrescribedAt:      result.PrescribedAt,
+		LastFilledAt:      result.LastFilledAt,
+		CourseEndsAt:      result.CourseEndsAt,
+		ExpiresAt:         result.ExpiresAt,
+		CancelledAt:       result.CancelledAt,
+		CancelReason:      result.CancelReason,
+		CreatedAt:         result.CreatedAt,
+		UpdatedAt:         result.UpdatedAt,
+	}
+}
+
+func FromPrescriptionResults(results []handler.PrescriptionResult) []PrescriptionResponse {
+	responses := make([]PrescriptionResponse, len(results))
+	for i, result := range results {
+		responses[i] = FromPrescriptionResult(result)
+	}
+	return responses
+}
+
+func FromMedicationResults(results []handler.PrescriptionResult) []MedicationResponse {
+	responses := make([]MedicationResponse, len(results))
+	for i, result := range results {
+		responses[i] = MedicationResponse{
+			PrescriptionID:   result.ID,
+			DrugName:         result.DrugName,
+			Strength:         result.Strength,
+			Dose:             result.Dose,
+			Route:            result.Route,
+			Frequency:        result.Frequency,
+			Instructions:     result.Instructions,
+			CourseEndsAt:     result.CourseEndsAt,
+			RefillsRemaining: result.RefillsRemaining,
+		}
+	}
+	return responses
+}
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
+	"clinic-vet-api/app/modules/medical/prescription/application"
+	"clinic-vet-api/app/modules/medical/prescription/application/handler"
+	sqlcRepo "clinic-vet-api/app/modules/medical/prescription/infrastructure/repository"
+	"clinic-vet-api/app/modules/medical/prescription/presentation/controller"
+	"clinic-vet-api/app/modules/medical/prescription/presentation/routes"
+	"clinic-vet-api/app/shared/database"
+	"clinic-vet-api/app/shared/mapper"
+	"clinic-vet-api/sqlc"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type PrescriptionAPIConfig struct {
+	Router         *gin.RouterGroup
+	Validator      *validator.Validate
+	AuthMiddleware *middleware.AuthMiddleware
+	Queries        *sqlc.Queries
+	Transactor     *database.Transactor
+
+	PetRepo            repository.PetRepository
+	MedicalSessionRepo repository.MedicalSessionRepository
+	StockService       *service.StockService
+}
+
+type PrescriptionAPIComponents struct {
+	Repository          repository.PrescriptionRepository
+	Service             application.PrescriptionFacadeService
+	Formulary           *service.DrugFormulary
+	EmployeeController  *controller.EmployeePrescriptionController
+	CustomerController  *controller.CustomerMedicationController
+	FormularyController *controller.FormularyController
+}
+
+type PrescriptionAPIModule struct {
+	config     *PrescriptionAPIConfig
+	isBuilt    bool
+	Components PrescriptionAPIComponents
+}
+
+func NewPrescriptionAPIModule(config *PrescriptionAPIConfig) *PrescriptionAPIModule {
+	return &PrescriptionAPIModule{
+		config:  config,
+		isBuilt: false,
+	}
+}
+
+func (b *PrescriptionAPIModule) Bootstrap() error {
+	if b.isBuilt {
+		return nil
+	}
+
+	if err := b.validateConfig(); err != nil {
+		return err
+	}
+
+	repo := sqlcRepo.NewSqlcPrescriptionRepository(b.config.Queries, b.config.Transactor, mapper.NewSqlcFieldMapper())
+
+	cmdHandler := handler.NewPrescriptionCommandHandler(repo, b.config.MedicalSessionRepo, b.config.StockService)
+	formulary := service.NewDrugFormulary()
+	doseCalculator := service.NewDoseCalculatorService(formulary, b.config.PetRepo, b.config.MedicalSessionRepo)
+	qryHandler := handler.NewPrescriptionQueryHandler(repo, b.config.PetRepo, formulary, doseCalculator)
+	prescriptionService := application.NewPrescriptionFacadeService(qryHandler, cmdHandler)
+
+	employeeController := controller.NewEmployeePrescriptionController(prescriptionService, b.config.Validator)
+	customerController := controller.NewCustomerMedicationController(prescriptionService)
+	formularyController := controller.NewFormularyController(prescriptionService, b.config.Validator)
+	routes.PrescriptionRoutes(b.config.Router, employeeController, customerController, formularyController, b.config.AuthMiddleware)
+
+	b.Components = PrescriptionAPIComponents{
+		Repository:          repo,
+		Service:             prescriptionService,
+		Formulary:           formulary,
+		EmployeeController:  employeeController,
+		CustomerController:  customerController,
+		FormularyController: formularyController,
+	}
+	b.isBuilt = true
+
+	return nil
+}
+
+func (b *PrescriptionAPIModule) validateConfig() error {
+	if b.config == nil {
+		return errors.New("prescription api config is nil")
+	}
+
+	if b.config.Router == nil {
+		return errors.New("router is nil")
+	}
+
+	if b.config.Validator == nil {
+		return errors.New("validator is nil")
+	}
+
+	if b.config.AuthMiddleware == nil {
+		return errors.New("auth middleware is nil")
+	}
+
+	if b.config.Queries == nil {
+		return errors.New("queries is nil")
+	}
+
+	if b.config.Transactor == nil {
+		return errors.New("transactor is nil")
+	}
+
+	if b.config.PetRepo == nil {
+		return errors.New("pet repository is nil")
+	}
+
+	if b.config.MedicalSessionRepo == nil {
+		return errors.New("medical session repository is nil")
+	}
+
+	if b.config.StockService == nil {
+		return errors.New("stock service is nil")
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package routes
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/medical/prescription/presentation/controller"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PrescriptionRoutes(
+	router *gin.RouterGroup,
+	employeeController *controller.EmployeePrescriptionController,
+	customerController *controller.CustomerMedicationController,
+	formularyController *controller.FormularyController,
+	authMiddleware *middleware.AuthMiddleware,
+) {
+	// Only veterinarians sign and cancel prescriptions, the front desk dispenses refills
+	prescriberGroup := router.Group("/employees/prescriptions")
+	prescriberGroup.Use(authMiddleware.Authenticate())
+	prescriberGroup.Use(authMiddleware.RequireAnyRole(enum.UserRoleVeterinarian.String()))
+	{
+		prescriberGroup.POST("", employeeController.PrescribeMedication)
+		prescriberGroup.PUT("/:id/cancel", employeeController.CancelPrescription)
+	}
+
+	employeeGroup := router.Group("/employees/prescriptions")
+	employeeGroup.Use(authMiddleware.Authenticate())
+	employeeGroup.Use(authMiddleware.RequireAnyRole(
+		enum.UserRoleVeterinarian.String(),
+		enum.UserRoleReceptionist.String(),
+		enum.UserRoleAdmin.String(),
+	))
+	{
+		employeeGroup.GET("/:id", employeeController.GetPrescription)
+		employeeGroup.PUT("/:id/refill", employeeController.RefillPrescription)
+		employeeGroup.GET("/sessions/:id", employeeController.GetSessionPrescriptions)
+		employeeGroup.GET("/pets/:id", employeeController.GetPetPrescriptions)
+		employeeGroup.GET("/pets/:id/active", employeeController.GetPetActiveMedications)
+	}
+
+	formularyGroup := router.Group("/employees/formulary")
+	formularyGroup.Use(authMiddleware.Authenticate())
+	formularyGroup.Use(authMiddleware.RequireAnyRole(enum.UserRoleVeterinarian.String(), enum.UserRoleAdmin.String()))
+	{
+		formularyGroup.GET("", formularyController.FindFormulary)
+		formularyGroup.GET("/dose", formularyController.CalculateDose)
+	}
+
+	customerGroup := router.Group("/customers/pets")
+	customerGroup.Use(authMiddleware.Authenticate())
+	customerGroup.Use(authMiddleware.RequireAnyRole(enum.UserRoleCustomer.String()))
+	{
+		customerGroup.GET("/:id/medications", customerController.GetMyPetMedications)
+	}
+}
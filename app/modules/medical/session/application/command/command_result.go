@@ -15,7 +15,13 @@ const (
 	msgMedicalSessionNotFound        = "Medical history not found"
 	msgMedicalSessionDateConflict    = "A medical history already exists for this pet on the specified date"
 	msgMedicalSessionNewDateConflict = "A medical history already exists for this pet on the new specified date"
+	msgMedicalSessionClosed          = "Medical session closed successfully"
 	msgErrorProcessingData           = "Error processing data: "
+	msgErrorClosingSession           = "Error closing medical session"
+	msgFollowUpUpdated               = "Follow-up updated successfully"
+	msgErrorProposingFollowUp        = "Error proposing the follow-up appointment"
+	msgErrorFindingOnCall            = "No veterinarian is on call to attend the emergency"
+	msgErrorFlaggingVitals           = "Error checking the vitals against the pet's normal ranges"
 )
 
 func MedicalNotFoundErr(id valueobject.MedSessionID) error {
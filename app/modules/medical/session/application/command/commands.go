@@ -49,6 +49,27 @@ type DeleteMedSessionCommand struct {
 	IsHardDelete bool
 }
 
+// CloseMedSessionCommand closes the draft session of a visit with its final findings, when
+// EmployeeID is set only sessions of that employee can be closed
+type CloseMedSessionCommand struct {
+	ID         valueobject.MedSessionID
+	EmployeeID *valueobject.EmployeeID
+	Diagnosis  string
+	Treatment  string
+	Condition  enum.PetCondition
+	Notes      *string
+	// FollowUpDate proposes the follow-up appointment of the visit when set
+	FollowUpDate *time.Time
+}
+
+// ScheduleFollowUpCommand sets, moves or clears the follow-up date of a session, keeping its
+// proposed follow-up appointment in line
+type ScheduleFollowUpCommand struct {
+	ID           valueobject.MedSessionID
+	EmployeeID   *valueobject.EmployeeID
+	FollowUpDate *time.Time
+}
+
 type HardDeleteMedSessionCommand struct {
 	ID valueobject.MedSessionID
 }
@@ -1,26 +1,81 @@
 package command
 
 import (
+	"clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
 	"clinic-vet-api/app/modules/core/domain/valueobject"
 	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
 	"clinic-vet-api/app/shared/cqrs"
 	"context"
+	"time"
 )
 
 type MedicalSessionCommandHandlers struct {
-	repo repository.MedicalSessionRepository
+	repo        repository.MedicalSessionRepository
+	apptRepo    repository.AppointmentRepository
+	visitRepo   repository.AppointmentVisitRepository
+	petRepo     repository.PetRepository
+	followUps   *service.FollowUpService
+	onCall      *service.OnCallService
+	vitalRanges *service.VitalRangeCatalog
 }
 
-func NewMedicalSessionCommandHandlers(repo repository.MedicalSessionRepository) *MedicalSessionCommandHandlers {
-	return &MedicalSessionCommandHandlers{repo: repo}
+func NewMedicalSessionCommandHandlers(
+	repo repository.MedicalSessionRepository,
+	apptRepo repository.AppointmentRepository,
+	visitRepo repository.AppointmentVisitRepository,
+	petRepo repository.PetRepository,
+	followUps *service.FollowUpService,
+	onCall *service.OnCallService,
+	vitalRanges *service.VitalRangeCatalog,
+) *MedicalSessionCommandHandlers {
+	return &MedicalSessionCommandHandlers{
+		repo:        repo,
+		apptRepo:    apptRepo,
+		visitRepo:   visitRepo,
+		petRepo:     petRepo,
+		followUps:   followUps,
+		onCall:      onCall,
+		vitalRanges: vitalRanges,
+	}
 }
 
+// CreateMedicalSession records a session, emergency visits without a veterinarian are assigned
+// to the veterinarian on call at the visit time. The vitals are flagged against the normal ranges
+// of the pet's species and life stage
 func (h *MedicalSessionCommandHandlers) CreateMedicalSession(ctx context.Context, cmd CreateMedSessionCommand) cqrs.CommandResult {
+	if cmd.EmployeeID.IsZero() && cmd.VisitType == enum.VisitTypeEmergencyVisit {
+		employeeID, err := h.onCall.EmergencyVet(ctx, cmd.VisitDate)
+		if err != nil {
+			return errorCreateResult(msgErrorFindingOnCall, err)
+		}
+		cmd.EmployeeID = employeeID
+	}
+
 	entity := cmd.ToEntity()
-	if err := h.repo.Save(ctx, &entity); err != nil {
+	if err := h.annotateVitals(ctx, &entity); err != nil {
+		return errorCreateResult(msgErrorFlaggingVitals, err)
+	}
+
+	if entity.PetDetails().FollowUpDate() == nil {
+		if err := h.repo.Save(ctx, &entity); err != nil {
+			return errorCreateResult(msgErrorProcessingData, err)
+		}
+		return successCreateResult(entity)
+	}
+
+	followUp, _, err := h.followUps.Prepare(ctx, &entity)
+	if err != nil {
+		return errorCreateResult(msgErrorProposingFollowUp, err)
+	}
+
+	if err := h.repo.SaveWithFollowUp(ctx, &entity, followUp); err != nil {
 		return errorCreateResult(msgErrorProcessingData, err)
 	}
 
+	h.followUps.Notify(ctx, followUp, false)
 	return successCreateResult(entity)
 }
 
@@ -49,6 +104,82 @@ func (h *MedicalSessionCommandHandlers) DeleteMedSessionCommand(ctx context.Cont
 
 	return successDeleteResult(cmd.ID, msgMedicalSessionSoftDeleted)
 }
+
+// CloseMedicalSession closes the draft and completes the appointment the visit was booked with.
+// The follow-up proposal is saved in the same transaction
+func (h *MedicalSessionCommandHandlers) CloseMedicalSession(ctx context.Context, cmd CloseMedSessionCommand) cqrs.CommandResult {
+	session, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return errorUpdateResult(msgMedicalSessionNotFound, err)
+	}
+
+	if cmd.EmployeeID != nil && session.EmployeeID() != *cmd.EmployeeID {
+		return errorUpdateResult(msgMedicalSessionNotFound, MedicalNotFoundErr(cmd.ID))
+	}
+
+	if err := session.RecordOutcome(ctx, cmd.Diagnosis, cmd.Treatment, cmd.Condition, cmd.Notes); err != nil {
+		return errorUpdateResult(msgErrorClosingSession, err)
+	}
+
+	if cmd.FollowUpDate != nil {
+		if err := session.ScheduleFollowUp(ctx, cmd.FollowUpDate); err != nil {
+			return errorUpdateResult(msgErrorClosingSession, err)
+		}
+	}
+
+	var appt *appointment.Appointment
+	if session.AppointmentID() != nil {
+		found, err := h.apptRepo.FindByID(ctx, *session.AppointmentID())
+		if err != nil {
+			return errorUpdateResult(msgErrorClosingSession, err)
+		}
+		appt = &found
+	}
+
+	if err := medical.CloseVisit(ctx, session, appt, time.Now()); err != nil {
+		return errorUpdateResult(msgErrorClosingSession, err)
+	}
+
+	var followUp *appointment.Appointment
+	var moved bool
+	if cmd.FollowUpDate != nil {
+		followUp, moved, err = h.followUps.Prepare(ctx, session)
+		if err != nil {
+			return errorUpdateResult(msgErrorProposingFollowUp, err)
+		}
+	}
+
+	if err := h.visitRepo.CloseSession(ctx, session, appt, followUp); err != nil {
+		return errorUpdateResult(msgErrorClosingSession, err)
+	}
+
+	h.followUps.Notify(ctx, followUp, moved)
+
+	return cqrs.SuccessResult(msgMedicalSessionClosed)
+}
+
+// ScheduleFollowUp changes the follow-up date of the session and its proposed appointment
+func (h *MedicalSessionCommandHandlers) ScheduleFollowUp(ctx context.Context, cmd ScheduleFollowUpCommand) cqrs.CommandResult {
+	session, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return errorUpdateResult(msgMedicalSessionNotFound, err)
+	}
+
+	if cmd.EmployeeID != nil && session.EmployeeID() != *cmd.EmployeeID {
+		return errorUpdateResult(msgMedicalSessionNotFound, MedicalNotFoundErr(cmd.ID))
+	}
+
+	if err := session.ScheduleFollowUp(ctx, cmd.FollowUpDate); err != nil {
+		return errorUpdateResult(msgErrorProposingFollowUp, err)
+	}
+
+	if err := h.followUps.Sync(ctx, session); err != nil {
+		return errorUpdateResult(msgErrorProposingFollowUp, err)
+	}
+
+	return cqrs.SuccessResult(msgFollowUpUpdated)
+}
+
 func (h *MedicalSessionCommandHandlers) valdiateExistingMedSession(ctx context.Context, medHistID valueobject.MedSessionID) error {
 	exists, err := h.repo.ExistsByID(ctx, medHistID)
 	if err != nil {
@@ -59,3 +190,19 @@ func (h *MedicalSessionCommandHandlers) valdiateExistingMedSession(ctx context.C
 	}
 	return nil
 }
+
+func (h *MedicalSessionCommandHandlers) annotateVitals(ctx context.Context, session *medical.MedicalSession) error {
+	pet, err := h.petRepo.FindByID(ctx, session.PetDetails().PetID())
+	if err != nil {
+		return err
+	}
+
+	ranges, found := h.vitalRanges.GetRanges(pet.Species(), pet.LifeStage())
+	if !found {
+		session.ClearVitalFlags()
+		return nil
+	}
+
+	session.AnnotateVitals(ranges)
+	return nil
+}
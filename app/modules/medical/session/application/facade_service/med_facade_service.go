@@ -12,6 +12,8 @@ type MedicalSessionCommandBus interface {
 	CreateMedicalSession(ctx context.Context, cmd c.CreateMedSessionCommand) cqrs.CommandResult
 	UpdateMedicalSession(ctx context.Context, cmd c.UpdateMedSessionCommand) cqrs.CommandResult
 	DeleteMedSessionCommand(ctx context.Context, cmd c.DeleteMedSessionCommand) cqrs.CommandResult
+	CloseMedicalSession(ctx context.Context, cmd c.CloseMedSessionCommand) cqrs.CommandResult
+	ScheduleFollowUp(ctx context.Context, cmd c.ScheduleFollowUpCommand) cqrs.CommandResult
 }
 
 type MedicalSessionQueryBus interface {
@@ -24,6 +26,7 @@ type MedicalSessionQueryBus interface {
 	FindMedSessionByDateRange(ctx context.Context, qry q.FindMedSessionByDateRangeQuery) (*p.Page[q.MedSessionResult], error)
 	FindMedSessionByPetAndDateRange(ctx context.Context, qry q.FindMedSessionByPetAndDateRangeQuery) ([]q.MedSessionResult, error)
 	FindMedSessionByDiagnosis(ctx context.Context, qry q.FindMedSessionByDiagnosisQuery) (*p.Page[q.MedSessionResult], error)
+	FindPetVitalsTimeline(ctx context.Context, qry q.FindPetVitalsTimelineQuery) (q.VitalsTimelineResult, error)
 }
 
 type MedicalApplicationService interface {
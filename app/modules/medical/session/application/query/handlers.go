@@ -8,11 +8,12 @@ import (
 )
 
 type MedSessionQueryHandler struct {
-	repo repository.MedicalSessionRepository
+	repo    repository.MedicalSessionRepository
+	petRepo repository.PetRepository
 }
 
-func NewMedicalSessionQueryHandler(repo repository.MedicalSessionRepository) *MedSessionQueryHandler {
-	return &MedSessionQueryHandler{repo: repo}
+func NewMedicalSessionQueryHandler(repo repository.MedicalSessionRepository, petRepo repository.PetRepository) *MedSessionQueryHandler {
+	return &MedSessionQueryHandler{repo: repo, petRepo: petRepo}
 }
 
 func (h *MedSessionQueryHandler) FindMedSessionByID(ctx context.Context, query FindMedSessionByIDQuery) (*MedSessionResult, error) {
@@ -108,6 +109,26 @@ func (h *MedSessionQueryHandler) FindMedSessionByPetAndDateRange(ctx context.Con
 	return toResultList(medSession), nil
 }
 
+// FindPetVitalsTimeline lays out the vitals the pet had taken in its sessions of the period, with
+// the weight trend and the rolling averages
+func (h *MedSessionQueryHandler) FindPetVitalsTimeline(ctx context.Context, query FindPetVitalsTimelineQuery) (VitalsTimelineResult, error) {
+	if query.optCustomerID != nil {
+		if _, err := h.petRepo.FindByIDAndCustomerID(ctx, query.petID, *query.optCustomerID); err != nil {
+			return VitalsTimelineResult{}, err
+		}
+	} else if _, err := h.petRepo.FindByID(ctx, query.petID); err != nil {
+		return VitalsTimelineResult{}, err
+	}
+
+	sessions, err := h.repo.FindByPetAndDateRange(ctx, query.petID, query.startDate, query.endDate)
+	if err != nil {
+		return VitalsTimelineResult{}, err
+	}
+
+	timeline := medical.NewVitalsTimeline(query.petID, sessions, query.window)
+	return toVitalsTimelineResult(timeline), nil
+}
+
 func (h *MedSessionQueryHandler) FindMedSessionByDiagnosis(ctx context.Context, query FindMedSessionByDiagnosisQuery) (*p.Page[MedSessionResult], error) {
 	medSessionpage, err := h.repo.FindByDiagnosis(ctx, query.Diagnosis, query.PaginationRequest)
 	if err != nil {
@@ -2,10 +2,13 @@
 package query
 
 import (
+	"fmt"
 	"time"
 
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
 	"clinic-vet-api/app/modules/core/domain/specification"
 	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
 	p "clinic-vet-api/app/shared/page"
 )
 
@@ -102,6 +105,61 @@ type FindMedSessionByPetAndDateRangeQuery struct {
 	EndDate   time.Time
 }
 
+// DefaultVitalsTimelineYears is how far back the vitals timeline goes when no start date is given
+const DefaultVitalsTimelineYears = 2
+
+// FindPetVitalsTimelineQuery represents a query for the vitals of a pet across its sessions in the
+// period, only the owner's pets when the customer is given
+type FindPetVitalsTimelineQuery struct {
+	petID         valueobject.PetID
+	optCustomerID *valueobject.CustomerID
+	startDate     time.Time
+	endDate       time.Time
+	window        int
+}
+
+func NewFindPetVitalsTimelineQuery(
+	petID uint,
+	optCustomerID *uint,
+	startDate, endDate *time.Time,
+	window *int,
+) (FindPetVitalsTimelineQuery, error) {
+	if petID == 0 {
+		return FindPetVitalsTimelineQuery{}, apperror.FieldValidationError("pet_id", "0", "pet ID is required")
+	}
+
+	end := time.Now()
+	if endDate != nil {
+		end = *endDate
+	}
+
+	start := end.AddDate(-DefaultVitalsTimelineYears, 0, 0)
+	if startDate != nil {
+		start = *startDate
+	}
+
+	if !start.Before(end) {
+		return FindPetVitalsTimelineQuery{}, apperror.FieldValidationError("start_date", start.Format(time.DateOnly), "start date must be before the end date")
+	}
+
+	averageWindow := medical.DefaultVitalsAverageWindow
+	if window != nil {
+		if *window < 1 || *window > medical.MaxVitalsAverageWindow {
+			return FindPetVitalsTimelineQuery{}, apperror.FieldValidationError("window", fmt.Sprint(*window),
+				fmt.Sprintf("window must be between 1 and %d readings", medical.MaxVitalsAverageWindow))
+		}
+		averageWindow = *window
+	}
+
+	return FindPetVitalsTimelineQuery{
+		petID:         valueobject.NewPetID(petID),
+		optCustomerID: valueobject.NewOptCustomerID(optCustomerID),
+		startDate:     start,
+		endDate:       end,
+		window:        averageWindow,
+	}, nil
+}
+
 type FindMedSessionByDiagnosisQuery struct {
 	Diagnosis         string
 	PaginationRequest p.PaginationRequest
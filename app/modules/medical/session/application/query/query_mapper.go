@@ -8,10 +8,14 @@ func toResult(entity medical.MedicalSession) MedSessionResult {
 	return MedSessionResult{
 		ID:            entity.ID(),
 		EmployeeID:    entity.EmployeeID(),
+		AppointmentID: entity.AppointmentID(),
 		VisitDate:     entity.VisitDate(),
 		VisitType:     entity.VisitType(),
 		ClinicService: entity.Service(),
 		Notes:         entity.Notes(),
+		IsEmergency:   entity.IsEmergency(),
+		Status:        entity.Status(),
+		ClosedAt:      entity.ClosedAt(),
 		CreatedAt:     entity.CreatedAt(),
 		UpdatedAt:     entity.UpdatedAt(),
 		PetDetailsResult: PetDetailsResult{
@@ -26,6 +30,7 @@ func toResult(entity medical.MedicalSession) MedSessionResult {
 			Treatment:       entity.PetDetails().Treatment(),
 			Symptoms:        entity.PetDetails().Symptoms(),
 			Medications:     entity.PetDetails().Medications(),
+			VitalFlags:      entity.PetDetails().VitalFlags(),
 		},
 	}
 }
@@ -37,3 +42,32 @@ func toResultList(entities []medical.MedicalSession) []MedSessionResult {
 	}
 	return dtos
 }
+
+func toVitalsTimelineResult(timeline medical.VitalsTimeline) VitalsTimelineResult {
+	readings := make([]VitalsReadingResult, len(timeline.Readings))
+	for i, reading := range timeline.Readings {
+		readings[i] = VitalsReadingResult{
+			SessionID:           reading.SessionID,
+			VisitDate:           reading.VisitDate,
+			Weight:              reading.Weight,
+			Temperature:         reading.Temperature,
+			HeartRate:           reading.HeartRate,
+			RespiratoryRate:     reading.RespiratoryRate,
+			WeightChangePercent: reading.WeightChangePercent,
+			DaysSinceWeighing:   reading.DaysSinceWeighing,
+			SuddenWeightLoss:    reading.SuddenWeightLoss,
+			AvgWeight:           reading.AvgWeight,
+			AvgTemperature:      reading.AvgTemperature,
+			AvgHeartRate:        reading.AvgHeartRate,
+			AvgRespiratoryRate:  reading.AvgRespiratoryRate,
+		}
+	}
+
+	return VitalsTimelineResult{
+		PetID:               timeline.PetID,
+		Window:              timeline.Window,
+		Readings:            readings,
+		WeightChangePercent: timeline.WeightChangePercent,
+		SuddenWeightLoss:    timeline.SuddenWeightLoss,
+	}
+}
@@ -1,6 +1,7 @@
 package query
 
 import (
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
 	"clinic-vet-api/app/modules/core/domain/enum"
 	"clinic-vet-api/app/modules/core/domain/valueobject"
 	"time"
@@ -9,10 +10,14 @@ import (
 type MedSessionResult struct {
 	ID               valueobject.MedSessionID
 	EmployeeID       valueobject.EmployeeID
+	AppointmentID    *valueobject.AppointmentID
 	VisitDate        time.Time
 	VisitType        enum.VisitType
 	ClinicService    enum.ClinicService
 	Notes            *string
+	IsEmergency      bool
+	Status           enum.MedSessionStatus
+	ClosedAt         *time.Time
 	PetDetailsResult PetDetailsResult
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
@@ -30,6 +35,7 @@ type PetDetailsResult struct {
 	Symptoms        []string
 	Treatment       string
 	FollowUpDate    *time.Time
+	VitalFlags      medical.VitalFlags
 }
 
 type MedSessionDetailResult struct {
@@ -67,3 +73,27 @@ type EmployeeResult struct {
 	LastName  string
 	Specialty string
 }
+
+type VitalsTimelineResult struct {
+	PetID               valueobject.PetID
+	Window              int
+	Readings            []VitalsReadingResult
+	WeightChangePercent *float64
+	SuddenWeightLoss    bool
+}
+
+type VitalsReadingResult struct {
+	SessionID           valueobject.MedSessionID
+	VisitDate           time.Time
+	Weight              *float64
+	Temperature         *float64
+	HeartRate           *int32
+	RespiratoryRate     *int32
+	WeightChangePercent *float64
+	DaysSinceWeighing   *int
+	SuddenWeightLoss    bool
+	AvgWeight           *float64
+	AvgTemperature      *float64
+	AvgHeartRate        *float64
+	AvgRespiratoryRate  *float64
+}
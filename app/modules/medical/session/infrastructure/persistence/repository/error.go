@@ -16,6 +16,7 @@ const (
 	OpSearch = "search"
 
 	TableMedicalSession = "medical_sessions"
+	TableFollowUps      = "medical_session_follow_ups"
 	DriverSQL           = "sql"
 )
 
@@ -32,12 +33,18 @@ const (
 	ErrMsgConvertToDomain      = "failed to convert to domain entity"
 	ErrMsgInvalidSearchParams  = "invalid search parameters type"
 	ErrMsgCountMedicalSession  = "failed to count medical history records"
+	ErrMsgCreateFollowUp       = "failed to create the follow-up appointment"
+	ErrMsgLinkFollowUp         = "failed to link the follow-up appointment to the medical session"
 )
 
 func (r *SQLCMedSessionRepository) dbError(operation, message string, err error) error {
 	return dberr.DatabaseOperationError(operation, TableMedicalSession, DriverSQL, fmt.Errorf("%s: %v", message, err))
 }
 
+func (r *SQLCMedSessionRepository) followUpError(message string, err error) error {
+	return dberr.DatabaseOperationError(OpInsert, TableFollowUps, DriverSQL, fmt.Errorf("%s: %v", message, err))
+}
+
 func (r *SQLCMedSessionRepository) notFoundError(parameterName, parameterValue string) error {
 	return dberr.EntityNotFoundError(parameterName, parameterValue, OpSelect, TableMedicalSession, DriverSQL)
 }
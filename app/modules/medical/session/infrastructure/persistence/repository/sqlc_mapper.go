@@ -4,9 +4,11 @@ import (
 	"encoding/json"
 	"math/big"
 
+	"clinic-vet-api/app/modules/core/domain/entity/appointment"
 	"clinic-vet-api/app/modules/core/domain/entity/medical"
 	"clinic-vet-api/app/modules/core/domain/enum"
 	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/db/models"
 	"clinic-vet-api/sqlc"
 
 	"github.com/jackc/pgx/v5/pgtype"
@@ -35,15 +37,32 @@ func (r *SQLCMedSessionRepository) sqlcRowToEntity(sqlRow sqlc.MedicalSession) m
 		WithMedications(medications).
 		WithFollowUpDate(r.pgMap.PgTimestamptz.ToTimePtr(sqlRow.FollowUpDate)).
 		WithSymptoms(symptoms).
+		WithVitalFlags(medical.VitalFlags{
+			Weight:          toVitalFlag(sqlRow.WeightFlag),
+			Temperature:     toVitalFlag(sqlRow.TemperatureFlag),
+			HeartRate:       toVitalFlag(sqlRow.HeartRateFlag),
+			RespiratoryRate: toVitalFlag(sqlRow.RespiratoryRateFlag),
+		}).
 		Build()
 
+	var appointmentID *valueobject.AppointmentID
+	if sqlRow.AppointmentID.Valid {
+		id := valueobject.NewAppointmentID(uint(sqlRow.AppointmentID.Int32))
+		appointmentID = &id
+	}
+
 	return *medical.NewMedicalSessionBuilder().
 		WithID(valueobject.NewMedSessionID(uint(sqlRow.ID))).
 		WithEmployeeID(valueobject.NewEmployeeID(uint(sqlRow.EmployeeID))).
 		WithCustomerID(valueobject.NewCustomerID(uint(sqlRow.CustomerID))).
+		WithAppointmentID(appointmentID).
+		WithService(enum.ClinicService(sqlRow.ClinicService)).
 		WithVisitType(enum.VisitType(sqlRow.VisitType)).
 		WithVisitDate(r.pgMap.PgTimestamptz.ToTime(sqlRow.VisitDate)).
 		WithNotes(r.pgMap.PgText.ToStringPtr(sqlRow.Notes)).
+		WithIsEmergency(sqlRow.IsEmergency.Bool).
+		WithStatus(enum.MedSessionStatus(sqlRow.Status)).
+		WithClosedAt(r.pgMap.PgTimestamptz.ToTimePtr(sqlRow.ClosedAt)).
 		WithPetDetails(*petDetails).
 		WithTimeStamps(sqlRow.CreatedAt.Time, sqlRow.UpdatedAt.Time).
 		Build()
@@ -102,6 +121,12 @@ func (r *SQLCMedSessionRepository) toUpdateParams(medSession medical.MedicalSess
 		params.RespiratoryRate = pgtype.Int4{Int32: *medSession.PetDetails().RespiratoryRate(), Valid: true}
 	}
 
+	flags := medSession.PetDetails().VitalFlags()
+	params.WeightFlag = fromVitalFlag(flags.Weight)
+	params.TemperatureFlag = fromVitalFlag(flags.Temperature)
+	params.HeartRateFlag = fromVitalFlag(flags.HeartRate)
+	params.RespiratoryRateFlag = fromVitalFlag(flags.RespiratoryRate)
+
 	/*
 		// Arrays (JSON)
 		if len(medSession.Symptoms()) > 0 {
@@ -154,18 +179,12 @@ func (r *SQLCMedSessionRepository) toCreateParams(medSession medical.MedicalSess
 			params.Medications = pgtype.Text{Valid: false}
 		}
 
-		// Follow-up date
-		if medSession.FollowUpDate() != nil {
-			params.FollowUpDate = pgtype.Timestamptz{Time: *medSession.FollowUpDate(), Valid: true}
-		} else {
-			params.FollowUpDate = pgtype.Timestamptz{Valid: false}
-		}
-
 
 		// IsEmergency
 		isEmergency := medSession.VisitReason() == enum.VisitReasonEmergency
 		params.IsEmergency = pgtype.Bool{Bool: isEmergency, Valid: true}
 	*/
+	flags := medSession.PetDetails().VitalFlags()
 	return sqlc.SaveMedicalSessionParams{
 		PetID:           medSession.PetDetails().PetID().Int32(),
 		CustomerID:      medSession.CustomerID().Int32(),
@@ -180,5 +199,43 @@ func (r *SQLCMedSessionRepository) toCreateParams(medSession medical.MedicalSess
 		Temperature:     r.pgMap.PgNumeric.FromDecimalPtr(medSession.PetDetails().Temperature()),
 		HeartRate:       r.pgMap.PgInt4.FromInt32Ptr(medSession.PetDetails().HeartRate()),
 		RespiratoryRate: r.pgMap.PgInt4.FromInt32Ptr(medSession.PetDetails().RespiratoryRate()),
+		FollowUpDate:    r.pgMap.PgTimestamptz.FromTimePtr(medSession.PetDetails().FollowUpDate()),
+
+		WeightFlag:          fromVitalFlag(flags.Weight),
+		TemperatureFlag:     fromVitalFlag(flags.Temperature),
+		HeartRateFlag:       fromVitalFlag(flags.HeartRate),
+		RespiratoryRateFlag: fromVitalFlag(flags.RespiratoryRate),
+	}
+}
+
+// toFollowUpParams maps the follow-up proposed when the session is recorded
+func (r *SQLCMedSessionRepository) toFollowUpParams(followUp appointment.Appointment) sqlc.CreateAppointmentParams {
+	params := sqlc.CreateAppointmentParams{
+		CustomerID:    followUp.CustomerID().Int32(),
+		PetID:         followUp.PetID().Int32(),
+		ScheduledDate: r.pgMap.PgTimestamptz.FromTime(followUp.ScheduledDate()),
+		Status:        models.AppointmentStatus(followUp.Status()),
+		ClinicService: models.ClinicService(followUp.Service().String()),
+		Notes:         r.pgMap.PgText.FromStringPtr(followUp.Notes()),
+	}
+
+	if followUp.EmployeeID() != nil {
+		params.EmployeeID = pgtype.Int4{Int32: followUp.EmployeeID().Int32(), Valid: true}
+	}
+	return params
+}
+
+func toVitalFlag(flag pgtype.Text) *enum.VitalFlag {
+	if !flag.Valid {
+		return nil
+	}
+	vitalFlag := enum.VitalFlag(flag.String)
+	return &vitalFlag
+}
+
+func fromVitalFlag(flag *enum.VitalFlag) pgtype.Text {
+	if flag == nil {
+		return pgtype.Text{Valid: false}
 	}
+	return pgtype.Text{String: flag.String(), Valid: true}
 }
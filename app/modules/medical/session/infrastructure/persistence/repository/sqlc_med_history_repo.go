@@ -9,24 +9,29 @@ import (
 	"fmt"
 	"time"
 
+	apptRepo "clinic-vet-api/app/modules/appointment/infrastructure/repository"
+	"clinic-vet-api/app/modules/core/domain/entity/appointment"
 	med "clinic-vet-api/app/modules/core/domain/entity/medical"
 	"clinic-vet-api/app/modules/core/domain/specification"
 	"clinic-vet-api/app/modules/core/domain/valueobject"
 	"clinic-vet-api/app/modules/core/repository"
 
+	"clinic-vet-api/app/shared/database"
 	"clinic-vet-api/app/shared/mapper"
 	p "clinic-vet-api/app/shared/page"
 )
 
 type SQLCMedSessionRepository struct {
-	queries *sqlc.Queries
-	pgMap   *mapper.SqlcFieldMapper
+	queries    *sqlc.Queries
+	transactor *database.Transactor
+	pgMap      *mapper.SqlcFieldMapper
 }
 
-func NewSQLCMedSessionRepository(queries *sqlc.Queries) repository.MedicalSessionRepository {
+func NewSQLCMedSessionRepository(queries *sqlc.Queries, transactor *database.Transactor) repository.MedicalSessionRepository {
 	return &SQLCMedSessionRepository{
-		queries: queries,
-		pgMap:   mapper.NewSqlcFieldMapper(),
+		queries:    queries,
+		transactor: transactor,
+		pgMap:      mapper.NewSqlcFieldMapper(),
 	}
 }
 
@@ -248,6 +253,52 @@ func (r *SQLCMedSessionRepository) Save(ctx context.Context, medSession *med.Med
 	return r.update(ctx, medSession)
 }
 
+// SaveWithFollowUp inserts the session, its follow-up proposal with the resources it reserves and
+// the link between both. IDs are only assigned to the entities once the transaction is committed
+func (r *SQLCMedSessionRepository) SaveWithFollowUp(ctx context.Context, medSession *med.MedicalSession, followUp *appointment.Appointment) error {
+	var sessionID valueobject.MedSessionID
+	var followUpID valueobject.AppointmentID
+
+	err := r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+		created, err := queries.SaveMedicalSession(ctx, r.toCreateParams(*medSession))
+		if err != nil {
+			return r.dbError(OpInsert, ErrMsgCreateMedicalSession, err)
+		}
+		sessionID = valueobject.NewMedSessionID(uint(created.ID))
+
+		if err := apptRepo.EnsureNoConflictWithin(ctx, queries, followUp); err != nil {
+			return err
+		}
+
+		proposal, err := queries.CreateAppointment(ctx, r.toFollowUpParams(*followUp))
+		if err != nil {
+			return r.followUpError(ErrMsgCreateFollowUp, err)
+		}
+		followUpID = valueobject.NewAppointmentID(uint(proposal.ID))
+
+		reserved := *followUp
+		reserved.SetID(followUpID)
+		if err := apptRepo.ReserveResourcesWithin(ctx, queries, &reserved); err != nil {
+			return err
+		}
+
+		if err := queries.CreateMedicalSessionFollowUp(ctx, sqlc.CreateMedicalSessionFollowUpParams{
+			MedicalSessionID: created.ID,
+			AppointmentID:    proposal.ID,
+		}); err != nil {
+			return r.followUpError(ErrMsgLinkFollowUp, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	medSession.SetID(sessionID)
+	followUp.SetID(followUpID)
+	return nil
+}
+
 func (r *SQLCMedSessionRepository) Delete(ctx context.Context, medicalSessionID valueobject.MedSessionID, isHarDelete bool) error {
 	if isHarDelete {
 		if err := r.queries.HardDeleteMedicalSession(ctx, medicalSessionID.Int32()); err != nil {
@@ -265,11 +316,12 @@ func (r *SQLCMedSessionRepository) Delete(ctx context.Context, medicalSessionID
 
 func (r *SQLCMedSessionRepository) create(ctx context.Context, medSession *med.MedicalSession) error {
 	params := r.toCreateParams(*medSession)
-	_, err := r.queries.SaveMedicalSession(ctx, params)
+	created, err := r.queries.SaveMedicalSession(ctx, params)
 	if err != nil {
 		return r.dbError("insert", "failed to create medical history", err)
 	}
 
+	medSession.SetID(valueobject.NewMedSessionID(uint(created.ID)))
 	return nil
 }
 
@@ -150,6 +150,10 @@ func (co *MedSessionControllerOperations) CreateMedicalSession(c *gin.Context, e
 	}
 
 	command := requestData.ToCommand()
+	if employeeID != nil {
+		command.EmployeeID = valueobject.NewEmployeeID(*employeeID)
+	}
+
 	result := co.CommandBus().CreateMedicalSession(c.Request.Context(), *command)
 	if !result.IsSuccess() {
 		response.ApplicationError(c, result.Error())
@@ -193,3 +197,84 @@ func (co *MedSessionControllerOperations) DeleteMedicalSession(c *gin.Context) {
 
 	response.Success(c, nil, result.Message())
 }
+
+func (co *MedSessionControllerOperations) CloseMedicalSession(c *gin.Context, employeeID *uint) {
+	idUint, err := ginUtils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "medical-session", c.Param("id")))
+		return
+	}
+
+	var requestData dto.CloseMedSessionRequest
+	if err := ginUtils.ShouldBindAndValidateBody(c, &requestData, co.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	command, err := requestData.ToCommand(idUint, employeeID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := co.CommandBus().CloseMedicalSession(c.Request.Context(), *command)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Success(c, nil, result.Message())
+}
+
+func (co *MedSessionControllerOperations) ScheduleFollowUp(c *gin.Context, employeeID *uint) {
+	idUint, err := ginUtils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "medical-session", c.Param("id")))
+		return
+	}
+
+	var requestData dto.ScheduleFollowUpRequest
+	if err := ginUtils.ShouldBindAndValidateBody(c, &requestData, co.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	command := requestData.ToCommand(idUint, employeeID)
+	result := co.CommandBus().ScheduleFollowUp(c.Request.Context(), *command)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Success(c, nil, result.Message())
+}
+
+// GetPetVitalsTimeline returns the vitals of the pet across its sessions, only for the customer's
+// pets when the customer is given
+func (co *MedSessionControllerOperations) GetPetVitalsTimeline(c *gin.Context, customerID *uint) {
+	petID, err := ginUtils.ParseParamToUInt(c, "pet_id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "pet", c.Param("pet_id")))
+		return
+	}
+
+	var requestData dto.VitalsTimelineRequest
+	if err := ginUtils.ShouldBindAndValidateQuery(c, &requestData, co.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	timelineQuery, err := requestData.ToQuery(petID, customerID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result, err := co.QueryBus().FindPetVitalsTimeline(c.Request.Context(), timelineQuery)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromVitalsTimelineResult(result), "Vitals Timeline")
+}
@@ -57,3 +57,14 @@ func (ctrl *CustomerMedicalSessionController) GetMyPetSessionsByPetID(c *gin.Con
 
 	ctrl.operation.GetMedicalSessionByCustomerID(c, userCTX.CustomerID, &petID)
 }
+
+// GetMyPetVitalsTimeline returns the vitals timeline of one of the customer's pets
+func (ctrl *CustomerMedicalSessionController) GetMyPetVitalsTimeline(c *gin.Context) {
+	userCTX, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.Unauthorized(c, autherror.UnauthorizedCTXError())
+		return
+	}
+
+	ctrl.operation.GetPetVitalsTimeline(c, &userCTX.CustomerID)
+}
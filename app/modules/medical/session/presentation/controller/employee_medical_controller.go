@@ -49,3 +49,31 @@ func (ctrl *EmployeeMedicalSessionController) GetMyMedicalSessionByID(c *gin.Con
 		EmployeeID: &userCTX.EmployeeID,
 	})
 }
+
+// CloseMedicalSession closes the draft session of one of the employee's visits and completes its appointment
+func (ctrl *EmployeeMedicalSessionController) CloseMedicalSession(c *gin.Context) {
+	userCTX, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.BadRequest(c, autherror.UnauthorizedCTXError())
+		return
+	}
+
+	ctrl.operations.CloseMedicalSession(c, &userCTX.EmployeeID)
+}
+
+// ScheduleFollowUp sets, moves or clears the follow-up date of one of the employee's sessions
+func (ctrl *EmployeeMedicalSessionController) ScheduleFollowUp(c *gin.Context) {
+	userCTX, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		response.BadRequest(c, autherror.UnauthorizedCTXError())
+		return
+	}
+
+	ctrl.operations.ScheduleFollowUp(c, &userCTX.EmployeeID)
+}
+
+// GetPetVitalsTimeline returns the weight, temperature, heart rate and respiratory rate of a pet
+// across its sessions with the weight trend, rolling averages and sudden weight loss flags
+func (ctrl *EmployeeMedicalSessionController) GetPetVitalsTimeline(c *gin.Context) {
+	ctrl.operations.GetPetVitalsTimeline(c, nil)
+}
@@ -144,6 +144,44 @@ type UpdateMedSessionRequest struct {
 	Treatment *string `json:"treatment,omitempty" validate:"omitempty,max=500"`
 }
 
+// CloseMedSessionRequest represents the final findings recorded when closing the draft session of a visit
+// swagger:model CloseMedSessionRequest
+type CloseMedSessionRequest struct {
+	// The diagnosis made during the visit, required unless already recorded on the draft
+	// Required: false
+	// Example: Otitis externa
+	Diagnosis string `json:"diagnosis" validate:"omitempty,max=500"`
+
+	// The treatment prescribed
+	// Required: false
+	// Example: Antibiotics for 7 days
+	Treatment string `json:"treatment" validate:"omitempty,max=500"`
+
+	// The medical condition observed
+	// Required: false
+	// Example: stable
+	Condition string `json:"condition" validate:"omitempty,max=200"`
+
+	// Additional notes about the visit
+	// Required: false
+	// Example: Patient responded well to treatment
+	Notes *string `json:"notes,omitempty" validate:"omitempty,max=1000"`
+
+	// The date the patient should come back, proposes a follow-up appointment to the owner
+	// Required: false
+	// Example: 2023-10-29T10:00:00Z
+	FollowUpDate *time.Time `json:"follow_up_date,omitempty" validate:"omitempty"`
+}
+
+// ScheduleFollowUpRequest sets, moves or clears the follow-up date of a medical session
+// swagger:model ScheduleFollowUpRequest
+type ScheduleFollowUpRequest struct {
+	// The date the patient should come back, omit it to cancel the proposed follow-up appointment
+	// Required: false
+	// Example: 2023-10-29T10:00:00Z
+	FollowUpDate *time.Time `json:"follow_up_date,omitempty" validate:"omitempty"`
+}
+
 func (req *AdminCreateMedSessionRequest) ToCommand() *command.CreateMedSessionCommand {
 	return &command.CreateMedSessionCommand{
 		CustomerID: valueobject.NewCustomerID(req.CustomerID),
@@ -206,3 +244,41 @@ func (req *UpdateMedSessionRequest) ToUpdateCommand(medSessionID uint) *command.
 		Date:      req.Date,
 	}
 }
+
+func (req *CloseMedSessionRequest) ToCommand(medSessionID uint, employeeID *uint) (*command.CloseMedSessionCommand, error) {
+	cmd := &command.CloseMedSessionCommand{
+		ID:        valueobject.NewMedSessionID(medSessionID),
+		Diagnosis: req.Diagnosis,
+		Treatment: req.Treatment,
+		Notes:     req.Notes,
+
+		FollowUpDate: req.FollowUpDate,
+	}
+
+	if req.Condition != "" {
+		condition, err := enum.ParsePetCondition(req.Condition)
+		if err != nil {
+			return nil, err
+		}
+		cmd.Condition = condition
+	}
+
+	if employeeID != nil {
+		id := valueobject.NewEmployeeID(*employeeID)
+		cmd.EmployeeID = &id
+	}
+	return cmd, nil
+}
+
+func (req *ScheduleFollowUpRequest) ToCommand(medSessionID uint, employeeID *uint) *command.ScheduleFollowUpCommand {
+	cmd := &command.ScheduleFollowUpCommand{
+		ID:           valueobject.NewMedSessionID(medSessionID),
+		FollowUpDate: req.FollowUpDate,
+	}
+
+	if employeeID != nil {
+		id := valueobject.NewEmployeeID(*employeeID)
+		cmd.EmployeeID = &id
+	}
+	return cmd
+}
@@ -3,6 +3,7 @@ package dto
 import (
 	"time"
 
+	"clinic-vet-api/app/modules/core/domain/enum"
 	"clinic-vet-api/app/modules/core/domain/valueobject"
 	"clinic-vet-api/app/modules/medical/session/application/query"
 	commondto "clinic-vet-api/app/shared/dto"
@@ -21,6 +22,28 @@ type MedSessionResponse struct {
 	// Example: 3
 	EmployeeID uint `json:"employee_id"`
 
+	// The ID of the appointment the session was opened from
+	// Required: false
+	// Example: 42
+	AppointmentID *uint `json:"appointment_id,omitempty"`
+
+	// Whether the session was opened for an emergency or walk-in
+	// Required: true
+	// Example: false
+	IsEmergency bool `json:"is_emergency"`
+
+	// Whether the session is still a draft being filled in or closed
+	// Required: true
+	// Enum: draft, closed
+	// Example: closed
+	Status string `json:"status"`
+
+	// When the session was closed, absent for drafts and records created closed
+	// Required: false
+	// Format: date-time
+	// Example: 2023-10-15T15:10:00Z
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+
 	// The date and time of the medical visit
 	// Required: true
 	// Format: date-time
@@ -127,12 +150,45 @@ type PetSessionSummaryResponse struct {
 	// Format: date-time
 	// Example: 2023-10-22T14:30:00Z
 	FollowUpDate *time.Time `json:"follow_up_date,omitempty"`
+
+	// The weight compared with the normal range of the pet's species and life stage
+	// Required: false
+	// Enum: low, normal, high
+	// Example: normal
+	WeightFlag *string `json:"weight_flag,omitempty"`
+
+	// The temperature compared with the normal range of the pet's species and life stage
+	// Required: false
+	// Enum: low, normal, high
+	// Example: high
+	TemperatureFlag *string `json:"temperature_flag,omitempty"`
+
+	// The heart rate compared with the normal range of the pet's species and life stage
+	// Required: false
+	// Enum: low, normal, high
+	// Example: normal
+	HeartRateFlag *string `json:"heart_rate_flag,omitempty"`
+
+	// The respiratory rate compared with the normal range of the pet's species and life stage
+	// Required: false
+	// Enum: low, normal, high
+	// Example: normal
+	RespiratoryRateFlag *string `json:"respiratory_rate_flag,omitempty"`
+
+	// Whether any of the vitals is out of its normal range
+	// Required: true
+	// Example: true
+	HasAbnormalVitals bool `json:"has_abnormal_vitals"`
 }
 
 func FromResult(res *query.MedSessionResult) *MedSessionResponse {
 	response := &MedSessionResponse{
 		ID:              res.ID.Value(),
 		EmployeeID:      res.EmployeeID.Value(),
+		AppointmentID:   valueobject.OptAppointmentIDToUint(res.AppointmentID),
+		IsEmergency:     res.IsEmergency,
+		Status:          res.Status.String(),
+		ClosedAt:        res.ClosedAt,
 		Date:            res.VisitDate,
 		VisitType:       res.VisitType.String(),
 		ServiceProvided: res.ClinicService.String(),
@@ -151,11 +207,25 @@ func FromResult(res *query.MedSessionResult) *MedSessionResponse {
 			Symptoms:        res.PetDetailsResult.Symptoms,
 			Medications:     res.PetDetailsResult.Medications,
 			FollowUpDate:    res.PetDetailsResult.FollowUpDate,
+
+			WeightFlag:          vitalFlagToStringPtr(res.PetDetailsResult.VitalFlags.Weight),
+			TemperatureFlag:     vitalFlagToStringPtr(res.PetDetailsResult.VitalFlags.Temperature),
+			HeartRateFlag:       vitalFlagToStringPtr(res.PetDetailsResult.VitalFlags.HeartRate),
+			RespiratoryRateFlag: vitalFlagToStringPtr(res.PetDetailsResult.VitalFlags.RespiratoryRate),
+			HasAbnormalVitals:   res.PetDetailsResult.VitalFlags.HasAbnormal(),
 		},
 	}
 	return response
 }
 
+func vitalFlagToStringPtr(flag *enum.VitalFlag) *string {
+	if flag == nil {
+		return nil
+	}
+	s := flag.String()
+	return &s
+}
+
 func decimalToFloat64Ptr(d *valueobject.Decimal) *float64 {
 	if d == nil {
 		return nil
@@ -0,0 +1,159 @@
+package dto
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/medical/session/application/query"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+)
+
+// VitalsTimelineRequest represents the period and the rolling average window of a vitals timeline
+type VitalsTimelineRequest struct {
+	// First day of the timeline, two years before the end date when omitted
+	// Required: false
+	// Format: date
+	// Example: 2024-01-01
+	StartDate *string `form:"start_date" validate:"omitempty,datetime=2006-01-02"`
+
+	// Last day of the timeline, today when omitted
+	// Required: false
+	// Format: date
+	// Example: 2025-06-30
+	EndDate *string `form:"end_date" validate:"omitempty,datetime=2006-01-02"`
+
+	// Number of readings the rolling averages are taken over
+	// Required: false
+	// Minimum: 1
+	// Maximum: 10
+	// Example: 3
+	Window *int `form:"window" validate:"omitempty,min=1,max=10"`
+}
+
+func (r *VitalsTimelineRequest) ToQuery(petID uint, customerID *uint) (query.FindPetVitalsTimelineQuery, error) {
+	startDate, err := parseOptDate("start_date", r.StartDate)
+	if err != nil {
+		return query.FindPetVitalsTimelineQuery{}, err
+	}
+
+	endDate, err := parseOptDate("end_date", r.EndDate)
+	if err != nil {
+		return query.FindPetVitalsTimelineQuery{}, err
+	}
+
+	if endDate != nil {
+		endOfDay := endDate.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		endDate = &endOfDay
+	}
+
+	return query.NewFindPetVitalsTimelineQuery(petID, customerID, startDate, endDate, r.Window)
+}
+
+func parseOptDate(field string, value *string) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	date, err := time.ParseInLocation(time.DateOnly, *value, time.Local)
+	if err != nil {
+		return nil, httpError.ValidationError(field, *value, "must use the YYYY-MM-DD format")
+	}
+	return &date, nil
+}
+
+// VitalsTimelineResponse represents the vitals of a pet across its sessions, oldest first
+// swagger:model VitalsTimelineResponse
+type VitalsTimelineResponse struct {
+	// The unique identifier of the pet
+	// Example: 5
+	PetID uint `json:"pet_id"`
+
+	// Number of readings the rolling averages are taken over
+	// Example: 3
+	Window int `json:"window"`
+
+	// Percentage the weight changed between the first and the last weighing of the period
+	// Required: false
+	// Example: -8.4
+	WeightChangePercent *float64 `json:"weight_change_percent,omitempty"`
+
+	// Whether any weighing of the period is flagged as a sudden weight loss
+	// Example: true
+	SuddenWeightLoss bool `json:"sudden_weight_loss"`
+
+	Readings []VitalsReadingResponse `json:"readings"`
+}
+
+// VitalsReadingResponse represents the vitals taken in a session and the trends up to it
+type VitalsReadingResponse struct {
+	// The unique identifier of the medical session
+	// Example: 42
+	SessionID uint `json:"session_id"`
+
+	// The date and time of the medical visit
+	// Format: date-time
+	// Example: 2025-03-10T10:00:00Z
+	VisitDate time.Time `json:"visit_date"`
+
+	// The weight of the pet in kilograms
+	// Example: 11.2
+	Weight *float64 `json:"weight,omitempty"`
+
+	// The temperature of the pet in Celsius
+	// Example: 38.6
+	Temperature *float64 `json:"temperature,omitempty"`
+
+	// The heart rate of the pet in beats per minute
+	// Example: 110
+	HeartRate *int32 `json:"heart_rate,omitempty"`
+
+	// The respiratory rate of the pet in breaths per minute
+	// Example: 24
+	RespiratoryRate *int32 `json:"respiratory_rate,omitempty"`
+
+	// Percentage the weight changed since the previous weighing
+	// Example: -6.2
+	WeightChangePercent *float64 `json:"weight_change_percent,omitempty"`
+
+	// Days since the previous weighing
+	// Example: 45
+	DaysSinceWeighing *int `json:"days_since_weighing,omitempty"`
+
+	// Whether the pet lost 5% or more of its weight in less than 90 days
+	// Example: true
+	SuddenWeightLoss bool `json:"sudden_weight_loss"`
+
+	// Rolling averages of the vitals over the window, this reading included
+	AvgWeight          *float64 `json:"avg_weight,omitempty"`
+	AvgTemperature     *float64 `json:"avg_temperature,omitempty"`
+	AvgHeartRate       *float64 `json:"avg_heart_rate,omitempty"`
+	AvgRespiratoryRate *float64 `json:"avg_respiratory_rate,omitempty"`
+}
+
+func FromVitalsTimelineResult(result query.VitalsTimelineResult) VitalsTimelineResponse {
+	readings := make([]VitalsReadingResponse, len(result.Readings))
+	for i, reading := range result.Readings {
+		readings[i] = VitalsReadingResponse{
+			SessionID:           reading.SessionID.Value(),
+			VisitDate:           reading.VisitDate,
+			Weight:              reading.Weight,
+			Temperature:         reading.Temperature,
+			HeartRate:           reading.HeartRate,
+			RespiratoryRate:     reading.RespiratoryRate,
+			WeightChangePercent: reading.WeightChangePercent,
+			DaysSinceWeighing:   reading.DaysSinceWeighing,
+			SuddenWeightLoss:    reading.SuddenWeightLoss,
+			AvgWeight:           reading.AvgWeight,
+			AvgTemperature:      reading.AvgTemperature,
+			AvgHeartRate:        reading.AvgHeartRate,
+			AvgRespiratoryRate:  reading.AvgRespiratoryRate,
+		}
+	}
+
+	return VitalsTimelineResponse{
+		PetID:               result.PetID.Value(),
+		Window:              result.Window,
+		WeightChangePercent: result.WeightChangePercent,
+		SuddenWeightLoss:    result.SuddenWeightLoss,
+		Readings:            readings,
+	}
+}
@@ -3,12 +3,14 @@ package medSessionAPI
 import (
 	"clinic-vet-api/app/middleware"
 	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
 	command "clinic-vet-api/app/modules/medical/session/application/command"
 	facade "clinic-vet-api/app/modules/medical/session/application/facade_service"
 	query "clinic-vet-api/app/modules/medical/session/application/query"
 	repositoryimpl "clinic-vet-api/app/modules/medical/session/infrastructure/persistence/repository"
 	controller "clinic-vet-api/app/modules/medical/session/presentation/controller"
 	"clinic-vet-api/app/modules/medical/session/presentation/routes"
+	"clinic-vet-api/app/shared/database"
 	"clinic-vet-api/sqlc"
 	"fmt"
 
@@ -19,11 +21,20 @@ import (
 type MedicalSessionModuleConfig struct {
 	Router         *gin.RouterGroup
 	Queries        *sqlc.Queries
+	Transactor     *database.Transactor
 	Validator      *validator.Validate
 	CustomerRepo   *repository.CustomerRepository
 	EmployeeRepo   *repository.EmployeeRepository
 	PetRepo        *repository.PetRepository
+	ApptRepo       repository.AppointmentRepository
+	VisitRepo      repository.AppointmentVisitRepository
+	CalendarRepo   repository.ClinicCalendarRepository
+	ExceptionRepo  repository.ScheduleExceptionRepository
+	UserRepo       repository.UserRepository
 	AuthMiddleware *middleware.AuthMiddleware
+
+	NotificationService service.NotificationService
+	OnCallService       *service.OnCallService
 }
 
 type MedicalSessionControllers struct {
@@ -76,12 +87,30 @@ func (m *MedicalSessionModule) Bootstrap() error {
 }
 
 func (m *MedicalSessionModule) createRepository() repository.MedicalSessionRepository {
-	return repositoryimpl.NewSQLCMedSessionRepository(m.config.Queries)
+	return repositoryimpl.NewSQLCMedSessionRepository(m.config.Queries, m.config.Transactor)
 }
 
 func (m *MedicalSessionModule) createBus(repository repository.MedicalSessionRepository) facade.MedicalApplicationService {
-	commandHandlers := command.NewMedicalSessionCommandHandlers(repository)
-	queryHandlers := query.NewMedicalSessionQueryHandler(repository)
+	contactService := service.NewCustomerContactService(*m.config.CustomerRepo, m.config.UserRepo)
+	followUps := service.NewFollowUpService(
+		m.config.ApptRepo,
+		m.config.VisitRepo,
+		m.config.CalendarRepo,
+		m.config.ExceptionRepo,
+		contactService,
+		m.config.NotificationService,
+	)
+
+	commandHandlers := command.NewMedicalSessionCommandHandlers(
+		repository,
+		m.config.ApptRepo,
+		m.config.VisitRepo,
+		*m.config.PetRepo,
+		followUps,
+		m.config.OnCallService,
+		service.NewVitalRangeCatalog(),
+	)
+	queryHandlers := query.NewMedicalSessionQueryHandler(repository, *m.config.PetRepo)
 	return facade.NewMedicalApplicationService(
 		commandHandlers,
 		queryHandlers,
@@ -118,6 +147,9 @@ func (m *MedicalSessionModule) validateConfig() error {
 	if m.config.Queries == nil {
 		return fmt.Errorf("queries cannot be nil")
 	}
+	if m.config.Transactor == nil {
+		return fmt.Errorf("transactor cannot be nil")
+	}
 	if m.config.Validator == nil {
 		return fmt.Errorf("validator cannot be nil")
 	}
@@ -130,6 +162,27 @@ func (m *MedicalSessionModule) validateConfig() error {
 	if m.config.PetRepo == nil {
 		return fmt.Errorf("pet repository cannot be nil")
 	}
+	if m.config.ApptRepo == nil {
+		return fmt.Errorf("appointment repository cannot be nil")
+	}
+	if m.config.VisitRepo == nil {
+		return fmt.Errorf("appointment visit repository cannot be nil")
+	}
+	if m.config.ExceptionRepo == nil {
+		return fmt.Errorf("schedule exception repository cannot be nil")
+	}
+	if m.config.CalendarRepo == nil {
+		return fmt.Errorf("calendar repository cannot be nil")
+	}
+	if m.config.UserRepo == nil {
+		return fmt.Errorf("user repository cannot be nil")
+	}
+	if m.config.NotificationService == nil {
+		return fmt.Errorf("notification service cannot be nil")
+	}
+	if m.config.OnCallService == nil {
+		return fmt.Errorf("on-call service cannot be nil")
+	}
 
 	if m.config.AuthMiddleware == nil {
 		return fmt.Errorf("auth middleware cannot be nil")
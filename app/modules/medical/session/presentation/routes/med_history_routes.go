@@ -45,6 +45,7 @@ func (r *MedicalSessionRoutes) RegisterCustomerRoutes(routerGroup *gin.RouterGro
 
 	routes.GET("/", r.CustomerController.GetMyPetSessions)
 	routes.GET("/:id", r.CustomerController.GetMyPetSessionsByPetID)
+	routes.GET("/pets/:pet_id/vitals", r.CustomerController.GetMyPetVitalsTimeline)
 }
 
 func (r *MedicalSessionRoutes) RegisterEmployeeRoutes(routerGroup *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware) {
@@ -54,5 +55,8 @@ func (r *MedicalSessionRoutes) RegisterEmployeeRoutes(routerGroup *gin.RouterGro
 
 	routes.GET("/", r.EmployeeController.GetMyMedicalSessions)
 	routes.GET("/:id", r.EmployeeController.GetMyMedicalSessionByID)
+	routes.GET("/pets/:pet_id/vitals", r.EmployeeController.GetPetVitalsTimeline)
 	routes.POST("/", r.EmployeeController.RegisterMedicalSession)
+	routes.PUT("/:id/close", r.EmployeeController.CloseMedicalSession)
+	routes.PUT("/:id/follow-up", r.EmployeeController.ScheduleFollowUp)
 }
@@ -15,6 +15,8 @@ type RegisterVaccinationCommand struct {
 	BatchNumber      string
 	Notes            *string
 	NextDueDate      *time.Time
+	// LotID is the stock lot the dose was taken from, its lot number is recorded as the batch
+	LotID *vo.StockLotID
 }
 
 func (cmd *RegisterVaccinationCommand) ToEntity(nextDueDate *time.Time) medical.PetVaccination {
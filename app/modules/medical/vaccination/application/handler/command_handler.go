@@ -1,7 +1,9 @@
 package handler
 
 import (
+	"clinic-vet-api/app/modules/core/domain/entity/inventory"
 	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
 	vo "clinic-vet-api/app/modules/core/domain/valueobject"
 	"clinic-vet-api/app/modules/core/repository"
 	"clinic-vet-api/app/modules/core/service"
@@ -19,6 +21,7 @@ var (
 	FailCalculatingNextDueDateMsg = "failed to calculate next due date"
 	FailVaccineConflictMsg        = "vaccine conflict detected"
 	FailVaccineValidationMsg      = "vaccine validation failed"
+	FailVaccineLotMsg             = "vaccine lot cannot be dispensed"
 
 	SuccesRegisteringVaccinationMsg = "vaccination registered successfully"
 	SuccesUpdatingVaccinationMsg    = "vaccination updated successfully"
@@ -29,17 +32,20 @@ type PetVaccineCmdHandler struct {
 	petRepo            repository.PetRepository
 	vaccinationService *service.VaccinationScheduleService
 	vaccinationRepo    repository.VaccinationRepository
+	stockService       *service.StockService
 }
 
 func NewPetVaccineCmdHandler(
 	petRepo repository.PetRepository,
 	vaccinationRepo repository.VaccinationRepository,
 	vaccinationService *service.VaccinationScheduleService,
+	stockService *service.StockService,
 ) *PetVaccineCmdHandler {
 	return &PetVaccineCmdHandler{
 		petRepo:            petRepo,
 		vaccinationRepo:    vaccinationRepo,
 		vaccinationService: vaccinationService,
+		stockService:       stockService,
 	}
 }
 
@@ -74,8 +80,23 @@ func (h *PetVaccineCmdHandler) HandleRegister(ctx context.Context, cmd c.Registe
 		}
 	}
 
+	now := time.Now()
+	if cmd.LotID != nil {
+		lot, err := h.stockService.CheckDispensable(ctx, *cmd.LotID, 1, now, enum.ProductCategoryVaccine)
+		if err != nil {
+			return cqrs.FailureResult(FailVaccineLotMsg, err)
+		}
+		cmd.BatchNumber = lot.LotNumber()
+	}
+
 	vaccination := cmd.ToEntity(nextDueDate)
-	vaccineCreated, err := h.vaccinationRepo.Save(ctx, vaccination)
+	var vaccineCreated medical.PetVaccination
+	if cmd.LotID != nil {
+		dose := inventory.Dispensing{LotID: *cmd.LotID, Quantity: 1, DispensedBy: cmd.AdministeredBy, At: now}
+		vaccineCreated, err = h.vaccinationRepo.SaveDispensed(ctx, vaccination, dose)
+	} else {
+		vaccineCreated, err = h.vaccinationRepo.Save(ctx, vaccination)
+	}
 	if err != nil {
 		return cqrs.FailureResult(FailSavingVaccinationMsg, err)
 	}
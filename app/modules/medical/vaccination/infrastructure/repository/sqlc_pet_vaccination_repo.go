@@ -1,9 +1,13 @@
 package repository
 
 import (
+	"clinic-vet-api/app/modules/core/domain/entity/inventory"
 	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
 	"clinic-vet-api/app/modules/core/domain/valueobject"
 	"clinic-vet-api/app/modules/core/repository"
+	inventoryRepo "clinic-vet-api/app/modules/inventory/infrastructure/repository"
+	"clinic-vet-api/app/shared/database"
 	"clinic-vet-api/app/shared/mapper"
 	"clinic-vet-api/app/shared/page"
 	"clinic-vet-api/sqlc"
@@ -12,12 +16,17 @@ import (
 )
 
 type SqlcPetVaccinationRepository struct {
-	queries *sqlc.Queries
-	pgMap   *mapper.SqlcFieldMapper
+	queries    *sqlc.Queries
+	transactor *database.Transactor
+	pgMap      *mapper.SqlcFieldMapper
 }
 
-func NewSqlcPetVaccinationRepository(queries *sqlc.Queries, pgMap *mapper.SqlcFieldMapper) repository.VaccinationRepository {
-	return &SqlcPetVaccinationRepository{queries: queries, pgMap: pgMap}
+func NewSqlcPetVaccinationRepository(
+	queries *sqlc.Queries,
+	transactor *database.Transactor,
+	pgMap *mapper.SqlcFieldMapper,
+) repository.VaccinationRepository {
+	return &SqlcPetVaccinationRepository{queries: queries, transactor: transactor, pgMap: pgMap}
 }
 
 func (r *SqlcPetVaccinationRepository) FindAllByPetID(ctx context.Context, petID valueobject.PetID) ([]medical.PetVaccination, error) {
@@ -159,6 +168,30 @@ func (r *SqlcPetVaccinationRepository) Save(ctx context.Context, vaccination med
 	}
 }
 
+func (r *SqlcPetVaccinationRepository) SaveDispensed(
+	ctx context.Context,
+	vaccination medical.PetVaccination,
+	dispensing inventory.Dispensing,
+) (medical.PetVaccination, error) {
+	var created sqlc.PetVaccination
+	err := r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+		_, err := inventoryRepo.DispenseWithin(ctx, queries, dispensing, func() (inventory.StockReference, error) {
+			row, err := queries.CreatePetVaccination(ctx, r.EntityToCreateParams(vaccination))
+			if err != nil {
+				return inventory.StockReference{}, err
+			}
+			created = row
+			return inventory.StockReference{Type: enum.StockReferenceVaccination, ID: uint(row.ID)}, nil
+		})
+		return err
+	})
+	if err != nil {
+		return medical.PetVaccination{}, err
+	}
+
+	return r.SqlcRowToEntity(created), nil
+}
+
 func (r *SqlcPetVaccinationRepository) Delete(ctx context.Context, vaccinationID valueobject.VaccinationID) error {
 	return r.queries.DeleteVaccination(ctx, vaccinationID.Int32())
 }
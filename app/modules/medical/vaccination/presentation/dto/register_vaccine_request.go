@@ -11,7 +11,8 @@ type RegisterVaccineRequest struct {
 	VaccineName      string     `json:"vaccine_name" binding:"required"`
 	VaccineType      string     `json:"vaccine_type" binding:"required"`
 	AdministeredDate time.Time  `json:"administered_date" binding:"required"`
-	BatchNumber      string     `json:"batch_number" binding:"required"`
+	BatchNumber      string     `json:"batch_number" binding:"required_without=LotID"`
+	LotID            *uint      `json:"lot_id,omitempty"`
 	Notes            *string    `json:"notes,omitempty"`
 	NextDueDate      *time.Time `json:"next_due_date,omitempty"`
 }
@@ -26,6 +27,7 @@ func (r *RegisterVaccineRequest) ToCommand(administeredBy uint) (command.Registe
 		BatchNumber:      r.BatchNumber,
 		Notes:            r.Notes,
 		NextDueDate:      r.NextDueDate,
+		LotID:            valueobject.NewOptStockLotID(r.LotID),
 	}, nil
 }
 
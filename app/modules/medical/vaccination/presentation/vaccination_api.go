@@ -9,6 +9,7 @@ import (
 	sqlcRepo "clinic-vet-api/app/modules/medical/vaccination/infrastructure/repository"
 	"clinic-vet-api/app/modules/medical/vaccination/presentation/controller"
 	"clinic-vet-api/app/modules/medical/vaccination/presentation/routes"
+	"clinic-vet-api/app/shared/database"
 	"clinic-vet-api/app/shared/mapper"
 	"clinic-vet-api/sqlc"
 	"errors"
@@ -24,7 +25,9 @@ type VaccinationConfig struct {
 	PetRepo        repository.PetRepository
 	EmployeeRepo   repository.EmployeeRepository
 	CustomerRepo   repository.CustomerRepository
+	StockService   *service.StockService
 	Queries        *sqlc.Queries
+	Transactor     *database.Transactor
 }
 
 type VaccinationComponents struct {
@@ -61,10 +64,10 @@ func (b *VaccinationAPIModule) Bootstrap() error {
 		return err
 	}
 
-	repo := sqlcRepo.NewSqlcPetVaccinationRepository(b.config.Queries, mapper.NewSqlcFieldMapper())
+	repo := sqlcRepo.NewSqlcPetVaccinationRepository(b.config.Queries, b.config.Transactor, mapper.NewSqlcFieldMapper())
 	vaccinationScheduleService := service.NewVaccinationScheduleService(nil)
 
-	petVaccineCmdHandler := handler.NewPetVaccineCmdHandler(b.config.PetRepo, repo, vaccinationScheduleService)
+	petVaccineCmdHandler := handler.NewPetVaccineCmdHandler(b.config.PetRepo, repo, vaccinationScheduleService, b.config.StockService)
 	petVaccineQryHandler := handler.NewVaccinationQueryHandler(repo, b.config.EmployeeRepo, b.config.PetRepo)
 	service := application.NewVaccinationFacadeService(petVaccineQryHandler, petVaccineCmdHandler)
 
@@ -108,6 +111,10 @@ func (b *VaccinationAPIModule) validateConfig() error {
 		return errors.New("queries is nil")
 	}
 
+	if b.config.Transactor == nil {
+		return errors.New("transactor is nil")
+	}
+
 	if b.config.PetRepo == nil {
 		return errors.New("pet repository is nil")
 	}
@@ -120,5 +127,9 @@ func (b *VaccinationAPIModule) validateConfig() error {
 		return errors.New("customer repository is nil")
 	}
 
+	if b.config.StockService == nil {
+		return errors.New("stock service is nil")
+	}
+
 	return nil
 }
@@ -0,0 +1,39 @@
+package command
+
+import (
+	"strings"
+
+	"clinic-vet-api/app/modules/core/domain/entity/resource"
+	"clinic-vet-api/app/modules/core/domain/enum"
+)
+
+type CreateResourceCommand struct {
+	name         string
+	resourceType enum.ResourceType
+	description  *string
+}
+
+func NewCreateResourceCommand(name, resourceType string, description *string) (CreateResourceCommand, error) {
+	resourceTypeEnum, err := enum.ParseResourceType(resourceType)
+	if err != nil {
+		return CreateResourceCommand{}, createResourceCmdErr("resourceType", err.Error())
+	}
+
+	if strings.TrimSpace(name) == "" {
+		return CreateResourceCommand{}, createResourceCmdErr("name", "is required")
+	}
+
+	return CreateResourceCommand{
+		name:         name,
+		resourceType: resourceTypeEnum,
+		description:  description,
+	}, nil
+}
+
+func (c *CreateResourceCommand) ToEntity() *resource.ClinicResource {
+	return resource.NewClinicResourceBuilder().
+		WithName(c.name).
+		WithResourceType(c.resourceType).
+		WithDescription(c.description).
+		Build()
+}
@@ -0,0 +1,17 @@
+package command
+
+import "clinic-vet-api/app/modules/core/domain/valueobject"
+
+type DeactivateResourceCommand struct {
+	resourceID valueobject.ResourceID
+}
+
+func NewDeactivateResourceCommand(resourceID uint) (DeactivateResourceCommand, error) {
+	if resourceID == 0 {
+		return DeactivateResourceCommand{}, deactivateResourceCmdErr("resourceID", "is required")
+	}
+
+	return DeactivateResourceCommand{resourceID: valueobject.NewResourceID(resourceID)}, nil
+}
+
+func (c DeactivateResourceCommand) ResourceID() valueobject.ResourceID { return c.resourceID }
@@ -0,0 +1,21 @@
+package command
+
+import (
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+func createResourceCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "CreateResourceCommand")
+}
+
+func updateResourceCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "UpdateResourceCommand")
+}
+
+func deactivateResourceCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "DeactivateResourceCommand")
+}
+
+func requirementsCmdErr(field, issue string) error {
+	return apperror.CommandDataValidationError(field, issue, "ReplaceRequirementsCommand")
+}
@@ -0,0 +1,49 @@
+package command
+
+import (
+	"fmt"
+
+	"clinic-vet-api/app/modules/core/domain/entity/resource"
+	"clinic-vet-api/app/modules/core/domain/enum"
+)
+
+// RequirementInput is the quantity of a resource type required by the service
+type RequirementInput struct {
+	ResourceType string
+	Quantity     int
+}
+
+// ReplaceRequirementsCommand sets every resource the service requires, an empty list means the
+// service no longer reserves rooms or equipment
+type ReplaceRequirementsCommand struct {
+	service      enum.ClinicService
+	requirements []resource.ServiceRequirement
+}
+
+func NewReplaceRequirementsCommand(service string, inputs []RequirementInput) (ReplaceRequirementsCommand, error) {
+	serviceEnum, err := enum.ParseClinicService(service)
+	if err != nil {
+		return ReplaceRequirementsCommand{}, requirementsCmdErr("service", err.Error())
+	}
+
+	requirements := make([]resource.ServiceRequirement, len(inputs))
+	for i, input := range inputs {
+		resourceType, err := enum.ParseResourceType(input.ResourceType)
+		if err != nil {
+			return ReplaceRequirementsCommand{}, requirementsCmdErr(fmt.Sprintf("requirements[%d].resourceType", i), err.Error())
+		}
+
+		requirements[i] = resource.ServiceRequirement{
+			Service:      serviceEnum,
+			ResourceType: resourceType,
+			Quantity:     input.Quantity,
+		}
+	}
+
+	return ReplaceRequirementsCommand{service: serviceEnum, requirements: requirements}, nil
+}
+
+func (c ReplaceRequirementsCommand) Service() enum.ClinicService { return c.service }
+func (c ReplaceRequirementsCommand) Requirements() []resource.ServiceRequirement {
+	return c.requirements
+}
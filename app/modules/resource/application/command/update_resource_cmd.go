@@ -0,0 +1,43 @@
+package command
+
+import (
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+)
+
+type UpdateResourceCommand struct {
+	resourceID   valueobject.ResourceID
+	name         *string
+	resourceType *enum.ResourceType
+	description  *string
+	isActive     *bool
+}
+
+func NewUpdateResourceCommand(resourceID uint, name, resourceType, description *string, isActive *bool) (UpdateResourceCommand, error) {
+	if resourceID == 0 {
+		return UpdateResourceCommand{}, updateResourceCmdErr("resourceID", "is required")
+	}
+
+	cmd := UpdateResourceCommand{
+		resourceID:  valueobject.NewResourceID(resourceID),
+		name:        name,
+		description: description,
+		isActive:    isActive,
+	}
+
+	if resourceType != nil {
+		resourceTypeEnum, err := enum.ParseResourceType(*resourceType)
+		if err != nil {
+			return UpdateResourceCommand{}, updateResourceCmdErr("resourceType", err.Error())
+		}
+		cmd.resourceType = &resourceTypeEnum
+	}
+
+	return cmd, nil
+}
+
+func (c UpdateResourceCommand) ResourceID() valueobject.ResourceID { return c.resourceID }
+func (c UpdateResourceCommand) Name() *string                      { return c.name }
+func (c UpdateResourceCommand) ResourceType() *enum.ResourceType   { return c.resourceType }
+func (c UpdateResourceCommand) Description() *string               { return c.description }
+func (c UpdateResourceCommand) IsActive() *bool                    { return c.isActive }
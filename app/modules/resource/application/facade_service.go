@@ -0,0 +1,66 @@
+package application
+
+import (
+	"context"
+
+	c "clinic-vet-api/app/modules/resource/application/command"
+	h "clinic-vet-api/app/modules/resource/application/handler"
+	q "clinic-vet-api/app/modules/resource/application/query"
+	"clinic-vet-api/app/shared/cqrs"
+)
+
+type ResourceFacadeService interface {
+	FindResources(ctx context.Context, includeInactive bool) ([]h.ResourceResult, error)
+	FindResourceByID(ctx context.Context, qry q.FindResourceByIDQuery) (h.ResourceResult, error)
+	FindRequirements(ctx context.Context) ([]h.RequirementResult, error)
+	FindReservations(ctx context.Context, qry q.FindReservationsQuery) ([]h.ReservationResult, error)
+
+	CreateResource(ctx context.Context, cmd c.CreateResourceCommand) cqrs.CommandResult
+	UpdateResource(ctx context.Context, cmd c.UpdateResourceCommand) cqrs.CommandResult
+	DeactivateResource(ctx context.Context, cmd c.DeactivateResourceCommand) cqrs.CommandResult
+	ReplaceRequirements(ctx context.Context, cmd c.ReplaceRequirementsCommand) cqrs.CommandResult
+}
+
+type resourceFacadeService struct {
+	qryHandler *h.ResourceQueryHandler
+	cmdHandler *h.ResourceCommandHandler
+}
+
+func NewResourceFacadeService(qryHandler *h.ResourceQueryHandler, cmdHandler *h.ResourceCommandHandler) ResourceFacadeService {
+	return &resourceFacadeService{
+		qryHandler: qryHandler,
+		cmdHandler: cmdHandler,
+	}
+}
+
+func (s *resourceFacadeService) FindResources(ctx context.Context, includeInactive bool) ([]h.ResourceResult, error) {
+	return s.qryHandler.HandleFindResources(ctx, includeInactive)
+}
+
+func (s *resourceFacadeService) FindResourceByID(ctx context.Context, qry q.FindResourceByIDQuery) (h.ResourceResult, error) {
+	return s.qryHandler.HandleFindResourceByID(ctx, qry)
+}
+
+func (s *resourceFacadeService) FindRequirements(ctx context.Context) ([]h.RequirementResult, error) {
+	return s.qryHandler.HandleFindRequirements(ctx)
+}
+
+func (s *resourceFacadeService) FindReservations(ctx context.Context, qry q.FindReservationsQuery) ([]h.ReservationResult, error) {
+	return s.qryHandler.HandleFindReservations(ctx, qry)
+}
+
+func (s *resourceFacadeService) CreateResource(ctx context.Context, cmd c.CreateResourceCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleCreateResource(ctx, cmd)
+}
+
+func (s *resourceFacadeService) UpdateResource(ctx context.Context, cmd c.UpdateResourceCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleUpdateResource(ctx, cmd)
+}
+
+func (s *resourceFacadeService) DeactivateResource(ctx context.Context, cmd c.DeactivateResourceCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleDeactivateResource(ctx, cmd)
+}
+
+func (s *resourceFacadeService) ReplaceRequirements(ctx context.Context, cmd c.ReplaceRequirementsCommand) cqrs.CommandResult {
+	return s.cmdHandler.HandleReplaceRequirements(ctx, cmd)
+}
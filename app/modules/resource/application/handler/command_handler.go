@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"clinic-vet-api/app/modules/core/domain/entity/resource"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/resource/application/command"
+	"clinic-vet-api/app/shared/cqrs"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+var (
+	FailFindResourceMsg          = "failed to find clinic resource"
+	FailValidateResourceMsg      = "clinic resource validation failed"
+	FailSaveResourceMsg          = "failed to save clinic resource"
+	FailResourceNameTakenMsg     = "a clinic resource with that name already exists"
+	FailValidateRequirementsMsg  = "service requirements validation failed"
+	FailReplaceRequirementsMsg   = "failed to save service requirements"
+	SuccessResourceCreatedMsg    = "clinic resource created successfully"
+	SuccessResourceUpdatedMsg    = "clinic resource updated successfully"
+	SuccessResourceDeactivateMsg = "clinic resource deactivated successfully"
+	SuccessRequirementsSavedMsg  = "service requirements updated successfully"
+)
+
+type ResourceCommandHandler struct {
+	resourceRepo repository.ClinicResourceRepository
+}
+
+func NewResourceCommandHandler(resourceRepo repository.ClinicResourceRepository) *ResourceCommandHandler {
+	return &ResourceCommandHandler{resourceRepo: resourceRepo}
+}
+
+func (h *ResourceCommandHandler) HandleCreateResource(ctx context.Context, cmd command.CreateResourceCommand) cqrs.CommandResult {
+	clinicResource := cmd.ToEntity()
+	if err := clinicResource.Validate(ctx); err != nil {
+		return cqrs.FailureResult(FailValidateResourceMsg, err)
+	}
+
+	if err := h.ensureNameAvailable(ctx, clinicResource); err != nil {
+		return cqrs.FailureResult(FailResourceNameTakenMsg, err)
+	}
+
+	if err := h.resourceRepo.Save(ctx, clinicResource); err != nil {
+		return cqrs.FailureResult(FailSaveResourceMsg, err)
+	}
+
+	return cqrs.SuccessCreateResult(clinicResource.ID().String(), SuccessResourceCreatedMsg)
+}
+
+func (h *ResourceCommandHandler) HandleUpdateResource(ctx context.Context, cmd command.UpdateResourceCommand) cqrs.CommandResult {
+	clinicResource, err := h.resourceRepo.FindByID(ctx, cmd.ResourceID())
+	if err != nil {
+		return cqrs.FailureResult(FailFindResourceMsg, err)
+	}
+
+	if err := clinicResource.Update(ctx, cmd.Name(), cmd.ResourceType(), cmd.Description(), cmd.IsActive()); err != nil {
+		return cqrs.FailureResult(FailValidateResourceMsg, err)
+	}
+
+	if err := h.ensureNameAvailable(ctx, &clinicResource); err != nil {
+		return cqrs.FailureResult(FailResourceNameTakenMsg, err)
+	}
+
+	if err := h.resourceRepo.Save(ctx, &clinicResource); err != nil {
+		return cqrs.FailureResult(FailSaveResourceMsg, err)
+	}
+
+	return cqrs.SuccessResult(SuccessResourceUpdatedMsg)
+}
+
+// HandleDeactivateResource withdraws the resource from new bookings, the reservations already
+// made for it are kept
+func (h *ResourceCommandHandler) HandleDeactivateResource(ctx context.Context, cmd command.DeactivateResourceCommand) cqrs.CommandResult {
+	clinicResource, err := h.resourceRepo.FindByID(ctx, cmd.ResourceID())
+	if err != nil {
+		return cqrs.FailureResult(FailFindResourceMsg, err)
+	}
+
+	clinicResource.Deactivate()
+	if err := h.resourceRepo.Save(ctx, &clinicResource); err != nil {
+		return cqrs.FailureResult(FailSaveResourceMsg, err)
+	}
+
+	return cqrs.SuccessResult(SuccessResourceDeactivateMsg)
+}
+
+// HandleReplaceRequirements only affects appointments confirmed or rescheduled afterwards, the
+// existing reservations are left untouched
+func (h *ResourceCommandHandler) HandleReplaceRequirements(ctx context.Context, cmd command.ReplaceRequirementsCommand) cqrs.CommandResult {
+	if err := resource.ValidateRequirements(ctx, cmd.Service(), cmd.Requirements()); err != nil {
+		return cqrs.FailureResult(FailValidateRequirementsMsg, err)
+	}
+
+	if err := h.resourceRepo.ReplaceRequirements(ctx, cmd.Service(), cmd.Requirements()); err != nil {
+		return cqrs.FailureResult(FailReplaceRequirementsMsg, err)
+	}
+
+	return cqrs.SuccessResult(SuccessRequirementsSavedMsg)
+}
+
+func (h *ResourceCommandHandler) ensureNameAvailable(ctx context.Context, clinicResource *resource.ClinicResource) error {
+	var excludeID *valueobject.ResourceID
+	if !clinicResource.ID().IsZero() {
+		id := clinicResource.ID()
+		excludeID = &id
+	}
+
+	exists, err := h.resourceRepo.ExistsByName(ctx, clinicResource.Name(), excludeID)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return apperror.ConflictError("clinic_resource", fmt.Sprintf("a resource named %s already exists", clinicResource.Name()))
+	}
+	return nil
+}
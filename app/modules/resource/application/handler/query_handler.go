@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"context"
+
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/resource/application/query"
+)
+
+type ResourceQueryHandler struct {
+	resourceRepo repository.ClinicResourceRepository
+}
+
+func NewResourceQueryHandler(resourceRepo repository.ClinicResourceRepository) *ResourceQueryHandler {
+	return &ResourceQueryHandler{resourceRepo: resourceRepo}
+}
+
+func (h *ResourceQueryHandler) HandleFindResources(ctx context.Context, includeInactive bool) ([]ResourceResult, error) {
+	resources, err := h.resourceRepo.FindAll(ctx, includeInactive)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]ResourceResult, len(resources))
+	for i, clinicResource := range resources {
+		results[i] = toResourceResult(clinicResource)
+	}
+	return results, nil
+}
+
+func (h *ResourceQueryHandler) HandleFindResourceByID(ctx context.Context, qry query.FindResourceByIDQuery) (ResourceResult, error) {
+	clinicResource, err := h.resourceRepo.FindByID(ctx, qry.ResourceID())
+	if err != nil {
+		return ResourceResult{}, err
+	}
+
+	return toResourceResult(clinicResource), nil
+}
+
+func (h *ResourceQueryHandler) HandleFindRequirements(ctx context.Context) ([]RequirementResult, error) {
+	requirements, err := h.resourceRepo.FindAllRequirements(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRequirementResults(requirements), nil
+}
+
+func (h *ResourceQueryHandler) HandleFindReservations(ctx context.Context, qry query.FindReservationsQuery) ([]ReservationResult, error) {
+	if _, err := h.resourceRepo.FindByID(ctx, qry.ResourceID()); err != nil {
+		return nil, err
+	}
+
+	reservations, err := h.resourceRepo.FindReservations(ctx, qry.ResourceID(), qry.StartDate(), qry.EndDate().AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	return toReservationResults(reservations), nil
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/resource"
+)
+
+type ResourceResult struct {
+	ID           uint
+	Name         string
+	ResourceType string
+	Kind         string
+	Description  *string
+	IsActive     bool
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type RequirementResult struct {
+	Service      string
+	ResourceType string
+	Quantity     int
+}
+
+type ReservationResult struct {
+	ID            uint
+	AppointmentID uint
+	Service       string
+	Status        string
+	StartTime     time.Time
+	EndTime       time.Time
+	IsHeld        bool
+}
+
+func toResourceResult(clinicResource resource.ClinicResource) ResourceResult {
+	return ResourceResult{
+		ID:           clinicResource.ID().Value(),
+		Name:         clinicResource.Name(),
+		ResourceType: clinicResource.ResourceType().String(),
+		Kind:         clinicResource.Kind().String(),
+		Description:  clinicResource.Description(),
+		IsActive:     clinicResource.IsActive(),
+		CreatedAt:    clinicResource.CreatedAt(),
+		UpdatedAt:    clinicResource.UpdatedAt(),
+	}
+}
+
+func toRequirementResults(requirements []resource.ServiceRequirement) []RequirementResult {
+	results := make([]RequirementResult, len(requirements))
+	for i, requirement := range requirements {
+		results[i] = RequirementResult{
+			Service:      requirement.Service.String(),
+			ResourceType: requirement.ResourceType.String(),
+			Quantity:     requirement.Quantity,
+		}
+	}
+	return results
+}
+
+func toReservationResults(reservations []resource.Reservation) []ReservationResult {
+	results := make([]ReservationResult, len(reservations))
+	for i, reservation := range reservations {
+		results[i] = ReservationResult{
+			ID:            reservation.ID,
+			AppointmentID: reservation.AppointmentID.Value(),
+			Service:       reservation.Service.String(),
+			Status:        reservation.Status.String(),
+			StartTime:     reservation.StartTime,
+			EndTime:       reservation.EndTime,
+			IsHeld:        reservation.IsHeld(),
+		}
+	}
+	return results
+}
@@ -0,0 +1,46 @@
+package query
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+const MaxReservationsRangeDays = 31
+
+type FindReservationsQuery struct {
+	resourceID valueobject.ResourceID
+	startDate  time.Time
+	endDate    time.Time
+}
+
+func NewFindReservationsQuery(resourceID uint, startDate, endDate time.Time) (FindReservationsQuery, error) {
+	if resourceID == 0 {
+		return FindReservationsQuery{}, apperror.FieldValidationError("id", "", "resource ID is required")
+	}
+
+	if startDate.IsZero() {
+		return FindReservationsQuery{}, apperror.FieldValidationError("start_date", "", "start date is required")
+	}
+
+	if endDate.IsZero() {
+		return FindReservationsQuery{}, apperror.FieldValidationError("end_date", "", "end date is required")
+	}
+
+	if endDate.Before(startDate) {
+		return FindReservationsQuery{}, apperror.FieldValidationError("end_date", endDate.Format(time.DateOnly), "end date cannot be before start date")
+	}
+
+	if endDate.Sub(startDate) > MaxReservationsRangeDays*24*time.Hour {
+		return FindReservationsQuery{}, apperror.FieldValidationError("end_date", endDate.Format(time.DateOnly), "date range cannot exceed 31 days")
+	}
+
+	return FindReservationsQuery{resourceID: valueobject.NewResourceID(resourceID), startDate: startDate, endDate: endDate}, nil
+}
+
+func (q FindReservationsQuery) ResourceID() valueobject.ResourceID { return q.resourceID }
+func (q FindReservationsQuery) StartDate() time.Time               { return q.startDate }
+
+// EndDate is the last day of the range, the reservations of the whole day are included
+func (q FindReservationsQuery) EndDate() time.Time { return q.endDate }
@@ -0,0 +1,20 @@
+package query
+
+import (
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	apperror "clinic-vet-api/app/shared/error/application"
+)
+
+type FindResourceByIDQuery struct {
+	resourceID valueobject.ResourceID
+}
+
+func NewFindResourceByIDQuery(resourceID uint) (FindResourceByIDQuery, error) {
+	if resourceID == 0 {
+		return FindResourceByIDQuery{}, apperror.FieldValidationError("id", "", "resource ID is required")
+	}
+
+	return FindResourceByIDQuery{resourceID: valueobject.NewResourceID(resourceID)}, nil
+}
+
+func (q FindResourceByIDQuery) ResourceID() valueobject.ResourceID { return q.resourceID }
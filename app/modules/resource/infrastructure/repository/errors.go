@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"fmt"
+
+	dberr "clinic-vet-api/app/shared/error/infrastructure/database"
+)
+
+const (
+	TableResources    = "clinic_resources"
+	TableRequirements = "service_resource_requirements"
+	TableReservations = "appointment_resource_reservations"
+	OpSelect          = "select"
+	OpInsert          = "insert"
+	OpUpdate          = "update"
+	OpDelete          = "delete"
+	DriverSQL         = "sqlc"
+)
+
+func (r *SqlcClinicResourceRepository) dbError(operation, table, message string, err error) error {
+	return dberr.DatabaseOperationError(operation, table, DriverSQL, fmt.Errorf("%s: %v", message, err))
+}
+
+func (r *SqlcClinicResourceRepository) notFoundError(parameterName, parameterValue string) error {
+	return dberr.EntityNotFoundError(parameterName, parameterValue, OpSelect, TableResources, DriverSQL)
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/resource"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/database"
+	"clinic-vet-api/app/shared/mapper"
+	"clinic-vet-api/db/models"
+	"clinic-vet-api/sqlc"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type SqlcClinicResourceRepository struct {
+	queries    *sqlc.Queries
+	transactor *database.Transactor
+	pgMap      *mapper.SqlcFieldMapper
+}
+
+func NewSqlcClinicResourceRepository(queries *sqlc.Queries, transactor *database.Transactor, pgMap *mapper.SqlcFieldMapper) repository.ClinicResourceRepository {
+	return &SqlcClinicResourceRepository{queries: queries, transactor: transactor, pgMap: pgMap}
+}
+
+func (r *SqlcClinicResourceRepository) FindByID(ctx context.Context, id valueobject.ResourceID) (resource.ClinicResource, error) {
+	row, err := r.queries.FindClinicResourceByID(ctx, id.Int32())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return resource.ClinicResource{}, r.notFoundError("id", id.String())
+		}
+		return resource.ClinicResource{}, r.dbError(OpSelect, TableResources, "failed to get clinic resource by ID", err)
+	}
+
+	return r.toResource(row), nil
+}
+
+func (r *SqlcClinicResourceRepository) FindAll(ctx context.Context, includeInactive bool) ([]resource.ClinicResource, error) {
+	rows, err := r.queries.ListClinicResources(ctx, includeInactive)
+	if err != nil {
+		return nil, r.dbError(OpSelect, TableResources, "failed to list clinic resources", err)
+	}
+
+	resources := make([]resource.ClinicResource, len(rows))
+	for i, row := range rows {
+		resources[i] = r.toResource(row)
+	}
+	return resources, nil
+}
+
+func (r *SqlcClinicResourceRepository) ExistsByName(ctx context.Context, name string, excludeID *valueobject.ResourceID) (bool, error) {
+	var excluded int32
+	if excludeID != nil {
+		excluded = excludeID.Int32()
+	}
+
+	exists, err := r.queries.ExistsClinicResourceByName(ctx, sqlc.ExistsClinicResourceByNameParams{Name: name, ExcludeID: excluded})
+	if err != nil {
+		return false, r.dbError(OpSelect, TableResources, "failed to check clinic resource name", err)
+	}
+	return exists, nil
+}
+
+func (r *SqlcClinicResourceRepository) Save(ctx context.Context, clinicResource *resource.ClinicResource) error {
+	if clinicResource.ID().IsZero() {
+		row, err := r.queries.CreateClinicResource(ctx, sqlc.CreateClinicResourceParams{
+			Name:         clinicResource.Name(),
+			ResourceType: clinicResource.ResourceType().String(),
+			Description:  r.pgMap.PgText.FromStringPtr(clinicResource.Description()),
+			IsActive:     clinicResource.IsActive(),
+		})
+		if err != nil {
+			return r.dbError(OpInsert, TableResources, "failed to create clinic resource", err)
+		}
+		*clinicResource = r.toResource(row)
+		return nil
+	}
+
+	row, err := r.queries.UpdateClinicResource(ctx, sqlc.UpdateClinicResourceParams{
+		ID:           clinicResource.ID().Int32(),
+		Name:         clinicResource.Name(),
+		ResourceType: clinicResource.ResourceType().String(),
+		Description:  r.pgMap.PgText.FromStringPtr(clinicResource.Description()),
+		IsActive:     clinicResource.IsActive(),
+	})
+	if err != nil {
+		return r.dbError(OpUpdate, TableResources, "failed to update clinic resource", err)
+	}
+	*clinicResource = r.toResource(row)
+	return nil
+}
+
+func (r *SqlcClinicResourceRepository) FindRequirements(ctx context.Context, service enum.ClinicService) ([]resource.ServiceRequirement, error) {
+	rows, err := r.queries.ListServiceResourceRequirements(ctx, models.ClinicService(service.String()))
+	if err != nil {
+		return nil, r.dbError(OpSelect, TableRequirements, "failed to list service requirements", err)
+	}
+
+	return r.toRequirements(rows), nil
+}
+
+func (r *SqlcClinicResourceRepository) FindAllRequirements(ctx context.Context) ([]resource.ServiceRequirement, error) {
+	rows, err := r.queries.ListAllServiceResourceRequirements(ctx)
+	if err != nil {
+		return nil, r.dbError(OpSelect, TableRequirements, "failed to list service requirements", err)
+	}
+
+	return r.toRequirements(rows), nil
+}
+
+func (r *SqlcClinicResourceRepository) ReplaceRequirements(ctx context.Context, service enum.ClinicService, requirements []resource.ServiceRequirement) error {
+	return r.transactor.WithinTx(ctx, func(queries *sqlc.Queries) error {
+		if err := queries.DeleteServiceResourceRequirements(ctx, models.ClinicService(service.String())); err != nil {
+			return r.dbError(OpDelete, TableRequirements, "failed to delete service requirements", err)
+		}
+
+		for _, requirement := range requirements {
+			if err := queries.CreateServiceResourceRequirement(ctx, sqlc.CreateServiceResourceRequirementParams{
+				ClinicService: models.ClinicService(service.String()),
+				ResourceType:  requirement.ResourceType.String(),
+				Quantity:      int16(requirement.Quantity),
+			}); err != nil {
+				return r.dbError(OpInsert, TableRequirements, "failed to create service requirement", err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *SqlcClinicResourceRepository) FindReservations(ctx context.Context, id valueobject.ResourceID, start, end time.Time) ([]resource.Reservation, error) {
+	rows, err := r.queries.ListResourceReservations(ctx, sqlc.ListResourceReservationsParams{
+		ResourceID: id.Int32(),
+		EndTime:    r.pgMap.PgTimestamptz.FromTime(end),
+		StartTime:  r.pgMap.PgTimestamptz.FromTime(start),
+	})
+	if err != nil {
+		return nil, r.dbError(OpSelect, TableReservations, "failed to list resource reservations", err)
+	}
+
+	reservations := make([]resource.Reservation, len(rows))
+	for i, row := range rows {
+		reservations[i] = r.toReservation(row)
+	}
+	return reservations, nil
+}
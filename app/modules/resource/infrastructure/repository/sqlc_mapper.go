@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"clinic-vet-api/app/modules/core/domain/entity/resource"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/sqlc"
+)
+
+func (r *SqlcClinicResourceRepository) toResource(row sqlc.ClinicResource) resource.ClinicResource {
+	return *resource.NewClinicResourceBuilder().
+		WithID(valueobject.NewResourceID(uint(row.ID))).
+		WithName(row.Name).
+		WithResourceType(enum.ResourceType(row.ResourceType)).
+		WithDescription(r.pgMap.PgText.ToStringPtr(row.Description)).
+		WithIsActive(row.IsActive).
+		WithTimestamps(row.CreatedAt.Time, row.UpdatedAt.Time).
+		Build()
+}
+
+func (r *SqlcClinicResourceRepository) toRequirements(rows []sqlc.ServiceResourceRequirement) []resource.ServiceRequirement {
+	requirements := make([]resource.ServiceRequirement, len(rows))
+	for i, row := range rows {
+		requirements[i] = resource.ServiceRequirement{
+			Service:      enum.ClinicService(row.ClinicService),
+			ResourceType: enum.ResourceType(row.ResourceType),
+			Quantity:     int(row.Quantity),
+		}
+	}
+	return requirements
+}
+
+func (r *SqlcClinicResourceRepository) toReservation(row sqlc.ListResourceReservationsRow) resource.Reservation {
+	return resource.Reservation{
+		ID:            uint(row.ID),
+		ResourceID:    valueobject.NewResourceID(uint(row.ResourceID)),
+		AppointmentID: valueobject.NewAppointmentID(uint(row.AppointmentID)),
+		Service:       enum.ClinicService(row.ClinicService),
+		Status:        enum.AppointmentStatus(row.Status),
+		StartTime:     row.StartTime.Time,
+		EndTime:       row.EndTime.Time,
+	}
+}
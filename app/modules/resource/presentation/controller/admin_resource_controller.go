@@ -0,0 +1,287 @@
+package controller
+
+import (
+	"clinic-vet-api/app/modules/resource/application"
+	"clinic-vet-api/app/modules/resource/application/command"
+	"clinic-vet-api/app/modules/resource/application/query"
+	"clinic-vet-api/app/modules/resource/presentation/dto"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+	ginutils "clinic-vet-api/app/shared/gin_utils"
+	"clinic-vet-api/app/shared/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type AdminResourceController struct {
+	resourceService application.ResourceFacadeService
+	validator       *validator.Validate
+}
+
+func NewAdminResourceController(
+	resourceService application.ResourceFacadeService,
+	validator *validator.Validate,
+) *AdminResourceController {
+	return &AdminResourceController{
+		resourceService: resourceService,
+		validator:       validator,
+	}
+}
+
+// FindResources godoc
+// @Summary List clinic resources
+// @Description Lists the rooms and equipment of the clinic, inactive ones only when requested
+// @Tags admin-clinic-resources
+// @Produce json
+// @Param include_inactive query bool false "Include inactive resources"
+// @Success 200 {object} response.APIResponse{data=[]dto.ResourceResponse}
+// @Failure 400 {object} response.APIResponse
+// @Router /admin/clinic-resources [get]
+// @Security BearerAuth
+func (ctrl *AdminResourceController) FindResources(c *gin.Context) {
+	var req dto.FindResourcesRequest
+	if err := ginutils.ShouldBindAndValidateQuery(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	results, err := ctrl.resourceService.FindResources(c.Request.Context(), req.IncludeInactive)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromResourceResults(results), "Clinic Resources")
+}
+
+// GetResource godoc
+// @Summary Get clinic resource
+// @Description Returns a room or piece of equipment by ID
+// @Tags admin-clinic-resources
+// @Produce json
+// @Param id path int true "Resource ID"
+// @Success 200 {object} response.APIResponse{data=dto.ResourceResponse}
+// @Failure 404 {object} response.APIResponse
+// @Router /admin/clinic-resources/{id} [get]
+// @Security BearerAuth
+func (ctrl *AdminResourceController) GetResource(c *gin.Context) {
+	resourceID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	qry, err := query.NewFindResourceByIDQuery(resourceID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result, err := ctrl.resourceService.FindResourceByID(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromResourceResult(result), "Clinic Resource")
+}
+
+// CreateResource godoc
+// @Summary Create clinic resource
+// @Description Registers an exam room, theatre or piece of equipment that appointments can reserve
+// @Tags admin-clinic-resources
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateResourceRequest true "Clinic resource"
+// @Success 201 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse
+// @Failure 409 {object} response.APIResponse
+// @Router /admin/clinic-resources [post]
+// @Security BearerAuth
+func (ctrl *AdminResourceController) CreateResource(c *gin.Context) {
+	var req dto.CreateResourceRequest
+	if err := ginutils.ShouldBindAndValidateBody(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	cmd, err := req.ToCommand()
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.resourceService.CreateResource(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Created(c, result.ID(), "Clinic Resource")
+}
+
+// UpdateResource godoc
+// @Summary Update clinic resource
+// @Description Updates the name, type, description or status of a room or piece of equipment
+// @Tags admin-clinic-resources
+// @Accept json
+// @Produce json
+// @Param id path int true "Resource ID"
+// @Param request body dto.UpdateResourceRequest true "Changes"
+// @Success 200 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse
+// @Failure 404 {object} response.APIResponse
+// @Failure 409 {object} response.APIResponse
+// @Router /admin/clinic-resources/{id} [put]
+// @Security BearerAuth
+func (ctrl *AdminResourceController) UpdateResource(c *gin.Context) {
+	resourceID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	var req dto.UpdateResourceRequest
+	if err := ginutils.ShouldBindAndValidateBody(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	cmd, err := req.ToCommand(resourceID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.resourceService.UpdateResource(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Updated(c, nil, "Clinic Resource")
+}
+
+// DeactivateResource godoc
+// @Summary Deactivate clinic resource
+// @Description Withdraws a room or piece of equipment from new bookings, its reservation history is kept
+// @Tags admin-clinic-resources
+// @Param id path int true "Resource ID"
+// @Success 204
+// @Failure 404 {object} response.APIResponse
+// @Router /admin/clinic-resources/{id} [delete]
+// @Security BearerAuth
+func (ctrl *AdminResourceController) DeactivateResource(c *gin.Context) {
+	resourceID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	cmd, err := command.NewDeactivateResourceCommand(resourceID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.resourceService.DeactivateResource(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// FindRequirements godoc
+// @Summary List service requirements
+// @Description Lists the rooms and equipment every service reserves when an appointment is confirmed
+// @Tags admin-clinic-resources
+// @Produce json
+// @Success 200 {object} response.APIResponse{data=[]dto.RequirementResponse}
+// @Router /admin/clinic-resources/requirements [get]
+// @Security BearerAuth
+func (ctrl *AdminResourceController) FindRequirements(c *gin.Context) {
+	results, err := ctrl.resourceService.FindRequirements(c.Request.Context())
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromRequirementResults(results), "Service Requirements")
+}
+
+// ReplaceRequirements godoc
+// @Summary Replace service requirements
+// @Description Sets the rooms and equipment reserved by the appointments of a service, affects new confirmations and reschedules only
+// @Tags admin-clinic-resources
+// @Accept json
+// @Produce json
+// @Param service path string true "Clinic service"
+// @Param request body dto.ReplaceRequirementsRequest true "Requirements"
+// @Success 200 {object} response.APIResponse
+// @Failure 400 {object} response.APIResponse
+// @Router /admin/clinic-resources/requirements/{service} [put]
+// @Security BearerAuth
+func (ctrl *AdminResourceController) ReplaceRequirements(c *gin.Context) {
+	var req dto.ReplaceRequirementsRequest
+	if err := ginutils.ShouldBindAndValidateBody(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	cmd, err := req.ToCommand(c.Param("service"))
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	result := ctrl.resourceService.ReplaceRequirements(c.Request.Context(), cmd)
+	if !result.IsSuccess() {
+		response.ApplicationError(c, result.Error())
+		return
+	}
+
+	response.Updated(c, nil, "Service Requirements")
+}
+
+// FindReservations godoc
+// @Summary List resource reservations
+// @Description Lists the appointments that reserved a room or piece of equipment within a date range
+// @Tags admin-clinic-resources
+// @Produce json
+// @Param id path int true "Resource ID"
+// @Param start_date query string true "Start date (YYYY-MM-DD)"
+// @Param end_date query string true "End date (YYYY-MM-DD)"
+// @Success 200 {object} response.APIResponse{data=[]dto.ReservationResponse}
+// @Failure 400 {object} response.APIResponse
+// @Failure 404 {object} response.APIResponse
+// @Router /admin/clinic-resources/{id}/reservations [get]
+// @Security BearerAuth
+func (ctrl *AdminResourceController) FindReservations(c *gin.Context) {
+	resourceID, err := ginutils.ParseParamToUInt(c, "id")
+	if err != nil {
+		response.BadRequest(c, httpError.RequestURLParamError(err, "id", c.Param("id")))
+		return
+	}
+
+	var req dto.FindReservationsRequest
+	if err := ginutils.ShouldBindAndValidateQuery(c, &req, ctrl.validator); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	qry, err := req.ToQuery(resourceID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	results, err := ctrl.resourceService.FindReservations(c.Request.Context(), qry)
+	if err != nil {
+		response.ApplicationError(c, err)
+		return
+	}
+
+	response.Found(c, dto.FromReservationResults(results), "Resource Reservations")
+}
@@ -0,0 +1,79 @@
+package dto
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/resource/application/command"
+	"clinic-vet-api/app/modules/resource/application/query"
+	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
+)
+
+// CreateResourceRequest represents a room or piece of equipment of the clinic
+// @Description Room or equipment that appointments reserve according to their service
+type CreateResourceRequest struct {
+	Name         string  `json:"name" validate:"required,max=100" example:"Exam Room 1"`
+	ResourceType string  `json:"resource_type" validate:"required" example:"exam_room"`
+	Description  *string `json:"description,omitempty" validate:"omitempty,max=500" example:"Ground floor, next to reception"`
+}
+
+func (r *CreateResourceRequest) ToCommand() (command.CreateResourceCommand, error) {
+	return command.NewCreateResourceCommand(r.Name, r.ResourceType, r.Description)
+}
+
+// UpdateResourceRequest represents the changes to a clinic resource, omitted fields are kept
+// @Description Partial update of a room or equipment
+type UpdateResourceRequest struct {
+	Name         *string `json:"name,omitempty" validate:"omitempty,min=1,max=100" example:"Exam Room 1"`
+	ResourceType *string `json:"resource_type,omitempty" example:"exam_room"`
+	Description  *string `json:"description,omitempty" validate:"omitempty,max=500" example:"Ground floor, next to reception"`
+	IsActive     *bool   `json:"is_active,omitempty" example:"true"`
+}
+
+func (r *UpdateResourceRequest) ToCommand(resourceID uint) (command.UpdateResourceCommand, error) {
+	return command.NewUpdateResourceCommand(resourceID, r.Name, r.ResourceType, r.Description, r.IsActive)
+}
+
+// RequirementRequest represents how many resources of a type the service holds
+type RequirementRequest struct {
+	ResourceType string `json:"resource_type" validate:"required" example:"surgery_theatre"`
+	Quantity     int    `json:"quantity" validate:"required,min=1,max=10" example:"1"`
+}
+
+// ReplaceRequirementsRequest represents every resource required by a service
+// @Description Resources reserved by each appointment of the service, an empty list removes them all
+type ReplaceRequirementsRequest struct {
+	Requirements []RequirementRequest `json:"requirements" validate:"max=10,dive"`
+}
+
+func (r *ReplaceRequirementsRequest) ToCommand(service string) (command.ReplaceRequirementsCommand, error) {
+	inputs := make([]command.RequirementInput, len(r.Requirements))
+	for i, requirement := range r.Requirements {
+		inputs[i] = command.RequirementInput{ResourceType: requirement.ResourceType, Quantity: requirement.Quantity}
+	}
+	return command.NewReplaceRequirementsCommand(service, inputs)
+}
+
+// FindResourcesRequest represents the query params to list the clinic resources
+type FindResourcesRequest struct {
+	IncludeInactive bool `form:"include_inactive" example:"false"`
+}
+
+// FindReservationsRequest represents the query params to list the reservations of a resource
+type FindReservationsRequest struct {
+	StartDate string `form:"start_date" validate:"required,datetime=2006-01-02" example:"2024-03-01"`
+	EndDate   string `form:"end_date" validate:"required,datetime=2006-01-02" example:"2024-03-07"`
+}
+
+func (r *FindReservationsRequest) ToQuery(resourceID uint) (query.FindReservationsQuery, error) {
+	startDate, err := time.ParseInLocation(time.DateOnly, r.StartDate, time.Local)
+	if err != nil {
+		return query.FindReservationsQuery{}, httpError.ValidationError("start_date", r.StartDate, "must use the YYYY-MM-DD format")
+	}
+
+	endDate, err := time.ParseInLocation(time.DateOnly, r.EndDate, time.Local)
+	if err != nil {
+		return query.FindReservationsQuery{}, httpError.ValidationError("end_date", r.EndDate, "must use the YYYY-MM-DD format")
+	}
+
+	return query.NewFindReservationsQuery(resourceID, startDate, endDate)
+}
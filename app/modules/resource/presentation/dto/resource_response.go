@@ -0,0 +1,86 @@
+package dto
+
+import (
+	"time"
+
+	"clinic-vet-api/app/modules/resource/application/handler"
+)
+
+// ResourceResponse represents a room or piece of equipment of the clinic
+type ResourceResponse struct {
+	ID           uint      `json:"id"`
+	Name         string    `json:"name"`
+	ResourceType string    `json:"resource_type"`
+	Kind         string    `json:"kind"`
+	Description  *string   `json:"description,omitempty"`
+	IsActive     bool      `json:"is_active"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// RequirementResponse represents the resources of a type held by every appointment of a service
+type RequirementResponse struct {
+	Service      string `json:"service"`
+	ResourceType string `json:"resource_type"`
+	Quantity     int    `json:"quantity"`
+}
+
+// ReservationResponse represents the time an appointment holds a resource
+type ReservationResponse struct {
+	ID            uint      `json:"id"`
+	AppointmentID uint      `json:"appointment_id"`
+	Service       string    `json:"service"`
+	Status        string    `json:"appointment_status"`
+	StartTime     time.Time `json:"start_time"`
+	EndTime       time.Time `json:"end_time"`
+	IsHeld        bool      `json:"is_held"`
+}
+
+func FromResourceResult(result handler.ResourceResult) ResourceResponse {
+	return ResourceResponse{
+		ID:           result.ID,
+		Name:         result.Name,
+		ResourceType: result.ResourceType,
+		Kind:         result.Kind,
+		Description:  result.Description,
+		IsActive:     result.IsActive,
+		CreatedAt:    result.CreatedAt,
+		UpdatedAt:    result.UpdatedAt,
+	}
+}
+
+func FromResourceResults(results []handler.ResourceResult) []ResourceResponse {
+	responses := make([]ResourceResponse, len(results))
+	for i, result := range results {
+		responses[i] = FromResourceResult(result)
+	}
+	return responses
+}
+
+func FromRequirementResults(results []handler.RequirementResult) []RequirementResponse {
+	responses := make([]RequirementResponse, len(results))
+	for i, result := range results {
+		responses[i] = RequirementResponse{
+			Service:      result.Service,
+			ResourceType: result.ResourceType,
+			Quantity:     result.Quantity,
+		}
+	}
+	return responses
+}
+
+func FromReservationResults(results []handler.ReservationResult) []ReservationResponse {
+	responses := make([]ReservationResponse, len(results))
+	for i, result := range results {
+		responses[i] = ReservationResponse{
+			ID:            result.ID,
+			AppointmentID: result.AppointmentID,
+			Service:       result.Service,
+			Status:        result.Status,
+			StartTime:     result.StartTime,
+			EndTime:       result.EndTime,
+			IsHeld:        result.IsHeld,
+		}
+	}
+	return responses
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/resource/application"
+	"clinic-vet-api/app/modules/resource/application/handler"
+	sqlcRepo "clinic-vet-api/app/modules/resource/infrastructure/repository"
+	"clinic-vet-api/app/modules/resource/presentation/controller"
+	"clinic-vet-api/app/modules/resource/presentation/routes"
+	"clinic-vet-api/app/shared/database"
+	"clinic-vet-api/app/shared/mapper"
+	"clinic-vet-api/sqlc"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type ResourceAPIConfig struct {
+	Router         *gin.RouterGroup
+	Validator      *validator.Validate
+	AuthMiddleware *middleware.AuthMiddleware
+	Queries        *sqlc.Queries
+	Transactor     *database.Transactor
+}
+
+type ResourceAPIComponents struct {
+	Repository repository.ClinicResourceRepository
+	Service    application.ResourceFacadeService
+	Controller *controller.AdminResourceController
+}
+
+type ResourceAPIModule struct {
+	config     *ResourceAPIConfig
+	isBuilt    bool
+	Components ResourceAPIComponents
+}
+
+func NewResourceAPIModule(config *ResourceAPIConfig) *ResourceAPIModule {
+	return &ResourceAPIModule{
+		config:  config,
+		isBuilt: false,
+	}
+}
+
+func (b *ResourceAPIModule) Bootstrap() error {
+	if b.isBuilt {
+		return nil
+	}
+
+	if err := b.validateConfig(); err != nil {
+		return err
+	}
+
+	repo := sqlcRepo.NewSqlcClinicResourceRepository(b.config.Queries, b.config.Transactor, mapper.NewSqlcFieldMapper())
+
+	cmdHandler := handler.NewResourceCommandHandler(repo)
+	qryHandler := handler.NewResourceQueryHandler(repo)
+	service := application.NewResourceFacadeService(qryHandler, cmdHandler)
+
+	adminController := controller.NewAdminResourceController(service, b.config.Validator)
+	routes.ResourceRoutes(b.config.Router, adminController, b.config.AuthMiddleware)
+
+	b.Components = ResourceAPIComponents{
+		Repository: repo,
+		Service:    service,
+		Controller: adminController,
+	}
+	b.isBuilt = true
+
+	return nil
+}
+
+func (b *ResourceAPIModule) validateConfig() error {
+	if b.config == nil {
+		return errors.New("resource api config is nil")
+	}
+
+	if b.config.Router == nil {
+		return errors.New("router is nil")
+	}
+
+	if b.config.Validator == nil {
+		return errors.New("validator is nil")
+	}
+
+	if b.config.AuthMiddleware == nil {
+		return errors.New("auth middleware is nil")
+	}
+
+	if b.config.Queries == nil {
+		return errors.New("queries is nil")
+	}
+
+	if b.config.Transactor == nil {
+		return errors.New("transactor is nil")
+	}
+
+	return nil
+}
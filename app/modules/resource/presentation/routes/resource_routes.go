@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"clinic-vet-api/app/middleware"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/resource/presentation/controller"
+
+	"github.com/gin-gonic/gin"
+)
+
+func ResourceRoutes(router *gin.RouterGroup, adminController *controller.AdminResourceController, authMiddleware *middleware.AuthMiddleware) {
+	adminGroup := router.Group("/admin/clinic-resources")
+	adminGroup.Use(authMiddleware.Authenticate())
+	adminGroup.Use(authMiddleware.RequireAnyRole(enum.UserRoleAdmin.String()))
+	{
+		adminGroup.GET("", adminController.FindResources)
+		adminGroup.POST("", adminController.CreateResource)
+		adminGroup.GET("/requirements", adminController.FindRequirements)
+		adminGroup.PUT("/requirements/:service", adminController.ReplaceRequirements)
+		adminGroup.GET("/:id", adminController.GetResource)
+		adminGroup.PUT("/:id", adminController.UpdateResource)
+		adminGroup.DELETE("/:id", adminController.DeactivateResource)
+		adminGroup.GET("/:id/reservations", adminController.FindReservations)
+	}
+}
@@ -0,0 +1,54 @@
+// Package database provides helpers to run several sqlc queries atomically
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"clinic-vet-api/sqlc"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// TxBeginner is implemented by *pgxpool.Pool and *pgx.Conn
+type TxBeginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// Transactor runs a function against queries bound to a single transaction
+type Transactor struct {
+	db      TxBeginner
+	queries *sqlc.Queries
+}
+
+func NewTransactor(db TxBeginner, queries *sqlc.Queries) *Transactor {
+	return &Transactor{db: db, queries: queries}
+}
+
+// WithinTx commits when fn succeeds and rolls back when it returns an error or panics
+func (t *Transactor) WithinTx(ctx context.Context, fn func(queries *sqlc.Queries) error) (err error) {
+	tx, err := t.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback(ctx)
+			panic(p)
+		}
+	}()
+
+	if err := fn(t.queries.WithTx(tx)); err != nil {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+			return errors.Join(err, fmt.Errorf("failed to rollback transaction: %w", rollbackErr))
+		}
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	httpError "clinic-vet-api/app/shared/error/infrastructure/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
 )
 
@@ -37,3 +38,22 @@ func ShouldBindAndValidateQuery(c *gin.Context, obj any, validate *validator.Val
 
 	return nil
 }
+
+// ShouldBindAndValidateForm binds the fields and files of a multipart form, the request body
+// has to be parsed beforehand when its size is limited
+func ShouldBindAndValidateForm(c *gin.Context, obj any, validate *validator.Validate) error {
+	if err := c.ShouldBindWith(obj, binding.FormMultipart); err != nil {
+		return httpError.RequestBodyDataError(err)
+	}
+
+	if err := validate.Struct(obj); err != nil {
+		validationErrors, ok := err.(validator.ValidationErrors)
+		if !ok {
+			return httpError.InvalidDataError(err)
+		}
+
+		return httpError.InvalidDataError(validationErrors)
+	}
+
+	return nil
+}
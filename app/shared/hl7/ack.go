@@ -0,0 +1,118 @@
+package hl7
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AckCode is the MSA-1 acknowledgment code of the original acknowledgment mode
+type AckCode string
+
+const (
+	// AckAccept tells the sender the message was processed
+	AckAccept AckCode = "AA"
+	// AckError tells the sender the message was understood but could not be processed
+	AckError AckCode = "AE"
+	// AckReject tells the sender the message was not understood, such as an unsupported type
+	AckReject AckCode = "AR"
+)
+
+// ErrorCode is an HL7 table 0357 code reported in ERR-3
+type ErrorCode string
+
+const (
+	ErrorSegmentSequence         ErrorCode = "100"
+	ErrorRequiredFieldMissing    ErrorCode = "101"
+	ErrorDataTypeError           ErrorCode = "102"
+	ErrorUnsupportedMessageType  ErrorCode = "200"
+	ErrorUnsupportedVersion      ErrorCode = "203"
+	ErrorUnknownKeyIdentifier    ErrorCode = "204"
+	ErrorDuplicateKeyIdentifier  ErrorCode = "205"
+	ErrorApplicationRecordLocked ErrorCode = "206"
+	ErrorApplicationInternal     ErrorCode = "207"
+)
+
+var errorCodeNames = map[ErrorCode]string{
+	ErrorSegmentSequence:         "Segment sequence error",
+	ErrorRequiredFieldMissing:    "Required field missing",
+	ErrorDataTypeError:           "Data type error",
+	ErrorUnsupportedMessageType:  "Unsupported message type",
+	ErrorUnsupportedVersion:      "Unsupported version id",
+	ErrorUnknownKeyIdentifier:    "Unknown key identifier",
+	ErrorDuplicateKeyIdentifier:  "Duplicate key identifier",
+	ErrorApplicationRecordLocked: "Application record locked",
+	ErrorApplicationInternal:     "Application internal error",
+}
+
+// Ack describes the acknowledgment of a received message. Code and Text are only
+// written when the message was not accepted
+type Ack struct {
+	Code      AckCode
+	ErrorCode ErrorCode
+	Text      string
+}
+
+// Accepted is the acknowledgment of a processed message
+func Accepted() Ack {
+	return Ack{Code: AckAccept}
+}
+
+// Failed is the acknowledgment of a message that could not be processed
+func Failed(code ErrorCode, text string) Ack {
+	return Ack{Code: AckError, ErrorCode: code, Text: text}
+}
+
+// Rejected is the acknowledgment of a message that was not understood
+func Rejected(code ErrorCode, text string) Ack {
+	return Ack{Code: AckReject, ErrorCode: code, Text: text}
+}
+
+// IsAccepted tells whether the sender can consider the message delivered
+func (a Ack) IsAccepted() bool {
+	return a.Code == AckAccept
+}
+
+// Encode writes the ACK answering the message. Sending and receiving application and facility
+// are swapped from the original header, which may be nil when the message could not be parsed
+func (a Ack) Encode(original *Message, controlID string, now time.Time) []byte {
+	d := DefaultDelimiters
+	var sendingApp, sendingFacility, receivingApp, receivingFacility, originalControlID, trigger string
+	version := "2.5.1"
+	if original != nil {
+		d = original.Delimiters
+		header := original.Header()
+		sendingApp, sendingFacility = header.Field(5), header.Field(6)
+		receivingApp, receivingFacility = header.Field(3), header.Field(4)
+		originalControlID = d.escape(original.ControlID())
+		_, trigger = original.Type()
+		if original.Version() != "" {
+			version = d.escape(original.Version())
+		}
+	}
+
+	messageType := "ACK"
+	if trigger != "" {
+		messageType = fmt.Sprintf("ACK%c%s%cACK", d.Component, d.escape(trigger), d.Component)
+	}
+
+	field := string(d.Field)
+	encoding := string([]byte{d.Component, d.Repetition, d.Escape, d.Subcomponent})
+	segments := []string{
+		strings.Join([]string{
+			"MSH" + field + encoding, sendingApp, sendingFacility, receivingApp, receivingFacility,
+			FormatTimestamp(now), "", messageType, d.escape(controlID), "P", version,
+		}, field),
+		strings.Join([]string{"MSA", string(a.Code), originalControlID}, field),
+	}
+	if a.Text != "" {
+		segments[1] += field + d.escape(a.Text)
+	}
+
+	if a.Code != AckAccept && a.ErrorCode != "" {
+		errorCode := strings.Join([]string{string(a.ErrorCode), errorCodeNames[a.ErrorCode], "HL70357"}, string(d.Component))
+		segments = append(segments, strings.Join([]string{"ERR", "", "", errorCode, "E", "", "", "", d.escape(a.Text)}, field))
+	}
+
+	return []byte(strings.Join(segments, segmentSeparator) + segmentSeparator)
+}
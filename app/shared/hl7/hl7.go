@@ -0,0 +1,292 @@
+// Package hl7 reads and writes HL7 v2 messages in their pipe delimited encoding and carries
+// them over MLLP, the framing used by laboratory analyzers on TCP
+package hl7
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	ContentType = "x-application/hl7-v2+er7; charset=utf-8"
+
+	segmentSeparator = "\r"
+	timestampFormat  = "20060102150405"
+)
+
+var (
+	ErrEmptyMessage   = errors.New("hl7: empty message")
+	ErrMissingHeader  = errors.New("hl7: message does not start with an MSH segment")
+	ErrInvalidHeader  = errors.New("hl7: MSH segment is too short to hold the encoding characters")
+	ErrInvalidSegment = errors.New("hl7: segment name must be three upper case letters or digits")
+)
+
+// Delimiters are the separators declared in MSH-1 and MSH-2 of each message
+type Delimiters struct {
+	Field        byte
+	Component    byte
+	Repetition   byte
+	Escape       byte
+	Subcomponent byte
+}
+
+// DefaultDelimiters are the recommended |^~\& separators, used for the messages written
+var DefaultDelimiters = Delimiters{Field: '|', Component: '^', Repetition: '~', Escape: '\\', Subcomponent: '&'}
+
+func (d Delimiters) encodingCharacters() string {
+	return string([]byte{d.Component, d.Repetition, d.Escape, d.Subcomponent})
+}
+
+// Segment is a line of the message. Fields are numbered as in the standard, so for MSH Field(1)
+// is the field separator and Field(2) the encoding characters
+type Segment struct {
+	Name       string
+	fields     []string
+	delimiters Delimiters
+}
+
+// Field returns the raw field at the position, empty when the segment is shorter
+func (s Segment) Field(position int) string {
+	if s.Name == "MSH" {
+		switch position {
+		case 1:
+			return string(s.delimiters.Field)
+		case 2:
+			return s.delimiters.encodingCharacters()
+		}
+		position--
+	}
+
+	if position < 1 || position >= len(s.fields) {
+		return ""
+	}
+	return s.fields[position]
+}
+
+// Component returns the unescaped component of the first repetition of the field, both
+// positions starting at 1
+func (s Segment) Component(position, component int) string {
+	field := s.Field(position)
+	if repetition := strings.IndexByte(field, s.delimiters.Repetition); repetition >= 0 {
+		field = field[:repetition]
+	}
+
+	components := strings.Split(field, string(s.delimiters.Component))
+	if component < 1 || component > len(components) {
+		return ""
+	}
+
+	value := components[component-1]
+	if subcomponent := strings.IndexByte(value, s.delimiters.Subcomponent); subcomponent >= 0 {
+		value = value[:subcomponent]
+	}
+	return s.delimiters.unescape(value)
+}
+
+// Value returns the first component of the field, unescaped
+func (s Segment) Value(position int) string {
+	return s.Component(position, 1)
+}
+
+// Message is a parsed HL7 v2 message, its segments in the order received
+type Message struct {
+	Segments   []Segment
+	Delimiters Delimiters
+}
+
+// Parse reads a message, segments may end with CR, LF or CRLF
+func Parse(raw []byte) (*Message, error) {
+	text := strings.ReplaceAll(string(raw), "\r\n", segmentSeparator)
+	text = strings.ReplaceAll(text, "\n", segmentSeparator)
+	text = strings.Trim(text, segmentSeparator+" \t\x00")
+	if text == "" {
+		return nil, ErrEmptyMessage
+	}
+
+	if !strings.HasPrefix(text, "MSH") {
+		return nil, ErrMissingHeader
+	}
+
+	if len(text) < 8 {
+		return nil, ErrInvalidHeader
+	}
+
+	delimiters := Delimiters{
+		Field:        text[3],
+		Component:    text[4],
+		Repetition:   text[5],
+		Escape:       text[6],
+		Subcomponent: text[7],
+	}
+
+	message := &Message{Delimiters: delimiters}
+	for _, line := range strings.Split(text, segmentSeparator) {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Split(line, string(delimiters.Field))
+		if !isSegmentName(fields[0]) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidSegment, fields[0])
+		}
+		message.Segments = append(message.Segments, Segment{Name: fields[0], fields: fields, delimiters: delimiters})
+	}
+
+	return message, nil
+}
+
+// Header returns the MSH segment
+func (m *Message) Header() Segment {
+	return m.Segments[0]
+}
+
+// First returns the first segment with the name, false when there is none
+func (m *Message) First(name string) (Segment, bool) {
+	for _, segment := range m.Segments {
+		if segment.Name == name {
+			return segment, true
+		}
+	}
+	return Segment{}, false
+}
+
+// Type returns the message code and trigger event of MSH-9, such as ORU and R01
+func (m *Message) Type() (string, string) {
+	header := m.Header()
+	return header.Component(9, 1), header.Component(9, 2)
+}
+
+// ControlID returns MSH-10, echoed back in the acknowledgment
+func (m *Message) ControlID() string {
+	return m.Header().Value(10)
+}
+
+// Version returns the HL7 version of MSH-12
+func (m *Message) Version() string {
+	return m.Header().Value(12)
+}
+
+// ParseTimestamp reads a DTM value, precision may go from the year down to fractions of a
+// second and an offset such as -0500 may follow. Values without offset are taken as local time
+func ParseTimestamp(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, errors.New("hl7: empty timestamp")
+	}
+
+	location := time.Local
+	if offsetAt := strings.IndexAny(value, "+-"); offsetAt >= 0 {
+		offset, err := time.Parse("-0700", value[offsetAt:])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("hl7: invalid timestamp offset %q", value)
+		}
+		location = offset.Location()
+		value = value[:offsetAt]
+	}
+
+	fraction := ""
+	if dot := strings.IndexByte(value, '.'); dot >= 0 {
+		value, fraction = value[:dot], value[dot:]
+	}
+
+	if len(value) < 4 || len(value) > len(timestampFormat) || len(value)%2 != 0 {
+		return time.Time{}, fmt.Errorf("hl7: invalid timestamp %q", value)
+	}
+
+	layout := timestampFormat[:len(value)]
+	if fraction != "" {
+		layout += "." + strings.Repeat("0", len(fraction)-1)
+		value += fraction
+	}
+
+	parsed, err := time.ParseInLocation(layout, value, location)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("hl7: invalid timestamp %q", value)
+	}
+	return parsed, nil
+}
+
+// FormatTimestamp writes the time with second precision and its offset
+func FormatTimestamp(t time.Time) string {
+	return t.Format(timestampFormat + "-0700")
+}
+
+func isSegmentName(name string) bool {
+	if len(name) != 3 {
+		return false
+	}
+	for _, c := range name {
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// unescape replaces the escape sequences of the delimiters, other sequences such as
+// highlighting are dropped
+func (d Delimiters) unescape(value string) string {
+	escape := string(d.Escape)
+	if !strings.Contains(value, escape) {
+		return value
+	}
+
+	var b strings.Builder
+	for {
+		start := strings.Index(value, escape)
+		if start < 0 {
+			b.WriteString(value)
+			return b.String()
+		}
+
+		end := strings.Index(value[start+1:], escape)
+		if end < 0 {
+			b.WriteString(value)
+			return b.String()
+		}
+
+		b.WriteString(value[:start])
+		switch value[start+1 : start+1+end] {
+		case "F":
+			b.WriteByte(d.Field)
+		case "S":
+			b.WriteByte(d.Component)
+		case "R":
+			b.WriteByte(d.Repetition)
+		case "E":
+			b.WriteByte(d.Escape)
+		case "T":
+			b.WriteByte(d.Subcomponent)
+		case ".br":
+			b.WriteByte('\n')
+		}
+		value = value[start+2+end:]
+	}
+}
+
+// escape protects the delimiters inside a value written to a message
+func (d Delimiters) escape(value string) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case d.Escape:
+			b.WriteString(string(d.Escape) + "E" + string(d.Escape))
+		case d.Field:
+			b.WriteString(string(d.Escape) + "F" + string(d.Escape))
+		case d.Component:
+			b.WriteString(string(d.Escape) + "S" + string(d.Escape))
+		case d.Repetition:
+			b.WriteString(string(d.Escape) + "R" + string(d.Escape))
+		case d.Subcomponent:
+			b.WriteString(string(d.Escape) + "T" + string(d.Escape))
+		case '\r', '\n':
+			b.WriteString(string(d.Escape) + ".br" + string(d.Escape))
+		default:
+			b.WriteByte(value[i])
+		}
+	}
+	return b.String()
+}
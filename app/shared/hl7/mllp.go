@@ -0,0 +1,219 @@
+package hl7
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"slices"
+	"sync"
+	"time"
+
+	"clinic-vet-api/app/shared/log"
+
+	"go.uber.org/zap"
+)
+
+const (
+	mllpStartBlock = 0x0b
+	mllpEndBlock   = 0x1c
+	mllpCarriage   = 0x0d
+
+	DefaultMaxMessageSize = 1 << 20
+)
+
+var ErrMessageTooLarge = errors.New("hl7: message exceeds the maximum size")
+
+// ReadFrame reads the next MLLP block, <VT>message<FS><CR>, and returns the message.
+// Bytes received before the start block are discarded
+func ReadFrame(r *bufio.Reader, maxSize int) ([]byte, error) {
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if b == mllpStartBlock {
+			break
+		}
+	}
+
+	var message []byte
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+
+		if b == mllpEndBlock {
+			next, err := r.ReadByte()
+			if err != nil {
+				return nil, err
+			}
+			if next != mllpCarriage {
+				return nil, fmt.Errorf("hl7: expected carriage return after the end block, got %#x", next)
+			}
+			return message, nil
+		}
+
+		if len(message) >= maxSize {
+			return nil, ErrMessageTooLarge
+		}
+		message = append(message, b)
+	}
+}
+
+// WriteFrame writes the message wrapped in an MLLP block
+func WriteFrame(w io.Writer, message []byte) error {
+	frame := make([]byte, 0, len(message)+3)
+	frame = append(frame, mllpStartBlock)
+	frame = append(frame, message...)
+	frame = append(frame, mllpEndBlock, mllpCarriage)
+	_, err := w.Write(frame)
+	return err
+}
+
+// Handler processes a received message and returns the acknowledgment to send back
+type Handler func(ctx context.Context, message []byte) []byte
+
+// MLLPServer listens for HL7 messages over TCP. Each connection is served in its own
+// goroutine, messages of a connection are acknowledged one by one in the order received.
+// MLLP carries no credentials, so connections from peers outside the allowed ones are closed
+// right away. Without allowed peers only loopback connections are accepted
+type MLLPServer struct {
+	address        string
+	handler        Handler
+	idleTimeout    time.Duration
+	allowedPeers   []netip.Prefix
+	maxMessageSize int
+
+	mu       sync.Mutex
+	listener net.Listener
+	conns    sync.WaitGroup
+}
+
+func NewMLLPServer(address string, handler Handler, idleTimeout time.Duration, allowedPeers []netip.Prefix) *MLLPServer {
+	return &MLLPServer{
+		address:        address,
+		handler:        handler,
+		idleTimeout:    idleTimeout,
+		allowedPeers:   allowedPeers,
+		maxMessageSize: DefaultMaxMessageSize,
+	}
+}
+
+func (s *MLLPServer) Name() string {
+	return "hl7 mllp listener " + s.address
+}
+
+// Addr returns the address the server listens on once serving, nil before
+func (s *MLLPServer) Addr() net.Addr {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
+// Serve accepts connections until ctx is cancelled, then waits for the open connections to
+// finish the message in progress
+func (s *MLLPServer) Serve(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return fmt.Errorf("hl7: failed to listen on %s: %w", s.address, err)
+	}
+
+	s.mu.Lock()
+	s.listener = listener
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+
+	log.Info("hl7 mllp listener started", zap.String("address", listener.Addr().String()))
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				s.conns.Wait()
+				log.Info("hl7 mllp listener stopped", zap.String("address", s.address))
+				return nil
+			}
+			cancel()
+			s.conns.Wait()
+			return fmt.Errorf("hl7: failed to accept connection: %w", err)
+		}
+
+		if !s.allows(conn.RemoteAddr()) {
+			log.Warn("hl7 mllp connection refused", zap.String("remote", conn.RemoteAddr().String()))
+			conn.Close()
+			continue
+		}
+
+		s.conns.Add(1)
+		go s.serveConn(ctx, conn)
+	}
+}
+
+// allows tells whether the peer may send messages
+func (s *MLLPServer) allows(remote net.Addr) bool {
+	addrPort, err := netip.ParseAddrPort(remote.String())
+	if err != nil {
+		return false
+	}
+
+	addr := addrPort.Addr().Unmap()
+	if len(s.allowedPeers) == 0 {
+		return addr.IsLoopback()
+	}
+	return slices.ContainsFunc(s.allowedPeers, func(peer netip.Prefix) bool {
+		return peer.Contains(addr)
+	})
+}
+
+func (s *MLLPServer) serveConn(ctx context.Context, conn net.Conn) {
+	defer s.conns.Done()
+	defer conn.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.SetReadDeadline(time.Now())
+		case <-done:
+		}
+	}()
+
+	remote := zap.String("remote", conn.RemoteAddr().String())
+	reader := bufio.NewReader(conn)
+	for ctx.Err() == nil {
+		if s.idleTimeout > 0 {
+			conn.SetReadDeadline(time.Now().Add(s.idleTimeout))
+		}
+
+		message, err := ReadFrame(reader, s.maxMessageSize)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+				log.Warn("hl7 mllp connection closed", remote, zap.Error(err))
+			}
+			return
+		}
+
+		ack := s.handler(ctx, message)
+		if err := WriteFrame(conn, ack); err != nil {
+			log.Warn("failed to send hl7 acknowledgment", remote, zap.Error(err))
+			return
+		}
+	}
+}
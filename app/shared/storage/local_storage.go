@@ -0,0 +1,119 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// LocalStorage keeps the files in a directory of the server, the key being the path inside it.
+// It suits single instance deployments, downloads are served by the API
+type LocalStorage struct {
+	root string
+}
+
+// NewLocalStorage creates the directory when it doesn't exist
+func NewLocalStorage(root string) (*LocalStorage, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("storage: invalid directory %q: %w", root, err)
+	}
+
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("storage: failed to create directory %q: %w", root, err)
+	}
+	return &LocalStorage{root: root}, nil
+}
+
+// Put writes the file next to its final path and renames it once complete, so a failed upload
+// never leaves a truncated file under the key
+func (s *LocalStorage) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	target, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target), 0o750); err != nil {
+		return fmt.Errorf("storage: failed to create directory for %s: %w", key, err)
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(target), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("storage: failed to create %s: %w", key, err)
+	}
+	defer os.Remove(file.Name())
+
+	written, err := io.Copy(file, contextReader{ctx: ctx, reader: body})
+	if err == nil && size >= 0 && written != size {
+		err = fmt.Errorf("received %d bytes, expected %d", written, size)
+	}
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("storage: failed to write %s: %w", key, err)
+	}
+
+	if err := os.Chmod(file.Name(), 0o640); err != nil {
+		return fmt.Errorf("storage: failed to write %s: %w", key, err)
+	}
+	if err := os.Rename(file.Name(), target); err != nil {
+		return fmt.Errorf("storage: failed to write %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *LocalStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	target, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(target)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("storage: failed to open %s: %w", key, err)
+	}
+	return file, nil
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	target, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(target); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("storage: failed to delete %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *LocalStorage) path(key string) (string, error) {
+	if err := ValidateKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// contextReader stops a copy once the context is done, such as when the client goes away
+type contextReader struct {
+	ctx    context.Context
+	reader io.Reader
+}
+
+func (r contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.reader.Read(p)
+}
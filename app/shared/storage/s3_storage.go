@@ -0,0 +1,404 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	s3Service        = "s3"
+	s3Algorithm      = "AWS4-HMAC-SHA256"
+	s3UnsignedBody   = "UNSIGNED-PAYLOAD"
+	s3DateFormat     = "20060102"
+	s3DateTimeFormat = "20060102T150405Z"
+	s3MaxPresign     = 7 * 24 * time.Hour
+)
+
+// S3Config points to a bucket of Amazon S3 or of a compatible service such as MinIO
+type S3Config struct {
+	// Endpoint of the service, e.g. http://localhost:9000 for MinIO. Empty for Amazon S3
+	Endpoint string
+	// PublicEndpoint is the endpoint written in download URLs when clients reach the service
+	// through another address than the API, e.g. a MinIO container. Defaults to Endpoint
+	PublicEndpoint  string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// UsePathStyle addresses the bucket in the path instead of the host name, as MinIO expects
+	UsePathStyle bool
+}
+
+// S3Storage talks to the S3 REST API with Signature Version 4 requests. Downloads are served by
+// the service through presigned URLs
+type S3Storage struct {
+	config         S3Config
+	endpoint       *url.URL
+	publicEndpoint *url.URL
+	client         *http.Client
+}
+
+func NewS3Storage(config S3Config) (*S3Storage, error) {
+	if config.Bucket == "" || config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, errors.New("storage: the S3 bucket and credentials are required")
+	}
+
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+
+	if config.Endpoint == "" {
+		config.Endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", config.Region)
+	}
+
+	endpoint, err := parseEndpoint(config.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	publicEndpoint := endpoint
+	if config.PublicEndpoint != "" {
+		if publicEndpoint, err = parseEndpoint(config.PublicEndpoint); err != nil {
+			return nil, err
+		}
+	}
+
+	return &S3Storage{
+		config:         config,
+		endpoint:       endpoint,
+		publicEndpoint: publicEndpoint,
+		client:         &http.Client{Timeout: 5 * time.Minute},
+	}, nil
+}
+
+// EnsureBucket creates the bucket when it doesn't exist yet
+func (s *S3Storage) EnsureBucket(ctx context.Context) error {
+	resp, err := s.do(ctx, http.MethodHead, "", nil, 0, "", nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return nil
+	case resp.StatusCode != http.StatusNotFound:
+		return fmt.Errorf("storage: failed to check bucket %s: %s", s.config.Bucket, resp.Status)
+	}
+
+	// Buckets outside us-east-1 are created with their region as location constraint
+	if s.config.Region == "us-east-1" {
+		resp, err = s.do(ctx, http.MethodPut, "", nil, 0, "", nil)
+	} else {
+		body := []byte("<CreateBucketConfiguration><LocationConstraint>" + s.config.Region +
+			"</LocationConstraint></CreateBucketConfiguration>")
+		resp, err = s.do(ctx, http.MethodPut, "", bytes.NewReader(body), int64(len(body)), "", body)
+	}
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.responseError("create bucket "+s.config.Bucket, resp)
+	}
+	return nil
+}
+
+func (s *S3Storage) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	if err := ValidateKey(key); err != nil {
+		return err
+	}
+
+	resp, err := s.do(ctx, http.MethodPut, key, body, size, contentType, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.responseError("put "+key, resp)
+	}
+	return nil
+}
+
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	if err := ValidateKey(key); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(ctx, http.MethodGet, key, nil, 0, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, ErrNotFound
+	default:
+		defer resp.Body.Close()
+		return nil, s.responseError("get "+key, resp)
+	}
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	if err := ValidateKey(key); err != nil {
+		return err
+	}
+
+	resp, err := s.do(ctx, http.MethodDelete, key, nil, 0, "", nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return s.responseError("delete "+key, resp)
+	}
+	return nil
+}
+
+// PresignGet returns a URL downloading the file until it expires, at most a week as S3 allows.
+// The file is served as an attachment under its original name
+func (s *S3Storage) PresignGet(ctx context.Context, key string, expiresIn time.Duration, options DownloadOptions) (string, error) {
+	if err := ValidateKey(key); err != nil {
+		return "", err
+	}
+
+	if expiresIn <= 0 || expiresIn > s3MaxPresign {
+		return "", fmt.Errorf("storage: presigned URLs last between 1 second and %s", s3MaxPresign)
+	}
+
+	now := time.Now().UTC()
+	target := s.objectURL(s.publicEndpoint, key)
+
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", s3Algorithm)
+	query.Set("X-Amz-Credential", s.config.AccessKeyID+"/"+s.scope(now))
+	query.Set("X-Amz-Date", now.Format(s3DateTimeFormat))
+	query.Set("X-Amz-Expires", strconv.Itoa(int(expiresIn.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+	if options.FileName != "" {
+		query.Set("response-content-disposition", mime.FormatMediaType("attachment", map[string]string{"filename": options.FileName}))
+	}
+	if options.ContentType != "" {
+		query.Set("response-content-type", options.ContentType)
+	}
+
+	headers := map[string]string{"host": target.Host}
+	signature := s.signature(now, http.MethodGet, target.EscapedPath(), query, headers, s3UnsignedBody)
+	query.Set("X-Amz-Signature", signature)
+
+	target.RawQuery = canonicalQuery(query)
+	return target.String(), nil
+}
+
+// do sends a signed request for the object under key, the bucket itself when key is empty.
+// signedBody is the body when its hash has to be signed, otherwise the payload is left unsigned
+func (s *S3Storage) do(
+	ctx context.Context, method, key string, body io.Reader, size int64, contentType string, signedBody []byte,
+) (*http.Response, error) {
+	target := s.objectURL(s.endpoint, key)
+
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("storage: failed to build request: %w", err)
+	}
+	if body != nil {
+		req.ContentLength = size
+	}
+
+	payloadHash := s3UnsignedBody
+	if signedBody != nil || body == nil {
+		sum := sha256.Sum256(signedBody)
+		payloadHash = hex.EncodeToString(sum[:])
+	}
+
+	now := time.Now().UTC()
+	headers := map[string]string{
+		"host":                 target.Host,
+		"x-amz-content-sha256": payloadHash,
+		"x-amz-date":           now.Format(s3DateTimeFormat),
+	}
+	if contentType != "" {
+		headers["content-type"] = contentType
+	}
+
+	signature := s.signature(now, method, target.EscapedPath(), url.Values{}, headers, payloadHash)
+	for name, value := range headers {
+		if name != "host" {
+			req.Header.Set(name, value)
+		}
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.config.AccessKeyID, s.scope(now), signedHeaderNames(headers), signature))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("storage: %s %s failed: %w", method, target.Redacted(), err)
+	}
+	return resp, nil
+}
+
+func (s *S3Storage) objectURL(endpoint *url.URL, key string) *url.URL {
+	target := *endpoint
+	escapedKey := escapeKey(key)
+
+	if s.config.UsePathStyle {
+		target.Path = strings.TrimSuffix(endpoint.Path, "/") + "/" + s.config.Bucket
+		target.RawPath = strings.TrimSuffix(endpoint.EscapedPath(), "/") + "/" + uriEncode(s.config.Bucket)
+		if key != "" {
+			target.Path += "/" + key
+			target.RawPath += "/" + escapedKey
+		}
+	} else {
+		target.Host = s.config.Bucket + "." + endpoint.Host
+		target.Path = strings.TrimSuffix(endpoint.Path, "/") + "/" + key
+		target.RawPath = strings.TrimSuffix(endpoint.EscapedPath(), "/") + "/" + escapedKey
+	}
+	return &target
+}
+
+func (s *S3Storage) scope(now time.Time) string {
+	return strings.Join([]string{now.Format(s3DateFormat), s.config.Region, s3Service, "aws4_request"}, "/")
+}
+
+// signature computes the Signature Version 4 of the request described
+func (s *S3Storage) signature(
+	now time.Time, method, escapedPath string, query url.Values, headers map[string]string, payloadHash string,
+) string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+
+	if escapedPath == "" {
+		escapedPath = "/"
+	}
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		escapedPath,
+		canonicalQuery(query),
+		canonicalHeaders.String(),
+		strings.Join(names, ";"),
+		payloadHash,
+	}, "\n")
+
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		now.Format(s3DateTimeFormat),
+		s.scope(now),
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.config.SecretAccessKey), now.Format(s3DateFormat))
+	key = hmacSHA256(key, s.config.Region)
+	key = hmacSHA256(key, s3Service)
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+// responseError reads the code and message of an S3 error response
+func (s *S3Storage) responseError(operation string, resp *http.Response) error {
+	var s3Err struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if err := xml.Unmarshal(body, &s3Err); err != nil || s3Err.Code == "" {
+		return fmt.Errorf("storage: %s failed: %s", operation, resp.Status)
+	}
+	return fmt.Errorf("storage: %s failed: %s: %s", operation, s3Err.Code, s3Err.Message)
+}
+
+func parseEndpoint(endpoint string) (*url.URL, error) {
+	parsed, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("storage: invalid S3 endpoint %q", endpoint)
+	}
+	return parsed, nil
+}
+
+func signedHeaderNames(headers map[string]string) string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ";")
+}
+
+// canonicalQuery sorts the parameters and encodes them as Signature Version 4 expects, spaces
+// as %20 rather than +
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		values := append([]string(nil), query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			pairs = append(pairs, uriEncode(key)+"="+uriEncode(value))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// escapeKey encodes every segment of the key, keeping the slashes between them
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = uriEncode(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// uriEncode escapes everything but the unreserved characters of RFC 3986
+func uriEncode(value string) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,51 @@
+// Package storage keeps binary files, such as medical images and documents, outside the database.
+// Files are addressed by a key made of slash separated segments, e.g. pets/12/3f2a.pdf
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+var (
+	ErrNotFound   = errors.New("storage: object not found")
+	ErrInvalidKey = errors.New("storage: invalid object key")
+)
+
+// BlobStorage stores files under a key. Put replaces the file kept under the same key
+type BlobStorage interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	// Get opens the file, ErrNotFound when there is none. The caller closes it
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the file, deleting a missing file is not an error
+	Delete(ctx context.Context, key string) error
+}
+
+// DownloadOptions are the headers the file is served with
+type DownloadOptions struct {
+	FileName    string
+	ContentType string
+}
+
+// Presigner is implemented by the storages able to serve a file themselves through a URL
+// valid for a while, so downloads don't go through the API
+type Presigner interface {
+	PresignGet(ctx context.Context, key string, expiresIn time.Duration, options DownloadOptions) (string, error)
+}
+
+// ValidateKey rejects empty keys, absolute keys and keys escaping their prefix with ".."
+func ValidateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") || path.Clean(key) != key {
+		return ErrInvalidKey
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "." || segment == ".." {
+			return ErrInvalidKey
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// Package worker runs periodic background jobs next to the HTTP server
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"clinic-vet-api/app/shared/log"
+
+	"go.uber.org/zap"
+)
+
+// Job is a unit of background work executed on every tick of its interval.
+// Jobs must be safe to run concurrently on several API replicas
+type Job interface {
+	Name() string
+	Run(ctx context.Context) error
+}
+
+// Service is a long running background process, such as a network listener. Serve blocks
+// until ctx is cancelled
+type Service interface {
+	Name() string
+	Serve(ctx context.Context) error
+}
+
+type scheduledJob struct {
+	job      Job
+	interval time.Duration
+}
+
+// Scheduler keeps the registered jobs and services and runs each one in its own goroutine until
+// stopped
+type Scheduler struct {
+	jobs     []scheduledJob
+	services []Service
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+// Register adds a job to be run every interval once the scheduler starts
+func (s *Scheduler) Register(job Job, interval time.Duration) {
+	if job == nil || interval <= 0 {
+		return
+	}
+	s.jobs = append(s.jobs, scheduledJob{job: job, interval: interval})
+}
+
+// RegisterService adds a service to be served once the scheduler starts
+func (s *Scheduler) RegisterService(service Service) {
+	if service == nil {
+		return
+	}
+	s.services = append(s.services, service)
+}
+
+// Start launches every registered job and service. It returns immediately; they stop when ctx is
+// cancelled or Stop is called
+func (s *Scheduler) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	for _, scheduled := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, scheduled)
+	}
+
+	for _, service := range s.services {
+		s.wg.Add(1)
+		go s.serve(ctx, service)
+	}
+}
+
+// Stop cancels the running jobs and services and waits for the current executions to finish
+func (s *Scheduler) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(ctx context.Context, scheduled scheduledJob) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(scheduled.interval)
+	defer ticker.Stop()
+
+	log.Info("background job started", zap.String("job", scheduled.job.Name()), zap.Duration("interval", scheduled.interval))
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("background job stopped", zap.String("job", scheduled.job.Name()))
+			return
+		case <-ticker.C:
+			if err := scheduled.job.Run(ctx); err != nil {
+				log.Error("background job failed", err, zap.String("job", scheduled.job.Name()))
+			}
+		}
+	}
+}
+
+func (s *Scheduler) serve(ctx context.Context, service Service) {
+	defer s.wg.Done()
+
+	if err := service.Serve(ctx); err != nil {
+		log.Error("background service failed", err, zap.String("service", service.Name()))
+	}
+}
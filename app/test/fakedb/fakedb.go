@@ -0,0 +1,243 @@
+// Package fakedb answers the queries generated by sqlc from scripted results, so repositories
+// can be tested together with their transactions without a running database
+package fakedb
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Result is the answer to a query: the rows it returns, one value per scanned column, or the
+// rows it affected. A nil column or a missing one scans as the zero value
+type Result struct {
+	Rows         [][]any
+	RowsAffected int64
+	Err          error
+}
+
+// Handler answers a query from its arguments
+type Handler func(args []any) Result
+
+// Call is a query run against the database, identified by its sqlc name
+type Call struct {
+	Name string
+	Args []any
+}
+
+// DB implements sqlc.DBTX and database.TxBeginner. Queries without a handler return no rows
+type DB struct {
+	mu         sync.Mutex
+	handlers   map[string]Handler
+	calls      []Call
+	commits    int
+	rollbacks  int
+	txBeginErr error
+}
+
+func New() *DB {
+	return &DB{handlers: make(map[string]Handler)}
+}
+
+// On answers the query with the given sqlc name, such as "RefillPrescription"
+func (db *DB) On(name string, handler Handler) *DB {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.handlers[name] = handler
+	return db
+}
+
+// Returns answers the query with the same result every time
+func (db *DB) Returns(name string, result Result) *DB {
+	return db.On(name, func([]any) Result { return result })
+}
+
+// FailBegin makes every transaction fail to start
+func (db *DB) FailBegin(err error) {
+	db.txBeginErr = err
+}
+
+// Calls returns the queries run so far, in order
+func (db *DB) Calls() []Call {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return append([]Call(nil), db.calls...)
+}
+
+// CallsTo returns the queries run so far with the given sqlc name
+func (db *DB) CallsTo(name string) []Call {
+	var found []Call
+	for _, call := range db.Calls() {
+		if call.Name == name {
+			found = append(found, call)
+		}
+	}
+	return found
+}
+
+func (db *DB) Commits() int {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.commits
+}
+
+func (db *DB) Rollbacks() int {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.rollbacks
+}
+
+func (db *DB) Begin(ctx context.Context) (pgx.Tx, error) {
+	if db.txBeginErr != nil {
+		return nil, db.txBeginErr
+	}
+	return &tx{db: db}, nil
+}
+
+func (db *DB) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	result := db.run(sql, args)
+	return pgconn.NewCommandTag(fmt.Sprintf("UPDATE %d", result.RowsAffected)), result.Err
+}
+
+func (db *DB) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	result := db.run(sql, args)
+	if result.Err != nil {
+		return nil, result.Err
+	}
+	return &rows{values: result.Rows, index: -1}, nil
+}
+
+func (db *DB) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	result := db.run(sql, args)
+	return &row{values: result.Rows, err: result.Err}
+}
+
+func (db *DB) run(sql string, args []any) Result {
+	name := queryName(sql)
+
+	db.mu.Lock()
+	db.calls = append(db.calls, Call{Name: name, Args: args})
+	handler, exists := db.handlers[name]
+	db.mu.Unlock()
+
+	if !exists {
+		return Result{}
+	}
+	return handler(args)
+}
+
+// queryName reads the name sqlc writes on the first line of every query: "-- name: X :one"
+func queryName(sql string) string {
+	fields := strings.Fields(strings.SplitN(sql, "\n", 2)[0])
+	if len(fields) >= 3 && fields[0] == "--" && fields[1] == "name:" {
+		return fields[2]
+	}
+	return sql
+}
+
+// tx runs its queries on the database and only records how it ended
+type tx struct {
+	pgx.Tx
+	db   *DB
+	done bool
+}
+
+func (t *tx) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	return t.db.Exec(ctx, sql, args...)
+}
+
+func (t *tx) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	return t.db.Query(ctx, sql, args...)
+}
+
+func (t *tx) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return t.db.QueryRow(ctx, sql, args...)
+}
+
+func (t *tx) Commit(ctx context.Context) error {
+	if t.done {
+		return pgx.ErrTxClosed
+	}
+	t.done = true
+
+	t.db.mu.Lock()
+	defer t.db.mu.Unlock()
+	t.db.commits++
+	return nil
+}
+
+func (t *tx) Rollback(ctx context.Context) error {
+	if t.done {
+		return pgx.ErrTxClosed
+	}
+	t.done = true
+
+	t.db.mu.Lock()
+	defer t.db.mu.Unlock()
+	t.db.rollbacks++
+	return nil
+}
+
+type row struct {
+	values [][]any
+	err    error
+}
+
+func (r *row) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	if len(r.values) == 0 {
+		return pgx.ErrNoRows
+	}
+	return scan(r.values[0], dest)
+}
+
+type rows struct {
+	pgx.Rows
+	values [][]any
+	index  int
+}
+
+func (r *rows) Next() bool {
+	r.index++
+	return r.index < len(r.values)
+}
+
+func (r *rows) Scan(dest ...any) error {
+	return scan(r.values[r.index], dest)
+}
+
+func (r *rows) Close()     {}
+func (r *rows) Err() error { return nil }
+
+func scan(values []any, dest []any) error {
+	for i, target := range dest {
+		pointer := reflect.ValueOf(target)
+		if pointer.Kind() != reflect.Pointer || pointer.IsNil() {
+			return fmt.Errorf("fakedb: column %d is not scanned into a pointer", i)
+		}
+		field := pointer.Elem()
+
+		if i >= len(values) || values[i] == nil {
+			field.Set(reflect.Zero(field.Type()))
+			continue
+		}
+
+		value := reflect.ValueOf(values[i])
+		switch {
+		case value.Type().AssignableTo(field.Type()):
+			field.Set(value)
+		case value.Type().ConvertibleTo(field.Type()):
+			field.Set(value.Convert(field.Type()))
+		default:
+			return fmt.Errorf("fakedb: cannot scan %T into %s for column %d", values[i], field.Type(), i)
+		}
+	}
+	return nil
+}
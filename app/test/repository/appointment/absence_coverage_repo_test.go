@@ -0,0 +1,108 @@
+package appointment_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	repositoryimpl "clinic-vet-api/app/modules/appointment/infrastructure/repository"
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/employee"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/database"
+	"clinic-vet-api/app/shared/log"
+	"clinic-vet-api/app/test/fakedb"
+	"clinic-vet-api/sqlc"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type AbsenceCoverageRepositoryTestSuite struct {
+	suite.Suite
+	ctx   context.Context
+	db    *fakedb.DB
+	repo  repository.AbsenceCoverageRepository
+	start time.Time
+}
+
+func TestAbsenceCoverageRepositorySuite(t *testing.T) {
+	suite.Run(t, new(AbsenceCoverageRepositoryTestSuite))
+}
+
+func (s *AbsenceCoverageRepositoryTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.start = time.Date(2030, time.March, 4, 8, 0, 0, 0, time.UTC)
+	s.db = fakedb.New().
+		Returns("CreateScheduleException", fakedb.Result{Rows: [][]any{{int32(5)}}}).
+		Returns("UpdateAppointment", fakedb.Result{Rows: [][]any{{}}})
+
+	s.repo = repositoryimpl.NewSqlcAbsenceCoverageRepository(database.NewTransactor(s.db, sqlc.New(s.db)))
+}
+
+func (s *AbsenceCoverageRepositoryTestSuite) timeOff(id uint) employee.ScheduleException {
+	return *employee.NewScheduleExceptionBuilder().
+		WithID(vo.NewScheduleExcID(id)).
+		WithEmployeeID(vo.NewEmployeeID(4)).
+		WithType(enum.ScheduleExceptionSickLeave).
+		WithPeriod(s.start, s.start.Add(10*time.Hour)).
+		WithReason("Sick day").
+		WithStatus(enum.ScheduleExceptionStatusApproved).
+		Build()
+}
+
+func (s *AbsenceCoverageRepositoryTestSuite) cancelled(id uint) appt.Appointment {
+	return *appt.NewAppointmentBuilder().
+		WithID(vo.NewAppointmentID(id)).
+		WithService(enum.ClinicServiceGeneralConsultation).
+		WithScheduledDate(s.start.Add(time.Hour)).
+		WithStatus(enum.AppointmentStatusCancelled).
+		Build()
+}
+
+func (s *AbsenceCoverageRepositoryTestSuite) TestSaveCoverage() {
+	testCases := []struct {
+		name      string
+		timeOffID uint
+		created   int
+	}{
+		{"new time off is inserted", 0, 1},
+		{"approved time off is only updated", 5, 0},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			timeOff := s.timeOff(tc.timeOffID)
+
+			err := s.repo.SaveCoverage(s.ctx, &timeOff, []appt.Appointment{s.cancelled(7)})
+
+			s.Require().NoError(err)
+			s.Len(s.db.CallsTo("CreateScheduleException"), tc.created)
+			s.Len(s.db.CallsTo("UpdateScheduleExceptionStatus"), 1)
+			s.Len(s.db.CallsTo("UpdateAppointment"), 1)
+			s.Len(s.db.CallsTo("DeleteAppointmentReservations"), 1, "the cancelled appointment frees its resources")
+			s.Equal(vo.NewScheduleExcID(5), timeOff.ID())
+			s.Equal(1, s.db.Commits())
+		})
+	}
+}
+
+func (s *AbsenceCoverageRepositoryTestSuite) TestSaveCoverage_NothingSavedOnFailure() {
+	s.db.On("UpdateAppointment", func([]any) fakedb.Result {
+		return fakedb.Result{Err: errors.New("connection reset")}
+	})
+	timeOff := s.timeOff(0)
+
+	err := s.repo.SaveCoverage(s.ctx, &timeOff, []appt.Appointment{s.cancelled(7)})
+
+	s.Require().Error(err)
+	s.True(timeOff.ID().IsZero(), "the time off keeps no ID when rolled back")
+	s.Equal(0, s.db.Commits())
+	s.Equal(1, s.db.Rollbacks())
+}
@@ -0,0 +1,222 @@
+package appointment_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	repositoryimpl "clinic-vet-api/app/modules/appointment/infrastructure/repository"
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/resource"
+	"clinic-vet-api/app/modules/core/domain/entity/waitlist"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/database"
+	"clinic-vet-api/app/shared/log"
+	"clinic-vet-api/app/test/fakedb"
+	"clinic-vet-api/sqlc"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type ReservationRepositoryTestSuite struct {
+	suite.Suite
+	ctx   context.Context
+	db    *fakedb.DB
+	repo  repository.AppointmentReservationRepository
+	start time.Time
+	busy  map[int32]bool
+}
+
+func TestReservationRepositorySuite(t *testing.T) {
+	suite.Run(t, new(ReservationRepositoryTestSuite))
+}
+
+func (s *ReservationRepositoryTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.start = time.Date(2030, time.March, 4, 10, 0, 0, 0, time.UTC)
+	s.busy = map[int32]bool{}
+
+	// Surgeries need one theatre, the clinic has two
+	s.db = fakedb.New().
+		Returns("CreateAppointment", fakedb.Result{Rows: [][]any{{int32(42)}}}).
+		Returns("UpdateAppointment", fakedb.Result{Rows: [][]any{{}}}).
+		Returns("ListServiceResourceRequirements", fakedb.Result{Rows: [][]any{
+			{"surgery", string(enum.ResourceTypeSurgeryTheatre), int16(1)},
+		}}).
+		Returns("LockActiveClinicResourcesByType", fakedb.Result{Rows: [][]any{
+			{int32(1), "Theatre 1", string(enum.ResourceTypeSurgeryTheatre), nil, true},
+			{int32(2), "Theatre 2", string(enum.ResourceTypeSurgeryTheatre), nil, true},
+		}}).
+		On("ListBusyClinicResourceIDs", func(args []any) fakedb.Result {
+			var busyRows [][]any
+			for _, id := range args[0].([]int32) {
+				if s.busy[id] {
+					busyRows = append(busyRows, []any{id})
+				}
+			}
+			return fakedb.Result{Rows: busyRows}
+		})
+
+	s.repo = repositoryimpl.NewSqlcReservationRepository(database.NewTransactor(s.db, sqlc.New(s.db)))
+}
+
+func (s *ReservationRepositoryTestSuite) surgery(id uint, status enum.AppointmentStatus) appt.Appointment {
+	vetID := vo.NewEmployeeID(1)
+	return *appt.NewAppointmentBuilder().
+		WithID(vo.NewAppointmentID(id)).
+		WithPetID(vo.NewPetID(1)).
+		WithCustomerID(vo.NewCustomerID(1)).
+		WithEmployeeID(&vetID).
+		WithService(enum.ClinicServiceSurgery).
+		WithScheduledDate(s.start).
+		WithStatus(status).
+		Build()
+}
+
+func (s *ReservationRepositoryTestSuite) reservedResources() []int32 {
+	var reserved []int32
+	for _, call := range s.db.CallsTo("CreateResourceReservation") {
+		reserved = append(reserved, call.Args[1].(int32))
+	}
+	return reserved
+}
+
+func (s *ReservationRepositoryTestSuite) TestSaveWithReservations_ReservesFreeResource() {
+	s.busy[1] = true
+	appointments := []appt.Appointment{s.surgery(0, enum.AppointmentStatusConfirmed)}
+
+	err := s.repo.SaveWithReservations(s.ctx, appointments)
+
+	s.Require().NoError(err)
+	s.Equal([]int32{2}, s.reservedResources())
+	s.Equal(int32(42), s.db.CallsTo("CreateResourceReservation")[0].Args[0])
+	s.Equal(vo.NewAppointmentID(42), appointments[0].ID(), "the ID is set once committed")
+	s.Equal(1, s.db.Commits())
+}
+
+func (s *ReservationRepositoryTestSuite) TestSaveWithReservations_RejectsWhenAllBusy() {
+	s.busy[1] = true
+	s.busy[2] = true
+	appointments := []appt.Appointment{s.surgery(0, enum.AppointmentStatusConfirmed)}
+
+	err := s.repo.SaveWithReservations(s.ctx, appointments)
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "only 0 is free")
+	s.Empty(s.reservedResources())
+	s.True(appointments[0].ID().IsZero(), "nothing was saved")
+	s.Equal(0, s.db.Commits())
+	s.Equal(1, s.db.Rollbacks())
+}
+
+func (s *ReservationRepositoryTestSuite) TestSaveWithReservations_RescheduleReleasesOwnReservations() {
+	appointments := []appt.Appointment{s.surgery(7, enum.AppointmentStatusRescheduled)}
+
+	err := s.repo.SaveWithReservations(s.ctx, appointments)
+
+	s.Require().NoError(err)
+	s.Len(s.db.CallsTo("DeleteAppointmentReservations"), 1)
+	s.Equal(int32(7), s.db.CallsTo("ListBusyClinicResourceIDs")[0].Args[3],
+		"the reservations of the appointment itself do not block it")
+	s.Equal([]int32{1}, s.reservedResources())
+}
+
+func (s *ReservationRepositoryTestSuite) TestSaveWithReservations_SeriesIsAllOrNothing() {
+	first := s.surgery(0, enum.AppointmentStatusConfirmed)
+	second := s.surgery(0, enum.AppointmentStatusConfirmed)
+	appointments := []appt.Appointment{first, second}
+
+	// The first occurrence takes a theatre, the second finds both busy
+	s.db.On("ListBusyClinicResourceIDs", func(args []any) fakedb.Result {
+		if len(s.db.CallsTo("ListBusyClinicResourceIDs")) == 1 {
+			return fakedb.Result{}
+		}
+		return fakedb.Result{Rows: [][]any{{int32(1)}, {int32(2)}}}
+	})
+
+	err := s.repo.SaveWithReservations(s.ctx, appointments)
+
+	s.Require().Error(err)
+	s.True(appointments[0].ID().IsZero())
+	s.Equal(0, s.db.Commits())
+	s.Equal(1, s.db.Rollbacks())
+}
+
+func (s *ReservationRepositoryTestSuite) TestSaveWithReservations_CancelledHoldsNothing() {
+	appointments := []appt.Appointment{s.surgery(7, enum.AppointmentStatusCancelled)}
+
+	err := s.repo.SaveWithReservations(s.ctx, appointments)
+
+	s.Require().NoError(err)
+	s.Empty(s.db.CallsTo("ListServiceResourceRequirements"))
+	s.Empty(s.reservedResources())
+}
+
+func (s *ReservationRepositoryTestSuite) TestSaveClaim_ReservesResources() {
+	testCases := []struct {
+		name     string
+		busy     []int32
+		claimed  bool
+		reserved []int32
+	}{
+		{"free theatre", []int32{1}, true, []int32{2}},
+		{"every theatre busy", []int32{1, 2}, false, nil},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			for _, id := range tc.busy {
+				s.busy[id] = true
+			}
+			s.db.Returns("ClaimWaitlistOffer", fakedb.Result{RowsAffected: 1})
+			repo := repositoryimpl.NewSqlcWaitlistRepository(sqlc.New(s.db), database.NewTransactor(s.db, sqlc.New(s.db)))
+
+			entry := waitlist.NewWaitlistEntryBuilder().WithID(vo.NewWaitlistID(3)).Build()
+			appointment := s.surgery(0, enum.AppointmentStatusPending)
+
+			claimed, err := repo.SaveClaim(s.ctx, entry, &appointment)
+
+			s.Equal(tc.reserved, s.reservedResources())
+			if !tc.claimed {
+				s.Error(err)
+				s.True(appointment.ID().IsZero(), "nothing was saved")
+				s.Equal(1, s.db.Rollbacks())
+				return
+			}
+
+			s.Require().NoError(err)
+			s.True(claimed)
+			s.Equal(int32(42), s.db.CallsTo("CreateResourceReservation")[0].Args[0])
+			s.Equal(vo.NewAppointmentID(42), appointment.ID())
+		})
+	}
+}
+
+func (s *ReservationRepositoryTestSuite) TestAllocate_SkipsInactiveAndOtherTypes() {
+	requirement := resource.ServiceRequirement{
+		Service:      enum.ClinicServiceDentalCare,
+		ResourceType: enum.ResourceTypeXRayRoom,
+		Quantity:     1,
+	}
+	candidates := []resource.ClinicResource{
+		*resource.NewClinicResourceBuilder().WithID(vo.NewResourceID(1)).WithResourceType(enum.ResourceTypeXRayRoom).WithIsActive(false).Build(),
+		*resource.NewClinicResourceBuilder().WithID(vo.NewResourceID(2)).WithResourceType(enum.ResourceTypeExamRoom).Build(),
+		*resource.NewClinicResourceBuilder().WithID(vo.NewResourceID(3)).WithResourceType(enum.ResourceTypeXRayRoom).Build(),
+	}
+
+	allocated, err := resource.Allocate(s.ctx, requirement, candidates, nil, s.start, s.start.Add(time.Hour))
+
+	s.Require().NoError(err)
+	s.Require().Len(allocated, 1)
+	s.Equal(vo.NewResourceID(3), allocated[0].ID())
+
+	requirement.Quantity = 2
+	_, err = resource.Allocate(s.ctx, requirement, candidates, nil, s.start, s.start.Add(time.Hour))
+	s.Error(err)
+}
@@ -0,0 +1,148 @@
+package appointment_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	repositoryimpl "clinic-vet-api/app/modules/appointment/infrastructure/repository"
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/database"
+	"clinic-vet-api/app/shared/log"
+	"clinic-vet-api/app/test/fakedb"
+	"clinic-vet-api/sqlc"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type ScheduleLockTestSuite struct {
+	suite.Suite
+	ctx   context.Context
+	db    *fakedb.DB
+	repo  repository.AppointmentReservationRepository
+	start time.Time
+	saved [][]any
+}
+
+func TestScheduleLockSuite(t *testing.T) {
+	suite.Run(t, new(ScheduleLockTestSuite))
+}
+
+func (s *ScheduleLockTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.start = time.Date(2030, time.March, 4, 10, 0, 0, 0, time.UTC)
+	s.saved = nil
+
+	// Consultations need no resources, only the schedule is checked
+	s.db = fakedb.New().
+		Returns("CreateAppointment", fakedb.Result{Rows: [][]any{{int32(42)}}}).
+		Returns("UpdateAppointment", fakedb.Result{Rows: [][]any{{}}}).
+		On("FindScheduleConflictCandidates", func([]any) fakedb.Result {
+			return fakedb.Result{Rows: s.saved}
+		})
+
+	s.repo = repositoryimpl.NewSqlcReservationRepository(database.NewTransactor(s.db, sqlc.New(s.db)))
+}
+
+// alreadySaved adds an appointment another booking committed first
+func (s *ScheduleLockTestSuite) alreadySaved(id, vetID, petID int32, scheduledDate time.Time, status enum.AppointmentStatus) {
+	s.saved = append(s.saved, []any{
+		id, string(enum.ClinicServiceGeneralConsultation), pgtype.Timestamptz{Time: scheduledDate, Valid: true}, string(status), nil,
+		int32(1), petID, pgtype.Int4{Int32: vetID, Valid: true},
+	})
+}
+
+func (s *ScheduleLockTestSuite) consultation(id, vetID, petID uint, scheduledDate time.Time) appt.Appointment {
+	employeeID := vo.NewEmployeeID(vetID)
+	return *appt.NewAppointmentBuilder().
+		WithID(vo.NewAppointmentID(id)).
+		WithPetID(vo.NewPetID(petID)).
+		WithCustomerID(vo.NewCustomerID(1)).
+		WithEmployeeID(&employeeID).
+		WithService(enum.ClinicServiceGeneralConsultation).
+		WithScheduledDate(scheduledDate).
+		WithStatus(enum.AppointmentStatusConfirmed).
+		Build()
+}
+
+func (s *ScheduleLockTestSuite) TestSaveWithReservations_ChecksTheScheduleWithinTheTransaction() {
+	testCases := []struct {
+		name     string
+		saved    func()
+		conflict bool
+	}{
+		{"free slot", func() {}, false},
+		{"same employee at the same time", func() {
+			s.alreadySaved(7, 1, 2, s.start, enum.AppointmentStatusConfirmed)
+		}, true},
+		{"same pet with another employee", func() {
+			s.alreadySaved(7, 2, 1, s.start.Add(15*time.Minute), enum.AppointmentStatusPending)
+		}, true},
+		{"cancelled appointment", func() {
+			s.alreadySaved(7, 1, 2, s.start, enum.AppointmentStatusCancelled)
+		}, false},
+		{"ends before the slot", func() {
+			s.alreadySaved(7, 1, 2, s.start.Add(-time.Hour), enum.AppointmentStatusConfirmed)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.saved()
+			appointments := []appt.Appointment{s.consultation(0, 1, 1, s.start)}
+
+			err := s.repo.SaveWithReservations(s.ctx, appointments)
+
+			calls := []string{}
+			for _, call := range s.db.Calls() {
+				calls = append(calls, call.Name)
+			}
+			s.Equal([]string{"LockEmployeeSchedule", "LockPetSchedule", "FindScheduleConflictCandidates"}, calls[:3],
+				"the employee and the pet are locked before the check")
+
+			if tc.conflict {
+				s.Error(err)
+				s.Empty(s.db.CallsTo("CreateAppointment"))
+				s.Equal(1, s.db.Rollbacks())
+				return
+			}
+			s.Require().NoError(err)
+			s.Equal(1, s.db.Commits())
+		})
+	}
+}
+
+func (s *ScheduleLockTestSuite) TestSaveWithReservations_IgnoresTheAppointmentsOfTheBatch() {
+	// Two occurrences swap their slots, each one still finds the other at its new time
+	s.alreadySaved(7, 1, 1, s.start, enum.AppointmentStatusConfirmed)
+	s.alreadySaved(8, 1, 1, s.start.Add(time.Hour), enum.AppointmentStatusConfirmed)
+	appointments := []appt.Appointment{
+		s.consultation(7, 1, 1, s.start.Add(time.Hour)),
+		s.consultation(8, 1, 1, s.start),
+	}
+
+	err := s.repo.SaveWithReservations(s.ctx, appointments)
+
+	s.Require().NoError(err)
+	s.Len(s.db.CallsTo("UpdateAppointment"), 2)
+}
+
+func (s *ScheduleLockTestSuite) TestSaveWithReservations_SkipsWhatNoLongerTakesPlace() {
+	s.alreadySaved(7, 1, 1, s.start, enum.AppointmentStatusConfirmed)
+	cancelled := s.consultation(8, 1, 1, s.start)
+	s.Require().NoError(cancelled.Cancel(s.ctx))
+
+	err := s.repo.SaveWithReservations(s.ctx, []appt.Appointment{cancelled})
+
+	s.Require().NoError(err)
+	s.Empty(s.db.CallsTo("LockEmployeeSchedule"))
+	s.Empty(s.db.CallsTo("FindScheduleConflictCandidates"))
+}
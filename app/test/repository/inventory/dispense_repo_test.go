@@ -0,0 +1,176 @@
+package inventory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/inventory"
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	inventoryRepo "clinic-vet-api/app/modules/inventory/infrastructure/repository"
+	vaccinationRepo "clinic-vet-api/app/modules/medical/vaccination/infrastructure/repository"
+	"clinic-vet-api/app/shared/database"
+	"clinic-vet-api/app/shared/log"
+	"clinic-vet-api/app/shared/mapper"
+	"clinic-vet-api/app/test/fakedb"
+	"clinic-vet-api/sqlc"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type DispenseRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	now        time.Time
+	db         *fakedb.DB
+	transactor *database.Transactor
+}
+
+func TestDispenseRepositorySuite(t *testing.T) {
+	suite.Run(t, new(DispenseRepositoryTestSuite))
+}
+
+func (s *DispenseRepositoryTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.now = time.Date(2030, time.March, 4, 10, 0, 0, 0, time.UTC)
+
+	s.db = fakedb.New().
+		On("CreateStockMovement", func(args []any) fakedb.Result {
+			return fakedb.Result{Rows: [][]any{append([]any{int32(9)}, args...)}}
+		})
+	s.stockLot(10, s.now.AddDate(1, 0, 0))
+	s.transactor = database.NewTransactor(s.db, sqlc.New(s.db))
+}
+
+// stockLot makes lot 3 hold the units until the expiry date
+func (s *DispenseRepositoryTestSuite) stockLot(quantity int32, expiresOn time.Time) {
+	s.db.Returns("LockStockLot", fakedb.Result{Rows: [][]any{{
+		int32(3), int32(1), int32(1), "LOT-3",
+		pgtype.Date{Time: expiresOn, Valid: true}, quantity,
+	}}})
+}
+
+func (s *DispenseRepositoryTestSuite) dispensing(quantity int) inventory.Dispensing {
+	return inventory.Dispensing{
+		LotID:       vo.NewStockLotID(3),
+		Quantity:    quantity,
+		DispensedBy: vo.NewEmployeeID(1),
+		At:          s.now,
+	}
+}
+
+func (s *DispenseRepositoryTestSuite) dispenseWithin(dispensing inventory.Dispensing, record func() (inventory.StockReference, error)) (inventory.StockMovement, error) {
+	var movement inventory.StockMovement
+	err := s.transactor.WithinTx(s.ctx, func(queries *sqlc.Queries) error {
+		var err error
+		movement, err = inventoryRepo.DispenseWithin(s.ctx, queries, dispensing, record)
+		return err
+	})
+	return movement, err
+}
+
+func recordAs(id uint) func() (inventory.StockReference, error) {
+	return func() (inventory.StockReference, error) {
+		return inventory.StockReference{Type: enum.StockReferenceVaccination, ID: id}, nil
+	}
+}
+
+func (s *DispenseRepositoryTestSuite) TestDispense_DomainDecrementsStock() {
+	lot := inventory.NewStockLotBuilder().
+		WithID(vo.NewStockLotID(3)).
+		WithLotNumber("LOT-3").
+		WithExpiresOn(s.now.AddDate(1, 0, 0)).
+		WithQuantityOnHand(5).
+		Build()
+
+	movement, err := lot.DispenseFor(s.ctx, s.dispensing(3), inventory.StockReference{Type: enum.StockReferencePrescription, ID: 1})
+
+	s.Require().NoError(err)
+	s.Equal(2, lot.QuantityOnHand())
+	s.Equal(-3, movement.Quantity())
+	s.Equal(2, movement.BalanceAfter())
+
+	_, err = lot.DispenseFor(s.ctx, s.dispensing(3), inventory.StockReference{Type: enum.StockReferencePrescription, ID: 2})
+	s.Error(err, "only two units are left")
+	s.Equal(2, lot.QuantityOnHand())
+
+	_, err = lot.DispenseFor(s.ctx, s.dispensing(0), inventory.StockReference{Type: enum.StockReferencePrescription, ID: 3})
+	s.Error(err)
+}
+
+func (s *DispenseRepositoryTestSuite) TestDispenseWithin_SavesLotAndMovement() {
+	movement, err := s.dispenseWithin(s.dispensing(3), recordAs(12))
+
+	s.Require().NoError(err)
+	s.Require().Len(s.db.CallsTo("UpdateStockLotQuantity"), 1)
+	s.Equal(int32(7), s.db.CallsTo("UpdateStockLotQuantity")[0].Args[1])
+	s.Equal(7, movement.BalanceAfter())
+	s.Equal(enum.StockMovementDispense, movement.MovementType())
+	s.Require().NotNil(movement.Reference())
+	s.Equal(uint(12), movement.Reference().ID)
+	s.Equal(1, s.db.Commits())
+}
+
+func (s *DispenseRepositoryTestSuite) TestDispenseWithin_LocksLotBeforeRecording() {
+	var callsBeforeRecord []fakedb.Call
+	_, err := s.dispenseWithin(s.dispensing(1), func() (inventory.StockReference, error) {
+		callsBeforeRecord = s.db.Calls()
+		return recordAs(12)()
+	})
+
+	s.Require().NoError(err)
+	s.Require().Len(callsBeforeRecord, 1)
+	s.Equal("LockStockLot", callsBeforeRecord[0].Name)
+}
+
+func (s *DispenseRepositoryTestSuite) TestDispenseWithin_InsufficientStockRecordsNothing() {
+	recorded := false
+	_, err := s.dispenseWithin(s.dispensing(11), func() (inventory.StockReference, error) {
+		recorded = true
+		return recordAs(12)()
+	})
+
+	s.Require().Error(err)
+	s.False(recorded, "the record is not saved without its stock")
+	s.Empty(s.db.CallsTo("UpdateStockLotQuantity"))
+	s.Equal(1, s.db.Rollbacks())
+}
+
+func (s *DispenseRepositoryTestSuite) TestDispenseWithin_ExpiredLot() {
+	s.stockLot(10, s.now.AddDate(0, 0, -1))
+
+	_, err := s.dispenseWithin(s.dispensing(1), recordAs(12))
+
+	s.Require().Error(err)
+	s.Empty(s.db.CallsTo("UpdateStockLotQuantity"))
+}
+
+func (s *DispenseRepositoryTestSuite) TestDispenseWithin_FailedRecordLeavesStock() {
+	_, err := s.dispenseWithin(s.dispensing(1), func() (inventory.StockReference, error) {
+		return inventory.StockReference{}, errors.New("insert failed")
+	})
+
+	s.Require().Error(err)
+	s.Empty(s.db.CallsTo("UpdateStockLotQuantity"))
+	s.Empty(s.db.CallsTo("CreateStockMovement"))
+	s.Equal(1, s.db.Rollbacks())
+}
+
+func (s *DispenseRepositoryTestSuite) TestVaccinationSaveDispensed_InsufficientStock() {
+	s.stockLot(0, s.now.AddDate(1, 0, 0))
+	repo := vaccinationRepo.NewSqlcPetVaccinationRepository(sqlc.New(s.db), s.transactor, mapper.NewSqlcFieldMapper())
+
+	_, err := repo.SaveDispensed(s.ctx, medical.PetVaccination{}, s.dispensing(1))
+
+	s.Require().Error(err)
+	s.Empty(s.db.CallsTo("CreatePetVaccination"), "no vaccination is recorded when the dose is not in stock")
+	s.Equal(0, s.db.Commits())
+	s.Equal(1, s.db.Rollbacks())
+}
@@ -0,0 +1,172 @@
+package prescription_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/inventory"
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	repositoryimpl "clinic-vet-api/app/modules/medical/prescription/infrastructure/repository"
+	"clinic-vet-api/app/shared/database"
+	"clinic-vet-api/app/shared/log"
+	"clinic-vet-api/app/shared/mapper"
+	"clinic-vet-api/app/test/fakedb"
+	"clinic-vet-api/sqlc"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type RefillRepositoryTestSuite struct {
+	suite.Suite
+	ctx  context.Context
+	now  time.Time
+	db   *fakedb.DB
+	repo repository.PrescriptionRepository
+}
+
+func TestRefillRepositorySuite(t *testing.T) {
+	suite.Run(t, new(RefillRepositoryTestSuite))
+}
+
+func (s *RefillRepositoryTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.now = time.Date(2030, time.March, 4, 10, 0, 0, 0, time.UTC)
+
+	// Lot 3 holds ten units and expires in a year
+	s.db = fakedb.New().
+		Returns("LockStockLot", fakedb.Result{Rows: [][]any{{
+			int32(3), int32(1), int32(1), "LOT-3",
+			pgtype.Date{Time: s.now.AddDate(1, 0, 0), Valid: true}, int32(10),
+		}}}).
+		Returns("CreateStockMovement", fakedb.Result{Rows: [][]any{{int32(1)}}})
+
+	s.repo = repositoryimpl.NewSqlcPrescriptionRepository(
+		sqlc.New(s.db),
+		database.NewTransactor(s.db, sqlc.New(s.db)),
+		mapper.NewSqlcFieldMapper(),
+	)
+}
+
+func (s *RefillRepositoryTestSuite) prescription(refillsRemaining int, status enum.PrescriptionStatus) *medical.Prescription {
+	return medical.NewPrescriptionBuilder().
+		WithID(vo.NewPrescriptionID(5)).
+		WithOrder(medical.PrescriptionOrder{DrugName: "Amoxicillin", Quantity: 2, Refills: 2}).
+		WithRefillsRemaining(refillsRemaining).
+		WithStatus(status).
+		WithFills(s.now.AddDate(0, -1, 0), s.now.AddDate(0, -1, 0)).
+		Build()
+}
+
+func (s *RefillRepositoryTestSuite) dispensing() *inventory.Dispensing {
+	return &inventory.Dispensing{
+		LotID:       vo.NewStockLotID(3),
+		Quantity:    2,
+		DispensedBy: vo.NewEmployeeID(1),
+		At:          s.now,
+	}
+}
+
+func (s *RefillRepositoryTestSuite) TestRefill_DomainLimits() {
+	prescription := s.prescription(1, enum.PrescriptionStatusActive)
+
+	s.Require().NoError(prescription.Refill(s.ctx, s.now))
+	s.Equal(0, prescription.RefillsRemaining())
+	s.Equal(s.now, prescription.LastFilledAt())
+	s.Error(prescription.Refill(s.ctx, s.now), "no refills remain")
+
+	cancelled := s.prescription(2, enum.PrescriptionStatusCancelled)
+	s.Error(cancelled.Refill(s.ctx, s.now))
+
+	expired := s.prescription(2, enum.PrescriptionStatusActive)
+	s.Error(expired.Refill(s.ctx, expired.ExpiresAt()))
+}
+
+func (s *RefillRepositoryTestSuite) TestRefill_TakenWithoutDispensing() {
+	s.db.Returns("RefillPrescription", fakedb.Result{RowsAffected: 1})
+
+	taken, err := s.repo.Refill(s.ctx, s.prescription(1, enum.PrescriptionStatusActive), nil)
+
+	s.Require().NoError(err)
+	s.True(taken)
+	s.Equal(int32(5), s.db.CallsTo("RefillPrescription")[0].Args[0])
+}
+
+func (s *RefillRepositoryTestSuite) TestRefill_NotTakenWhenNoRowUpdated() {
+	s.db.Returns("RefillPrescription", fakedb.Result{RowsAffected: 0})
+
+	taken, err := s.repo.Refill(s.ctx, s.prescription(1, enum.PrescriptionStatusActive), nil)
+
+	s.Require().NoError(err)
+	s.False(taken)
+}
+
+func (s *RefillRepositoryTestSuite) TestRefill_DispensesInTheSameTransaction() {
+	s.db.Returns("RefillPrescription", fakedb.Result{RowsAffected: 1})
+
+	taken, err := s.repo.Refill(s.ctx, s.prescription(1, enum.PrescriptionStatusActive), s.dispensing())
+
+	s.Require().NoError(err)
+	s.True(taken)
+	s.Require().Len(s.db.CallsTo("UpdateStockLotQuantity"), 1)
+	s.Equal(int32(8), s.db.CallsTo("UpdateStockLotQuantity")[0].Args[1])
+	s.Len(s.db.CallsTo("CreateStockMovement"), 1)
+	s.Equal(1, s.db.Commits())
+}
+
+func (s *RefillRepositoryTestSuite) TestRefill_LostRaceRollsBackStock() {
+	s.db.Returns("RefillPrescription", fakedb.Result{RowsAffected: 0})
+
+	taken, err := s.repo.Refill(s.ctx, s.prescription(1, enum.PrescriptionStatusActive), s.dispensing())
+
+	s.Require().NoError(err)
+	s.False(taken)
+	s.Empty(s.db.CallsTo("UpdateStockLotQuantity"), "no stock is taken for a refill that was not taken")
+	s.Equal(0, s.db.Commits())
+	s.Equal(1, s.db.Rollbacks())
+}
+
+func (s *RefillRepositoryTestSuite) TestRefill_ConcurrentRefillsNeverExceedAuthorized() {
+	// The conditional update only matches while refills remain, like the query does
+	var remaining atomic.Int32
+	remaining.Store(2)
+	s.db.On("RefillPrescription", func([]any) fakedb.Result {
+		for {
+			current := remaining.Load()
+			if current <= 0 {
+				return fakedb.Result{RowsAffected: 0}
+			}
+			if remaining.CompareAndSwap(current, current-1) {
+				return fakedb.Result{RowsAffected: 1}
+			}
+		}
+	})
+
+	var taken atomic.Int32
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Every request loaded the prescription before any refill was taken
+			ok, err := s.repo.Refill(s.ctx, s.prescription(2, enum.PrescriptionStatusActive), nil)
+			s.NoError(err)
+			if ok {
+				taken.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	s.Equal(int32(2), taken.Load())
+	s.Equal(int32(0), remaining.Load())
+}
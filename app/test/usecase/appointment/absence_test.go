@@ -0,0 +1,230 @@
+package appointment_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/employee"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/log"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type AbsenceTestSuite struct {
+	suite.Suite
+	ctx     context.Context
+	absence appt.Absence
+}
+
+func TestAbsenceSuite(t *testing.T) {
+	suite.Run(t, new(AbsenceTestSuite))
+}
+
+func (s *AbsenceTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.absence = appt.Absence{
+		EmployeeID: vo.NewEmployeeID(4),
+		Start:      s.monday(8, 0),
+		End:        s.monday(18, 0),
+		Reason:     "Sick day",
+	}
+}
+
+// monday returns the given time of Monday March 4 2030
+func (s *AbsenceTestSuite) monday(hour, minute int) time.Time {
+	return time.Date(2030, time.March, 4, hour, minute, 0, 0, time.UTC)
+}
+
+func (s *AbsenceTestSuite) booked(id, vetID uint, service enum.ClinicService, scheduledDate time.Time, status enum.AppointmentStatus) appt.Appointment {
+	employeeID := vo.NewEmployeeID(vetID)
+	return *appt.NewAppointmentBuilder().
+		WithID(vo.NewAppointmentID(id)).
+		WithEmployeeID(&employeeID).
+		WithService(service).
+		WithScheduledDate(scheduledDate).
+		WithStatus(status).
+		Build()
+}
+
+// workCalendar builds the calendar of an employee working the given days without exceptions
+func workCalendar(workDays ...vo.WorkDaySchedule) employee.WorkCalendar {
+	return employee.NewWorkCalendar(&vo.Schedule{WorkDays: workDays}, nil)
+}
+
+func (s *AbsenceTestSuite) TestNewAbsence() {
+	start := s.monday(8, 0)
+
+	testCases := []struct {
+		name       string
+		employeeID vo.EmployeeID
+		end        time.Time
+		reason     string
+		reportedBy vo.UserID
+		valid      bool
+	}{
+		{"one day", vo.NewEmployeeID(4), start.Add(10 * time.Hour), "Sick day", vo.NewUserID(9), true},
+		{"longest absence", vo.NewEmployeeID(4), start.AddDate(0, 0, appt.MaxAbsenceDays), "Holidays", vo.NewUserID(9), true},
+		{"no employee", vo.EmployeeID{}, start.Add(10 * time.Hour), "Sick day", vo.NewUserID(9), false},
+		{"nobody reporting it", vo.NewEmployeeID(4), start.Add(10 * time.Hour), "Sick day", vo.UserID{}, false},
+		{"ends before it starts", vo.NewEmployeeID(4), start.Add(-time.Hour), "Sick day", vo.NewUserID(9), false},
+		{"ends when it starts", vo.NewEmployeeID(4), start, "Sick day", vo.NewUserID(9), false},
+		{"too long", vo.NewEmployeeID(4), start.AddDate(0, 0, appt.MaxAbsenceDays+1), "Holidays", vo.NewUserID(9), false},
+		{"no reason", vo.NewEmployeeID(4), start.Add(10 * time.Hour), "", vo.NewUserID(9), false},
+		{"reason too long", vo.NewEmployeeID(4), start.Add(10 * time.Hour), strings.Repeat("x", appt.MaxAbsenceReasonLength+1), vo.NewUserID(9), false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			absence, err := appt.NewAbsence(s.ctx, tc.employeeID, start, tc.end, tc.reason, tc.reportedBy)
+			if !tc.valid {
+				s.Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Equal(tc.employeeID, absence.EmployeeID)
+			s.Equal(tc.end, absence.End)
+			s.Equal(tc.reportedBy, absence.ReportedBy)
+		})
+	}
+}
+
+func (s *AbsenceTestSuite) TestAffects() {
+	testCases := []struct {
+		name        string
+		appointment appt.Appointment
+		affected    bool
+	}{
+		{"confirmed within the absence", s.booked(1, 4, enum.ClinicServiceGeneralConsultation, s.monday(10, 0), enum.AppointmentStatusConfirmed), true},
+		{"starts before and ends within the absence", s.booked(1, 4, enum.ClinicServiceSurgery, s.monday(7, 0), enum.AppointmentStatusConfirmed), true},
+		{"ends when the absence starts", s.booked(1, 4, enum.ClinicServiceGeneralConsultation, s.monday(7, 30), enum.AppointmentStatusConfirmed), false},
+		{"starts when the absence ends", s.booked(1, 4, enum.ClinicServiceGeneralConsultation, s.monday(18, 0), enum.AppointmentStatusConfirmed), false},
+		{"pending", s.booked(1, 4, enum.ClinicServiceGeneralConsultation, s.monday(10, 0), enum.AppointmentStatusPending), false},
+		{"cancelled", s.booked(1, 4, enum.ClinicServiceGeneralConsultation, s.monday(10, 0), enum.AppointmentStatusCancelled), false},
+		{"booked with another employee", s.booked(1, 5, enum.ClinicServiceGeneralConsultation, s.monday(10, 0), enum.AppointmentStatusConfirmed), false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Equal(tc.affected, s.absence.Affects(tc.appointment))
+		})
+	}
+}
+
+func (s *AbsenceTestSuite) TestCoverAbsence() {
+	confirmed := enum.AppointmentStatusConfirmed
+	consultation := enum.ClinicServiceGeneralConsultation
+
+	substitutes := []appt.Substitute{
+		{
+			// the absent employee is never a substitute of themselves
+			EmployeeID: vo.NewEmployeeID(4),
+			Calendar:   workCalendar(vo.WorkDaySchedule{Day: time.Monday, StartHour: 8, EndHour: 18}),
+		},
+		{
+			EmployeeID: vo.NewEmployeeID(5),
+			Calendar:   workCalendar(vo.WorkDaySchedule{Day: time.Monday, StartHour: 8, EndHour: 14, Breaks: vo.Break{StartHour: 12, EndHour: 13}}),
+		},
+		{
+			EmployeeID: vo.NewEmployeeID(6),
+			Calendar:   workCalendar(vo.WorkDaySchedule{Day: time.Monday, StartHour: 9, EndHour: 18}),
+			Booked:     []appt.Appointment{s.booked(20, 6, consultation, s.monday(10, 0), confirmed)},
+		},
+		{
+			EmployeeID: vo.NewEmployeeID(7),
+			Calendar:   workCalendar(vo.WorkDaySchedule{Day: time.Tuesday, StartHour: 8, EndHour: 18}),
+		},
+	}
+
+	affected := []appt.Appointment{
+		s.booked(1, 4, consultation, s.monday(9, 0), confirmed),
+		s.booked(2, 4, consultation, s.monday(9, 0), confirmed),
+		s.booked(3, 4, consultation, s.monday(12, 15), confirmed),
+		s.booked(4, 4, enum.ClinicServiceSurgery, s.monday(17, 0), confirmed),
+		s.booked(5, 4, consultation, s.monday(11, 0), enum.AppointmentStatusPending),
+	}
+
+	coverage, err := appt.CoverAbsence(s.ctx, s.absence, affected, substitutes)
+	s.Require().NoError(err)
+
+	proposedTo := map[vo.AppointmentID]vo.EmployeeID{}
+	for _, proposed := range coverage.Proposed {
+		s.Equal(enum.AppointmentStatusPending, proposed.Status(), "waits for confirmation")
+		proposedTo[proposed.ID()] = *proposed.EmployeeID()
+	}
+	s.Equal(map[vo.AppointmentID]vo.EmployeeID{
+		vo.NewAppointmentID(1): vo.NewEmployeeID(5), // the substitute with the fewest appointments
+		vo.NewAppointmentID(2): vo.NewEmployeeID(6), // the other one is now busy at 9:00
+		vo.NewAppointmentID(3): vo.NewEmployeeID(6), // the other one is on a break
+	}, proposedTo)
+
+	s.Require().Len(coverage.Cancelled, 1, "the surgery ends after every shift")
+	s.Equal(vo.NewAppointmentID(4), coverage.Cancelled[0].ID())
+	s.Equal(enum.AppointmentStatusCancelled, coverage.Cancelled[0].Status())
+}
+
+func (s *AbsenceTestSuite) TestCoverAbsence_NoSubstitutes() {
+	affected := []appt.Appointment{
+		s.booked(1, 4, enum.ClinicServiceGeneralConsultation, s.monday(9, 0), enum.AppointmentStatusConfirmed),
+	}
+
+	coverage, err := appt.CoverAbsence(s.ctx, s.absence, affected, nil)
+
+	s.Require().NoError(err)
+	s.Empty(coverage.Proposed)
+	s.Len(coverage.Cancelled, 1)
+}
+
+func (s *AbsenceTestSuite) TestProposeReassignment() {
+	testCases := []struct {
+		name       string
+		status     enum.AppointmentStatus
+		employeeID vo.EmployeeID
+		valid      bool
+	}{
+		{"confirmed", enum.AppointmentStatusConfirmed, vo.NewEmployeeID(5), true},
+		{"pending", enum.AppointmentStatusPending, vo.NewEmployeeID(5), false},
+		{"completed", enum.AppointmentStatusCompleted, vo.NewEmployeeID(5), false},
+		{"no employee", enum.AppointmentStatusConfirmed, vo.EmployeeID{}, false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			appointment := s.booked(1, 4, enum.ClinicServiceGeneralConsultation, s.monday(9, 0), tc.status)
+
+			err := appointment.ProposeReassignment(s.ctx, tc.employeeID)
+			if !tc.valid {
+				s.Error(err)
+				s.Equal(vo.NewEmployeeID(4), *appointment.EmployeeID())
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Equal(tc.employeeID, *appointment.EmployeeID())
+			s.Equal(enum.AppointmentStatusPending, appointment.Status())
+		})
+	}
+}
+
+func (s *AbsenceTestSuite) TestTimeOff() {
+	s.absence.ReportedBy = vo.NewUserID(9)
+	now := s.monday(7, 0)
+
+	timeOff, err := s.absence.TimeOff(s.ctx, now)
+
+	s.Require().NoError(err)
+	s.True(timeOff.IsApproved(), "approved by whoever reports the absence")
+	s.Equal(enum.ScheduleExceptionSickLeave, timeOff.Type())
+	s.Equal(s.absence.EmployeeID, timeOff.EmployeeID())
+	s.Equal(s.absence.Start, timeOff.StartDate())
+	s.Equal(s.absence.End, timeOff.EndDate())
+	s.Equal(&s.absence.ReportedBy, timeOff.ReviewedBy())
+}
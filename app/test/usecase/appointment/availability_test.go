@@ -0,0 +1,182 @@
+package appointment_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/calendar"
+	"clinic-vet-api/app/modules/core/domain/entity/employee"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
+	"clinic-vet-api/app/shared/log"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+// fakeScheduleExceptionRepository returns the stored exceptions of the employee overlapping the
+// range, leaving the approval filter to the work calendar
+type fakeScheduleExceptionRepository struct {
+	repository.ScheduleExceptionRepository
+	exceptions []employee.ScheduleException
+}
+
+func (r *fakeScheduleExceptionRepository) FindApproved(ctx context.Context, employeeID vo.EmployeeID, start, end time.Time) ([]employee.ScheduleException, error) {
+	var found []employee.ScheduleException
+	for _, exception := range r.exceptions {
+		if exception.IsOwnedBy(employeeID) && exception.Overlaps(start, end) {
+			found = append(found, exception)
+		}
+	}
+	return found, nil
+}
+
+type AvailabilityTestSuite struct {
+	suite.Suite
+	ctx            context.Context
+	apptRepo       *fakeAppointmentRepository
+	exceptionRepo  *fakeScheduleExceptionRepository
+	availability   *service.AppointmentAvailabilityService
+	guard          *service.ScheduleGuard
+	clinicCalendar calendar.ClinicCalendar
+	vetID          vo.EmployeeID
+	vet            employee.Employee
+	day            time.Time
+}
+
+func TestAvailabilitySuite(t *testing.T) {
+	suite.Run(t, new(AvailabilityTestSuite))
+}
+
+func (s *AvailabilityTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.apptRepo = &fakeAppointmentRepository{}
+	s.exceptionRepo = &fakeScheduleExceptionRepository{}
+	s.availability = service.NewAppointmentAvailabilityService(s.apptRepo, s.exceptionRepo)
+	s.guard = service.NewScheduleGuard(s.apptRepo, s.exceptionRepo)
+
+	// The service works from the current time, so the clinic and the veterinarian work every day
+	// to keep the expectations independent of the weekday the test runs on
+	openingHours := make([]calendar.OpeningHours, 0, 7)
+	workDays := make([]vo.WorkDaySchedule, 0, 7)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		openingHours = append(openingHours, calendar.OpeningHours{Day: day, OpenHour: 8, CloseHour: 20})
+		workDays = append(workDays, vo.WorkDaySchedule{
+			Day:       day,
+			StartHour: 9,
+			EndHour:   17,
+			Breaks:    vo.Break{StartHour: 12, EndHour: 13},
+		})
+	}
+	s.clinicCalendar = calendar.NewClinicCalendar(openingHours, nil, calendar.BookingPolicy{MinLeadDays: 2, MaxLeadDays: 10})
+
+	s.vetID = vo.NewEmployeeID(1)
+	s.vet = *employee.NewEmployeeBuilder().
+		WithID(s.vetID).
+		WithSchedule(&vo.Schedule{WorkDays: workDays}).
+		Build()
+
+	s.day = startOfDay(time.Now().AddDate(0, 0, 4))
+}
+
+func startOfDay(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+}
+
+func at(day time.Time, hour, minute int) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, day.Location())
+}
+
+func (s *AvailabilityTestSuite) exception(
+	exceptionType enum.ScheduleExceptionType,
+	status enum.ScheduleExceptionStatus,
+	start, end time.Time,
+) employee.ScheduleException {
+	return *employee.NewScheduleExceptionBuilder().
+		WithEmployeeID(s.vetID).
+		WithType(exceptionType).
+		WithStatus(status).
+		WithPeriod(start, end).
+		Build()
+}
+
+func (s *AvailabilityTestSuite) slotsOn(day time.Time, clinicService enum.ClinicService) []service.TimeSlot {
+	availability, err := s.availability.FindAvailableSlots(s.ctx, s.vet, clinicService, day, day, s.clinicCalendar)
+	s.Require().NoError(err)
+	s.Require().Len(availability, 1)
+	return availability[0].Slots
+}
+
+func (s *AvailabilityTestSuite) TestFindAvailableSlots_SkipsBreak() {
+	slots := s.slotsOn(s.day, enum.ClinicServiceGeneralConsultation)
+
+	// 9 to 17 in slots of 30 minutes, without the two slots of the 12 to 13 break
+	s.Len(slots, 14)
+	s.Equal(at(s.day, 9, 0), slots[0].Start)
+	s.Equal(at(s.day, 17, 0), slots[len(slots)-1].End)
+	for _, slot := range slots {
+		s.False(slot.Start.Before(at(s.day, 13, 0)) && slot.End.After(at(s.day, 12, 0)),
+			"slot %s overlaps the break", slot.Start.Format(time.TimeOnly))
+	}
+}
+
+func (s *AvailabilityTestSuite) TestFindAvailableSlots_SkipsBookedAppointments() {
+	vetID := s.vetID
+	booked := appt.NewAppointmentBuilder().
+		WithID(vo.NewAppointmentID(1)).
+		WithPetID(vo.NewPetID(1)).
+		WithEmployeeID(&vetID).
+		WithService(enum.ClinicServiceSurgery).
+		WithScheduledDate(at(s.day, 9, 0)).
+		WithStatus(enum.AppointmentStatusConfirmed).
+		Build()
+	cancelled := appt.NewAppointmentBuilder().
+		WithID(vo.NewAppointmentID(2)).
+		WithPetID(vo.NewPetID(2)).
+		WithEmployeeID(&vetID).
+		WithService(enum.ClinicServiceGeneralConsultation).
+		WithScheduledDate(at(s.day, 14, 0)).
+		WithStatus(enum.AppointmentStatusCancelled).
+		Build()
+	s.apptRepo.appointments = []appt.Appointment{*booked, *cancelled}
+
+	slots := s.slotsOn(s.day, enum.ClinicServiceGeneralConsultation)
+
+	s.Len(slots, 10, "the two hours of surgery take four slots")
+	s.Equal(at(s.day, 11, 0), slots[0].Start)
+	s.Contains(slots, service.TimeSlot{Start: at(s.day, 14, 0), End: at(s.day, 14, 30)},
+		"a cancelled appointment frees its slot")
+}
+
+func (s *AvailabilityTestSuite) TestFindAvailableSlots_ClampsToBookingWindow() {
+	now := time.Now()
+
+	availability, err := s.availability.FindAvailableSlots(s.ctx, s.vet, enum.ClinicServiceGeneralConsultation,
+		now.AddDate(0, 0, -5), now.AddDate(0, 0, 30), s.clinicCalendar)
+
+	s.Require().NoError(err)
+	s.Require().NotEmpty(availability)
+	s.Equal(startOfDay(now.AddDate(0, 0, 2)), availability[0].Date)
+	s.Equal(startOfDay(now.AddDate(0, 0, 10)), availability[len(availability)-1].Date)
+	for _, day := range availability {
+		for _, slot := range day.Slots {
+			s.NoError(s.clinicCalendar.CheckBookingDate(slot.Start, slot.End.Sub(slot.Start), now))
+		}
+	}
+}
+
+func (s *AvailabilityTestSuite) TestFindAvailableSlots_OutsideBookingWindow() {
+	now := time.Now()
+
+	availability, err := s.availability.FindAvailableSlots(s.ctx, s.vet, enum.ClinicServiceGeneralConsultation,
+		now.AddDate(0, 0, 20), now.AddDate(0, 0, 25), s.clinicCalendar)
+
+	s.Require().NoError(err)
+	s.Empty(availability)
+}
@@ -0,0 +1,233 @@
+package appointment_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"clinic-vet-api/app/modules/appointment/application/command"
+	"clinic-vet-api/app/modules/appointment/application/handler"
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/entity/pet"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/log"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+// ownedPetRepository finds a pet only for the customer owning it
+type ownedPetRepository struct {
+	repository.PetRepository
+	pets []pet.Pet
+}
+
+func (r *ownedPetRepository) FindByIDAndCustomerID(ctx context.Context, id vo.PetID, customerID vo.CustomerID) (pet.Pet, error) {
+	for _, owned := range r.pets {
+		if owned.ID() == id && owned.CustomerID() == customerID {
+			return owned, nil
+		}
+	}
+	return pet.Pet{}, errors.New("pet not found")
+}
+
+// emergencyVisitRepository records the emergencies registered with their session
+type emergencyVisitRepository struct {
+	repository.AppointmentVisitRepository
+	created []appt.Appointment
+}
+
+func (r *emergencyVisitRepository) CreateWithSession(ctx context.Context, appointment *appt.Appointment, session *medical.MedicalSession) error {
+	appointment.SetID(vo.NewAppointmentID(uint(len(r.created) + 1)))
+	r.created = append(r.created, *appointment)
+	return nil
+}
+
+type EmergencyTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func TestEmergencySuite(t *testing.T) {
+	suite.Run(t, new(EmergencyTestSuite))
+}
+
+func (s *EmergencyTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+	s.ctx = context.Background()
+}
+
+func (s *EmergencyTestSuite) intake() appt.EmergencyIntake {
+	return appt.EmergencyIntake{
+		CustomerID:     vo.NewCustomerID(2),
+		PetID:          vo.NewPetID(1),
+		EmployeeID:     vo.NewEmployeeID(4),
+		Service:        enum.ClinicServiceEmergencyCare,
+		TriagePriority: enum.TriagePriorityUrgent,
+	}
+}
+
+func (s *EmergencyTestSuite) TestNewEmergencyAppointment() {
+	longNotes := strings.Repeat("x", 1001)
+
+	testCases := []struct {
+		name   string
+		change func(*appt.EmergencyIntake)
+		valid  bool
+	}{
+		{"emergency", func(*appt.EmergencyIntake) {}, true},
+		{"walk-in", func(intake *appt.EmergencyIntake) {
+			intake.Service = enum.ClinicServiceGeneralConsultation
+			intake.TriagePriority = enum.TriagePriorityNonUrgent
+		}, true},
+		{"unknown service", func(intake *appt.EmergencyIntake) { intake.Service = "massage" }, false},
+		{"unknown triage priority", func(intake *appt.EmergencyIntake) { intake.TriagePriority = "whenever" }, false},
+		{"no veterinarian", func(intake *appt.EmergencyIntake) { intake.EmployeeID = vo.EmployeeID{} }, false},
+		{"notes too long", func(intake *appt.EmergencyIntake) { intake.Notes = &longNotes }, false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			intake := s.intake()
+			tc.change(&intake)
+
+			before := time.Now()
+			emergency, err := appt.NewEmergencyAppointment(s.ctx, intake)
+			if !tc.valid {
+				s.Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.True(emergency.IsEmergency())
+			s.Equal(enum.AppointmentStatusConfirmed, emergency.Status())
+			s.Require().NotNil(emergency.TriagePriority())
+			s.Equal(intake.TriagePriority, *emergency.TriagePriority())
+			s.Require().NotNil(emergency.EmployeeID())
+			s.Equal(intake.EmployeeID, *emergency.EmployeeID())
+			s.False(emergency.ScheduledDate().Before(before), "the patient is seen right away")
+		})
+	}
+}
+
+func (s *EmergencyTestSuite) TestTriagePriority() {
+	priorities := []enum.TriagePriority{
+		enum.TriagePriorityNonUrgent, "unknown", enum.TriagePriorityCritical,
+		enum.TriagePrioritySemiUrgent, enum.TriagePriorityUrgent,
+	}
+	sort.Slice(priorities, func(i, j int) bool { return priorities[i].Rank() < priorities[j].Rank() })
+
+	s.Equal([]enum.TriagePriority{
+		enum.TriagePriorityCritical, enum.TriagePriorityUrgent, enum.TriagePrioritySemiUrgent,
+		enum.TriagePriorityNonUrgent, "unknown",
+	}, priorities)
+
+	for input, expected := range map[string]enum.TriagePriority{
+		"immediate":   enum.TriagePriorityCritical,
+		"semi-urgent": enum.TriagePrioritySemiUrgent,
+		"Non_Urgent":  enum.TriagePriorityNonUrgent,
+	} {
+		parsed, err := enum.ParseTriagePriority(input)
+		s.Require().NoError(err, input)
+		s.Equal(expected, parsed, input)
+	}
+
+	_, err := enum.ParseTriagePriority("later")
+	s.Error(err)
+}
+
+func (s *EmergencyTestSuite) TestEmergencyNeverBecomesNoShow() {
+	emergency, err := appt.NewEmergencyAppointment(s.ctx, s.intake())
+	s.Require().NoError(err)
+
+	policy := appt.NoShowPolicy{GracePeriod: 30 * time.Minute}
+	s.False(policy.IsOverdue(*emergency, time.Now().Add(24*time.Hour)))
+}
+
+func (s *EmergencyTestSuite) TestOpenAppointmentSession() {
+	testCases := []struct {
+		name      string
+		service   enum.ClinicService
+		emergency bool
+		visitType enum.VisitType
+	}{
+		{"emergency walk-in", enum.ClinicServiceGeneralConsultation, true, enum.VisitTypeEmergencyVisit},
+		{"emergency care booking", enum.ClinicServiceEmergencyCare, false, enum.VisitTypeEmergencyVisit},
+		{"surgery", enum.ClinicServiceSurgery, false, enum.VisitTypeSurgery},
+		{"vaccination", enum.ClinicServiceVaccination, false, enum.VisitTypeVaccination},
+		{"other services are consultations", enum.ClinicServiceNutritionConsult, false, enum.VisitTypeConsultation},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			vetID := vo.NewEmployeeID(4)
+			priority := enum.TriagePriorityUrgent
+			visit := appt.NewAppointmentBuilder().
+				WithID(vo.NewAppointmentID(9)).
+				WithCustomerID(vo.NewCustomerID(2)).
+				WithPetID(vo.NewPetID(1)).
+				WithEmployeeID(&vetID).
+				WithService(tc.service).
+				WithScheduledDate(time.Now()).
+				WithEmergency(tc.emergency, &priority).
+				Build()
+
+			session, err := medical.OpenAppointmentSession(s.ctx, *visit)
+
+			s.Require().NoError(err)
+			s.Equal(tc.visitType, session.VisitType())
+			s.Equal(tc.emergency, session.IsEmergency())
+			s.Equal(vetID, session.EmployeeID())
+			s.Require().NotNil(session.AppointmentID())
+			s.Equal(vo.NewAppointmentID(9), *session.AppointmentID())
+		})
+	}
+
+	unassigned := appt.NewAppointmentBuilder().WithService(enum.ClinicServiceSurgery).Build()
+	_, err := medical.OpenAppointmentSession(s.ctx, *unassigned)
+	s.Error(err, "a session needs a veterinarian")
+}
+
+func (s *EmergencyTestSuite) TestHandleCreateEmergency_PetOfTheCustomer() {
+	testCases := []struct {
+		name       string
+		customerID uint
+		success    bool
+	}{
+		{"pet of the customer", 2, true},
+		{"pet of another customer", 5, false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			pets := &ownedPetRepository{pets: []pet.Pet{
+				*pet.NewPetBuilder().WithID(vo.NewPetID(1)).WithCustomerID(vo.NewCustomerID(2)).Build(),
+			}}
+			visits := &emergencyVisitRepository{}
+			commandHandler := handler.NewAppointmentCommandHandler(
+				&fakeAppointmentRepository{}, nil, nil, nil, nil, visits, nil,
+				&fakeScheduleExceptionRepository{}, nil, pets, nil, nil, nil, appt.NoShowPolicy{},
+			)
+
+			vetID := uint(4)
+			cmd, err := command.NewCreateEmergencyApptCommand(tc.customerID, 1, &vetID, 0, "", "urgent", nil)
+			s.Require().NoError(err)
+
+			result := commandHandler.HandleCreateEmergency(s.ctx, cmd)
+
+			s.Equal(tc.success, result.IsSuccess())
+			if tc.success {
+				s.Len(visits.created, 1)
+				return
+			}
+			s.Equal(handler.PetNotFound, result.Message())
+			s.Empty(visits.created, "nothing is registered for a pet the customer does not own")
+		})
+	}
+}
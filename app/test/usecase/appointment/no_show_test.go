@@ -0,0 +1,137 @@
+package appointment_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clinic-vet-api/app/modules/appointment/application/worker"
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/specification"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/shared/log"
+	"clinic-vet-api/app/shared/page"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+// noShowAppointmentRepository returns the same appointments for every search and records saves
+type noShowAppointmentRepository struct {
+	repository.AppointmentRepository
+	appointments []appt.Appointment
+	saved        []appt.Appointment
+}
+
+func (r *noShowAppointmentRepository) Find(ctx context.Context, spec specification.ApptSearchSpecification) (page.Page[appt.Appointment], error) {
+	return page.Page[appt.Appointment]{Items: r.appointments}, nil
+}
+
+func (r *noShowAppointmentRepository) Save(ctx context.Context, appointment *appt.Appointment) error {
+	r.saved = append(r.saved, *appointment)
+	return nil
+}
+
+type NoShowTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	now    time.Time
+	policy appt.NoShowPolicy
+}
+
+func TestNoShowSuite(t *testing.T) {
+	suite.Run(t, new(NoShowTestSuite))
+}
+
+func (s *NoShowTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.now = time.Date(2030, time.March, 4, 12, 0, 0, 0, time.UTC)
+	s.policy = appt.NoShowPolicy{GracePeriod: 30 * time.Minute, MaxNoShows: 2, LookbackDays: 90}
+}
+
+func (s *NoShowTestSuite) appointment(id uint, scheduledDate time.Time, status enum.AppointmentStatus) appt.Appointment {
+	return *appt.NewAppointmentBuilder().
+		WithID(vo.NewAppointmentID(id)).
+		WithCustomerID(vo.NewCustomerID(2)).
+		WithPetID(vo.NewPetID(1)).
+		WithService(enum.ClinicServiceGeneralConsultation).
+		WithScheduledDate(scheduledDate).
+		WithStatus(status).
+		Build()
+}
+
+func (s *NoShowTestSuite) TestIsOverdue() {
+	testCases := []struct {
+		name          string
+		scheduledDate time.Time
+		status        enum.AppointmentStatus
+		overdue       bool
+	}{
+		{"confirmed past the grace period", s.now.Add(-31 * time.Minute), enum.AppointmentStatusConfirmed, true},
+		{"confirmed a day ago", s.now.AddDate(0, 0, -1), enum.AppointmentStatusConfirmed, true},
+		{"confirmed exactly at the end of the grace period", s.now.Add(-30 * time.Minute), enum.AppointmentStatusConfirmed, false},
+		{"confirmed within the grace period", s.now.Add(-10 * time.Minute), enum.AppointmentStatusConfirmed, false},
+		{"confirmed in the future", s.now.Add(time.Hour), enum.AppointmentStatusConfirmed, false},
+		{"completed", s.now.AddDate(0, 0, -1), enum.AppointmentStatusCompleted, false},
+		{"cancelled", s.now.AddDate(0, 0, -1), enum.AppointmentStatusCancelled, false},
+		{"already not presented", s.now.AddDate(0, 0, -1), enum.AppointmentStatusNotPresented, false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Equal(tc.overdue, s.policy.IsOverdue(s.appointment(1, tc.scheduledDate, tc.status), s.now))
+		})
+	}
+}
+
+func (s *NoShowTestSuite) TestWindows() {
+	s.Equal(s.now.Add(-30*time.Minute), s.policy.OverdueBefore(s.now))
+	s.Equal(time.Date(2029, time.December, 4, 12, 0, 0, 0, time.UTC), s.policy.LookbackStart(s.now))
+}
+
+func (s *NoShowTestSuite) TestEnsureCanSelfBook() {
+	testCases := []struct {
+		name       string
+		maxNoShows int
+		noShows    int64
+		allowed    bool
+	}{
+		{"no no-shows", 2, 0, true},
+		{"at the limit", 2, 2, true},
+		{"over the limit", 2, 3, false},
+		{"limit disabled", 0, 50, true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			policy := s.policy
+			policy.MaxNoShows = tc.maxNoShows
+
+			err := policy.EnsureCanSelfBook(s.ctx, tc.noShows)
+			if tc.allowed {
+				s.NoError(err)
+			} else {
+				s.Error(err)
+			}
+		})
+	}
+}
+
+func (s *NoShowTestSuite) TestNoShowMarker_MarksOnlyOverdueAppointments() {
+	repo := &noShowAppointmentRepository{appointments: []appt.Appointment{
+		s.appointment(1, time.Now().Add(-2*time.Hour), enum.AppointmentStatusConfirmed),
+		s.appointment(2, time.Now().Add(-5*time.Minute), enum.AppointmentStatusConfirmed),
+		s.appointment(3, time.Now().Add(-2*time.Hour), enum.AppointmentStatusCompleted),
+	}}
+
+	err := worker.NewNoShowMarker(repo, s.policy).Run(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Len(repo.saved, 1)
+	s.Equal(vo.NewAppointmentID(1), repo.saved[0].ID())
+	s.Equal(enum.AppointmentStatusNotPresented, repo.saved[0].Status())
+}
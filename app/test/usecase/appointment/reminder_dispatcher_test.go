@@ -0,0 +1,211 @@
+package appointment_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"clinic-vet-api/app/modules/appointment/application/worker"
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/customer"
+	"clinic-vet-api/app/modules/core/domain/entity/notification"
+	"clinic-vet-api/app/modules/core/domain/entity/user"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/specification"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
+	"clinic-vet-api/app/shared/log"
+	"clinic-vet-api/app/shared/page"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+// dueAppointmentRepository returns the same appointments for every search
+type dueAppointmentRepository struct {
+	repository.AppointmentRepository
+	appointments []appt.Appointment
+}
+
+func (r *dueAppointmentRepository) Find(ctx context.Context, spec specification.ApptSearchSpecification) (page.Page[appt.Appointment], error) {
+	return page.Page[appt.Appointment]{Items: r.appointments}, nil
+}
+
+// fakeReminderRepository grants claims unless told otherwise and records what happened to each one
+type fakeReminderRepository struct {
+	refuseClaims bool
+	claimed      []vo.AppointmentID
+	sent         map[vo.AppointmentID]enum.NotificationChannel
+	released     []vo.AppointmentID
+}
+
+func (r *fakeReminderRepository) Claim(ctx context.Context, id vo.AppointmentID, scheduledDate time.Time, staleAfter time.Duration) (bool, error) {
+	if r.refuseClaims {
+		return false, nil
+	}
+	r.claimed = append(r.claimed, id)
+	return true, nil
+}
+
+func (r *fakeReminderRepository) MarkSent(ctx context.Context, id vo.AppointmentID, scheduledDate time.Time, channel enum.NotificationChannel) error {
+	r.sent[id] = channel
+	return nil
+}
+
+func (r *fakeReminderRepository) Release(ctx context.Context, id vo.AppointmentID, scheduledDate time.Time) error {
+	r.released = append(r.released, id)
+	return nil
+}
+
+type reminderCustomerRepository struct {
+	repository.CustomerRepository
+	customer customer.Customer
+}
+
+func (r *reminderCustomerRepository) FindByID(ctx context.Context, id vo.CustomerID) (customer.Customer, error) {
+	return r.customer, nil
+}
+
+type reminderUserRepository struct {
+	repository.UserRepository
+	user user.User
+}
+
+func (r *reminderUserRepository) FindByID(ctx context.Context, id vo.UserID) (user.User, error) {
+	return r.user, nil
+}
+
+type fakeNotificationService struct {
+	err  error
+	sent []*notification.Notification
+}
+
+func (s *fakeNotificationService) Send(ctx context.Context, notif *notification.Notification) error {
+	s.sent = append(s.sent, notif)
+	return s.err
+}
+
+type ReminderDispatcherTestSuite struct {
+	suite.Suite
+	ctx           context.Context
+	appointments  *dueAppointmentRepository
+	reminders     *fakeReminderRepository
+	customers     *reminderCustomerRepository
+	users         *reminderUserRepository
+	notifications *fakeNotificationService
+	dispatcher    *worker.ReminderDispatcher
+}
+
+func TestReminderDispatcherSuite(t *testing.T) {
+	suite.Run(t, new(ReminderDispatcherTestSuite))
+}
+
+func (s *ReminderDispatcherTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.appointments = &dueAppointmentRepository{}
+	s.reminders = &fakeReminderRepository{sent: map[vo.AppointmentID]enum.NotificationChannel{}}
+	s.customers = &reminderCustomerRepository{}
+	s.users = &reminderUserRepository{}
+	s.notifications = &fakeNotificationService{}
+	s.contact(enum.NotificationChannelEmail, true)
+
+	s.dispatcher = worker.NewReminderDispatcher(
+		s.appointments, s.reminders, service.NewCustomerContactService(s.customers, s.users), s.notifications, time.Minute,
+	)
+}
+
+// contact sets the preferred channel of the customer and whether the user has a phone number
+func (s *ReminderDispatcherTestSuite) contact(channel enum.NotificationChannel, hasPhone bool) {
+	userID := vo.NewUserID(3)
+	s.customers.customer = *customer.NewCustomerBuilder().
+		WithID(vo.NewCustomerID(2)).
+		WithName(vo.NewPersonName("Ana", "Lopez")).
+		WithUserID(&userID).
+		WithPreferredChannel(channel).
+		Build()
+
+	var phone *vo.PhoneNumber
+	if hasPhone {
+		number := vo.NewPhoneNumberNoErr("+525512345678")
+		phone = &number
+	}
+	s.users.user = *user.NewUserBuilder().
+		WithID(userID).
+		WithEmail(vo.NewEmailNoErr("ana@example.com")).
+		WithPhoneNumber(phone).
+		Build()
+}
+
+func (s *ReminderDispatcherTestSuite) due(id uint, startsIn time.Duration) appt.Appointment {
+	return *appt.NewAppointmentBuilder().
+		WithID(vo.NewAppointmentID(id)).
+		WithCustomerID(vo.NewCustomerID(2)).
+		WithPetID(vo.NewPetID(1)).
+		WithService(enum.ClinicServiceGeneralConsultation).
+		WithScheduledDate(time.Now().Add(startsIn)).
+		WithStatus(enum.AppointmentStatusConfirmed).
+		Build()
+}
+
+func (s *ReminderDispatcherTestSuite) TestRun_SendsOverPreferredChannel() {
+	testCases := []struct {
+		name     string
+		channel  enum.NotificationChannel
+		hasPhone bool
+		expected enum.NotificationChannel
+	}{
+		{"email", enum.NotificationChannelEmail, true, enum.NotificationChannelEmail},
+		{"sms", enum.NotificationChannelSMS, true, enum.NotificationChannelSMS},
+		{"sms without a phone falls back to email", enum.NotificationChannelSMS, false, enum.NotificationChannelEmail},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.contact(tc.channel, tc.hasPhone)
+			s.appointments.appointments = []appt.Appointment{s.due(7, 2*time.Hour)}
+
+			s.Require().NoError(s.dispatcher.Run(s.ctx))
+
+			s.Require().Len(s.notifications.sent, 1)
+			s.Equal(tc.expected, s.notifications.sent[0].Channel())
+			s.Equal(enum.NotificationTypeAppointmentRemind, s.notifications.sent[0].NType())
+			s.Equal(tc.expected, s.reminders.sent[vo.NewAppointmentID(7)])
+		})
+	}
+}
+
+func (s *ReminderDispatcherTestSuite) TestRun_SkipsAppointmentsOutsideTheWindow() {
+	s.appointments.appointments = []appt.Appointment{s.due(7, 2*worker.ReminderWindow), s.due(8, -time.Hour)}
+
+	s.Require().NoError(s.dispatcher.Run(s.ctx))
+
+	s.Empty(s.reminders.claimed)
+	s.Empty(s.notifications.sent)
+}
+
+func (s *ReminderDispatcherTestSuite) TestRun_SkipsRemindersClaimedElsewhere() {
+	s.reminders.refuseClaims = true
+	s.appointments.appointments = []appt.Appointment{s.due(7, 2*time.Hour)}
+
+	s.Require().NoError(s.dispatcher.Run(s.ctx))
+
+	s.Empty(s.notifications.sent, "another replica sends this reminder")
+	s.Empty(s.reminders.sent)
+}
+
+func (s *ReminderDispatcherTestSuite) TestRun_ReleasesClaimWhenSendingFails() {
+	s.notifications.err = errors.New("smtp unavailable")
+	s.appointments.appointments = []appt.Appointment{s.due(7, 2*time.Hour), s.due(8, 3*time.Hour)}
+
+	err := s.dispatcher.Run(s.ctx)
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "2 appointment reminders")
+	s.Equal([]vo.AppointmentID{vo.NewAppointmentID(7), vo.NewAppointmentID(8)}, s.reminders.released)
+	s.Empty(s.reminders.sent, "released reminders are retried on the next run")
+}
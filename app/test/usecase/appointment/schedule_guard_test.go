@@ -0,0 +1,176 @@
+package appointment_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/modules/core/domain/specification"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
+	"clinic-vet-api/app/shared/log"
+	p "clinic-vet-api/app/shared/page"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+// fakeAppointmentRepository keeps the appointments in memory and filters them like the search
+// query does, by pet, employee and scheduled date
+type fakeAppointmentRepository struct {
+	repository.AppointmentRepository
+	appointments []appt.Appointment
+}
+
+func (r *fakeAppointmentRepository) Find(ctx context.Context, spec specification.ApptSearchSpecification) (p.Page[appt.Appointment], error) {
+	params := spec.ToSQLCParams()
+
+	var items []appt.Appointment
+	for _, appointment := range r.appointments {
+		if params.PetID != nil && int32(appointment.PetID().Value()) != *params.PetID {
+			continue
+		}
+		if params.EmployeeID != nil {
+			if appointment.EmployeeID() == nil || int32(appointment.EmployeeID().Value()) != *params.EmployeeID {
+				continue
+			}
+		}
+		if params.StartDate != nil && appointment.ScheduledDate().Before(*params.StartDate) {
+			continue
+		}
+		if params.EndDate != nil && appointment.ScheduledDate().After(*params.EndDate) {
+			continue
+		}
+		items = append(items, appointment)
+	}
+	return p.Page[appt.Appointment]{Items: items}, nil
+}
+
+type ScheduleConflictTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	start      time.Time
+	apptRepo   *fakeAppointmentRepository
+	guard      *service.ScheduleGuard
+	vetID      vo.EmployeeID
+	otherVet   vo.EmployeeID
+	petID      vo.PetID
+	otherPet   vo.PetID
+	nextApptID uint
+}
+
+func TestScheduleConflictSuite(t *testing.T) {
+	suite.Run(t, new(ScheduleConflictTestSuite))
+}
+
+func (s *ScheduleConflictTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.start = time.Date(2030, time.March, 4, 10, 0, 0, 0, time.UTC)
+	s.apptRepo = &fakeAppointmentRepository{}
+	s.guard = service.NewScheduleGuard(s.apptRepo, nil)
+	s.vetID = vo.NewEmployeeID(1)
+	s.otherVet = vo.NewEmployeeID(2)
+	s.petID = vo.NewPetID(1)
+	s.otherPet = vo.NewPetID(2)
+	s.nextApptID = 0
+}
+
+func (s *ScheduleConflictTestSuite) appointment(
+	petID vo.PetID,
+	employeeID *vo.EmployeeID,
+	service enum.ClinicService,
+	scheduledDate time.Time,
+	status enum.AppointmentStatus,
+) appt.Appointment {
+	s.nextApptID++
+	return *appt.NewAppointmentBuilder().
+		WithID(vo.NewAppointmentID(s.nextApptID)).
+		WithPetID(petID).
+		WithEmployeeID(employeeID).
+		WithService(service).
+		WithScheduledDate(scheduledDate).
+		WithStatus(status).
+		Build()
+}
+
+func (s *ScheduleConflictTestSuite) TestOverlapsWith_UsesServiceDuration() {
+	// Surgery lasts two hours, a consultation starting one hour later still overlaps it
+	surgery := s.appointment(s.petID, &s.vetID, enum.ClinicServiceSurgery, s.start, enum.AppointmentStatusConfirmed)
+	during := s.appointment(s.otherPet, &s.vetID, enum.ClinicServiceGeneralConsultation, s.start.Add(time.Hour), enum.AppointmentStatusPending)
+	after := s.appointment(s.otherPet, &s.vetID, enum.ClinicServiceGeneralConsultation, surgery.EndDate(), enum.AppointmentStatusPending)
+
+	s.True(during.OverlapsWith(surgery))
+	s.True(surgery.OverlapsWith(during))
+	s.False(after.OverlapsWith(surgery), "an appointment starting when the other ends does not overlap it")
+}
+
+func (s *ScheduleConflictTestSuite) TestEnsureNoScheduleConflict_SameEmployee() {
+	booked := s.appointment(s.otherPet, &s.vetID, enum.ClinicServiceSurgery, s.start, enum.AppointmentStatusConfirmed)
+	candidate := s.appointment(s.petID, &s.vetID, enum.ClinicServiceVaccination, s.start.Add(90*time.Minute), enum.AppointmentStatusPending)
+
+	err := candidate.EnsureNoScheduleConflict(s.ctx, []appt.Appointment{booked})
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "the employee already has appointment")
+}
+
+func (s *ScheduleConflictTestSuite) TestEnsureNoScheduleConflict_SamePet() {
+	booked := s.appointment(s.petID, &s.otherVet, enum.ClinicServiceGrooming, s.start, enum.AppointmentStatusConfirmed)
+	candidate := s.appointment(s.petID, &s.vetID, enum.ClinicServiceGeneralConsultation, s.start.Add(30*time.Minute), enum.AppointmentStatusPending)
+
+	err := candidate.EnsureNoScheduleConflict(s.ctx, []appt.Appointment{booked})
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "the pet already has appointment")
+}
+
+func (s *ScheduleConflictTestSuite) TestEnsureNoScheduleConflict_IgnoresFinalStatusesAndItself() {
+	cancelled := s.appointment(s.petID, &s.vetID, enum.ClinicServiceSurgery, s.start, enum.AppointmentStatusCancelled)
+	completed := s.appointment(s.petID, &s.vetID, enum.ClinicServiceSurgery, s.start, enum.AppointmentStatusCompleted)
+	candidate := s.appointment(s.petID, &s.vetID, enum.ClinicServiceGeneralConsultation, s.start, enum.AppointmentStatusConfirmed)
+
+	err := candidate.EnsureNoScheduleConflict(s.ctx, []appt.Appointment{cancelled, completed, candidate})
+
+	s.NoError(err)
+}
+
+func (s *ScheduleConflictTestSuite) TestEnsureNoScheduleConflict_DifferentEmployeeAndPet() {
+	booked := s.appointment(s.otherPet, &s.otherVet, enum.ClinicServiceSurgery, s.start, enum.AppointmentStatusConfirmed)
+	candidate := s.appointment(s.petID, &s.vetID, enum.ClinicServiceGeneralConsultation, s.start, enum.AppointmentStatusPending)
+
+	s.NoError(candidate.EnsureNoScheduleConflict(s.ctx, []appt.Appointment{booked}))
+}
+
+func (s *ScheduleConflictTestSuite) TestGuardEnsureNoConflict_FindsLongAppointmentStartedBefore() {
+	// The surgery starts before the candidate, the guard must widen the search window to find it
+	surgery := s.appointment(s.otherPet, &s.vetID, enum.ClinicServiceSurgery, s.start, enum.AppointmentStatusConfirmed)
+	s.apptRepo.appointments = []appt.Appointment{surgery}
+
+	candidate := s.appointment(s.petID, &s.vetID, enum.ClinicServiceGeneralConsultation, s.start.Add(100*time.Minute), enum.AppointmentStatusPending)
+
+	s.Error(s.guard.EnsureNoConflict(s.ctx, candidate))
+}
+
+func (s *ScheduleConflictTestSuite) TestGuardEnsureNoConflict_IgnoredAppointments() {
+	occurrence := s.appointment(s.petID, &s.vetID, enum.ClinicServiceGeneralConsultation, s.start, enum.AppointmentStatusConfirmed)
+	s.apptRepo.appointments = []appt.Appointment{occurrence}
+
+	candidate := s.appointment(s.petID, &s.vetID, enum.ClinicServiceGeneralConsultation, s.start.Add(15*time.Minute), enum.AppointmentStatusPending)
+
+	s.Error(s.guard.EnsureNoConflict(s.ctx, candidate))
+	s.NoError(s.guard.EnsureNoConflict(s.ctx, candidate, occurrence.ID()))
+}
+
+func (s *ScheduleConflictTestSuite) TestGuardEnsureNoConflict_FreeSlot() {
+	morning := s.appointment(s.petID, &s.vetID, enum.ClinicServiceGeneralConsultation, s.start, enum.AppointmentStatusConfirmed)
+	s.apptRepo.appointments = []appt.Appointment{morning}
+
+	candidate := s.appointment(s.petID, &s.vetID, enum.ClinicServiceGeneralConsultation, morning.EndDate(), enum.AppointmentStatusPending)
+
+	s.NoError(s.guard.EnsureNoConflict(s.ctx, candidate))
+}
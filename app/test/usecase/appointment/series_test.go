@@ -0,0 +1,275 @@
+package appointment_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/calendar"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/log"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type SeriesTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func TestSeriesSuite(t *testing.T) {
+	suite.Run(t, new(SeriesTestSuite))
+}
+
+func (s *SeriesTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+	s.ctx = context.Background()
+}
+
+func count(n int) *int { return &n }
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 10, 0, 0, 0, time.UTC)
+}
+
+func (s *SeriesTestSuite) TestDates() {
+	until := time.Date(2030, time.January, 21, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		recurrence appt.Recurrence
+		start      time.Time
+		expected   []time.Time
+	}{
+		{
+			name:       "every two weeks",
+			recurrence: appt.Recurrence{Frequency: enum.RecurrenceFrequencyWeekly, Interval: 2, Count: count(3)},
+			start:      date(2030, time.January, 7),
+			expected:   []time.Time{date(2030, time.January, 7), date(2030, time.January, 21), date(2030, time.February, 4)},
+		},
+		{
+			name:       "until includes the whole last day",
+			recurrence: appt.Recurrence{Frequency: enum.RecurrenceFrequencyWeekly, Interval: 1, Until: &until},
+			start:      date(2030, time.January, 7),
+			expected:   []time.Time{date(2030, time.January, 7), date(2030, time.January, 14), date(2030, time.January, 21)},
+		},
+		{
+			name:       "monthly falls back to the last day and keeps the original day",
+			recurrence: appt.Recurrence{Frequency: enum.RecurrenceFrequencyMonthly, Interval: 1, Count: count(4)},
+			start:      date(2030, time.January, 31),
+			expected: []time.Time{
+				date(2030, time.January, 31), date(2030, time.February, 28),
+				date(2030, time.March, 31), date(2030, time.April, 30),
+			},
+		},
+		{
+			name:       "monthly in a leap year",
+			recurrence: appt.Recurrence{Frequency: enum.RecurrenceFrequencyMonthly, Interval: 1, Count: count(2)},
+			start:      date(2032, time.January, 30),
+			expected:   []time.Time{date(2032, time.January, 30), date(2032, time.February, 29)},
+		},
+		{
+			name:       "quarterly across the year end",
+			recurrence: appt.Recurrence{Frequency: enum.RecurrenceFrequencyMonthly, Interval: 3, Count: count(2)},
+			start:      date(2030, time.November, 30),
+			expected:   []time.Time{date(2030, time.November, 30), date(2031, time.February, 28)},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Equal(tc.expected, tc.recurrence.Dates(tc.start))
+		})
+	}
+}
+
+func (s *SeriesTestSuite) TestDates_CappedAtMaxOccurrences() {
+	until := date(2035, time.January, 1)
+	recurrence := appt.Recurrence{Frequency: enum.RecurrenceFrequencyWeekly, Interval: 1, Until: &until}
+
+	s.Len(recurrence.Dates(date(2030, time.January, 7)), appt.MaxSeriesOccurrences)
+}
+
+func (s *SeriesTestSuite) TestValidate() {
+	start := date(2030, time.January, 7)
+	before := start.AddDate(0, 0, -1)
+	inAYear := start.AddDate(1, 0, 0)
+	inTwoYears := start.AddDate(2, 0, 0)
+
+	testCases := []struct {
+		name       string
+		recurrence appt.Recurrence
+		valid      bool
+	}{
+		{"weekly count", appt.Recurrence{Frequency: enum.RecurrenceFrequencyWeekly, Interval: 1, Count: count(10)}, true},
+		{"monthly until", appt.Recurrence{Frequency: enum.RecurrenceFrequencyMonthly, Interval: 1, Until: &inTwoYears}, true},
+		{"53 weekly occurrences", appt.Recurrence{Frequency: enum.RecurrenceFrequencyWeekly, Interval: 1, Until: &inAYear}, false},
+		{"invalid frequency", appt.Recurrence{Frequency: "daily", Interval: 1, Count: count(3)}, false},
+		{"zero interval", appt.Recurrence{Frequency: enum.RecurrenceFrequencyWeekly, Interval: 0, Count: count(3)}, false},
+		{"count and until", appt.Recurrence{Frequency: enum.RecurrenceFrequencyWeekly, Interval: 1, Count: count(3), Until: &inAYear}, false},
+		{"neither count nor until", appt.Recurrence{Frequency: enum.RecurrenceFrequencyWeekly, Interval: 1}, false},
+		{"zero count", appt.Recurrence{Frequency: enum.RecurrenceFrequencyWeekly, Interval: 1, Count: count(0)}, false},
+		{"count over the cap", appt.Recurrence{Frequency: enum.RecurrenceFrequencyWeekly, Interval: 1, Count: count(53)}, false},
+		{"until before the start", appt.Recurrence{Frequency: enum.RecurrenceFrequencyWeekly, Interval: 1, Until: &before}, false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			err := tc.recurrence.Validate(s.ctx, start)
+			if tc.valid {
+				s.NoError(err)
+			} else {
+				s.Error(err)
+			}
+		})
+	}
+}
+
+// openCalendar opens every day from 8 to 20 and lets bookings be made up to a year ahead
+func openCalendar(closures ...calendar.Closure) calendar.ClinicCalendar {
+	hours := make([]calendar.OpeningHours, 0, 7)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		hours = append(hours, calendar.OpeningHours{Day: day, OpenHour: 8, CloseHour: 20})
+	}
+	return calendar.NewClinicCalendar(hours, closures, calendar.BookingPolicy{MinLeadDays: 0, MaxLeadDays: 365})
+}
+
+func (s *SeriesTestSuite) series(start time.Time, employeeID *vo.EmployeeID) *appt.AppointmentSeries {
+	return appt.NewAppointmentSeriesBuilder().
+		WithCustomerID(vo.NewCustomerID(2)).
+		WithPetID(vo.NewPetID(1)).
+		WithEmployeeID(employeeID).
+		WithService(enum.ClinicServiceGeneralConsultation).
+		WithStartDate(start).
+		WithRecurrence(appt.Recurrence{Frequency: enum.RecurrenceFrequencyWeekly, Interval: 1, Count: count(3)}).
+		Build()
+}
+
+func nextDays(days int) time.Time {
+	now := time.Now()
+	return time.Date(now.Year(), now.Month(), now.Day()+days, 10, 0, 0, 0, now.Location())
+}
+
+func (s *SeriesTestSuite) TestGenerateOccurrences() {
+	vetID := vo.NewEmployeeID(4)
+
+	testCases := []struct {
+		name       string
+		employeeID *vo.EmployeeID
+		status     enum.AppointmentStatus
+	}{
+		{"without a vet the occurrences are pending", nil, enum.AppointmentStatusPending},
+		{"with a vet the occurrences are confirmed", &vetID, enum.AppointmentStatusConfirmed},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			start := nextDays(2)
+
+			occurrences, err := s.series(start, tc.employeeID).GenerateOccurrences(s.ctx, openCalendar())
+
+			s.Require().NoError(err)
+			s.Require().Len(occurrences, 3)
+			for i, occurrence := range occurrences {
+				s.Equal(start.AddDate(0, 0, 7*i), occurrence.ScheduledDate())
+				s.Equal(tc.status, occurrence.Status())
+				s.Equal(enum.ClinicServiceGeneralConsultation, occurrence.Service())
+				s.Equal(vo.NewPetID(1), occurrence.PetID())
+			}
+		})
+	}
+}
+
+func (s *SeriesTestSuite) TestGenerateOccurrences_RejectsWholeSeries() {
+	start := nextDays(2)
+	closure := calendar.NewClosureBuilder().
+		WithDate(start.AddDate(0, 0, 14)).
+		WithClosureType(enum.ClosureTypePublicHoliday).
+		WithReason("Holiday").
+		Build()
+
+	occurrences, err := s.series(start, nil).GenerateOccurrences(s.ctx, openCalendar(*closure))
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "occurrence 3")
+	s.Empty(occurrences)
+
+	recurrence := s.series(start, nil).Recurrence()
+	_, err = appt.NewAppointmentSeriesBuilder().
+		WithStartDate(start).
+		WithRecurrence(recurrence).
+		WithService("massage").
+		Build().
+		GenerateOccurrences(s.ctx, openCalendar())
+	s.Error(err, "unknown service")
+
+	notes := strings.Repeat("x", 1001)
+	_, err = appt.NewAppointmentSeriesBuilder().
+		WithStartDate(start).
+		WithRecurrence(recurrence).
+		WithService(enum.ClinicServiceGeneralConsultation).
+		WithNotes(&notes).
+		Build().
+		GenerateOccurrences(s.ctx, openCalendar())
+	s.Error(err, "notes are limited to 1000 characters")
+}
+
+func (s *SeriesTestSuite) TestGenerateOccurrences_BookingWindow() {
+	hours := make([]calendar.OpeningHours, 0, 7)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		hours = append(hours, calendar.OpeningHours{Day: day, OpenHour: 8, CloseHour: 20})
+	}
+	clinicCalendar := calendar.NewClinicCalendar(hours, nil, calendar.BookingPolicy{MinLeadDays: 0, MaxLeadDays: 20})
+
+	testCases := []struct {
+		name     string
+		start    time.Time
+		rejected string
+	}{
+		{"every occurrence inside the window", nextDays(2), ""},
+		{"last occurrence past the window", nextDays(10), "occurrence 3"},
+		{"second occurrence past the window", nextDays(15), "occurrence 2"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			occurrences, err := s.series(tc.start, nil).GenerateOccurrences(s.ctx, clinicCalendar)
+			if tc.rejected == "" {
+				s.Require().NoError(err)
+				s.Len(occurrences, 3)
+				return
+			}
+
+			s.Require().Error(err)
+			s.Contains(err.Error(), tc.rejected)
+			s.Contains(err.Error(), "more than 20 days in advance")
+			s.Empty(occurrences)
+		})
+	}
+}
+
+func (s *SeriesTestSuite) TestFollowingOccurrences() {
+	occurrence := func(id uint, day int, status enum.AppointmentStatus) appt.Appointment {
+		return *appt.NewAppointmentBuilder().
+			WithID(vo.NewAppointmentID(id)).
+			WithScheduledDate(date(2030, time.March, day)).
+			WithStatus(status).
+			Build()
+	}
+	occurrences := []appt.Appointment{
+		occurrence(1, 4, enum.AppointmentStatusCompleted),
+		occurrence(2, 11, enum.AppointmentStatusConfirmed),
+		occurrence(3, 18, enum.AppointmentStatusCancelled),
+		occurrence(4, 25, enum.AppointmentStatusConfirmed),
+	}
+
+	following := appt.FollowingOccurrences(occurrences, occurrences[1])
+
+	s.Require().Len(following, 2)
+	s.Equal(vo.NewAppointmentID(2), following[0].ID())
+	s.Equal(vo.NewAppointmentID(4), following[1].ID())
+}
@@ -0,0 +1,87 @@
+package appointment_test
+
+import (
+	"testing"
+
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/enum"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type StatsTestSuite struct {
+	suite.Suite
+}
+
+func TestStatsSuite(t *testing.T) {
+	suite.Run(t, new(StatsTestSuite))
+}
+
+// statsOf builds the stats of the given status counts the way the repository does
+func statsOf(byStatus map[enum.AppointmentStatus]int) appt.Stats {
+	stats := appt.Stats{ByStatus: byStatus}
+	for _, total := range byStatus {
+		stats.Total += total
+	}
+	return stats
+}
+
+func (s *StatsTestSuite) TestRates() {
+	testCases := []struct {
+		name           string
+		byStatus       map[enum.AppointmentStatus]int
+		noShowRate     float64
+		completionRate float64
+	}{
+		{
+			name:     "no appointments",
+			byStatus: map[enum.AppointmentStatus]int{},
+		},
+		{
+			name: "mixed statuses",
+			byStatus: map[enum.AppointmentStatus]int{
+				enum.AppointmentStatusCompleted:    6,
+				enum.AppointmentStatusNotPresented: 2,
+				enum.AppointmentStatusCancelled:    2,
+				enum.AppointmentStatusConfirmed:    2,
+			},
+			noShowRate:     0.25,
+			completionRate: 0.6,
+		},
+		{
+			name: "upcoming appointments do not count as no-shows",
+			byStatus: map[enum.AppointmentStatus]int{
+				enum.AppointmentStatusCompleted: 3,
+				enum.AppointmentStatusConfirmed: 5,
+				enum.AppointmentStatusPending:   2,
+			},
+			noShowRate:     0,
+			completionRate: 0.3,
+		},
+		{
+			name: "every due appointment was missed",
+			byStatus: map[enum.AppointmentStatus]int{
+				enum.AppointmentStatusNotPresented: 4,
+			},
+			noShowRate:     1,
+			completionRate: 0,
+		},
+		{
+			name: "only cancellations",
+			byStatus: map[enum.AppointmentStatus]int{
+				enum.AppointmentStatusCancelled: 4,
+			},
+			noShowRate:     0,
+			completionRate: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			stats := statsOf(tc.byStatus)
+
+			s.InDelta(tc.noShowRate, stats.NoShowRate(), 1e-9)
+			s.InDelta(tc.completionRate, stats.CompletionRate(), 1e-9)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package appointment_test
+
+import (
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/employee"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/service"
+)
+
+func (s *AvailabilityTestSuite) TestFindAvailableSlots_SkipsApprovedTimeOff() {
+	nextDay := s.day.AddDate(0, 0, 1)
+	s.exceptionRepo.exceptions = []employee.ScheduleException{
+		s.exception(enum.ScheduleExceptionVacation, enum.ScheduleExceptionStatusApproved, s.day, nextDay),
+		s.exception(enum.ScheduleExceptionConference, enum.ScheduleExceptionStatusPending, nextDay, nextDay.AddDate(0, 0, 1)),
+	}
+
+	s.Empty(s.slotsOn(s.day, enum.ClinicServiceGeneralConsultation), "approved vacation")
+	s.Len(s.slotsOn(nextDay, enum.ClinicServiceGeneralConsultation), 14, "pending time off does not block")
+}
+
+func (s *AvailabilityTestSuite) TestFindAvailableSlots_ExtraShift() {
+	s.vet = *employee.NewEmployeeBuilder().WithID(s.vetID).Build()
+	s.exceptionRepo.exceptions = []employee.ScheduleException{
+		s.exception(enum.ScheduleExceptionExtraShift, enum.ScheduleExceptionStatusApproved, at(s.day, 17, 0), at(s.day, 19, 0)),
+	}
+
+	slots := s.slotsOn(s.day, enum.ClinicServiceGrooming)
+
+	s.Equal([]service.TimeSlot{
+		{Start: at(s.day, 17, 0), End: at(s.day, 18, 0)},
+		{Start: at(s.day, 18, 0), End: at(s.day, 19, 0)},
+	}, slots)
+}
+
+func (s *AvailabilityTestSuite) TestEnsureEmployeeAvailable_RejectsTimeOff() {
+	s.exceptionRepo.exceptions = []employee.ScheduleException{
+		s.exception(enum.ScheduleExceptionSickLeave, enum.ScheduleExceptionStatusApproved, at(s.day, 0, 0), at(s.day, 12, 0)),
+	}
+
+	vetID := s.vetID
+	during := appt.NewAppointmentBuilder().
+		WithEmployeeID(&vetID).
+		WithPetID(vo.NewPetID(1)).
+		WithService(enum.ClinicServiceGeneralConsultation).
+		WithScheduledDate(at(s.day, 10, 0)).
+		Build()
+	after := appt.NewAppointmentBuilder().
+		WithEmployeeID(&vetID).
+		WithPetID(vo.NewPetID(1)).
+		WithService(enum.ClinicServiceGeneralConsultation).
+		WithScheduledDate(at(s.day, 14, 0)).
+		Build()
+	unassigned := appt.NewAppointmentBuilder().
+		WithPetID(vo.NewPetID(1)).
+		WithService(enum.ClinicServiceGeneralConsultation).
+		WithScheduledDate(at(s.day, 10, 0)).
+		Build()
+
+	s.Error(s.guard.EnsureEmployeeAvailable(s.ctx, *during))
+	s.NoError(s.guard.EnsureEmployeeAvailable(s.ctx, *after))
+	s.NoError(s.guard.EnsureEmployeeAvailable(s.ctx, *unassigned))
+}
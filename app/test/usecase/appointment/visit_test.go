@@ -0,0 +1,159 @@
+package appointment_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/log"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type VisitTestSuite struct {
+	suite.Suite
+	ctx context.Context
+	now time.Time
+}
+
+func TestVisitSuite(t *testing.T) {
+	suite.Run(t, new(VisitTestSuite))
+}
+
+func (s *VisitTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.now = time.Date(2030, time.March, 4, 11, 0, 0, 0, time.UTC)
+}
+
+func (s *VisitTestSuite) visit(id uint, scheduledDate time.Time, status enum.AppointmentStatus) *appt.Appointment {
+	return appt.NewAppointmentBuilder().
+		WithID(vo.NewAppointmentID(id)).
+		WithService(enum.ClinicServiceGeneralConsultation).
+		WithScheduledDate(scheduledDate).
+		WithStatus(status).
+		Build()
+}
+
+// inStage builds a visit of today that reached the stage, checked in minutesAgo
+func (s *VisitTestSuite) inStage(id uint, scheduledHour int, stage enum.VisitStage, minutesAgo int, priority *enum.TriagePriority) appt.Appointment {
+	checkedInAt := s.now.Add(-time.Duration(minutesAgo) * time.Minute)
+	progress := appt.VisitProgress{Stage: &stage, CheckedInAt: &checkedInAt}
+	if stage != enum.VisitStageCheckedIn {
+		inExamRoomAt := checkedInAt.Add(10 * time.Minute)
+		progress.InExamRoomAt = &inExamRoomAt
+	}
+
+	return *appt.NewAppointmentBuilder().
+		WithID(vo.NewAppointmentID(id)).
+		WithScheduledDate(time.Date(2030, time.March, 4, scheduledHour, 0, 0, 0, time.UTC)).
+		WithStatus(enum.AppointmentStatusConfirmed).
+		WithEmergency(priority != nil, priority).
+		WithVisitProgress(progress).
+		Build()
+}
+
+func (s *VisitTestSuite) TestAdvanceVisit_FollowsTheStages() {
+	visit := s.visit(1, s.now.Add(-time.Hour), enum.AppointmentStatusConfirmed)
+
+	s.Error(visit.AdvanceVisit(s.ctx, enum.VisitStageInExamRoom, s.now), "the patient has not checked in")
+
+	s.Require().NoError(visit.AdvanceVisit(s.ctx, enum.VisitStageCheckedIn, s.now))
+	s.True(visit.HasArrived())
+	s.Error(visit.AdvanceVisit(s.ctx, enum.VisitStageCheckedIn, s.now), "checked in twice")
+	s.Error(visit.AdvanceVisit(s.ctx, enum.VisitStageReadyForCheckout, s.now), "stages can't be skipped")
+
+	s.Require().NoError(visit.AdvanceVisit(s.ctx, enum.VisitStageInExamRoom, s.now.Add(15*time.Minute)))
+	s.Require().NoError(visit.AdvanceVisit(s.ctx, enum.VisitStageReadyForCheckout, s.now.Add(45*time.Minute)))
+	s.Equal(enum.VisitStageReadyForCheckout, *visit.VisitStage())
+
+	progress := visit.VisitProgress()
+	s.Equal(15*time.Minute, progress.WaitTime(s.now.Add(time.Hour)))
+	s.Equal(30*time.Minute, progress.ExamTime(s.now.Add(time.Hour)))
+
+	s.Error(visit.AdvanceVisit(s.ctx, "discharged", s.now))
+}
+
+func (s *VisitTestSuite) TestCheckIn_Rejected() {
+	testCases := []struct {
+		name          string
+		scheduledDate time.Time
+		status        enum.AppointmentStatus
+	}{
+		{"pending appointment", s.now, enum.AppointmentStatusPending},
+		{"cancelled appointment", s.now, enum.AppointmentStatusCancelled},
+		{"appointment of another day", s.now.AddDate(0, 0, 1), enum.AppointmentStatusConfirmed},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			visit := s.visit(1, tc.scheduledDate, tc.status)
+
+			s.Error(visit.CheckIn(s.ctx, s.now))
+			s.False(visit.HasArrived())
+		})
+	}
+}
+
+func (s *VisitTestSuite) TestRunningTimes() {
+	checkedInAt := s.now.Add(-20 * time.Minute)
+
+	s.Zero(appt.VisitProgress{}.WaitTime(s.now), "not arrived yet")
+	s.Equal(20*time.Minute, appt.VisitProgress{CheckedInAt: &checkedInAt}.WaitTime(s.now), "still waiting")
+	s.Zero(appt.VisitProgress{CheckedInAt: &checkedInAt}.ExamTime(s.now))
+}
+
+func (s *VisitTestSuite) TestBuildWaitingRoomQueue() {
+	critical := enum.TriagePriorityCritical
+	nonUrgent := enum.TriagePriorityNonUrgent
+	cancelled := s.inStage(6, 8, enum.VisitStageCheckedIn, 90, nil)
+	cancelled = *appt.NewAppointmentBuilder().
+		WithID(cancelled.ID()).
+		WithStatus(enum.AppointmentStatusCancelled).
+		WithVisitProgress(cancelled.VisitProgress()).
+		Build()
+
+	visits := []appt.Appointment{
+		s.inStage(1, 9, enum.VisitStageCheckedIn, 40, nil),
+		s.inStage(2, 10, enum.VisitStageCheckedIn, 10, &critical),
+		s.inStage(3, 8, enum.VisitStageCheckedIn, 60, &nonUrgent),
+		s.inStage(4, 9, enum.VisitStageInExamRoom, 30, nil),
+		s.inStage(5, 8, enum.VisitStageReadyForCheckout, 80, nil),
+		*s.visit(7, s.now, enum.AppointmentStatusConfirmed),
+		cancelled,
+	}
+
+	queue := appt.BuildWaitingRoomQueue(visits, s.now)
+
+	order := make([]vo.AppointmentID, 0, len(queue.Entries))
+	positions := make([]int, 0, len(queue.Entries))
+	for _, entry := range queue.Entries {
+		order = append(order, entry.Appointment.ID())
+		positions = append(positions, entry.Position)
+	}
+	s.Equal([]vo.AppointmentID{
+		vo.NewAppointmentID(2), vo.NewAppointmentID(3), vo.NewAppointmentID(5),
+		vo.NewAppointmentID(1), vo.NewAppointmentID(4),
+	}, order, "critical first, then by scheduled time, patients not arrived or cancelled are left out")
+	s.Equal([]int{1, 2, 0, 3, 0}, positions, "only waiting patients hold a position")
+
+	s.Equal(3, queue.Metrics.Waiting)
+	s.Equal(1, queue.Metrics.InExamRoom)
+	s.Equal(1, queue.Metrics.ReadyForCheckout)
+	s.Equal(time.Hour, queue.Metrics.LongestWait)
+	s.Equal(time.Duration(110)*time.Minute/3, queue.Metrics.AvgWait)
+	s.Equal(2, queue.Metrics.Seen)
+	s.Equal(10*time.Minute, queue.Metrics.AvgDoorToRoom)
+}
+
+func (s *VisitTestSuite) TestCheckedInPatientIsNotNoShow() {
+	visit := s.inStage(1, 9, enum.VisitStageCheckedIn, 120, nil)
+
+	policy := appt.NoShowPolicy{GracePeriod: 15 * time.Minute}
+	s.False(policy.IsOverdue(visit, s.now))
+}
@@ -0,0 +1,190 @@
+package appointment_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/customer"
+	"clinic-vet-api/app/modules/core/domain/entity/user"
+	"clinic-vet-api/app/modules/core/domain/entity/waitlist"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
+	"clinic-vet-api/app/shared/log"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+// fakeWaitlistRepository returns the same entries for every search and records the stored offers
+type fakeWaitlistRepository struct {
+	repository.WaitlistRepository
+	expired       []waitlist.WaitlistEntry
+	waiting       []waitlist.WaitlistEntry
+	refuseMissed  bool
+	missed        []waitlist.WaitlistEntry
+	offered       []waitlist.WaitlistEntry
+	searchedSlots int
+}
+
+func (r *fakeWaitlistRepository) FindExpiredOffers(ctx context.Context, now time.Time) ([]waitlist.WaitlistEntry, error) {
+	return r.expired, nil
+}
+
+func (r *fakeWaitlistRepository) FindWaitingForSlot(ctx context.Context, slot waitlist.Slot) ([]waitlist.WaitlistEntry, error) {
+	r.searchedSlots++
+	return r.waiting, nil
+}
+
+func (r *fakeWaitlistRepository) SaveMissedOffer(ctx context.Context, entry *waitlist.WaitlistEntry) (bool, error) {
+	if r.refuseMissed {
+		return false, nil
+	}
+	r.missed = append(r.missed, *entry)
+	return true, nil
+}
+
+func (r *fakeWaitlistRepository) SaveOffer(ctx context.Context, entry *waitlist.WaitlistEntry) (bool, error) {
+	r.offered = append(r.offered, *entry)
+	return true, nil
+}
+
+type WaitlistOfferTestSuite struct {
+	suite.Suite
+	ctx           context.Context
+	slot          waitlist.Slot
+	waitlist      *fakeWaitlistRepository
+	notifications *fakeNotificationService
+	offerService  *service.WaitlistOfferService
+}
+
+func TestWaitlistOfferSuite(t *testing.T) {
+	suite.Run(t, new(WaitlistOfferTestSuite))
+}
+
+func (s *WaitlistOfferTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.slot = waitlist.Slot{Start: time.Now().Add(4 * time.Hour), Service: enum.ClinicServiceGeneralConsultation}
+	s.waitlist = &fakeWaitlistRepository{}
+	s.notifications = &fakeNotificationService{}
+
+	userID := vo.NewUserID(3)
+	customers := &reminderCustomerRepository{customer: *customer.NewCustomerBuilder().
+		WithID(vo.NewCustomerID(2)).
+		WithName(vo.NewPersonName("Ana", "Lopez")).
+		WithUserID(&userID).
+		WithPreferredChannel(enum.NotificationChannelEmail).
+		Build()}
+	users := &reminderUserRepository{user: *user.NewUserBuilder().
+		WithID(userID).
+		WithEmail(vo.NewEmailNoErr("ana@example.com")).
+		Build()}
+
+	s.offerService = service.NewWaitlistOfferService(
+		s.waitlist, service.NewCustomerContactService(customers, users), s.notifications, time.Hour,
+	)
+}
+
+func (s *WaitlistOfferTestSuite) waiting(id uint) waitlist.WaitlistEntry {
+	return *waitlist.NewWaitlistEntryBuilder().
+		WithID(vo.NewWaitlistID(id)).
+		WithCustomerID(vo.NewCustomerID(2)).
+		WithPetID(vo.NewPetID(1)).
+		WithService(s.slot.Service).
+		WithRequestedDate(s.slot.Start).
+		WithStatus(enum.WaitlistStatusWaiting).
+		Build()
+}
+
+// offered returns an entry holding an expired offer of the slot after missing the given offers
+func (s *WaitlistOfferTestSuite) offered(id uint, missedOffers int) waitlist.WaitlistEntry {
+	start := s.slot.Start
+	tokenHash := waitlist.HashClaimToken("token")
+	expiresAt := time.Now().Add(-time.Minute)
+
+	return *waitlist.NewWaitlistEntryBuilder().
+		WithID(vo.NewWaitlistID(id)).
+		WithCustomerID(vo.NewCustomerID(2)).
+		WithPetID(vo.NewPetID(1)).
+		WithService(s.slot.Service).
+		WithRequestedDate(s.slot.Start).
+		WithStatus(enum.WaitlistStatusOffered).
+		WithOffer(&start, nil, &tokenHash, &expiresAt).
+		WithMissedOffers(missedOffers).
+		Build()
+}
+
+func (s *WaitlistOfferTestSuite) TestMissOffer() {
+	testCases := []struct {
+		name           string
+		missedBefore   int
+		expectedStatus enum.WaitlistStatus
+		keepsSlot      bool
+	}{
+		{"first missed offer goes back to waiting", 0, enum.WaitlistStatusWaiting, false},
+		{"missed offer under the cap goes back to waiting", waitlist.MaxMissedOffers - 2, enum.WaitlistStatusWaiting, false},
+		{"missed offer reaching the cap expires", waitlist.MaxMissedOffers - 1, enum.WaitlistStatusExpired, true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			entry := s.offered(1, tc.missedBefore)
+
+			s.Require().NoError(entry.MissOffer(s.ctx))
+
+			s.Equal(tc.expectedStatus, entry.Status())
+			s.Equal(tc.missedBefore+1, entry.MissedOffers())
+			s.Nil(entry.ClaimTokenHash())
+			_, hasSlot := entry.Slot()
+			s.Equal(tc.keepsSlot, hasSlot)
+		})
+	}
+}
+
+func (s *WaitlistOfferTestSuite) TestMissOffer_RequiresAnOffer() {
+	entry := s.waiting(1)
+
+	s.Error(entry.MissOffer(s.ctx))
+	s.Zero(entry.MissedOffers())
+}
+
+func (s *WaitlistOfferTestSuite) TestExpireOffers_PassesSlotOnWithoutTheMissedEntry() {
+	s.waitlist.expired = []waitlist.WaitlistEntry{s.offered(1, 0)}
+	s.waitlist.waiting = []waitlist.WaitlistEntry{s.waiting(1), s.waiting(2)}
+
+	s.Require().NoError(s.offerService.ExpireOffers(s.ctx))
+
+	s.Require().Len(s.waitlist.missed, 1)
+	s.Equal(enum.WaitlistStatusWaiting, s.waitlist.missed[0].Status())
+	s.Equal(1, s.waitlist.missed[0].MissedOffers())
+
+	s.Require().Len(s.waitlist.offered, 1, "the entry that missed the slot is not offered it again")
+	s.Equal(vo.NewWaitlistID(2), s.waitlist.offered[0].ID())
+	s.Len(s.notifications.sent, 1)
+}
+
+func (s *WaitlistOfferTestSuite) TestExpireOffers_ExpiresAtTheCap() {
+	s.waitlist.expired = []waitlist.WaitlistEntry{s.offered(1, waitlist.MaxMissedOffers-1)}
+
+	s.Require().NoError(s.offerService.ExpireOffers(s.ctx))
+
+	s.Require().Len(s.waitlist.missed, 1)
+	s.Equal(enum.WaitlistStatusExpired, s.waitlist.missed[0].Status())
+	s.Equal(1, s.waitlist.searchedSlots, "the slot is still passed on")
+}
+
+func (s *WaitlistOfferTestSuite) TestExpireOffers_SkipsOffersTakenBackElsewhere() {
+	s.waitlist.refuseMissed = true
+	s.waitlist.expired = []waitlist.WaitlistEntry{s.offered(1, 0)}
+	s.waitlist.waiting = []waitlist.WaitlistEntry{s.waiting(2)}
+
+	s.Require().NoError(s.offerService.ExpireOffers(s.ctx))
+
+	s.Zero(s.waitlist.searchedSlots, "another replica re-offers the slot")
+	s.Empty(s.waitlist.offered)
+	s.Empty(s.notifications.sent)
+}
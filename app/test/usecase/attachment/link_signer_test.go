@@ -0,0 +1,100 @@
+package attachment_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/log"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+const linkSecret = "attachment-link-secret-of-32-characters"
+
+type LinkSignerTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	now        time.Time
+	expiresAt  time.Time
+	signer     medical.AttachmentLinkSigner
+	attachment medical.MedicalAttachment
+}
+
+func TestLinkSignerSuite(t *testing.T) {
+	suite.Run(t, new(LinkSignerTestSuite))
+}
+
+func (s *LinkSignerTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.now = time.Date(2030, time.March, 4, 10, 0, 0, 0, time.UTC)
+	s.expiresAt = s.now.Add(15 * time.Minute)
+	s.signer = medical.NewAttachmentLinkSigner(linkSecret)
+	s.attachment = s.stored(5, "pets/1/xray.png")
+}
+
+func (s *LinkSignerTestSuite) stored(id uint, storageKey string) medical.MedicalAttachment {
+	return *medical.NewMedicalAttachmentBuilder().
+		WithID(vo.NewAttachmentID(id)).
+		WithStorageKey(storageKey).
+		Build()
+}
+
+func (s *LinkSignerTestSuite) TestToken_ValidUntilExpiry() {
+	token := s.signer.Token(s.attachment, s.expiresAt)
+
+	id, err := s.signer.ParseToken(s.ctx, token)
+	s.Require().NoError(err)
+	s.Equal(vo.NewAttachmentID(5), id)
+
+	s.NoError(s.signer.Verify(s.ctx, s.attachment, token, s.now))
+	s.NoError(s.signer.Verify(s.ctx, s.attachment, token, s.expiresAt.Add(-time.Second)))
+}
+
+func (s *LinkSignerTestSuite) TestVerify_RejectsExpiredLink() {
+	token := s.signer.Token(s.attachment, s.expiresAt)
+
+	err := s.signer.Verify(s.ctx, s.attachment, token, s.expiresAt)
+	s.Require().Error(err, "the link stops working at its expiry")
+	s.Contains(err.Error(), "expired")
+
+	s.Error(s.signer.Verify(s.ctx, s.attachment, token, s.expiresAt.Add(time.Hour)))
+}
+
+func (s *LinkSignerTestSuite) TestVerify_RejectsExtendedExpiry() {
+	token := s.signer.Token(s.attachment, s.expiresAt)
+	extended := strings.Replace(token,
+		fmt.Sprintf(".%d.", s.expiresAt.Unix()),
+		fmt.Sprintf(".%d.", s.expiresAt.Add(24*time.Hour).Unix()), 1)
+	s.Require().NotEqual(token, extended)
+
+	err := s.signer.Verify(s.ctx, s.attachment, extended, s.expiresAt.Add(time.Hour))
+	s.Require().Error(err)
+	s.NotContains(err.Error(), "expired", "a forged expiry is rejected as an invalid link")
+}
+
+func (s *LinkSignerTestSuite) TestVerify_RejectsLinkOfAnotherFile() {
+	token := s.signer.Token(s.attachment, s.expiresAt)
+
+	s.Error(s.signer.Verify(s.ctx, s.stored(6, "pets/1/xray.png"), token, s.now), "another attachment")
+	s.Error(s.signer.Verify(s.ctx, s.stored(5, "pets/1/other.png"), token, s.now), "the file was replaced")
+
+	other := medical.NewAttachmentLinkSigner("another-secret-of-at-least-32-characters")
+	s.Error(other.Verify(s.ctx, s.attachment, token, s.now), "signed with another secret")
+}
+
+func (s *LinkSignerTestSuite) TestParseToken_RejectsMalformedTokens() {
+	for _, token := range []string{"", "5", "5.1900000000", "5.1900000000.sig.extra", "abc.1900000000.sig", "0.1900000000.sig"} {
+		_, err := s.signer.ParseToken(s.ctx, token)
+		s.Error(err, token)
+	}
+
+	s.Error(s.signer.Verify(s.ctx, s.attachment, "5.soon.sig", s.now))
+}
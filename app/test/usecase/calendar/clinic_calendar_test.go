@@ -0,0 +1,155 @@
+package calendar_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/calendar"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	"clinic-vet-api/app/shared/log"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type ClinicCalendarTestSuite struct {
+	suite.Suite
+	ctx context.Context
+	// now is a Monday, bookings between 3 and 30 days ahead are allowed
+	now      time.Time
+	calendar calendar.ClinicCalendar
+}
+
+func TestClinicCalendarSuite(t *testing.T) {
+	suite.Run(t, new(ClinicCalendarTestSuite))
+}
+
+func (s *ClinicCalendarTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.now = time.Date(2030, time.March, 4, 8, 0, 0, 0, time.UTC)
+
+	morningStart, morningEnd := 9, 13
+	closures := []calendar.Closure{
+		*calendar.NewClosureBuilder().
+			WithDate(s.day(10)).
+			WithClosureType(enum.ClosureTypePublicHoliday).
+			WithReason("Bank holiday").
+			Build(),
+		*calendar.NewClosureBuilder().
+			WithDate(s.day(11)).
+			WithClosureType(enum.ClosureTypeAdHoc).
+			WithReason("Staff training").
+			WithHours(&morningStart, &morningEnd).
+			Build(),
+	}
+	s.calendar = calendar.NewClinicCalendar(calendar.DefaultOpeningHours(), closures, calendar.DefaultBookingPolicy())
+}
+
+// day returns midnight of the given day of March 2030
+func (s *ClinicCalendarTestSuite) day(day int) time.Time {
+	return time.Date(2030, time.March, day, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *ClinicCalendarTestSuite) TestCheckBookingDate() {
+	testCases := []struct {
+		name     string
+		date     time.Time
+		duration time.Duration
+		valid    bool
+	}{
+		{"open weekday", s.day(7).Add(10 * time.Hour), time.Hour, true},
+		{"ends at closing time", s.day(7).Add(17 * time.Hour), time.Hour, true},
+		{"in the past", s.now.Add(-time.Hour), time.Hour, false},
+		{"before the minimum lead time", s.day(5).Add(10 * time.Hour), time.Hour, false},
+		{"after the maximum lead time", s.now.AddDate(0, 0, 31), time.Hour, false},
+		{"weekend", s.day(9).Add(10 * time.Hour), time.Hour, false},
+		{"before opening", s.day(7).Add(8 * time.Hour), time.Hour, false},
+		{"runs past closing", s.day(7).Add(17*time.Hour + 30*time.Minute), time.Hour, false},
+		{"full-day closure", s.day(10).Add(10 * time.Hour), time.Hour, false},
+		{"inside a partial closure", s.day(11).Add(10 * time.Hour), time.Hour, false},
+		{"overlapping a partial closure", s.day(11).Add(12*time.Hour + 30*time.Minute), time.Hour, false},
+		{"after a partial closure", s.day(11).Add(13 * time.Hour), time.Hour, true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			err := s.calendar.CheckBookingDate(tc.date, tc.duration, s.now)
+			if tc.valid {
+				s.NoError(err)
+			} else {
+				s.Error(err)
+			}
+		})
+	}
+}
+
+func (s *ClinicCalendarTestSuite) TestOpeningHoursFor() {
+	openHour, closeHour, isOpen := s.calendar.OpeningHoursFor(time.Wednesday)
+	s.True(isOpen)
+	s.Equal(calendar.DefaultOpenHour, openHour)
+	s.Equal(calendar.DefaultCloseHour, closeHour)
+
+	_, _, isOpen = s.calendar.OpeningHoursFor(time.Sunday)
+	s.False(isOpen)
+}
+
+func (s *ClinicCalendarTestSuite) TestClosureOn() {
+	closure, exists := s.calendar.ClosureOn(s.day(10).Add(15 * time.Hour))
+	s.Require().True(exists)
+	s.True(closure.IsFullDay())
+	s.Equal("Bank holiday", closure.Reason())
+
+	_, exists = s.calendar.ClosureOn(s.day(12))
+	s.False(exists)
+}
+
+func (s *ClinicCalendarTestSuite) TestValidateOpeningHours() {
+	testCases := []struct {
+		name  string
+		hours []calendar.OpeningHours
+		valid bool
+	}{
+		{"defaults", calendar.DefaultOpeningHours(), true},
+		{"closed day ignores hours", []calendar.OpeningHours{{Day: time.Sunday, OpenHour: 20, CloseHour: 2, IsClosed: true}}, true},
+		{"opens after closing", []calendar.OpeningHours{{Day: time.Monday, OpenHour: 18, CloseHour: 9}}, false},
+		{"past midnight", []calendar.OpeningHours{{Day: time.Monday, OpenHour: 9, CloseHour: 25}}, false},
+		{"invalid weekday", []calendar.OpeningHours{{Day: time.Weekday(7), OpenHour: 9, CloseHour: 18}}, false},
+		{"duplicated day", []calendar.OpeningHours{
+			{Day: time.Monday, OpenHour: 9, CloseHour: 18},
+			{Day: time.Monday, OpenHour: 10, CloseHour: 12},
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			err := calendar.ValidateOpeningHours(s.ctx, tc.hours)
+			if tc.valid {
+				s.NoError(err)
+			} else {
+				s.Error(err)
+			}
+		})
+	}
+}
+
+func (s *ClinicCalendarTestSuite) TestBookingPolicyValidate() {
+	s.NoError(calendar.DefaultBookingPolicy().Validate(s.ctx))
+	s.NoError(calendar.BookingPolicy{MinLeadDays: 0, MaxLeadDays: 0}.Validate(s.ctx))
+	s.Error(calendar.BookingPolicy{MinLeadDays: -1, MaxLeadDays: 10}.Validate(s.ctx))
+	s.Error(calendar.BookingPolicy{MinLeadDays: 5, MaxLeadDays: 4}.Validate(s.ctx))
+}
+
+func (s *ClinicCalendarTestSuite) TestClosureValidate() {
+	startHour, endHour := 14, 12
+
+	s.Error(calendar.NewClosureBuilder().WithClosureType(enum.ClosureTypeAdHoc).WithReason("x").Build().Validate(s.ctx), "date is required")
+	s.Error(calendar.NewClosureBuilder().WithDate(s.day(12)).WithClosureType("other").WithReason("x").Build().Validate(s.ctx))
+	s.Error(calendar.NewClosureBuilder().WithDate(s.day(12)).WithClosureType(enum.ClosureTypeAdHoc).Build().Validate(s.ctx), "reason is required")
+	s.Error(calendar.NewClosureBuilder().WithDate(s.day(12)).WithClosureType(enum.ClosureTypeAdHoc).WithReason("x").
+		WithHours(&startHour, nil).Build().Validate(s.ctx), "hours go together")
+	s.Error(calendar.NewClosureBuilder().WithDate(s.day(12)).WithClosureType(enum.ClosureTypeAdHoc).WithReason("x").
+		WithHours(&startHour, &endHour).Build().Validate(s.ctx), "starts after it ends")
+}
@@ -0,0 +1,99 @@
+package calendarfeed_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/calendarfeed"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/log"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+const feedSecret = "calendar-feed-secret-of-32-characters"
+
+type FeedTokenTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	createdAt time.Time
+	signer    calendarfeed.Signer
+}
+
+func TestFeedTokenSuite(t *testing.T) {
+	suite.Run(t, new(FeedTokenTestSuite))
+}
+
+func (s *FeedTokenTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.createdAt = time.Date(2030, time.March, 4, 10, 0, 0, 0, time.UTC)
+	s.signer = calendarfeed.NewSigner(feedSecret)
+}
+
+func (s *FeedTokenTestSuite) feed(id uint, ownerID uint, createdAt time.Time) calendarfeed.CalendarFeed {
+	return *calendarfeed.NewCalendarFeedBuilder().
+		WithID(vo.NewCalendarFeedID(id)).
+		WithOwner(enum.CalendarFeedOwnerEmployee, ownerID).
+		WithCreatedAt(createdAt).
+		Build()
+}
+
+func (s *FeedTokenTestSuite) TestToken_RoundTrips() {
+	feed := s.feed(7, 1, s.createdAt)
+	token := s.signer.Token(feed)
+
+	id, err := s.signer.ParseToken(s.ctx, token)
+	s.Require().NoError(err)
+	s.Equal(vo.NewCalendarFeedID(7), id)
+	s.NoError(s.signer.Verify(s.ctx, feed, token))
+}
+
+func (s *FeedTokenTestSuite) TestVerify_RejectsTokenOfAnotherFeed() {
+	token := s.signer.Token(s.feed(7, 1, s.createdAt))
+
+	s.Error(s.signer.Verify(s.ctx, s.feed(8, 1, s.createdAt), token), "another feed")
+	s.Error(s.signer.Verify(s.ctx, s.feed(7, 2, s.createdAt), token), "another owner")
+
+	reissued := s.feed(7, 1, s.createdAt.Add(time.Microsecond))
+	s.Error(s.signer.Verify(s.ctx, reissued, token), "a re-issued feed doesn't accept the old tokens")
+}
+
+func (s *FeedTokenTestSuite) TestVerify_RejectsTamperedToken() {
+	feed := s.feed(7, 1, s.createdAt)
+	token := s.signer.Token(feed)
+
+	other := calendarfeed.NewSigner("another-secret-of-at-least-32-characters")
+	s.Error(other.Verify(s.ctx, feed, token), "signed with another secret")
+
+	forged := "8" + strings.TrimPrefix(token, "7")
+	s.Error(s.signer.Verify(s.ctx, s.feed(8, 1, s.createdAt), forged), "the id can't be swapped")
+	s.Error(s.signer.Verify(s.ctx, feed, token+"x"))
+}
+
+func (s *FeedTokenTestSuite) TestVerify_RejectsRevokedFeed() {
+	revokedAt := s.createdAt.Add(time.Hour)
+	feed := s.feed(7, 1, s.createdAt)
+	token := s.signer.Token(feed)
+
+	revoked := *calendarfeed.NewCalendarFeedBuilder().
+		WithID(feed.ID()).
+		WithOwner(feed.OwnerType(), feed.OwnerID()).
+		WithCreatedAt(s.createdAt).
+		WithRevokedAt(&revokedAt).
+		Build()
+
+	s.Error(s.signer.Verify(s.ctx, revoked, token))
+}
+
+func (s *FeedTokenTestSuite) TestParseToken_RejectsMalformedTokens() {
+	for _, token := range []string{"", "7", "abc.signature", "0.signature", "-1.signature", "99999999999.signature"} {
+		_, err := s.signer.ParseToken(s.ctx, token)
+		s.Error(err, token)
+	}
+}
@@ -0,0 +1,233 @@
+package employee_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/employee"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/log"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type OnCallTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	start     time.Time
+	employees []employee.Employee
+}
+
+func TestOnCallSuite(t *testing.T) {
+	suite.Run(t, new(OnCallTestSuite))
+}
+
+func (s *OnCallTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.start = time.Date(2030, time.March, 4, 8, 0, 0, 0, time.UTC)
+	s.employees = []employee.Employee{
+		s.employee(3, enum.VetSpecialtyGeneralPractice, true),
+		s.employee(1, enum.VetSpecialtyGeneralPractice, true),
+		s.employee(2, enum.VetSpecialtyGeneralPractice, true),
+		s.employee(4, enum.VetSpecialtyGeneralPractice, false),
+		s.employee(5, enum.VetSpecialtySurgery, true),
+	}
+}
+
+func (s *OnCallTestSuite) employee(id uint, specialty enum.VetSpecialty, active bool) employee.Employee {
+	return *employee.NewEmployeeBuilder().
+		WithID(vo.NewEmployeeID(id)).
+		WithSpecialty(specialty).
+		WithIsActive(active).
+		Build()
+}
+
+func (s *OnCallTestSuite) timeOff(employeeID uint, status enum.ScheduleExceptionStatus, start, end time.Time) employee.ScheduleException {
+	return *employee.NewScheduleExceptionBuilder().
+		WithEmployeeID(vo.NewEmployeeID(employeeID)).
+		WithType(enum.ScheduleExceptionVacation).
+		WithPeriod(start, end).
+		WithReason("Holidays").
+		WithStatus(status).
+		Build()
+}
+
+func (s *OnCallTestSuite) rotation(days, shiftHours int) employee.OnCallRotation {
+	return employee.OnCallRotation{
+		Specialty:  enum.VetSpecialtyGeneralPractice,
+		StartsAt:   s.start,
+		EndsAt:     s.start.AddDate(0, 0, days),
+		ShiftHours: shiftHours,
+	}
+}
+
+// onCall lists who covers each shift, in order
+func onCall(shifts []employee.OnCallShift) []uint {
+	ids := make([]uint, 0, len(shifts))
+	for _, shift := range shifts {
+		ids = append(ids, shift.EmployeeID().Value())
+	}
+	return ids
+}
+
+func (s *OnCallTestSuite) TestValidate() {
+	testCases := []struct {
+		name   string
+		change func(*employee.OnCallRotation)
+		valid  bool
+	}{
+		{"valid", func(*employee.OnCallRotation) {}, true},
+		{"unknown specialty", func(r *employee.OnCallRotation) { r.Specialty = enum.VetSpecialtyUnknown }, false},
+		{"ends before it starts", func(r *employee.OnCallRotation) { r.EndsAt = r.StartsAt.Add(-time.Hour) }, false},
+		{"longest rotation", func(r *employee.OnCallRotation) { r.EndsAt = r.StartsAt.AddDate(0, 0, employee.MaxOnCallRotationDays) }, true},
+		{"too long", func(r *employee.OnCallRotation) {
+			r.EndsAt = r.StartsAt.AddDate(0, 0, employee.MaxOnCallRotationDays+1)
+		}, false},
+		{"shifts too short", func(r *employee.OnCallRotation) { r.ShiftHours = employee.MinOnCallShiftHours - 1 }, false},
+		{"shifts too long", func(r *employee.OnCallRotation) { r.ShiftHours = employee.MaxOnCallShiftHours + 1 }, false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			rotation := s.rotation(1, 12)
+			tc.change(&rotation)
+
+			err := rotation.Validate(s.ctx)
+			if tc.valid {
+				s.NoError(err)
+			} else {
+				s.Error(err)
+			}
+		})
+	}
+}
+
+func (s *OnCallTestSuite) TestGenerateOnCallRotation() {
+	two := vo.NewEmployeeID(2)
+	unknown := vo.NewEmployeeID(9)
+
+	testCases := []struct {
+		name       string
+		exceptions []employee.ScheduleException
+		lastOnCall *vo.EmployeeID
+		expected   []uint
+	}{
+		{
+			name:     "turns in ID order among the active employees of the specialty",
+			expected: []uint{1, 2, 3, 1, 2, 3},
+		},
+		{
+			name:       "carries on after the last on call",
+			lastOnCall: &two,
+			expected:   []uint{3, 1, 2, 3, 1, 2},
+		},
+		{
+			name:       "last on call no longer in the rotation",
+			lastOnCall: &unknown,
+			expected:   []uint{1, 2, 3, 1, 2, 3},
+		},
+		{
+			name: "approved time off loses the turn",
+			exceptions: []employee.ScheduleException{
+				s.timeOff(2, enum.ScheduleExceptionStatusApproved, s.start.Add(12*time.Hour), s.start.Add(14*time.Hour)),
+			},
+			expected: []uint{1, 3, 1, 2, 3, 1},
+		},
+		{
+			name: "pending time off is ignored",
+			exceptions: []employee.ScheduleException{
+				s.timeOff(2, enum.ScheduleExceptionStatusPending, s.start.Add(12*time.Hour), s.start.Add(14*time.Hour)),
+			},
+			expected: []uint{1, 2, 3, 1, 2, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			shifts, err := employee.GenerateOnCallRotation(s.ctx, s.rotation(3, 12), s.employees, tc.exceptions, tc.lastOnCall)
+
+			s.Require().NoError(err)
+			s.Equal(tc.expected, onCall(shifts))
+			for i, shift := range shifts {
+				s.Equal(enum.VetSpecialtyGeneralPractice, shift.Specialty())
+				s.Equal(s.start.Add(time.Duration(12*i)*time.Hour), shift.StartsAt())
+				s.Equal(12*time.Hour, shift.EndsAt().Sub(shift.StartsAt()))
+			}
+		})
+	}
+}
+
+func (s *OnCallTestSuite) TestGenerateOnCallRotation_LastShiftEndsWithThePeriod() {
+	shifts, err := employee.GenerateOnCallRotation(s.ctx, s.rotation(1, 10), s.employees, nil, nil)
+
+	s.Require().NoError(err)
+	s.Require().Len(shifts, 3)
+	s.Equal(s.start.Add(20*time.Hour), shifts[2].StartsAt())
+	s.Equal(s.start.AddDate(0, 0, 1), shifts[2].EndsAt())
+}
+
+func (s *OnCallTestSuite) TestGenerateOnCallRotation_Rejected() {
+	everyoneOff := []employee.ScheduleException{
+		s.timeOff(1, enum.ScheduleExceptionStatusApproved, s.start, s.start.AddDate(0, 0, 1)),
+		s.timeOff(2, enum.ScheduleExceptionStatusApproved, s.start, s.start.AddDate(0, 0, 1)),
+		s.timeOff(3, enum.ScheduleExceptionStatusApproved, s.start.Add(12*time.Hour), s.start.AddDate(0, 0, 1)),
+	}
+	_, err := employee.GenerateOnCallRotation(s.ctx, s.rotation(1, 12), s.employees, everyoneOff, nil)
+	s.Error(err, "nobody covers the second shift")
+
+	dentistry := s.rotation(1, 12)
+	dentistry.Specialty = enum.VetSpecialtyDentistry
+	_, err = employee.GenerateOnCallRotation(s.ctx, dentistry, s.employees, nil, nil)
+	s.Error(err, "no employee has the specialty")
+
+	_, err = employee.GenerateOnCallRotation(s.ctx, s.rotation(1, 2), s.employees, nil, nil)
+	s.Error(err, "invalid rotation")
+}
+
+func (s *OnCallTestSuite) TestPickEmergencyOnCall() {
+	shiftOf := func(id uint, specialty enum.VetSpecialty) employee.OnCallShift {
+		return *employee.NewOnCallShiftBuilder().
+			WithEmployeeID(vo.NewEmployeeID(id)).
+			WithSpecialty(specialty).
+			WithPeriod(s.start, s.start.Add(12*time.Hour)).
+			Build()
+	}
+
+	testCases := []struct {
+		name     string
+		shifts   []employee.OnCallShift
+		expected uint
+		found    bool
+	}{
+		{"nobody on call", nil, 0, false},
+		{"emergency and critical care first", []employee.OnCallShift{
+			shiftOf(1, enum.VetSpecialtySurgery),
+			shiftOf(2, enum.VetSpecialtyGeneralPractice),
+			shiftOf(3, enum.VetSpecialtyEmergencyCriticalCare),
+		}, 3, true},
+		{"then general practice", []employee.OnCallShift{
+			shiftOf(1, enum.VetSpecialtySurgery),
+			shiftOf(2, enum.VetSpecialtyGeneralPractice),
+		}, 2, true},
+		{"then anyone on call", []employee.OnCallShift{
+			shiftOf(1, enum.VetSpecialtySurgery),
+			shiftOf(2, enum.VetSpecialtyCardiology),
+		}, 1, true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			shift, found := employee.PickEmergencyOnCall(tc.shifts)
+
+			s.Equal(tc.found, found)
+			if found {
+				s.Equal(tc.expected, shift.EmployeeID().Value())
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package lab_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"clinic-vet-api/app/shared/hl7"
+	"clinic-vet-api/app/shared/log"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+const oruMessage = "MSH|^~\\&|ANALYZER|LAB|VETAPI|CLINIC|20300304101500||ORU^R01|MSG001|P|2.5.1\r" +
+	"PID|||42\r" +
+	"OBR|1||ACC-1|CBC^Complete blood count|||20300304100000||||||||||||||||||F\r" +
+	"OBX|1|NM|WBC^White cells||12.5|10*9/L|6-17|N|||F\r" +
+	"OBX|2|ST|NOTE^Comment||Lipemic \\T\\ hemolyzed|||||F\r"
+
+type HL7TestSuite struct {
+	suite.Suite
+}
+
+func TestHL7Suite(t *testing.T) {
+	suite.Run(t, new(HL7TestSuite))
+}
+
+func (s *HL7TestSuite) SetupTest() {
+	log.App = zap.NewNop()
+}
+
+func (s *HL7TestSuite) TestParse_ReadsSegmentsAndFields() {
+	message, err := hl7.Parse([]byte(oruMessage))
+	s.Require().NoError(err)
+
+	s.Len(message.Segments, 5)
+	code, trigger := message.Type()
+	s.Equal("ORU", code)
+	s.Equal("R01", trigger)
+	s.Equal("MSG001", message.ControlID())
+	s.Equal("2.5.1", message.Version())
+
+	header := message.Header()
+	s.Equal("|", header.Field(1))
+	s.Equal("^~\\&", header.Field(2))
+	s.Equal("ANALYZER", header.Field(3))
+
+	request, exists := message.First("OBR")
+	s.Require().True(exists)
+	s.Equal("CBC", request.Component(4, 1))
+	s.Equal("Complete blood count", request.Component(4, 2))
+	s.Equal("", request.Component(4, 3))
+	s.Equal("", request.Field(99), "fields past the end are empty")
+
+	observation := message.Segments[4]
+	s.Equal("Lipemic & hemolyzed", observation.Value(5), "escape sequences are decoded")
+}
+
+func (s *HL7TestSuite) TestParse_AcceptsLineFeedsAndCustomDelimiters() {
+	raw := "MSH#*~!@#ANALYZER\nPID###7*ignored~8\r\n"
+
+	message, err := hl7.Parse([]byte(raw))
+	s.Require().NoError(err)
+
+	s.Len(message.Segments, 2)
+	s.Equal(byte('#'), message.Delimiters.Field)
+	s.Equal(byte('*'), message.Delimiters.Component)
+	s.Equal("7", message.Segments[1].Value(3), "only the first repetition and component are read")
+}
+
+func (s *HL7TestSuite) TestParse_Errors() {
+	_, err := hl7.Parse([]byte("\r\n  "))
+	s.ErrorIs(err, hl7.ErrEmptyMessage)
+
+	_, err = hl7.Parse([]byte("PID|||42"))
+	s.ErrorIs(err, hl7.ErrMissingHeader)
+
+	_, err = hl7.Parse([]byte("MSH|^"))
+	s.ErrorIs(err, hl7.ErrInvalidHeader)
+
+	_, err = hl7.Parse([]byte("MSH|^~\\&|A\robx|1"))
+	s.ErrorIs(err, hl7.ErrInvalidSegment)
+}
+
+func (s *HL7TestSuite) TestParseTimestamp() {
+	withOffset, err := hl7.ParseTimestamp("20300304101530-0500")
+	s.Require().NoError(err)
+	s.True(withOffset.Equal(time.Date(2030, time.March, 4, 15, 15, 30, 0, time.UTC)))
+
+	dayOnly, err := hl7.ParseTimestamp("20300304")
+	s.Require().NoError(err)
+	s.Equal(time.Date(2030, time.March, 4, 0, 0, 0, 0, time.Local), dayOnly)
+
+	fraction, err := hl7.ParseTimestamp("20300304101530.25+0000")
+	s.Require().NoError(err)
+	s.Equal(250*time.Millisecond, time.Duration(fraction.Nanosecond()))
+
+	for _, invalid := range []string{"", "203", "2030030410153", "20301304", "20300304-05"} {
+		_, err := hl7.ParseTimestamp(invalid)
+		s.Error(err, invalid)
+	}
+}
+
+func (s *HL7TestSuite) TestAckEncode_AcceptedSwapsApplications() {
+	message, err := hl7.Parse([]byte(oruMessage))
+	s.Require().NoError(err)
+	now := time.Date(2030, time.March, 4, 10, 16, 0, 0, time.UTC)
+
+	ack, err := hl7.Parse(hl7.Accepted().Encode(message, "ACK001", now))
+	s.Require().NoError(err)
+
+	header := ack.Header()
+	s.Equal("VETAPI", header.Field(3))
+	s.Equal("CLINIC", header.Field(4))
+	s.Equal("ANALYZER", header.Field(5))
+	s.Equal("LAB", header.Field(6))
+	s.Equal("20300304101600+0000", header.Field(7))
+	code, trigger := ack.Type()
+	s.Equal("ACK", code)
+	s.Equal("R01", trigger)
+	s.Equal("ACK001", ack.ControlID())
+	s.Equal("2.5.1", ack.Version())
+
+	acknowledgment, exists := ack.First("MSA")
+	s.Require().True(exists)
+	s.Equal("AA", acknowledgment.Value(1))
+	s.Equal("MSG001", acknowledgment.Value(2))
+	_, hasError := ack.First("ERR")
+	s.False(hasError)
+}
+
+func (s *HL7TestSuite) TestAckEncode_FailedWritesErrorSegment() {
+	message, err := hl7.Parse([]byte(oruMessage))
+	s.Require().NoError(err)
+
+	raw := hl7.Failed(hl7.ErrorUnknownKeyIdentifier, "no open order for ACC|1").Encode(message, "ACK002", time.Now())
+	ack, err := hl7.Parse(raw)
+	s.Require().NoError(err)
+
+	acknowledgment, _ := ack.First("MSA")
+	s.Equal("AE", acknowledgment.Value(1))
+	s.Equal("no open order for ACC|1", acknowledgment.Value(3), "the text is escaped and read back")
+
+	errorSegment, exists := ack.First("ERR")
+	s.Require().True(exists)
+	s.Equal("204", errorSegment.Component(3, 1))
+	s.Equal("Unknown key identifier", errorSegment.Component(3, 2))
+	s.Equal("HL70357", errorSegment.Component(3, 3))
+	s.Equal("E", errorSegment.Value(4))
+}
+
+func (s *HL7TestSuite) TestAckEncode_RejectedWithoutOriginal() {
+	ack, err := hl7.Parse(hl7.Rejected(hl7.ErrorSegmentSequence, "unreadable").Encode(nil, "ACK003", time.Now()))
+	s.Require().NoError(err)
+
+	code, trigger := ack.Type()
+	s.Equal("ACK", code)
+	s.Equal("", trigger)
+	acknowledgment, _ := ack.First("MSA")
+	s.Equal("AR", acknowledgment.Value(1))
+	s.Equal("", acknowledgment.Value(2))
+}
+
+func (s *HL7TestSuite) TestFrames_RoundTrip() {
+	var buffer bytes.Buffer
+	buffer.WriteString("noise before the block")
+	s.Require().NoError(hl7.WriteFrame(&buffer, []byte("first")))
+	s.Require().NoError(hl7.WriteFrame(&buffer, []byte("second")))
+	reader := bufio.NewReader(&buffer)
+
+	first, err := hl7.ReadFrame(reader, hl7.DefaultMaxMessageSize)
+	s.Require().NoError(err)
+	s.Equal("first", string(first))
+
+	second, err := hl7.ReadFrame(reader, hl7.DefaultMaxMessageSize)
+	s.Require().NoError(err)
+	s.Equal("second", string(second))
+
+	_, err = hl7.ReadFrame(reader, hl7.DefaultMaxMessageSize)
+	s.ErrorIs(err, io.EOF)
+}
+
+func (s *HL7TestSuite) TestFrames_Errors() {
+	_, err := hl7.ReadFrame(bufio.NewReader(strings.NewReader("\x0btoo long\x1c\r")), 3)
+	s.ErrorIs(err, hl7.ErrMessageTooLarge)
+
+	_, err = hl7.ReadFrame(bufio.NewReader(strings.NewReader("\x0bcut")), hl7.DefaultMaxMessageSize)
+	s.ErrorIs(err, io.ErrUnexpectedEOF)
+
+	_, err = hl7.ReadFrame(bufio.NewReader(strings.NewReader("\x0bmessage\x1cX")), hl7.DefaultMaxMessageSize)
+	s.Error(err)
+}
+
+// serve starts the listener on a free loopback port and stops it when the test ends
+func (s *HL7TestSuite) serve(allowedPeers []netip.Prefix) string {
+	handler := func(ctx context.Context, message []byte) []byte {
+		return append([]byte("ack:"), message...)
+	}
+	server := hl7.NewMLLPServer("127.0.0.1:0", handler, time.Second, allowedPeers)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan error, 1)
+	go func() { stopped <- server.Serve(ctx) }()
+	s.T().Cleanup(func() {
+		cancel()
+		s.NoError(<-stopped)
+	})
+
+	s.Require().Eventually(func() bool { return server.Addr() != nil }, time.Second, 5*time.Millisecond)
+	return server.Addr().String()
+}
+
+func (s *HL7TestSuite) exchange(address string, message string) ([]byte, error) {
+	conn, err := net.DialTimeout("tcp", address, time.Second)
+	s.Require().NoError(err)
+	defer conn.Close()
+	s.Require().NoError(conn.SetDeadline(time.Now().Add(2 * time.Second)))
+
+	if err := hl7.WriteFrame(conn, []byte(message)); err != nil {
+		return nil, err
+	}
+	return hl7.ReadFrame(bufio.NewReader(conn), hl7.DefaultMaxMessageSize)
+}
+
+func (s *HL7TestSuite) TestMLLPServer_AcceptsLoopbackByDefault() {
+	address := s.serve(nil)
+
+	ack, err := s.exchange(address, "MSH|first")
+	s.Require().NoError(err)
+	s.Equal("ack:MSH|first", string(ack))
+}
+
+func (s *HL7TestSuite) TestMLLPServer_AcceptsAllowedPeer() {
+	address := s.serve([]netip.Prefix{netip.MustParsePrefix("127.0.0.1/32")})
+
+	ack, err := s.exchange(address, "MSH|allowed")
+	s.Require().NoError(err)
+	s.Equal("ack:MSH|allowed", string(ack))
+}
+
+func (s *HL7TestSuite) TestMLLPServer_RefusesOtherPeers() {
+	// Once an allowlist is configured loopback is no longer accepted implicitly
+	address := s.serve([]netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")})
+
+	_, err := s.exchange(address, "MSH|refused")
+	s.Require().Error(err)
+
+	var netErr net.Error
+	s.False(errors.As(err, &netErr) && netErr.Timeout(), "the connection is closed instead of left open")
+}
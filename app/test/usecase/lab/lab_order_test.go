@@ -0,0 +1,330 @@
+package lab_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/log"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type LabOrderTestSuite struct {
+	suite.Suite
+	ctx context.Context
+	now time.Time
+}
+
+func TestLabOrderSuite(t *testing.T) {
+	suite.Run(t, new(LabOrderTestSuite))
+}
+
+func (s *LabOrderTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.now = time.Date(2030, time.March, 4, 11, 0, 0, 0, time.UTC)
+}
+
+func (s *LabOrderTestSuite) order(status enum.LabOrderStatus) *medical.LabOrder {
+	return medical.NewLabOrderBuilder().
+		WithID(vo.NewLabOrderID(7)).
+		WithSessionID(vo.NewMedSessionID(3)).
+		WithPetID(vo.NewPetID(1)).
+		WithOrderedBy(vo.NewEmployeeID(4)).
+		WithLab("IDEXX", nil).
+		WithPanels([]medical.LabOrderPanel{{Code: "CHEM10", Name: "Chemistry 10"}}).
+		WithStatus(status).
+		WithDates(s.now.Add(-24*time.Hour), nil).
+		Build()
+}
+
+// alt is a numeric ALT result within a 10 to 125 U/L reference range
+func (s *LabOrderTestSuite) alt(value float64) medical.LabResultEntry {
+	return medical.LabResultEntry{
+		PanelCode:      "chem10",
+		AnalyteCode:    " alt ",
+		AnalyteName:    "Alanine aminotransferase",
+		NumericValue:   &value,
+		Unit:           "U/L",
+		ReferenceRange: vo.NewClosedLabReferenceRange(10, 125),
+		ObservedAt:     s.now.Add(-time.Hour),
+	}
+}
+
+func labFlag(flag enum.LabResultFlag) *enum.LabResultFlag { return &flag }
+
+func value(v float64) *float64 { return &v }
+
+func text(t string) *string { return &t }
+
+func (s *LabOrderTestSuite) TestClassify() {
+	testCases := []struct {
+		name     string
+		low      *float64
+		high     *float64
+		value    float64
+		expected *enum.LabResultFlag
+	}{
+		{"below", value(10), value(125), 9.9, labFlag(enum.LabResultFlagLow)},
+		{"lower end", value(10), value(125), 10, labFlag(enum.LabResultFlagNormal)},
+		{"upper end", value(10), value(125), 125, labFlag(enum.LabResultFlagNormal)},
+		{"above", value(10), value(125), 126, labFlag(enum.LabResultFlagHigh)},
+		{"upper limit only", nil, value(5), 0, labFlag(enum.LabResultFlagNormal)},
+		{"above the upper limit only", nil, value(5), 6, labFlag(enum.LabResultFlagHigh)},
+		{"lower limit only", value(2), nil, 1, labFlag(enum.LabResultFlagLow)},
+		{"no range", nil, nil, 50, nil},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Equal(tc.expected, vo.NewLabReferenceRange(tc.low, tc.high).Classify(tc.value))
+		})
+	}
+}
+
+func (s *LabOrderTestSuite) TestRecordResults_Flags() {
+	testCases := []struct {
+		name     string
+		entry    func() medical.LabResultEntry
+		expected *enum.LabResultFlag
+	}{
+		{"within range", func() medical.LabResultEntry { return s.alt(60) }, labFlag(enum.LabResultFlagNormal)},
+		{"range overrides the lab flag", func() medical.LabResultEntry {
+			entry := s.alt(300)
+			entry.Flag = labFlag(enum.LabResultFlagNormal)
+			return entry
+		}, labFlag(enum.LabResultFlagHigh)},
+		{"lab flag kept without range", func() medical.LabResultEntry {
+			entry := s.alt(300)
+			entry.ReferenceRange = vo.LabReferenceRange{}
+			entry.Flag = labFlag(enum.LabResultFlagHigh)
+			return entry
+		}, labFlag(enum.LabResultFlagHigh)},
+		{"qualitative result", func() medical.LabResultEntry {
+			return medical.LabResultEntry{AnalyteCode: "FELV", AnalyteName: "FeLV antigen", TextValue: text("Positive"), Flag: labFlag(enum.LabResultFlagAbnormal)}
+		}, labFlag(enum.LabResultFlagAbnormal)},
+		{"no range and no flag", func() medical.LabResultEntry {
+			entry := s.alt(60)
+			entry.ReferenceRange = vo.LabReferenceRange{}
+			return entry
+		}, nil},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			order := s.order(enum.LabOrderStatusOrdered)
+
+			s.Require().NoError(order.RecordResults(s.ctx, []medical.LabResultEntry{tc.entry()}, false, s.now))
+
+			s.Require().Len(order.Results(), 1)
+			s.Equal(tc.expected, order.Results()[0].Flag())
+			s.Equal(tc.expected != nil && tc.expected.IsAbnormal(), order.HasAbnormalResults())
+		})
+	}
+}
+
+func (s *LabOrderTestSuite) TestRecordResults_PartialThenFinal() {
+	order := s.order(enum.LabOrderStatusOrdered)
+
+	s.Require().NoError(order.RecordResults(s.ctx, []medical.LabResultEntry{s.alt(300)}, false, s.now))
+	s.Equal(enum.LabOrderStatusPartial, order.Status())
+	s.Nil(order.ResultedAt())
+	s.True(order.HasAbnormalResults())
+
+	correction := s.alt(80)
+	correction.AnalyteCode = "ALT"
+	s.Require().NoError(order.RecordResults(s.ctx, []medical.LabResultEntry{correction}, true, s.now))
+
+	s.Equal(enum.LabOrderStatusCompleted, order.Status())
+	s.Equal(&s.now, order.ResultedAt())
+	s.Require().Len(order.Results(), 1, "the correction replaces the first result")
+	s.Equal("ALT", order.Results()[0].AnalyteCode())
+	s.Equal("CHEM10", order.Results()[0].PanelCode())
+	s.InDelta(80, *order.Results()[0].NumericValue(), 1e-9)
+	s.False(order.HasAbnormalResults())
+}
+
+func (s *LabOrderTestSuite) TestRecordResults_FinalWithoutNewResults() {
+	order := s.order(enum.LabOrderStatusOrdered)
+	s.Error(order.RecordResults(s.ctx, nil, true, s.now), "nothing was reported")
+
+	s.Require().NoError(order.RecordResults(s.ctx, []medical.LabResultEntry{s.alt(60)}, false, s.now))
+	s.Require().NoError(order.RecordResults(s.ctx, nil, true, s.now))
+	s.Equal(enum.LabOrderStatusCompleted, order.Status())
+}
+
+func (s *LabOrderTestSuite) TestRecordResults_Rejected() {
+	testCases := []struct {
+		name   string
+		status enum.LabOrderStatus
+		change func(*medical.LabResultEntry)
+	}{
+		{"no analyte code", enum.LabOrderStatusOrdered, func(e *medical.LabResultEntry) { e.AnalyteCode = " " }},
+		{"no analyte name", enum.LabOrderStatusOrdered, func(e *medical.LabResultEntry) { e.AnalyteName = "" }},
+		{"no value", enum.LabOrderStatusOrdered, func(e *medical.LabResultEntry) { e.NumericValue = nil; e.TextValue = text(" ") }},
+		{"text value too long", enum.LabOrderStatusOrdered, func(e *medical.LabResultEntry) { e.TextValue = text(strings.Repeat("x", 101)) }},
+		{"unit too long", enum.LabOrderStatusOrdered, func(e *medical.LabResultEntry) { e.Unit = strings.Repeat("x", 21) }},
+		{"unknown flag", enum.LabOrderStatusOrdered, func(e *medical.LabResultEntry) { e.Flag = labFlag("critical") }},
+		{"reference range upside down", enum.LabOrderStatusOrdered, func(e *medical.LabResultEntry) {
+			e.ReferenceRange = vo.NewClosedLabReferenceRange(125, 10)
+		}},
+		{"observed in the future", enum.LabOrderStatusOrdered, func(e *medical.LabResultEntry) { e.ObservedAt = s.now.Add(time.Minute) }},
+		{"order completed", enum.LabOrderStatusCompleted, func(*medical.LabResultEntry) {}},
+		{"order cancelled", enum.LabOrderStatusCancelled, func(*medical.LabResultEntry) {}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			order := s.order(tc.status)
+			entry := s.alt(60)
+			tc.change(&entry)
+
+			s.Error(order.RecordResults(s.ctx, []medical.LabResultEntry{entry}, false, s.now))
+			s.Empty(order.Results())
+			s.Equal(tc.status, order.Status())
+		})
+	}
+}
+
+func (s *LabOrderTestSuite) TestRecordResults_ObservedNowByDefault() {
+	order := s.order(enum.LabOrderStatusOrdered)
+	entry := s.alt(60)
+	entry.ObservedAt = time.Time{}
+
+	s.Require().NoError(order.RecordResults(s.ctx, []medical.LabResultEntry{entry}, false, s.now))
+	s.Equal(s.now, order.Results()[0].ObservedAt())
+}
+
+func (s *LabOrderTestSuite) TestCancel() {
+	testCases := []struct {
+		name   string
+		status enum.LabOrderStatus
+		reason string
+		valid  bool
+	}{
+		{"before any result", enum.LabOrderStatusOrdered, "Sample haemolysed", true},
+		{"without a reason", enum.LabOrderStatusOrdered, "  ", false},
+		{"with partial results", enum.LabOrderStatusPartial, "Sample haemolysed", false},
+		{"completed", enum.LabOrderStatusCompleted, "Sample haemolysed", false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			order := s.order(tc.status)
+
+			err := order.Cancel(s.ctx, tc.reason, s.now)
+			if !tc.valid {
+				s.Error(err)
+				s.Equal(tc.status, order.Status())
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Equal(enum.LabOrderStatusCancelled, order.Status())
+			s.Equal(&s.now, order.CancelledAt())
+			s.Equal(tc.reason, *order.CancelReason())
+		})
+	}
+}
+
+func (s *LabOrderTestSuite) TestOrderLabWork() {
+	session := medical.NewMedicalSessionBuilder().
+		WithID(vo.NewMedSessionID(3)).
+		WithPetDetails(*medical.NewPetSessionSummaryBuilder().WithPetID(vo.NewPetID(1)).Build()).
+		Build()
+	cbc := medical.LabOrderPanel{Code: "CBC", Name: "Complete blood count"}
+	tooMany := make([]medical.LabOrderPanel, 0, medical.MaxLabPanelsPerOrder+1)
+	for i := 0; i <= medical.MaxLabPanelsPerOrder; i++ {
+		tooMany = append(tooMany, medical.LabOrderPanel{Code: "P" + strings.Repeat("X", i), Name: "Panel"})
+	}
+
+	testCases := []struct {
+		name    string
+		labName string
+		panels  []medical.LabOrderPanel
+		valid   bool
+	}{
+		{"one panel", "IDEXX", []medical.LabOrderPanel{cbc}, true},
+		{"no panels", "IDEXX", nil, false},
+		{"too many panels", "IDEXX", tooMany, false},
+		{"panel ordered twice", "IDEXX", []medical.LabOrderPanel{cbc, {Code: "cbc", Name: "CBC"}}, false},
+		{"panel without name", "IDEXX", []medical.LabOrderPanel{{Code: "CBC"}}, false},
+		{"no laboratory", "  ", []medical.LabOrderPanel{cbc}, false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			order, err := medical.OrderLabWork(s.ctx, *session, vo.NewEmployeeID(4), tc.labName, text(" "), tc.panels, nil, s.now)
+			if !tc.valid {
+				s.Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Equal(enum.LabOrderStatusOrdered, order.Status())
+			s.Equal(vo.NewPetID(1), order.PetID())
+			s.Equal(vo.NewMedSessionID(3), order.SessionID())
+			s.Nil(order.AccessionNumber(), "blank accession numbers are dropped")
+			s.Equal(s.now, order.OrderedAt())
+		})
+	}
+}
+
+func (s *LabOrderTestSuite) TestNewLabAnalyteTrend() {
+	result := func(id uint, daysAgo int, numeric *float64, textValue *string, flag *enum.LabResultFlag) medical.LabResult {
+		return medical.RestoreLabResult(vo.NewLabResultID(id), vo.NewLabOrderID(id), vo.NewPetID(1), medical.LabResultEntry{
+			AnalyteCode:  "CREA",
+			AnalyteName:  "Creatinine",
+			NumericValue: numeric,
+			TextValue:    textValue,
+			Unit:         "mg/dL",
+			Flag:         flag,
+			ObservedAt:   s.now.AddDate(0, 0, -daysAgo),
+		})
+	}
+
+	results := []medical.LabResult{
+		result(3, 10, value(3), nil, labFlag(enum.LabResultFlagHigh)),
+		result(1, 90, value(2), nil, labFlag(enum.LabResultFlagNormal)),
+		result(4, 1, value(3.6), nil, labFlag(enum.LabResultFlagHigh)),
+		result(2, 30, nil, text("Hemolysed"), nil),
+	}
+
+	trend := medical.NewLabAnalyteTrend(vo.NewPetID(1), "CREA", results)
+
+	order := []uint{}
+	changes := []*float64{}
+	for _, point := range trend.Points {
+		order = append(order, point.ResultID.Value())
+		changes = append(changes, point.ChangePercent)
+	}
+	s.Equal([]uint{1, 2, 3, 4}, order, "oldest first")
+	s.Equal([]*float64{nil, nil, value(50), value(20)}, changes, "the text result is skipped")
+
+	s.Equal("Creatinine", trend.AnalyteName)
+	s.InDelta(2, *trend.Min, 1e-9)
+	s.InDelta(3.6, *trend.Max, 1e-9)
+	s.InDelta(2.87, *trend.Average, 1e-9)
+	s.InDelta(80, *trend.ChangePercent, 1e-9)
+	s.Equal(2, trend.AbnormalCount)
+}
+
+func (s *LabOrderTestSuite) TestNewLabAnalyteTrend_NoNumericResults() {
+	trend := medical.NewLabAnalyteTrend(vo.NewPetID(1), "FELV", []medical.LabResult{
+		medical.RestoreLabResult(vo.NewLabResultID(1), vo.NewLabOrderID(1), vo.NewPetID(1), medical.LabResultEntry{
+			AnalyteCode: "FELV", AnalyteName: "FeLV antigen", TextValue: text("Negative"), ObservedAt: s.now,
+		}),
+	})
+
+	s.Len(trend.Points, 1)
+	s.Nil(trend.Min)
+	s.Nil(trend.Average)
+	s.Nil(trend.ChangePercent)
+}
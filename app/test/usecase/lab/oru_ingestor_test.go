@@ -0,0 +1,154 @@
+package lab_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	domainerr "clinic-vet-api/app/modules/core/error"
+	"clinic-vet-api/app/modules/medical/lab/application"
+	"clinic-vet-api/app/modules/medical/lab/application/command"
+	"clinic-vet-api/app/modules/medical/lab/presentation/oru"
+	"clinic-vet-api/app/shared/cqrs"
+	"clinic-vet-api/app/shared/hl7"
+	"clinic-vet-api/app/shared/log"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+// fakeLabService records the ingested command and answers with the configured result
+type fakeLabService struct {
+	application.LabFacadeService
+	ingested []command.IngestLabResultsCommand
+	result   cqrs.CommandResult
+}
+
+func (f *fakeLabService) IngestLabResults(ctx context.Context, cmd command.IngestLabResultsCommand) cqrs.CommandResult {
+	f.ingested = append(f.ingested, cmd)
+	return f.result
+}
+
+type ORUIngestorTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	labService *fakeLabService
+	ingestor   *oru.Ingestor
+}
+
+func TestORUIngestorSuite(t *testing.T) {
+	suite.Run(t, new(ORUIngestorTestSuite))
+}
+
+func (s *ORUIngestorTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.labService = &fakeLabService{result: cqrs.SuccessCreateResult("7", "lab results recorded")}
+	s.ingestor = oru.NewIngestor(s.labService)
+}
+
+// ingest runs the message through the ingestor and parses the acknowledgment sent back
+func (s *ORUIngestorTestSuite) ingest(raw string) (hl7.Ack, hl7.Segment, *hl7.Message) {
+	encoded, ack := s.ingestor.Ingest(s.ctx, []byte(raw))
+
+	reply, err := hl7.Parse(encoded)
+	s.Require().NoError(err)
+	acknowledgment, exists := reply.First("MSA")
+	s.Require().True(exists)
+	s.Equal(string(ack.Code), acknowledgment.Value(1), "the encoded code matches the outcome")
+	return ack, acknowledgment, reply
+}
+
+func (s *ORUIngestorTestSuite) TestIngest_AcceptsResults() {
+	ack, acknowledgment, _ := s.ingest(oruMessage)
+
+	s.True(ack.IsAccepted())
+	s.Equal("MSG001", acknowledgment.Value(2))
+	s.Require().Len(s.labService.ingested, 1)
+
+	cmd := s.labService.ingested[0]
+	s.Require().NotNil(cmd.AccessionNumber())
+	s.Equal("ACC-1", *cmd.AccessionNumber())
+	s.Require().NotNil(cmd.PetID())
+	s.Equal(uint(42), cmd.PetID().Value())
+	s.Equal([]string{"CBC"}, cmd.PanelCodes())
+	s.True(cmd.IsFinal())
+
+	entries := cmd.Entries()
+	s.Require().Len(entries, 2)
+	s.Equal("WBC", entries[0].AnalyteCode)
+	s.Require().NotNil(entries[0].NumericValue)
+	s.Equal(12.5, *entries[0].NumericValue)
+	s.Require().NotNil(entries[1].TextValue)
+	s.Equal("Lipemic & hemolyzed", *entries[1].TextValue)
+}
+
+func (s *ORUIngestorTestSuite) TestIngest_RejectsUnreadableMessage() {
+	ack, _, reply := s.ingest("not hl7 at all")
+
+	s.Equal(hl7.AckReject, ack.Code)
+	s.Equal(hl7.ErrorSegmentSequence, ack.ErrorCode)
+	_, hasError := reply.First("ERR")
+	s.True(hasError)
+	s.Empty(s.labService.ingested)
+}
+
+func (s *ORUIngestorTestSuite) TestIngest_RejectsOtherMessageTypes() {
+	ack, _, _ := s.ingest(strings.Replace(oruMessage, "ORU^R01", "ADT^A01", 1))
+
+	s.Equal(hl7.AckReject, ack.Code)
+	s.Equal(hl7.ErrorUnsupportedMessageType, ack.ErrorCode)
+	s.Empty(s.labService.ingested)
+}
+
+func (s *ORUIngestorTestSuite) TestIngest_RejectsOtherVersions() {
+	ack, _, _ := s.ingest(strings.Replace(oruMessage, "|P|2.5.1", "|P|3.0", 1))
+
+	s.Equal(hl7.AckReject, ack.Code)
+	s.Equal(hl7.ErrorUnsupportedVersion, ack.ErrorCode)
+}
+
+func (s *ORUIngestorTestSuite) TestIngest_RejectsObservationBeforeRequest() {
+	raw := "MSH|^~\\&|ANALYZER|LAB|VETAPI|CLINIC|20300304101500||ORU^R01|MSG002|P|2.5.1\r" +
+		"OBX|1|NM|WBC^White cells||12.5\r" +
+		"OBR|1||ACC-1|CBC\r"
+
+	ack, _, _ := s.ingest(raw)
+
+	s.Equal(hl7.AckReject, ack.Code)
+	s.Equal(hl7.ErrorSegmentSequence, ack.ErrorCode)
+}
+
+func (s *ORUIngestorTestSuite) TestIngest_FailsOnNonNumericValue() {
+	ack, acknowledgment, _ := s.ingest(strings.Replace(oruMessage, "||12.5|", "||twelve|", 1))
+
+	s.Equal(hl7.AckError, ack.Code)
+	s.Equal(hl7.ErrorDataTypeError, ack.ErrorCode)
+	s.Contains(acknowledgment.Value(3), "not numeric")
+	s.Empty(s.labService.ingested)
+}
+
+func (s *ORUIngestorTestSuite) TestIngest_FailsWithoutOrderReference() {
+	raw := "MSH|^~\\&|ANALYZER|LAB|VETAPI|CLINIC|20300304101500||ORU^R01|MSG003|P|2.5.1\r" +
+		"OBR|1|||CBC\r" +
+		"OBX|1|NM|WBC||12.5\r"
+
+	ack, _, _ := s.ingest(raw)
+
+	s.Equal(hl7.AckError, ack.Code)
+	s.Equal(hl7.ErrorRequiredFieldMissing, ack.ErrorCode)
+}
+
+func (s *ORUIngestorTestSuite) TestIngest_ReportsServiceFailure() {
+	s.labService.result = cqrs.FailureResult("failed to ingest lab results", domainerr.BaseDomainError{
+		Code:    "ENTITY_NOT_FOUND",
+		Message: "no open lab order for ACC-1",
+	})
+
+	ack, acknowledgment, _ := s.ingest(oruMessage)
+
+	s.Equal(hl7.AckError, ack.Code)
+	s.Equal(hl7.ErrorUnknownKeyIdentifier, ack.ErrorCode)
+	s.Contains(acknowledgment.Value(3), "no open lab order")
+}
@@ -0,0 +1,168 @@
+package medical_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/calendar"
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/log"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type FollowUpTestSuite struct {
+	suite.Suite
+	ctx      context.Context
+	visitDay time.Time
+	calendar calendar.ClinicCalendar
+}
+
+func TestFollowUpSuite(t *testing.T) {
+	suite.Run(t, new(FollowUpTestSuite))
+}
+
+func (s *FollowUpTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	now := time.Now()
+	s.visitDay = time.Date(now.Year(), now.Month(), now.Day(), 10, 0, 0, 0, now.Location())
+
+	// Open every day from 8 to 20, Sundays closed
+	hours := make([]calendar.OpeningHours, 0, 7)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		hours = append(hours, calendar.OpeningHours{Day: day, OpenHour: 8, CloseHour: 20, IsClosed: day == time.Sunday})
+	}
+	s.calendar = calendar.NewClinicCalendar(hours, nil, calendar.BookingPolicy{MinLeadDays: 0, MaxLeadDays: 365})
+}
+
+// nextOpenDay returns the first day at least days after the visit the clinic opens, at 10:00
+func (s *FollowUpTestSuite) nextOpenDay(days int) time.Time {
+	date := s.visitDay.AddDate(0, 0, days)
+	if date.Weekday() == time.Sunday {
+		date = date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+func (s *FollowUpTestSuite) session(visitType enum.VisitType, followUpDate *time.Time) *medical.MedicalSession {
+	session := medical.NewMedicalSessionBuilder().
+		WithID(vo.NewMedSessionID(3)).
+		WithCustomerID(vo.NewCustomerID(2)).
+		WithEmployeeID(vo.NewEmployeeID(4)).
+		WithVisitType(visitType).
+		WithVisitDate(s.visitDay).
+		WithPetDetails(*medical.NewPetSessionSummaryBuilder().WithPetID(vo.NewPetID(1)).Build()).
+		Build()
+	s.Require().NoError(session.ScheduleFollowUp(s.ctx, followUpDate))
+	return session
+}
+
+func (s *FollowUpTestSuite) TestScheduleFollowUp() {
+	session := s.session(enum.VisitTypeConsultation, nil)
+	sameDay := s.visitDay
+	before := s.visitDay.AddDate(0, 0, -1)
+	later := s.nextOpenDay(7)
+
+	s.Error(session.ScheduleFollowUp(s.ctx, &before))
+	s.Error(session.ScheduleFollowUp(s.ctx, &sameDay), "the follow-up comes after the visit")
+	s.Require().NoError(session.ScheduleFollowUp(s.ctx, &later))
+	s.Equal(&later, session.PetDetails().FollowUpDate())
+	s.Require().NoError(session.ScheduleFollowUp(s.ctx, nil))
+	s.Nil(session.PetDetails().FollowUpDate())
+}
+
+func (s *FollowUpTestSuite) TestProposeFollowUp() {
+	testCases := []struct {
+		visitType enum.VisitType
+		service   enum.ClinicService
+	}{
+		{enum.VisitTypeSurgery, enum.ClinicServiceSurgery},
+		{enum.VisitTypeVaccination, enum.ClinicServiceVaccination},
+		{enum.VisitTypePhysicalExam, enum.ClinicServiceWellnessExam},
+		{enum.VisitTypeConsultation, enum.ClinicServiceGeneralConsultation},
+		{enum.VisitTypeEmergencyVisit, enum.ClinicServiceGeneralConsultation},
+	}
+
+	for _, tc := range testCases {
+		s.Run(string(tc.visitType), func() {
+			followUpDate := s.nextOpenDay(7)
+
+			proposal, err := medical.ProposeFollowUp(s.ctx, *s.session(tc.visitType, &followUpDate), s.calendar)
+
+			s.Require().NoError(err)
+			s.Equal(tc.service, proposal.Service())
+			s.Equal(enum.AppointmentStatusPending, proposal.Status())
+			s.Equal(followUpDate, proposal.ScheduledDate())
+			s.Equal(vo.NewPetID(1), proposal.PetID())
+			s.Equal(vo.NewCustomerID(2), proposal.CustomerID())
+			s.Require().NotNil(proposal.EmployeeID())
+			s.Equal(vo.NewEmployeeID(4), *proposal.EmployeeID())
+		})
+	}
+}
+
+func (s *FollowUpTestSuite) TestProposeFollowUp_Rejected() {
+	_, err := medical.ProposeFollowUp(s.ctx, *s.session(enum.VisitTypeConsultation, nil), s.calendar)
+	s.Error(err, "no follow-up date")
+
+	sunday := s.visitDay.AddDate(0, 0, 7+int(time.Sunday-s.visitDay.Weekday()+7)%7)
+	_, err = medical.ProposeFollowUp(s.ctx, *s.session(enum.VisitTypeConsultation, &sunday), s.calendar)
+	s.Error(err, "the clinic is closed")
+
+	evening := s.nextOpenDay(7).Add(10 * time.Hour)
+	_, err = medical.ProposeFollowUp(s.ctx, *s.session(enum.VisitTypeConsultation, &evening), s.calendar)
+	s.Error(err, "after closing time")
+}
+
+func (s *FollowUpTestSuite) proposal(status enum.AppointmentStatus, scheduledDate time.Time) *appt.Appointment {
+	vetID := vo.NewEmployeeID(4)
+	return appt.NewAppointmentBuilder().
+		WithID(vo.NewAppointmentID(8)).
+		WithCustomerID(vo.NewCustomerID(2)).
+		WithPetID(vo.NewPetID(1)).
+		WithEmployeeID(&vetID).
+		WithService(enum.ClinicServiceGeneralConsultation).
+		WithScheduledDate(scheduledDate).
+		WithStatus(status).
+		Build()
+}
+
+func (s *FollowUpTestSuite) TestMoveFollowUp() {
+	booked := s.nextOpenDay(7)
+	moved := s.nextOpenDay(14)
+
+	testCases := []struct {
+		name         string
+		status       enum.AppointmentStatus
+		followUpDate *time.Time
+		changed      bool
+		expectedDate time.Time
+		cancelled    bool
+	}{
+		{"same date", enum.AppointmentStatusPending, &booked, false, booked, false},
+		{"pending moved", enum.AppointmentStatusPending, &moved, true, moved, false},
+		{"confirmed moved", enum.AppointmentStatusConfirmed, &moved, true, moved, false},
+		{"cleared", enum.AppointmentStatusConfirmed, nil, true, booked, true},
+		{"cleared and already cancelled", enum.AppointmentStatusCancelled, nil, false, booked, true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			proposal := s.proposal(tc.status, booked)
+
+			changed, err := medical.MoveFollowUp(s.ctx, *s.session(enum.VisitTypeConsultation, tc.followUpDate), proposal, s.calendar)
+
+			s.Require().NoError(err)
+			s.Equal(tc.changed, changed)
+			s.Equal(tc.expectedDate, proposal.ScheduledDate())
+			s.Equal(tc.cancelled, proposal.Status() == enum.AppointmentStatusCancelled)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package medical_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appt "clinic-vet-api/app/modules/core/domain/entity/appointment"
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/shared/log"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type SessionDraftTestSuite struct {
+	suite.Suite
+	ctx context.Context
+	now time.Time
+}
+
+func TestSessionDraftSuite(t *testing.T) {
+	suite.Run(t, new(SessionDraftTestSuite))
+}
+
+func (s *SessionDraftTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.now = time.Date(2030, time.March, 4, 11, 0, 0, 0, time.UTC)
+}
+
+func (s *SessionDraftTestSuite) visit(id uint, status enum.AppointmentStatus) *appt.Appointment {
+	vetID := vo.NewEmployeeID(4)
+	return appt.NewAppointmentBuilder().
+		WithID(vo.NewAppointmentID(id)).
+		WithCustomerID(vo.NewCustomerID(2)).
+		WithPetID(vo.NewPetID(1)).
+		WithEmployeeID(&vetID).
+		WithService(enum.ClinicServiceGeneralConsultation).
+		WithScheduledDate(s.now).
+		WithStatus(status).
+		Build()
+}
+
+func (s *SessionDraftTestSuite) draft(visit *appt.Appointment) *medical.MedicalSession {
+	session, err := medical.OpenAppointmentSession(s.ctx, *visit)
+	s.Require().NoError(err)
+	return session
+}
+
+func (s *SessionDraftTestSuite) TestOpenAppointmentSession_OpensDraft() {
+	session := s.draft(s.visit(9, enum.AppointmentStatusConfirmed))
+
+	s.True(session.IsDraft())
+	s.Nil(session.ClosedAt())
+	s.Equal(vo.NewPetID(1), session.PetDetails().PetID())
+	s.Require().NotNil(session.AppointmentID())
+	s.Equal(vo.NewAppointmentID(9), *session.AppointmentID())
+}
+
+func (s *SessionDraftTestSuite) TestRecordOutcome_KeepsEarlierValues() {
+	session := s.draft(s.visit(9, enum.AppointmentStatusConfirmed))
+	notes := "Recheck in two weeks"
+
+	s.Require().NoError(session.RecordOutcome(s.ctx, "Otitis", "Ear drops", enum.PetConditionStable, nil))
+	s.Require().NoError(session.RecordOutcome(s.ctx, "", "Ear drops twice a day", "", &notes))
+
+	details := session.PetDetails()
+	s.Equal("Otitis", details.Diagnosis())
+	s.Equal("Ear drops twice a day", details.Treatment())
+	s.Equal(enum.PetConditionStable, details.Condition())
+	s.Equal(&notes, session.Notes())
+
+	s.Error(session.RecordOutcome(s.ctx, "", "", "sleepy", nil), "unknown condition")
+}
+
+func (s *SessionDraftTestSuite) TestClose() {
+	testCases := []struct {
+		name       string
+		diagnosis  string
+		closeTwice bool
+		valid      bool
+	}{
+		{"with a diagnosis", "Otitis", false, true},
+		{"without a diagnosis", "", false, false},
+		{"already closed", "Otitis", true, false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			session := s.draft(s.visit(9, enum.AppointmentStatusConfirmed))
+			s.Require().NoError(session.RecordOutcome(s.ctx, tc.diagnosis, "", "", nil))
+			if tc.closeTwice {
+				s.Require().NoError(session.Close(s.ctx, s.now))
+			}
+
+			err := session.Close(s.ctx, s.now)
+			if !tc.valid {
+				s.Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Equal(enum.MedSessionStatusClosed, session.Status())
+			s.Equal(&s.now, session.ClosedAt())
+			s.Error(session.RecordOutcome(s.ctx, "Changed", "", "", nil), "closed sessions are final")
+		})
+	}
+}
+
+func (s *SessionDraftTestSuite) TestCloseVisit_CompletesAppointment() {
+	visit := s.visit(9, enum.AppointmentStatusConfirmed)
+	session := s.draft(visit)
+	s.Require().NoError(session.RecordOutcome(s.ctx, "Otitis", "", "", nil))
+
+	s.Require().NoError(medical.CloseVisit(s.ctx, session, visit, s.now))
+
+	s.False(session.IsDraft())
+	s.Equal(enum.AppointmentStatusCompleted, visit.Status())
+}
+
+func (s *SessionDraftTestSuite) TestCloseVisit_AppointmentAlreadyCompleted() {
+	visit := s.visit(9, enum.AppointmentStatusConfirmed)
+	session := s.draft(visit)
+	s.Require().NoError(session.RecordOutcome(s.ctx, "Otitis", "", "", nil))
+	s.Require().NoError(visit.Complete(s.ctx))
+
+	s.Require().NoError(medical.CloseVisit(s.ctx, session, visit, s.now))
+	s.False(session.IsDraft())
+}
+
+func (s *SessionDraftTestSuite) TestCloseVisit_RejectsOtherAppointment() {
+	session := s.draft(s.visit(9, enum.AppointmentStatusConfirmed))
+	s.Require().NoError(session.RecordOutcome(s.ctx, "Otitis", "", "", nil))
+	other := s.visit(10, enum.AppointmentStatusConfirmed)
+
+	s.Error(medical.CloseVisit(s.ctx, session, other, s.now))
+	s.True(session.IsDraft(), "nothing is closed")
+	s.Equal(enum.AppointmentStatusConfirmed, other.Status())
+}
+
+func (s *SessionDraftTestSuite) TestCloseVisit_WithoutAppointment() {
+	session := s.draft(s.visit(9, enum.AppointmentStatusConfirmed))
+	s.Require().NoError(session.RecordOutcome(s.ctx, "Otitis", "", "", nil))
+
+	s.Require().NoError(medical.CloseVisit(s.ctx, session, nil, s.now))
+	s.False(session.IsDraft())
+}
@@ -0,0 +1,163 @@
+package medical_test
+
+import (
+	"testing"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/service"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type VitalFlagsTestSuite struct {
+	suite.Suite
+	catalog *service.VitalRangeCatalog
+}
+
+func TestVitalFlagsSuite(t *testing.T) {
+	suite.Run(t, new(VitalFlagsTestSuite))
+}
+
+func (s *VitalFlagsTestSuite) SetupTest() {
+	s.catalog = service.NewVitalRangeCatalog()
+}
+
+func (s *VitalFlagsTestSuite) sessionWith(v vitals) *medical.MedicalSession {
+	return medical.NewMedicalSessionBuilder().WithPetDetails(petDetailsWith(vo.NewPetID(1), v)).Build()
+}
+
+func flag(f enum.VitalFlag) *enum.VitalFlag { return &f }
+
+func (s *VitalFlagsTestSuite) TestClassify() {
+	vitalRange := vo.NewVitalRange(37.5, 39.2)
+
+	testCases := []struct {
+		name     string
+		value    float64
+		expected enum.VitalFlag
+	}{
+		{"below", 37.4, enum.VitalFlagLow},
+		{"lower end", 37.5, enum.VitalFlagNormal},
+		{"within", 38.6, enum.VitalFlagNormal},
+		{"upper end", 39.2, enum.VitalFlagNormal},
+		{"above", 39.3, enum.VitalFlagHigh},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Equal(tc.expected, vitalRange.Classify(tc.value))
+		})
+	}
+}
+
+func (s *VitalFlagsTestSuite) TestGetRanges() {
+	testCases := []struct {
+		name           string
+		species        enum.PetSpecies
+		lifeStage      string
+		found          bool
+		expectedStage  string
+		temperatureMax float64
+	}{
+		{"own life stage", enum.PetSpeciesDog, service.LifeStageBaby, true, service.LifeStageBaby, 39.4},
+		{"adult", enum.PetSpeciesDog, service.LifeStageAdult, true, service.LifeStageAdult, 39.2},
+		{"falls back to adult", enum.PetSpeciesDog, service.LifeStageSenior, true, service.LifeStageAdult, 39.2},
+		{"unknown life stage", enum.PetSpeciesCat, service.LifeStageUnknown, true, service.LifeStageAdult, 39.2},
+		{"species with adult ranges only", enum.PetSpeciesRabbit, service.LifeStageYoung, true, service.LifeStageAdult, 40},
+		{"species not covered", enum.PetSpeciesFish, service.LifeStageAdult, false, "", 0},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ranges, found := s.catalog.GetRanges(tc.species, tc.lifeStage)
+
+			s.Equal(tc.found, found)
+			if !found {
+				return
+			}
+			s.Equal(tc.species, ranges.Species())
+			s.Equal(tc.expectedStage, ranges.LifeStage())
+			s.InDelta(tc.temperatureMax, ranges.Temperature().Max(), 1e-9)
+		})
+	}
+}
+
+func (s *VitalFlagsTestSuite) TestAnnotateVitals() {
+	testCases := []struct {
+		name        string
+		species     enum.PetSpecies
+		vitals      vitals
+		expected    medical.VitalFlags
+		hasAbnormal bool
+	}{
+		{
+			name:    "every vital within range",
+			species: enum.PetSpeciesCat,
+			vitals:  vitals{weight: 4, temperature: 38.5, heartRate: 180, respiratory: 25},
+			expected: medical.VitalFlags{
+				Weight: flag(enum.VitalFlagNormal), Temperature: flag(enum.VitalFlagNormal),
+				HeartRate: flag(enum.VitalFlagNormal), RespiratoryRate: flag(enum.VitalFlagNormal),
+			},
+		},
+		{
+			name:    "low and high vitals",
+			species: enum.PetSpeciesCat,
+			vitals:  vitals{weight: 8, temperature: 38.5, heartRate: 130},
+			expected: medical.VitalFlags{
+				Weight: flag(enum.VitalFlagHigh), Temperature: flag(enum.VitalFlagNormal), HeartRate: flag(enum.VitalFlagLow),
+			},
+			hasAbnormal: true,
+		},
+		{
+			name:    "no weight range for dogs",
+			species: enum.PetSpeciesDog,
+			vitals:  vitals{weight: 45, temperature: 40},
+			expected: medical.VitalFlags{
+				Temperature: flag(enum.VitalFlagHigh),
+			},
+			hasAbnormal: true,
+		},
+		{
+			name:     "no vitals taken",
+			species:  enum.PetSpeciesDog,
+			expected: medical.VitalFlags{},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ranges, found := s.catalog.GetRanges(tc.species, service.LifeStageAdult)
+			s.Require().True(found)
+			session := s.sessionWith(tc.vitals)
+
+			session.AnnotateVitals(ranges)
+
+			flags := session.PetDetails().VitalFlags()
+			s.Equal(tc.expected, flags)
+			s.Equal(tc.hasAbnormal, flags.HasAbnormal())
+		})
+	}
+}
+
+func (s *VitalFlagsTestSuite) TestClearVitalFlags() {
+	ranges, _ := s.catalog.GetRanges(enum.PetSpeciesCat, service.LifeStageAdult)
+	session := s.sessionWith(vitals{weight: 9})
+	session.AnnotateVitals(ranges)
+	s.Require().True(session.PetDetails().VitalFlags().HasAbnormal())
+
+	session.ClearVitalFlags()
+
+	s.Equal(medical.VitalFlags{}, session.PetDetails().VitalFlags())
+}
+
+func (s *VitalFlagsTestSuite) TestParseVitalFlag() {
+	parsed, err := enum.ParseVitalFlag("HIGH")
+	s.Require().NoError(err)
+	s.Equal(enum.VitalFlagHigh, parsed)
+	s.False(enum.VitalFlagNormal.IsAbnormal())
+
+	_, err = enum.ParseVitalFlag("critical")
+	s.Error(err)
+}
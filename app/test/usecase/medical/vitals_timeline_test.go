@@ -0,0 +1,168 @@
+package medical_test
+
+import (
+	"testing"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type VitalsTimelineTestSuite struct {
+	suite.Suite
+	petID vo.PetID
+}
+
+func TestVitalsTimelineSuite(t *testing.T) {
+	suite.Run(t, new(VitalsTimelineTestSuite))
+}
+
+func (s *VitalsTimelineTestSuite) SetupTest() {
+	s.petID = vo.NewPetID(1)
+}
+
+// vitals holds the readings of a session, zero values are not recorded
+type vitals struct {
+	weight      float64
+	temperature float64
+	heartRate   int32
+	respiratory int32
+}
+
+// petDetailsWith builds the session details of the pet with the vitals taken
+func petDetailsWith(petID vo.PetID, v vitals) medical.PetSessionSummary {
+	details := medical.NewPetSessionSummaryBuilder().WithPetID(petID)
+	if v.weight > 0 {
+		weight := vo.NewDecimalFromFloat(v.weight)
+		details.WithWeight(&weight)
+	}
+	if v.temperature > 0 {
+		temperature := vo.NewDecimalFromFloat(v.temperature)
+		details.WithTemperature(&temperature)
+	}
+	if v.heartRate > 0 {
+		details.WithHeartRate(&v.heartRate)
+	}
+	if v.respiratory > 0 {
+		details.WithRespiratoryRate(&v.respiratory)
+	}
+	return *details.Build()
+}
+
+func (s *VitalsTimelineTestSuite) session(id uint, visitDate time.Time, v vitals) medical.MedicalSession {
+	return *medical.NewMedicalSessionBuilder().
+		WithID(vo.NewMedSessionID(id)).
+		WithVisitDate(visitDate).
+		WithPetDetails(petDetailsWith(s.petID, v)).
+		Build()
+}
+
+func day(month time.Month, d int) time.Time {
+	return time.Date(2030, month, d, 10, 0, 0, 0, time.UTC)
+}
+
+func (s *VitalsTimelineTestSuite) TestNewVitalsTimeline() {
+	sessions := []medical.MedicalSession{
+		s.session(4, day(time.March, 1), vitals{weight: 19, temperature: 38, heartRate: 120}),
+		s.session(1, day(time.January, 1), vitals{weight: 20, temperature: 38.5, heartRate: 100}),
+		s.session(3, day(time.February, 15), vitals{}),
+		s.session(5, day(time.July, 1), vitals{weight: 17, respiratory: 24}),
+		s.session(2, day(time.February, 1), vitals{weight: 20.5, temperature: 39}),
+	}
+
+	timeline := medical.NewVitalsTimeline(s.petID, sessions, 2)
+
+	s.Equal(2, timeline.Window)
+	s.Require().Len(timeline.Readings, 4, "sessions without vitals are left out")
+
+	ids := []uint{}
+	for _, reading := range timeline.Readings {
+		ids = append(ids, reading.SessionID.Value())
+	}
+	s.Equal([]uint{1, 2, 4, 5}, ids, "oldest first")
+
+	first, second, third, fourth := timeline.Readings[0], timeline.Readings[1], timeline.Readings[2], timeline.Readings[3]
+
+	s.Nil(first.WeightChangePercent, "nothing to compare the first weighing with")
+	s.Nil(first.DaysSinceWeighing)
+	s.InDelta(20, *first.AvgWeight, 1e-9)
+
+	s.InDelta(2.5, *second.WeightChangePercent, 1e-9)
+	s.Equal(31, *second.DaysSinceWeighing)
+	s.InDelta(20.25, *second.AvgWeight, 1e-9)
+	s.InDelta(38.75, *second.AvgTemperature, 1e-9)
+	s.Nil(second.AvgHeartRate, "no heart rate taken")
+
+	s.InDelta(-7.3, *third.WeightChangePercent, 1e-9)
+	s.Equal(28, *third.DaysSinceWeighing)
+	s.True(third.SuddenWeightLoss)
+	s.InDelta(19.75, *third.AvgWeight, 1e-9, "the window keeps the last two weighings")
+	s.InDelta(38.5, *third.AvgTemperature, 1e-9)
+	s.InDelta(110, *third.AvgHeartRate, 1e-9)
+
+	s.InDelta(-10.5, *fourth.WeightChangePercent, 1e-9)
+	s.False(fourth.SuddenWeightLoss, "the loss is spread over four months")
+	s.InDelta(18, *fourth.AvgWeight, 1e-9)
+	s.InDelta(24, *fourth.AvgRespiratoryRate, 1e-9)
+	s.Nil(fourth.AvgTemperature)
+
+	s.Require().NotNil(timeline.WeightChangePercent)
+	s.InDelta(-15, *timeline.WeightChangePercent, 1e-9)
+	s.True(timeline.SuddenWeightLoss)
+}
+
+func (s *VitalsTimelineTestSuite) TestSuddenWeightLoss() {
+	window := int(medical.SuddenWeightLossWindow.Hours() / 24)
+
+	testCases := []struct {
+		name      string
+		lastKg    float64
+		afterDays int
+		sudden    bool
+	}{
+		{"threshold loss", 19, 30, true},
+		{"smaller loss", 19.1, 30, false},
+		{"weight gain", 22, 30, false},
+		{"at the end of the window", 19, window, true},
+		{"after the window", 15, window + 1, false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			sessions := []medical.MedicalSession{
+				s.session(1, day(time.January, 1), vitals{weight: 20}),
+				s.session(2, day(time.January, 1).AddDate(0, 0, tc.afterDays), vitals{weight: tc.lastKg}),
+			}
+
+			timeline := medical.NewVitalsTimeline(s.petID, sessions, 0)
+
+			s.Equal(tc.sudden, timeline.Readings[1].SuddenWeightLoss)
+			s.Equal(tc.sudden, timeline.SuddenWeightLoss)
+		})
+	}
+}
+
+func (s *VitalsTimelineTestSuite) TestNewVitalsTimeline_DefaultWindow() {
+	sessions := []medical.MedicalSession{
+		s.session(1, day(time.January, 1), vitals{temperature: 38}),
+		s.session(2, day(time.January, 2), vitals{temperature: 39}),
+		s.session(3, day(time.January, 3), vitals{temperature: 40}),
+		s.session(4, day(time.January, 4), vitals{temperature: 38}),
+	}
+
+	timeline := medical.NewVitalsTimeline(s.petID, sessions, 0)
+
+	s.Equal(medical.DefaultVitalsAverageWindow, timeline.Window)
+	s.InDelta(39, *timeline.Readings[3].AvgTemperature, 1e-9)
+	s.Nil(timeline.WeightChangePercent, "never weighed")
+	s.False(timeline.SuddenWeightLoss)
+}
+
+func (s *VitalsTimelineTestSuite) TestNewVitalsTimeline_NoSessions() {
+	timeline := medical.NewVitalsTimeline(s.petID, nil, 3)
+
+	s.Empty(timeline.Readings)
+	s.Nil(timeline.WeightChangePercent)
+}
@@ -0,0 +1,241 @@
+package prescription_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clinic-vet-api/app/modules/core/domain/entity/medical"
+	"clinic-vet-api/app/modules/core/domain/entity/pet"
+	"clinic-vet-api/app/modules/core/domain/enum"
+	vo "clinic-vet-api/app/modules/core/domain/valueobject"
+	"clinic-vet-api/app/modules/core/repository"
+	"clinic-vet-api/app/modules/core/service"
+	"clinic-vet-api/app/shared/log"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type fakePetRepository struct {
+	repository.PetRepository
+	pet pet.Pet
+}
+
+func (r *fakePetRepository) FindByID(ctx context.Context, petID vo.PetID) (pet.Pet, error) {
+	return r.pet, nil
+}
+
+type recentSessionRepository struct {
+	repository.MedicalSessionRepository
+	sessions []medical.MedicalSession
+}
+
+func (r *recentSessionRepository) FindRecentByPetID(ctx context.Context, petID vo.PetID, limit int) ([]medical.MedicalSession, error) {
+	return r.sessions, nil
+}
+
+type DoseCalculatorTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	pets      *fakePetRepository
+	sessions  *recentSessionRepository
+	formulary *service.DrugFormulary
+	service   *service.DoseCalculatorService
+	visitDay  time.Time
+}
+
+func TestDoseCalculatorSuite(t *testing.T) {
+	suite.Run(t, new(DoseCalculatorTestSuite))
+}
+
+func (s *DoseCalculatorTestSuite) SetupTest() {
+	log.App = zap.NewNop()
+
+	s.ctx = context.Background()
+	s.visitDay = time.Date(2030, time.March, 4, 10, 0, 0, 0, time.UTC)
+	s.pets = &fakePetRepository{}
+	s.sessions = &recentSessionRepository{}
+	s.formulary = service.NewDrugFormulary()
+	s.service = service.NewDoseCalculatorService(s.formulary, s.pets, s.sessions)
+}
+
+func (s *DoseCalculatorTestSuite) weighed(species enum.PetSpecies, weightsKg ...float64) {
+	s.pets.pet = *pet.NewPetBuilder().WithID(vo.NewPetID(1)).WithSpecies(species).Build()
+
+	s.sessions.sessions = nil
+	for i, weightKg := range weightsKg {
+		details := medical.NewPetSessionSummaryBuilder().WithPetID(vo.NewPetID(1))
+		if weightKg > 0 {
+			weight := vo.NewDecimalFromFloat(weightKg)
+			details.WithWeight(&weight)
+		}
+
+		s.sessions.sessions = append(s.sessions.sessions, *medical.NewMedicalSessionBuilder().
+			WithVisitDate(s.visitDay.AddDate(0, -i, 0)).
+			WithPetDetails(*details.Build()).
+			Build())
+	}
+}
+
+func warningCodes(calculation service.DoseCalculation) []service.DoseWarningCode {
+	codes := []service.DoseWarningCode{}
+	for _, warning := range calculation.Warnings {
+		codes = append(codes, warning.Code)
+	}
+	return codes
+}
+
+func dose(mg float64) *float64 { return &mg }
+
+func (s *DoseCalculatorTestSuite) TestCalculate() {
+	testCases := []struct {
+		name        string
+		species     enum.PetSpecies
+		weightKg    float64
+		drug        string
+		doseMg      *float64
+		minMg       float64
+		maxMg       float64
+		mgPerKg     float64
+		warnings    []service.DoseWarningCode
+		noRecommend bool
+	}{
+		{
+			name: "range for the weight", species: enum.PetSpeciesDog, weightKg: 10, drug: "Meloxicam",
+			minMg: 1, maxMg: 2, warnings: []service.DoseWarningCode{},
+		},
+		{
+			name: "drug names ignore case", species: enum.PetSpeciesCat, weightKg: 4, drug: " gabapentin ",
+			minMg: 20, maxMg: 40, warnings: []service.DoseWarningCode{},
+		},
+		{
+			name: "dose within the range", species: enum.PetSpeciesDog, weightKg: 10, drug: "Meloxicam", doseMg: dose(1.5),
+			minMg: 1, maxMg: 2, mgPerKg: 0.15, warnings: []service.DoseWarningCode{},
+		},
+		{
+			name: "dose above the range", species: enum.PetSpeciesDog, weightKg: 10, drug: "Meloxicam", doseMg: dose(3),
+			minMg: 1, maxMg: 2, mgPerKg: 0.3, warnings: []service.DoseWarningCode{service.DoseWarningAboveRange},
+		},
+		{
+			name: "dose below the range", species: enum.PetSpeciesDog, weightKg: 10, drug: "Meloxicam", doseMg: dose(0.5),
+			minMg: 1, maxMg: 2, mgPerKg: 0.05, warnings: []service.DoseWarningCode{service.DoseWarningBelowRange},
+		},
+		{
+			name: "maximum dose caps the range", species: enum.PetSpeciesDog, weightKg: 40, drug: "Metronidazole",
+			minMg: 400, maxMg: 500, warnings: []service.DoseWarningCode{},
+		},
+		{
+			name: "maximum dose caps both ends", species: enum.PetSpeciesDog, weightKg: 60, drug: "Metronidazole",
+			minMg: 500, maxMg: 500, warnings: []service.DoseWarningCode{},
+		},
+		{
+			name: "dose above the maximum dose", species: enum.PetSpeciesDog, weightKg: 40, drug: "Metronidazole", doseMg: dose(550),
+			minMg: 400, maxMg: 500, mgPerKg: 13.75, warnings: []service.DoseWarningCode{service.DoseWarningAboveMaxDose},
+		},
+		{
+			name: "species contraindicated", species: enum.PetSpeciesCat, weightKg: 4, drug: "Carprofen",
+			warnings: []service.DoseWarningCode{service.DoseWarningSpeciesContraindicated}, noRecommend: true,
+		},
+		{
+			name: "no dose for the species", species: enum.PetSpeciesBird, weightKg: 0.5, drug: "Meloxicam",
+			warnings: []service.DoseWarningCode{service.DoseWarningNoSpeciesDose}, noRecommend: true,
+		},
+		{
+			name: "not weighed", species: enum.PetSpeciesDog, drug: "Meloxicam",
+			warnings: []service.DoseWarningCode{service.DoseWarningNoWeight}, noRecommend: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.weighed(tc.species, tc.weightKg)
+
+			calculation, err := s.service.Calculate(s.ctx, vo.NewPetID(1), tc.drug, tc.doseMg)
+
+			s.Require().NoError(err)
+			s.Equal(tc.species, calculation.Species)
+			s.Equal(tc.warnings, warningCodes(calculation))
+			if tc.noRecommend {
+				s.Nil(calculation.RecommendedMinMg)
+				s.Nil(calculation.RecommendedMaxMg)
+				return
+			}
+
+			s.Require().NotNil(calculation.RecommendedMinMg)
+			s.InDelta(tc.minMg, *calculation.RecommendedMinMg, 1e-9)
+			s.InDelta(tc.maxMg, *calculation.RecommendedMaxMg, 1e-9)
+			if tc.doseMg != nil {
+				s.Require().NotNil(calculation.PrescribedMgPerKg)
+				s.InDelta(tc.mgPerKg, *calculation.PrescribedMgPerKg, 1e-9)
+			}
+		})
+	}
+}
+
+func (s *DoseCalculatorTestSuite) TestCalculate_UsesTheLatestWeight() {
+	s.weighed(enum.PetSpeciesDog, 0, 12, 20)
+
+	calculation, err := s.service.Calculate(s.ctx, vo.NewPetID(1), "Carprofen", nil)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(calculation.WeightKg)
+	s.InDelta(12, *calculation.WeightKg, 1e-9, "the latest session has no weight")
+	s.Equal(s.visitDay.AddDate(0, -1, 0), *calculation.WeighedAt)
+	s.InDelta(26.4, *calculation.RecommendedMinMg, 1e-9)
+	s.InDelta(52.8, *calculation.RecommendedMaxMg, 1e-9)
+}
+
+func (s *DoseCalculatorTestSuite) TestCalculate_UnknownDrug() {
+	s.weighed(enum.PetSpeciesDog, 10)
+
+	_, err := s.service.Calculate(s.ctx, vo.NewPetID(1), "Aspirin", nil)
+	s.Error(err)
+}
+
+func (s *DoseCalculatorTestSuite) TestDoseRangeForWeight() {
+	testCases := []struct {
+		name      string
+		doseRange vo.DoseRange
+		weightKg  float64
+		minMg     float64
+		maxMg     float64
+	}{
+		{"per kg", vo.NewDoseRange("dog", 2.2, 4.4), 8, 17.6, 35.2},
+		{"rounded to two decimals", vo.NewDoseRange("cat", 0.05, 0.1), 3.333, 0.17, 0.33},
+		{"below the maximum dose", vo.NewDoseRange("dog", 5, 20).WithMaxDose(600), 20, 100, 400},
+		{"capped by the maximum dose", vo.NewDoseRange("dog", 5, 20).WithMaxDose(600), 50, 250, 600},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			minMg, maxMg := tc.doseRange.ForWeight(tc.weightKg)
+
+			s.InDelta(tc.minMg, minMg, 1e-9)
+			s.InDelta(tc.maxMg, maxMg, 1e-9)
+		})
+	}
+}
+
+func (s *DoseCalculatorTestSuite) TestFormulary() {
+	names := func(drugs []vo.DrugDefinition) []string {
+		result := []string{}
+		for _, drug := range drugs {
+			result = append(result, drug.Name())
+		}
+		return result
+	}
+
+	cat := names(s.formulary.GetDrugsForSpecies("cat"))
+	s.NotContains(cat, "Carprofen")
+	s.NotContains(cat, "Acetaminophen")
+	s.Contains(cat, "Meloxicam")
+	s.IsIncreasing(cat)
+
+	s.Equal([]string{"Enrofloxacin", "Meloxicam"}, names(s.formulary.GetDrugsForSpecies("rabbit")))
+
+	s.Error(s.formulary.AddDrug(vo.NewDrugDefinition("meloxicam", "NSAID", nil)), "already in the formulary")
+	s.Require().NoError(s.formulary.AddDrug(vo.NewDrugDefinition("Cefalexin", "Antibiotic", []vo.DoseRange{vo.NewDoseRange("dog", 15, 30)})))
+	_, err := s.formulary.GetDrugByName("CEFALEXIN")
+	s.NoError(err)
+}
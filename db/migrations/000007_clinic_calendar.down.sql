@@ -0,0 +1,9 @@
+-- 000007_clinic_calendar.down.sql
+-- Drop clinic calendar tables
+
+DROP TABLE IF EXISTS clinic_booking_policy;
+
+DROP INDEX IF EXISTS idx_clinic_closures_date;
+DROP TABLE IF EXISTS clinic_closures;
+
+DROP TABLE IF EXISTS clinic_opening_hours;
@@ -0,0 +1,50 @@
+-- 000007_clinic_calendar.up.sql
+-- Clinic calendar: weekly opening hours, holidays / ad-hoc closures and booking lead-time policy
+
+CREATE TABLE IF NOT EXISTS clinic_opening_hours (
+    weekday SMALLINT PRIMARY KEY CHECK (weekday BETWEEN 0 AND 6), -- 0 = Sunday
+    open_hour SMALLINT NOT NULL DEFAULT 9 CHECK (open_hour BETWEEN 0 AND 23),
+    close_hour SMALLINT NOT NULL DEFAULT 18 CHECK (close_hour BETWEEN 1 AND 24),
+    is_closed BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_opening_hours_range CHECK (is_closed OR open_hour < close_hour)
+);
+
+INSERT INTO clinic_opening_hours (weekday, open_hour, close_hour, is_closed) VALUES
+    (0, 9, 18, TRUE),
+    (1, 9, 18, FALSE),
+    (2, 9, 18, FALSE),
+    (3, 9, 18, FALSE),
+    (4, 9, 18, FALSE),
+    (5, 9, 18, FALSE),
+    (6, 9, 18, TRUE)
+ON CONFLICT (weekday) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS clinic_closures (
+    id SERIAL PRIMARY KEY,
+    closure_date DATE NOT NULL UNIQUE,
+    closure_type VARCHAR(20) NOT NULL CHECK (closure_type IN ('public_holiday', 'ad_hoc')),
+    reason VARCHAR(255) NOT NULL,
+    start_hour SMALLINT NULL CHECK (start_hour BETWEEN 0 AND 23),
+    end_hour SMALLINT NULL CHECK (end_hour BETWEEN 1 AND 24),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_closure_hours CHECK (
+        (start_hour IS NULL AND end_hour IS NULL) OR
+        (start_hour IS NOT NULL AND end_hour IS NOT NULL AND start_hour < end_hour)
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_clinic_closures_date ON clinic_closures(closure_date);
+
+-- Single row table holding the booking lead-time rules
+CREATE TABLE IF NOT EXISTS clinic_booking_policy (
+    id SMALLINT PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+    min_lead_days INT NOT NULL DEFAULT 3 CHECK (min_lead_days >= 0),
+    max_lead_days INT NOT NULL DEFAULT 30,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_booking_policy_range CHECK (max_lead_days >= min_lead_days)
+);
+
+INSERT INTO clinic_booking_policy (id, min_lead_days, max_lead_days) VALUES (1, 3, 30)
+ON CONFLICT (id) DO NOTHING;
@@ -0,0 +1,7 @@
+-- 000008_appointment_reminders.down.sql
+-- Drop appointment reminder log and customer preferred channel
+
+DROP INDEX IF EXISTS idx_appointment_reminders_pending;
+DROP TABLE IF EXISTS appointment_reminders;
+
+ALTER TABLE customers DROP COLUMN IF EXISTS preferred_notification_channel;
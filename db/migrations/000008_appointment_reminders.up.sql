@@ -0,0 +1,20 @@
+-- 000008_appointment_reminders.up.sql
+-- Customer preferred notification channel and the log of appointment reminders already dispatched
+
+ALTER TABLE customers
+    ADD COLUMN IF NOT EXISTS preferred_notification_channel VARCHAR(20) NOT NULL DEFAULT 'email'
+    CHECK (preferred_notification_channel IN ('email', 'sms'));
+
+-- One row per appointment and scheduled date: a rescheduled appointment gets a new reminder.
+-- A row with sent_at NULL is a claim taken by a dispatcher replica; stale claims can be taken over.
+CREATE TABLE IF NOT EXISTS appointment_reminders (
+    appointment_id INT NOT NULL,
+    scheduled_date TIMESTAMP WITH TIME ZONE NOT NULL,
+    channel VARCHAR(20) NULL,
+    claimed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at TIMESTAMP WITH TIME ZONE NULL,
+    PRIMARY KEY (appointment_id, scheduled_date),
+    FOREIGN KEY (appointment_id) REFERENCES appointments(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_appointment_reminders_pending ON appointment_reminders(claimed_at) WHERE sent_at IS NULL;
@@ -0,0 +1,7 @@
+-- 000009_appointment_waitlist.down.sql
+-- Drop appointment waitlist
+
+DROP INDEX IF EXISTS idx_appointment_waitlist_offers;
+DROP INDEX IF EXISTS idx_appointment_waitlist_customer;
+DROP INDEX IF EXISTS idx_appointment_waitlist_slot;
+DROP TABLE IF EXISTS appointment_waitlist;
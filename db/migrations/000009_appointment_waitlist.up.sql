@@ -0,0 +1,29 @@
+-- 000009_appointment_waitlist.up.sql
+-- Waitlist of customers waiting for a freed slot of a service on a given date
+
+CREATE TABLE IF NOT EXISTS appointment_waitlist (
+    id SERIAL PRIMARY KEY,
+    customer_id INT NOT NULL,
+    pet_id INT NOT NULL,
+    clinic_service clinic_service NOT NULL,
+    requested_date DATE NOT NULL,
+    preferred_employee_id INT NULL,
+    notes TEXT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'waiting' CHECK (status IN ('waiting', 'offered', 'claimed', 'expired', 'cancelled')),
+    offered_slot TIMESTAMP WITH TIME ZONE NULL,
+    offered_employee_id INT NULL,
+    claim_token_hash VARCHAR(64) NULL UNIQUE,
+    offer_expires_at TIMESTAMP WITH TIME ZONE NULL,
+    appointment_id INT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE,
+    FOREIGN KEY (pet_id) REFERENCES pets(id) ON DELETE CASCADE,
+    FOREIGN KEY (preferred_employee_id) REFERENCES employees(id) ON DELETE SET NULL,
+    FOREIGN KEY (offered_employee_id) REFERENCES employees(id) ON DELETE SET NULL,
+    FOREIGN KEY (appointment_id) REFERENCES appointments(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_appointment_waitlist_slot ON appointment_waitlist(requested_date, clinic_service, status);
+CREATE INDEX IF NOT EXISTS idx_appointment_waitlist_customer ON appointment_waitlist(customer_id);
+CREATE INDEX IF NOT EXISTS idx_appointment_waitlist_offers ON appointment_waitlist(offer_expires_at) WHERE status = 'offered';
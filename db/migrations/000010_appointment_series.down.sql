@@ -0,0 +1,6 @@
+-- 000010_appointment_series.down.sql
+-- Drop recurring appointment series
+
+DROP INDEX IF EXISTS idx_appointments_series;
+ALTER TABLE appointments DROP COLUMN IF EXISTS series_id;
+DROP TABLE IF EXISTS appointment_series;
@@ -0,0 +1,26 @@
+-- 000010_appointment_series.up.sql
+-- Recurring appointment series; each occurrence is a regular appointment linked to its series
+
+CREATE TABLE IF NOT EXISTS appointment_series (
+    id SERIAL PRIMARY KEY,
+    customer_id INT NOT NULL,
+    pet_id INT NOT NULL,
+    employee_id INT NULL,
+    clinic_service clinic_service NOT NULL,
+    start_date TIMESTAMP WITH TIME ZONE NOT NULL,
+    frequency VARCHAR(20) NOT NULL CHECK (frequency IN ('weekly', 'monthly')),
+    repeat_interval INT NOT NULL DEFAULT 1 CHECK (repeat_interval > 0),
+    occurrence_count INT NULL CHECK (occurrence_count > 0),
+    until_date TIMESTAMP WITH TIME ZONE NULL,
+    notes TEXT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (occurrence_count IS NOT NULL OR until_date IS NOT NULL),
+    FOREIGN KEY (customer_id) REFERENCES customers(id) ON DELETE CASCADE,
+    FOREIGN KEY (pet_id) REFERENCES pets(id) ON DELETE CASCADE,
+    FOREIGN KEY (employee_id) REFERENCES employees(id) ON DELETE SET NULL
+);
+
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS series_id INT NULL REFERENCES appointment_series(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_appointments_series ON appointments(series_id, scheduled_date) WHERE series_id IS NOT NULL;
@@ -0,0 +1,6 @@
+-- 000011_calendar_feeds.down.sql
+-- Drop iCalendar feeds
+
+DROP INDEX IF EXISTS idx_calendar_feeds_active_owner;
+DROP TABLE IF EXISTS calendar_feeds;
+ALTER TABLE appointments DROP COLUMN IF EXISTS sequence;
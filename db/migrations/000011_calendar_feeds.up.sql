@@ -0,0 +1,16 @@
+-- 000011_calendar_feeds.up.sql
+-- iCalendar feeds of employee and customer appointments
+
+-- Revision number of the appointment, published as the iCalendar SEQUENCE
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS sequence INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+    id SERIAL PRIMARY KEY,
+    owner_type VARCHAR(20) NOT NULL CHECK (owner_type IN ('employee', 'customer')),
+    owner_id INT NOT NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Only one feed per owner can be active, issuing a new one revokes the previous
+CREATE UNIQUE INDEX IF NOT EXISTS idx_calendar_feeds_active_owner ON calendar_feeds(owner_type, owner_id) WHERE revoked_at IS NULL;
@@ -0,0 +1,8 @@
+-- 000012_emergency_appointments.down.sql
+-- Drop emergency and walk-in appointments
+
+DROP INDEX IF EXISTS idx_medical_sessions_appointment_id;
+DROP INDEX IF EXISTS idx_appointments_emergency;
+ALTER TABLE appointments DROP CONSTRAINT IF EXISTS chk_appointments_emergency_triage;
+ALTER TABLE appointments DROP COLUMN IF EXISTS triage_priority;
+ALTER TABLE appointments DROP COLUMN IF EXISTS is_emergency;
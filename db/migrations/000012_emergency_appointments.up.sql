@@ -0,0 +1,13 @@
+-- 000012_emergency_appointments.up.sql
+-- Emergency and walk-in appointments, registered by the staff outside the booking lead time
+
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS is_emergency BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS triage_priority VARCHAR(20) NULL
+    CHECK (triage_priority IN ('critical', 'urgent', 'semi_urgent', 'non_urgent'));
+
+-- Emergencies always carry a triage priority
+ALTER TABLE appointments ADD CONSTRAINT chk_appointments_emergency_triage
+    CHECK (is_emergency = FALSE OR triage_priority IS NOT NULL);
+
+CREATE INDEX IF NOT EXISTS idx_appointments_emergency ON appointments(scheduled_date) WHERE is_emergency = TRUE AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_medical_sessions_appointment_id ON medical_sessions(appointment_id);
@@ -0,0 +1,9 @@
+-- 000013_appointment_visit_stages.down.sql
+-- Drop the patient flow inside the clinic
+
+DROP INDEX IF EXISTS idx_appointments_checked_in_at;
+ALTER TABLE appointments DROP CONSTRAINT IF EXISTS chk_appointments_visit_stage_checked_in;
+ALTER TABLE appointments DROP COLUMN IF EXISTS ready_for_checkout_at;
+ALTER TABLE appointments DROP COLUMN IF EXISTS in_exam_room_at;
+ALTER TABLE appointments DROP COLUMN IF EXISTS checked_in_at;
+ALTER TABLE appointments DROP COLUMN IF EXISTS visit_stage;
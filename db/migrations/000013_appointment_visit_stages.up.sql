@@ -0,0 +1,14 @@
+-- 000013_appointment_visit_stages.up.sql
+-- Patient flow inside the clinic, from check-in at the front desk to checkout
+
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS visit_stage VARCHAR(30) NULL
+    CHECK (visit_stage IN ('checked_in', 'in_exam_room', 'ready_for_checkout'));
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS checked_in_at TIMESTAMP WITH TIME ZONE NULL;
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS in_exam_room_at TIMESTAMP WITH TIME ZONE NULL;
+ALTER TABLE appointments ADD COLUMN IF NOT EXISTS ready_for_checkout_at TIMESTAMP WITH TIME ZONE NULL;
+
+-- Every stage is reached through check-in
+ALTER TABLE appointments ADD CONSTRAINT chk_appointments_visit_stage_checked_in
+    CHECK (visit_stage IS NULL OR checked_in_at IS NOT NULL);
+
+CREATE INDEX IF NOT EXISTS idx_appointments_checked_in_at ON appointments(checked_in_at) WHERE checked_in_at IS NOT NULL AND deleted_at IS NULL;
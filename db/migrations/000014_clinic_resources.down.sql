@@ -0,0 +1,8 @@
+-- 000014_clinic_resources.down.sql
+-- Drop clinic resources, service requirements and reservations
+
+DROP INDEX IF EXISTS idx_resource_reservations_resource_time;
+DROP TABLE IF EXISTS appointment_resource_reservations;
+DROP TABLE IF EXISTS service_resource_requirements;
+DROP INDEX IF EXISTS idx_clinic_resources_type;
+DROP TABLE IF EXISTS clinic_resources;
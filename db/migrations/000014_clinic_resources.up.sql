@@ -0,0 +1,38 @@
+-- 000014_clinic_resources.up.sql
+-- Clinic resources (rooms and equipment), the resources every service needs and their reservations
+
+CREATE TABLE IF NOT EXISTS clinic_resources (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    resource_type VARCHAR(30) NOT NULL CHECK (resource_type IN (
+        'exam_room', 'surgery_theatre', 'xray_room', 'dental_suite', 'anesthesia_machine', 'ultrasound_machine'
+    )),
+    description TEXT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_clinic_resources_type ON clinic_resources(resource_type) WHERE is_active = TRUE;
+
+CREATE TABLE IF NOT EXISTS service_resource_requirements (
+    clinic_service clinic_service NOT NULL,
+    resource_type VARCHAR(30) NOT NULL CHECK (resource_type IN (
+        'exam_room', 'surgery_theatre', 'xray_room', 'dental_suite', 'anesthesia_machine', 'ultrasound_machine'
+    )),
+    quantity SMALLINT NOT NULL DEFAULT 1 CHECK (quantity BETWEEN 1 AND 10),
+    PRIMARY KEY (clinic_service, resource_type)
+);
+
+CREATE TABLE IF NOT EXISTS appointment_resource_reservations (
+    id SERIAL PRIMARY KEY,
+    appointment_id INT NOT NULL REFERENCES appointments(id) ON DELETE CASCADE,
+    resource_id INT NOT NULL REFERENCES clinic_resources(id),
+    start_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    end_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_reservation_appointment_resource UNIQUE (appointment_id, resource_id),
+    CONSTRAINT chk_reservation_range CHECK (end_time > start_time)
+);
+
+CREATE INDEX IF NOT EXISTS idx_resource_reservations_resource_time ON appointment_resource_reservations(resource_id, start_time, end_time);
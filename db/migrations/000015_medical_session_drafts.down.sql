@@ -0,0 +1,7 @@
+-- 000015_medical_session_drafts.down.sql
+-- Drop the draft medical sessions
+
+DROP INDEX IF EXISTS idx_medical_sessions_drafts;
+DROP INDEX IF EXISTS idx_medical_sessions_appointment_unique;
+ALTER TABLE medical_sessions DROP COLUMN IF EXISTS closed_at;
+ALTER TABLE medical_sessions DROP COLUMN IF EXISTS status;
@@ -0,0 +1,12 @@
+-- 000015_medical_session_drafts.up.sql
+-- Draft sessions opened from appointments, filled in during the visit and closed by the veterinarian
+
+-- Sessions recorded before drafts existed are already final
+ALTER TABLE medical_sessions ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'closed'
+    CHECK (status IN ('draft', 'closed'));
+ALTER TABLE medical_sessions ADD COLUMN IF NOT EXISTS closed_at TIMESTAMP WITH TIME ZONE NULL;
+
+-- A visit is recorded by a single session
+CREATE UNIQUE INDEX IF NOT EXISTS idx_medical_sessions_appointment_unique ON medical_sessions(appointment_id)
+    WHERE appointment_id IS NOT NULL AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_medical_sessions_drafts ON medical_sessions(employee_id, visit_date) WHERE status = 'draft' AND deleted_at IS NULL;
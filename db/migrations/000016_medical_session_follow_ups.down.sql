@@ -0,0 +1,4 @@
+-- 000016_medical_session_follow_ups.down.sql
+-- Drop the follow-up appointments of medical sessions
+
+DROP TABLE IF EXISTS medical_session_follow_ups;
@@ -0,0 +1,9 @@
+-- 000016_medical_session_follow_ups.up.sql
+-- Follow-up appointments proposed from the follow-up date of a medical session
+
+CREATE TABLE IF NOT EXISTS medical_session_follow_ups (
+    medical_session_id INT PRIMARY KEY REFERENCES medical_sessions(id) ON DELETE CASCADE,
+    appointment_id INT NOT NULL UNIQUE REFERENCES appointments(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
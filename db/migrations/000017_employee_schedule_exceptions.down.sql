@@ -0,0 +1,6 @@
+-- 000017_employee_schedule_exceptions.down.sql
+-- Drop the schedule exceptions of the employees
+
+DROP INDEX IF EXISTS idx_schedule_exceptions_status;
+DROP INDEX IF EXISTS idx_schedule_exceptions_employee_period;
+DROP TABLE IF EXISTS employee_schedule_exceptions;
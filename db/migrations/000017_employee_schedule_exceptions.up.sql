@@ -0,0 +1,21 @@
+-- 000017_employee_schedule_exceptions.up.sql
+-- Dated exceptions to the weekly schedule of the employees (time off and extra shifts) and their review
+
+CREATE TABLE IF NOT EXISTS employee_schedule_exceptions (
+    id SERIAL PRIMARY KEY,
+    employee_id INT NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    exception_type VARCHAR(20) NOT NULL CHECK (exception_type IN ('vacation', 'sick_leave', 'conference', 'extra_shift')),
+    start_date TIMESTAMP WITH TIME ZONE NOT NULL,
+    end_date TIMESTAMP WITH TIME ZONE NOT NULL,
+    reason VARCHAR(500) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected', 'cancelled')),
+    reviewed_by INT NULL REFERENCES users(id) ON DELETE SET NULL,
+    review_notes TEXT NULL,
+    reviewed_at TIMESTAMP WITH TIME ZONE NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_schedule_exception_range CHECK (end_date > start_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_schedule_exceptions_employee_period ON employee_schedule_exceptions(employee_id, start_date, end_date) WHERE status = 'approved';
+CREATE INDEX IF NOT EXISTS idx_schedule_exceptions_status ON employee_schedule_exceptions(status, start_date);
@@ -0,0 +1,6 @@
+-- 000018_on_call_shifts.down.sql
+-- Drop the on-call roster
+
+DROP INDEX IF EXISTS idx_on_call_shifts_employee;
+DROP INDEX IF EXISTS idx_on_call_shifts_specialty_period;
+DROP TABLE IF EXISTS on_call_shifts;
@@ -0,0 +1,16 @@
+-- 000018_on_call_shifts.up.sql
+-- On-call roster of the veterinarians covering after-hours emergencies, one rotation per specialty
+
+CREATE TABLE IF NOT EXISTS on_call_shifts (
+    id SERIAL PRIMARY KEY,
+    employee_id INT NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    specialty VARCHAR(50) NOT NULL,
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ends_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_on_call_shift_range CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_on_call_shifts_specialty_period ON on_call_shifts(specialty, starts_at, ends_at);
+CREATE INDEX IF NOT EXISTS idx_on_call_shifts_employee ON on_call_shifts(employee_id, starts_at);
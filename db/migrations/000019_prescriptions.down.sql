@@ -0,0 +1,7 @@
+-- 000019_prescriptions.down.sql
+-- Drop the prescriptions
+
+DROP INDEX IF EXISTS idx_prescriptions_pet_active;
+DROP INDEX IF EXISTS idx_prescriptions_pet;
+DROP INDEX IF EXISTS idx_prescriptions_session;
+DROP TABLE IF EXISTS prescriptions;
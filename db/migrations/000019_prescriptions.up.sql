@@ -0,0 +1,31 @@
+-- 000019_prescriptions.up.sql
+-- Prescriptions issued during medical sessions, with their refills and cancellation
+
+CREATE TABLE IF NOT EXISTS prescriptions (
+    id SERIAL PRIMARY KEY,
+    medical_session_id INT NOT NULL REFERENCES medical_sessions(id) ON DELETE CASCADE,
+    pet_id INT NOT NULL REFERENCES pets(id) ON DELETE CASCADE,
+    prescribed_by INT NOT NULL REFERENCES employees(id) ON DELETE RESTRICT,
+    drug_name VARCHAR(150) NOT NULL,
+    strength VARCHAR(50) NOT NULL,
+    dose VARCHAR(100) NOT NULL,
+    route VARCHAR(20) NOT NULL CHECK (route IN ('oral', 'topical', 'subcutaneous', 'intramuscular', 'intravenous', 'ophthalmic', 'otic', 'inhaled', 'rectal')),
+    frequency VARCHAR(100) NOT NULL,
+    duration_days INT NOT NULL CHECK (duration_days > 0),
+    quantity INT NOT NULL CHECK (quantity > 0),
+    refills_authorized INT NOT NULL DEFAULT 0 CHECK (refills_authorized >= 0),
+    refills_remaining INT NOT NULL DEFAULT 0 CHECK (refills_remaining >= 0),
+    instructions TEXT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'cancelled')),
+    prescribed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_filled_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    cancelled_at TIMESTAMP WITH TIME ZONE NULL,
+    cancel_reason TEXT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_prescription_refills CHECK (refills_remaining <= refills_authorized)
+);
+
+CREATE INDEX IF NOT EXISTS idx_prescriptions_session ON prescriptions(medical_session_id);
+CREATE INDEX IF NOT EXISTS idx_prescriptions_pet ON prescriptions(pet_id, prescribed_at);
+CREATE INDEX IF NOT EXISTS idx_prescriptions_pet_active ON prescriptions(pet_id, last_filled_at) WHERE status = 'active';
@@ -0,0 +1,13 @@
+-- 000020_inventory.down.sql
+-- Drop the pharmacy and medical supply inventory
+
+DROP INDEX IF EXISTS idx_stock_movements_reference;
+DROP INDEX IF EXISTS idx_stock_movements_lot;
+DROP TABLE IF EXISTS stock_movements;
+DROP INDEX IF EXISTS idx_stock_lots_expiring;
+DROP INDEX IF EXISTS idx_stock_lots_product;
+DROP TABLE IF EXISTS stock_lots;
+DROP INDEX IF EXISTS idx_stock_locations_name;
+DROP TABLE IF EXISTS stock_locations;
+DROP INDEX IF EXISTS idx_inventory_products_name;
+DROP TABLE IF EXISTS inventory_products;
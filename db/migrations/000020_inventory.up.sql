@@ -0,0 +1,63 @@
+-- 000020_inventory.up.sql
+-- Pharmacy and medical supply inventory: products, stock locations, lots with their expiry and every stock movement
+
+CREATE TABLE IF NOT EXISTS inventory_products (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(150) NOT NULL,
+    category VARCHAR(20) NOT NULL CHECK (category IN ('medication', 'vaccine', 'dewormer', 'supply')),
+    unit VARCHAR(30) NOT NULL,
+    reorder_level INT NOT NULL DEFAULT 0 CHECK (reorder_level >= 0),
+    description TEXT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_inventory_products_name ON inventory_products(LOWER(name));
+
+CREATE TABLE IF NOT EXISTS stock_locations (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_stock_locations_name ON stock_locations(LOWER(name));
+
+CREATE TABLE IF NOT EXISTS stock_lots (
+    id SERIAL PRIMARY KEY,
+    product_id INT NOT NULL REFERENCES inventory_products(id) ON DELETE RESTRICT,
+    location_id INT NOT NULL REFERENCES stock_locations(id) ON DELETE RESTRICT,
+    lot_number VARCHAR(50) NOT NULL,
+    expires_on DATE NOT NULL,
+    quantity_on_hand INT NOT NULL DEFAULT 0 CHECK (quantity_on_hand >= 0),
+    received_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_stock_lots_number UNIQUE (product_id, location_id, lot_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_lots_product ON stock_lots(product_id, expires_on);
+CREATE INDEX IF NOT EXISTS idx_stock_lots_expiring ON stock_lots(expires_on) WHERE quantity_on_hand > 0;
+
+CREATE TABLE IF NOT EXISTS stock_movements (
+    id SERIAL PRIMARY KEY,
+    lot_id INT NOT NULL REFERENCES stock_lots(id) ON DELETE CASCADE,
+    product_id INT NOT NULL REFERENCES inventory_products(id) ON DELETE RESTRICT,
+    movement_type VARCHAR(20) NOT NULL CHECK (movement_type IN ('receive', 'dispense', 'adjust', 'waste')),
+    quantity INT NOT NULL CHECK (quantity <> 0),
+    balance_after INT NOT NULL CHECK (balance_after >= 0),
+    reason TEXT NULL,
+    reference_type VARCHAR(20) NULL CHECK (reference_type IN ('vaccination', 'deworming', 'prescription')),
+    reference_id INT NULL,
+    performed_by INT NOT NULL REFERENCES employees(id) ON DELETE RESTRICT,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_stock_movements_reference CHECK ((reference_type IS NULL) = (reference_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_movements_lot ON stock_movements(lot_id, occurred_at);
+CREATE INDEX IF NOT EXISTS idx_stock_movements_reference ON stock_movements(reference_type, reference_id) WHERE reference_type IS NOT NULL;
@@ -0,0 +1,7 @@
+-- 000021_medical_session_vital_flags.down.sql
+-- Drop the vital flags of the medical sessions
+
+ALTER TABLE medical_sessions DROP COLUMN IF EXISTS respiratory_rate_flag;
+ALTER TABLE medical_sessions DROP COLUMN IF EXISTS heart_rate_flag;
+ALTER TABLE medical_sessions DROP COLUMN IF EXISTS temperature_flag;
+ALTER TABLE medical_sessions DROP COLUMN IF EXISTS weight_flag;
@@ -0,0 +1,11 @@
+-- 000021_medical_session_vital_flags.up.sql
+-- Vitals of a session flagged against the normal ranges of the pet's species and life stage
+
+ALTER TABLE medical_sessions ADD COLUMN IF NOT EXISTS weight_flag VARCHAR(10) NULL
+    CHECK (weight_flag IN ('low', 'normal', 'high'));
+ALTER TABLE medical_sessions ADD COLUMN IF NOT EXISTS temperature_flag VARCHAR(10) NULL
+    CHECK (temperature_flag IN ('low', 'normal', 'high'));
+ALTER TABLE medical_sessions ADD COLUMN IF NOT EXISTS heart_rate_flag VARCHAR(10) NULL
+    CHECK (heart_rate_flag IN ('low', 'normal', 'high'));
+ALTER TABLE medical_sessions ADD COLUMN IF NOT EXISTS respiratory_rate_flag VARCHAR(10) NULL
+    CHECK (respiratory_rate_flag IN ('low', 'normal', 'high'));
@@ -0,0 +1,10 @@
+-- 000022_lab_orders.down.sql
+-- Drop the lab orders and their results
+
+DROP INDEX IF EXISTS idx_lab_results_trend;
+DROP INDEX IF EXISTS idx_lab_orders_accession;
+DROP INDEX IF EXISTS idx_lab_orders_pet;
+DROP INDEX IF EXISTS idx_lab_orders_session;
+DROP TABLE IF EXISTS lab_results;
+DROP TABLE IF EXISTS lab_order_panels;
+DROP TABLE IF EXISTS lab_orders;
@@ -0,0 +1,52 @@
+-- 000022_lab_orders.up.sql
+-- Lab orders placed during medical sessions, with the panels requested and the results reported
+
+CREATE TABLE IF NOT EXISTS lab_orders (
+    id SERIAL PRIMARY KEY,
+    medical_session_id INT NOT NULL REFERENCES medical_sessions(id) ON DELETE CASCADE,
+    pet_id INT NOT NULL REFERENCES pets(id) ON DELETE CASCADE,
+    ordered_by INT NOT NULL REFERENCES employees(id) ON DELETE RESTRICT,
+    lab_name VARCHAR(100) NOT NULL,
+    accession_number VARCHAR(50) NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'ordered' CHECK (status IN ('ordered', 'partial', 'completed', 'cancelled')),
+    notes TEXT NULL,
+    ordered_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    resulted_at TIMESTAMP WITH TIME ZONE NULL,
+    cancelled_at TIMESTAMP WITH TIME ZONE NULL,
+    cancel_reason TEXT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS lab_order_panels (
+    lab_order_id INT NOT NULL REFERENCES lab_orders(id) ON DELETE CASCADE,
+    panel_code VARCHAR(30) NOT NULL,
+    panel_name VARCHAR(100) NOT NULL,
+    PRIMARY KEY (lab_order_id, panel_code)
+);
+
+-- A corrected result replaces the previous one of the analyte
+CREATE TABLE IF NOT EXISTS lab_results (
+    id SERIAL PRIMARY KEY,
+    lab_order_id INT NOT NULL REFERENCES lab_orders(id) ON DELETE CASCADE,
+    pet_id INT NOT NULL REFERENCES pets(id) ON DELETE CASCADE,
+    panel_code VARCHAR(30) NOT NULL DEFAULT '',
+    analyte_code VARCHAR(30) NOT NULL,
+    analyte_name VARCHAR(100) NOT NULL,
+    numeric_value DOUBLE PRECISION NULL,
+    text_value VARCHAR(100) NULL,
+    unit VARCHAR(20) NOT NULL DEFAULT '',
+    reference_low DOUBLE PRECISION NULL,
+    reference_high DOUBLE PRECISION NULL,
+    flag VARCHAR(10) NULL CHECK (flag IN ('low', 'normal', 'high', 'abnormal')),
+    observed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_lab_results_value CHECK (numeric_value IS NOT NULL OR text_value IS NOT NULL),
+    CONSTRAINT uq_lab_results_analyte UNIQUE (lab_order_id, analyte_code)
+);
+
+CREATE INDEX IF NOT EXISTS idx_lab_orders_session ON lab_orders(medical_session_id);
+CREATE INDEX IF NOT EXISTS idx_lab_orders_pet ON lab_orders(pet_id, ordered_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_lab_orders_accession ON lab_orders(lab_name, accession_number) WHERE accession_number IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_lab_results_trend ON lab_results(pet_id, analyte_code, observed_at);
@@ -0,0 +1,6 @@
+-- 000023_medical_attachments.down.sql
+-- Drop the medical attachments, the files left in the blob storage have to be removed apart
+
+DROP INDEX IF EXISTS idx_medical_attachments_session;
+DROP INDEX IF EXISTS idx_medical_attachments_pet;
+DROP TABLE IF EXISTS medical_attachments;
@@ -0,0 +1,23 @@
+-- 000023_medical_attachments.up.sql
+-- Files attached to the medical record of a pet, such as X-rays, photos and documents. The
+-- content lives in the blob storage under storage_key, the table keeps what is needed to list,
+-- check and serve it
+
+CREATE TABLE IF NOT EXISTS medical_attachments (
+    id SERIAL PRIMARY KEY,
+    pet_id INT NOT NULL REFERENCES pets(id) ON DELETE CASCADE,
+    medical_session_id INT NULL REFERENCES medical_sessions(id) ON DELETE SET NULL,
+    uploaded_by INT NOT NULL REFERENCES employees(id) ON DELETE RESTRICT,
+    category VARCHAR(20) NOT NULL CHECK (category IN ('xray', 'photo', 'document', 'other')),
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+    checksum_sha256 CHAR(64) NOT NULL,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    description TEXT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_medical_attachments_pet ON medical_attachments(pet_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_medical_attachments_session ON medical_attachments(medical_session_id);
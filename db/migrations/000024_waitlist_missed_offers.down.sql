@@ -0,0 +1,4 @@
+-- 000024_waitlist_missed_offers.down.sql
+-- Drop the missed offers count of the waitlist entries
+
+ALTER TABLE appointment_waitlist DROP COLUMN IF EXISTS missed_offers;
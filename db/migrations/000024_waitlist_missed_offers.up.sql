@@ -0,0 +1,4 @@
+-- 000024_waitlist_missed_offers.up.sql
+-- Count of the offers an entry let expire, it goes back to waiting until it reaches the cap
+
+ALTER TABLE appointment_waitlist ADD COLUMN IF NOT EXISTS missed_offers INT NOT NULL DEFAULT 0 CHECK (missed_offers >= 0);
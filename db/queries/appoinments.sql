@@ -1,13 +1,13 @@
 -- name: FindAppointmentsBySpec :many
 SELECT 
     id, clinic_service, scheduled_date, status, notes,
-    customer_id, employee_id, pet_id, created_at, updated_at
+    customer_id, employee_id, pet_id, created_at, updated_at, series_id, sequence, is_emergency, triage_priority, visit_stage, checked_in_at, in_exam_room_at, ready_for_checkout_at
 FROM appointments 
 WHERE 
-    ($1::INT IS NULL OR id = $1)
-    AND ($2::INT IS NULL OR customer_id = $2)
-    AND ($3::INT IS NULL OR employee_id = $3)
-    AND ($4::INT IS NULL OR pet_id = $4)
+    ($1::INT = 0 OR id = $1)
+    AND ($2::INT = 0 OR customer_id = $2)
+    AND ($3::INT = 0 OR employee_id = $3)
+    AND ($4::INT = 0 OR pet_id = $4)
     AND (
         $5::TEXT IS NULL OR 
         $5::TEXT = '' OR 
@@ -28,10 +28,10 @@ LIMIT $10 OFFSET $11;
 SELECT COUNT(*) 
 FROM appointments 
 WHERE 
-    ($1::INT IS NULL OR id = $1)
-    AND ($2::INT IS NULL OR customer_id = $2)
-    AND ($3::INT IS NULL OR employee_id = $3)
-    AND ($4::INT IS NULL OR pet_id = $4)
+    ($1::INT = 0 OR id = $1)
+    AND ($2::INT = 0 OR customer_id = $2)
+    AND ($3::INT = 0 OR employee_id = $3)
+    AND ($4::INT = 0 OR pet_id = $4)
     AND (
         $5::TEXT IS NULL OR 
         $5::TEXT = '' OR 
@@ -65,6 +65,28 @@ WHERE id = $1
 AND employee_id = $2
 AND deleted_at IS NULL;
 
+-- name: FindAppointmentsBySeries :many
+SELECT * FROM appointments
+WHERE series_id = $1
+AND deleted_at IS NULL
+ORDER BY scheduled_date ASC;
+
+-- name: FindAppointmentsCheckedInBetween :many
+SELECT * FROM appointments
+WHERE checked_in_at >= @start_date
+    AND checked_in_at < @end_date
+    AND (@employee_id::INT = 0 OR employee_id = @employee_id)
+    AND deleted_at IS NULL
+ORDER BY checked_in_at ASC;
+
+-- name: FindScheduleConflictCandidates :many
+SELECT * FROM appointments
+WHERE (pet_id = @pet_id OR employee_id = sqlc.narg(employee_id))
+    AND scheduled_date >= @window_start
+    AND scheduled_date < @window_end
+    AND deleted_at IS NULL
+ORDER BY scheduled_date ASC;
+
 -- name: CreateAppointment :one
 INSERT INTO appointments (
     clinic_service, 
@@ -74,11 +96,18 @@ INSERT INTO appointments (
     customer_id, 
     employee_id,
     pet_id,
+    series_id,
+    is_emergency,
+    triage_priority,
+    visit_stage,
+    checked_in_at,
+    in_exam_room_at,
+    ready_for_checkout_at,
     created_at,
     updated_at,
     deleted_at
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, NULL
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, NULL
 ) RETURNING *;
 
 -- name: UpdateAppointment :one
@@ -90,6 +119,12 @@ UPDATE appointments SET
     customer_id = $6,
     employee_id = $7,
     pet_id = $8,
+    triage_priority = $9,
+    visit_stage = $10,
+    checked_in_at = $11,
+    in_exam_room_at = $12,
+    ready_for_checkout_at = $13,
+    sequence = sequence + 1,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = $1
 RETURNING *;
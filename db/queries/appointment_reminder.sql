@@ -0,0 +1,22 @@
+-- name: ClaimAppointmentReminder :one
+INSERT INTO appointment_reminders (
+    appointment_id, scheduled_date, claimed_at
+) VALUES (
+    $1, $2, CURRENT_TIMESTAMP
+)
+ON CONFLICT (appointment_id, scheduled_date) DO UPDATE
+SET claimed_at = CURRENT_TIMESTAMP
+WHERE appointment_reminders.sent_at IS NULL
+    AND appointment_reminders.claimed_at < sqlc.arg(claimed_before)
+RETURNING appointment_id;
+
+-- name: MarkAppointmentReminderSent :exec
+UPDATE appointment_reminders
+SET 
+    channel = $3,
+    sent_at = CURRENT_TIMESTAMP
+WHERE appointment_id = $1 AND scheduled_date = $2;
+
+-- name: ReleaseAppointmentReminder :exec
+DELETE FROM appointment_reminders
+WHERE appointment_id = $1 AND scheduled_date = $2 AND sent_at IS NULL;
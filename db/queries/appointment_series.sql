@@ -0,0 +1,21 @@
+-- name: CreateAppointmentSeries :one
+INSERT INTO appointment_series (
+    customer_id,
+    pet_id,
+    employee_id,
+    clinic_service,
+    start_date,
+    frequency,
+    repeat_interval,
+    occurrence_count,
+    until_date,
+    notes,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+) RETURNING *;
+
+-- name: FindAppointmentSeriesByID :one
+SELECT * FROM appointment_series
+WHERE id = $1;
@@ -0,0 +1,54 @@
+-- name: GetAppointmentStatusCounts :many
+SELECT status, COUNT(*) AS total
+FROM appointments
+WHERE scheduled_date >= @start_date
+    AND scheduled_date < @end_date
+    AND (@employee_id::INT = 0 OR employee_id = @employee_id)
+    AND deleted_at IS NULL
+GROUP BY status
+ORDER BY status;
+
+-- name: GetAppointmentServiceStats :many
+SELECT
+    clinic_service,
+    COUNT(*) AS total,
+    COUNT(*) FILTER (WHERE status = 'completed') AS completed,
+    COUNT(*) FILTER (WHERE status = 'not_presented') AS no_shows,
+    COUNT(*) FILTER (WHERE status = 'cancelled') AS cancelled
+FROM appointments
+WHERE scheduled_date >= @start_date
+    AND scheduled_date < @end_date
+    AND (@employee_id::INT = 0 OR employee_id = @employee_id)
+    AND deleted_at IS NULL
+GROUP BY clinic_service
+ORDER BY total DESC, clinic_service;
+
+-- name: GetAppointmentWeekdayCounts :many
+SELECT EXTRACT(DOW FROM scheduled_date)::INT AS weekday, COUNT(*) AS total
+FROM appointments
+WHERE scheduled_date >= @start_date
+    AND scheduled_date < @end_date
+    AND (@employee_id::INT = 0 OR employee_id = @employee_id)
+    AND status <> 'cancelled'
+    AND deleted_at IS NULL
+GROUP BY weekday
+ORDER BY total DESC, weekday;
+
+-- name: GetAppointmentHourCounts :many
+SELECT EXTRACT(HOUR FROM scheduled_date)::INT AS hour, COUNT(*) AS total
+FROM appointments
+WHERE scheduled_date >= @start_date
+    AND scheduled_date < @end_date
+    AND (@employee_id::INT = 0 OR employee_id = @employee_id)
+    AND status <> 'cancelled'
+    AND deleted_at IS NULL
+GROUP BY hour
+ORDER BY total DESC, hour;
+
+-- name: GetAppointmentAverageLeadTime :one
+SELECT COALESCE(AVG(EXTRACT(EPOCH FROM (scheduled_date - created_at))), 0)::FLOAT8 AS avg_lead_seconds
+FROM appointments
+WHERE scheduled_date >= @start_date
+    AND scheduled_date < @end_date
+    AND (@employee_id::INT = 0 OR employee_id = @employee_id)
+    AND deleted_at IS NULL;
@@ -0,0 +1,78 @@
+-- name: CreateWaitlistEntry :one
+INSERT INTO appointment_waitlist (
+    customer_id, pet_id, clinic_service, requested_date, 
+    preferred_employee_id, notes, status
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING *;
+
+-- name: UpdateWaitlistEntry :exec
+UPDATE appointment_waitlist
+SET 
+    status = $2,
+    offered_slot = $3,
+    offered_employee_id = $4,
+    claim_token_hash = $5,
+    offer_expires_at = $6,
+    appointment_id = $7,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: OfferWaitlistSlot :execrows
+UPDATE appointment_waitlist
+SET 
+    status = 'offered',
+    offered_slot = $2,
+    offered_employee_id = $3,
+    claim_token_hash = $4,
+    offer_expires_at = $5,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 'waiting';
+
+-- name: ClaimWaitlistOffer :execrows
+UPDATE appointment_waitlist
+SET 
+    status = 'claimed',
+    claim_token_hash = NULL,
+    appointment_id = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 'offered';
+
+-- name: FindWaitlistEntryByID :one
+SELECT * FROM appointment_waitlist
+WHERE id = $1;
+
+-- name: FindWaitlistEntryByClaimToken :one
+SELECT * FROM appointment_waitlist
+WHERE claim_token_hash = $1;
+
+-- name: FindWaitlistEntriesByCustomer :many
+SELECT * FROM appointment_waitlist
+WHERE customer_id = $1
+ORDER BY requested_date DESC, created_at DESC;
+
+-- name: FindWaitingEntriesForSlot :many
+SELECT * FROM appointment_waitlist
+WHERE requested_date = $1
+    AND clinic_service = $2
+    AND status = 'waiting'
+    AND (preferred_employee_id IS NULL OR preferred_employee_id = sqlc.narg(employee_id))
+ORDER BY created_at ASC, id ASC;
+
+-- name: FindExpiredWaitlistOffers :many
+SELECT * FROM appointment_waitlist
+WHERE status = 'offered' AND offer_expires_at < $1
+ORDER BY offer_expires_at ASC;
+
+-- name: MissWaitlistOffer :execrows
+UPDATE appointment_waitlist
+SET 
+    status = $2,
+    missed_offers = $3,
+    offered_slot = $4,
+    offered_employee_id = $5,
+    claim_token_hash = NULL,
+    offer_expires_at = $6,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 'offered';
@@ -0,0 +1,20 @@
+-- name: CreateCalendarFeed :one
+INSERT INTO calendar_feeds (
+    owner_type, owner_id, created_at
+) VALUES (
+    $1, $2, CURRENT_TIMESTAMP
+)
+RETURNING *;
+
+-- name: FindCalendarFeedByID :one
+SELECT * FROM calendar_feeds
+WHERE id = $1;
+
+-- name: FindActiveCalendarFeedByOwner :one
+SELECT * FROM calendar_feeds
+WHERE owner_type = $1 AND owner_id = $2 AND revoked_at IS NULL;
+
+-- name: RevokeCalendarFeedsByOwner :execrows
+UPDATE calendar_feeds
+SET revoked_at = CURRENT_TIMESTAMP
+WHERE owner_type = $1 AND owner_id = $2 AND revoked_at IS NULL;
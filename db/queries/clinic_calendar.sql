@@ -0,0 +1,78 @@
+-- name: ListClinicOpeningHours :many
+SELECT *
+FROM clinic_opening_hours
+ORDER BY weekday;
+
+-- name: UpsertClinicOpeningHours :exec
+INSERT INTO clinic_opening_hours (
+    weekday,
+    open_hour,
+    close_hour,
+    is_closed,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, CURRENT_TIMESTAMP
+)
+ON CONFLICT (weekday) DO UPDATE SET
+    open_hour = EXCLUDED.open_hour,
+    close_hour = EXCLUDED.close_hour,
+    is_closed = EXCLUDED.is_closed,
+    updated_at = CURRENT_TIMESTAMP;
+
+-- name: GetClinicBookingPolicy :one
+SELECT *
+FROM clinic_booking_policy
+WHERE id = 1;
+
+-- name: UpsertClinicBookingPolicy :exec
+INSERT INTO clinic_booking_policy (
+    id,
+    min_lead_days,
+    max_lead_days,
+    updated_at
+) VALUES (
+    1, $1, $2, CURRENT_TIMESTAMP
+)
+ON CONFLICT (id) DO UPDATE SET
+    min_lead_days = EXCLUDED.min_lead_days,
+    max_lead_days = EXCLUDED.max_lead_days,
+    updated_at = CURRENT_TIMESTAMP;
+
+-- name: FindClinicClosureByID :one
+SELECT *
+FROM clinic_closures
+WHERE id = $1;
+
+-- name: FindClinicClosuresByDateRange :many
+SELECT *
+FROM clinic_closures
+WHERE closure_date BETWEEN $1 AND $2
+ORDER BY closure_date;
+
+-- name: CreateClinicClosure :one
+INSERT INTO clinic_closures (
+    closure_date,
+    closure_type,
+    reason,
+    start_hour,
+    end_hour
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: UpdateClinicClosure :one
+UPDATE clinic_closures
+SET
+    closure_date = $2,
+    closure_type = $3,
+    reason = $4,
+    start_hour = $5,
+    end_hour = $6,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteClinicClosure :exec
+DELETE FROM clinic_closures
+WHERE id = $1;
@@ -0,0 +1,106 @@
+-- name: FindClinicResourceByID :one
+SELECT *
+FROM clinic_resources
+WHERE id = $1;
+
+-- name: ListClinicResources :many
+SELECT *
+FROM clinic_resources
+WHERE (@include_inactive::BOOLEAN OR is_active = TRUE)
+ORDER BY resource_type, name;
+
+-- name: ExistsClinicResourceByName :one
+SELECT EXISTS(
+    SELECT 1 FROM clinic_resources
+    WHERE LOWER(name) = LOWER(@name) AND id <> @exclude_id
+);
+
+-- name: CreateClinicResource :one
+INSERT INTO clinic_resources (
+    name,
+    resource_type,
+    description,
+    is_active,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+) RETURNING *;
+
+-- name: UpdateClinicResource :one
+UPDATE clinic_resources SET
+    name = $2,
+    resource_type = $3,
+    description = $4,
+    is_active = $5,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: LockActiveClinicResourcesByType :many
+SELECT *
+FROM clinic_resources
+WHERE resource_type = $1 AND is_active = TRUE
+ORDER BY id
+FOR UPDATE;
+
+-- name: ListServiceResourceRequirements :many
+SELECT *
+FROM service_resource_requirements
+WHERE clinic_service = $1
+ORDER BY resource_type;
+
+-- name: ListAllServiceResourceRequirements :many
+SELECT *
+FROM service_resource_requirements
+ORDER BY clinic_service, resource_type;
+
+-- name: DeleteServiceResourceRequirements :exec
+DELETE FROM service_resource_requirements
+WHERE clinic_service = $1;
+
+-- name: CreateServiceResourceRequirement :exec
+INSERT INTO service_resource_requirements (
+    clinic_service,
+    resource_type,
+    quantity
+) VALUES (
+    $1, $2, $3
+);
+
+-- name: ListBusyClinicResourceIDs :many
+SELECT DISTINCT r.resource_id
+FROM appointment_resource_reservations r
+JOIN appointments a ON a.id = r.appointment_id
+WHERE r.resource_id = ANY(@resource_ids::INT[])
+    AND r.start_time < @end_time
+    AND r.end_time > @start_time
+    AND r.appointment_id <> @appointment_id
+    AND a.status IN ('pending', 'confirmed', 'rescheduled')
+    AND a.deleted_at IS NULL;
+
+-- name: DeleteAppointmentReservations :exec
+DELETE FROM appointment_resource_reservations
+WHERE appointment_id = $1;
+
+-- name: CreateResourceReservation :exec
+INSERT INTO appointment_resource_reservations (
+    appointment_id,
+    resource_id,
+    start_time,
+    end_time,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, CURRENT_TIMESTAMP
+);
+
+-- name: ListResourceReservations :many
+SELECT r.id, r.appointment_id, r.resource_id, r.start_time, r.end_time, a.clinic_service, a.status
+FROM appointment_resource_reservations r
+JOIN appointments a ON a.id = r.appointment_id
+WHERE r.resource_id = @resource_id
+    AND r.start_time < @end_time
+    AND r.end_time > @start_time
+    AND a.status IN ('pending', 'confirmed', 'rescheduled')
+    AND a.deleted_at IS NULL
+ORDER BY r.start_time;
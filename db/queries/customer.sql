@@ -1,9 +1,9 @@
 -- name: CreateCustomer :one
 INSERT INTO customers (
     photo, first_name, last_name, gender, 
-    user_id, is_active, date_of_birth
+    user_id, is_active, date_of_birth, preferred_notification_channel
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
+    $1, $2, $3, $4, $5, $6, $7, $8
 )
 RETURNING *;
 
@@ -27,6 +27,7 @@ SET
     user_id = $6, 
     is_active = $7, 
     date_of_birth = $8,
+    preferred_notification_channel = $9,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = $1 AND deleted_at IS NULL;
 
@@ -62,6 +62,11 @@ WHERE id = $1;
 -- name: HardDeleteEmployee :exec
 DELETE FROM employees WHERE id = $1;
 
+-- name: LockEmployeeSchedule :exec
+SELECT id FROM employees
+WHERE id = $1
+FOR UPDATE;
+
 -- name: ExistsEmployeeByID :one
 SELECT COUNT(*) > 0 FROM employees
 WHERE id = $1 AND deleted_at IS NULL;
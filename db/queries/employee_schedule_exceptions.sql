@@ -0,0 +1,50 @@
+-- name: CreateScheduleException :one
+INSERT INTO employee_schedule_exceptions (
+    employee_id, exception_type, start_date, end_date, reason, status, created_at, updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+) RETURNING *;
+
+-- name: UpdateScheduleExceptionStatus :exec
+UPDATE employee_schedule_exceptions
+SET
+    status = $2,
+    reviewed_by = $3,
+    review_notes = $4,
+    reviewed_at = $5,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: FindScheduleExceptionByID :one
+SELECT * FROM employee_schedule_exceptions
+WHERE id = $1;
+
+-- name: FindScheduleExceptionsByEmployee :many
+SELECT * FROM employee_schedule_exceptions
+WHERE employee_id = $1
+    AND (sqlc.narg(status)::VARCHAR IS NULL OR status = sqlc.narg(status))
+ORDER BY start_date DESC, id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountScheduleExceptionsByEmployee :one
+SELECT COUNT(*) FROM employee_schedule_exceptions
+WHERE employee_id = $1
+    AND (sqlc.narg(status)::VARCHAR IS NULL OR status = sqlc.narg(status));
+
+-- name: FindScheduleExceptionsByStatus :many
+SELECT * FROM employee_schedule_exceptions
+WHERE status = $1
+ORDER BY start_date ASC, id ASC
+LIMIT $2 OFFSET $3;
+
+-- name: CountScheduleExceptionsByStatus :one
+SELECT COUNT(*) FROM employee_schedule_exceptions
+WHERE status = $1;
+
+-- name: FindApprovedScheduleExceptions :many
+SELECT * FROM employee_schedule_exceptions
+WHERE employee_id = @employee_id
+    AND status = 'approved'
+    AND start_date < @end_date
+    AND end_date > @start_date
+ORDER BY start_date ASC;
@@ -0,0 +1,176 @@
+-- name: FindInventoryProductByID :one
+SELECT *
+FROM inventory_products
+WHERE id = $1;
+
+-- name: ListInventoryProducts :many
+SELECT *
+FROM inventory_products
+WHERE (@include_inactive::BOOLEAN OR is_active = TRUE)
+ORDER BY category, name;
+
+-- name: ExistsInventoryProductByName :one
+SELECT EXISTS(
+    SELECT 1 FROM inventory_products
+    WHERE LOWER(name) = LOWER(@name) AND id <> @exclude_id
+);
+
+-- name: CreateInventoryProduct :one
+INSERT INTO inventory_products (
+    name,
+    category,
+    unit,
+    reorder_level,
+    description,
+    is_active,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+) RETURNING *;
+
+-- name: UpdateInventoryProduct :one
+UPDATE inventory_products SET
+    name = $2,
+    category = $3,
+    unit = $4,
+    reorder_level = $5,
+    description = $6,
+    is_active = $7,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: FindStockLocationByID :one
+SELECT *
+FROM stock_locations
+WHERE id = $1;
+
+-- name: ListStockLocations :many
+SELECT *
+FROM stock_locations
+WHERE (@include_inactive::BOOLEAN OR is_active = TRUE)
+ORDER BY name;
+
+-- name: ExistsStockLocationByName :one
+SELECT EXISTS(
+    SELECT 1 FROM stock_locations
+    WHERE LOWER(name) = LOWER(@name) AND id <> @exclude_id
+);
+
+-- name: CreateStockLocation :one
+INSERT INTO stock_locations (
+    name,
+    description,
+    is_active,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+) RETURNING *;
+
+-- name: UpdateStockLocation :one
+UPDATE stock_locations SET
+    name = $2,
+    description = $3,
+    is_active = $4,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING *;
+
+-- name: FindStockLotByID :one
+SELECT *
+FROM stock_lots
+WHERE id = $1;
+
+-- name: LockStockLot :one
+SELECT *
+FROM stock_lots
+WHERE id = $1
+FOR UPDATE;
+
+-- name: FindStockLotByNumber :one
+SELECT *
+FROM stock_lots
+WHERE product_id = $1 AND location_id = $2 AND lot_number = $3;
+
+-- name: ListStockLotsByProduct :many
+SELECT *
+FROM stock_lots
+WHERE product_id = $1 AND (@include_empty::BOOLEAN OR quantity_on_hand > 0)
+ORDER BY expires_on, id;
+
+-- name: ListExpiringStockLots :many
+SELECT *
+FROM stock_lots
+WHERE quantity_on_hand > 0 AND expires_on < $1
+ORDER BY expires_on, id;
+
+-- name: ListLowStockProducts :many
+SELECT
+    p.id,
+    p.name,
+    p.category,
+    p.unit,
+    p.reorder_level,
+    p.description,
+    p.is_active,
+    p.created_at,
+    p.updated_at,
+    COALESCE(SUM(l.quantity_on_hand) FILTER (WHERE l.expires_on >= @today::DATE), 0)::INT AS on_hand
+FROM inventory_products p
+LEFT JOIN stock_lots l ON l.product_id = p.id
+WHERE p.is_active = TRUE
+GROUP BY p.id
+HAVING COALESCE(SUM(l.quantity_on_hand) FILTER (WHERE l.expires_on >= @today::DATE), 0) <= p.reorder_level
+ORDER BY p.category, p.name;
+
+-- name: CreateStockLot :one
+INSERT INTO stock_lots (
+    product_id,
+    location_id,
+    lot_number,
+    expires_on,
+    quantity_on_hand,
+    received_at,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+) RETURNING *;
+
+-- name: UpdateStockLotQuantity :exec
+UPDATE stock_lots SET
+    quantity_on_hand = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: CreateStockMovement :one
+INSERT INTO stock_movements (
+    lot_id,
+    product_id,
+    movement_type,
+    quantity,
+    balance_after,
+    reason,
+    reference_type,
+    reference_id,
+    performed_by,
+    occurred_at,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+) RETURNING *;
+
+-- name: ListStockMovementsByLot :many
+SELECT *
+FROM stock_movements
+WHERE lot_id = $1
+ORDER BY occurred_at DESC, id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountStockMovementsByLot :one
+SELECT COUNT(*)
+FROM stock_movements
+WHERE lot_id = $1;
@@ -0,0 +1,94 @@
+-- name: CreateLabOrder :one
+INSERT INTO lab_orders (
+    medical_session_id, pet_id, ordered_by, lab_name, accession_number, status, notes, ordered_at,
+    created_at, updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+) RETURNING *;
+
+-- name: UpdateLabOrderStatus :execrows
+UPDATE lab_orders
+SET
+    status = $2,
+    resulted_at = $3,
+    cancelled_at = $4,
+    cancel_reason = $5,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+    AND status IN ('ordered', 'partial')
+    AND ($2 <> 'cancelled' OR status = 'ordered');
+
+-- name: FindLabOrderByID :one
+SELECT * FROM lab_orders
+WHERE id = $1;
+
+-- name: FindLabOrdersBySession :many
+SELECT * FROM lab_orders
+WHERE medical_session_id = $1
+ORDER BY ordered_at ASC, id ASC;
+
+-- name: FindLabOrdersByPet :many
+SELECT * FROM lab_orders
+WHERE pet_id = $1
+ORDER BY ordered_at DESC, id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountLabOrdersByPet :one
+SELECT COUNT(*) FROM lab_orders
+WHERE pet_id = $1;
+
+-- name: CreateLabOrderPanel :exec
+INSERT INTO lab_order_panels (lab_order_id, panel_code, panel_name)
+VALUES ($1, $2, $3);
+
+-- name: FindLabOrderPanelsByOrders :many
+SELECT * FROM lab_order_panels
+WHERE lab_order_id = ANY(@lab_order_ids::int[])
+ORDER BY lab_order_id, panel_code;
+
+-- name: UpsertLabResult :one
+INSERT INTO lab_results (
+    lab_order_id, pet_id, panel_code, analyte_code, analyte_name, numeric_value, text_value, unit,
+    reference_low, reference_high, flag, observed_at, created_at, updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+)
+ON CONFLICT (lab_order_id, analyte_code) DO UPDATE SET
+    panel_code = EXCLUDED.panel_code,
+    analyte_name = EXCLUDED.analyte_name,
+    numeric_value = EXCLUDED.numeric_value,
+    text_value = EXCLUDED.text_value,
+    unit = EXCLUDED.unit,
+    reference_low = EXCLUDED.reference_low,
+    reference_high = EXCLUDED.reference_high,
+    flag = EXCLUDED.flag,
+    observed_at = EXCLUDED.observed_at,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: FindLabResultsByOrders :many
+SELECT * FROM lab_results
+WHERE lab_order_id = ANY(@lab_order_ids::int[])
+ORDER BY lab_order_id, panel_code, id;
+
+-- name: FindLabResultsByAnalyte :many
+SELECT lab_results.* FROM lab_results
+JOIN lab_orders ON lab_orders.id = lab_results.lab_order_id
+WHERE lab_results.pet_id = $1
+    AND lab_results.analyte_code = $2
+    AND lab_results.observed_at >= @observed_from
+    AND lab_results.observed_at < @observed_to
+    AND lab_orders.status <> 'cancelled'
+ORDER BY lab_results.observed_at ASC, lab_results.id ASC;
+
+-- name: FindOpenLabOrdersByAccession :many
+SELECT * FROM lab_orders
+WHERE accession_number = $1
+    AND status IN ('ordered', 'partial')
+ORDER BY ordered_at DESC, id DESC;
+
+-- name: FindOpenLabOrdersByPet :many
+SELECT * FROM lab_orders
+WHERE pet_id = $1
+    AND status IN ('ordered', 'partial')
+ORDER BY ordered_at DESC, id DESC;
@@ -0,0 +1,32 @@
+-- name: CreateMedicalAttachment :one
+INSERT INTO medical_attachments (
+    pet_id, medical_session_id, uploaded_by, category, file_name, content_type, size_bytes,
+    checksum_sha256, storage_key, description, created_at, updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+) RETURNING *;
+
+-- name: FindMedicalAttachmentByID :one
+SELECT * FROM medical_attachments
+WHERE id = $1;
+
+-- name: FindMedicalAttachmentsByPet :many
+SELECT * FROM medical_attachments
+WHERE pet_id = $1
+    AND (@category::VARCHAR = '' OR category = @category)
+ORDER BY created_at DESC, id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountMedicalAttachmentsByPet :one
+SELECT COUNT(*) FROM medical_attachments
+WHERE pet_id = $1
+    AND (@category::VARCHAR = '' OR category = @category);
+
+-- name: FindMedicalAttachmentsBySession :many
+SELECT * FROM medical_attachments
+WHERE medical_session_id = $1
+ORDER BY created_at ASC, id ASC;
+
+-- name: DeleteMedicalAttachment :exec
+DELETE FROM medical_attachments
+WHERE id = $1;
@@ -102,12 +102,50 @@ INSERT INTO medical_sessions (
     weight,
     temperature,
     heart_rate,
-    respiratory_rate
+    respiratory_rate,
+    follow_up_date,
+    weight_flag,
+    temperature_flag,
+    heart_rate_flag,
+    respiratory_rate_flag
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19
 )
 RETURNING *;
 
+-- name: CreateAppointmentMedicalSession :one
+INSERT INTO medical_sessions (
+    pet_id,
+    customer_id,
+    employee_id,
+    appointment_id,
+    clinic_service,
+    visit_date,
+    visit_type,
+    is_emergency,
+    status
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, 'draft'
+)
+RETURNING *;
+
+-- name: ExistsMedicalSessionByAppointmentID :one
+SELECT COUNT(*) > 0 FROM medical_sessions
+WHERE appointment_id = $1 AND deleted_at IS NULL;
+
+-- name: CloseMedicalSession :execrows
+UPDATE medical_sessions
+SET
+    status = 'closed',
+    closed_at = $2,
+    diagnosis = $3,
+    treatment = $4,
+    condition = $5,
+    notes = $6,
+    follow_up_date = $7,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 'draft' AND deleted_at IS NULL;
+
 -- name: UpdateMedicalSession :one
 UPDATE medical_sessions
 SET 
@@ -125,10 +163,21 @@ SET
     heart_rate = $13,
     respiratory_rate = $14,
     clinic_service = $15,
+    weight_flag = $16,
+    temperature_flag = $17,
+    heart_rate_flag = $18,
+    respiratory_rate_flag = $19,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = $1 AND deleted_at IS NULL
 RETURNING *;
 
+-- name: UpdateMedicalSessionFollowUpDate :exec
+UPDATE medical_sessions
+SET
+    follow_up_date = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND deleted_at IS NULL;
+
 -- name: SoftDeleteMedicalSession :exec
 UPDATE medical_sessions
 SET 
@@ -0,0 +1,12 @@
+-- name: CreateMedicalSessionFollowUp :exec
+INSERT INTO medical_session_follow_ups (
+    medical_session_id,
+    appointment_id
+) VALUES (
+    $1, $2
+);
+
+-- name: FindMedicalSessionFollowUp :one
+SELECT * FROM medical_session_follow_ups
+WHERE medical_session_id = $1;
+
@@ -0,0 +1,40 @@
+-- name: CreateOnCallShift :one
+INSERT INTO on_call_shifts (
+    employee_id, specialty, starts_at, ends_at, created_at, updated_at
+) VALUES (
+    $1, $2, $3, $4, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+) RETURNING *;
+
+-- name: DeleteOnCallShiftsInPeriod :exec
+DELETE FROM on_call_shifts
+WHERE specialty = @specialty
+    AND starts_at < @period_end
+    AND ends_at > @period_start;
+
+-- name: FindOnCallShiftsAt :many
+SELECT * FROM on_call_shifts
+WHERE starts_at <= @at
+    AND ends_at > @at
+    AND (sqlc.narg(specialty)::VARCHAR IS NULL OR specialty = sqlc.narg(specialty))
+ORDER BY specialty ASC, starts_at ASC;
+
+-- name: FindOnCallShifts :many
+SELECT * FROM on_call_shifts
+WHERE starts_at < @period_end
+    AND ends_at > @period_start
+    AND (sqlc.narg(specialty)::VARCHAR IS NULL OR specialty = sqlc.narg(specialty))
+ORDER BY starts_at ASC, specialty ASC
+LIMIT @limit_val OFFSET @offset_val;
+
+-- name: CountOnCallShifts :one
+SELECT COUNT(*) FROM on_call_shifts
+WHERE starts_at < @period_end
+    AND ends_at > @period_start
+    AND (sqlc.narg(specialty)::VARCHAR IS NULL OR specialty = sqlc.narg(specialty));
+
+-- name: FindLastOnCallShiftBefore :one
+SELECT * FROM on_call_shifts
+WHERE specialty = @specialty
+    AND starts_at < @before
+ORDER BY starts_at DESC
+LIMIT 1;
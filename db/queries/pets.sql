@@ -87,6 +87,11 @@ WHERE id = $1;
 -- name: HardDeletePet :exec
 DELETE FROM pets WHERE id = $1;
 
+-- name: LockPetSchedule :exec
+SELECT id FROM pets
+WHERE id = $1
+FOR UPDATE;
+
 
 -- name: RestorePet :exec
 UPDATE pets
@@ -0,0 +1,54 @@
+-- name: CreatePrescription :one
+INSERT INTO prescriptions (
+    medical_session_id, pet_id, prescribed_by, drug_name, strength, dose, route, frequency, duration_days,
+    quantity, refills_authorized, refills_remaining, instructions, status, prescribed_at, last_filled_at,
+    created_at, updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+) RETURNING *;
+
+-- name: UpdatePrescriptionFills :exec
+UPDATE prescriptions
+SET
+    refills_remaining = $2,
+    status = $3,
+    last_filled_at = $4,
+    cancelled_at = $5,
+    cancel_reason = $6,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: RefillPrescription :execrows
+UPDATE prescriptions
+SET
+    refills_remaining = refills_remaining - 1,
+    last_filled_at = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND refills_remaining > 0 AND status = 'active';
+
+-- name: FindPrescriptionByID :one
+SELECT * FROM prescriptions
+WHERE id = $1;
+
+-- name: FindPrescriptionsBySession :many
+SELECT * FROM prescriptions
+WHERE medical_session_id = $1
+ORDER BY prescribed_at ASC, id ASC;
+
+-- name: FindPrescriptionsByPet :many
+SELECT * FROM prescriptions
+WHERE pet_id = $1
+ORDER BY prescribed_at DESC, id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountPrescriptionsByPet :one
+SELECT COUNT(*) FROM prescriptions
+WHERE pet_id = $1;
+
+-- name: FindActivePrescriptionsByPet :many
+SELECT * FROM prescriptions
+WHERE pet_id = @pet_id
+    AND status = 'active'
+    AND last_filled_at <= @at
+    AND last_filled_at + make_interval(days => duration_days) > @at
+ORDER BY drug_name ASC, id ASC;
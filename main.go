@@ -13,7 +13,9 @@ import (
 	"clinic-vet-api/app/config"
 	"clinic-vet-api/app/middleware"
 	notiAPI "clinic-vet-api/app/modules/notification/presentation"
+	"clinic-vet-api/app/shared/database"
 	"clinic-vet-api/app/shared/log"
+	"clinic-vet-api/app/shared/worker"
 	"clinic-vet-api/sqlc"
 
 	_ "clinic-vet-api/docs"
@@ -32,6 +34,7 @@ type Application struct {
 	Server    *http.Server
 	Validator *validator.Validate
 	Queries   *sqlc.Queries
+	Workers   *worker.Scheduler
 }
 
 // @title API Clínica Veterinaria
@@ -63,6 +66,9 @@ func main() {
 		}
 	}()
 
+	// Start background jobs
+	app.Workers.Start(ctx)
+
 	log.App.Info(fmt.Sprintf("Environment: %s", app.Settings.Server.Environment))
 	log.App.Info(fmt.Sprintf("Debug mode: %t", app.Settings.App.Debug))
 
@@ -101,6 +107,7 @@ func initializeApplication() (*Application, error) {
 	// Initialize database
 	pxpool := config.CreatePgxPool(settings.Database.URL)
 	queries := sqlc.New(pxpool)
+	transactor := database.NewTransactor(pxpool, queries)
 
 	// Initialize validator
 	dataValidator := validator.New()
@@ -109,7 +116,8 @@ func initializeApplication() (*Application, error) {
 	router := setupRouter(settings)
 
 	// Setup modules
-	if err := setupModules(router, settings, queries, dataValidator); err != nil {
+	workers := worker.NewScheduler()
+	if err := setupModules(router, settings, queries, transactor, dataValidator, workers); err != nil {
 		return nil, fmt.Errorf("failed to setup modules: %w", err)
 	}
 
@@ -122,6 +130,7 @@ func initializeApplication() (*Application, error) {
 		Server:    server,
 		Validator: dataValidator,
 		Queries:   queries,
+		Workers:   workers,
 	}
 
 	return app, nil
@@ -197,7 +206,14 @@ func setupCORS(settings *config.AppSettings) gin.HandlerFunc {
 }
 
 // setupModules initializes and registers all application modules
-func setupModules(router *gin.Engine, settings *config.AppSettings, queries *sqlc.Queries, validator *validator.Validate) error {
+func setupModules(
+	router *gin.Engine,
+	settings *config.AppSettings,
+	queries *sqlc.Queries,
+	transactor *database.Transactor,
+	validator *validator.Validate,
+	workers *worker.Scheduler,
+) error {
 	// Initialize MongoDB for notification module
 	mongoClient := config.InitMongoDB(settings.Services.Mongo)
 
@@ -206,7 +222,7 @@ func setupModules(router *gin.Engine, settings *config.AppSettings, queries *sql
 	notificationService := notiAPI.SetupNotificationModule(routerGroup, mongoClient, settings.Services.Email, config.GetTwilioClient())
 
 	// Bootstrap other API modules
-	if err := config.BootstrapAPIModules(routerGroup, queries, notificationService, validator, config.RedisClient, settings.Auth.JWTSecret); err != nil {
+	if err := config.BootstrapAPIModules(routerGroup, queries, transactor, notificationService, validator, config.RedisClient, settings.Auth.JWTSecret, workers, settings); err != nil {
 		return fmt.Errorf("failed to bootstrap API modules: %w", err)
 	}
 
@@ -285,6 +301,9 @@ func (app *Application) waitForShutdown(ctx context.Context) {
 		log.App.Error(fmt.Sprintf("Server forced to shutdown: %v", err))
 	}
 
+	// Stop background jobs
+	app.Workers.Stop()
+
 	// Cleanup resources
 	app.cleanup()
 
@@ -16,10 +16,10 @@ const countAppointmentsBySpec = `-- name: CountAppointmentsBySpec :one
 SELECT COUNT(*) 
 FROM appointments 
 WHERE 
-    ($1::INT IS NULL OR id = $1)
-    AND ($2::INT IS NULL OR customer_id = $2)
-    AND ($3::INT IS NULL OR employee_id = $3)
-    AND ($4::INT IS NULL OR pet_id = $4)
+    ($1::INT = 0 OR id = $1)
+    AND ($2::INT = 0 OR customer_id = $2)
+    AND ($3::INT = 0 OR employee_id = $3)
+    AND ($4::INT = 0 OR pet_id = $4)
     AND (
         $5::TEXT IS NULL OR 
         $5::TEXT = '' OR 
@@ -73,22 +73,36 @@ INSERT INTO appointments (
     customer_id, 
     employee_id,
     pet_id,
+    series_id,
+    is_emergency,
+    triage_priority,
+    visit_stage,
+    checked_in_at,
+    in_exam_room_at,
+    ready_for_checkout_at,
     created_at,
     updated_at,
     deleted_at
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, NULL
-) RETURNING id, clinic_service, scheduled_date, status, notes, customer_id, pet_id, employee_id, created_at, updated_at, deleted_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, NULL
+) RETURNING id, clinic_service, scheduled_date, status, notes, customer_id, pet_id, employee_id, created_at, updated_at, deleted_at, series_id, sequence, is_emergency, triage_priority, visit_stage, checked_in_at, in_exam_room_at, ready_for_checkout_at
 `
 
 type CreateAppointmentParams struct {
-	ClinicService models.ClinicService
-	ScheduledDate pgtype.Timestamptz
-	Status        models.AppointmentStatus
-	Notes         pgtype.Text
-	CustomerID    int32
-	EmployeeID    pgtype.Int4
-	PetID         int32
+	ClinicService      models.ClinicService
+	ScheduledDate      pgtype.Timestamptz
+	Status             models.AppointmentStatus
+	Notes              pgtype.Text
+	CustomerID         int32
+	EmployeeID         pgtype.Int4
+	PetID              int32
+	SeriesID           pgtype.Int4
+	IsEmergency        bool
+	TriagePriority     pgtype.Text
+	VisitStage         pgtype.Text
+	CheckedInAt        pgtype.Timestamptz
+	InExamRoomAt       pgtype.Timestamptz
+	ReadyForCheckoutAt pgtype.Timestamptz
 }
 
 func (q *Queries) CreateAppointment(ctx context.Context, arg CreateAppointmentParams) (Appointment, error) {
@@ -100,6 +114,13 @@ func (q *Queries) CreateAppointment(ctx context.Context, arg CreateAppointmentPa
 		arg.CustomerID,
 		arg.EmployeeID,
 		arg.PetID,
+		arg.SeriesID,
+		arg.IsEmergency,
+		arg.TriagePriority,
+		arg.VisitStage,
+		arg.CheckedInAt,
+		arg.InExamRoomAt,
+		arg.ReadyForCheckoutAt,
 	)
 	var i Appointment
 	err := row.Scan(
@@ -114,6 +135,14 @@ func (q *Queries) CreateAppointment(ctx context.Context, arg CreateAppointmentPa
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.SeriesID,
+		&i.Sequence,
+		&i.IsEmergency,
+		&i.TriagePriority,
+		&i.VisitStage,
+		&i.CheckedInAt,
+		&i.InExamRoomAt,
+		&i.ReadyForCheckoutAt,
 	)
 	return i, err
 }
@@ -160,7 +189,7 @@ func (q *Queries) ExistsConflictingAppointment(ctx context.Context, arg ExistsCo
 }
 
 const findAppointmentByID = `-- name: FindAppointmentByID :one
-SELECT id, clinic_service, scheduled_date, status, notes, customer_id, pet_id, employee_id, created_at, updated_at, deleted_at FROM appointments 
+SELECT id, clinic_service, scheduled_date, status, notes, customer_id, pet_id, employee_id, created_at, updated_at, deleted_at, series_id, sequence, is_emergency, triage_priority, visit_stage, checked_in_at, in_exam_room_at, ready_for_checkout_at FROM appointments 
 WHERE id = $1 
 AND deleted_at IS NULL
 `
@@ -180,12 +209,20 @@ func (q *Queries) FindAppointmentByID(ctx context.Context, id int32) (Appointmen
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.SeriesID,
+		&i.Sequence,
+		&i.IsEmergency,
+		&i.TriagePriority,
+		&i.VisitStage,
+		&i.CheckedInAt,
+		&i.InExamRoomAt,
+		&i.ReadyForCheckoutAt,
 	)
 	return i, err
 }
 
 const findAppointmentByIDAndCustomerID = `-- name: FindAppointmentByIDAndCustomerID :one
-SELECT id, clinic_service, scheduled_date, status, notes, customer_id, pet_id, employee_id, created_at, updated_at, deleted_at FROM appointments 
+SELECT id, clinic_service, scheduled_date, status, notes, customer_id, pet_id, employee_id, created_at, updated_at, deleted_at, series_id, sequence, is_emergency, triage_priority, visit_stage, checked_in_at, in_exam_room_at, ready_for_checkout_at FROM appointments 
 WHERE id = $1 
 AND customer_id = $2
 AND deleted_at IS NULL
@@ -211,12 +248,20 @@ func (q *Queries) FindAppointmentByIDAndCustomerID(ctx context.Context, arg Find
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.SeriesID,
+		&i.Sequence,
+		&i.IsEmergency,
+		&i.TriagePriority,
+		&i.VisitStage,
+		&i.CheckedInAt,
+		&i.InExamRoomAt,
+		&i.ReadyForCheckoutAt,
 	)
 	return i, err
 }
 
 const findAppointmentByIDAndEmployeeID = `-- name: FindAppointmentByIDAndEmployeeID :one
-SELECT id, clinic_service, scheduled_date, status, notes, customer_id, pet_id, employee_id, created_at, updated_at, deleted_at FROM appointments 
+SELECT id, clinic_service, scheduled_date, status, notes, customer_id, pet_id, employee_id, created_at, updated_at, deleted_at, series_id, sequence, is_emergency, triage_priority, visit_stage, checked_in_at, in_exam_room_at, ready_for_checkout_at FROM appointments 
 WHERE id = $1 
 AND employee_id = $2
 AND deleted_at IS NULL
@@ -242,20 +287,75 @@ func (q *Queries) FindAppointmentByIDAndEmployeeID(ctx context.Context, arg Find
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.SeriesID,
+		&i.Sequence,
+		&i.IsEmergency,
+		&i.TriagePriority,
+		&i.VisitStage,
+		&i.CheckedInAt,
+		&i.InExamRoomAt,
+		&i.ReadyForCheckoutAt,
 	)
 	return i, err
 }
 
+const findAppointmentsBySeries = `-- name: FindAppointmentsBySeries :many
+SELECT id, clinic_service, scheduled_date, status, notes, customer_id, pet_id, employee_id, created_at, updated_at, deleted_at, series_id, sequence, is_emergency, triage_priority, visit_stage, checked_in_at, in_exam_room_at, ready_for_checkout_at FROM appointments
+WHERE series_id = $1
+AND deleted_at IS NULL
+ORDER BY scheduled_date ASC
+`
+
+func (q *Queries) FindAppointmentsBySeries(ctx context.Context, seriesID pgtype.Int4) ([]Appointment, error) {
+	rows, err := q.db.Query(ctx, findAppointmentsBySeries, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Appointment
+	for rows.Next() {
+		var i Appointment
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClinicService,
+			&i.ScheduledDate,
+			&i.Status,
+			&i.Notes,
+			&i.CustomerID,
+			&i.PetID,
+			&i.EmployeeID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.SeriesID,
+			&i.Sequence,
+			&i.IsEmergency,
+			&i.TriagePriority,
+			&i.VisitStage,
+			&i.CheckedInAt,
+			&i.InExamRoomAt,
+			&i.ReadyForCheckoutAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findAppointmentsBySpec = `-- name: FindAppointmentsBySpec :many
 SELECT 
     id, clinic_service, scheduled_date, status, notes,
-    customer_id, employee_id, pet_id, created_at, updated_at
+    customer_id, employee_id, pet_id, created_at, updated_at, series_id, sequence, is_emergency, triage_priority, visit_stage, checked_in_at, in_exam_room_at, ready_for_checkout_at
 FROM appointments 
 WHERE 
-    ($1::INT IS NULL OR id = $1)
-    AND ($2::INT IS NULL OR customer_id = $2)
-    AND ($3::INT IS NULL OR employee_id = $3)
-    AND ($4::INT IS NULL OR pet_id = $4)
+    ($1::INT = 0 OR id = $1)
+    AND ($2::INT = 0 OR customer_id = $2)
+    AND ($3::INT = 0 OR employee_id = $3)
+    AND ($4::INT = 0 OR pet_id = $4)
     AND (
         $5::TEXT IS NULL OR 
         $5::TEXT = '' OR 
@@ -288,16 +388,24 @@ type FindAppointmentsBySpecParams struct {
 }
 
 type FindAppointmentsBySpecRow struct {
-	ID            int32
-	ClinicService models.ClinicService
-	ScheduledDate pgtype.Timestamptz
-	Status        models.AppointmentStatus
-	Notes         pgtype.Text
-	CustomerID    int32
-	EmployeeID    pgtype.Int4
-	PetID         int32
-	CreatedAt     pgtype.Timestamptz
-	UpdatedAt     pgtype.Timestamptz
+	ID                 int32
+	ClinicService      models.ClinicService
+	ScheduledDate      pgtype.Timestamptz
+	Status             models.AppointmentStatus
+	Notes              pgtype.Text
+	CustomerID         int32
+	EmployeeID         pgtype.Int4
+	PetID              int32
+	CreatedAt          pgtype.Timestamptz
+	UpdatedAt          pgtype.Timestamptz
+	SeriesID           pgtype.Int4
+	Sequence           int32
+	IsEmergency        bool
+	TriagePriority     pgtype.Text
+	VisitStage         pgtype.Text
+	CheckedInAt        pgtype.Timestamptz
+	InExamRoomAt       pgtype.Timestamptz
+	ReadyForCheckoutAt pgtype.Timestamptz
 }
 
 func (q *Queries) FindAppointmentsBySpec(ctx context.Context, arg FindAppointmentsBySpecParams) ([]FindAppointmentsBySpecRow, error) {
@@ -332,6 +440,130 @@ func (q *Queries) FindAppointmentsBySpec(ctx context.Context, arg FindAppointmen
 			&i.PetID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SeriesID,
+			&i.Sequence,
+			&i.IsEmergency,
+			&i.TriagePriority,
+			&i.VisitStage,
+			&i.CheckedInAt,
+			&i.InExamRoomAt,
+			&i.ReadyForCheckoutAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findAppointmentsCheckedInBetween = `-- name: FindAppointmentsCheckedInBetween :many
+SELECT id, clinic_service, scheduled_date, status, notes, customer_id, pet_id, employee_id, created_at, updated_at, deleted_at, series_id, sequence, is_emergency, triage_priority, visit_stage, checked_in_at, in_exam_room_at, ready_for_checkout_at FROM appointments
+WHERE checked_in_at >= $1
+    AND checked_in_at < $2
+    AND ($3::INT = 0 OR employee_id = $3)
+    AND deleted_at IS NULL
+ORDER BY checked_in_at ASC
+`
+
+type FindAppointmentsCheckedInBetweenParams struct {
+	StartDate  pgtype.Timestamptz
+	EndDate    pgtype.Timestamptz
+	EmployeeID int32
+}
+
+func (q *Queries) FindAppointmentsCheckedInBetween(ctx context.Context, arg FindAppointmentsCheckedInBetweenParams) ([]Appointment, error) {
+	rows, err := q.db.Query(ctx, findAppointmentsCheckedInBetween, arg.StartDate, arg.EndDate, arg.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Appointment
+	for rows.Next() {
+		var i Appointment
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClinicService,
+			&i.ScheduledDate,
+			&i.Status,
+			&i.Notes,
+			&i.CustomerID,
+			&i.PetID,
+			&i.EmployeeID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.SeriesID,
+			&i.Sequence,
+			&i.IsEmergency,
+			&i.TriagePriority,
+			&i.VisitStage,
+			&i.CheckedInAt,
+			&i.InExamRoomAt,
+			&i.ReadyForCheckoutAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findScheduleConflictCandidates = `-- name: FindScheduleConflictCandidates :many
+SELECT id, clinic_service, scheduled_date, status, notes, customer_id, pet_id, employee_id, created_at, updated_at, deleted_at, series_id, sequence, is_emergency, triage_priority, visit_stage, checked_in_at, in_exam_room_at, ready_for_checkout_at FROM appointments
+WHERE (pet_id = $1 OR employee_id = $2)
+    AND scheduled_date >= $3
+    AND scheduled_date < $4
+    AND deleted_at IS NULL
+ORDER BY scheduled_date ASC
+`
+
+type FindScheduleConflictCandidatesParams struct {
+	PetID       int32
+	EmployeeID  pgtype.Int4
+	WindowStart pgtype.Timestamptz
+	WindowEnd   pgtype.Timestamptz
+}
+
+func (q *Queries) FindScheduleConflictCandidates(ctx context.Context, arg FindScheduleConflictCandidatesParams) ([]Appointment, error) {
+	rows, err := q.db.Query(ctx, findScheduleConflictCandidates,
+		arg.PetID,
+		arg.EmployeeID,
+		arg.WindowStart,
+		arg.WindowEnd,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Appointment
+	for rows.Next() {
+		var i Appointment
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClinicService,
+			&i.ScheduledDate,
+			&i.Status,
+			&i.Notes,
+			&i.CustomerID,
+			&i.PetID,
+			&i.EmployeeID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.SeriesID,
+			&i.Sequence,
+			&i.IsEmergency,
+			&i.TriagePriority,
+			&i.VisitStage,
+			&i.CheckedInAt,
+			&i.InExamRoomAt,
+			&i.ReadyForCheckoutAt,
 		); err != nil {
 			return nil, err
 		}
@@ -352,20 +584,31 @@ UPDATE appointments SET
     customer_id = $6,
     employee_id = $7,
     pet_id = $8,
+    triage_priority = $9,
+    visit_stage = $10,
+    checked_in_at = $11,
+    in_exam_room_at = $12,
+    ready_for_checkout_at = $13,
+    sequence = sequence + 1,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = $1
-RETURNING id, clinic_service, scheduled_date, status, notes, customer_id, pet_id, employee_id, created_at, updated_at, deleted_at
+RETURNING id, clinic_service, scheduled_date, status, notes, customer_id, pet_id, employee_id, created_at, updated_at, deleted_at, series_id, sequence, is_emergency, triage_priority, visit_stage, checked_in_at, in_exam_room_at, ready_for_checkout_at
 `
 
 type UpdateAppointmentParams struct {
-	ID            int32
-	ClinicService models.ClinicService
-	ScheduledDate pgtype.Timestamptz
-	Status        models.AppointmentStatus
-	Notes         pgtype.Text
-	CustomerID    int32
-	EmployeeID    pgtype.Int4
-	PetID         int32
+	ID                 int32
+	ClinicService      models.ClinicService
+	ScheduledDate      pgtype.Timestamptz
+	Status             models.AppointmentStatus
+	Notes              pgtype.Text
+	CustomerID         int32
+	EmployeeID         pgtype.Int4
+	PetID              int32
+	TriagePriority     pgtype.Text
+	VisitStage         pgtype.Text
+	CheckedInAt        pgtype.Timestamptz
+	InExamRoomAt       pgtype.Timestamptz
+	ReadyForCheckoutAt pgtype.Timestamptz
 }
 
 func (q *Queries) UpdateAppointment(ctx context.Context, arg UpdateAppointmentParams) (Appointment, error) {
@@ -378,6 +621,11 @@ func (q *Queries) UpdateAppointment(ctx context.Context, arg UpdateAppointmentPa
 		arg.CustomerID,
 		arg.EmployeeID,
 		arg.PetID,
+		arg.TriagePriority,
+		arg.VisitStage,
+		arg.CheckedInAt,
+		arg.InExamRoomAt,
+		arg.ReadyForCheckoutAt,
 	)
 	var i Appointment
 	err := row.Scan(
@@ -392,6 +640,14 @@ func (q *Queries) UpdateAppointment(ctx context.Context, arg UpdateAppointmentPa
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.SeriesID,
+		&i.Sequence,
+		&i.IsEmergency,
+		&i.TriagePriority,
+		&i.VisitStage,
+		&i.CheckedInAt,
+		&i.InExamRoomAt,
+		&i.ReadyForCheckoutAt,
 	)
 	return i, err
 }
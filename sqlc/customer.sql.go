@@ -54,21 +54,22 @@ func (q *Queries) CountAllCustomers(ctx context.Context) (int64, error) {
 const createCustomer = `-- name: CreateCustomer :one
 INSERT INTO customers (
     photo, first_name, last_name, gender, 
-    user_id, is_active, date_of_birth
+    user_id, is_active, date_of_birth, preferred_notification_channel
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
+    $1, $2, $3, $4, $5, $6, $7, $8
 )
-RETURNING id, first_name, last_name, photo, date_of_birth, gender, user_id, is_active, created_at, updated_at, deleted_at
+RETURNING id, first_name, last_name, photo, date_of_birth, gender, user_id, is_active, created_at, updated_at, deleted_at, preferred_notification_channel
 `
 
 type CreateCustomerParams struct {
-	Photo       string
-	FirstName   string
-	LastName    string
-	Gender      models.PersonGender
-	UserID      pgtype.Int4
-	IsActive    bool
-	DateOfBirth pgtype.Date
+	Photo                        string
+	FirstName                    string
+	LastName                     string
+	Gender                       models.PersonGender
+	UserID                       pgtype.Int4
+	IsActive                     bool
+	DateOfBirth                  pgtype.Date
+	PreferredNotificationChannel string
 }
 
 func (q *Queries) CreateCustomer(ctx context.Context, arg CreateCustomerParams) (Customer, error) {
@@ -80,6 +81,7 @@ func (q *Queries) CreateCustomer(ctx context.Context, arg CreateCustomerParams)
 		arg.UserID,
 		arg.IsActive,
 		arg.DateOfBirth,
+		arg.PreferredNotificationChannel,
 	)
 	var i Customer
 	err := row.Scan(
@@ -94,6 +96,7 @@ func (q *Queries) CreateCustomer(ctx context.Context, arg CreateCustomerParams)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.PreferredNotificationChannel,
 	)
 	return i, err
 }
@@ -125,7 +128,7 @@ func (q *Queries) ExistsCustomerByID(ctx context.Context, id int32) (bool, error
 }
 
 const findActiveCustomers = `-- name: FindActiveCustomers :many
-SELECT id, first_name, last_name, photo, date_of_birth, gender, user_id, is_active, created_at, updated_at, deleted_at
+SELECT id, first_name, last_name, photo, date_of_birth, gender, user_id, is_active, created_at, updated_at, deleted_at, preferred_notification_channel
 FROM customers
 WHERE is_active = TRUE AND deleted_at IS NULL
 ORDER BY created_at DESC
@@ -158,6 +161,7 @@ func (q *Queries) FindActiveCustomers(ctx context.Context, arg FindActiveCustome
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.PreferredNotificationChannel,
 		); err != nil {
 			return nil, err
 		}
@@ -170,7 +174,7 @@ func (q *Queries) FindActiveCustomers(ctx context.Context, arg FindActiveCustome
 }
 
 const getCustomerByID = `-- name: GetCustomerByID :one
-SELECT id, first_name, last_name, photo, date_of_birth, gender, user_id, is_active, created_at, updated_at, deleted_at
+SELECT id, first_name, last_name, photo, date_of_birth, gender, user_id, is_active, created_at, updated_at, deleted_at, preferred_notification_channel
 FROM customers
 WHERE id = $1 AND deleted_at IS NULL
 `
@@ -190,12 +194,13 @@ func (q *Queries) GetCustomerByID(ctx context.Context, id int32) (Customer, erro
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.PreferredNotificationChannel,
 	)
 	return i, err
 }
 
 const getCustomerByUserID = `-- name: GetCustomerByUserID :one
-SELECT id, first_name, last_name, photo, date_of_birth, gender, user_id, is_active, created_at, updated_at, deleted_at
+SELECT id, first_name, last_name, photo, date_of_birth, gender, user_id, is_active, created_at, updated_at, deleted_at, preferred_notification_channel
 FROM customers
 WHERE user_id = $1 AND deleted_at IS NULL
 `
@@ -215,6 +220,7 @@ func (q *Queries) GetCustomerByUserID(ctx context.Context, userID pgtype.Int4) (
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.PreferredNotificationChannel,
 	)
 	return i, err
 }
@@ -252,19 +258,21 @@ SET
     user_id = $6, 
     is_active = $7, 
     date_of_birth = $8,
+    preferred_notification_channel = $9,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = $1 AND deleted_at IS NULL
 `
 
 type UpdateCustomerParams struct {
-	ID          int32
-	Photo       string
-	FirstName   string
-	LastName    string
-	Gender      models.PersonGender
-	UserID      pgtype.Int4
-	IsActive    bool
-	DateOfBirth pgtype.Date
+	ID                           int32
+	Photo                        string
+	FirstName                    string
+	LastName                     string
+	Gender                       models.PersonGender
+	UserID                       pgtype.Int4
+	IsActive                     bool
+	DateOfBirth                  pgtype.Date
+	PreferredNotificationChannel string
 }
 
 func (q *Queries) UpdateCustomer(ctx context.Context, arg UpdateCustomerParams) error {
@@ -277,6 +285,7 @@ func (q *Queries) UpdateCustomer(ctx context.Context, arg UpdateCustomerParams)
 		arg.UserID,
 		arg.IsActive,
 		arg.DateOfBirth,
+		arg.PreferredNotificationChannel,
 	)
 	return err
 }
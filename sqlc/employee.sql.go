@@ -341,6 +341,17 @@ func (q *Queries) HardDeleteEmployee(ctx context.Context, id int32) error {
 	return err
 }
 
+const lockEmployeeSchedule = `-- name: LockEmployeeSchedule :exec
+SELECT id FROM employees
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockEmployeeSchedule(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, lockEmployeeSchedule, id)
+	return err
+}
+
 const softDeleteEmployee = `-- name: SoftDeleteEmployee :exec
 UPDATE employees
 SET
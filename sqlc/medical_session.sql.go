@@ -12,6 +12,46 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const closeMedicalSession = `-- name: CloseMedicalSession :execrows
+UPDATE medical_sessions
+SET
+    status = 'closed',
+    closed_at = $2,
+    diagnosis = $3,
+    treatment = $4,
+    condition = $5,
+    notes = $6,
+    follow_up_date = $7,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 'draft' AND deleted_at IS NULL
+`
+
+type CloseMedicalSessionParams struct {
+	ID           int32
+	ClosedAt     pgtype.Timestamptz
+	Diagnosis    pgtype.Text
+	Treatment    pgtype.Text
+	Condition    pgtype.Text
+	Notes        pgtype.Text
+	FollowUpDate pgtype.Timestamptz
+}
+
+func (q *Queries) CloseMedicalSession(ctx context.Context, arg CloseMedicalSessionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, closeMedicalSession,
+		arg.ID,
+		arg.ClosedAt,
+		arg.Diagnosis,
+		arg.Treatment,
+		arg.Condition,
+		arg.Notes,
+		arg.FollowUpDate,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const countAllMedicalSession = `-- name: CountAllMedicalSession :one
 SELECT COUNT(*) FROM medical_sessions
 WHERE deleted_at IS NULL
@@ -91,6 +131,92 @@ func (q *Queries) CountMedicalSessionByPetID(ctx context.Context, petID int32) (
 	return count, err
 }
 
+const createAppointmentMedicalSession = `-- name: CreateAppointmentMedicalSession :one
+INSERT INTO medical_sessions (
+    pet_id,
+    customer_id,
+    employee_id,
+    appointment_id,
+    clinic_service,
+    visit_date,
+    visit_type,
+    is_emergency,
+    status
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, 'draft'
+)
+RETURNING id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at, status, closed_at, weight_flag, temperature_flag, heart_rate_flag, respiratory_rate_flag
+`
+
+type CreateAppointmentMedicalSessionParams struct {
+	PetID         int32
+	CustomerID    int32
+	EmployeeID    int32
+	AppointmentID pgtype.Int4
+	ClinicService models.ClinicService
+	VisitDate     pgtype.Timestamptz
+	VisitType     string
+	IsEmergency   pgtype.Bool
+}
+
+func (q *Queries) CreateAppointmentMedicalSession(ctx context.Context, arg CreateAppointmentMedicalSessionParams) (MedicalSession, error) {
+	row := q.db.QueryRow(ctx, createAppointmentMedicalSession,
+		arg.PetID,
+		arg.CustomerID,
+		arg.EmployeeID,
+		arg.AppointmentID,
+		arg.ClinicService,
+		arg.VisitDate,
+		arg.VisitType,
+		arg.IsEmergency,
+	)
+	var i MedicalSession
+	err := row.Scan(
+		&i.ID,
+		&i.PetID,
+		&i.CustomerID,
+		&i.EmployeeID,
+		&i.AppointmentID,
+		&i.ClinicService,
+		&i.VisitDate,
+		&i.VisitType,
+		&i.Diagnosis,
+		&i.Notes,
+		&i.Treatment,
+		&i.Condition,
+		&i.Weight,
+		&i.Temperature,
+		&i.HeartRate,
+		&i.RespiratoryRate,
+		&i.Symptoms,
+		&i.Medications,
+		&i.FollowUpDate,
+		&i.IsEmergency,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.WeightFlag,
+		&i.TemperatureFlag,
+		&i.HeartRateFlag,
+		&i.RespiratoryRateFlag,
+	)
+	return i, err
+}
+
+const existsMedicalSessionByAppointmentID = `-- name: ExistsMedicalSessionByAppointmentID :one
+SELECT COUNT(*) > 0 FROM medical_sessions
+WHERE appointment_id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) ExistsMedicalSessionByAppointmentID(ctx context.Context, appointmentID pgtype.Int4) (bool, error) {
+	row := q.db.QueryRow(ctx, existsMedicalSessionByAppointmentID, appointmentID)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const existsMedicalSessionByID = `-- name: ExistsMedicalSessionByID :one
 SELECT COUNT(*) > 0 FROM medical_sessions
 WHERE id = $1 AND deleted_at IS NULL
@@ -123,7 +249,7 @@ func (q *Queries) ExistsMedicalSessionByPetAndDate(ctx context.Context, arg Exis
 }
 
 const findAllMedicalSession = `-- name: FindAllMedicalSession :many
-SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at FROM medical_sessions
+SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at, status, closed_at, weight_flag, temperature_flag, heart_rate_flag, respiratory_rate_flag FROM medical_sessions
 WHERE deleted_at IS NULL
 ORDER BY visit_date DESC
 LIMIT $1 OFFSET $2
@@ -167,6 +293,12 @@ func (q *Queries) FindAllMedicalSession(ctx context.Context, arg FindAllMedicalS
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Status,
+			&i.ClosedAt,
+			&i.WeightFlag,
+			&i.TemperatureFlag,
+			&i.HeartRateFlag,
+			&i.RespiratoryRateFlag,
 		); err != nil {
 			return nil, err
 		}
@@ -179,7 +311,7 @@ func (q *Queries) FindAllMedicalSession(ctx context.Context, arg FindAllMedicalS
 }
 
 const findMedicalSessionByCustomerID = `-- name: FindMedicalSessionByCustomerID :many
-SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at FROM medical_sessions
+SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at, status, closed_at, weight_flag, temperature_flag, heart_rate_flag, respiratory_rate_flag FROM medical_sessions
 WHERE customer_id = $1 AND deleted_at IS NULL
 ORDER BY visit_date DESC
 LIMIT $2 OFFSET $3
@@ -224,6 +356,12 @@ func (q *Queries) FindMedicalSessionByCustomerID(ctx context.Context, arg FindMe
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Status,
+			&i.ClosedAt,
+			&i.WeightFlag,
+			&i.TemperatureFlag,
+			&i.HeartRateFlag,
+			&i.RespiratoryRateFlag,
 		); err != nil {
 			return nil, err
 		}
@@ -236,7 +374,7 @@ func (q *Queries) FindMedicalSessionByCustomerID(ctx context.Context, arg FindMe
 }
 
 const findMedicalSessionByDateRange = `-- name: FindMedicalSessionByDateRange :many
-SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at FROM medical_sessions
+SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at, status, closed_at, weight_flag, temperature_flag, heart_rate_flag, respiratory_rate_flag FROM medical_sessions
 WHERE visit_date BETWEEN $1 AND $2
 AND deleted_at IS NULL
 ORDER BY visit_date DESC
@@ -288,6 +426,12 @@ func (q *Queries) FindMedicalSessionByDateRange(ctx context.Context, arg FindMed
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Status,
+			&i.ClosedAt,
+			&i.WeightFlag,
+			&i.TemperatureFlag,
+			&i.HeartRateFlag,
+			&i.RespiratoryRateFlag,
 		); err != nil {
 			return nil, err
 		}
@@ -300,7 +444,7 @@ func (q *Queries) FindMedicalSessionByDateRange(ctx context.Context, arg FindMed
 }
 
 const findMedicalSessionByDiagnosis = `-- name: FindMedicalSessionByDiagnosis :many
-SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at FROM medical_sessions
+SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at, status, closed_at, weight_flag, temperature_flag, heart_rate_flag, respiratory_rate_flag FROM medical_sessions
 WHERE diagnosis ILIKE '%' || $1 || '%'
 AND deleted_at IS NULL
 ORDER BY visit_date DESC
@@ -346,6 +490,12 @@ func (q *Queries) FindMedicalSessionByDiagnosis(ctx context.Context, arg FindMed
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Status,
+			&i.ClosedAt,
+			&i.WeightFlag,
+			&i.TemperatureFlag,
+			&i.HeartRateFlag,
+			&i.RespiratoryRateFlag,
 		); err != nil {
 			return nil, err
 		}
@@ -358,7 +508,7 @@ func (q *Queries) FindMedicalSessionByDiagnosis(ctx context.Context, arg FindMed
 }
 
 const findMedicalSessionByEmployeeID = `-- name: FindMedicalSessionByEmployeeID :many
-SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at FROM medical_sessions
+SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at, status, closed_at, weight_flag, temperature_flag, heart_rate_flag, respiratory_rate_flag FROM medical_sessions
 WHERE employee_id = $1 AND deleted_at IS NULL
 ORDER BY visit_date DESC
 LIMIT $2 OFFSET $3
@@ -403,6 +553,12 @@ func (q *Queries) FindMedicalSessionByEmployeeID(ctx context.Context, arg FindMe
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Status,
+			&i.ClosedAt,
+			&i.WeightFlag,
+			&i.TemperatureFlag,
+			&i.HeartRateFlag,
+			&i.RespiratoryRateFlag,
 		); err != nil {
 			return nil, err
 		}
@@ -415,7 +571,7 @@ func (q *Queries) FindMedicalSessionByEmployeeID(ctx context.Context, arg FindMe
 }
 
 const findMedicalSessionByID = `-- name: FindMedicalSessionByID :one
-SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at FROM medical_sessions
+SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at, status, closed_at, weight_flag, temperature_flag, heart_rate_flag, respiratory_rate_flag FROM medical_sessions
 WHERE id = $1 AND deleted_at IS NULL
 `
 
@@ -446,12 +602,18 @@ func (q *Queries) FindMedicalSessionByID(ctx context.Context, id int32) (Medical
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.WeightFlag,
+		&i.TemperatureFlag,
+		&i.HeartRateFlag,
+		&i.RespiratoryRateFlag,
 	)
 	return i, err
 }
 
 const findMedicalSessionByIDAndCustomerID = `-- name: FindMedicalSessionByIDAndCustomerID :one
-SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at FROM medical_sessions
+SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at, status, closed_at, weight_flag, temperature_flag, heart_rate_flag, respiratory_rate_flag FROM medical_sessions
 WHERE id = $1 AND customer_id = $2 AND deleted_at IS NULL
 `
 
@@ -487,12 +649,18 @@ func (q *Queries) FindMedicalSessionByIDAndCustomerID(ctx context.Context, arg F
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.WeightFlag,
+		&i.TemperatureFlag,
+		&i.HeartRateFlag,
+		&i.RespiratoryRateFlag,
 	)
 	return i, err
 }
 
 const findMedicalSessionByIDAndEmployeeID = `-- name: FindMedicalSessionByIDAndEmployeeID :one
-SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at FROM medical_sessions
+SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at, status, closed_at, weight_flag, temperature_flag, heart_rate_flag, respiratory_rate_flag FROM medical_sessions
 WHERE id = $1 AND employee_id = $2 AND deleted_at IS NULL
 `
 
@@ -528,12 +696,18 @@ func (q *Queries) FindMedicalSessionByIDAndEmployeeID(ctx context.Context, arg F
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.WeightFlag,
+		&i.TemperatureFlag,
+		&i.HeartRateFlag,
+		&i.RespiratoryRateFlag,
 	)
 	return i, err
 }
 
 const findMedicalSessionByIDAndPetID = `-- name: FindMedicalSessionByIDAndPetID :one
-SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at FROM medical_sessions
+SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at, status, closed_at, weight_flag, temperature_flag, heart_rate_flag, respiratory_rate_flag FROM medical_sessions
 WHERE id = $1 AND pet_id = $2 AND deleted_at IS NULL
 `
 
@@ -569,12 +743,18 @@ func (q *Queries) FindMedicalSessionByIDAndPetID(ctx context.Context, arg FindMe
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.WeightFlag,
+		&i.TemperatureFlag,
+		&i.HeartRateFlag,
+		&i.RespiratoryRateFlag,
 	)
 	return i, err
 }
 
 const findMedicalSessionByPetAndDateRange = `-- name: FindMedicalSessionByPetAndDateRange :many
-SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at FROM medical_sessions
+SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at, status, closed_at, weight_flag, temperature_flag, heart_rate_flag, respiratory_rate_flag FROM medical_sessions
 WHERE pet_id = $1
 AND visit_date BETWEEN $2 AND $3
 AND deleted_at IS NULL
@@ -620,6 +800,12 @@ func (q *Queries) FindMedicalSessionByPetAndDateRange(ctx context.Context, arg F
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Status,
+			&i.ClosedAt,
+			&i.WeightFlag,
+			&i.TemperatureFlag,
+			&i.HeartRateFlag,
+			&i.RespiratoryRateFlag,
 		); err != nil {
 			return nil, err
 		}
@@ -632,7 +818,7 @@ func (q *Queries) FindMedicalSessionByPetAndDateRange(ctx context.Context, arg F
 }
 
 const findMedicalSessionByPetID = `-- name: FindMedicalSessionByPetID :many
-SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at FROM medical_sessions
+SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at, status, closed_at, weight_flag, temperature_flag, heart_rate_flag, respiratory_rate_flag FROM medical_sessions
 WHERE pet_id = $1 AND deleted_at IS NULL
 ORDER BY visit_date DESC
 LIMIT $2 OFFSET $3
@@ -677,6 +863,12 @@ func (q *Queries) FindMedicalSessionByPetID(ctx context.Context, arg FindMedical
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Status,
+			&i.ClosedAt,
+			&i.WeightFlag,
+			&i.TemperatureFlag,
+			&i.HeartRateFlag,
+			&i.RespiratoryRateFlag,
 		); err != nil {
 			return nil, err
 		}
@@ -689,7 +881,7 @@ func (q *Queries) FindMedicalSessionByPetID(ctx context.Context, arg FindMedical
 }
 
 const findRecentMedicalSessionByPetID = `-- name: FindRecentMedicalSessionByPetID :many
-SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at FROM medical_sessions
+SELECT id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at, status, closed_at, weight_flag, temperature_flag, heart_rate_flag, respiratory_rate_flag FROM medical_sessions
 WHERE pet_id = $1 AND deleted_at IS NULL
 ORDER BY visit_date DESC
 LIMIT $2
@@ -733,6 +925,12 @@ func (q *Queries) FindRecentMedicalSessionByPetID(ctx context.Context, arg FindR
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Status,
+			&i.ClosedAt,
+			&i.WeightFlag,
+			&i.TemperatureFlag,
+			&i.HeartRateFlag,
+			&i.RespiratoryRateFlag,
 		); err != nil {
 			return nil, err
 		}
@@ -769,28 +967,38 @@ INSERT INTO medical_sessions (
     weight,
     temperature,
     heart_rate,
-    respiratory_rate
+    respiratory_rate,
+    follow_up_date,
+    weight_flag,
+    temperature_flag,
+    heart_rate_flag,
+    respiratory_rate_flag
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19
 )
-RETURNING id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at
+RETURNING id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at, status, closed_at, weight_flag, temperature_flag, heart_rate_flag, respiratory_rate_flag
 `
 
 type SaveMedicalSessionParams struct {
-	PetID           int32
-	CustomerID      int32
-	EmployeeID      int32
-	VisitDate       pgtype.Timestamptz
-	VisitType       string
-	Diagnosis       pgtype.Text
-	ClinicService   models.ClinicService
-	Treatment       pgtype.Text
-	Notes           pgtype.Text
-	Condition       pgtype.Text
-	Weight          pgtype.Numeric
-	Temperature     pgtype.Numeric
-	HeartRate       pgtype.Int4
-	RespiratoryRate pgtype.Int4
+	PetID               int32
+	CustomerID          int32
+	EmployeeID          int32
+	VisitDate           pgtype.Timestamptz
+	VisitType           string
+	Diagnosis           pgtype.Text
+	ClinicService       models.ClinicService
+	Treatment           pgtype.Text
+	Notes               pgtype.Text
+	Condition           pgtype.Text
+	Weight              pgtype.Numeric
+	Temperature         pgtype.Numeric
+	HeartRate           pgtype.Int4
+	RespiratoryRate     pgtype.Int4
+	FollowUpDate        pgtype.Timestamptz
+	WeightFlag          pgtype.Text
+	TemperatureFlag     pgtype.Text
+	HeartRateFlag       pgtype.Text
+	RespiratoryRateFlag pgtype.Text
 }
 
 func (q *Queries) SaveMedicalSession(ctx context.Context, arg SaveMedicalSessionParams) (MedicalSession, error) {
@@ -809,6 +1017,11 @@ func (q *Queries) SaveMedicalSession(ctx context.Context, arg SaveMedicalSession
 		arg.Temperature,
 		arg.HeartRate,
 		arg.RespiratoryRate,
+		arg.FollowUpDate,
+		arg.WeightFlag,
+		arg.TemperatureFlag,
+		arg.HeartRateFlag,
+		arg.RespiratoryRateFlag,
 	)
 	var i MedicalSession
 	err := row.Scan(
@@ -835,6 +1048,12 @@ func (q *Queries) SaveMedicalSession(ctx context.Context, arg SaveMedicalSession
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.WeightFlag,
+		&i.TemperatureFlag,
+		&i.HeartRateFlag,
+		&i.RespiratoryRateFlag,
 	)
 	return i, err
 }
@@ -869,27 +1088,35 @@ SET
     heart_rate = $13,
     respiratory_rate = $14,
     clinic_service = $15,
+    weight_flag = $16,
+    temperature_flag = $17,
+    heart_rate_flag = $18,
+    respiratory_rate_flag = $19,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = $1 AND deleted_at IS NULL
-RETURNING id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at
+RETURNING id, pet_id, customer_id, employee_id, appointment_id, clinic_service, visit_date, visit_type, diagnosis, notes, treatment, condition, weight, temperature, heart_rate, respiratory_rate, symptoms, medications, follow_up_date, is_emergency, created_at, updated_at, deleted_at, status, closed_at, weight_flag, temperature_flag, heart_rate_flag, respiratory_rate_flag
 `
 
 type UpdateMedicalSessionParams struct {
-	ID              int32
-	PetID           int32
-	CustomerID      int32
-	EmployeeID      int32
-	VisitDate       pgtype.Timestamptz
-	VisitType       string
-	Diagnosis       pgtype.Text
-	Treatment       pgtype.Text
-	Notes           pgtype.Text
-	Condition       pgtype.Text
-	Weight          pgtype.Numeric
-	Temperature     pgtype.Numeric
-	HeartRate       pgtype.Int4
-	RespiratoryRate pgtype.Int4
-	ClinicService   models.ClinicService
+	ID                  int32
+	PetID               int32
+	CustomerID          int32
+	EmployeeID          int32
+	VisitDate           pgtype.Timestamptz
+	VisitType           string
+	Diagnosis           pgtype.Text
+	Treatment           pgtype.Text
+	Notes               pgtype.Text
+	Condition           pgtype.Text
+	Weight              pgtype.Numeric
+	Temperature         pgtype.Numeric
+	HeartRate           pgtype.Int4
+	RespiratoryRate     pgtype.Int4
+	ClinicService       models.ClinicService
+	WeightFlag          pgtype.Text
+	TemperatureFlag     pgtype.Text
+	HeartRateFlag       pgtype.Text
+	RespiratoryRateFlag pgtype.Text
 }
 
 func (q *Queries) UpdateMedicalSession(ctx context.Context, arg UpdateMedicalSessionParams) (MedicalSession, error) {
@@ -909,6 +1136,10 @@ func (q *Queries) UpdateMedicalSession(ctx context.Context, arg UpdateMedicalSes
 		arg.HeartRate,
 		arg.RespiratoryRate,
 		arg.ClinicService,
+		arg.WeightFlag,
+		arg.TemperatureFlag,
+		arg.HeartRateFlag,
+		arg.RespiratoryRateFlag,
 	)
 	var i MedicalSession
 	err := row.Scan(
@@ -935,6 +1166,30 @@ func (q *Queries) UpdateMedicalSession(ctx context.Context, arg UpdateMedicalSes
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.WeightFlag,
+		&i.TemperatureFlag,
+		&i.HeartRateFlag,
+		&i.RespiratoryRateFlag,
 	)
 	return i, err
 }
+
+const updateMedicalSessionFollowUpDate = `-- name: UpdateMedicalSessionFollowUpDate :exec
+UPDATE medical_sessions
+SET
+    follow_up_date = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+type UpdateMedicalSessionFollowUpDateParams struct {
+	ID           int32
+	FollowUpDate pgtype.Timestamptz
+}
+
+func (q *Queries) UpdateMedicalSessionFollowUpDate(ctx context.Context, arg UpdateMedicalSessionFollowUpDateParams) error {
+	_, err := q.db.Exec(ctx, updateMedicalSessionFollowUpDate, arg.ID, arg.FollowUpDate)
+	return err
+}
@@ -10,31 +10,136 @@ import (
 )
 
 type Appointment struct {
-	ID            int32
-	ClinicService models.ClinicService
+	ID                 int32
+	ClinicService      models.ClinicService
+	ScheduledDate      pgtype.Timestamptz
+	Status             models.AppointmentStatus
+	Notes              pgtype.Text
+	CustomerID         int32
+	PetID              int32
+	EmployeeID         pgtype.Int4
+	CreatedAt          pgtype.Timestamptz
+	UpdatedAt          pgtype.Timestamptz
+	DeletedAt          pgtype.Timestamptz
+	SeriesID           pgtype.Int4
+	Sequence           int32
+	IsEmergency        bool
+	TriagePriority     pgtype.Text
+	VisitStage         pgtype.Text
+	CheckedInAt        pgtype.Timestamptz
+	InExamRoomAt       pgtype.Timestamptz
+	ReadyForCheckoutAt pgtype.Timestamptz
+}
+
+type AppointmentReminder struct {
+	AppointmentID int32
 	ScheduledDate pgtype.Timestamptz
-	Status        models.AppointmentStatus
-	Notes         pgtype.Text
-	CustomerID    int32
-	PetID         int32
-	EmployeeID    pgtype.Int4
+	Channel       pgtype.Text
+	ClaimedAt     pgtype.Timestamptz
+	SentAt        pgtype.Timestamptz
+}
+
+type AppointmentResourceReservation struct {
+	ID            int32
+	AppointmentID int32
+	ResourceID    int32
+	StartTime     pgtype.Timestamptz
+	EndTime       pgtype.Timestamptz
 	CreatedAt     pgtype.Timestamptz
-	UpdatedAt     pgtype.Timestamptz
-	DeletedAt     pgtype.Timestamptz
 }
 
-type Customer struct {
+type AppointmentSeries struct {
+	ID              int32
+	CustomerID      int32
+	PetID           int32
+	EmployeeID      pgtype.Int4
+	ClinicService   models.ClinicService
+	StartDate       pgtype.Timestamptz
+	Frequency       string
+	RepeatInterval  int32
+	OccurrenceCount pgtype.Int4
+	UntilDate       pgtype.Timestamptz
+	Notes           pgtype.Text
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+}
+
+type AppointmentWaitlist struct {
+	ID                  int32
+	CustomerID          int32
+	PetID               int32
+	ClinicService       models.ClinicService
+	RequestedDate       pgtype.Date
+	PreferredEmployeeID pgtype.Int4
+	Notes               pgtype.Text
+	Status              string
+	OfferedSlot         pgtype.Timestamptz
+	OfferedEmployeeID   pgtype.Int4
+	ClaimTokenHash      pgtype.Text
+	OfferExpiresAt      pgtype.Timestamptz
+	AppointmentID       pgtype.Int4
+	CreatedAt           pgtype.Timestamptz
+	UpdatedAt           pgtype.Timestamptz
+	MissedOffers        int32
+}
+
+type CalendarFeed struct {
+	ID        int32
+	OwnerType string
+	OwnerID   int32
+	RevokedAt pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
+}
+
+type ClinicBookingPolicy struct {
+	ID          int16
+	MinLeadDays int32
+	MaxLeadDays int32
+	UpdatedAt   pgtype.Timestamptz
+}
+
+type ClinicClosure struct {
 	ID          int32
-	FirstName   string
-	LastName    string
-	Photo       string
-	DateOfBirth pgtype.Date
-	Gender      models.PersonGender
-	UserID      pgtype.Int4
-	IsActive    bool
-	CreatedAt   pgtype.Timestamp
-	UpdatedAt   pgtype.Timestamp
-	DeletedAt   pgtype.Timestamp
+	ClosureDate pgtype.Date
+	ClosureType string
+	Reason      string
+	StartHour   pgtype.Int2
+	EndHour     pgtype.Int2
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+}
+
+type ClinicOpeningHour struct {
+	Weekday   int16
+	OpenHour  int16
+	CloseHour int16
+	IsClosed  bool
+	UpdatedAt pgtype.Timestamptz
+}
+
+type ClinicResource struct {
+	ID           int32
+	Name         string
+	ResourceType string
+	Description  pgtype.Text
+	IsActive     bool
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+}
+
+type Customer struct {
+	ID                           int32
+	FirstName                    string
+	LastName                     string
+	Photo                        string
+	DateOfBirth                  pgtype.Date
+	Gender                       models.PersonGender
+	UserID                       pgtype.Int4
+	IsActive                     bool
+	CreatedAt                    pgtype.Timestamp
+	UpdatedAt                    pgtype.Timestamp
+	DeletedAt                    pgtype.Timestamp
+	PreferredNotificationChannel string
 }
 
 type Employee struct {
@@ -55,30 +160,137 @@ type Employee struct {
 	DeletedAt         pgtype.Timestamp
 }
 
+type EmployeeScheduleException struct {
+	ID            int32
+	EmployeeID    int32
+	ExceptionType string
+	StartDate     pgtype.Timestamptz
+	EndDate       pgtype.Timestamptz
+	Reason        string
+	Status        string
+	ReviewedBy    pgtype.Int4
+	ReviewNotes   pgtype.Text
+	ReviewedAt    pgtype.Timestamptz
+	CreatedAt     pgtype.Timestamptz
+	UpdatedAt     pgtype.Timestamptz
+}
+
+type InventoryProduct struct {
+	ID           int32
+	Name         string
+	Category     string
+	Unit         string
+	ReorderLevel int32
+	Description  pgtype.Text
+	IsActive     bool
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+}
+
+type LabOrder struct {
+	ID               int32
+	MedicalSessionID int32
+	PetID            int32
+	OrderedBy        int32
+	LabName          string
+	AccessionNumber  pgtype.Text
+	Status           string
+	Notes            pgtype.Text
+	OrderedAt        pgtype.Timestamptz
+	ResultedAt       pgtype.Timestamptz
+	CancelledAt      pgtype.Timestamptz
+	CancelReason     pgtype.Text
+	CreatedAt        pgtype.Timestamptz
+	UpdatedAt        pgtype.Timestamptz
+}
+
+type LabOrderPanel struct {
+	LabOrderID int32
+	PanelCode  string
+	PanelName  string
+}
+
+type LabResult struct {
+	ID            int32
+	LabOrderID    int32
+	PetID         int32
+	PanelCode     string
+	AnalyteCode   string
+	AnalyteName   string
+	NumericValue  pgtype.Float8
+	TextValue     pgtype.Text
+	Unit          string
+	ReferenceLow  pgtype.Float8
+	ReferenceHigh pgtype.Float8
+	Flag          pgtype.Text
+	ObservedAt    pgtype.Timestamptz
+	CreatedAt     pgtype.Timestamptz
+	UpdatedAt     pgtype.Timestamptz
+}
+
+type MedicalAttachment struct {
+	ID               int32
+	PetID            int32
+	MedicalSessionID pgtype.Int4
+	UploadedBy       int32
+	Category         string
+	FileName         string
+	ContentType      string
+	SizeBytes        int64
+	ChecksumSha256   string
+	StorageKey       string
+	Description      pgtype.Text
+	CreatedAt        pgtype.Timestamptz
+	UpdatedAt        pgtype.Timestamptz
+}
+
 type MedicalSession struct {
-	ID              int32
-	PetID           int32
-	CustomerID      int32
-	EmployeeID      int32
-	AppointmentID   pgtype.Int4
-	ClinicService   models.ClinicService
-	VisitDate       pgtype.Timestamptz
-	VisitType       string
-	Diagnosis       pgtype.Text
-	Notes           pgtype.Text
-	Treatment       pgtype.Text
-	Condition       pgtype.Text
-	Weight          pgtype.Numeric
-	Temperature     pgtype.Numeric
-	HeartRate       pgtype.Int4
-	RespiratoryRate pgtype.Int4
-	Symptoms        pgtype.Text
-	Medications     pgtype.Text
-	FollowUpDate    pgtype.Timestamptz
-	IsEmergency     pgtype.Bool
-	CreatedAt       pgtype.Timestamptz
-	UpdatedAt       pgtype.Timestamptz
-	DeletedAt       pgtype.Timestamptz
+	ID                  int32
+	PetID               int32
+	CustomerID          int32
+	EmployeeID          int32
+	AppointmentID       pgtype.Int4
+	ClinicService       models.ClinicService
+	VisitDate           pgtype.Timestamptz
+	VisitType           string
+	Diagnosis           pgtype.Text
+	Notes               pgtype.Text
+	Treatment           pgtype.Text
+	Condition           pgtype.Text
+	Weight              pgtype.Numeric
+	Temperature         pgtype.Numeric
+	HeartRate           pgtype.Int4
+	RespiratoryRate     pgtype.Int4
+	Symptoms            pgtype.Text
+	Medications         pgtype.Text
+	FollowUpDate        pgtype.Timestamptz
+	IsEmergency         pgtype.Bool
+	CreatedAt           pgtype.Timestamptz
+	UpdatedAt           pgtype.Timestamptz
+	DeletedAt           pgtype.Timestamptz
+	Status              string
+	ClosedAt            pgtype.Timestamptz
+	WeightFlag          pgtype.Text
+	TemperatureFlag     pgtype.Text
+	HeartRateFlag       pgtype.Text
+	RespiratoryRateFlag pgtype.Text
+}
+
+type MedicalSessionFollowUp struct {
+	MedicalSessionID int32
+	AppointmentID    int32
+	CreatedAt        pgtype.Timestamptz
+	UpdatedAt        pgtype.Timestamptz
+}
+
+type OnCallShift struct {
+	ID         int32
+	EmployeeID int32
+	Specialty  string
+	StartsAt   pgtype.Timestamptz
+	EndsAt     pgtype.Timestamptz
+	CreatedAt  pgtype.Timestamptz
+	UpdatedAt  pgtype.Timestamptz
 }
 
 type Payment struct {
@@ -187,6 +399,73 @@ type PetVaccination struct {
 	UpdatedAt        pgtype.Timestamptz
 }
 
+type Prescription struct {
+	ID                int32
+	MedicalSessionID  int32
+	PetID             int32
+	PrescribedBy      int32
+	DrugName          string
+	Strength          string
+	Dose              string
+	Route             string
+	Frequency         string
+	DurationDays      int32
+	Quantity          int32
+	RefillsAuthorized int32
+	RefillsRemaining  int32
+	Instructions      pgtype.Text
+	Status            string
+	PrescribedAt      pgtype.Timestamptz
+	LastFilledAt      pgtype.Timestamptz
+	CancelledAt       pgtype.Timestamptz
+	CancelReason      pgtype.Text
+	CreatedAt         pgtype.Timestamptz
+	UpdatedAt         pgtype.Timestamptz
+}
+
+type ServiceResourceRequirement struct {
+	ClinicService models.ClinicService
+	ResourceType  string
+	Quantity      int16
+}
+
+type StockLocation struct {
+	ID          int32
+	Name        string
+	Description pgtype.Text
+	IsActive    bool
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+}
+
+type StockLot struct {
+	ID             int32
+	ProductID      int32
+	LocationID     int32
+	LotNumber      string
+	ExpiresOn      pgtype.Date
+	QuantityOnHand int32
+	ReceivedAt     pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+}
+
+type StockMovement struct {
+	ID            int32
+	LotID         int32
+	ProductID     int32
+	MovementType  string
+	Quantity      int32
+	BalanceAfter  int32
+	Reason        pgtype.Text
+	ReferenceType pgtype.Text
+	ReferenceID   pgtype.Int4
+	PerformedBy   int32
+	OccurredAt    pgtype.Timestamptz
+	CreatedAt     pgtype.Timestamptz
+	UpdatedAt     pgtype.Timestamptz
+}
+
 type User struct {
 	ID          int32
 	Email       pgtype.Text
@@ -353,6 +353,17 @@ func (q *Queries) HardDeletePet(ctx context.Context, id int32) error {
 	return err
 }
 
+const lockPetSchedule = `-- name: LockPetSchedule :exec
+SELECT id FROM pets
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockPetSchedule(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, lockPetSchedule, id)
+	return err
+}
+
 const restorePet = `-- name: RestorePet :exec
 UPDATE pets
 SET 